@@ -35,8 +35,10 @@ corteza: schema.#platform & {
 		options.provision,
 		options.sentry,
 		options.template,
+		options.tracing,
 		options.upgrade,
 		options.waitFor,
+		options.webhook,
 		options.websocket,
 		options.workflow,
 		options.discovery,
@@ -37,6 +37,7 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/rbac"
 	"github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/pkg/sentry"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
 	"github.com/cortezaproject/corteza/server/pkg/valuestore"
 	"github.com/cortezaproject/corteza/server/pkg/version"
 	"github.com/cortezaproject/corteza/server/pkg/websocket"
@@ -126,6 +127,10 @@ func (app *CortezaApp) Setup() (err error) {
 	// that might occur inside auth, mail setup...
 	defer sentry.Recover()
 
+	if err = tracing.Setup(context.Background(), app.Opt.Tracing); err != nil {
+		return fmt.Errorf("could not initialize tracing: %w", err)
+	}
+
 	{
 		var (
 			localeLog = zap.NewNop()
@@ -356,6 +361,11 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 
 		// Initialize RBAC subsystem
 		ac := rbac.NewService(log, app.Store)
+		ac.Transactional(func(ctx context.Context, fn func(context.Context, rbac.RulesStore) error) error {
+			return store.Tx(ctx, app.Store, func(ctx context.Context, s store.Storer) error {
+				return fn(ctx, s)
+			})
+		})
 
 		// and (re)load rules from the storage backend
 		ac.Reload(ctx)
@@ -363,6 +373,14 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 		rbac.SetGlobal(ac)
 	}
 
+	// Scheduled jobs are claimed in the primary store
+	// so that each of them runs once across all instances
+	scheduler.SetupClaims(app.Log, app.Store)
+	if scheduler.Service() != nil {
+		scheduler.Service().Coordinate(scheduler.Claims())
+	}
+	rbac.Global().Coordinate(scheduler.Claims())
+
 	// Initialize resource translation stuff
 	locale.Global().BindStore(app.Store)
 	if err = locale.Global().ReloadResourceTranslations(ctx); err != nil {
@@ -384,6 +402,7 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 		Limit:      app.Opt.Limit,
 		Attachment: app.Opt.Attachment,
 		Webapps:    app.Opt.Webapp,
+		Webhook:    app.Opt.Webhook,
 	})
 	if err != nil {
 		return
@@ -428,6 +447,9 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 	corredor.Service().SetUserFinder(sysService.DefaultUser)
 	corredor.Service().SetRoleFinder(sysService.DefaultRole)
 
+	// webhooks deliver only record values their owners can read
+	sysService.DefaultWebhook.SetRecordAccessController(cmpService.DefaultAccessControl)
+
 	{
 		var c = apigwTypes.Config{Enabled: true}
 
@@ -654,6 +676,10 @@ func updateAuthSettings(svc authServicer, current *types.AppSettings) {
 				Enabled:  current.Auth.MultiFactor.EmailOTP.Enabled,
 				Enforced: current.Auth.MultiFactor.EmailOTP.Enforced,
 			},
+			WebAuthn: authSettings.WebAuthn{
+				Enabled:      current.Auth.MultiFactor.WebAuthn.Enabled,
+				Passwordless: current.Auth.MultiFactor.WebAuthn.Passwordless,
+			},
 		},
 		BackgroundUI: authSettings.BackgroundUI{
 			BackgroundImageSrcUrl: setAuthBgImageSrcUrl(current.Auth.UI.BackgroundImageSrc),
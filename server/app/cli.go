@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	composeCommands "github.com/cortezaproject/corteza/server/compose/commands"
 
 	authCommands "github.com/cortezaproject/corteza/server/auth/commands"
+	autService "github.com/cortezaproject/corteza/server/automation/service"
 	federationCommands "github.com/cortezaproject/corteza/server/federation/commands"
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
 	"github.com/cortezaproject/corteza/server/pkg/api/server"
@@ -16,6 +18,7 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/envoyx"
 	"github.com/cortezaproject/corteza/server/pkg/id"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
 	"github.com/cortezaproject/corteza/server/store"
 	systemCommands "github.com/cortezaproject/corteza/server/system/commands"
 	"go.uber.org/zap"
@@ -99,6 +102,25 @@ func (app *CortezaApp) InitCLI() {
 
 		app.HttpServer.Shutdown()
 
+		{
+			// store state of running workflow sessions
+			// so they can be resumed after restart
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			autService.Shutdown(ctx)
+		}
+
+		{
+			// flush spans that were not exported yet
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err = tracing.Shutdown(ctx); err != nil {
+				app.Log.Warn("could not flush traces", zap.Error(err))
+			}
+		}
+
 		return nil
 	})
 
@@ -139,6 +161,7 @@ func (app *CortezaApp) InitCLI() {
 		systemCommands.RBAC(ctx, storeInit),
 		systemCommands.Sink(ctx, app),
 		systemCommands.Settings(ctx, app),
+		systemCommands.Attachments(ctx, app),
 		systemCommands.Import(ctx, storeInit, dalInit, envoyInit),
 		systemCommands.Export(ctx, storeInit, dalInit, envoyInit),
 		serveCmd,
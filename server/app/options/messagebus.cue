@@ -16,6 +16,9 @@ messagebus: schema.#optionsGroup & {
 			type:        "bool"
 			description: "Enable extra logging for messagebus watchers"
 		}
+		redis_URL: {
+			description: "Redis connection URL used by queues with redis consumer (e.g. redis://:password@localhost:6379/0)."
+		}
 	}
 	title: "Messaging queue"
 }
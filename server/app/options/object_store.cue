@@ -8,7 +8,7 @@ objectStore: schema.#optionsGroup & {
 	handle: "object-store"
 	title:  "Object (file) storage"
 
-	intro: "The MinIO integration allows you to replace local storage with cloud storage. When configured, `STORAGE_PATH` is not needed."
+	intro: "The MinIO and S3 integrations allow you to replace local storage with cloud storage. When configured, `STORAGE_PATH` is not needed."
 	options: {
 		path: {
 			defaultValue: "var/store"
@@ -45,5 +45,64 @@ objectStore: schema.#optionsGroup & {
 			type: "bool"
 			env:  "MINIO_STRICT"
 		}
+		s3Endpoint: {
+			description: "S3-compatible storage endpoint (host and optional port). When set, S3 storage is used instead of MinIO or local storage."
+			env:         "S3_ENDPOINT"
+		}
+		s3Region: {
+			defaultValue: "us-east-1"
+			env:          "S3_REGION"
+		}
+		s3Secure: {
+			type:          "bool"
+			defaultGoExpr: "true"
+			env:           "S3_SECURE"
+		}
+		s3AccessKey: {
+			env: "S3_ACCESS_KEY"
+		}
+		s3SecretKey: {
+			env: "S3_SECRET_KEY"
+		}
+		s3Bucket: {
+			defaultValue: "{component}"
+			description:  "`component` placeholder is replaced with service name (e.g system)."
+			env:          "S3_BUCKET"
+		}
+		s3PathPrefix: {
+			description: "`component` placeholder is replaced with service name (e.g system)."
+			env:         "S3_PATH_PREFIX"
+		}
+		s3PathStyle: {
+			type:        "bool"
+			description: "Use path-style bucket addressing instead of virtual-hosted one; required by most self-hosted S3-compatible storages."
+			env:         "S3_PATH_STYLE"
+		}
+		s3PartSize: {
+			type:          "int"
+			defaultGoExpr: "16"
+			defaultValue:  "16"
+			description:   "Size of the parts (in MB) files are uploaded in. Can not be less than 5."
+			env:           "S3_PART_SIZE"
+		}
+		s3Strict: {
+			type: "bool"
+			env:  "S3_STRICT"
+		}
+		presignTtl: {
+			type:        "time.Duration"
+			description: "When set, attachments are downloaded directly from the storage through presigned URLs valid for the given duration instead of being streamed through the server. Supported by MinIO (without SSE-C) and S3 storages."
+			env:         "STORAGE_PRESIGN_TTL"
+		}
+		encryptionKeys: {
+			description: """
+				Comma separated list of base64 encoded 256-bit master keys. When set, files are encrypted before they are stored.
+				Each file is encrypted with its own data key that is wrapped with the first master key; the rest of the keys are only used for reading files wrapped with them.
+				To rotate the master key, put the new key first and run `corteza-server attachments rotate-key`; the old key can be removed afterwards.
+				Files stored before the encryption was enabled are still readable and are encrypted by the rotation command.
+				Encrypted files are always streamed through the server; presigned URLs are not used.
+				"""
+			env: "STORAGE_ENCRYPTION_KEYS"
+		}
 	}
 }
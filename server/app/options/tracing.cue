@@ -0,0 +1,58 @@
+package options
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+tracing: schema.#optionsGroup & {
+	handle: "tracing"
+	title:  "OpenTelemetry tracing"
+
+	intro: """
+		Traces cover HTTP requests, API gateway pipelines, database queries, DAL pipelines and workflow steps.
+		Trace context is propagated to Corredor and to HTTP requests sent from workflows.
+		"""
+
+	options: {
+		enabled: {
+			type:        "bool"
+			description: "Enable collection and export of OpenTelemetry traces."
+		}
+		exporter: {
+			defaultGoExpr: "\"otlp\""
+			defaultValue:  "otlp"
+			description: """
+				Trace exporter.
+				Supported values are `otlp` and `stdout`.
+				"""
+		}
+		otlp_endpoint: {
+			defaultGoExpr: "\"localhost:4317\""
+			defaultValue:  "localhost:4317"
+			description:   "Address (host and port) of the OTLP collector."
+		}
+		otlp_protocol: {
+			defaultGoExpr: "\"grpc\""
+			defaultValue:  "grpc"
+			description: """
+				Protocol used by the OTLP exporter.
+				Supported values are `grpc` and `http`.
+				"""
+		}
+		otlp_insecure: {
+			type:        "bool"
+			description: "Disable TLS when connecting to the OTLP collector."
+		}
+		service_name: {
+			defaultGoExpr: "\"corteza-server\""
+			defaultValue:  "corteza-server"
+			description:   "Service name reported with the traces."
+		}
+		sample_ratio: {
+			type:          "float64"
+			defaultGoExpr: "1"
+			defaultValue:  "1"
+			description:   "Ratio of the traces that are sampled (0.0 - 1.0)."
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package options
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+webhook: schema.#optionsGroup & {
+	handle: "webhook"
+	title:  "Webhooks"
+
+	intro: """
+		Webhooks deliver events (e.g. record changes) to external endpoints.
+		Failed deliveries are retried with exponential backoff until they run out of attempts.
+		"""
+
+	options: {
+		enabled: {
+			type:          "bool"
+			defaultGoExpr: "true"
+			defaultValue:  "true"
+			description:   "Enable webhook deliveries."
+		}
+		timeout: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Second * 10"
+			defaultValue:  "10s"
+			description:   "Timeout for one delivery attempt."
+		}
+		max_attempts: {
+			type:          "int"
+			defaultGoExpr: "8"
+			defaultValue:  "8"
+			description: """
+				Number of delivery attempts before delivery is marked as dead.
+				Dead deliveries can only be redelivered manually.
+				"""
+		}
+		retry_backoff: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Second * 30"
+			defaultValue:  "30s"
+			description:   "Delay before the first retry; each next retry waits twice as long."
+		}
+		retry_backoff_max: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Hour * 6"
+			defaultValue:  "6h"
+			description:   "Maximum delay between retries."
+		}
+		retry_interval: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Second * 15"
+			defaultValue:  "15s"
+			description:   "How often are pending deliveries checked and retried."
+		}
+	}
+}
@@ -44,6 +44,14 @@ resources: { [key=_]: {"handle": key, "component": "system", "platform": "cortez
 					goType: "types.Access"
 					dal: { type: "Number", meta: { "rdbms:type": "integer" } }
 				}
+				not_before: {
+					goType: "*time.Time"
+					dal: { type: "Timestamp", timezone: true, nullable: true }
+				}
+				not_after: {
+					goType: "*time.Time"
+					dal: { type: "Timestamp", timezone: true, nullable: true }
+				}
 			}
 
 			indexes: {
@@ -69,6 +77,165 @@ resources: { [key=_]: {"handle": key, "component": "system", "platform": "cortez
 		}
 	}
 
+	"rbac-rule-change": {
+		package: {
+			ident: "rbac"
+			import: "github.com/cortezaproject/corteza/server/pkg/rbac"
+		}
+
+		ident: "ruleChange"
+		identPlural: "ruleChanges"
+		expIdent: "RuleChange"
+
+		features: {
+			labels: false
+			paging: false
+			checkFn: false
+		}
+
+		model: {
+			ident: "rbac_rule_changes"
+			attributes: {
+				id:        schema.IdField
+				change_set_id: {
+					goType: "uint64",
+					ident: "changeSetID"
+					storeIdent: "rel_change_set"
+					dal: { type: "ID" }
+				}
+				timestamp: schema.SortableTimestampField & { storeIdent: "ts" }
+				actor_id: {
+					goType: "uint64",
+					ident: "actorID"
+					dal: { type: "Ref", refModelResType: "corteza::system:user" }
+				}
+				role_id:   {
+					goType: "uint64",
+					ident: "roleID",
+					storeIdent: "rel_role"
+					dal: { type: "Ref", refModelResType: "corteza::system:role" }
+				}
+				resource:  {
+					dal: { length: 512 }
+				}
+				operation: {
+					dal: { length: 50 }
+				}
+				before: {
+					goType: "types.RuleState"
+					dal: { type: "JSON", defaultEmptyObject: true }
+				}
+				after: {
+					goType: "types.RuleState"
+					dal: { type: "JSON", defaultEmptyObject: true }
+				}
+			}
+
+			indexes: {
+				"primary": { attribute: "id" }
+				"change_set": { attribute: "change_set_id" }
+				"role": { attribute: "role_id" }
+			}
+		}
+
+		filter: {
+			expIdent: "RuleChangeFilter"
+			struct: {
+				change_set_id: { goType: "[]uint64", ident: "changeSetID", storeIdent: "rel_change_set" }
+				role_id: { goType: "[]uint64", ident: "roleID", storeIdent: "rel_role" }
+				after_change_set_id: { goType: "uint64", ident: "afterChangeSetID" }
+				changed_after: { goType: "*time.Time", ident: "changedAfter" }
+				limit: { goType: "uint" }
+			}
+
+			byValue: ["change_set_id", "role_id"]
+		}
+
+		store: {
+			ident: "rbacRuleChange"
+
+			api: {
+				lookups: []
+			}
+		}
+	}
+
+	"scheduler-claim": {
+		package: {
+			ident: "scheduler"
+			import: "github.com/cortezaproject/corteza/server/pkg/scheduler"
+		}
+
+		ident: "claim"
+		identPlural: "claims"
+		expIdent: "Claim"
+
+		features: _allFeaturesDisabled
+
+		model: {
+			ident: "scheduler_claims"
+			attributes: {
+				job: {
+					dal: { length: 512 }
+				}
+				claimed_by: {
+					dal: { length: 256 }
+				}
+				claimed_at: {
+					goType: "time.Time"
+					dal: { type: "Timestamp", timezone: true }
+				}
+				expires_at: {
+					goType: "time.Time"
+					dal: { type: "Timestamp", timezone: true }
+				}
+			}
+
+			indexes: {
+				"primary": { attribute: "job" }
+				"expires_at": { attribute: "expires_at" }
+			}
+		}
+
+		filter: {
+			expIdent: "ClaimFilter"
+			struct: {
+				job: { goType: "[]string" }
+				expired_before: { goType: "*time.Time" }
+				limit: { goType: "uint" }
+			}
+
+			byValue: ["job"]
+		}
+
+		store: {
+			ident: "schedulerClaim"
+
+			api: {
+				lookups: [
+					{
+						fields: ["job"]
+						description: """
+							searches for scheduler claim by job
+							"""
+					},
+				]
+
+				functions: [
+					{
+						expIdent: "RenewSchedulerClaim"
+						args: [ {ident: "claim", goType: "*types.Claim"} ]
+						return: [ "bool" ]
+					}, {
+						expIdent: "ReleaseSchedulerClaim"
+						args: [ {ident: "claim", goType: "*types.Claim"} ]
+						return: [ "bool" ]
+					},
+				]
+			}
+		}
+	}
+
 	"label": {
 		package: {
 			ident: "labels"
@@ -14,6 +14,7 @@ import (
 	federationRest "github.com/cortezaproject/corteza/server/federation/rest"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/version"
 	"github.com/cortezaproject/corteza/server/pkg/webapp"
 	systemRest "github.com/cortezaproject/corteza/server/system/rest"
 	"github.com/cortezaproject/corteza/server/system/scim"
@@ -101,8 +102,12 @@ func (app *CortezaApp) mountHttpRoutes(r chi.Router) {
 			app.Log.Info(
 				"API docs enabled",
 				zap.String("baseUrl", fullpathDocs),
+				zap.String("openapi", fullpathDocs+"/openapi/v3.1.json"),
 			)
 
+			// generated OpenAPI document, path is versioned with the specification version
+			r.Get("/docs/openapi/v3.1.json", docs.OpenAPI(version.Version, fullpathAPI))
+
 			r.Handle("/docs", http.RedirectHandler(fullpathDocs+"/", http.StatusPermanentRedirect))
 			r.Handle("/docs*", http.StripPrefix(fullpathDocs, http.FileServer(docs.GetFS())))
 
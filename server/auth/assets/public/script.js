@@ -15,4 +15,91 @@ $(function () {
   $('#avatar').change(function () {
     $('form').submit();
   })
+
+  $('form[data-webauthn]').on('submit', webauthnSubmit)
 })
+
+// Security keys (WebAuthn)
+//
+// Form with data-webauthn="create" or data-webauthn="get" attribute
+// holds ceremony options (data-webauthn-options) prepared by the server.
+//
+// On submit, browser is asked to create or get the credential
+// and the result is sent with the form in the "credential" field
+function webauthnSubmit (event) {
+  let form = this
+
+  if (form.dataset.webauthnDone) {
+    return
+  }
+
+  event.preventDefault()
+  event.stopImmediatePropagation()
+
+  if (!window.PublicKeyCredential) {
+    webauthnError(form, form.dataset.webauthnUnsupported)
+    return
+  }
+
+  let opt = JSON.parse(form.dataset.webauthnOptions)
+  let ceremony
+
+  opt.challenge = b64urlDecode(opt.challenge)
+  if (form.dataset.webauthn === 'create') {
+    opt.user.id = b64urlDecode(opt.user.id)
+    opt.excludeCredentials = (opt.excludeCredentials || []).map(c => ({ ...c, id: b64urlDecode(c.id) }))
+    ceremony = navigator.credentials.create({ publicKey: opt })
+  } else {
+    opt.allowCredentials = (opt.allowCredentials || []).map(c => ({ ...c, id: b64urlDecode(c.id) }))
+    ceremony = navigator.credentials.get({ publicKey: opt })
+  }
+
+  ceremony
+    .then(function (cred) {
+      let rsp = {
+        id: cred.id,
+        rawId: b64urlEncode(cred.rawId),
+        type: cred.type,
+        response: { clientDataJSON: b64urlEncode(cred.response.clientDataJSON) },
+      }
+
+      if (cred.response.attestationObject) {
+        rsp.response.attestationObject = b64urlEncode(cred.response.attestationObject)
+        if (cred.response.getTransports) {
+          rsp.transports = cred.response.getTransports()
+        }
+      } else {
+        rsp.response.authenticatorData = b64urlEncode(cred.response.authenticatorData)
+        rsp.response.signature = b64urlEncode(cred.response.signature)
+        if (cred.response.userHandle) {
+          rsp.response.userHandle = b64urlEncode(cred.response.userHandle)
+        }
+      }
+
+      form.elements.credential.value = JSON.stringify(rsp)
+      form.dataset.webauthnDone = 'true'
+      form.submit()
+    })
+    .catch(function (err) {
+      webauthnError(form, err.message)
+    })
+}
+
+function webauthnError (form, msg) {
+  $('.webauthn-error', form).text(msg).removeClass('d-none')
+
+  // buttons are disabled on submit, let user try again
+  setTimeout(function () {
+    $('button', form).attr('disabled', false)
+  }, 100)
+}
+
+function b64urlDecode (s) {
+  s = s.replace(/-/g, '+').replace(/_/g, '/')
+  return Uint8Array.from(atob(s + '='.repeat((4 - s.length % 4) % 4)), c => c.charCodeAt(0))
+}
+
+function b64urlEncode (buf) {
+  return btoa(String.fromCharCode(...new Uint8Array(buf)))
+    .replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '')
+}
@@ -109,6 +109,29 @@
 	</div>
 	{{ end }}
 
+	{{ if .passkeyOptions }}
+	<form
+		method="POST"
+		action="{{ links.LoginPasskey }}"
+		class="px-3 pb-3"
+		data-webauthn="get"
+		data-webauthn-options="{{ .passkeyOptions }}"
+		data-webauthn-unsupported="{{ tr "login.template.passkey-unsupported" }}"
+	>
+		{{ .csrfField }}
+		<input type="hidden" name="credential">
+		<div class="webauthn-error text-danger mb-2 font-weight-bold d-none" role="alert"></div>
+		<button
+			data-test-id="button-login-passkey"
+			class="btn btn-light btn-block btn-lg text-dark"
+			type="submit"
+		>
+			<i class="bi bi-key mr-1"></i>
+			{{ tr "login.template.links.passkey" }}
+		</button>
+	</form>
+	{{ end }}
+
 	{{ if .settings.ExternalEnabled }}
 	<div class="px-3 pb-3">
 		{{ range .providers }}
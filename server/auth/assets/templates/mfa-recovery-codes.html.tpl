@@ -0,0 +1,27 @@
+{{ template "inc_header.html.tpl" set . "hideNav" true }}
+<div class="card-body p-0">
+	<h4 class="mb-0 p-3 border-bottom">{{ tr "mfa-recovery-codes.template.title" }}</h4>
+
+	<div class="p-3">
+		<p>{{ tr "mfa-recovery-codes.template.instructions" }}</p>
+
+		<div class="row text-center bg-light rounded py-2 mx-0 mb-3" data-test-id="recovery-codes">
+		{{ range .codes }}
+			<div class="col-6 py-1">
+				<code class="text-dark" style="font-size:18px;letter-spacing:2px;">{{ . }}</code>
+			</div>
+		{{ end }}
+		</div>
+
+		<p class="font-weight-bold">{{ tr "mfa-recovery-codes.template.warning" }}</p>
+
+		<a
+			data-test-id="link-continue"
+			href="{{ links.Security }}"
+			class="btn btn-primary btn-block btn-lg"
+		>
+			{{ tr "mfa-recovery-codes.template.continue" }}
+		</a>
+	</div>
+</div>
+{{ template "inc_footer.html.tpl" . }}
@@ -0,0 +1,105 @@
+{{ template "inc_header.html.tpl" set . "activeNav" "security" }}
+<div class="card-body p-0">
+	<h4 class="mb-0 p-3 border-bottom">{{ tr "mfa-webauthn.template.title" }}</h4>
+
+	<div class="p-3">
+		<p>{{ tr "mfa-webauthn.template.instructions" }}</p>
+		{{ if .settings.MultiFactor.WebAuthn.Passwordless }}
+		<p>{{ tr "mfa-webauthn.template.passwordless" }}</p>
+		{{ end }}
+
+		{{ if .form.error }}
+		<div class="text-danger mb-3 font-weight-bold" role="alert">
+			{{ .form.error }}
+		</div>
+		{{ end }}
+
+		{{ if .credentials }}
+		<form
+			method="POST"
+			action="{{ links.MfaWebAuthn }}"
+		>
+			{{ .csrfField }}
+			<input type="hidden" name="action" value="remove">
+			<ul class="list-group">
+			{{ range .credentials }}
+				<li class="list-group-item d-flex justify-content-between align-items-center">
+					<div>
+						<i class="bi bi-key mr-1"></i>
+						{{ .Label }}
+						<div class="small text-muted">
+						{{ if .LastUsedAt }}
+							{{ tr "mfa-webauthn.template.last-used" "at" (.LastUsedAt | date "Mon, 02 Jan 2006 15:04 MST") }}
+						{{ else }}
+							{{ tr "mfa-webauthn.template.never-used" }}
+						{{ end }}
+						</div>
+					</div>
+					<button
+						data-test-id="button-remove-webauthn"
+						name="credentialID"
+						value="{{ .ID }}"
+						class="btn btn-danger btn-sm"
+					>
+						{{ tr "mfa-webauthn.template.remove" }}
+					</button>
+				</li>
+			{{ end }}
+			</ul>
+		</form>
+		{{ else }}
+		<div class="font-italic">
+			{{ tr "mfa-webauthn.template.empty" }}
+		</div>
+		{{ end }}
+	</div>
+
+	<form
+		method="POST"
+		action="{{ links.MfaWebAuthn }}"
+		class="p-3 border-top"
+		{{ if .webauthnOptions }}
+		data-webauthn="create"
+		data-webauthn-options="{{ .webauthnOptions }}"
+		data-webauthn-unsupported="{{ tr "mfa-webauthn.template.unsupported" }}"
+		{{ end }}
+	>
+		<h5>{{ tr "mfa-webauthn.template.form.title" }}</h5>
+		{{ .csrfField }}
+		<input type="hidden" name="action" value="register">
+		<input type="hidden" name="credential">
+
+		<div class="webauthn-error text-danger mb-3 font-weight-bold {{ if not .webauthnError }}d-none{{ end }}" role="alert">
+			{{ .webauthnError }}
+		</div>
+
+		<div class="mb-3">
+			<label>{{ tr "mfa-webauthn.template.form.label.label" }}</label>
+			<input
+				data-test-id="input-label"
+				type="text"
+				class="form-control"
+				name="label"
+				maxlength="64"
+				placeholder="{{ tr "mfa-webauthn.template.form.label.placeholder" }}"
+				aria-label="{{ tr "mfa-webauthn.template.form.label.label" }}">
+		</div>
+
+		<button
+			data-test-id="button-register-webauthn"
+			class="btn btn-primary btn-block btn-lg"
+			type="submit"
+			{{ if not .webauthnOptions }}disabled{{ end }}
+		>
+			{{ tr "mfa-webauthn.template.form.button" }}
+		</button>
+
+		<a
+			href="{{ links.Security }}"
+			class="btn btn-light btn-block btn-lg text-dark"
+		>
+			{{ tr "mfa-webauthn.template.back" }}
+		</a>
+	</form>
+</div>
+{{ template "inc_footer.html.tpl" . }}
@@ -97,10 +97,82 @@
 			{{ tr "mfa.template.email.verify" }}
 		</button>
 	</form>
+
+	<form
+		class="px-3 pb-3"
+		method="POST"
+		action="{{ links.Mfa }}"
+	>
+		{{ .csrfField }}
+		<details>
+			<summary>{{ tr "mfa.template.totp.recovery.instructions" }}</summary>
+
+			<div class="input-group my-3">
+				<input
+					type="text"
+					required
+					class="form-control text-center"
+					name="code"
+					maxlength="9"
+					minlength="8"
+					aria-required="true"
+					placeholder="XXXX-XXXX"
+					autocomplete="off"
+					style="letter-spacing:3px;font-size:20px;"
+					aria-label="{{ tr "mfa.template.totp.recovery.code" }}">
+			</div>
+
+			<button
+				data-test-id="button-verify-recovery-code"
+				class="btn btn-light btn-block btn-lg text-dark"
+				type="submit"
+				name="action"
+				value="verifyTotp"
+			>
+				{{ tr "mfa.template.totp.recovery.verify" }}
+			</button>
+		</details>
+	</form>
 	{{ else if not .totpDisabled }}
 		<p class="px-3 pt-3 pb-2 mb-0">
 			<i class="bi bi-check-circle text-success h5 mr-1"></i> {{ tr "mfa.template.totp.confirmed" }}
 		</p>
 	{{ end }}
+
+	{{ if .webauthnPending }}
+	<form
+		class="p-3"
+		method="POST"
+		action="{{ links.Mfa }}"
+		{{ if .webauthnOptions }}
+		data-webauthn="get"
+		data-webauthn-options="{{ .webauthnOptions }}"
+		data-webauthn-unsupported="{{ tr "mfa.template.webauthn.unsupported" }}"
+		{{ end }}
+	>
+		<h5>{{ tr "mfa.template.webauthn.instructions" }}</h5>
+
+		<div class="webauthn-error alert alert-danger {{ if not (or .form.webauthnError .webauthnError) }}d-none{{ end }}" role="alert">
+			{{ coalesce .form.webauthnError .webauthnError }}
+		</div>
+		{{ .csrfField }}
+		<input type="hidden" name="action" value="verifyWebAuthn">
+		<input type="hidden" name="credential">
+
+		<button
+			data-test-id="button-verify-webauthn"
+			class="btn btn-primary btn-block btn-lg"
+			type="submit"
+			{{ if not .webauthnOptions }}disabled{{ end }}
+		>
+			<i class="bi bi-key mr-1"></i>
+			{{ tr "mfa.template.webauthn.verify" }}
+		</button>
+	</form>
+	{{ else if not .webauthnDisabled }}
+		<p class="px-3 pt-3 pb-2 mb-0">
+			<i class="bi bi-check-circle text-success h5 mr-1"></i> {{ tr "mfa.template.webauthn.confirmed" }}
+		</p>
+	{{ end }}
 </div>
 {{ template "inc_footer.html.tpl" . }}
@@ -21,7 +21,7 @@
 	<div>
 		{{ .csrfField }}
 		<h5>{{ tr "security.template.mfa.title" }}</h5>
-		{{ if or .settings.MultiFactor.TOTP.Enabled .settings.MultiFactor.EmailOTP.Enabled }}
+		{{ if or .settings.MultiFactor.TOTP.Enabled .settings.MultiFactor.EmailOTP.Enabled .settings.MultiFactor.WebAuthn.Enabled }}
 			{{ if .settings.MultiFactor.TOTP.Enabled }}
 			<div class="py-4">
 				<h6>{{ tr "security.template.mfa.totp.title" }}</h6>
@@ -62,6 +62,50 @@
 						{{ end }}
 					</div>
 				</div>
+				{{ if .totpEnforced }}
+				<div class="row pt-3">
+					<div class="col-10 pt-2">
+						{{ tr "security.template.mfa.totp.recovery-codes.remaining" "count" .recoveryCodesCount }}
+					</div>
+					<div class="col-md-2 col-sm-12">
+						<button
+							data-test-id="button-regenerate-recovery-codes"
+							name="action"
+							value="regenerateRecoveryCodes"
+							class="btn btn-light float-right"
+						>
+							{{ tr "security.template.mfa.totp.recovery-codes.regenerate" }}
+						</button>
+					</div>
+				</div>
+				{{ end }}
+			</div>
+			{{ end }}
+
+			{{ if .settings.MultiFactor.WebAuthn.Enabled }}
+			<div class="py-4">
+				<h6>{{ tr "security.template.mfa.webauthn.title" }}</h6>
+				<div class="row">
+					<div class="col-10 pt-2">
+						{{ if .webauthnEnforced }}
+						<i class="bi bi-check-circle text-success h5 mr-1"></i>
+						{{ tr "security.template.mfa.webauthn.enforced" }}
+						{{ else }}
+						<i class="bi bi-exclamation-circle-fill text-danger h5 mr-1"></i>
+						{{ tr "security.template.mfa.webauthn.disabled" }}
+						{{ end }}
+					</div>
+					<div class="col-md-2 col-sm-12">
+						<button
+							data-test-id="button-manage-webauthn"
+							name="action"
+							value="manageWebAuthn"
+							class="btn btn-primary float-right"
+						>
+							{{ tr "security.template.mfa.webauthn.manage" }}
+						</button>
+					</div>
+				</div>
 			</div>
 			{{ end }}
 
@@ -77,9 +77,10 @@ func (h *AuthHandlers) handleSuccessfulExternalSAMLAuth(w http.ResponseWriter, r
 			false,
 		)
 
-		// auto-complete EmailOTP and TOTP when authenticating via external identity provider
+		// auto-complete EmailOTP, TOTP and security keys when authenticating via external identity provider
 		req.AuthUser.CompleteEmailOTP()
 		req.AuthUser.CompleteTOTP()
+		req.AuthUser.CompleteWebAuthn()
 
 		req.AuthUser.Save(req.Session)
 
@@ -133,9 +134,10 @@ func (h *AuthHandlers) handleSuccessfulExternalGenericAuth(w http.ResponseWriter
 			h.handle(func(req *request.AuthReq) error {
 				req.AuthUser = au
 
-				// auto-complete EmailOTP and TOTP when authenticating via external identity provider
+				// auto-complete EmailOTP, TOTP and security keys when authenticating via external identity provider
 				req.AuthUser.CompleteEmailOTP()
 				req.AuthUser.CompleteTOTP()
+				req.AuthUser.CompleteWebAuthn()
 
 				req.AuthUser.Save(req.Session)
 
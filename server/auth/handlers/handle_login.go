@@ -33,6 +33,7 @@ func (h *AuthHandlers) loginForm(req *request.AuthReq) error {
 
 	req.Data["form"] = kv
 	req.Data["enableRememberMe"] = h.Opt.SessionPermLifetime > 0
+	h.passkeyLoginOptions(req)
 	return nil
 }
 
@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/cortezaproject/corteza/server/auth/request"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/system/service"
 )
 
 // Handles MFA TOTP configuration form
@@ -37,6 +38,13 @@ func (h AuthHandlers) mfaForm(req *request.AuthReq) (err error) {
 	req.Data["emailOtpPending"] = req.AuthUser.PendingEmailOTP()
 	req.Data["totpDisabled"] = req.AuthUser.DisabledTOTP()
 	req.Data["totpPending"] = req.AuthUser.PendingTOTP()
+	req.Data["webauthnDisabled"] = req.AuthUser.DisabledWebAuthn()
+	req.Data["webauthnPending"] = req.AuthUser.PendingWebAuthn()
+
+	if req.AuthUser.PendingWebAuthn() {
+		h.mfaWebAuthnOptions(req)
+	}
+
 	return nil
 }
 
@@ -63,9 +71,26 @@ func (h AuthHandlers) mfaProc(req *request.AuthReq) (err error) {
 		req.AuthUser.CompleteEmailOTP()
 
 	case "verifyTotp":
+		var (
+			code = req.Request.PostFormValue("code")
+			t    = translator(req, "auth")
+		)
+
+		if service.IsMFARecoveryCode(code) {
+			// recovery code used in place of the TOTP code
+			if err = h.mfaRecoveryCodeValidate(req, code); err != nil {
+				req.SetKV(map[string]string{"totpError": err.Error()})
+				return nil
+			}
+
+			req.PushAlert(t("mfa.alerts.recovery-code.valid"))
+			req.AuthUser.CompleteTOTP()
+			break
+		}
+
 		err = h.AuthService.ValidateTOTP(
 			auth.SetIdentityToContext(req.Context(), req.AuthUser.User),
-			req.Request.PostFormValue("code"),
+			code,
 		)
 
 		if err != nil {
@@ -73,10 +98,13 @@ func (h AuthHandlers) mfaProc(req *request.AuthReq) (err error) {
 			return nil
 		}
 
-		t := translator(req, "auth")
-
 		req.PushAlert(t("mfa.topt.valid"))
 		req.AuthUser.CompleteTOTP()
+
+	case "verifyWebAuthn":
+		if !h.mfaWebAuthnValidate(req) {
+			return nil
+		}
 	}
 
 	// All required MFA's confirmed, proceed to profile
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"github.com/cortezaproject/corteza/server/auth/request"
+	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"go.uber.org/zap"
+)
+
+const (
+	// session key where freshly issued recovery codes
+	// are kept until they are shown to the user
+	recoveryCodesKey = "mfaRecoveryCodes"
+)
+
+// Displays freshly issued recovery codes
+//
+// Codes are removed from the session and can not be displayed again
+func (h AuthHandlers) mfaRecoveryCodesView(req *request.AuthReq) error {
+	codes, has := req.Session.Values[recoveryCodesKey].([]string)
+	delete(req.Session.Values, recoveryCodesKey)
+
+	if !has || len(codes) == 0 {
+		req.RedirectTo = GetLinks().Security
+		return nil
+	}
+
+	req.Data["codes"] = codes
+	req.Template = TmplMfaRecoveryCodes
+	return nil
+}
+
+// issues new set of recovery codes and keeps them in the session
+// and redirects user to the page where they are displayed
+func (h AuthHandlers) issueRecoveryCodes(req *request.AuthReq) error {
+	codes, err := h.AuthService.GenerateMFARecoveryCodes(
+		auth.SetIdentityToContext(req.Context(), req.AuthUser.User),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	h.Log.Info("MFA recovery codes issued", zap.Int("count", len(codes)))
+
+	req.Session.Values[recoveryCodesKey] = codes
+	req.RedirectTo = GetLinks().MfaRecoveryCodes
+	return nil
+}
+
+// validates recovery code used in place of the TOTP code
+func (h AuthHandlers) mfaRecoveryCodeValidate(req *request.AuthReq, code string) error {
+	return h.AuthService.ValidateMFARecoveryCode(
+		auth.SetIdentityToContext(req.Context(), req.AuthUser.User),
+		code,
+	)
+}
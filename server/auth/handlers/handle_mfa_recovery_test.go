@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/auth/settings"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mfaRecoveryCodes(t *testing.T) {
+	var (
+		rq    = require.New(t)
+		user  = makeMockUser()
+		codes = []string{"ABCD-EFGH", "IJKL-MNOP"}
+	)
+
+	service.CurrentSettings = &types.AppSettings{}
+
+	authHandlers := prepareClientAuthHandlers(&authServiceMocked{
+		generateMFARecoveryCodes: func(ctx context.Context) ([]string, error) {
+			return codes, nil
+		},
+	}, &settings.Settings{})
+
+	authReq := prepareClientAuthReq(authHandlers, &http.Request{}, user)
+
+	rq.NoError(authHandlers.issueRecoveryCodes(authReq))
+	rq.Equal(GetLinks().MfaRecoveryCodes, authReq.RedirectTo)
+
+	authReq.RedirectTo = ""
+	rq.NoError(authHandlers.mfaRecoveryCodesView(authReq))
+	rq.Equal(TmplMfaRecoveryCodes, authReq.Template)
+	rq.Equal(codes, authReq.Data["codes"])
+
+	// codes are displayed only once
+	authReq.Template = ""
+	rq.NoError(authHandlers.mfaRecoveryCodesView(authReq))
+	rq.Empty(authReq.Template)
+	rq.Equal(GetLinks().Security, authReq.RedirectTo)
+}
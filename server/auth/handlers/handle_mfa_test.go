@@ -59,6 +59,38 @@ func Test_mfaProc(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:    "TOTP: successful login with recovery code",
+			payload: map[string]string(nil),
+			alerts:  []request.Alert{{Type: "primary", Text: "mfa.alerts.recovery-code.valid"}},
+			link:    GetLinks().Mfa,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "verifyTotp")
+				req.PostForm.Add("code", "ABCD-EFGH")
+
+				authService = &authServiceMocked{
+					validateMFARecoveryCode: func(ctx context.Context, code string) (err error) {
+						return nil
+					},
+				}
+			},
+		},
+		{
+			name:    "TOTP: used recovery code",
+			payload: map[string]string{"totpError": "invalid recovery code"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().Mfa,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "verifyTotp")
+				req.PostForm.Add("code", "ABCD-EFGH")
+
+				authService = &authServiceMocked{
+					validateMFARecoveryCode: func(ctx context.Context, code string) (err error) {
+						return service.AuthErrInvalidRecoveryCode()
+					},
+				}
+			},
+		},
 		{
 			name:    "Email: disabled",
 			payload: map[string]string{"emailOtpError": "multi factor authentication with email OTP is disabled"},
@@ -85,6 +85,12 @@ func (h AuthHandlers) mfaTotpConfigProc(req *request.AuthReq) (err error) {
 		h.Log.Info("TOTP code verified")
 		req.RedirectTo = GetLinks().Security
 		delete(req.Session.Values, totpSecretKey)
+
+		// recovery codes can be used when TOTP device is lost
+		if err = h.issueRecoveryCodes(req); err != nil {
+			h.Log.Error("could not issue MFA recovery codes", zap.Error(err))
+		}
+
 		return nil
 	}
 
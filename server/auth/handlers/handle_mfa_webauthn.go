@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cortezaproject/corteza/server/auth/request"
+	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// session keys where ceremony challenges are kept between requests
+	webauthnRegChallengeKey  = "webauthnRegChallenge"
+	webauthnMfaChallengeKey  = "webauthnMfaChallenge"
+	passkeyLoginChallengeKey = "passkeyLoginChallenge"
+)
+
+// Handles security keys list & registration form
+func (h AuthHandlers) mfaWebAuthnForm(req *request.AuthReq) (err error) {
+	if !h.Settings.MultiFactor.WebAuthn.Enabled {
+		req.RedirectTo = GetLinks().Security
+		return nil
+	}
+
+	var (
+		cc  types.CredentialSet
+		opt *webauthn.CreationOptions
+	)
+
+	req.Template = TmplMfaWebAuthn
+	req.Data["form"] = req.PopKV()
+
+	if cc, err = h.AuthService.WebAuthnCredentials(req.Context(), req.AuthUser.User.ID); err != nil {
+		return
+	}
+
+	req.Data["credentials"] = cc
+
+	if opt, err = h.AuthService.WebAuthnRegistrationOptions(req.Context()); err != nil {
+		// security keys are not available (misconfiguration),
+		// show the error on the form instead of failing the page
+		req.Data["webauthnError"] = err.Error()
+		return nil
+	}
+
+	req.Data["webauthnOptions"], err = webAuthnCeremony(req, webauthnRegChallengeKey, opt.Challenge, opt)
+	return
+}
+
+// Handles security keys registration & removal
+func (h AuthHandlers) mfaWebAuthnProc(req *request.AuthReq) (err error) {
+	req.RedirectTo = GetLinks().MfaWebAuthn
+	req.SetKV(nil)
+
+	var (
+		user *types.User
+		t    = translator(req, "auth")
+	)
+
+	switch req.Request.Form.Get("action") {
+	case "register":
+		var (
+			rsp       = &webauthn.RegistrationResponse{}
+			challenge = popWebAuthnChallenge(req, webauthnRegChallengeKey)
+		)
+
+		if err = json.Unmarshal([]byte(req.Request.PostFormValue("credential")), rsp); err != nil {
+			req.SetKV(map[string]string{"error": t("mfa-webauthn.errors.invalid-response")})
+			return nil
+		}
+
+		user, err = h.AuthService.ConfigureWebAuthn(req.Context(), challenge, req.Request.PostFormValue("label"), rsp)
+		if err != nil {
+			req.SetKV(map[string]string{"error": err.Error()})
+			h.Log.Warn("could not register security key", zap.Error(err))
+			return nil
+		}
+
+		req.PushAlert(t("mfa-webauthn.alerts.registered"))
+
+		// registration proves possession of the security key
+		req.AuthUser.CompleteWebAuthn()
+		h.Log.Info("security key registered")
+
+	case "remove":
+		credentialID, _ := strconv.ParseUint(req.Request.PostFormValue("credentialID"), 10, 64)
+
+		user, err = h.AuthService.RemoveWebAuthn(req.Context(), req.AuthUser.User.ID, credentialID)
+		if err != nil {
+			req.SetKV(map[string]string{"error": err.Error()})
+			return nil
+		}
+
+		req.PushAlert(t("mfa-webauthn.alerts.removed"))
+		h.Log.Info("security key removed")
+
+	default:
+		return nil
+	}
+
+	// Make sure we update User's data in the session
+	req.AuthUser.User = user
+	req.AuthUser.Save(req.Session)
+	return nil
+}
+
+// validates assertion signed with one of user's security keys
+//
+// part of the MFA form processing
+func (h AuthHandlers) mfaWebAuthnValidate(req *request.AuthReq) bool {
+	var (
+		rsp       = &webauthn.AssertionResponse{}
+		challenge = popWebAuthnChallenge(req, webauthnMfaChallengeKey)
+		t         = translator(req, "auth")
+	)
+
+	if err := json.Unmarshal([]byte(req.Request.PostFormValue("credential")), rsp); err != nil {
+		req.SetKV(map[string]string{"webauthnError": t("mfa-webauthn.errors.invalid-response")})
+		return false
+	}
+
+	err := h.AuthService.ValidateWebAuthn(
+		auth.SetIdentityToContext(req.Context(), req.AuthUser.User),
+		challenge,
+		rsp,
+	)
+
+	if err != nil {
+		req.SetKV(map[string]string{"webauthnError": err.Error()})
+		return false
+	}
+
+	req.PushAlert(t("mfa.alerts.webauthn.valid"))
+	req.AuthUser.CompleteWebAuthn()
+	return true
+}
+
+// prepares assertion options for the MFA form
+func (h AuthHandlers) mfaWebAuthnOptions(req *request.AuthReq) {
+	opt, err := h.AuthService.WebAuthnAssertionOptions(
+		auth.SetIdentityToContext(req.Context(), req.AuthUser.User),
+	)
+
+	if err == nil {
+		req.Data["webauthnOptions"], err = webAuthnCeremony(req, webauthnMfaChallengeKey, opt.Challenge, opt)
+	}
+
+	if err != nil {
+		req.Data["webauthnError"] = err.Error()
+	}
+}
+
+// prepares passkey options for the login form
+func (h AuthHandlers) passkeyLoginOptions(req *request.AuthReq) {
+	if !h.Settings.MultiFactor.WebAuthn.Enabled || !h.Settings.MultiFactor.WebAuthn.Passwordless {
+		return
+	}
+
+	opt, err := h.AuthService.PasswordlessOptions(req.Context())
+	if err != nil {
+		// passkey login button is not shown
+		h.Log.Warn("passwordless login not available", zap.Error(err))
+		return
+	}
+
+	if req.Data["passkeyOptions"], err = webAuthnCeremony(req, passkeyLoginChallengeKey, opt.Challenge, opt); err != nil {
+		delete(req.Data, "passkeyOptions")
+	}
+}
+
+// Handles login with passkey
+func (h AuthHandlers) passkeyLoginProc(req *request.AuthReq) (err error) {
+	req.RedirectTo = GetLinks().Login
+	req.SetKV(nil)
+
+	var (
+		user      *types.User
+		rsp       = &webauthn.AssertionResponse{}
+		challenge = popWebAuthnChallenge(req, passkeyLoginChallengeKey)
+		t         = translator(req, "auth")
+	)
+
+	if err = json.Unmarshal([]byte(req.Request.PostFormValue("credential")), rsp); err != nil {
+		req.SetKV(map[string]string{"error": t("mfa-webauthn.errors.invalid-response")})
+		return nil
+	}
+
+	if user, err = h.AuthService.PasswordlessLogin(req.Context(), challenge, rsp); err != nil {
+		switch {
+		case service.AuthErrDisabledPasswordless().Is(err),
+			service.AuthErrInvalidWebAuthn().Is(err),
+			service.AuthErrFailedForUnknownUser().Is(err),
+			service.AuthErrFailedForSuspendedUser().Is(err),
+			service.AuthErrFailedForDeletedUser().Is(err),
+			service.AuthErrFailedForSystemUser().Is(err):
+			req.SetKV(map[string]string{"error": err.Error()})
+			h.Log.Warn("handled error", zap.Error(err))
+			return nil
+
+		default:
+			h.Log.Error("unhandled error", zap.Error(err))
+			return err
+		}
+	}
+
+	req.AuthUser = request.NewAuthUser(h.Settings, user, false)
+
+	// user was verified by the authenticator (PIN, biometrics)
+	req.AuthUser.CompletePasswordless()
+	req.AuthUser.Save(req.Session)
+
+	h.Log.Info("login with passkey successful")
+
+	req.PushAlert(t("login.alerts.logged-in"))
+	handleSuccessfulAuth(req)
+	return nil
+}
+
+// keeps ceremony challenge in the session and
+// returns JSON encoded options for the template
+func webAuthnCeremony(req *request.AuthReq, key string, challenge []byte, opt interface{}) (string, error) {
+	enc, err := json.Marshal(opt)
+	if err != nil {
+		return "", err
+	}
+
+	req.Session.Values[key] = base64.RawURLEncoding.EncodeToString(challenge)
+	return string(enc), nil
+}
+
+// removes challenge from the session and returns it
+//
+// challenges can be used only once
+func popWebAuthnChallenge(req *request.AuthReq, key string) (challenge []byte) {
+	s, _ := req.Session.Values[key].(string)
+	delete(req.Session.Values, key)
+
+	challenge, _ = base64.RawURLEncoding.DecodeString(s)
+	return
+}
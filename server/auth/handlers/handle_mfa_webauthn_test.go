@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/auth/request"
+	"github.com/cortezaproject/corteza/server/auth/settings"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mfaWebAuthnProc(t *testing.T) {
+	var (
+		user = makeMockUser()
+
+		req = &http.Request{}
+
+		authService  authService
+		authHandlers *AuthHandlers
+		authReq      *request.AuthReq
+	)
+
+	service.CurrentSettings = &types.AppSettings{}
+
+	tcc := []testingExpect{
+		{
+			name:    "register: successful",
+			payload: map[string]string(nil),
+			alerts:  []request.Alert{{Type: "primary", Text: "mfa-webauthn.alerts.registered"}},
+			link:    GetLinks().MfaWebAuthn,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "register")
+				req.PostForm.Add("label", "YubiKey")
+				req.PostForm.Add("credential", `{"id":"AQID","rawId":"AQID","type":"public-key"}`)
+
+				authService = &authServiceMocked{
+					configureWebAuthn: func(ctx context.Context, challenge []byte, label string, rsp *webauthn.RegistrationResponse) (*types.User, error) {
+						if label != "YubiKey" || rsp.ID != "AQID" {
+							return nil, service.AuthErrInvalidWebAuthn()
+						}
+
+						return user, nil
+					},
+				}
+			},
+		},
+		{
+			name:    "register: invalid response",
+			payload: map[string]string{"error": "mfa-webauthn.errors.invalid-response"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().MfaWebAuthn,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "register")
+				req.PostForm.Add("credential", "{invalid")
+
+				authService = &authServiceMocked{}
+			},
+		},
+		{
+			name:    "register: verification failed",
+			payload: map[string]string{"error": "security key verification failed"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().MfaWebAuthn,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "register")
+				req.PostForm.Add("credential", `{}`)
+
+				authService = &authServiceMocked{
+					configureWebAuthn: func(ctx context.Context, challenge []byte, label string, rsp *webauthn.RegistrationResponse) (*types.User, error) {
+						return nil, service.AuthErrInvalidWebAuthn()
+					},
+				}
+			},
+		},
+		{
+			name:    "remove: successful",
+			payload: map[string]string(nil),
+			alerts:  []request.Alert{{Type: "primary", Text: "mfa-webauthn.alerts.removed"}},
+			link:    GetLinks().MfaWebAuthn,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "remove")
+				req.PostForm.Add("credentialID", "42")
+
+				authService = &authServiceMocked{
+					removeWebAuthn: func(ctx context.Context, userID, credentialID uint64) (*types.User, error) {
+						if credentialID != 42 {
+							return nil, service.AuthErrUnconfiguredWebAuthn()
+						}
+
+						return user, nil
+					},
+				}
+			},
+		},
+		{
+			name:    "remove: unknown key",
+			payload: map[string]string{"error": "security key not configured"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().MfaWebAuthn,
+			fn: func(_ *settings.Settings) {
+				req.Form.Set("action", "remove")
+				req.PostForm.Add("credentialID", "0")
+
+				authService = &authServiceMocked{
+					removeWebAuthn: func(ctx context.Context, userID, credentialID uint64) (*types.User, error) {
+						return nil, service.AuthErrUnconfiguredWebAuthn()
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			rq := require.New(t)
+
+			// reset from previous
+			req.Form = url.Values{}
+			req.PostForm = url.Values{}
+			user.Meta = &types.UserMeta{}
+
+			authSettings := &settings.Settings{}
+
+			tc.fn(authSettings)
+
+			authHandlers = prepareClientAuthHandlers(authService, authSettings)
+			authReq = prepareClientAuthReq(authHandlers, req, user)
+
+			err := authHandlers.mfaWebAuthnProc(authReq)
+
+			rq.NoError(err)
+			rq.Equal(tc.payload, authReq.GetKV())
+			rq.Equal(tc.alerts, authReq.NewAlerts)
+			rq.Equal(tc.link, authReq.RedirectTo)
+		})
+	}
+}
+
+func Test_mfaProcWebAuthn(t *testing.T) {
+	var (
+		rq   = require.New(t)
+		user = makeMockUser()
+		req  = &http.Request{Form: url.Values{}, PostForm: url.Values{}}
+
+		challenge []byte
+	)
+
+	service.CurrentSettings = &types.AppSettings{}
+
+	user.Meta.SecurityPolicy.MFA.EnforcedEmailOTP = false
+	user.Meta.SecurityPolicy.MFA.EnforcedWebAuthn = true
+
+	req.Form.Set("action", "verifyWebAuthn")
+	req.PostForm.Set("credential", `{"id":"AQID"}`)
+
+	authHandlers := prepareClientAuthHandlers(&authServiceMocked{
+		validateWebAuthn: func(ctx context.Context, c []byte, rsp *webauthn.AssertionResponse) error {
+			challenge = c
+			return nil
+		},
+	}, &settings.Settings{})
+
+	authReq := prepareClientAuthReq(authHandlers, req, user)
+	authReq.AuthUser.Update(&settings.Settings{MultiFactor: settings.MultiFactor{WebAuthn: settings.WebAuthn{Enabled: true}}}, user)
+	authReq.Session.Values[webauthnMfaChallengeKey] = "AQID"
+
+	rq.True(authReq.AuthUser.PendingWebAuthn())
+	rq.NoError(authHandlers.mfaProc(authReq))
+	rq.Equal([]byte{1, 2, 3}, challenge)
+	rq.False(authReq.AuthUser.PendingMFA())
+	rq.Equal(GetLinks().Profile, authReq.RedirectTo)
+
+	// challenge can be used only once
+	rq.NotContains(authReq.Session.Values, webauthnMfaChallengeKey)
+}
+
+func Test_passkeyLoginProc(t *testing.T) {
+	var (
+		user = makeMockUser()
+
+		req = &http.Request{}
+
+		authService  authService
+		authHandlers *AuthHandlers
+		authReq      *request.AuthReq
+	)
+
+	service.CurrentSettings = &types.AppSettings{}
+
+	tcc := []testingExpect{
+		{
+			name:    "successful login",
+			payload: map[string]string(nil),
+			alerts:  []request.Alert{{Type: "primary", Text: "login.alerts.logged-in"}},
+			link:    GetLinks().Profile,
+			fn: func(s *settings.Settings) {
+				// passkeys replace all other factors
+				s.MultiFactor.EmailOTP.Enabled = true
+				s.MultiFactor.EmailOTP.Enforced = true
+
+				req.PostForm.Add("credential", `{"id":"AQID"}`)
+
+				authService = &authServiceMocked{
+					passwordlessLogin: func(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (*types.User, error) {
+						return user, nil
+					},
+				}
+			},
+		},
+		{
+			name:    "invalid response",
+			payload: map[string]string{"error": "mfa-webauthn.errors.invalid-response"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().Login,
+			fn: func(_ *settings.Settings) {
+				authService = &authServiceMocked{}
+			},
+		},
+		{
+			name:    "verification failed",
+			payload: map[string]string{"error": "security key verification failed"},
+			alerts:  []request.Alert(nil),
+			link:    GetLinks().Login,
+			fn: func(_ *settings.Settings) {
+				req.PostForm.Add("credential", `{"id":"AQID"}`)
+
+				authService = &authServiceMocked{
+					passwordlessLogin: func(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (*types.User, error) {
+						return nil, service.AuthErrInvalidWebAuthn()
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			rq := require.New(t)
+
+			// reset from previous
+			req.Form = url.Values{}
+			req.PostForm = url.Values{}
+			user.Meta = &types.UserMeta{}
+
+			authSettings := &settings.Settings{}
+
+			tc.fn(authSettings)
+
+			authHandlers = prepareClientAuthHandlers(authService, authSettings)
+			authReq = prepareClientAuthReq(authHandlers, req, nil)
+
+			err := authHandlers.passkeyLoginProc(authReq)
+
+			rq.NoError(err)
+			rq.Equal(tc.payload, authReq.GetKV())
+			rq.Equal(tc.alerts, authReq.NewAlerts)
+			rq.Equal(tc.link, authReq.RedirectTo)
+		})
+	}
+}
@@ -14,6 +14,16 @@ func (h *AuthHandlers) securityForm(req *request.AuthReq) error {
 	req.Data["emailOtpEnforced"] = umsp.EnforcedEmailOTP
 	req.Data["totpEnforced"] = umsp.EnforcedTOTP
 
+	if umsp.EnforcedTOTP {
+		count, err := h.AuthService.CountMFARecoveryCodes(req.Context(), req.AuthUser.User.ID)
+		if err != nil {
+			return err
+		}
+
+		req.Data["recoveryCodesCount"] = count
+	}
+	req.Data["webauthnEnforced"] = umsp.EnforcedWebAuthn
+
 	return nil
 }
 
@@ -30,6 +40,14 @@ func (h *AuthHandlers) securityProc(req *request.AuthReq) error {
 	case "disableTOTP":
 		req.RedirectTo = GetLinks().MfaTotpDisable
 
+	case "regenerateRecoveryCodes":
+		if err := h.issueRecoveryCodes(req); err != nil {
+			return err
+		}
+
+	case "manageWebAuthn":
+		req.RedirectTo = GetLinks().MfaWebAuthn
+
 	case "disableEmailOTP", "enableEmailOTP":
 		enable := action == "enableEmailOTP"
 		if user, err := h.AuthService.ConfigureEmailOTP(req.Context(), req.AuthUser.User.ID, enable); err != nil {
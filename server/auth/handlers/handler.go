@@ -24,6 +24,7 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/errors"
 	"github.com/cortezaproject/corteza/server/pkg/locale"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
 	"github.com/cortezaproject/corteza/server/system/types"
 	"github.com/go-oauth2/oauth2/v4"
 	"github.com/go-oauth2/oauth2/v4/server"
@@ -51,6 +52,9 @@ type (
 		ValidateTOTP(ctx context.Context, code string) (err error)
 		ConfigureTOTP(ctx context.Context, secret string, code string) (u *types.User, err error)
 		RemoveTOTP(ctx context.Context, userID uint64, code string) (u *types.User, err error)
+		GenerateMFARecoveryCodes(ctx context.Context) (codes []string, err error)
+		CountMFARecoveryCodes(ctx context.Context, userID uint64) (int, error)
+		ValidateMFARecoveryCode(ctx context.Context, code string) (err error)
 		LoadRoleMemberships(ctx context.Context, u *types.User) error
 
 		SendEmailOTP(ctx context.Context) (err error)
@@ -58,6 +62,15 @@ type (
 		ValidateEmailOTP(ctx context.Context, code string) (err error)
 		SendInviteEmail(ctx context.Context, email string) (err error)
 		ValidateInviteEmailToken(ctx context.Context, token string) (user *types.User, err error)
+
+		WebAuthnCredentials(ctx context.Context, userID uint64) (cc types.CredentialSet, err error)
+		WebAuthnRegistrationOptions(ctx context.Context) (opt *webauthn.CreationOptions, err error)
+		ConfigureWebAuthn(ctx context.Context, challenge []byte, label string, rsp *webauthn.RegistrationResponse) (u *types.User, err error)
+		WebAuthnAssertionOptions(ctx context.Context) (opt *webauthn.RequestOptions, err error)
+		ValidateWebAuthn(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (err error)
+		RemoveWebAuthn(ctx context.Context, userID, credentialID uint64) (u *types.User, err error)
+		PasswordlessOptions(ctx context.Context) (opt *webauthn.RequestOptions, err error)
+		PasswordlessLogin(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (u *types.User, err error)
 	}
 
 	credentialsService interface {
@@ -158,11 +171,16 @@ const (
 	TmplMfa                      = "mfa.html.tpl"
 	TmplMfaTotp                  = "mfa-totp.html.tpl"
 	TmplMfaTotpDisable           = "mfa-totp-disable.html.tpl"
+	TmplMfaWebAuthn              = "mfa-webauthn.html.tpl"
+	TmplMfaRecoveryCodes         = "mfa-recovery-codes.html.tpl"
 	TmplInternalError            = "error-internal.html.tpl"
 
 	// 1k of data per POST field is all we allow
 	maxPostValueLength = 2 << 9
 
+	// 16k for JSON encoded security key (WebAuthn) responses
+	maxPostCredentialLength = 2 << 13
+
 	// general limitation on number of fields
 	maxPostFields = 10
 )
@@ -528,6 +546,14 @@ func validFormPost(r *http.Request) bool {
 			return false
 		}
 
+		if k == "credential" {
+			if len(r.Form[k][0]) > maxPostCredentialLength {
+				return false
+			}
+
+			continue
+		}
+
 		if len(r.Form[k][0]) > maxPostValueLength {
 			return false
 		}
@@ -38,6 +38,11 @@ type (
 		MfaTotpNewSecret,
 		MfaTotpQRImage,
 		MfaTotpDisable,
+		MfaRecoveryCodes,
+
+		MfaWebAuthn,
+
+		LoginPasskey,
 
 		External,
 
@@ -93,6 +98,9 @@ func GetLinks() Links {
 		MfaTotpNewSecret: b + "auth/mfa/totp/setup",
 		MfaTotpQRImage:   b + "auth/mfa/totp/qr.png",
 		MfaTotpDisable:   b + "auth/mfa/totp/disable",
+		MfaRecoveryCodes: b + "auth/mfa/recovery-codes",
+		MfaWebAuthn:      b + "auth/mfa/webauthn",
+		LoginPasskey:     b + "auth/login/passkey",
 
 		External: b + "auth/external",
 
@@ -12,6 +12,7 @@ import (
 	"github.com/cortezaproject/corteza/server/auth/settings"
 	"github.com/cortezaproject/corteza/server/pkg/locale"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
 	"github.com/cortezaproject/corteza/server/store"
 	"github.com/cortezaproject/corteza/server/system/service"
 	"github.com/cortezaproject/corteza/server/system/types"
@@ -98,11 +99,22 @@ type (
 		validateTOTP                      func(context.Context, string) (err error)
 		configureTOTP                     func(context.Context, string, string) (u *types.User, err error)
 		removeTOTP                        func(context.Context, uint64, string) (u *types.User, err error)
+		generateMFARecoveryCodes          func(context.Context) ([]string, error)
+		countMFARecoveryCodes             func(context.Context, uint64) (int, error)
+		validateMFARecoveryCode           func(context.Context, string) error
 		sendEmailOTP                      func(context.Context) (err error)
 		configureEmailOTP                 func(context.Context, uint64, bool) (u *types.User, err error)
 		validateEmailOTP                  func(context.Context, string) (err error)
 		sendInviteEmail                   func(context.Context, string) (err error)
 		validateEmailToken                func(context.Context, string) (user *types.User, err error)
+		webAuthnCredentials               func(context.Context, uint64) (types.CredentialSet, error)
+		webAuthnRegistrationOptions       func(context.Context) (*webauthn.CreationOptions, error)
+		configureWebAuthn                 func(context.Context, []byte, string, *webauthn.RegistrationResponse) (*types.User, error)
+		webAuthnAssertionOptions          func(context.Context) (*webauthn.RequestOptions, error)
+		validateWebAuthn                  func(context.Context, []byte, *webauthn.AssertionResponse) error
+		removeWebAuthn                    func(context.Context, uint64, uint64) (*types.User, error)
+		passwordlessOptions               func(context.Context) (*webauthn.RequestOptions, error)
+		passwordlessLogin                 func(context.Context, []byte, *webauthn.AssertionResponse) (*types.User, error)
 	}
 )
 
@@ -188,6 +200,18 @@ func (s authServiceMocked) RemoveTOTP(ctx context.Context, userID uint64, code s
 	return s.removeTOTP(ctx, userID, code)
 }
 
+func (s authServiceMocked) GenerateMFARecoveryCodes(ctx context.Context) ([]string, error) {
+	return s.generateMFARecoveryCodes(ctx)
+}
+
+func (s authServiceMocked) CountMFARecoveryCodes(ctx context.Context, userID uint64) (int, error) {
+	return s.countMFARecoveryCodes(ctx, userID)
+}
+
+func (s authServiceMocked) ValidateMFARecoveryCode(ctx context.Context, code string) error {
+	return s.validateMFARecoveryCode(ctx, code)
+}
+
 func (s authServiceMocked) SendEmailOTP(ctx context.Context) (err error) {
 	return s.sendEmailOTP(ctx)
 }
@@ -213,6 +237,38 @@ func (s authServiceMocked) LoadRoleMemberships(ctx context.Context, u *types.Use
 	return nil
 }
 
+func (s authServiceMocked) WebAuthnCredentials(ctx context.Context, userID uint64) (types.CredentialSet, error) {
+	return s.webAuthnCredentials(ctx, userID)
+}
+
+func (s authServiceMocked) WebAuthnRegistrationOptions(ctx context.Context) (*webauthn.CreationOptions, error) {
+	return s.webAuthnRegistrationOptions(ctx)
+}
+
+func (s authServiceMocked) ConfigureWebAuthn(ctx context.Context, challenge []byte, label string, rsp *webauthn.RegistrationResponse) (*types.User, error) {
+	return s.configureWebAuthn(ctx, challenge, label, rsp)
+}
+
+func (s authServiceMocked) WebAuthnAssertionOptions(ctx context.Context) (*webauthn.RequestOptions, error) {
+	return s.webAuthnAssertionOptions(ctx)
+}
+
+func (s authServiceMocked) ValidateWebAuthn(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) error {
+	return s.validateWebAuthn(ctx, challenge, rsp)
+}
+
+func (s authServiceMocked) RemoveWebAuthn(ctx context.Context, userID, credentialID uint64) (*types.User, error) {
+	return s.removeWebAuthn(ctx, userID, credentialID)
+}
+
+func (s authServiceMocked) PasswordlessOptions(ctx context.Context) (*webauthn.RequestOptions, error) {
+	return s.passwordlessOptions(ctx)
+}
+
+func (s authServiceMocked) PasswordlessLogin(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (*types.User, error) {
+	return s.passwordlessLogin(ctx, challenge, rsp)
+}
+
 // Mocking oauth2Service
 func (s *oauth2ServiceMocked) GetRedirectURI(req *server.AuthorizeRequest, data map[string]interface{}) (string, error) {
 	return s.GetRedirectURI(req, data)
@@ -68,6 +68,7 @@ func (h *AuthHandlers) MountHttpRoutes(r chi.Router) {
 
 			r.Get(tbp(l.Login), h.handle(anonyOnly(h.loginForm)))
 			r.Post(tbp(l.Login), h.handle(h.onlyIfLocalEnabled(anonyOnly(h.loginProc))))
+			r.Post(tbp(l.LoginPasskey), h.handle(anonyOnly(h.passkeyLoginProc)))
 
 			r.Get(tbp(l.Mfa), h.handle(h.mfaForm))
 			r.Post(tbp(l.Mfa), h.handle(h.mfaProc))
@@ -92,6 +93,9 @@ func (h *AuthHandlers) MountHttpRoutes(r chi.Router) {
 			r.Get(tbp(l.MfaTotpQRImage), h.handle(partAuthOnly(h.mfaTotpConfigQR)))
 			r.Get(tbp(l.MfaTotpDisable), h.handle(authOnly(h.mfaTotpDisableForm)))
 			r.Post(tbp(l.MfaTotpDisable), h.handle(authOnly(h.mfaTotpDisableProc)))
+			r.Get(tbp(l.MfaRecoveryCodes), h.handle(partAuthOnly(h.mfaRecoveryCodesView)))
+			r.Get(tbp(l.MfaWebAuthn), h.handle(authOnly(h.mfaWebAuthnForm)))
+			r.Post(tbp(l.MfaWebAuthn), h.handle(authOnly(h.mfaWebAuthnProc)))
 
 		})
 
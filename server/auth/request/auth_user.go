@@ -45,6 +45,7 @@ const (
 	authByPassword = "password"
 	authByEmailOTP = "email-otp"
 	authByTOTP     = "totp"
+	authByWebAuthn = "webauthn"
 )
 
 func init() {
@@ -85,6 +86,7 @@ func (au *authUser) set(s *settings.Settings, u *types.User) {
 		authByPassword: authStatusOK,
 		authByEmailOTP: authStatusDisabled,
 		authByTOTP:     authStatusDisabled,
+		authByWebAuthn: authStatusDisabled,
 	}
 
 	// determinate mfa status for email OTP
@@ -104,6 +106,11 @@ func (au *authUser) set(s *settings.Settings, u *types.User) {
 		mfaStatus[authByTOTP] = authStatusPending
 	}
 
+	// determinate mfa status for security keys
+	if gmsp.WebAuthn.Enabled && umsp.EnforcedWebAuthn {
+		mfaStatus[authByWebAuthn] = authStatusPending
+	}
+
 	au.MFAStatus = mfaStatus
 }
 
@@ -127,6 +134,14 @@ func (au authUser) PendingTOTP() bool {
 	return au.MFAStatus[authByTOTP] == authStatusPending
 }
 
+func (au authUser) DisabledWebAuthn() bool {
+	return au.MFAStatus[authByWebAuthn] == authStatusDisabled
+}
+
+func (au authUser) PendingWebAuthn() bool {
+	return au.MFAStatus[authByWebAuthn] == authStatusPending
+}
+
 // PendingMFA Returns true if any of MFAs are pending
 func (au authUser) PendingMFA() bool {
 	for _, st := range au.MFAStatus {
@@ -146,6 +161,22 @@ func (au *authUser) CompleteTOTP() {
 	au.MFAStatus[authByTOTP] = authStatusOK
 }
 
+func (au *authUser) CompleteWebAuthn() {
+	au.MFAStatus[authByWebAuthn] = authStatusOK
+}
+
+// CompletePasswordless completes all pending MFAs
+//
+// Passkeys are verified by the authenticator (PIN, biometrics)
+// and are by themselves a multi-factor authentication
+func (au *authUser) CompletePasswordless() {
+	for t, st := range au.MFAStatus {
+		if st == authStatusPending {
+			au.MFAStatus[t] = authStatusOK
+		}
+	}
+}
+
 func (au *authUser) ResetTOTP() {
 	au.MFAStatus[authByTOTP] = authStatusUnconfigured
 }
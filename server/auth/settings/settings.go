@@ -52,6 +52,7 @@ type (
 	MultiFactor struct {
 		EmailOTP EmailOTP
 		TOTP     TOTP
+		WebAuthn WebAuthn
 	}
 
 	EmailOTP struct {
@@ -73,6 +74,14 @@ type (
 		Issuer string
 	}
 
+	WebAuthn struct {
+		// Can users use security keys for MFA?
+		Enabled bool
+
+		// Can users log-in with passkeys (without password)?
+		Passwordless bool
+	}
+
 	Provider struct {
 		Handle      string
 		Label       string
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
 	"github.com/cortezaproject/corteza/server/pkg/version"
 )
 
@@ -48,7 +49,8 @@ func (h httpRequestHandler) send(ctx context.Context, args *httpRequestSendArgs)
 		defer tfn()
 	}
 
-	rsp, err = http.DefaultClient.Do(req)
+	// client span is started and trace context is sent with the request headers
+	rsp, err = tracing.Client(http.DefaultClient).Do(req)
 	if err != nil {
 		return
 	}
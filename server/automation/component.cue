@@ -9,6 +9,7 @@ component: schema.#component & {
 
 	resources: {
 		"workflow": workflow
+		"workflow-version": workflow_version
 		"session":  session
 		"trigger":  trigger
 	}
@@ -33,6 +33,15 @@ var Session = &dal.Model{
 			Store: &dal.CodecAlias{Ident: "rel_workflow"},
 		},
 
+		&dal.Attribute{
+			Ident: "WorkflowVersion",
+			Type: &dal.TypeNumber{HasDefault: true,
+				DefaultValue: 0,
+				Precision:    -1, Scale: -1, Meta: map[string]interface{}{"rdbms:type": "integer"},
+			},
+			Store: &dal.CodecAlias{Ident: "workflow_version"},
+		},
+
 		&dal.Attribute{
 			Ident: "Status", Sortable: true,
 			Type: &dal.TypeNumber{HasDefault: true,
@@ -78,6 +87,12 @@ var Session = &dal.Model{
 			Store: &dal.CodecAlias{Ident: "stacktrace"},
 		},
 
+		&dal.Attribute{
+			Ident: "State",
+			Type:  &dal.TypeJSON{Nullable: true},
+			Store: &dal.CodecAlias{Ident: "state"},
+		},
+
 		&dal.Attribute{
 			Ident: "CreatedBy",
 			Type: &dal.TypeRef{HasDefault: true,
@@ -445,6 +460,15 @@ var Workflow = &dal.Model{
 			Store: &dal.CodecAlias{Ident: "issues"},
 		},
 
+		&dal.Attribute{
+			Ident: "PublishedVersion",
+			Type: &dal.TypeNumber{HasDefault: true,
+				DefaultValue: 0,
+				Precision:    -1, Scale: -1, Meta: map[string]interface{}{"rdbms:type": "integer"},
+			},
+			Store: &dal.CodecAlias{Ident: "published_version"},
+		},
+
 		&dal.Attribute{
 			Ident: "RunAs",
 			Type: &dal.TypeRef{HasDefault: true,
@@ -545,11 +569,122 @@ var Workflow = &dal.Model{
 	},
 }
 
+var WorkflowVersion = &dal.Model{
+	Ident:        "automation_workflow_versions",
+	ResourceType: types.WorkflowVersionResourceType,
+
+	Attributes: dal.AttributeSet{
+		&dal.Attribute{
+			Ident: "ID",
+			Type:  &dal.TypeID{},
+			Store: &dal.CodecAlias{Ident: "id"},
+		},
+
+		&dal.Attribute{
+			Ident: "WorkflowID",
+			Type: &dal.TypeRef{
+				RefAttribute: "id",
+				RefModel: &dal.ModelRef{
+					ResourceType: "corteza::automation:workflow",
+				},
+			},
+			Store: &dal.CodecAlias{Ident: "rel_workflow"},
+		},
+
+		&dal.Attribute{
+			Ident: "Version", Sortable: true,
+			Type:  &dal.TypeNumber{Precision: -1, Scale: -1, Meta: map[string]interface{}{"rdbms:type": "integer"}},
+			Store: &dal.CodecAlias{Ident: "version"},
+		},
+
+		&dal.Attribute{
+			Ident: "Description",
+			Type:  &dal.TypeText{},
+			Store: &dal.CodecAlias{Ident: "description"},
+		},
+
+		&dal.Attribute{
+			Ident: "Scope",
+			Type: &dal.TypeJSON{
+				DefaultValue: "{}",
+			},
+			Store: &dal.CodecAlias{Ident: "scope"},
+		},
+
+		&dal.Attribute{
+			Ident: "Steps",
+			Type: &dal.TypeJSON{
+				DefaultValue: "{}",
+			},
+			Store: &dal.CodecAlias{Ident: "steps"},
+		},
+
+		&dal.Attribute{
+			Ident: "Paths",
+			Type: &dal.TypeJSON{
+				DefaultValue: "{}",
+			},
+			Store: &dal.CodecAlias{Ident: "paths"},
+		},
+
+		&dal.Attribute{
+			Ident: "CreatedAt", Sortable: true,
+			Type: &dal.TypeTimestamp{
+				DefaultCurrentTimestamp: true, Timezone: true, Precision: -1,
+			},
+			Store: &dal.CodecAlias{Ident: "created_at"},
+		},
+
+		&dal.Attribute{
+			Ident: "CreatedBy",
+			Type: &dal.TypeRef{HasDefault: true,
+				DefaultValue: 0,
+
+				RefAttribute: "id",
+				RefModel: &dal.ModelRef{
+					ResourceType: "corteza::system:user",
+				},
+			},
+			Store: &dal.CodecAlias{Ident: "created_by"},
+		},
+	},
+
+	Indexes: dal.IndexSet{
+		&dal.Index{
+			Ident: "PRIMARY",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "ID",
+				},
+			},
+		},
+
+		&dal.Index{
+			Ident:  "automation_workflow_versions_uniqueWorkflowVersion",
+			Type:   "BTREE",
+			Unique: true,
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "WorkflowID",
+				},
+
+				{
+					AttributeIdent: "Version",
+				},
+			},
+		},
+	},
+}
+
 func init() {
 	models = append(
 		models,
 		Session,
 		Trigger,
 		Workflow,
+		WorkflowVersion,
 	)
 }
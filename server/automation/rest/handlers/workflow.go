@@ -27,18 +27,28 @@ type (
 		Undelete(context.Context, *request.WorkflowUndelete) (interface{}, error)
 		Test(context.Context, *request.WorkflowTest) (interface{}, error)
 		Exec(context.Context, *request.WorkflowExec) (interface{}, error)
+		Publish(context.Context, *request.WorkflowPublish) (interface{}, error)
+		ListVersions(context.Context, *request.WorkflowListVersions) (interface{}, error)
+		ReadVersion(context.Context, *request.WorkflowReadVersion) (interface{}, error)
+		Rollback(context.Context, *request.WorkflowRollback) (interface{}, error)
+		Diff(context.Context, *request.WorkflowDiff) (interface{}, error)
 	}
 
 	// HTTP API interface
 	Workflow struct {
-		List     func(http.ResponseWriter, *http.Request)
-		Create   func(http.ResponseWriter, *http.Request)
-		Update   func(http.ResponseWriter, *http.Request)
-		Read     func(http.ResponseWriter, *http.Request)
-		Delete   func(http.ResponseWriter, *http.Request)
-		Undelete func(http.ResponseWriter, *http.Request)
-		Test     func(http.ResponseWriter, *http.Request)
-		Exec     func(http.ResponseWriter, *http.Request)
+		List         func(http.ResponseWriter, *http.Request)
+		Create       func(http.ResponseWriter, *http.Request)
+		Update       func(http.ResponseWriter, *http.Request)
+		Read         func(http.ResponseWriter, *http.Request)
+		Delete       func(http.ResponseWriter, *http.Request)
+		Undelete     func(http.ResponseWriter, *http.Request)
+		Test         func(http.ResponseWriter, *http.Request)
+		Exec         func(http.ResponseWriter, *http.Request)
+		Publish      func(http.ResponseWriter, *http.Request)
+		ListVersions func(http.ResponseWriter, *http.Request)
+		ReadVersion  func(http.ResponseWriter, *http.Request)
+		Rollback     func(http.ResponseWriter, *http.Request)
+		Diff         func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -170,6 +180,86 @@ func NewWorkflow(h WorkflowAPI) *Workflow {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		Publish: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowPublish()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Publish(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		ListVersions: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowListVersions()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.ListVersions(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		ReadVersion: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowReadVersion()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.ReadVersion(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Rollback: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowRollback()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Rollback(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Diff: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowDiff()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Diff(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -186,5 +276,10 @@ func (h Workflow) MountRoutes(r chi.Router, middlewares ...func(http.Handler) ht
 		r.Post("/workflows/{workflowID}/undelete", h.Undelete)
 		r.Post("/workflows/{workflowID}/test", h.Test)
 		r.Post("/workflows/{workflowID}/exec", h.Exec)
+		r.Post("/workflows/{workflowID}/publish", h.Publish)
+		r.Get("/workflows/{workflowID}/versions", h.ListVersions)
+		r.Get("/workflows/{workflowID}/versions/{version}", h.ReadVersion)
+		r.Post("/workflows/{workflowID}/versions/{version}/rollback", h.Rollback)
+		r.Get("/workflows/{workflowID}/diff", h.Diff)
 	})
 }
@@ -282,6 +282,66 @@ type (
 		// Execute step and return immediately
 		Async bool
 	}
+
+	WorkflowPublish struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Description POST parameter
+		//
+		// Version description
+		Description string
+	}
+
+	WorkflowListVersions struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+	}
+
+	WorkflowReadVersion struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Version PATH parameter
+		//
+		// Version
+		Version uint
+	}
+
+	WorkflowRollback struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Version PATH parameter
+		//
+		// Version
+		Version uint
+	}
+
+	WorkflowDiff struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// From GET parameter
+		//
+		// Version to compare from, 0 for the draft
+		From uint
+
+		// To GET parameter
+		//
+		// Version to compare to, 0 (default) for the draft
+		To uint
+	}
 )
 
 // NewWorkflowList request
@@ -1495,3 +1555,280 @@ func (r *WorkflowExec) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewWorkflowPublish request
+func NewWorkflowPublish() *WorkflowPublish {
+	return &WorkflowPublish{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowPublish) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID":  r.WorkflowID,
+		"description": r.Description,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowPublish) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowPublish) GetDescription() string {
+	return r.Description
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowPublish) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["description"]; ok && len(val) > 0 {
+				r.Description, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["description"]; ok && len(val) > 0 {
+			r.Description, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowListVersions request
+func NewWorkflowListVersions() *WorkflowListVersions {
+	return &WorkflowListVersions{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowListVersions) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowListVersions) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowListVersions) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowReadVersion request
+func NewWorkflowReadVersion() *WorkflowReadVersion {
+	return &WorkflowReadVersion{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowReadVersion) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"version":    r.Version,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowReadVersion) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowReadVersion) GetVersion() uint {
+	return r.Version
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowReadVersion) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "version")
+		r.Version, err = payload.ParseUint(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowRollback request
+func NewWorkflowRollback() *WorkflowRollback {
+	return &WorkflowRollback{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"version":    r.Version,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) GetVersion() uint {
+	return r.Version
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowRollback) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "version")
+		r.Version, err = payload.ParseUint(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowDiff request
+func NewWorkflowDiff() *WorkflowDiff {
+	return &WorkflowDiff{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDiff) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"from":       r.From,
+		"to":         r.To,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDiff) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDiff) GetFrom() uint {
+	return r.From
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDiff) GetTo() uint {
+	return r.To
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowDiff) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["from"]; ok && len(val) > 0 {
+			r.From, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["to"]; ok && len(val) > 0 {
+			r.To, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -25,6 +25,12 @@ type (
 			DeleteByID(ctx context.Context, workflowID uint64) error
 			UndeleteByID(ctx context.Context, workflowID uint64) error
 			Exec(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (*expr.Vars, uint64, types.Stacktrace, error)
+
+			Publish(ctx context.Context, workflowID uint64, description string) (*types.WorkflowVersion, error)
+			Rollback(ctx context.Context, workflowID uint64, version uint) (*types.WorkflowVersion, error)
+			Versions(ctx context.Context, workflowID uint64) (types.WorkflowVersionSet, error)
+			LookupVersion(ctx context.Context, workflowID uint64, version uint) (*types.WorkflowVersion, error)
+			Diff(ctx context.Context, workflowID uint64, from, to uint) (*types.WorkflowVersionDiff, error)
 		}
 
 		// cross-link with compose service to load module on resolved records
@@ -63,6 +69,10 @@ type (
 		Set    []*workflowPayload   `json:"set"`
 	}
 
+	workflowVersionSetPayload struct {
+		Set types.WorkflowVersionSet `json:"set"`
+	}
+
 	workflowExecPayload struct {
 		Results   *expr.Vars       `json:"results"`
 		Trace     types.Stacktrace `json:"trace,omitempty"`
@@ -163,6 +173,31 @@ func (ctrl Workflow) Undelete(ctx context.Context, r *request.WorkflowUndelete)
 	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.WorkflowID)
 }
 
+func (ctrl Workflow) Publish(ctx context.Context, r *request.WorkflowPublish) (interface{}, error) {
+	return ctrl.svc.Publish(ctx, r.WorkflowID, r.Description)
+}
+
+func (ctrl Workflow) ListVersions(ctx context.Context, r *request.WorkflowListVersions) (interface{}, error) {
+	vv, err := ctrl.svc.Versions(ctx, r.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowVersionSetPayload{Set: vv}, nil
+}
+
+func (ctrl Workflow) ReadVersion(ctx context.Context, r *request.WorkflowReadVersion) (interface{}, error) {
+	return ctrl.svc.LookupVersion(ctx, r.WorkflowID, r.Version)
+}
+
+func (ctrl Workflow) Rollback(ctx context.Context, r *request.WorkflowRollback) (interface{}, error) {
+	return ctrl.svc.Rollback(ctx, r.WorkflowID, r.Version)
+}
+
+func (ctrl Workflow) Diff(ctx context.Context, r *request.WorkflowDiff) (interface{}, error) {
+	return ctrl.svc.Diff(ctx, r.WorkflowID, r.From, r.To)
+}
+
 func (ctrl Workflow) Exec(ctx context.Context, r *request.WorkflowExec) (interface{}, error) {
 	var (
 		wep = &workflowExecPayload{}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/cortezaproject/corteza/server/pkg/sentry"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"go.uber.org/zap"
+)
+
+type (
+	// eventCorrelator routes dispatched events to the sessions waiting for them
+	//
+	// Waiting states are indexed by resource and event type, step and correlation key
+	// so that correlation expression is evaluated once per step and only states
+	// with the matching key are tested against the step's constraint
+	//
+	// Index is kept in memory and holds only states of the sessions running
+	// on this instance (the ones it claimed). Events dispatched on other
+	// instances are not delivered to them; there is no cross-instance routing.
+	eventCorrelator struct {
+		log      *zap.Logger
+		eventbus triggerEventTriggerHandler
+		deliver  func(ctx context.Context, sessionID, stateID uint64, input *expr.Vars) error
+
+		// eventbus handler is registered once for each resource and event type pair
+		// and is never removed
+		//
+		// Handlers are registered in the background (see watch) because
+		// synchronously dispatched events hold the eventbus lock and
+		// sessions are synced from the event handlers
+		handlers map[eventCorrelatorType]bool
+		expected map[eventCorrelatorType]bool
+		register chan struct{}
+
+		mux sync.Mutex
+
+		index map[eventCorrelatorType]map[types.EventAwaiter]map[string]map[uint64]*eventWaiter
+
+		// waiting states by session and state ID
+		sessions map[uint64]map[uint64]*eventWaiter
+	}
+
+	eventCorrelatorType struct {
+		resourceType string
+		eventType    string
+	}
+
+	eventWaiter struct {
+		sessionID uint64
+		stateID   uint64
+		key       string
+		scope     *expr.Vars
+		step      types.EventAwaiter
+
+		// event is being delivered to the state; waiter is kept
+		// in the index until the delivery succeeds
+		delivering bool
+	}
+)
+
+func EventCorrelator(log *zap.Logger, eb triggerEventTriggerHandler, deliver func(context.Context, uint64, uint64, *expr.Vars) error) *eventCorrelator {
+	return &eventCorrelator{
+		log:      log,
+		eventbus: eb,
+		deliver:  deliver,
+		handlers: make(map[eventCorrelatorType]bool),
+		expected: make(map[eventCorrelatorType]bool),
+		register: make(chan struct{}, 1),
+		index:    make(map[eventCorrelatorType]map[types.EventAwaiter]map[string]map[uint64]*eventWaiter),
+		sessions: make(map[uint64]map[uint64]*eventWaiter),
+	}
+}
+
+// sync updates the index with states of the session that are waiting for the event
+//
+// States that are no longer waiting are removed from the index
+func (svc *eventCorrelator) sync(sessionID uint64, aa []*wfexec.AwaitingState) {
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	if len(aa) == 0 && len(svc.sessions[sessionID]) == 0 {
+		return
+	}
+
+	awaiting := make(map[uint64]bool, len(aa))
+	for _, a := range aa {
+		step, ok := a.Step.(types.EventAwaiter)
+		if !ok {
+			continue
+		}
+
+		awaiting[a.StateID] = true
+		if svc.sessions[sessionID][a.StateID] != nil {
+			continue
+		}
+
+		svc.add(&eventWaiter{
+			sessionID: sessionID,
+			stateID:   a.StateID,
+			key:       a.Key,
+			scope:     a.Scope,
+			step:      step,
+		})
+	}
+
+	for stateID, w := range svc.sessions[sessionID] {
+		if !awaiting[stateID] {
+			svc.remove(w)
+		}
+	}
+}
+
+// expect makes sure events of the given type are routed to the waiting sessions
+//
+// Called when workflows with wait-event steps are loaded so that handlers
+// are registered before the first session waits for the event
+func (svc *eventCorrelator) expect(resourceType, eventType string) {
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	svc.expectType(eventCorrelatorType{resourceType: resourceType, eventType: eventType})
+}
+
+// expectType signals watcher to register the handler for the type
+//
+// Expects locked index
+func (svc *eventCorrelator) expectType(t eventCorrelatorType) {
+	if svc.expected[t] {
+		return
+	}
+
+	svc.expected[t] = true
+
+	select {
+	case svc.register <- struct{}{}:
+	default:
+		// registration is already pending
+	}
+}
+
+// watch registers eventbus handlers for expected types
+func (svc *eventCorrelator) watch(ctx context.Context) {
+	go func() {
+		defer sentry.Recover()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-svc.register:
+				svc.registerHandlers()
+			}
+		}
+	}()
+}
+
+// registerHandlers registers eventbus handlers for
+// all expected types without one
+func (svc *eventCorrelator) registerHandlers() {
+	svc.mux.Lock()
+	tt := make([]eventCorrelatorType, 0, len(svc.expected))
+	for t := range svc.expected {
+		if !svc.handlers[t] {
+			tt = append(tt, t)
+		}
+	}
+	svc.mux.Unlock()
+
+	for _, t := range tt {
+		svc.eventbus.Register(
+			svc.handler(t),
+			eventbus.For(t.resourceType),
+			eventbus.On(t.eventType),
+		)
+
+		svc.mux.Lock()
+		svc.handlers[t] = true
+		svc.mux.Unlock()
+
+		svc.log.Debug("routing events to waiting sessions",
+			zap.String("resourceType", t.resourceType),
+			zap.String("eventType", t.eventType),
+		)
+	}
+}
+
+// add waiter to the index
+//
+// Expects locked index
+func (svc *eventCorrelator) add(w *eventWaiter) {
+	var (
+		rType, eType = w.step.AwaitsEvent()
+		t            = eventCorrelatorType{resourceType: rType, eventType: eType}
+	)
+
+	if svc.index[t] == nil {
+		svc.index[t] = make(map[types.EventAwaiter]map[string]map[uint64]*eventWaiter)
+	}
+
+	if svc.index[t][w.step] == nil {
+		svc.index[t][w.step] = make(map[string]map[uint64]*eventWaiter)
+	}
+
+	if svc.index[t][w.step][w.key] == nil {
+		svc.index[t][w.step][w.key] = make(map[uint64]*eventWaiter)
+	}
+
+	svc.index[t][w.step][w.key][w.stateID] = w
+	svc.expectType(t)
+
+	if svc.sessions[w.sessionID] == nil {
+		svc.sessions[w.sessionID] = make(map[uint64]*eventWaiter)
+	}
+
+	svc.sessions[w.sessionID][w.stateID] = w
+
+	svc.log.Debug("waiting for event",
+		logger.Uint64("sessionID", w.sessionID),
+		logger.Uint64("stateID", w.stateID),
+		zap.String("resourceType", rType),
+		zap.String("eventType", eType),
+		zap.String("key", w.key),
+	)
+}
+
+// remove waiter from the index; returns false when waiter was already removed
+//
+// Expects locked index
+func (svc *eventCorrelator) remove(w *eventWaiter) bool {
+	var (
+		rType, eType = w.step.AwaitsEvent()
+		t            = eventCorrelatorType{resourceType: rType, eventType: eType}
+	)
+
+	if svc.sessions[w.sessionID][w.stateID] != w {
+		return false
+	}
+
+	delete(svc.sessions[w.sessionID], w.stateID)
+	if len(svc.sessions[w.sessionID]) == 0 {
+		delete(svc.sessions, w.sessionID)
+	}
+
+	delete(svc.index[t][w.step][w.key], w.stateID)
+	if len(svc.index[t][w.step][w.key]) > 0 {
+		return true
+	}
+
+	delete(svc.index[t][w.step], w.key)
+	if len(svc.index[t][w.step]) > 0 {
+		return true
+	}
+
+	delete(svc.index[t], w.step)
+	if len(svc.index[t]) == 0 {
+		delete(svc.index, t)
+	}
+
+	return true
+}
+
+// claim marks the waiter as being delivered to; returns false when waiter
+// was already removed or the event is being delivered with another event
+//
+// Expects locked index
+func (svc *eventCorrelator) claim(w *eventWaiter) bool {
+	if svc.sessions[w.sessionID][w.stateID] != w || w.delivering {
+		return false
+	}
+
+	w.delivering = true
+	return true
+}
+
+// unregister removes all waiting states of the session
+func (svc *eventCorrelator) unregister(sessionID uint64) {
+	svc.sync(sessionID, nil)
+}
+
+// handler delivers the event to all waiting states with the matching key that accept it
+func (svc *eventCorrelator) handler(t eventCorrelatorType) eventbus.HandlerFn {
+	return func(ctx context.Context, ev eventbus.Event) (err error) {
+		var (
+			payload = &expr.Vars{}
+			ww      []*eventWaiter
+		)
+
+		if enc, is := ev.(varsEncoder); is {
+			if payload, err = enc.EncodeVars(); err != nil {
+				return
+			}
+		}
+
+		_ = payload.AssignFieldValue("eventType", expr.Must(expr.NewString(ev.EventType())))
+		_ = payload.AssignFieldValue("resourceType", expr.Must(expr.NewString(ev.ResourceType())))
+
+		svc.mux.Lock()
+		for step, keys := range svc.index[t] {
+			key, err := step.Correlate(ctx, payload)
+			if err != nil {
+				svc.log.Warn("could not correlate event", logger.Uint64("stepID", step.ID()), zap.Error(err))
+				continue
+			}
+
+			for _, w := range keys[key] {
+				ww = append(ww, w)
+			}
+		}
+		svc.mux.Unlock()
+
+		for _, w := range ww {
+			log := svc.log.With(
+				logger.Uint64("sessionID", w.sessionID),
+				logger.Uint64("stateID", w.stateID),
+			)
+
+			if ok, err := w.step.Accepts(ctx, w.scope, payload); err != nil {
+				log.Warn("could not test event constraint", zap.Error(err))
+				continue
+			} else if !ok {
+				continue
+			}
+
+			svc.mux.Lock()
+			claimed := svc.claim(w)
+			svc.mux.Unlock()
+
+			if !claimed {
+				// delivered (or being delivered) with another event
+				continue
+			}
+
+			err = svc.deliver(ctx, w.sessionID, w.stateID, cloneVars(payload))
+
+			svc.mux.Lock()
+			if err != nil {
+				// state is still waiting for the event
+				w.delivering = false
+			} else {
+				svc.remove(w)
+			}
+			svc.mux.Unlock()
+
+			if err != nil {
+				log.Warn("could not deliver event", zap.Error(err))
+				continue
+			}
+
+			log.Debug("event delivered")
+		}
+
+		return nil
+	}
+}
+
+func cloneVars(vars *expr.Vars) *expr.Vars {
+	aux, err := vars.Clone()
+	if err != nil {
+		return expr.EmptyVars()
+	}
+
+	return aux.(*expr.Vars)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	correlatorTestEvent struct {
+		payload map[string]interface{}
+	}
+
+	correlatorTriggerEvent struct{}
+)
+
+func (correlatorTestEvent) ResourceType() string                  { return "test:order" }
+func (correlatorTestEvent) EventType() string                     { return "afterUpdate" }
+func (correlatorTestEvent) Match(eventbus.ConstraintMatcher) bool { return true }
+func (ev correlatorTestEvent) EncodeVars() (*expr.Vars, error)    { return expr.NewVars(ev.payload) }
+
+func (correlatorTriggerEvent) ResourceType() string                  { return "test:trigger" }
+func (correlatorTriggerEvent) EventType() string                     { return "afterCreate" }
+func (correlatorTriggerEvent) Match(eventbus.ConstraintMatcher) bool { return true }
+
+func TestEventCorrelator(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx, cfn = context.WithCancel(context.Background())
+		eb       = eventbus.New()
+		p        = expr.NewParser()
+
+		mux       sync.Mutex
+		delivered []uint64
+
+		parse = func(src string) *types.Expr {
+			e := &types.Expr{Expr: src}
+			req.NoError(p.ParseEvaluators(e))
+			return e
+		}
+
+		event = types.WorkflowStepEvent{ResourceType: "test:order", EventType: "afterUpdate"}
+
+		// waits for paid order with the key
+		paid = types.WaitEventStep(event, parse(`orderID`), parse(`status == "paid"`), nil, nil, nil, nil)
+
+		// waits for any update
+		any = types.WaitEventStep(event, nil, nil, nil, nil, nil, nil)
+
+		svc = EventCorrelator(zap.NewNop(), eb, func(_ context.Context, sessionID, stateID uint64, in *expr.Vars) error {
+			mux.Lock()
+			defer mux.Unlock()
+			delivered = append(delivered, stateID)
+			return nil
+		})
+
+		dispatch = func(payload map[string]interface{}) []uint64 {
+			mux.Lock()
+			delivered = nil
+			mux.Unlock()
+
+			req.NoError(eb.WaitFor(ctx, correlatorTestEvent{payload: payload}))
+
+			mux.Lock()
+			defer mux.Unlock()
+			return delivered
+		}
+	)
+
+	defer cfn()
+	svc.watch(ctx)
+
+	svc.sync(1, []*wfexec.AwaitingState{{SessionID: 1, StateID: 11, Step: paid, Key: "7", Scope: &expr.Vars{}}})
+	svc.sync(2, []*wfexec.AwaitingState{{SessionID: 2, StateID: 21, Step: paid, Key: "8", Scope: &expr.Vars{}}})
+	svc.sync(3, []*wfexec.AwaitingState{{SessionID: 3, StateID: 31, Step: any, Scope: &expr.Vars{}}})
+
+	// handler is registered in the background
+	req.Eventually(func() bool {
+		svc.mux.Lock()
+		defer svc.mux.Unlock()
+		return len(svc.handlers) == 1
+	}, time.Second, time.Millisecond)
+
+	// constraint is not met for the state with the matching key
+	req.Equal([]uint64{31}, dispatch(map[string]interface{}{"orderID": 7, "status": "pending"}))
+
+	// delivered only to the state with the matching key
+	req.Equal([]uint64{11}, dispatch(map[string]interface{}{"orderID": 7, "status": "paid"}))
+
+	// states are no longer waiting
+	req.Empty(dispatch(map[string]interface{}{"orderID": 7, "status": "paid"}))
+
+	// session is done
+	svc.unregister(2)
+	req.Empty(svc.index)
+	req.Empty(svc.sessions)
+}
+
+// sessions are synced from their workers while synchronously
+// dispatched events wait for the sessions to complete
+func TestEventCorrelator_syncDuringDispatch(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx, cfn = context.WithCancel(context.Background())
+		eb       = eventbus.New()
+
+		svc = EventCorrelator(zap.NewNop(), eb, func(context.Context, uint64, uint64, *expr.Vars) error { return nil })
+
+		step = types.WaitEventStep(types.WorkflowStepEvent{ResourceType: "test:order", EventType: "afterUpdate"}, nil, nil, nil, nil, nil, nil)
+	)
+
+	defer cfn()
+	svc.watch(ctx)
+
+	eb.Register(func(ctx context.Context, ev eventbus.Event) error {
+		synced := make(chan struct{})
+		go func() {
+			// session worker
+			svc.sync(1, []*wfexec.AwaitingState{{SessionID: 1, StateID: 11, Step: step, Scope: &expr.Vars{}}})
+			close(synced)
+		}()
+
+		select {
+		case <-synced:
+			return nil
+		case <-time.After(time.Second):
+			return context.DeadlineExceeded
+		}
+	}, eventbus.For("test:trigger"), eventbus.On("afterCreate"))
+
+	req.NoError(eb.WaitFor(ctx, correlatorTriggerEvent{}))
+}
+
+// waiting states are kept when the event could not be delivered
+func TestEventCorrelator_failedDelivery(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx, cfn = context.WithCancel(context.Background())
+		eb       = eventbus.New()
+
+		fail      = true
+		delivered int
+
+		svc = EventCorrelator(zap.NewNop(), eb, func(context.Context, uint64, uint64, *expr.Vars) error {
+			if fail {
+				return fmt.Errorf("session is busy")
+			}
+
+			delivered++
+			return nil
+		})
+
+		step = types.WaitEventStep(types.WorkflowStepEvent{ResourceType: "test:order", EventType: "afterUpdate"}, nil, nil, nil, nil, nil, nil)
+	)
+
+	defer cfn()
+	svc.watch(ctx)
+
+	svc.sync(1, []*wfexec.AwaitingState{{SessionID: 1, StateID: 11, Step: step, Scope: &expr.Vars{}}})
+	req.Eventually(func() bool {
+		svc.mux.Lock()
+		defer svc.mux.Unlock()
+		return len(svc.handlers) == 1
+	}, time.Second, time.Millisecond)
+
+	req.NoError(eb.WaitFor(ctx, correlatorTestEvent{}))
+	req.Zero(delivered)
+	req.Len(svc.sessions[1], 1)
+
+	fail = false
+	req.NoError(eb.WaitFor(ctx, correlatorTestEvent{}))
+	req.Equal(1, delivered)
+	req.Empty(svc.sessions)
+
+	req.NoError(eb.WaitFor(ctx, correlatorTestEvent{}))
+	req.Equal(1, delivered)
+}
+
+// states are indexed only on the instance that runs (claimed) the session;
+// events dispatched on other instances are not delivered to them
+func TestEventCorrelator_otherInstance(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx, cfn = context.WithCancel(context.Background())
+
+		delivered = make(map[string]int)
+
+		// instances with their own eventbus
+		eb1, eb2 = eventbus.New(), eventbus.New()
+
+		instance = func(name string, eb triggerEventTriggerHandler) *eventCorrelator {
+			svc := EventCorrelator(zap.NewNop(), eb, func(context.Context, uint64, uint64, *expr.Vars) error {
+				delivered[name]++
+				return nil
+			})
+
+			svc.watch(ctx)
+			return svc
+		}
+
+		svc1 = instance("first", eb1)
+		svc2 = instance("second", eb2)
+
+		event = types.WorkflowStepEvent{ResourceType: "test:order", EventType: "afterUpdate"}
+		step  = types.WaitEventStep(event, nil, nil, nil, nil, nil, nil)
+	)
+
+	defer cfn()
+
+	// session runs on the first instance;
+	// second instance only expects the event type
+	svc1.sync(1, []*wfexec.AwaitingState{{SessionID: 1, StateID: 11, Step: step, Scope: &expr.Vars{}}})
+	svc2.expect(event.ResourceType, event.EventType)
+
+	for _, svc := range []*eventCorrelator{svc1, svc2} {
+		svc := svc
+		req.Eventually(func() bool {
+			svc.mux.Lock()
+			defer svc.mux.Unlock()
+			return len(svc.handlers) == 1
+		}, time.Second, time.Millisecond)
+	}
+
+	req.NoError(eb2.WaitFor(ctx, correlatorTestEvent{}))
+	req.Empty(delivered)
+	req.Len(svc1.sessions[1], 1)
+
+	req.NoError(eb1.WaitFor(ctx, correlatorTestEvent{}))
+	req.Equal(map[string]int{"first": 1}, delivered)
+}
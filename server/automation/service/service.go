@@ -137,6 +137,12 @@ func Activate(ctx context.Context) (err error) {
 		return
 	}
 
+	// workflows need to be loaded before
+	// sessions are resumed
+	if err = DefaultSession.resumeAll(ctx, DefaultWorkflow.graph); err != nil {
+		return
+	}
+
 	return
 }
 
@@ -145,6 +151,18 @@ func Watchers(ctx context.Context) {
 	return
 }
 
+// Shutdown stores state of all running workflow sessions
+//
+// Needs to be called before the process exits so that
+// sessions can be resumed after restart
+func Shutdown(ctx context.Context) {
+	if DefaultSession == nil {
+		return
+	}
+
+	DefaultSession.suspendAll(ctx)
+}
+
 // Data is stale when new date does not match updatedAt or createdAt (before first update)
 func isStale(new *time.Time, updatedAt *time.Time, createdAt time.Time) bool {
 	if new == nil {
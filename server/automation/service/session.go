@@ -10,9 +10,12 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
 	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
 	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/pkg/sentry"
 	"github.com/cortezaproject/corteza/server/pkg/wfexec"
 	"github.com/cortezaproject/corteza/server/store"
@@ -35,8 +38,27 @@ type (
 		pool         map[uint64]*types.Session
 		spawnQueue   chan *spawn
 		promptSender promptSender
+
+		// routes events to sessions waiting for them
+		events *eventCorrelator
+
+		// sessions with stored state are claimed by the instance
+		// running them so that other instances do not resume them
+		claims  sessionClaimer
+		claimed map[uint64]bool
+
+		// graph loader used when resuming sessions
+		graph sessionGraphLoader
 	}
 
+	sessionClaimer interface {
+		Claim(ctx context.Context, job string, ttl time.Duration) (bool, error)
+		Renew(ctx context.Context, ttl time.Duration, jobs ...string) ([]string, error)
+		Release(ctx context.Context, jobs ...string) error
+	}
+
+	sessionGraphLoader func(ctx context.Context, workflowID uint64, version uint) (*wfexec.Graph, error)
+
 	spawn struct {
 		workflowID uint64
 		session    chan *wfexec.Session
@@ -63,10 +85,17 @@ const (
 	// We use the size of the stacktrace and for every F (see the value of the constant)
 	// we flush the session info to the store.
 	sessionStateFlushFrequency = 1000
+
+	// how long the session is claimed by the instance;
+	// claims are renewed while the session is running
+	sessionClaimTTL = time.Minute * 5
+
+	// how often claims are renewed
+	sessionClaimRenewInterval = time.Minute
 )
 
 func Session(log *zap.Logger, opt options.WorkflowOpt, ps promptSender) *session {
-	return &session{
+	svc := &session{
 		log:          log,
 		opt:          opt,
 		actionlog:    DefaultActionlog,
@@ -75,7 +104,12 @@ func Session(log *zap.Logger, opt options.WorkflowOpt, ps promptSender) *session
 		pool:         make(map[uint64]*types.Session),
 		spawnQueue:   make(chan *spawn),
 		promptSender: ps,
+		claims:       scheduler.Claims(),
+		claimed:      make(map[uint64]bool),
 	}
+
+	svc.events = EventCorrelator(log.Named("events"), eventbus.Service(), svc.deliver)
+	return svc
 }
 
 func (svc *session) Search(ctx context.Context, filter types.SessionFilter) (rr types.SessionSet, f types.SessionFilter, err error) {
@@ -127,28 +161,234 @@ func (svc *session) LookupByID(ctx context.Context, sessionID uint64) (res *type
 	return res, svc.recordAction(ctx, sap, SessionActionLookup, err)
 }
 
-func (svc *session) resumeAll(ctx context.Context) error {
-	// In theory we could resume active/pending/prompt sessions from persistent store
-	// so that they can survive server termination
+// resumeAll loads all unfinished sessions with stored state and puts them back into the pool
+//
+// Sessions continue where they stopped, with the workflow version they
+// were started with; sessions that can not be restored (workflow was
+// removed or changed) are marked as failed
+//
+// Only sessions that are not claimed by another instance are resumed;
+// watcher calls this periodically to pick up sessions of instances
+// that stopped without releasing them
+func (svc *session) resumeAll(ctx context.Context, graph sessionGraphLoader) error {
+	svc.graph = graph
+
+	set, _, err := store.SearchAutomationSessions(ctx, svc.store, types.SessionFilter{
+		Completed: filter.StateExcluded,
+		Status: []uint{
+			uint(types.SessionStarted),
+			uint(types.SessionPrompted),
+			uint(types.SessionSuspended),
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, ses := range set {
+		if ses.State == nil || ses.State.Snapshot == nil {
+			continue
+		}
+
+		log := svc.log.With(
+			logger.Uint64("sessionID", ses.ID),
+			logger.Uint64("workflowID", ses.WorkflowID),
+		)
+
+		sessionID := ses.ID
+		if svc.inPool(sessionID) {
+			continue
+		}
+
+		if claimed, err := svc.claims.Claim(ctx, sessionClaimJob(sessionID), sessionClaimTTL); err != nil {
+			log.Error("could not claim session", zap.Error(err))
+			continue
+		} else if !claimed {
+			log.Debug("session is running on another instance")
+			continue
+		}
+
+		// session might have been completed by the instance
+		// that released it after it was loaded
+		if ses, err = store.LookupAutomationSessionByID(ctx, svc.store, sessionID); err != nil || ses.CompletedAt != nil || ses.State == nil || ses.State.Snapshot == nil {
+			svc.release(ctx, sessionID)
+			continue
+		}
+
+		g, err := graph(ctx, ses.WorkflowID, ses.WorkflowVersion)
+		if err == nil {
+			err = svc.restore(ses, g)
+		}
+
+		if err == nil {
+			// states waiting for the event need to be routed again
+			svc.events.sync(ses.ID, ses.Awaiting())
+
+			log.Debug("session resumed")
+			continue
+		}
+
+		log.Error("could not resume session", zap.Error(err))
+
+		ses.Status = types.SessionFailed
+		ses.Error = fmt.Sprintf("could not resume session: %v", err)
+		ses.CompletedAt = now()
+		ses.SuspendedAt = nil
+		ses.State = nil
+
+		if err = store.UpsertAutomationSession(ctx, svc.store, ses); err != nil {
+			log.Error("failed to update session", zap.Error(err))
+		}
+
+		svc.release(ctx, ses.ID)
+	}
 
-	// @todo resume active sessions from storage
-	//       load all active sessions from store and load them into the pool
-	//
 	return nil
 }
 
-func (svc *session) suspendAll(ctx context.Context) error {
-	// In theory we could suspend active/pending/prompt sessions to persistent store
-	// so that they can survive server termination
+// restore rehydrates the session from its state and puts it into the pool
+func (svc *session) restore(ses *types.Session, g *wfexec.Graph) (err error) {
+	if g == nil {
+		return fmt.Errorf("workflow not found or not executable")
+	}
 
-	// @todo suspend active sessions to storage:
-	//       stop watcher queue
-	//       run gc
-	//       stop worker on each session
-	//       flush session to store (like we're doing in the status handler
+	if err = ses.State.Snapshot.ResolveTypes(Registry().Type); err != nil {
+		return
+	}
+
+	var (
+		runner  = ses.State.Runner()
+		invoker = ses.State.Invoker()
+
+		ws *wfexec.Session
+	)
+
+	// pool is locked until the session is in it;
+	// state change handler can be called as soon as the session is restored
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	ws, err = wfexec.RestoreSession(
+		svc.execContext(runner, invoker),
+		g,
+		ses.State.Snapshot,
+		svc.sessionOpts(ses.WorkflowID, ses.State.Snapshot.CallStack, runner)...,
+	)
+
+	if err != nil {
+		return
+	}
+
+	ses.Restore(ws)
+	if !svc.opt.StackTraceEnabled {
+		ses.DisableStacktrace()
+	}
+
+	if svc.opt.StackTraceFull {
+		ses.FullStacktrace()
+	}
+
+	svc.pool[ses.ID] = ses
+	svc.claimed[ses.ID] = true
 	return nil
 }
 
+// inPool checks if the session is already running on this instance
+func (svc *session) inPool(sessionID uint64) bool {
+	svc.mux.RLock()
+	defer svc.mux.RUnlock()
+	return svc.pool[sessionID] != nil
+}
+
+// claim marks session as running on this instance
+//
+// Expects locked pool
+func (svc *session) claim(ctx context.Context, sessionID uint64) {
+	if svc.claimed[sessionID] {
+		return
+	}
+
+	claimed, err := svc.claims.Claim(ctx, sessionClaimJob(sessionID), sessionClaimTTL)
+	if err != nil || !claimed {
+		svc.log.Warn("could not claim session", logger.Uint64("sessionID", sessionID), zap.Error(err))
+		return
+	}
+
+	svc.claimed[sessionID] = true
+}
+
+// release lets other instances resume the session
+func (svc *session) release(ctx context.Context, sessionID uint64) {
+	if err := svc.claims.Release(ctx, sessionClaimJob(sessionID)); err != nil {
+		svc.log.Warn("could not release session", logger.Uint64("sessionID", sessionID), zap.Error(err))
+	}
+}
+
+// renewClaims extends claims of all sessions running on this instance
+func (svc *session) renewClaims(ctx context.Context) {
+	svc.mux.RLock()
+	jobs := make([]string, 0, len(svc.claimed))
+	for sessionID := range svc.claimed {
+		jobs = append(jobs, sessionClaimJob(sessionID))
+	}
+	svc.mux.RUnlock()
+
+	if len(jobs) == 0 {
+		return
+	}
+
+	lost, err := svc.claims.Renew(ctx, sessionClaimTTL, jobs...)
+	if err != nil {
+		svc.log.Error("could not renew session claims", zap.Error(err))
+		return
+	}
+
+	for _, job := range lost {
+		svc.log.Error("session claim expired and might be resumed by another instance", zap.String("job", job))
+	}
+}
+
+// sessionClaimJob returns job identifier used to claim the session
+func sessionClaimJob(sessionID uint64) string {
+	return fmt.Sprintf("automation-session:%d", sessionID)
+}
+
+// suspendAll stores state of all sessions in the pool
+//
+// Steps that are executed while the state is taken
+// are executed again when session is resumed.
+// Claims are released so that sessions can be resumed
+// by another instance right away.
+func (svc *session) suspendAll(ctx context.Context) {
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	for _, ses := range svc.pool {
+		if ses.GC() {
+			continue
+		}
+
+		log := svc.log.With(logger.Uint64("sessionID", ses.ID))
+
+		if err := ses.CaptureState(); err != nil {
+			log.Error("failed to capture session state", zap.Error(err))
+			continue
+		}
+
+		ses.CopyRuntimeStacktrace()
+		if err := store.UpsertAutomationSession(ctx, svc.store, ses); err != nil {
+			log.Error("failed to store session", zap.Error(err))
+			continue
+		}
+
+		if svc.claimed[ses.ID] {
+			svc.release(ctx, ses.ID)
+			delete(svc.claimed, ses.ID)
+		}
+	}
+}
+
 // PendingPrompts returns all prompts on all sessions owned by current user
 func (svc *session) PendingPrompts(ctx context.Context) (pp []*wfexec.PendingPrompt) {
 	var (
@@ -273,6 +513,22 @@ func (svc *session) Resume(sessionID, stateID uint64, i auth.Identifiable, input
 	return nil
 }
 
+// deliver resumes the state that is waiting for the event
+//
+// There is no access-control; sessions are resumed with the
+// events that match constraints set on the wait-event step
+func (svc *session) deliver(ctx context.Context, sessionID, stateID uint64, input *expr.Vars) error {
+	svc.mux.RLock()
+	defer svc.mux.RUnlock()
+
+	ses := svc.pool[sessionID]
+	if ses == nil {
+		return errors.NotFound("session not found")
+	}
+
+	return ses.Deliver(ctx, stateID, input)
+}
+
 // Terminates session ID
 func (svc *session) Cancel(ctx context.Context, sessionID uint64) (err error) {
 	svc.mux.RLock()
@@ -341,48 +597,29 @@ func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callSt
 func (svc *session) Watch(ctx context.Context) {
 	gcTicker := time.NewTicker(time.Second)
 	lpTicker := time.NewTicker(time.Second * 30)
+	clTicker := time.NewTicker(sessionClaimRenewInterval)
+	rsTicker := time.NewTicker(sessionClaimTTL)
+
+	svc.events.watch(ctx)
 
 	go func() {
 		defer sentry.Recover()
 		defer gcTicker.Stop()
+		defer clTicker.Stop()
+		defer rsTicker.Stop()
 		defer svc.log.Info("stopped")
 
 		for {
 			select {
 			case <-ctx.Done():
+				// sessions are stored on shutdown (see Shutdown)
 				return
 			case s := <-svc.spawnQueue:
-				var execCtx = context.Background()
-
-				opts := []wfexec.SessionOpt{
-					wfexec.SetWorkflowID(s.workflowID),
-					wfexec.SetCallStack(s.callStack...),
-					wfexec.SetHandler(svc.stateChangeHandler(ctx)),
-				}
-
-				if svc.opt.ExecDebug {
-					log := svc.log.
-						Named("exec").
-						With(logger.Uint64("workflowID", s.workflowID)).
-						With(logger.Uint64("runnerID", s.runner.Identity())).
-						With(logger.Uint64s("runnerRoles", s.runner.Roles()))
-
-					opts = append(
-						opts,
-						wfexec.SetLogger(log),
-						wfexec.SetDumpStacktraceOnPanic(true),
-					)
-				}
-
-				// Encode runner into execution context
-				// runner is used as identity and for access control
-				execCtx = auth.SetIdentityToContext(execCtx, s.runner)
-
-				// Encode invoker into execution context
-				// invoker is used
-				execCtx = context.WithValue(execCtx, workflowInvokerCtxKey{}, s.invoker)
-
-				s.session <- wfexec.NewSession(execCtx, s.graph, opts...)
+				s.session <- wfexec.NewSession(
+					svc.execContext(s.runner, s.invoker),
+					s.graph,
+					svc.sessionOpts(s.workflowID, s.callStack, s.runner)...,
+				)
 				// case time for a pool cleanup
 				// @todo cleanup pool when sessions are complete
 
@@ -391,16 +628,63 @@ func (svc *session) Watch(ctx context.Context) {
 
 			case <-lpTicker.C:
 				svc.logPending()
+
+			case <-clTicker.C:
+				svc.renewClaims(ctx)
+
+			case <-rsTicker.C:
+				// resume sessions of instances that stopped
+				// without releasing them (claims expired)
+				if svc.graph != nil {
+					if err := svc.resumeAll(ctx, svc.graph); err != nil {
+						svc.log.Error("could not resume sessions", zap.Error(err))
+					}
+				}
 			}
 		}
-
-		// @todo serialize sessions & suspended states
-		//svc.suspendAll(ctx)
 	}()
 
 	svc.log.Debug("watcher initialized")
 }
 
+// execContext returns context the session is executed with
+func (svc *session) execContext(runner, invoker auth.Identifiable) context.Context {
+	var execCtx = context.Background()
+
+	// Encode runner into execution context
+	// runner is used as identity and for access control
+	execCtx = auth.SetIdentityToContext(execCtx, runner)
+
+	// Encode invoker into execution context
+	// invoker is used
+	return context.WithValue(execCtx, workflowInvokerCtxKey{}, invoker)
+}
+
+// sessionOpts returns options for new or restored session
+func (svc *session) sessionOpts(workflowID uint64, callStack []uint64, runner auth.Identifiable) []wfexec.SessionOpt {
+	opts := []wfexec.SessionOpt{
+		wfexec.SetWorkflowID(workflowID),
+		wfexec.SetCallStack(callStack...),
+		wfexec.SetHandler(svc.stateChangeHandler(context.Background())),
+	}
+
+	if svc.opt.ExecDebug {
+		log := svc.log.
+			Named("exec").
+			With(logger.Uint64("workflowID", workflowID)).
+			With(logger.Uint64("runnerID", runner.Identity())).
+			With(logger.Uint64s("runnerRoles", runner.Roles()))
+
+		opts = append(
+			opts,
+			wfexec.SetLogger(log),
+			wfexec.SetDumpStacktraceOnPanic(true),
+		)
+	}
+
+	return opts
+}
+
 // garbage collection for stale sessions
 func (svc *session) gc() {
 	svc.mux.Lock()
@@ -451,6 +735,15 @@ func (svc *session) logPending() {
 // stateChangeHandler keeps track of session status changes and frequently stores session into db
 func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHandler {
 	return func(status wfexec.SessionStatus, state *wfexec.State, s *wfexec.Session) (err error) {
+		// states waiting for the event are routed before the pool is locked;
+		// event handlers lock it when delivering events
+		switch status {
+		case wfexec.SessionCompleted, wfexec.SessionFailed, wfexec.SessionCanceled:
+			svc.events.unregister(s.ID())
+		default:
+			svc.events.sync(s.ID(), s.Awaiting())
+		}
+
 		svc.mux.Lock()
 		defer svc.mux.Unlock()
 
@@ -496,6 +789,23 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 			ses.AppendRuntimeStacktrace(frame)
 		}
 
+		switch status {
+		case wfexec.SessionPrompted, wfexec.SessionDelayed:
+			// claim the session before its state is stored
+			// so that other instances do not resume it
+			svc.claim(ctx, s.ID())
+
+			// store the state at every suspension point
+			// so that session can be resumed after restart
+			if err := ses.CaptureState(); err != nil {
+				svc.log.Warn(
+					"could not capture session state",
+					logger.Uint64("sessionID", s.ID()),
+					zap.Error(err),
+				)
+			}
+		}
+
 		switch status {
 		case wfexec.SessionPrompted:
 			ses.SuspendedAt = now()
@@ -528,6 +838,7 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 			ses.SuspendedAt = nil
 			ses.CompletedAt = now()
 			ses.Status = types.SessionCompleted
+			ses.State = nil
 
 		case wfexec.SessionFailed:
 			ses.SuspendedAt = nil
@@ -536,11 +847,13 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 				ses.Error = state.Error()
 			}
 			ses.Status = types.SessionFailed
+			ses.State = nil
 
 		case wfexec.SessionCanceled:
 			ses.SuspendedAt = nil
 			ses.CompletedAt = now()
 			ses.Status = types.SessionCanceled
+			ses.State = nil
 
 		default:
 			// force update every X iterations
@@ -562,6 +875,15 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 			log.Error("failed to update session", zap.Error(err))
 		}
 
+		switch status {
+		case wfexec.SessionCompleted, wfexec.SessionFailed, wfexec.SessionCanceled:
+			// released after the final status is stored
+			if svc.claimed[s.ID()] {
+				svc.release(ctx, s.ID())
+				delete(svc.claimed, s.ID())
+			}
+		}
+
 		return
 	}
 }
@@ -3,11 +3,19 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cortezaproject/corteza/server/automation/types"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers/sqlite"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestSession_Start(t *testing.T) {
@@ -189,3 +197,117 @@ func BenchmarkSessionStackTraces_1000000(b *testing.B) {
 func BenchmarkSessionStackTraces_10000000(b *testing.B) {
 	benchmarkSessionStackTraces(b, 10000000)
 }
+
+func TestSession_resumeAll(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   store.Storer
+		err error
+
+		g    = wfexec.NewGraph()
+		step = wfexec.NewGenericStep(func(context.Context, *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+			return expr.NewVars(map[string]interface{}{"done": true})
+		})
+
+		makeSession = func(sessionID, workflowID uint64) *types.Session {
+			return &types.Session{
+				ID:         sessionID,
+				WorkflowID: workflowID,
+				Status:     types.SessionSuspended,
+				CreatedAt:  *now(),
+				State: &types.SessionState{
+					RunnerID: 42,
+					Snapshot: &wfexec.SessionSnapshot{
+						SessionID:  sessionID,
+						WorkflowID: workflowID,
+						CallStack:  []uint64{workflowID, sessionID},
+						States: []*wfexec.StateSnapshot{{
+							StateID: sessionID + 1,
+							StepID:  step.ID(),
+							Scope:   &expr.Vars{},
+							// delay expired while server was down
+							Delay: &wfexec.DelaySnapshot{ResumeAt: now().Add(-time.Minute)},
+						}},
+					},
+				},
+			}
+		}
+
+		graphs = func(_ context.Context, workflowID uint64, _ uint) (*wfexec.Graph, error) {
+			if workflowID == 1 {
+				return g, nil
+			}
+
+			return nil, nil
+		}
+	)
+
+	step.SetID(100)
+	g.AddStep(step)
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.TruncateAutomationSessions(ctx, s))
+	req.NoError(store.CreateAutomationSession(ctx, s,
+		makeSession(10, 1),
+		// workflow is no longer available
+		makeSession(20, 2),
+		// running on another instance
+		makeSession(30, 1),
+	))
+
+	req.NoError(store.TruncateSchedulerClaims(ctx, s))
+	claimed, err := scheduler.NewClaims(zap.NewNop(), s, "node-1").Claim(ctx, sessionClaimJob(30), time.Hour)
+	req.NoError(err)
+	req.True(claimed)
+
+	svc := &session{
+		store:   s,
+		log:     zap.NewNop(),
+		pool:    make(map[uint64]*types.Session),
+		claims:  scheduler.NewClaims(zap.NewNop(), s, "node-0"),
+		claimed: make(map[uint64]bool),
+	}
+
+	svc.events = EventCorrelator(zap.NewNop(), eventbus.New(), svc.deliver)
+
+	req.NoError(svc.resumeAll(ctx, graphs))
+	req.Len(svc.pool, 1)
+	req.NotNil(svc.pool[10])
+
+	c, err := store.LookupSchedulerClaimByJob(ctx, s, sessionClaimJob(10))
+	req.NoError(err)
+	req.Equal("node-0", c.ClaimedBy)
+
+	// claim is released when session is marked as failed
+	_, err = store.LookupSchedulerClaimByJob(ctx, s, sessionClaimJob(20))
+	req.True(errors.IsNotFound(err))
+
+	failed, err := store.LookupAutomationSessionByID(ctx, s, 20)
+	req.NoError(err)
+	req.Equal(types.SessionFailed, failed.Status)
+	req.Nil(failed.State)
+
+	// delayed state is resumed and session completes
+	req.Eventually(func() bool {
+		ses, err := store.LookupAutomationSessionByID(ctx, s, 10)
+		return err == nil && ses.Status == types.SessionCompleted && ses.State == nil
+	}, time.Second*5, time.Millisecond*10)
+
+	// claim is released when session completes
+	req.Eventually(func() bool {
+		_, err = store.LookupSchedulerClaimByJob(ctx, s, sessionClaimJob(10))
+		return errors.IsNotFound(err)
+	}, time.Second*5, time.Millisecond*10)
+
+	running, err := store.LookupAutomationSessionByID(ctx, s, 30)
+	req.NoError(err)
+	req.Equal(types.SessionSuspended, running.Status)
+	req.NotNil(running.State)
+}
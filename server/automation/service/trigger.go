@@ -231,6 +231,11 @@ func (svc *trigger) Create(ctx context.Context, new *types.Trigger) (res *types.
 			return
 		}
 
+		// triggers start published version of the workflow
+		if wf, err = publishedWorkflow(ctx, s, wf); err != nil {
+			return
+		}
+
 		// Ignore workflow issues as those are defined by the workflow itself.
 		// Internal errors should still be reported.
 		if err = svc.registerWorkflow(ctx, wf, res); err != nil {
@@ -462,6 +467,10 @@ func (svc *trigger) updateTriggerRegistration(ctx context.Context, t *types.Trig
 		return err
 	}
 
+	if wf, err = publishedWorkflow(ctx, svc.store, wf); err != nil {
+		return err
+	}
+
 	return svc.registerWorkflow(ctx, wf, t)
 }
 
@@ -353,10 +353,19 @@ func (svc *workflow) updater(ctx context.Context, workflowID uint64, action func
 			return
 		}
 
-		svc.updateCache(res, runAs, g)
+		// published workflow keeps executing the published version;
+		// changes of the draft are applied when draft is published
+		live := res
+		if res.PublishedVersion > 0 {
+			if live, g, runAs, err = svc.published(ctx, s, res); err != nil {
+				return
+			}
+		}
 
-		if len(res.Issues) == 0 {
-			if err = svc.triggers.registerWorkflows(ctx, res); err != nil {
+		svc.updateCache(live, runAs, g)
+
+		if len(live.Issues) == 0 {
+			if err = svc.triggers.registerWorkflows(ctx, live); err != nil {
 				return err
 			}
 		}
@@ -524,14 +533,21 @@ func (svc *workflow) Load(ctx context.Context) error {
 	svc.muxWIndex.Lock()
 	defer svc.muxWIndex.Unlock()
 
-	for _, wf := range set {
+	for i, wf := range set {
 		svc.wIndex[wf.Handle] = wf.ID
 
-		if g, runAs, err = svc.validateWorkflow(ctx, wf); err != nil {
+		if wf.PublishedVersion > 0 {
+			if set[i], g, runAs, err = svc.published(ctx, svc.store, wf); err != nil {
+				// never fall back to the draft
+				set[i] = wf
+				wf.Issues = wf.Issues.Append(err, nil)
+				continue
+			}
+		} else if g, runAs, err = svc.validateWorkflow(ctx, wf); err != nil {
 			continue
 		}
 
-		svc.updateCache(wf, runAs, g)
+		svc.updateCache(set[i], runAs, g)
 	}
 
 	return svc.triggers.registerWorkflows(ctx, set...)
@@ -545,6 +561,7 @@ func (svc *workflow) updateCache(wf *types.Workflow, runAs intAuth.Identifiable,
 	if wf.Executable() {
 		svc.wIndex[wf.Handle] = wf.ID
 		svc.cache[wf.ID] = &wfCacheItem{g: g, wf: wf, runAs: runAs}
+		svc.expectEvents(wf)
 	} else {
 		// remove deleted
 		delete(svc.cache, wf.ID)
@@ -554,6 +571,59 @@ func (svc *workflow) updateCache(wf *types.Workflow, runAs intAuth.Identifiable,
 	return
 }
 
+// expectEvents prepares routing of the events wait-event steps are waiting for
+func (svc *workflow) expectEvents(wf *types.Workflow) {
+	if svc.session == nil || svc.session.events == nil {
+		return
+	}
+
+	for _, s := range wf.Steps {
+		if s.Kind == types.WorkflowStepKindWaitEvent && s.Event != nil {
+			svc.session.events.expect(s.Event.ResourceType, s.Event.EventType)
+		}
+	}
+}
+
+// graph returns exec graph of the given workflow version
+//
+// Graph of the published version (or of the draft when workflow
+// was never published) is cached, other versions are converted.
+// Nil is returned for workflows that are not executable.
+func (svc *workflow) graph(ctx context.Context, workflowID uint64, version uint) (*wfexec.Graph, error) {
+	svc.muxCache.RLock()
+	c := svc.cache[workflowID]
+	svc.muxCache.RUnlock()
+
+	if c == nil {
+		return nil, nil
+	}
+
+	if c.wf.PublishedVersion == version {
+		return c.g, nil
+	}
+
+	wf, err := loadWorkflow(ctx, svc.store, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > 0 {
+		v, err := loadWorkflowVersion(ctx, svc.store, workflowID, version)
+		if err != nil {
+			return nil, err
+		}
+
+		wf = v.Apply(wf)
+	}
+
+	g, issues := Convert(svc, wf)
+	if len(issues) > 0 {
+		return nil, issues
+	}
+
+	return g, nil
+}
+
 func (svc *workflow) Exec(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (*expr.Vars, uint64, types.Stacktrace, error) {
 	var (
 		wap        = &workflowActionProps{}
@@ -646,7 +716,12 @@ func (svc *workflow) Exec(ctx context.Context, workflowID uint64, p types.Workfl
 			return
 		}
 
-		wait, sessionID, err = svc.exec(ctx, wf, p)
+		if p.Trace && wf.PublishedVersion > 0 {
+			// tracing published workflow while designing it
+			wait, sessionID, err = svc.execDraft(ctx, wf.ID, p)
+		} else {
+			wait, sessionID, err = svc.exec(ctx, wf, p)
+		}
 
 		if err != nil {
 			return err
@@ -721,27 +796,45 @@ func (svc *workflow) exec(ctx context.Context, wf *types.Workflow, p types.Workf
 		return nil, 0, WorkflowErrInvalidID()
 	}
 
-	var (
-		g     = svc.cache[wf.ID].g
-		runAs = svc.cache[wf.ID].runAs
+	return svc.start(ctx, wf, wf.PublishedVersion, svc.cache[wf.ID].g, svc.cache[wf.ID].runAs, p)
+}
 
-		scope *expr.Vars
-	)
+// execDraft executes draft of the published workflow
+func (svc *workflow) execDraft(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (WaitFn, uint64, error) {
+	draft, err := loadWorkflow(ctx, svc.store, workflowID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	g, runAs, err := svc.validateWorkflow(ctx, draft)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(draft.Issues) > 0 {
+		return nil, 0, draft.Issues
+	}
+
+	return svc.start(ctx, draft, 0, g, runAs, p)
+}
 
+// start starts new session with the given workflow version and graph
+func (svc *workflow) start(ctx context.Context, wf *types.Workflow, version uint, g *wfexec.Graph, runAs intAuth.Identifiable, p types.WorkflowExecParams) (WaitFn, uint64, error) {
 	// merge workflow scope with the input
-	scope = wf.Scope.MustMerge(p.Input)
+	scope := wf.Scope.MustMerge(p.Input)
 
 	return svc.session.Start(ctx, g, types.SessionStartParams{
 		Invoker: intAuth.GetIdentityFromContext(ctx),
 		Runner:  runAs,
 
-		WorkflowID:   wf.ID,
-		KeepFor:      wf.KeepSessions,
-		Trace:        wf.Trace || p.Trace,
-		Input:        scope,
-		StepID:       p.StepID,
-		EventType:    p.EventType,
-		ResourceType: p.ResourceType,
+		WorkflowID:      wf.ID,
+		WorkflowVersion: version,
+		KeepFor:         wf.KeepSessions,
+		Trace:           wf.Trace || p.Trace,
+		Input:           scope,
+		StepID:          p.StepID,
+		EventType:       p.EventType,
+		ResourceType:    p.ResourceType,
 
 		CallStack: wfexec.GetContextCallStack(ctx),
 	})
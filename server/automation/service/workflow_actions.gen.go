@@ -24,6 +24,7 @@ type (
 		workflow *types.Workflow
 		new      *types.Workflow
 		update   *types.Workflow
+		version  *types.WorkflowVersion
 		trigger  *types.Trigger
 		filter   *types.WorkflowFilter
 	}
@@ -80,6 +81,15 @@ func (p *workflowActionProps) setUpdate(update *types.Workflow) *workflowActionP
 	return p
 }
 
+// setVersion updates workflowActionProps's version
+//
+// This function is auto-generated.
+//
+func (p *workflowActionProps) setVersion(version *types.WorkflowVersion) *workflowActionProps {
+	p.version = version
+	return p
+}
+
 // setTrigger updates workflowActionProps's trigger
 //
 // This function is auto-generated.
@@ -119,6 +129,10 @@ func (p workflowActionProps) Serialize() actionlog.Meta {
 		m.Set("update.handle", p.update.Handle, true)
 		m.Set("update.ID", p.update.ID, true)
 	}
+	if p.version != nil {
+		m.Set("version.version", p.version.Version, true)
+		m.Set("version.ID", p.version.ID, true)
+	}
 	if p.trigger != nil {
 		m.Set("trigger.eventType", p.trigger.EventType, true)
 		m.Set("trigger.resourceType", p.trigger.ResourceType, true)
@@ -198,6 +212,20 @@ func (p workflowActionProps) Format(in string, err error) string {
 		pairs = append(pairs, "{{update.ID}}", fns(p.update.ID))
 	}
 
+	if p.version != nil {
+		// replacement for "{{version}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{version}}",
+			fns(
+				p.version.Version,
+				p.version.ID,
+			),
+		)
+		pairs = append(pairs, "{{version.version}}", fns(p.version.Version))
+		pairs = append(pairs, "{{version.ID}}", fns(p.version.ID))
+	}
+
 	if p.trigger != nil {
 		// replacement for "{{trigger}}" (in order how fields are defined)
 		pairs = append(
@@ -399,6 +427,46 @@ func WorkflowActionExecute(props ...*workflowActionProps) *workflowAction {
 	return a
 }
 
+// WorkflowActionPublish returns "automation:workflow.publish" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionPublish(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "publish",
+		log:       "published {{workflow}} as {{version}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionRollback returns "automation:workflow.rollback" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionRollback(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "rollback",
+		log:       "rolled back {{workflow}} to {{version}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -507,6 +575,40 @@ func WorkflowErrInvalidID(mm ...*workflowActionProps) *errors.Error {
 	return e
 }
 
+// WorkflowErrVersionNotFound returns "automation:workflow.versionNotFound" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func WorkflowErrVersionNotFound(mm ...*workflowActionProps) *errors.Error {
+	var p = &workflowActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("workflow version not found", nil),
+
+		errors.Meta("type", "versionNotFound"),
+		errors.Meta("resource", "automation:workflow"),
+
+		errors.Meta(workflowPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "workflow.errors.versionNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // WorkflowErrDisabled returns "automation:workflow.disabled" as *errors.Error
 //
 //
@@ -787,6 +889,42 @@ func WorkflowErrNotAllowedToUpdate(mm ...*workflowActionProps) *errors.Error {
 	return e
 }
 
+// WorkflowErrNotAllowedToPublish returns "automation:workflow.notAllowedToPublish" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func WorkflowErrNotAllowedToPublish(mm ...*workflowActionProps) *errors.Error {
+	var p = &workflowActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to publish this workflow", nil),
+
+		errors.Meta("type", "notAllowedToPublish"),
+		errors.Meta("resource", "automation:workflow"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(workflowLogMetaKey{}, "failed to publish {{workflow}}; insufficient permissions"),
+		errors.Meta(workflowPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "workflow.errors.notAllowedToPublish"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // WorkflowErrNotAllowedToDelete returns "automation:workflow.notAllowedToDelete" as *errors.Error
 //
 //
@@ -210,6 +210,9 @@ func (svc workflowConverter) workflowStepDefConv(g *wfexec.Graph, def *types.Wor
 		case types.WorkflowStepKindExecWorkflow:
 			return svc.convExecWorkflowStep(def, s)
 
+		case types.WorkflowStepKindWaitEvent:
+			return svc.convWaitEventStep(g, s, out)
+
 		default:
 			return nil, errors.Internal("unsupported step kind %q", s.Kind)
 		}
@@ -393,7 +396,18 @@ func (svc workflowConverter) convFunctionStep(g *wfexec.Graph, s *types.Workflow
 			return types.IteratorStep(def, s.Arguments, s.Results, next, exit)
 
 		} else {
-			return types.FunctionStep(def, s.Arguments, s.Results)
+			step, err := types.FunctionStep(def, s.Arguments, s.Results)
+			if err != nil || s.Retry == nil {
+				return step, err
+			}
+
+			policy, err := s.Retry.Policy()
+			if err != nil {
+				return nil, err
+			}
+
+			step.FailOnStatus(s.Retry.RetryOnStatus...)
+			return wfexec.Retry(step, policy), nil
 		}
 	}
 }
@@ -509,6 +523,49 @@ func (svc workflowConverter) convDelayStep(s *types.WorkflowStep) (wfexec.Step,
 	return types.DelayStep(s.Arguments), nil
 }
 
+// converts wait-event definition to wfexec.Step
+//
+// Expects max TWO outgoing paths; when there are two, the first one
+// is followed when event is received and the second one on timeout
+func (svc workflowConverter) convWaitEventStep(g *wfexec.Graph, s *types.WorkflowStep, out []*types.WorkflowPath) (wfexec.Step, error) {
+	var (
+		next, timeout         wfexec.Step
+		correlate, constraint *types.Expr
+
+		parse = func(src string) (*types.Expr, error) {
+			if len(strings.TrimSpace(src)) == 0 {
+				return nil, nil
+			}
+
+			e := &types.Expr{Expr: src}
+			return e, svc.parser.ParseEvaluators(e)
+		}
+	)
+
+	if s.Event == nil {
+		return nil, fmt.Errorf("wait-event step expects event")
+	}
+
+	if len(out) == 2 {
+		next, timeout = g.StepByID(out[0].ChildID), g.StepByID(out[1].ChildID)
+		if next == nil || timeout == nil {
+			// wait for steps to be resolved
+			return nil, nil
+		}
+	}
+
+	var err error
+	if correlate, err = parse(s.Event.Correlate); err != nil {
+		return nil, errors.Internal("failed to parse event correlation expression: %s", err).Wrap(err)
+	}
+
+	if constraint, err = parse(s.Event.Constraint); err != nil {
+		return nil, errors.Internal("failed to parse event constraint expression: %s", err).Wrap(err)
+	}
+
+	return types.WaitEventStep(*s.Event, correlate, constraint, s.Arguments, s.Results, next, timeout), nil
+}
+
 func (svc workflowConverter) convBreakStep() (wfexec.Step, error) {
 	return wfexec.NewGenericStep(func(ctx context.Context, r *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
 		return wfexec.LoopBreak(), nil
@@ -865,6 +922,35 @@ func verifyStep(s *types.WorkflowStep, in, out types.WorkflowPathSet) types.Work
 			count(0, 1, outbound),
 		)
 
+	case types.WorkflowStepKindWaitEvent:
+		checks = append(checks,
+			noRef,
+			checkArg(types.WaitEventArgTimeout, expr.Duration{}),
+			count(0, 2, arguments),
+			count(0, 2, outbound),
+			func() error {
+				if s.Event == nil {
+					return errors.Internal("%s step expects event", s.Kind)
+				}
+
+				if err := s.Event.Validate(); err != nil {
+					return err
+				}
+
+				hasKey := types.ExprSet(s.Arguments).GetByTarget(types.WaitEventArgKey) != nil
+				if hasKey != (len(strings.TrimSpace(s.Event.Correlate)) > 0) {
+					return errors.Internal("%s step expects both correlation key and expression or none", s.Kind)
+				}
+
+				hasTimeout := types.ExprSet(s.Arguments).GetByTarget(types.WaitEventArgTimeout) != nil
+				if !hasTimeout && len(out) == 2 {
+					return errors.Internal("%s step expects timeout argument for the timeout path", s.Kind)
+				}
+
+				return nil
+			},
+		)
+
 	case "":
 		return ii.Append(fmt.Errorf("missing step kind"), nil)
 
@@ -873,6 +959,16 @@ func verifyStep(s *types.WorkflowStep, in, out types.WorkflowPathSet) types.Work
 
 	}
 
+	if s.Retry != nil {
+		checks = append(checks, func() error {
+			if s.Kind != types.WorkflowStepKindFunction {
+				return errors.Internal("%s step does not support retry policy", s.Kind)
+			}
+
+			return s.Retry.Validate()
+		})
+	}
+
 	for _, check := range checks {
 		if err := check(); err != nil {
 			ii = ii.Append(err, nil)
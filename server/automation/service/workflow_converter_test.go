@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/automation/automation"
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWorkflowConverter_retryOnStatus(t *testing.T) {
+	var (
+		reg = Registry()
+
+		conv = &workflowConverter{
+			reg:    reg,
+			parser: expr.NewParser(),
+			log:    zap.NewNop(),
+		}
+
+		run = func(t *testing.T, unavailable int32, retry *types.WorkflowStepRetry) (ses *wfexec.Session, requests int32) {
+			var (
+				req = require.New(t)
+				ctx = context.Background()
+
+				srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if atomic.AddInt32(&requests, 1) <= unavailable {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						return
+					}
+
+					w.WriteHeader(http.StatusOK)
+				}))
+
+				wf = &types.Workflow{Steps: types.WorkflowStepSet{{
+					ID:   1,
+					Kind: types.WorkflowStepKindFunction,
+					Ref:  "httpRequestSend",
+					Arguments: types.ExprSet{
+						{Target: "url", Type: "String", Value: srv.URL},
+						{Target: "method", Type: "String", Value: "GET"},
+					},
+					Results: types.ExprSet{
+						{Target: "status", Expr: "statusCode"},
+					},
+					Retry: retry,
+				}}}
+			)
+
+			defer srv.Close()
+
+			g, ii := conv.makeGraph(wf)
+			req.Empty(ii)
+
+			ctx, cancelFn := context.WithTimeout(ctx, 5*time.Second)
+			defer cancelFn()
+
+			ses = wfexec.NewSession(ctx, g, wfexec.SetWorkerIntervalSuspended(time.Millisecond))
+			req.NoError(ses.Exec(ctx, g.StepByID(1), nil))
+			_ = ses.WaitUntil(ctx, wfexec.SessionFailed, wfexec.SessionCompleted)
+
+			return ses, atomic.LoadInt32(&requests)
+		}
+	)
+
+	// function steps are checked against workflow service options
+	defer func(wf *workflow) { DefaultWorkflow = wf }(DefaultWorkflow)
+	DefaultWorkflow = &workflow{}
+
+	reg.AddTypes(
+		&expr.Any{},
+		&expr.Boolean{},
+		&expr.Integer{},
+		&expr.String{},
+		&expr.Duration{},
+		&expr.KV{},
+		&expr.KVV{},
+		&expr.Reader{},
+		&expr.Vars{},
+	)
+
+	automation.HttpRequestHandler(reg)
+
+	t.Run("retried until available", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, requests := run(t, 2, &types.WorkflowStepRetry{
+			MaxAttempts:   3,
+			Backoff:       "constant",
+			Delay:         "1ms",
+			RetryOn:       []string{"timeout"},
+			RetryOnStatus: []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+		})
+
+		req.NoError(ses.Error())
+		req.Equal(wfexec.SessionCompleted, ses.Status())
+		req.Equal(int32(3), requests)
+		req.Equal(int64(http.StatusOK), expr.Must(expr.Select(ses.Result(), "status")).Get())
+	})
+
+	t.Run("fails when attempts are exhausted", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, requests := run(t, 5, &types.WorkflowStepRetry{
+			MaxAttempts:   3,
+			Backoff:       "constant",
+			Delay:         "1ms",
+			RetryOnStatus: []int{http.StatusServiceUnavailable},
+		})
+
+		req.Error(ses.Error())
+		req.Contains(ses.Error().Error(), "returned status code 503")
+		req.Equal(wfexec.SessionFailed, ses.Status())
+		req.Equal(int32(3), requests)
+	})
+
+	t.Run("status is not retried when not configured", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, requests := run(t, 5, &types.WorkflowStepRetry{
+			MaxAttempts: 3,
+			Backoff:     "constant",
+			Delay:       "1ms",
+		})
+
+		req.NoError(ses.Error())
+		req.Equal(int32(1), requests)
+		req.Equal(int64(http.StatusServiceUnavailable), expr.Must(expr.Select(ses.Result(), "status")).Get())
+	})
+}
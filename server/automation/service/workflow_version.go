@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza/server/automation/types"
+	intAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"github.com/cortezaproject/corteza/server/store"
+)
+
+// Publish stores current workflow definition (draft) as a new immutable version
+// and makes it the version that is executed when workflow is triggered
+//
+// Sessions that are already running keep running the version they started with.
+func (svc *workflow) Publish(ctx context.Context, workflowID uint64, description string) (v *types.WorkflowVersion, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var wf *types.Workflow
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanUpdateWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToPublish()
+		}
+
+		v, err = svc.publish(ctx, s, wf, description)
+		return
+	})
+
+	if v != nil {
+		wap.setVersion(v)
+	}
+
+	return v, svc.recordAction(ctx, wap, WorkflowActionPublish, err)
+}
+
+// Rollback copies definition of the given version to the draft and publishes it as a new version
+//
+// Versions are never modified, rollback always results in a new version
+func (svc *workflow) Rollback(ctx context.Context, workflowID uint64, version uint) (v *types.WorkflowVersion, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var (
+			wf  *types.Workflow
+			old *types.WorkflowVersion
+		)
+
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanUpdateWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToPublish()
+		}
+
+		if old, err = loadWorkflowVersion(ctx, s, workflowID, version); err != nil {
+			return
+		}
+
+		wap.setVersion(old)
+
+		wf.Scope = old.Scope
+		wf.Steps = old.Steps
+		wf.Paths = old.Paths
+		wf.UpdatedAt = now()
+		wf.UpdatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+
+		v, err = svc.publish(ctx, s, wf, fmt.Sprintf("rollback to version %d", old.Version))
+		return
+	})
+
+	return v, svc.recordAction(ctx, wap, WorkflowActionRollback, err)
+}
+
+// Versions returns all published versions of the workflow, latest first
+func (svc *workflow) Versions(ctx context.Context, workflowID uint64) (vv types.WorkflowVersionSet, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var wf *types.Workflow
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanReadWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToRead()
+		}
+
+		vv, _, err = store.SearchAutomationWorkflowVersions(ctx, s, types.WorkflowVersionFilter{
+			WorkflowID: []uint64{workflowID},
+			Sorting: filter.Sorting{Sort: filter.SortExprSet{
+				&filter.SortExpr{Column: "version", Descending: true},
+			}},
+		})
+
+		return
+	})
+
+	return vv, svc.recordAction(ctx, wap, WorkflowActionLookup, err)
+}
+
+// LookupVersion returns one published version of the workflow
+func (svc *workflow) LookupVersion(ctx context.Context, workflowID uint64, version uint) (v *types.WorkflowVersion, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var wf *types.Workflow
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanReadWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToRead()
+		}
+
+		if v, err = loadWorkflowVersion(ctx, s, workflowID, version); err != nil {
+			return
+		}
+
+		wap.setVersion(v)
+		return
+	})
+
+	return v, svc.recordAction(ctx, wap, WorkflowActionLookup, err)
+}
+
+// Diff compares two versions of the workflow
+//
+// Version 0 stands for the current draft
+func (svc *workflow) Diff(ctx context.Context, workflowID uint64, from, to uint) (d *types.WorkflowVersionDiff, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var (
+			wf       *types.Workflow
+			fromV    *types.WorkflowVersion
+			toV      *types.WorkflowVersion
+			loadByNo = func(version uint) (*types.WorkflowVersion, error) {
+				if version == 0 {
+					return wf.Draft(), nil
+				}
+
+				return loadWorkflowVersion(ctx, s, workflowID, version)
+			}
+		)
+
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanReadWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToRead()
+		}
+
+		if fromV, err = loadByNo(from); err != nil {
+			return
+		}
+
+		if toV, err = loadByNo(to); err != nil {
+			return
+		}
+
+		d = fromV.Diff(toV)
+		return
+	})
+
+	return d, svc.recordAction(ctx, wap, WorkflowActionLookup, err)
+}
+
+// publish validates the draft, stores it as the next version and
+// swaps the live (cached & registered) workflow
+func (svc *workflow) publish(ctx context.Context, s store.Storer, wf *types.Workflow, description string) (v *types.WorkflowVersion, err error) {
+	var (
+		vv    types.WorkflowVersionSet
+		g     *wfexec.Graph
+		runAs intAuth.Identifiable
+	)
+
+	if g, runAs, err = svc.validateWorkflow(ctx, wf); err != nil {
+		return
+	}
+
+	if len(wf.Issues) > 0 {
+		// refusing to publish workflow with issues
+		return nil, wf.Issues
+	}
+
+	vv, _, err = store.SearchAutomationWorkflowVersions(ctx, s, types.WorkflowVersionFilter{
+		WorkflowID: []uint64{wf.ID},
+		Sorting: filter.Sorting{Sort: filter.SortExprSet{
+			&filter.SortExpr{Column: "version", Descending: true},
+		}},
+		Paging: filter.Paging{Limit: 1},
+	})
+
+	if err != nil {
+		return
+	}
+
+	v = wf.Draft()
+	v.ID = nextID()
+	v.Version = 1
+	v.Description = description
+	v.CreatedAt = *now()
+	v.CreatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+
+	if len(vv) > 0 {
+		v.Version = vv[0].Version + 1
+	}
+
+	if err = store.CreateAutomationWorkflowVersion(ctx, s, v); err != nil {
+		return
+	}
+
+	wf.PublishedVersion = v.Version
+	if err = store.UpdateAutomationWorkflow(ctx, s, wf); err != nil {
+		return
+	}
+
+	svc.updateCache(wf, runAs, g)
+	if err = svc.triggers.registerWorkflows(ctx, wf); err != nil {
+		return
+	}
+
+	return
+}
+
+// published returns validated live view of the published workflow
+func (svc *workflow) published(ctx context.Context, s store.Storer, wf *types.Workflow) (live *types.Workflow, g *wfexec.Graph, runAs intAuth.Identifiable, err error) {
+	if live, err = publishedWorkflow(ctx, s, wf); err != nil {
+		return
+	}
+
+	g, runAs, err = svc.validateWorkflow(ctx, live)
+	return
+}
+
+// publishedWorkflow returns workflow with definition of the published version
+//
+// Workflows that were never published are returned as they are
+func publishedWorkflow(ctx context.Context, s store.Storer, wf *types.Workflow) (*types.Workflow, error) {
+	if wf.PublishedVersion == 0 {
+		return wf, nil
+	}
+
+	v, err := loadWorkflowVersion(ctx, s, wf.ID, wf.PublishedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Apply(wf), nil
+}
+
+func loadWorkflowVersion(ctx context.Context, s store.Storer, workflowID uint64, version uint) (res *types.WorkflowVersion, err error) {
+	if workflowID == 0 {
+		return nil, WorkflowErrInvalidID()
+	}
+
+	if res, err = store.LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx, s, workflowID, version); errors.IsNotFound(err) {
+		return nil, WorkflowErrVersionNotFound()
+	}
+
+	return
+}
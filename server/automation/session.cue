@@ -22,6 +22,10 @@ session: {
 				storeIdent: "rel_workflow"
 				dal: { type: "Ref", refModelResType: "corteza::automation:workflow" }
 			}
+			workflow_version: {
+				goType: "uint"
+				dal: { type: "Number", default: 0, meta: { "rdbms:type": "integer" } }
+			}
 			status: {
 				sortable: true,
 				goType: "types.SessionStatus"
@@ -57,6 +61,12 @@ session: {
 				omitSetter: true
 				omitGetter: true
 			}
+			state: {
+				goType: "*types.SessionState"
+				dal: { type: "JSON", nullable: true }
+				omitSetter: true
+				omitGetter: true
+			}
 
 			created_by: schema.AttributeUserRef
 			created_at: schema.SortableTimestampNowField
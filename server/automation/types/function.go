@@ -3,8 +3,10 @@ package types
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/errors"
 	"github.com/cortezaproject/corteza/server/pkg/expr"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/pkg/wfexec"
@@ -41,6 +43,9 @@ type (
 		def       *Function
 		arguments ExprSet
 		results   ExprSet
+
+		// status codes (statusCode result) that fail the step
+		failOnStatus map[int64]bool
 	}
 
 	iteratorStep struct {
@@ -56,6 +61,9 @@ type (
 const (
 	FunctionKindFunction = "function"
 	FunctionKindIterator = "iterator"
+
+	// function result with the status code of the response (like HTTP request)
+	functionStatusResult = "statusCode"
 )
 
 // FunctionStep initializes new function step with function definition and configured arguments and results
@@ -111,6 +119,10 @@ func (f functionStep) Exec(ctx context.Context, r *wfexec.ExecRequest) (wfexec.E
 		return nil, err
 	}
 
+	if err = f.checkStatus(results); err != nil {
+		return nil, err
+	}
+
 	if len(f.results) == 0 {
 		// No results defined, nothing to return
 		return expr.NewVars(nil)
@@ -124,6 +136,47 @@ func (f functionStep) Exec(ctx context.Context, r *wfexec.ExecRequest) (wfexec.E
 	return results, nil
 }
 
+// FailOnStatus makes function step fail when function returns one of the given status codes
+//
+// Status code is read from the statusCode result of the function (like HTTP request)
+func (f *functionStep) FailOnStatus(codes ...int) {
+	if len(codes) == 0 {
+		f.failOnStatus = nil
+		return
+	}
+
+	f.failOnStatus = make(map[int64]bool, len(codes))
+	for _, c := range codes {
+		f.failOnStatus[int64(c)] = true
+	}
+}
+
+// checkStatus returns an error when function returned one of the failing status codes
+//
+// Results of the failed function are discarded and readers (response body) closed
+func (f functionStep) checkStatus(results *expr.Vars) error {
+	if len(f.failOnStatus) == 0 || results == nil || !results.Has(functionStatusResult) {
+		return nil
+	}
+
+	vv := results.GetValue()
+
+	code, err := expr.CastToInteger(vv[functionStatusResult].Get())
+	if err != nil || !f.failOnStatus[code] {
+		return nil
+	}
+
+	for _, v := range vv {
+		if c, is := v.Get().(io.Closer); is {
+			_ = c.Close()
+		}
+	}
+
+	return errors.
+		External("function %s returned status code %d", f.def.Ref, code).
+		Apply(errors.Meta(functionStatusResult, code))
+}
+
 // IteratorStep initializes new iterator step with function (iterator) and other parameters
 //
 // Pointers to next and exit steps are are given and then passed on to the iterator
@@ -36,6 +36,8 @@ func (r *Workflow) GetValue(name string, pos uint) (any, error) {
 		return r.KeepSessions, nil
 	case "ownedBy", "OwnedBy":
 		return r.OwnedBy, nil
+	case "publishedVersion", "PublishedVersion":
+		return r.PublishedVersion, nil
 	case "runAs", "RunAs":
 		return r.RunAs, nil
 	case "trace", "Trace":
@@ -73,6 +75,8 @@ func (r *Workflow) SetValue(name string, pos uint, value any) (err error) {
 		return cast2.Int(value, &r.KeepSessions)
 	case "ownedBy", "OwnedBy":
 		return cast2.Uint64(value, &r.OwnedBy)
+	case "publishedVersion", "PublishedVersion":
+		return cast2.Uint(value, &r.PublishedVersion)
 	case "runAs", "RunAs":
 		return cast2.Uint64(value, &r.RunAs)
 	case "trace", "Trace":
@@ -114,6 +118,8 @@ func (r *Session) GetValue(name string, pos uint) (any, error) {
 		return r.SuspendedAt, nil
 	case "workflowID", "WorkflowID":
 		return r.WorkflowID, nil
+	case "workflowVersion", "WorkflowVersion":
+		return r.WorkflowVersion, nil
 
 	}
 	return nil, nil
@@ -145,6 +151,8 @@ func (r *Session) SetValue(name string, pos uint, value any) (err error) {
 		return cast2.TimePtr(value, &r.SuspendedAt)
 	case "workflowID", "WorkflowID":
 		return cast2.Uint64(value, &r.WorkflowID)
+	case "workflowVersion", "WorkflowVersion":
+		return cast2.Uint(value, &r.WorkflowVersion)
 
 	}
 	return nil
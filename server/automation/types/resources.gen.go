@@ -7,8 +7,9 @@ package types
 //
 
 const (
-	WorkflowResourceType  = "corteza::automation:workflow"
-	SessionResourceType   = "corteza::automation:session"
-	TriggerResourceType   = "corteza::automation:trigger"
-	ComponentResourceType = "corteza::automation"
+	WorkflowResourceType        = "corteza::automation:workflow"
+	WorkflowVersionResourceType = "corteza::automation:workflow-version"
+	SessionResourceType         = "corteza::automation:session"
+	TriggerResourceType         = "corteza::automation:trigger"
+	ComponentResourceType       = "corteza::automation"
 )
@@ -27,6 +27,10 @@ type (
 		ID         uint64 `json:"sessionID,string"`
 		WorkflowID uint64 `json:"workflowID,string"`
 
+		// Version of the workflow session was started with;
+		// 0 when started with the draft
+		WorkflowVersion uint `json:"workflowVersion"`
+
 		Status SessionStatus `json:"status,string"`
 
 		EventType    string `json:"eventType"`
@@ -48,8 +52,18 @@ type (
 		CompletedAt *time.Time `json:"completedAt,omitempty"`
 		Error       string     `json:"error,omitempty"`
 
+		// State of the suspended session
+		//
+		// Used to resume the session after restart
+		State *SessionState `json:"-"`
+
 		session *wfexec.Session
 
+		// identities session is executed with;
+		// kept so that they can be stored with the state
+		runner  auth.Identifiable
+		invoker auth.Identifiable
+
 		runtimeOpts runtimeOptions `json:"-"`
 
 		// For keeping runtime stacktrace,
@@ -75,13 +89,14 @@ type (
 		// Optional, (alternative) user that is running the workflow
 		Runner auth.Identifiable
 
-		WorkflowID   uint64
-		KeepFor      int
-		Trace        bool
-		Input        *expr.Vars
-		StepID       uint64
-		EventType    string
-		ResourceType string
+		WorkflowID      uint64
+		WorkflowVersion uint
+		KeepFor         int
+		Trace           bool
+		Input           *expr.Vars
+		StepID          uint64
+		EventType       string
+		ResourceType    string
 
 		CallStack []uint64
 	}
@@ -109,6 +124,17 @@ type (
 
 	Stacktrace []*wfexec.Frame
 
+	// SessionState holds serialized state of the session
+	// and identities it was executed with
+	SessionState struct {
+		RunnerID     uint64   `json:"runnerID,string"`
+		RunnerRoles  []uint64 `json:"runnerRoles,omitempty"`
+		InvokerID    uint64   `json:"invokerID,string"`
+		InvokerRoles []uint64 `json:"invokerRoles,omitempty"`
+
+		Snapshot *wfexec.SessionSnapshot `json:"snapshot"`
+	}
+
 	SessionStatus uint
 )
 
@@ -144,6 +170,14 @@ func (s *Session) Resume(ctx context.Context, stateID uint64, input *expr.Vars)
 	return s.session.Resume(ctx, stateID, input)
 }
 
+func (s *Session) Deliver(ctx context.Context, stateID uint64, input *expr.Vars) error {
+	return s.session.Deliver(ctx, stateID, input)
+}
+
+func (s *Session) Awaiting() []*wfexec.AwaitingState {
+	return s.session.Awaiting()
+}
+
 func (s *Session) Cancel() {
 	s.session.Cancel()
 	s.Status = SessionCanceled
@@ -179,6 +213,9 @@ func (s *Session) Apply(ssp SessionStartParams) {
 	defer s.l.Unlock()
 
 	s.WorkflowID = ssp.WorkflowID
+	s.WorkflowVersion = ssp.WorkflowVersion
+	s.runner = ssp.Runner
+	s.invoker = ssp.Invoker
 	s.EventType = ssp.EventType
 	s.ResourceType = ssp.ResourceType
 	s.Input = ssp.Input
@@ -195,6 +232,42 @@ func (s *Session) Apply(ssp SessionStartParams) {
 	}
 }
 
+// CaptureState takes the snapshot of the running session and sets it as session state
+func (s *Session) CaptureState() error {
+	ss, err := s.session.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	s.State = &SessionState{Snapshot: ss}
+
+	if s.runner != nil {
+		s.State.RunnerID, s.State.RunnerRoles = s.runner.Identity(), s.runner.Roles()
+	}
+
+	if s.invoker != nil {
+		s.State.InvokerID, s.State.InvokerRoles = s.invoker.Identity(), s.invoker.Roles()
+	}
+
+	return nil
+}
+
+// Restore attaches session restored from the session state
+func (s *Session) Restore(ses *wfexec.Session) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	s.session = ses
+	s.runner = s.State.Runner()
+	s.invoker = s.State.Invoker()
+
+	// continue with the stored stacktrace
+	s.RuntimeStacktrace = s.Stacktrace
+}
+
 func (s *Session) AppendRuntimeStacktrace(frame *wfexec.Frame) {
 	if s.runtimeOpts.disableStacktrace {
 		return
@@ -270,6 +343,24 @@ func (s *Session) CopyRuntimeStacktrace() {
 	}
 }
 
+func (s *SessionState) Runner() auth.Identifiable {
+	return auth.Authenticated(s.RunnerID, s.RunnerRoles...)
+}
+
+func (s *SessionState) Invoker() auth.Identifiable {
+	return auth.Authenticated(s.InvokerID, s.InvokerRoles...)
+}
+
+func (s *SessionState) Scan(src any) error { return sql.ParseJSON(src, s) }
+
+func (s *SessionState) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(s)
+}
+
 func (set *Stacktrace) Scan(src any) error          { return sql.ParseJSON(src, set) }
 func (set Stacktrace) Value() (driver.Value, error) { return json.Marshal(set) }
 
@@ -25,6 +25,12 @@ type (
 		// only valid when kind=function
 		Results []*Expr `json:"results"`
 
+		// only valid when kind=function
+		Retry *WorkflowStepRetry `json:"retry,omitempty"`
+
+		// only valid when kind=wait-event
+		Event *WorkflowStepEvent `json:"event,omitempty"`
+
 		Meta WorkflowStepMeta `json:"meta,omitempty"`
 
 		Labels map[string]string `json:"labels,omitempty"`
@@ -72,20 +78,23 @@ const (
 	WorkflowStepKindBreak        WorkflowStepKind = "break"         // ref = <*>
 	WorkflowStepKindContinue     WorkflowStepKind = "continue"      // ref = <*>
 	WorkflowStepKindExecWorkflow WorkflowStepKind = "exec-workflow" // no ref
+	WorkflowStepKindWaitEvent    WorkflowStepKind = "wait-event"    // no ref
 )
 
-// IsDeferred fn returns true if type of step is delay or prompt
+// IsDeferred fn returns true if type of step is delay, prompt or wait-event
 func (s WorkflowStep) IsDeferred() (is bool) {
 	switch s.Kind {
 	case WorkflowStepKindPrompt:
 		return true
 	case WorkflowStepKindDelay:
 		return true
+	case WorkflowStepKindWaitEvent:
+		return true
 	}
 	return false
 }
 
-// HasDeferred fn returns true if wf-step is delay or prompt
+// HasDeferred fn returns true if wf-step is delay, prompt or wait-event
 func (vv WorkflowStepSet) HasDeferred() bool {
 	for _, s := range vv {
 		if s.IsDeferred() {
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+)
+
+type (
+	// WorkflowStepRetry declares how failed function step is retried
+	WorkflowStepRetry struct {
+		// Total number of attempts, including the first one
+		MaxAttempts uint `json:"maxAttempts"`
+
+		// constant, linear or exponential (default)
+		Backoff string `json:"backoff,omitempty"`
+
+		// Delay before the first retry and upper limit of the delay
+		// between attempts, both as duration strings (500ms, 10s, 1m)
+		Delay    string `json:"delay,omitempty"`
+		MaxDelay string `json:"maxDelay,omitempty"`
+
+		// Random portion of the delay (0-1)
+		Jitter float64 `json:"jitter,omitempty"`
+
+		// Error classes that are retried; all errors are retried when empty
+		RetryOn []string `json:"retryOn,omitempty"`
+
+		// Status codes returned by the function (statusCode result, like HTTP request)
+		// that are retried; step fails with the last status when out of attempts
+		RetryOnStatus []int `json:"retryOnStatus,omitempty"`
+	}
+)
+
+const (
+	workflowStepRetryMaxAttempts  = 100
+	workflowStepRetryDefaultDelay = time.Second
+)
+
+var (
+	// error classes that can be used in retryOn
+	workflowStepRetryClasses = map[string]func(error) bool{
+		"timeout": func(err error) bool {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return true
+			}
+
+			var nErr net.Error
+			return errors.As(err, &nErr) && nErr.Timeout()
+		},
+		"network": func(err error) bool {
+			var nErr net.Error
+			return errors.As(err, &nErr)
+		},
+
+		"internal":        retryOnKind(errors.IsInternal),
+		"invalidData":     retryOnKind(errors.IsInvalidData),
+		"notFound":        retryOnKind(errors.IsNotFound),
+		"staleData":       retryOnKind(errors.IsStaleData),
+		"duplicateData":   retryOnKind(errors.IsDuplicateData),
+		"unauthorized":    retryOnKind(errors.IsUnauthorized),
+		"unauthenticated": retryOnKind(errors.IsUnauthenticated),
+		"external":        retryOnKind(errors.IsExternal),
+		"store":           retryOnKind(errors.IsStore),
+		"objStore":        retryOnKind(errors.IsObjStore),
+		"automation":      retryOnKind(errors.IsAutomation),
+	}
+)
+
+// Validate checks retry policy for invalid values
+func (r WorkflowStepRetry) Validate() error {
+	_, err := r.Policy()
+	return err
+}
+
+// Policy converts retry declaration to the retry policy used by the workflow runtime
+func (r WorkflowStepRetry) Policy() (p *wfexec.RetryPolicy, err error) {
+	if r.MaxAttempts < 1 || r.MaxAttempts > workflowStepRetryMaxAttempts {
+		return nil, errors.InvalidData("retry max attempts must be between 1 and %d", workflowStepRetryMaxAttempts)
+	}
+
+	if r.Jitter < 0 || r.Jitter > 1 {
+		return nil, errors.InvalidData("retry jitter must be between 0 and 1")
+	}
+
+	p = &wfexec.RetryPolicy{
+		MaxAttempts: r.MaxAttempts,
+		Backoff:     wfexec.Backoff(r.Backoff),
+		Delay:       workflowStepRetryDefaultDelay,
+		Jitter:      r.Jitter,
+	}
+
+	switch p.Backoff {
+	case "", wfexec.BackoffConstant, wfexec.BackoffLinear, wfexec.BackoffExponential:
+	default:
+		return nil, errors.InvalidData("unknown retry backoff %q", r.Backoff)
+	}
+
+	if r.Delay != "" {
+		if p.Delay, err = parseRetryDuration(r.Delay); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.MaxDelay != "" {
+		if p.MaxDelay, err = parseRetryDuration(r.MaxDelay); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range r.RetryOnStatus {
+		if s < 100 || s > 599 {
+			return nil, errors.InvalidData("invalid retry status code %d", s)
+		}
+	}
+
+	if len(r.RetryOn) > 0 {
+		mm := make([]func(error) bool, len(r.RetryOn))
+		for i, c := range r.RetryOn {
+			if mm[i] = workflowStepRetryClasses[c]; mm[i] == nil {
+				return nil, errors.InvalidData("unknown retry error class %q", c)
+			}
+		}
+
+		if len(r.RetryOnStatus) > 0 {
+			mm = append(mm, isFunctionStatusError)
+		}
+
+		p.Retryable = func(err error) bool {
+			for _, m := range mm {
+				if m(err) {
+					return true
+				}
+			}
+
+			return false
+		}
+	}
+
+	return p, nil
+}
+
+func parseRetryDuration(s string) (d time.Duration, err error) {
+	if d, err = time.ParseDuration(s); err != nil {
+		return 0, errors.InvalidData("invalid retry delay %q", s).Wrap(err)
+	}
+
+	if d < 0 {
+		return 0, errors.InvalidData("invalid retry delay %q", s)
+	}
+
+	return
+}
+
+// isFunctionStatusError matches error returned by the function
+// step that failed on one of the retried status codes
+func isFunctionStatusError(err error) bool {
+	var t *errors.Error
+	return errors.As(err, &t) && t.Meta()[functionStatusResult] != nil
+}
+
+// retryOnKind matches error of the given kind on the error or any error it wraps
+func retryOnKind(is func(error) bool) func(error) bool {
+	return func(err error) bool {
+		var t *errors.Error
+		return errors.As(err, &t) && is(t)
+	}
+}
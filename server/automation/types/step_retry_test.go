@@ -0,0 +1,88 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStepRetry_Policy(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		req := require.New(t)
+
+		p, err := WorkflowStepRetry{
+			MaxAttempts: 5,
+			Backoff:     "linear",
+			Delay:       "500ms",
+			MaxDelay:    "1m",
+			Jitter:      .2,
+		}.Policy()
+
+		req.NoError(err)
+		req.Equal(uint(5), p.MaxAttempts)
+		req.Equal(wfexec.BackoffLinear, p.Backoff)
+		req.Equal(500*time.Millisecond, p.Delay)
+		req.Equal(time.Minute, p.MaxDelay)
+		req.Nil(p.Retryable)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, r := range []WorkflowStepRetry{
+			{},
+			{MaxAttempts: 1000},
+			{MaxAttempts: 3, Backoff: "fibonacci"},
+			{MaxAttempts: 3, Delay: "soon"},
+			{MaxAttempts: 3, MaxDelay: "-1s"},
+			{MaxAttempts: 3, Jitter: 2},
+			{MaxAttempts: 3, RetryOn: []string{"anything"}},
+			{MaxAttempts: 3, RetryOnStatus: []int{42}},
+		} {
+			require.Error(t, r.Validate(), "%+v", r)
+		}
+	})
+
+	t.Run("retry on", func(t *testing.T) {
+		req := require.New(t)
+
+		p, err := WorkflowStepRetry{MaxAttempts: 3, RetryOn: []string{"timeout", "external"}}.Policy()
+		req.NoError(err)
+
+		req.True(p.Retryable(context.DeadlineExceeded))
+		req.True(p.Retryable(fmt.Errorf("wrapped: %w", errors.External("failed"))))
+		req.False(p.Retryable(errors.InvalidData("failed")))
+		req.False(p.Retryable(fmt.Errorf("failed")))
+	})
+
+	t.Run("retry on status", func(t *testing.T) {
+		req := require.New(t)
+
+		p, err := WorkflowStepRetry{MaxAttempts: 3, RetryOn: []string{"timeout"}, RetryOnStatus: []int{503}}.Policy()
+		req.NoError(err)
+
+		var (
+			f = &functionStep{def: &Function{Ref: "fn"}}
+
+			status = func(code int) *expr.Vars {
+				vv := &expr.Vars{}
+				req.NoError(vv.Set("statusCode", expr.Must(expr.NewInteger(code))))
+				return vv
+			}
+		)
+
+		f.FailOnStatus(503)
+
+		req.NoError(f.checkStatus(status(200)))
+
+		err = f.checkStatus(status(503))
+		req.Error(err)
+		req.True(p.Retryable(err))
+		req.True(p.Retryable(context.DeadlineExceeded))
+		req.False(p.Retryable(errors.External("failed")))
+	})
+}
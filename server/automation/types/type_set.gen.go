@@ -49,6 +49,11 @@ type (
 	//
 	// This type is auto-generated.
 	WorkflowStepSet []*WorkflowStep
+
+	// WorkflowVersionSet slice of WorkflowVersion
+	//
+	// This type is auto-generated.
+	WorkflowVersionSet []*WorkflowVersion
 )
 
 // Walk iterates through every slice item and calls w(Session) err
@@ -420,3 +425,59 @@ func (set WorkflowStepSet) IDs() (IDs []uint64) {
 
 	return
 }
+
+// Walk iterates through every slice item and calls w(WorkflowVersion) err
+//
+// This function is auto-generated.
+func (set WorkflowVersionSet) Walk(w func(*WorkflowVersion) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(WorkflowVersion) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WorkflowVersionSet) Filter(f func(*WorkflowVersion) (bool, error)) (out WorkflowVersionSet, err error) {
+	var ok bool
+	out = WorkflowVersionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set WorkflowVersionSet) FindByID(ID uint64) *WorkflowVersion {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set WorkflowVersionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
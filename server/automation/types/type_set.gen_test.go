@@ -631,3 +631,93 @@ func TestWorkflowStepSetIDs(t *testing.T) {
 		req.Equal(len(val), len(value))
 	}
 }
+
+func TestWorkflowVersionSetWalk(t *testing.T) {
+	var (
+		value = make(WorkflowVersionSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*WorkflowVersion) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*WorkflowVersion) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWorkflowVersionSetFilter(t *testing.T) {
+	var (
+		value = make(WorkflowVersionSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*WorkflowVersion) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*WorkflowVersion) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*WorkflowVersion) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestWorkflowVersionSetIDs(t *testing.T) {
+	var (
+		value = make(WorkflowVersionSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(WorkflowVersion)
+	value[1] = new(WorkflowVersion)
+	value[2] = new(WorkflowVersion)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+)
+
+type (
+	// WorkflowStepEvent describes event the wait-event step is waiting for
+	WorkflowStepEvent struct {
+		ResourceType string `json:"resourceType"`
+		EventType    string `json:"eventType"`
+
+		// Expression evaluated over the event payload; result is
+		// compared with the correlation key of the waiting session
+		Correlate string `json:"correlate,omitempty"`
+
+		// Expression evaluated over the scope of the waiting session
+		// merged with the event payload; event is accepted when true
+		Constraint string `json:"constraint,omitempty"`
+	}
+
+	// EventAwaiter is implemented by steps that wait for the event
+	EventAwaiter interface {
+		wfexec.Step
+
+		// AwaitsEvent returns resource and event type of the awaited event
+		AwaitsEvent() (resourceType, eventType string)
+
+		// Correlate returns correlation key of the event
+		Correlate(ctx context.Context, payload *expr.Vars) (string, error)
+
+		// Accepts returns true when the waiting session accepts the event
+		Accepts(ctx context.Context, scope, payload *expr.Vars) (bool, error)
+	}
+
+	waitEventStep struct {
+		wfexec.StepIdentifier
+		event      WorkflowStepEvent
+		correlate  *Expr
+		constraint *Expr
+		args       ExprSet
+		results    ExprSet
+
+		// next step when event is received and step on timeout
+		next    wfexec.Step
+		timeout wfexec.Step
+
+		now func() time.Time
+	}
+)
+
+const (
+	WaitEventArgKey     = "key"
+	WaitEventArgTimeout = "timeout"
+)
+
+// Validate checks if event is properly described
+func (e WorkflowStepEvent) Validate() error {
+	if e.ResourceType == "" {
+		return errors.InvalidData("wait-event step expects resource type")
+	}
+
+	if e.EventType == "" {
+		return errors.InvalidData("wait-event step expects event type")
+	}
+
+	return nil
+}
+
+// WaitEventStep creates a step that suspends the session until the event is delivered
+//
+// Correlate and constraint expressions are optional and need to be parsed.
+// When timeout step is set, session continues with it when event is not
+// received in time; without it, step fails on timeout.
+func WaitEventStep(event WorkflowStepEvent, correlate, constraint *Expr, args, results ExprSet, next, timeout wfexec.Step) *waitEventStep {
+	return &waitEventStep{
+		event:      event,
+		correlate:  correlate,
+		constraint: constraint,
+		args:       args,
+		results:    results,
+		next:       next,
+		timeout:    timeout,
+		now:        func() time.Time { return time.Now() },
+	}
+}
+
+func (s waitEventStep) AwaitsEvent() (resourceType, eventType string) {
+	return s.event.ResourceType, s.event.EventType
+}
+
+func (s waitEventStep) Correlate(ctx context.Context, payload *expr.Vars) (string, error) {
+	if s.correlate == nil {
+		return "", nil
+	}
+
+	v, err := s.correlate.Eval(ctx, payload)
+	if err != nil {
+		return "", err
+	}
+
+	return CorrelationKey(v), nil
+}
+
+func (s waitEventStep) Accepts(ctx context.Context, scope, payload *expr.Vars) (bool, error) {
+	if s.constraint == nil {
+		return true, nil
+	}
+
+	return s.constraint.Test(ctx, scope.MustMerge(payload))
+}
+
+// Exec executes wait-event step
+//
+// Step suspends the session on the first execution; session will set "resumed"
+// on input when the event is delivered or "timeout" when it did not arrive in time
+func (s waitEventStep) Exec(ctx context.Context, r *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+	switch {
+	case !r.Input.Has("resumed"):
+		return s.await(ctx, r.Scope)
+
+	case r.Input.Has("timeout"):
+		if s.timeout == nil {
+			return nil, errors.Automation(
+				"timed out waiting for %s event on %s",
+				s.event.EventType,
+				s.event.ResourceType,
+			)
+		}
+
+		return s.timeout, nil
+	}
+
+	results, err := s.results.Eval(ctx, r.Scope.MustMerge(r.Input))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.next == nil {
+		// session continues on all (one) child steps
+		return results, nil
+	}
+
+	return wfexec.Branch(s.next, results), nil
+}
+
+func (s waitEventStep) await(ctx context.Context, scope *expr.Vars) (wfexec.ExecResponse, error) {
+	var (
+		key   string
+		until time.Time
+
+		result, err = s.args.Eval(ctx, scope)
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Has(WaitEventArgKey) {
+		key = CorrelationKey(expr.Must(result.Select(WaitEventArgKey)))
+	}
+
+	if result.Has(WaitEventArgTimeout) {
+		d, err := expr.NewDuration(expr.Must(result.Select(WaitEventArgTimeout)))
+		if err != nil {
+			return nil, err
+		}
+
+		until = s.now().Add(d.GetValue())
+	}
+
+	return wfexec.Await(key, until), nil
+}
+
+// CorrelationKey converts value to the key that is used
+// to match the event with the waiting sessions
+func CorrelationKey(v interface{}) string {
+	v = expr.UntypedValue(v)
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", v)
+}
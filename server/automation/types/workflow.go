@@ -35,6 +35,10 @@ type (
 		// Collection of issues from the last parse
 		Issues WorkflowIssueSet `json:"issues,omitempty"`
 
+		// Version that is executed when workflow is triggered;
+		// when 0, workflow was never published and draft is executed
+		PublishedVersion uint `json:"publishedVersion"`
+
 		RunAs uint64 `json:"runAs,string"`
 
 		OwnedBy   uint64     `json:"ownedBy,string"`
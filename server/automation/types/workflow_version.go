@@ -0,0 +1,225 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+)
+
+type (
+	// WorkflowVersion is an immutable, published definition of the workflow
+	//
+	// Workflow itself holds the draft definition that is copied
+	// into a new version when workflow is published
+	WorkflowVersion struct {
+		ID          uint64 `json:"versionID,string"`
+		WorkflowID  uint64 `json:"workflowID,string"`
+		Version     uint   `json:"version"`
+		Description string `json:"description,omitempty"`
+
+		Scope *expr.Vars      `json:"scope"`
+		Steps WorkflowStepSet `json:"steps"`
+		Paths WorkflowPathSet `json:"paths"`
+
+		CreatedAt time.Time `json:"createdAt,omitempty"`
+		CreatedBy uint64    `json:"createdBy,string"`
+	}
+
+	WorkflowVersionFilter struct {
+		WorkflowID []uint64 `json:"workflowID"`
+		Version    []uint   `json:"version"`
+
+		// Standard helpers for paging and sorting
+		filter.Sorting
+		filter.Paging
+	}
+
+	// WorkflowChange describes a single difference between two workflow definitions
+	WorkflowChange struct {
+		// step, path or scope
+		Kind string `json:"kind"`
+
+		// added, removed or modified
+		Change string `json:"change"`
+
+		StepID   uint64 `json:"stepID,string,omitempty"`
+		ParentID uint64 `json:"parentID,string,omitempty"`
+		ChildID  uint64 `json:"childID,string,omitempty"`
+
+		Before interface{} `json:"before,omitempty"`
+		After  interface{} `json:"after,omitempty"`
+	}
+
+	WorkflowChangeSet []*WorkflowChange
+
+	// WorkflowVersionDiff holds differences between two workflow versions
+	//
+	// Version 0 stands for the draft
+	WorkflowVersionDiff struct {
+		WorkflowID uint64            `json:"workflowID,string"`
+		From       uint              `json:"from"`
+		To         uint              `json:"to"`
+		Changes    WorkflowChangeSet `json:"changes"`
+	}
+)
+
+const (
+	WorkflowChangeStep  = "step"
+	WorkflowChangePath  = "path"
+	WorkflowChangeScope = "scope"
+
+	WorkflowChangeAdded    = "added"
+	WorkflowChangeRemoved  = "removed"
+	WorkflowChangeModified = "modified"
+)
+
+// Draft returns draft definition of the workflow as version 0
+func (r Workflow) Draft() *WorkflowVersion {
+	return &WorkflowVersion{
+		WorkflowID: r.ID,
+		Scope:      r.Scope,
+		Steps:      r.Steps,
+		Paths:      r.Paths,
+	}
+}
+
+// Apply returns copy of the workflow with definition from the version
+func (v WorkflowVersion) Apply(wf *Workflow) *Workflow {
+	aux := *wf
+	aux.Scope = v.Scope
+	aux.Steps = v.Steps
+	aux.Paths = v.Paths
+	aux.Issues = nil
+	return &aux
+}
+
+// Diff compares definitions of both versions
+//
+// Steps are matched by ID and paths by parent and child step IDs
+func (v WorkflowVersion) Diff(to *WorkflowVersion) *WorkflowVersionDiff {
+	var (
+		d = &WorkflowVersionDiff{
+			WorkflowID: v.WorkflowID,
+			From:       v.Version,
+			To:         to.Version,
+			Changes:    WorkflowChangeSet{},
+		}
+
+		change = func(c *WorkflowChange, before, after interface{}) {
+			switch {
+			case before != nil && after == nil:
+				c.Change, c.Before = WorkflowChangeRemoved, before
+			case before == nil && after != nil:
+				c.Change, c.After = WorkflowChangeAdded, after
+			case !jsonEqual(before, after):
+				c.Change, c.Before, c.After = WorkflowChangeModified, before, after
+			default:
+				return
+			}
+
+			d.Changes = append(d.Changes, c)
+		}
+	)
+
+	if !jsonEqual(v.Scope, to.Scope) {
+		d.Changes = append(d.Changes, &WorkflowChange{
+			Kind:   WorkflowChangeScope,
+			Change: WorkflowChangeModified,
+			Before: v.Scope,
+			After:  to.Scope,
+		})
+	}
+
+	{
+		var (
+			before = make(map[uint64]*WorkflowStep)
+			after  = make(map[uint64]*WorkflowStep)
+			ids    = make([]uint64, 0, len(v.Steps)+len(to.Steps))
+		)
+
+		for _, s := range v.Steps {
+			before[s.ID] = s
+			ids = append(ids, s.ID)
+		}
+
+		for _, s := range to.Steps {
+			after[s.ID] = s
+			if before[s.ID] == nil {
+				ids = append(ids, s.ID)
+			}
+		}
+
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, stepID := range ids {
+			var b, a interface{}
+			if s := before[stepID]; s != nil {
+				b = s
+			}
+			if s := after[stepID]; s != nil {
+				a = s
+			}
+
+			change(&WorkflowChange{Kind: WorkflowChangeStep, StepID: stepID}, b, a)
+		}
+	}
+
+	{
+		type pathKey struct{ parentID, childID uint64 }
+
+		var (
+			before = make(map[pathKey]*WorkflowPath)
+			after  = make(map[pathKey]*WorkflowPath)
+			keys   = make([]pathKey, 0, len(v.Paths)+len(to.Paths))
+		)
+
+		for _, p := range v.Paths {
+			k := pathKey{p.ParentID, p.ChildID}
+			before[k] = p
+			keys = append(keys, k)
+		}
+
+		for _, p := range to.Paths {
+			k := pathKey{p.ParentID, p.ChildID}
+			after[k] = p
+			if before[k] == nil {
+				keys = append(keys, k)
+			}
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].parentID == keys[j].parentID {
+				return keys[i].childID < keys[j].childID
+			}
+
+			return keys[i].parentID < keys[j].parentID
+		})
+
+		for _, k := range keys {
+			var b, a interface{}
+			if p := before[k]; p != nil {
+				b = p
+			}
+			if p := after[k]; p != nil {
+				a = p
+			}
+
+			change(&WorkflowChange{Kind: WorkflowChangePath, ParentID: k.parentID, ChildID: k.childID}, b, a)
+		}
+	}
+
+	return d
+}
+
+// jsonEqual compares values by their JSON encoding
+//
+// Steps and paths hold parsed expressions that are
+// not relevant when comparing definitions
+func jsonEqual(a, b interface{}) bool {
+	aa, aErr := json.Marshal(a)
+	bb, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aa) == string(bb)
+}
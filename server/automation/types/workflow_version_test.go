@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowVersion_Diff(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		from = &WorkflowVersion{
+			WorkflowID: 1,
+			Version:    1,
+			Steps: WorkflowStepSet{
+				{ID: 10, Kind: WorkflowStepKindExpressions},
+				{ID: 20, Kind: WorkflowStepKindDelay},
+				{ID: 30, Kind: WorkflowStepKindTermination},
+			},
+			Paths: WorkflowPathSet{
+				{ParentID: 10, ChildID: 20},
+				{ParentID: 20, ChildID: 30},
+			},
+		}
+
+		to = &WorkflowVersion{
+			WorkflowID: 1,
+			Version:    2,
+			Steps: WorkflowStepSet{
+				{ID: 10, Kind: WorkflowStepKindExpressions},
+				{ID: 30, Kind: WorkflowStepKindTermination, Ref: "changed"},
+				{ID: 40, Kind: WorkflowStepKindDebug},
+			},
+			Paths: WorkflowPathSet{
+				{ParentID: 10, ChildID: 40},
+				{ParentID: 40, ChildID: 30},
+			},
+		}
+
+		d = from.Diff(to)
+	)
+
+	req.Equal(uint(1), d.From)
+	req.Equal(uint(2), d.To)
+
+	type change struct {
+		kind, change string
+		stepID       uint64
+		parentID     uint64
+		childID      uint64
+	}
+
+	var cc []change
+	for _, c := range d.Changes {
+		cc = append(cc, change{c.Kind, c.Change, c.StepID, c.ParentID, c.ChildID})
+	}
+
+	req.Equal([]change{
+		{WorkflowChangeStep, WorkflowChangeRemoved, 20, 0, 0},
+		{WorkflowChangeStep, WorkflowChangeModified, 30, 0, 0},
+		{WorkflowChangeStep, WorkflowChangeAdded, 40, 0, 0},
+		{WorkflowChangePath, WorkflowChangeRemoved, 0, 10, 20},
+		{WorkflowChangePath, WorkflowChangeAdded, 0, 10, 40},
+		{WorkflowChangePath, WorkflowChangeRemoved, 0, 20, 30},
+		{WorkflowChangePath, WorkflowChangeAdded, 0, 40, 30},
+	}, cc)
+
+	req.Empty(from.Diff(from).Changes)
+}
@@ -59,6 +59,16 @@ workflow: {
 				}
 			}
 
+			published_version: {
+				goType: "uint"
+				dal: { type: "Number", default: 0, meta: { "rdbms:type": "integer" } }
+				envoy: {
+					yaml: {
+						omitEncoder: true
+					}
+				}
+			}
+
 			run_as: schema.AttributeUserRef
 
 			owned_by:   schema.AttributeUserRef
@@ -0,0 +1,87 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+workflow_version: {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	model: {
+		ident: "automation_workflow_versions"
+		omitGetterSetter: true
+
+		attributes: {
+			id: schema.IdField
+			workflow_id: {
+				ident: "workflowID",
+				goType: "uint64",
+				storeIdent: "rel_workflow"
+				dal: { type: "Ref", refModelResType: "corteza::automation:workflow" }
+			}
+			version: {
+				sortable: true,
+				goType: "uint"
+				dal: { type: "Number", meta: { "rdbms:type": "integer" } }
+			}
+			description: {
+				dal: {}
+			}
+			scope: {
+				goType: "*expr.Vars"
+				dal: { type: "JSON", defaultEmptyObject: true }
+			}
+			steps: {
+				goType: "types.WorkflowStepSet"
+				dal: { type: "JSON", defaultEmptyObject: true }
+			}
+			paths: {
+				goType: "types.WorkflowPathSet"
+				dal: { type: "JSON", defaultEmptyObject: true }
+			}
+			created_at: schema.SortableTimestampNowField
+			created_by: schema.AttributeUserRef
+		}
+
+		indexes: {
+			"primary": { attribute: "id" }
+			"unique_workflow_version": {
+				fields: [
+					{ attribute: "workflow_id" },
+					{ attribute: "version" },
+				]
+			}
+		}
+	}
+
+	envoy: {
+		omit: true
+	}
+
+	filter: {
+		struct: {
+			workflow_id: { goType: "[]uint64", ident: "workflowID", storeIdent: "rel_workflow" }
+			version: { goType: "[]uint" }
+		}
+
+		byValue: ["workflow_id", "version"]
+	}
+
+	store: {
+		ident: "automationWorkflowVersion"
+
+		api: {
+			lookups: [
+				{
+					fields: ["workflow_id", "version"]
+					description: """
+						searches for workflow version by workflow ID and version number
+						"""
+				}
+			]
+		}
+	}
+}
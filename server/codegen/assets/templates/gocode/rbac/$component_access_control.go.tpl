@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cast"
 	"strings"
 	"context"
+	"time"
 	"github.com/cortezaproject/corteza/server/store"
 	"github.com/cortezaproject/corteza/server/pkg/rbac"
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
@@ -22,6 +23,7 @@ type (
 	rbacService interface {
 		Can(rbac.Session, string, rbac.Resource) bool
 		Trace(rbac.Session, string, rbac.Resource) *rbac.Trace
+		At(context.Context, time.Time) (*rbac.Snapshot, error)
 		Grant(context.Context, ...*rbac.Rule) error
 		FindRulesByRoleID(roleID uint64) (rr rbac.RuleSet)
 	}
@@ -70,7 +72,54 @@ func (svc accessControl) Trace(ctx context.Context, userID uint64, roles []uint6
 		return nil, AccessControlErrNotAllowedToSetPermissions()
 	}
 
+	return svc.trace(ctx, rbac.SimulationSubject{UserID: userID, Roles: roles}, rr...)
+}
+
+// Simulate evaluates all operations on the given resources for the subject
+//
+// Returns access matrix with the rule that decided each of the entries
+//
+// This function is auto-generated
+func (svc accessControl) Simulate(ctx context.Context, sub rbac.SimulationSubject, rr ...string) (rbac.EvaluationSet, error) {
+	// Reusing the grant permission since this is who the feature is for
+	if !svc.CanGrant(ctx) {
+		return nil, AccessControlErrNotAllowedToSetPermissions()
+	}
+
+	tt, err := svc.trace(ctx, sub, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.Evaluate(tt...), nil
+}
+
+// Diff evaluates all operations on the given resources for both subjects
+// and returns the ones with different access
+//
+// This function is auto-generated
+func (svc accessControl) Diff(ctx context.Context, a, b rbac.SimulationSubject, rr ...string) (rbac.EvaluationDiffSet, error) {
+	ea, err := svc.Simulate(ctx, a, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	eb, err := svc.Simulate(ctx, b, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.Diff(ea, eb), nil
+}
+
+// trace evaluates all operations on the given resources for the subject
+//
+// This function is auto-generated
+func (svc accessControl) trace(ctx context.Context, sub rbac.SimulationSubject, rr ...string) (ee []*rbac.Trace, err error) {
 	var (
+		userID = sub.UserID
+		roles  = sub.Roles
+
 		resource rbac.Resource
 		resources []rbac.Resource
 		members   systemTypes.RoleMemberSet
@@ -119,11 +168,24 @@ func (svc accessControl) Trace(ctx context.Context, userID uint64, roles []uint6
 		return nil, fmt.Errorf("no roles specified")
 	}
 
+	var (
+		tracer interface {
+			Trace(rbac.Session, string, rbac.Resource) *rbac.Trace
+		} = svc.rbac
+	)
+
+	if !sub.At.IsZero() {
+		// rules as they were at the given time
+		if tracer, err = svc.rbac.At(ctx, sub.At); err != nil {
+			return nil, err
+		}
+	}
+
 	session := rbac.ParamsToSession(ctx, userID, roles...)
 	for _, res := range resources {
 		r := res.RbacResource()
 		for op := range rbacResourceOperations(r) {
-			ee = append(ee, svc.rbac.Trace(session, op, res))
+			ee = append(ee, tracer.Trace(session, op, res))
 		}
 	}
 
@@ -1228,6 +1228,9 @@ type (
 	recordsUpdateArgs struct {
 		hasRecord bool
 		Record    *types.Record
+
+		hasRevision bool
+		Revision    int64
 	}
 
 	recordsUpdateResults struct {
@@ -1256,6 +1259,14 @@ func (h recordsHandler) Update() *atypes.Function {
 				Name:  "record",
 				Types: []string{"ComposeRecord"}, Required: true,
 			},
+			{
+				Name:  "revision",
+				Types: []string{"Integer"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Expected revision",
+					Description: "When set, update is rejected if the stored record revision\ndoes not match (record was modified in the meantime)",
+				},
+			},
 		},
 
 		Results: []*atypes.Param{
@@ -1269,7 +1280,8 @@ func (h recordsHandler) Update() *atypes.Function {
 		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
 			var (
 				args = &recordsUpdateArgs{
-					hasRecord: in.Has("record"),
+					hasRecord:   in.Has("record"),
+					hasRevision: in.Has("revision"),
 				}
 			)
 
@@ -283,6 +283,12 @@ func (h recordsHandler) create(ctx context.Context, args *recordsCreateArgs) (re
 
 func (h recordsHandler) update(ctx context.Context, args *recordsUpdateArgs) (results *recordsUpdateResults, err error) {
 	results = &recordsUpdateResults{}
+
+	if args.hasRevision {
+		// expected revision is checked against the stored one
+		args.Record.ExpectRevision(int(args.Revision))
+	}
+
 	results.Record, err = wrapRecordValueErrorSet(h.rec.Update(ctx, args.Record))
 	return
 }
@@ -17,6 +17,10 @@ type (
 		Update(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, rr ...dal.ValueGetter) (err error)
 	}
 
+	conditionalUpdater interface {
+		UpdateIf(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, expected map[string]any, r dal.ValueGetter) (bool, error)
+	}
+
 	searcher interface {
 		Search(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, f filter.Filter) (dal.Iterator, error)
 	}
@@ -90,6 +94,13 @@ func ComposeRecordUpdate(ctx context.Context, u updater, mod *types.Module, reco
 	return u.Update(ctx, mod.ModelRef(), recUpdateOperations(mod), recToGetters(records...)...)
 }
 
+// ComposeRecordUpdateRevision updates the record when it is still at the given revision
+//
+// Returns false when record was updated in the meantime
+func ComposeRecordUpdateRevision(ctx context.Context, u conditionalUpdater, mod *types.Module, revision int, rec *types.Record) (bool, error) {
+	return u.UpdateIf(ctx, mod.ModelRef(), recUpdateOperations(mod), map[string]any{"revision": revision}, rec)
+}
+
 func ComposeRecordSoftDelete(ctx context.Context, u updater, mod *types.Module, records ...*types.Record) (err error) {
 	return u.Update(ctx, mod.ModelRef(), recUpdateOperations(mod), recToGetters(records...)...)
 }
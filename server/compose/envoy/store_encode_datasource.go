@@ -162,7 +162,14 @@ func (e StoreEncoder) encodeRecordDatasource(ctx context.Context, p envoyx.Encod
 	recordGetters := e.makeRecordGetters(dl, tree, n)
 	userGetters := e.makeUserGetters(s, dl, tree, mod, n)
 
-	maykr := e.recordMaker(ns, mod, recordGetters, userGetters)
+	// Only some sources (xlsx) hold all of the values of
+	// a multi-value field in a single cell
+	var split func(string) []string
+	if mv, ok := ds.Provider.(envoyx.MultiValueProvider); ok {
+		split = mv.SplitMultiValue
+	}
+
+	maykr := e.recordMaker(ns, mod, recordGetters, userGetters, split)
 
 	var (
 		rec     types.Record
@@ -318,39 +325,46 @@ func (e StoreEncoder) makeUserGetters(s store.Storer, dl dal.FullService, tree e
 	return
 }
 
-func (e StoreEncoder) recordMaker(ns *types.Namespace, mod *types.Module, recordGetters map[string]*recordGetter, userGetters map[string]*systemEnvoy.UserGetter) func(ctx context.Context, auxRec map[string]string) (r types.Record, err error) {
+func (e StoreEncoder) recordMaker(ns *types.Namespace, mod *types.Module, recordGetters map[string]*recordGetter, userGetters map[string]*systemEnvoy.UserGetter, split func(string) []string) func(ctx context.Context, auxRec map[string]string) (r types.Record, err error) {
 	return func(ctx context.Context, auxRec map[string]string) (rec types.Record, err error) {
 		// Iterate mapified record values and populate the provided values
 		var auxv *types.RecordValue
-		for k, v := range auxRec {
-			if recordGetters[k] != nil {
-				auxv, err = e.resolveRecordRef(ctx, recordGetters, k, v)
-				if err != nil {
-					return
-				}
-				err = rec.SetValue(k, auxv.Place, auxv.Ref)
-				if err != nil {
-					return
-				}
-				continue
+		for k, raw := range auxRec {
+			vv := []string{raw}
+			if f := mod.Fields.FindByName(k); split != nil && f != nil && f.Multi {
+				vv = split(raw)
 			}
 
-			if userGetters[k] != nil {
-				auxv, err = e.resolveUserRef(ctx, userGetters, k, v)
-				if err != nil {
-					return
+			for place, v := range vv {
+				if recordGetters[k] != nil {
+					auxv, err = e.resolveRecordRef(ctx, recordGetters, k, v)
+					if err != nil {
+						return
+					}
+					err = rec.SetValue(k, uint(place), auxv.Ref)
+					if err != nil {
+						return
+					}
+					continue
+				}
+
+				if userGetters[k] != nil {
+					auxv, err = e.resolveUserRef(ctx, userGetters, k, v)
+					if err != nil {
+						return
+					}
+					err = rec.SetValue(k, uint(place), auxv.Ref)
+					if err != nil {
+						return
+					}
+					continue
 				}
-				err = rec.SetValue(k, auxv.Place, auxv.Ref)
+
+				// Default is regular values
+				err = rec.SetValue(k, uint(place), v)
 				if err != nil {
 					return
 				}
-				continue
-			}
-
-			// Default is regular values
-			err = rec.SetValue(k, 0, v)
-			if err != nil {
-				return
 			}
 		}
 
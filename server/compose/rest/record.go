@@ -23,7 +23,9 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/envoy/csv"
 	envoyJson "github.com/cortezaproject/corteza/server/pkg/envoy/json"
 	estore "github.com/cortezaproject/corteza/server/pkg/envoy/store"
+	"github.com/cortezaproject/corteza/server/pkg/envoy/xlsx"
 	"github.com/cortezaproject/corteza/server/pkg/envoyx"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
 	"github.com/cortezaproject/corteza/server/pkg/filter"
 	"github.com/cortezaproject/corteza/server/pkg/revisions"
 	"github.com/cortezaproject/corteza/server/store"
@@ -167,7 +169,7 @@ func (ctrl *Record) Read(ctx context.Context, r *request.RecordRead) (interface{
 		return nil, store.ErrNotFound
 	}
 
-	return ctrl.makePayload(ctx, m, record, dd, err)
+	return ctrl.withETag(ctrl.makePayload(ctx, m, record, dd, err))
 }
 
 func (ctrl *Record) Create(ctx context.Context, r *request.RecordCreate) (interface{}, error) {
@@ -228,7 +230,54 @@ func (ctrl *Record) Create(ctx context.Context, r *request.RecordCreate) (interf
 		dd.Merge(r.DuplicationError)
 	}
 
-	return ctrl.makeBulkPayload(ctx, m, dd, err, rr...)
+	return ctrl.withETag(ctrl.makeBulkPayload(ctx, m, dd, err, rr...))
+}
+
+func (ctrl *Record) PatchRecord(ctx context.Context, r *request.RecordPatchRecord) (interface{}, error) {
+	var (
+		m   *types.Module
+		err error
+	)
+
+	if m, err = ctrl.module.FindByID(ctx, r.NamespaceID, r.ModuleID); err != nil {
+		return nil, err
+	}
+
+	revision, err := expectedRevision(r.IfMatch, r.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	counters := make(map[string]uint)
+	for _, v := range r.Values {
+		v.Place = counters[v.Name]
+		counters[v.Name]++
+	}
+
+	rec := &types.Record{
+		ID:          r.RecordID,
+		NamespaceID: r.NamespaceID,
+		ModuleID:    r.ModuleID,
+		Values:      r.Values,
+	}
+
+	rec.ExpectRevision(revision)
+
+	results, err := ctrl.record.Bulk(ctx, false, &types.RecordBulkOperation{
+		Record:    rec,
+		Operation: types.OperationTypePatch,
+		ID:        strconv.FormatUint(r.RecordID, 10),
+	})
+
+	if rve := types.IsRecordValueErrorSet(err); rve != nil {
+		return ctrl.handleValidationError(rve), nil
+	}
+
+	if err != nil {
+		return ctrl.withETag(nil, err)
+	}
+
+	return ctrl.withETag(ctrl.makePayload(ctx, m, results[0].Record, results[0].DuplicationError, nil))
 }
 
 func (ctrl *Record) Patch(ctx context.Context, req *request.RecordPatch) (interface{}, error) {
@@ -268,6 +317,11 @@ func (ctrl *Record) Update(ctx context.Context, r *request.RecordUpdate) (interf
 		return nil, err
 	}
 
+	revision, err := expectedRevision(r.IfMatch, r.Revision)
+	if err != nil {
+		return nil, err
+	}
+
 	oo := make([]*types.RecordBulkOperation, 0)
 
 	// If defined, initialize parent record for creation
@@ -282,6 +336,8 @@ func (ctrl *Record) Update(ctx context.Context, r *request.RecordUpdate) (interf
 			UpdatedAt:   r.UpdatedAt,
 		}
 
+		rr.ExpectRevision(revision)
+
 		oo = append(oo, &types.RecordBulkOperation{
 			Record:    rr,
 			Operation: types.OperationTypeUpdate,
@@ -316,7 +372,7 @@ func (ctrl *Record) Update(ctx context.Context, r *request.RecordUpdate) (interf
 		dd.Merge(r.DuplicationError)
 	}
 
-	return ctrl.makeBulkPayload(ctx, m, dd, err, rr...)
+	return ctrl.withETag(ctrl.makeBulkPayload(ctx, m, dd, err, rr...))
 }
 
 func (ctrl *Record) Delete(ctx context.Context, r *request.RecordDelete) (interface{}, error) {
@@ -393,7 +449,7 @@ func (ctrl *Record) ImportInit(ctx context.Context, r *request.RecordImportInit)
 	// Mime type detection library fails for some .csv files, so let's help them out a bit.
 	// The detection can now fallback to the user-provided content-type.
 	ct := r.Upload.Header.Get("Content-Type")
-	return ctrl.importSession.Create(ctx, f, r.Upload.Filename, ct, r.NamespaceID, r.ModuleID)
+	return ctrl.importSession.Create(ctx, f, r.Upload.Filename, ct, r.Sheet, r.NamespaceID, r.ModuleID)
 }
 
 // @todo :')
@@ -733,6 +789,13 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 				Timezone: r.Timezone,
 			})
 
+		case "xlsx":
+			contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+			encoder = xlsx.NewBulkRecordEncoder(&xlsx.EncoderConfig{
+				Fields:   fx,
+				Timezone: r.Timezone,
+			})
+
 		default:
 			http.Error(w, "unsupported format ("+r.Ext+")", http.StatusBadRequest)
 			return
@@ -918,6 +981,39 @@ func (ctrl Record) makeFilterPayload(ctx context.Context, m *types.Module, rr ty
 	return modp, nil
 }
 
+// withETag sets ETag header with the current revision of the record
+//
+// Revision conflicts (If-Match header or revision in the payload do not
+// match the stored revision) are served with 412 Precondition Failed status
+func (ctrl Record) withETag(p *recordPayload, err error) (interface{}, error) {
+	if errors.Is(err, service.RecordErrRevisionConflict()) {
+		return func(w http.ResponseWriter, req *http.Request) {
+			errors.ServeHTTPWithCode(w, req, http.StatusPreconditionFailed, err, !api.DebugFromContext(req.Context()))
+		}, nil
+	}
+
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("ETag", p.Record.ETag())
+		api.Send(w, req, p)
+	}, nil
+}
+
+// expectedRevision returns revision the record is expected to be at
+//
+// Revision can be sent with If-Match header (ETag, as returned on read)
+// or as revision in the payload; header takes precedence
+func expectedRevision(ifMatch string, revision int) (int, error) {
+	if ifMatch == "" {
+		return revision, nil
+	}
+
+	return types.ParseRecordETag(ifMatch)
+}
+
 // Special care for record validation errors
 //
 // We need to return a bit different format of response
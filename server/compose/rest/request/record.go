@@ -129,6 +129,11 @@ type (
 		//
 		// File import
 		Upload *multipart.FileHeader
+
+		// Sheet POST parameter
+		//
+		// Sheet to import from (xlsx only); active sheet is used when omitted
+		Sheet string
 	}
 
 	RecordImportRun struct {
@@ -323,6 +328,16 @@ type (
 		//
 		// Last update (or creation) date
 		UpdatedAt *time.Time
+
+		// Revision POST parameter
+		//
+		// Expected record revision; update is rejected if record was modified in the meantime
+		Revision int
+
+		// IfMatch HEADER parameter
+		//
+		// Record ETag (as returned by read); update is rejected if record was modified in the meantime
+		IfMatch string `json:"-"`
 	}
 
 	RecordPatch struct {
@@ -347,6 +362,38 @@ type (
 		Query string
 	}
 
+	RecordPatchRecord struct {
+		// NamespaceID PATH parameter
+		//
+		// Namespace ID
+		NamespaceID uint64 `json:",string"`
+
+		// ModuleID PATH parameter
+		//
+		// Module ID
+		ModuleID uint64 `json:",string"`
+
+		// RecordID PATH parameter
+		//
+		// Record ID
+		RecordID uint64 `json:",string"`
+
+		// Values POST parameter
+		//
+		// Fields to update and their values
+		Values types.RecordValueSet
+
+		// Revision POST parameter
+		//
+		// Expected record revision; patch is rejected if record was modified in the meantime
+		Revision int
+
+		// IfMatch HEADER parameter
+		//
+		// Record ETag (as returned by read); patch is rejected if record was modified in the meantime
+		IfMatch string `json:"-"`
+	}
+
 	RecordBulkDelete struct {
 		// NamespaceID PATH parameter
 		//
@@ -768,6 +815,7 @@ func (r RecordImportInit) Auditable() map[string]interface{} {
 		"namespaceID": r.NamespaceID,
 		"moduleID":    r.ModuleID,
 		"upload":      r.Upload,
+		"sheet":       r.Sheet,
 	}
 }
 
@@ -786,6 +834,11 @@ func (r RecordImportInit) GetUpload() *multipart.FileHeader {
 	return r.Upload
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r RecordImportInit) GetSheet() string {
+	return r.Sheet
+}
+
 // Fill processes request and fills internal variables
 func (r *RecordImportInit) Fill(req *http.Request) (err error) {
 
@@ -808,6 +861,13 @@ func (r *RecordImportInit) Fill(req *http.Request) (err error) {
 			// Multipart params
 
 			// Ignoring upload as its handled in the POST params section
+
+			if val, ok := req.MultipartForm.Value["sheet"]; ok && len(val) > 0 {
+				r.Sheet, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -822,6 +882,12 @@ func (r *RecordImportInit) Fill(req *http.Request) (err error) {
 			return fmt.Errorf("error processing uploaded file: %w", err)
 		}
 
+		if val, ok := req.Form["sheet"]; ok && len(val) > 0 {
+			r.Sheet, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	{
@@ -1476,6 +1542,8 @@ func (r RecordUpdate) Auditable() map[string]interface{} {
 		"meta":        r.Meta,
 		"records":     r.Records,
 		"updatedAt":   r.UpdatedAt,
+		"revision":    r.Revision,
+		"If-Match":    r.IfMatch,
 	}
 }
 
@@ -1519,6 +1587,16 @@ func (r RecordUpdate) GetUpdatedAt() *time.Time {
 	return r.UpdatedAt
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r RecordUpdate) GetRevision() int {
+	return r.Revision
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordUpdate) GetIfMatch() string {
+	return r.IfMatch
+}
+
 // Fill processes request and fills internal variables
 func (r *RecordUpdate) Fill(req *http.Request) (err error) {
 
@@ -1565,6 +1643,13 @@ func (r *RecordUpdate) Fill(req *http.Request) (err error) {
 					return err
 				}
 			}
+
+			if val, ok := req.MultipartForm.Value["revision"]; ok && len(val) > 0 {
+				r.Revision, err = payload.ParseInt(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -1614,6 +1699,25 @@ func (r *RecordUpdate) Fill(req *http.Request) (err error) {
 				return err
 			}
 		}
+
+		if val, ok := req.Form["revision"]; ok && len(val) > 0 {
+			r.Revision, err = payload.ParseInt(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		// header params
+
+		if val := req.Header.Get("If-Match"); val != "" {
+			r.IfMatch, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+
 	}
 
 	{
@@ -1751,6 +1855,144 @@ func (r *RecordPatch) Fill(req *http.Request) (err error) {
 	return err
 }
 
+// NewRecordPatchRecord request
+func NewRecordPatchRecord() *RecordPatchRecord {
+	return &RecordPatchRecord{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"namespaceID": r.NamespaceID,
+		"moduleID":    r.ModuleID,
+		"recordID":    r.RecordID,
+		"values":      r.Values,
+		"revision":    r.Revision,
+		"If-Match":    r.IfMatch,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetNamespaceID() uint64 {
+	return r.NamespaceID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetModuleID() uint64 {
+	return r.ModuleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetRecordID() uint64 {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetValues() types.RecordValueSet {
+	return r.Values
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetRevision() int {
+	return r.Revision
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordPatchRecord) GetIfMatch() string {
+	return r.IfMatch
+}
+
+// Fill processes request and fills internal variables
+func (r *RecordPatchRecord) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["revision"]; ok && len(val) > 0 {
+				r.Revision, err = payload.ParseInt(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		//if val, ok := req.Form["values[]"]; ok && len(val) > 0  {
+		//    r.Values, err = types.RecordValueSet(val), nil
+		//    if err != nil {
+		//        return err
+		//    }
+		//}
+
+		if val, ok := req.Form["revision"]; ok && len(val) > 0 {
+			r.Revision, err = payload.ParseInt(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		// header params
+
+		if val := req.Header.Get("If-Match"); val != "" {
+			r.IfMatch, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "namespaceID")
+		r.NamespaceID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "moduleID")
+		r.ModuleID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "recordID")
+		r.RecordID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
 // NewRecordBulkDelete request
 func NewRecordBulkDelete() *RecordBulkDelete {
 	return &RecordBulkDelete{}
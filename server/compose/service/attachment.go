@@ -65,6 +65,7 @@ type (
 		CreateNamespaceAttachment(ctx context.Context, name string, size int64, fh io.ReadSeeker) (*types.Attachment, error)
 		OpenOriginal(att *types.Attachment) (io.ReadSeekCloser, error)
 		OpenPreview(att *types.Attachment) (io.ReadSeekCloser, error)
+		PresignedURL(att *types.Attachment, preview, download bool) (string, error)
 		DeleteByID(ctx context.Context, namespaceID, attachmentID uint64) error
 	}
 )
@@ -249,6 +250,33 @@ func (svc attachment) OpenPreview(att *types.Attachment) (io.ReadSeekCloser, err
 	return svc.objects.Open(att.PreviewUrl)
 }
 
+// PresignedURL returns time-limited URL for downloading the attachment directly from the object store
+//
+// Empty string is returned when the object store does not support presigned URLs
+// (or is not configured for them) and attachment needs to be served by us.
+func (svc attachment) PresignedURL(att *types.Attachment, preview, download bool) (string, error) {
+	ps, ok := svc.objects.(objstore.Presigner)
+	if !ok {
+		return "", nil
+	}
+
+	name := att.Url
+	if preview {
+		name = att.PreviewUrl
+	}
+
+	if len(name) == 0 {
+		return "", nil
+	}
+
+	url, err := ps.Presign(name, objstore.PresignOptions{Name: att.Name, Download: download})
+	if errors.Is(err, objstore.ErrPresignNotSupported) {
+		return "", nil
+	}
+
+	return url, err
+}
+
 func (svc attachment) CreatePageAttachment(ctx context.Context, namespaceID uint64, name string, size int64, fh io.ReadSeeker, pageID uint64) (att *types.Attachment, err error) {
 	var (
 		ns *types.Namespace
@@ -545,7 +573,7 @@ func (svc attachment) create(ctx context.Context, s store.ComposeAttachments, na
 	att.Url = svc.objects.Original(att.ID, att.Meta.Original.Extension)
 	aProps.setUrl(att.Url)
 
-	if err = svc.objects.Save(att.Url, fh); err != nil {
+	if err = svc.objects.SaveStream(att.Url, fh, objstore.Meta{Size: size, ContentType: att.Meta.Original.Mimetype}); err != nil {
 		return AttachmentErrFailedToStoreFile(aProps).Wrap(err)
 	}
 
@@ -701,7 +729,7 @@ func (svc attachment) processImage(original io.ReadSeeker, att *types.Attachment
 	// Can and how we make a preview of this attachment?
 	att.PreviewUrl = svc.objects.Preview(att.ID, meta.Extension)
 
-	return svc.objects.Save(att.PreviewUrl, buf)
+	return svc.objects.SaveStream(att.PreviewUrl, buf, objstore.Meta{Size: meta.Size, ContentType: meta.Mimetype})
 }
 
 func (attachment) checkMimeType(test *mimetype.MIME, vv ...string) bool {
@@ -11,6 +11,7 @@ type (
 	dalDater interface {
 		Create(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, vv ...dal.ValueGetter) error
 		Update(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, rr ...dal.ValueGetter) (err error)
+		UpdateIf(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, expected map[string]any, r dal.ValueGetter) (bool, error)
 		Search(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, f filter.Filter) (dal.Iterator, error)
 		Count(ctx context.Context, m dal.ModelRef, operations dal.OperationSet, f filter.Filter) (uint, error)
 		Run(ctx context.Context, pp dal.Pipeline) (dal.Iterator, error)
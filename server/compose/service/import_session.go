@@ -13,6 +13,7 @@ import (
 
 	envoyCsv "github.com/cortezaproject/corteza/server/pkg/envoyx/csv"
 	envoyJson "github.com/cortezaproject/corteza/server/pkg/envoyx/json"
+	envoyXlsx "github.com/cortezaproject/corteza/server/pkg/envoyx/xlsx"
 )
 
 type (
@@ -30,7 +31,7 @@ type (
 	}
 
 	ImportSessionService interface {
-		Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (*RecordImportSession, error)
+		Create(ctx context.Context, f io.ReadSeeker, name, contentType, sheet string, namespaceID, moduleID uint64) (*RecordImportSession, error)
 		FindByID(ctx context.Context, sessionID uint64) (*RecordImportSession, error)
 		DeleteByID(ctx context.Context, sessionID uint64) error
 	}
@@ -52,7 +53,10 @@ func (svc *importSession) indexOf(userID, sessionID uint64) int {
 	return -1
 }
 
-func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (_ *RecordImportSession, err error) {
+// Create prepares a new import session for the given file
+//
+// Sheet is only considered for xlsx files; when omitted, the active sheet is used.
+func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, contentType, sheet string, namespaceID, moduleID uint64) (_ *RecordImportSession, err error) {
 	svc.l.Lock()
 	defer svc.l.Unlock()
 
@@ -83,6 +87,22 @@ func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, con
 			return envoyJson.Decoder(f, name)
 		}
 
+		if envoyXlsx.CanDecodeFile(f) || envoyXlsx.CanDecodeMime(contentType) {
+			f.Seek(0, 0)
+			if sh.Sheets, err = envoyXlsx.Sheets(f); err != nil {
+				return
+			}
+
+			f.Seek(0, 0)
+			xd, err := envoyXlsx.Decoder(f, name, sheet)
+			if err != nil {
+				return nil, err
+			}
+
+			sh.Sheet = xd.Sheet()
+			return xd, nil
+		}
+
 		return nil, fmt.Errorf("compose.service.RecordImportFormatNotSupported")
 	}()
 	if err != nil {
@@ -172,6 +172,11 @@ type (
 		Key      string                `json:"key"`
 		Progress *RecordImportProgress `json:"progress"`
 
+		// Sheets lists all of the sheets of the imported workbook
+		// and Sheet the one we are importing from (xlsx only)
+		Sheets []string `json:"sheets,omitempty"`
+		Sheet  string   `json:"sheet,omitempty"`
+
 		CreatedAt time.Time `json:"createdAt"`
 		UpdatedAt time.Time `json:"updatedAt"`
 
@@ -1154,6 +1159,20 @@ func (svc record) update(ctx context.Context, upd *types.Record) (rec *types.Rec
 		return nil, dd, RecordErrStaleData()
 	}
 
+	// Revision is checked only when explicitly requested (If-Match, revision argument)
+	// and the expectation is consumed here so that the record passed
+	// to automation does not make further updates conditional
+	expectedRevision := upd.ExpectedRevision()
+	upd.ExpectRevision(0)
+
+	if isRevisionConflict(expectedRevision, old.Revision) {
+		return nil, dd, RecordErrRevisionConflict(aProps)
+	}
+
+	// Revision is checked again on write in case
+	// record was updated since it was loaded
+	checkRevision := expectedRevision > 0 && old.Revision > 0
+
 	if err = RecordValueSanitization(m, upd.Values); err != nil {
 		return
 	}
@@ -1200,7 +1219,14 @@ func (svc record) update(ctx context.Context, upd *types.Record) (rec *types.Rec
 	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
 		aProps.setChanged(upd)
 
-		if err = dalutils.ComposeRecordUpdate(ctx, svc.dal, m, upd); err != nil {
+		if checkRevision {
+			var updated bool
+			if updated, err = dalutils.ComposeRecordUpdateRevision(ctx, svc.dal, m, old.Revision, upd); err != nil {
+				return err
+			} else if !updated {
+				return RecordErrRevisionConflict(aProps)
+			}
+		} else if err = dalutils.ComposeRecordUpdate(ctx, svc.dal, m, upd); err != nil {
 			return err
 		}
 
@@ -1253,11 +1279,21 @@ func (svc record) patch(ctx context.Context, upd *types.Record, values types.Rec
 		return
 	}
 
+	// Revision needs to be checked here since the patch
+	// is applied on the current version of the record
+	expectedRevision := upd.ExpectedRevision()
+	if isRevisionConflict(expectedRevision, old.Revision) {
+		return nil, dd, RecordErrRevisionConflict(&recordActionProps{record: old})
+	}
+
 	// Create an update version from the old
 	//
 	// In case the record has any multi-value fields, they need to be removed
 	// since they'll be replaced with new ones.
 	upd = old.Clone()
+
+	// patch is conditional only when the caller asked for it
+	upd.ExpectRevision(expectedRevision)
 	// - figure out what fields are multi value
 	mvFields := make(map[string]bool)
 	for _, f := range m.Fields {
@@ -1426,6 +1462,15 @@ func (svc record) procUpdate(ctx context.Context, invokerID uint64, m *types.Mod
 	return
 }
 
+// isRevisionConflict checks if the expected revision matches the stored one
+//
+// Revision is only checked when it is explicitly expected (see Record.ExpectRevision)
+// and stored for the record (revision system field can be omitted
+// by module's DAL configuration)
+func isRevisionConflict(expected, stored int) bool {
+	return expected > 0 && stored > 0 && expected != stored
+}
+
 func (svc record) recordInfoUpdate(ctx context.Context, r *types.Record) {
 	r.UpdatedAt = now()
 	r.UpdatedBy = auth.GetIdentityFromContext(ctx).Identity()
@@ -1038,6 +1038,40 @@ func RecordErrStaleData(mm ...*recordActionProps) *errors.Error {
 	return e
 }
 
+// RecordErrRevisionConflict returns "compose:record.revisionConflict" as *errors.Error
+//
+// This function is auto-generated.
+func RecordErrRevisionConflict(mm ...*recordActionProps) *errors.Error {
+	var p = &recordActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("record was modified since it was read", nil),
+
+		errors.Meta("type", "revisionConflict"),
+		errors.Meta("resource", "compose:record"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(recordLogMetaKey{}, "failed to update {{record}}; revision does not match"),
+		errors.Meta(recordPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "compose"),
+		errors.Meta(locale.ErrorMetaKey{}, "record.errors.revisionConflict"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // RecordErrNotAllowedToRead returns "compose:record.notAllowedToRead" as *errors.Error
 //
 // This function is auto-generated.
@@ -9,6 +9,7 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/eventbus"
 	"github.com/cortezaproject/corteza/server/store/adapters/rdbms"
 
+	"github.com/cortezaproject/corteza/server/compose/dalutils"
 	"github.com/cortezaproject/corteza/server/compose/service/values"
 	"github.com/cortezaproject/corteza/server/compose/types"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
@@ -376,6 +377,126 @@ func TestRecord_boolFieldPermissionIssueKBR(t *testing.T) {
 	}
 }
 
+func TestRecord_revisionConflict(t *testing.T) {
+	var (
+		err error
+
+		ctx = context.Background()
+		req = require.New(t)
+
+		u = &sysTypes.User{ID: nextID()}
+
+		ns = &types.Namespace{ID: nextID()}
+
+		modConf = types.ModuleConfig{DAL: types.ModuleConfigDAL{ConnectionID: 1}}
+
+		mod         = &types.Module{ID: nextID(), NamespaceID: ns.ID, Config: modConf}
+		stringField = &types.ModuleField{ID: nextID(), ModuleID: mod.ID, Name: "string", Kind: "String"}
+
+		authRoleID uint64 = 1
+
+		rbacService = rbac.NewService(zap.NewNop(), nil)
+
+		svc = makeTestRecordService(t,
+			rbacService,
+			zap.NewNop(),
+			u,
+			ns,
+			mod,
+			stringField,
+		)
+
+		rec, upd *types.Record
+	)
+
+	rbacService.UpdateRoles(rbac.AuthenticatedRole.Make(authRoleID, "authenticated"))
+	rbacService.Grant(ctx,
+		rbac.AllowRule(authRoleID, mod.RbacResource(), "record.create"),
+		rbac.AllowRule(authRoleID, types.RecordRbacResource(0, 0, 0), "read"),
+		rbac.AllowRule(authRoleID, types.RecordRbacResource(0, 0, 0), "update"),
+		rbac.AllowRule(authRoleID, types.ModuleFieldRbacResource(0, 0, 0), "record.value.read"),
+		rbac.AllowRule(authRoleID, types.ModuleFieldRbacResource(0, 0, 0), "record.value.update"),
+	)
+
+	ctx = auth.SetIdentityToContext(ctx, auth.Authenticated(u.ID, authRoleID))
+
+	rec, _, err = svc.Create(ctx, &types.Record{ModuleID: mod.ID, NamespaceID: ns.ID, Values: types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v1"},
+	}})
+	req.NoError(err)
+	req.Equal(1, rec.Revision)
+
+	// update with expected revision
+	upd = &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID, Values: types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v2"},
+	}}
+	upd.ExpectRevision(1)
+	rec, _, err = svc.Update(ctx, upd)
+	req.NoError(err)
+	req.Equal(2, rec.Revision)
+	req.Zero(rec.ExpectedRevision())
+
+	// stale revision
+	upd = &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID, Values: types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v3"},
+	}}
+	upd.ExpectRevision(1)
+	_, _, err = svc.Update(ctx, upd)
+	req.True(errors.Is(err, RecordErrRevisionConflict()))
+
+	// stale revision on patch
+	upd = &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID}
+	upd.ExpectRevision(1)
+	_, _, err = svc.patch(ctx, upd, types.RecordValueSet{&types.RecordValue{Name: "string", Value: "v3"}})
+	req.True(errors.Is(err, RecordErrRevisionConflict()))
+
+	// revision on the record (as loaded by workflows) is not a condition
+	upd = &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID, Revision: 1, Values: types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v3"},
+	}}
+	rec, _, err = svc.Update(ctx, upd)
+	req.NoError(err)
+	req.Equal(3, rec.Revision)
+	req.Equal("v3", rec.Values.Get("string", 0).Value)
+
+	// record updated by someone else after the revision was checked
+	eb := eventbus.New()
+	eb.Register(func(ctx context.Context, ev eventbus.Event) error {
+		e := ev.(interface {
+			OldRecord() *types.Record
+			Module() *types.Module
+		})
+
+		conc := e.OldRecord().Clone()
+		conc.Revision++
+		return dalutils.ComposeRecordUpdate(ctx, svc.dal, e.Module(), conc)
+	}, eventbus.For("compose:record"), eventbus.On("beforeUpdate"))
+	svc.eventbus = eb
+
+	upd = &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID, Values: types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v4"},
+	}}
+	upd.ExpectRevision(3)
+	_, _, err = svc.Update(ctx, upd)
+	req.True(errors.Is(err, RecordErrRevisionConflict()))
+
+	rec, _, err = svc.FindByID(ctx, ns.ID, mod.ID, rec.ID)
+	req.NoError(err)
+	req.Equal(4, rec.Revision)
+	req.Equal("v3", rec.Values.Get("string", 0).Value)
+
+	// patch without expected revision is not a conditional write
+	// even when the record is modified while the patch is processed
+	_, _, err = svc.patch(ctx, &types.Record{ID: rec.ID, ModuleID: mod.ID, NamespaceID: ns.ID}, types.RecordValueSet{
+		&types.RecordValue{Name: "string", Value: "v5"},
+	})
+	req.NoError(err)
+
+	rec, _, err = svc.FindByID(ctx, ns.ID, mod.ID, rec.ID)
+	req.NoError(err)
+	req.Equal("v5", rec.Values.Get("string", 0).Value)
+}
+
 func TestRecord_defValueFieldPermissionIssue(t *testing.T) {
 	var (
 		err error
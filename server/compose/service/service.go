@@ -20,8 +20,10 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/locale"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/pkg/objstore"
+	"github.com/cortezaproject/corteza/server/pkg/objstore/encrypted"
 	"github.com/cortezaproject/corteza/server/pkg/objstore/minio"
 	"github.com/cortezaproject/corteza/server/pkg/objstore/plain"
+	"github.com/cortezaproject/corteza/server/pkg/objstore/s3"
 	"github.com/cortezaproject/corteza/server/pkg/options"
 	"github.com/cortezaproject/corteza/server/store"
 	systemTypes "github.com/cortezaproject/corteza/server/system/types"
@@ -149,7 +151,27 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 			bucket string
 		)
 		const svcPath = "compose"
-		if opt.MinioEndpoint != "" {
+		if opt.S3Endpoint != "" {
+			bucket = s3.GetBucket(opt.S3Bucket, svcPath)
+
+			DefaultObjectStore, err = s3.New(bucket, opt.S3PathPrefix, svcPath, s3.Options{
+				Endpoint:        opt.S3Endpoint,
+				Region:          opt.S3Region,
+				Secure:          opt.S3Secure,
+				Strict:          opt.S3Strict,
+				PathStyle:       opt.S3PathStyle,
+				AccessKeyID:     opt.S3AccessKey,
+				SecretAccessKey: opt.S3SecretKey,
+
+				PartSize:   int64(opt.S3PartSize) << 20,
+				PresignTTL: opt.PresignTtl,
+			})
+
+			log.Info("initializing s3",
+				zap.String("bucket", bucket),
+				zap.String("endpoint", opt.S3Endpoint),
+				zap.Error(err))
+		} else if opt.MinioEndpoint != "" {
 			bucket = minio.GetBucket(opt.MinioBucket, svcPath)
 
 			DefaultObjectStore, err = minio.New(bucket, opt.MinioPathPrefix, svcPath, minio.Options{
@@ -160,6 +182,8 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 				SecretAccessKey: opt.MinioSecretKey,
 
 				ServerSideEncryptKey: []byte(opt.MinioSSECKey),
+
+				PresignTTL: opt.PresignTtl,
 			})
 
 			log.Info("initializing minio",
@@ -175,6 +199,17 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 
 		}
 
+		if err == nil && opt.EncryptionKeys != "" {
+			var keys [][]byte
+			if keys, err = encrypted.ParseKeys(opt.EncryptionKeys); err == nil {
+				DefaultObjectStore, err = encrypted.New(DefaultObjectStore, keys...)
+			}
+
+			log.Info("initializing encryption at rest",
+				zap.Int("keys", len(keys)),
+				zap.Error(err))
+		}
+
 		hcd.Add(objstore.Healthcheck(DefaultObjectStore), "ObjectStore/Compose")
 
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cortezaproject/corteza/server/pkg/filter"
@@ -48,6 +49,10 @@ type (
 
 		Revision int `json:"revision,omitempty"`
 
+		// revision the stored record is expected to be at when updated
+		// set only when explicitly requested (see ExpectRevision)
+		expectedRevision int
+
 		module *Module
 
 		Values RecordValueSet `json:"values,omitempty"`
@@ -155,6 +160,20 @@ func (r *Record) GetModule() *Module {
 	return r.module
 }
 
+// ExpectRevision makes update of the record conditional
+//
+// Update is rejected when the stored record is not at the given
+// revision; revision on the record itself is never used for that
+// since records loaded by workflows or scripts always carry one
+func (r *Record) ExpectRevision(rev int) {
+	r.expectedRevision = rev
+}
+
+// ExpectedRevision returns revision set with ExpectRevision (0 when not set)
+func (r *Record) ExpectedRevision() int {
+	return r.expectedRevision
+}
+
 func (r Record) Clone() *Record {
 	c := &r
 	c.Values = r.Values.Clone()
@@ -322,6 +341,37 @@ func (r Record) Dict() map[string]interface{} {
 	return dict
 }
 
+// ETag returns entity tag of the record
+//
+// Tag is derived from the record revision that is
+// incremented on every change of the record
+func (r Record) ETag() string {
+	return strconv.Quote(strconv.Itoa(r.Revision))
+}
+
+// ParseRecordETag parses entity tag (as used in If-Match header)
+// and returns record revision
+//
+// Empty and wildcard tags are returned as 0 (any revision)
+func ParseRecordETag(tag string) (rev int, err error) {
+	tag = strings.TrimSpace(tag)
+	tag = strings.TrimPrefix(tag, "W/")
+
+	if tag == "" || tag == "*" {
+		return 0, nil
+	}
+
+	if unq, err := strconv.Unquote(tag); err == nil {
+		tag = unq
+	}
+
+	if rev, err = strconv.Atoi(tag); err != nil || rev < 0 {
+		return 0, fmt.Errorf("invalid record ETag %q", tag)
+	}
+
+	return
+}
+
 // UnmarshalJSON for custom record deserialization
 //
 // Due to https://github.com/golang/go/issues/21092, we should manually reset the given record value set.
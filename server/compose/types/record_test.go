@@ -236,3 +236,38 @@ func TestRecord_ValueGetter(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordETag(t *testing.T) {
+	req := require.New(t)
+	req.Equal(`"0"`, Record{}.ETag())
+	req.Equal(`"42"`, Record{Revision: 42}.ETag())
+
+	tests := []struct {
+		tag string
+		rev int
+		err bool
+	}{
+		{tag: "", rev: 0},
+		{tag: "*", rev: 0},
+		{tag: `"3"`, rev: 3},
+		{tag: `W/"3"`, rev: 3},
+		{tag: "3", rev: 3},
+		{tag: Record{Revision: 7}.ETag(), rev: 7},
+		{tag: `"foo"`, err: true},
+		{tag: `"-1"`, err: true},
+		{tag: `"1", "2"`, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			rev, err := ParseRecordETag(tt.tag)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.rev, rev)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// OpenAPI 3.1 document generated from the REST definitions (rest.yaml)
+//
+// See pkg/codegen/openapi.go
+//
+//go:embed openapi.gen.json
+var openapi []byte
+
+// OpenAPI serves generated OpenAPI document
+//
+// Document is updated with the server version and API base URL
+func OpenAPI(version, apiBaseURL string) http.HandlerFunc {
+	var (
+		once sync.Once
+		doc  []byte
+		err  error
+	)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() { doc, err = openapiDocument(version, apiBaseURL) })
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(doc)
+	}
+}
+
+func openapiDocument(version, apiBaseURL string) (_ []byte, err error) {
+	var (
+		doc  map[string]json.RawMessage
+		info map[string]interface{}
+	)
+
+	if err = json.Unmarshal(openapi, &doc); err != nil {
+		return
+	}
+
+	if err = json.Unmarshal(doc["info"], &info); err != nil {
+		return
+	}
+
+	info["version"] = version
+
+	if doc["info"], err = json.Marshal(info); err != nil {
+		return
+	}
+
+	doc["servers"], err = json.Marshal([]map[string]string{{
+		"url":         apiBaseURL,
+		"description": "Corteza REST API",
+	}})
+
+	if err != nil {
+		return
+	}
+
+	return json.Marshal(doc)
+}
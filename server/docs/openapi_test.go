@@ -0,0 +1,40 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPI(t *testing.T) {
+	var (
+		req = require.New(t)
+		rec = httptest.NewRecorder()
+
+		doc struct {
+			OpenAPI string `json:"openapi"`
+			Info    struct {
+				Version string `json:"version"`
+			} `json:"info"`
+			Servers []struct {
+				URL string `json:"url"`
+			} `json:"servers"`
+			Paths map[string]interface{} `json:"paths"`
+		}
+	)
+
+	OpenAPI("2023.9.0", "/custom/api")(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	req.Equal(http.StatusOK, rec.Code)
+	req.Equal("application/json", rec.Header().Get("Content-Type"))
+	req.NoError(json.Unmarshal(rec.Body.Bytes(), &doc))
+
+	req.Equal("3.1.0", doc.OpenAPI)
+	req.Equal("2023.9.0", doc.Info.Version)
+	req.Len(doc.Servers, 1)
+	req.Equal("/custom/api", doc.Servers[0].URL)
+	req.Contains(doc.Paths, "/system/users/{userID}")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/healthcheck"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
 	"github.com/cortezaproject/corteza/server/pkg/version"
 	"github.com/cortezaproject/corteza/server/webconsole"
 	"github.com/go-chi/chi/v5"
@@ -83,6 +84,11 @@ func activeRoutes(log *zap.Logger, mountable []func(r chi.Router), opts *options
 	envOpt := opts.Environment
 
 	r.Route("/"+strings.TrimPrefix(httpOpt.BaseUrl, "/"), func(r chi.Router) {
+		// Starts (or continues) trace for each request if enabled
+		if opts.Tracing.Enabled {
+			r.Use(tracing.HttpMiddleware)
+		}
+
 		// Reports error to Sentry if enabled
 		if httpOpt.EnablePanicReporting {
 			r.Use(sentryMiddleware())
@@ -35,6 +35,11 @@ func sentryMiddleware() func(http.Handler) http.Handler {
 
 func panicRecovery(ctx context.Context, w http.ResponseWriter) {
 	if err := recover(); err != nil {
+		if err == http.ErrAbortHandler {
+			// handler wants the response aborted;
+			// http server closes the connection
+			panic(err)
+		}
 
 		if _, has := os.LookupEnv("LOG_DEBUG"); has {
 			println("================================================================================")
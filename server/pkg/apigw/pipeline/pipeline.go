@@ -10,6 +10,9 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/apigw/pipeline/chain"
 	"github.com/cortezaproject/corteza/server/pkg/apigw/types"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -96,7 +99,15 @@ func (pp *Pl) makeHandler(hh Worker) func(next http.Handler) http.Handler {
 			log.Debug("started processing", zap.Bool("async", hh.Async))
 
 			fn := func() (err error) {
-				err = hh.Handler(rw, r)
+				ctx, span := tracing.Start(r.Context(), "apigw filter "+hh.Name,
+					attribute.String("apigw.filter.name", hh.Name),
+					attribute.String("apigw.filter.kind", string(hh.Type)),
+					attribute.Bool("apigw.filter.async", hh.Async),
+				)
+
+				err = hh.Handler(rw, r.WithContext(ctx))
+				tracing.End(span, err)
+
 				log.Debug("finished processing", zap.Duration("duration", time.Since(start)))
 				return
 			}
@@ -113,6 +124,9 @@ func (pp *Pl) makeHandler(hh Worker) func(next http.Handler) http.Handler {
 					newCtx = actx.ScopeToContext(context.Background(), scope)
 					newCtx = auth.SetIdentityToContext(newCtx, ident)
 
+					// keep async filter in the same trace
+					newCtx = tracing.WithSpanContext(newCtx, trace.SpanContextFromContext(r.Context()))
+
 					r = r.WithContext(newCtx)
 
 					// only log error, do not call error handler,
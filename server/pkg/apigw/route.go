@@ -3,6 +3,7 @@ package apigw
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	actx "github.com/cortezaproject/corteza/server/pkg/apigw/ctx"
@@ -10,6 +11,8 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/apigw/types"
 	"github.com/cortezaproject/corteza/server/pkg/auth"
 	h "github.com/cortezaproject/corteza/server/pkg/http"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -42,6 +45,12 @@ func (r route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		hit   = &profiler.Hit{}
 	)
 
+	ctx, span := tracing.Start(ctx, "apigw "+r.String(),
+		attribute.String("apigw.route.endpoint", r.endpoint),
+		attribute.String("apigw.route.id", strconv.FormatUint(r.ID, 10)),
+	)
+	defer span.End()
+
 	r.log.Debug("started serving route")
 
 	ar, err := h.NewRequest(req)
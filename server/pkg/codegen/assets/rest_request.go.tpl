@@ -201,6 +201,20 @@ func (r *{{ export $.Endpoint.Entrypoint $a.Name }}) Fill(req *http.Request) (er
 	}
 	{{ end }}
 
+	{{ if $a.Params.Header }}
+    {
+        // header params
+	{{ range $p := $a.Params.Header }}
+        if val := req.Header.Get("{{ $p.Name }}"); val != "" {
+            r.{{ export $p.Name }}, err = {{ $p.Parser "val" }}
+            if err != nil {
+                return err
+            }
+        }
+	{{ end }}
+	}
+	{{ end }}
+
 	{{ if $a.Params.Path }}
     {
         var val string
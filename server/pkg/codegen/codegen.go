@@ -58,6 +58,8 @@ func Proc() {
 		restSrc     []string
 		restDefs    []*restDef
 
+		openapiDst = filepath.Join("docs", "openapi.gen.json")
+
 		aFuncsSrcPath = filepath.Join("*", "automation", "*_handler.yaml")
 		aFuncsSrc     []string
 		aFuncsDefs    []*aFuncDefs
@@ -236,6 +238,10 @@ func Proc() {
 				if genCode {
 					err = genRest(tpls, restDefs...)
 				}
+
+				if genCode && err == nil {
+					err = genOpenAPI(openapiDst, restDefs...)
+				}
 			}
 
 			if outputErr(err, "failed to process rest:\n") {
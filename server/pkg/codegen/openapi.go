@@ -0,0 +1,592 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// OpenAPI 3.1 document, generated from the REST definitions
+//
+// Only the subset of the specification we need is covered;
+// schemas are JSON Schema (2020-12) objects
+type (
+	openapiDoc struct {
+		OpenAPI    string                           `json:"openapi"`
+		Info       openapiInfo                      `json:"info"`
+		Servers    []openapiServer                  `json:"servers"`
+		Security   []map[string][]string            `json:"security"`
+		Tags       []openapiTag                     `json:"tags"`
+		Paths      map[string]map[string]*openapiOp `json:"paths"`
+		Components openapiComponents                `json:"components"`
+	}
+
+	openapiInfo struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+		License     struct {
+			Name       string `json:"name"`
+			Identifier string `json:"identifier"`
+		} `json:"license"`
+	}
+
+	openapiServer struct {
+		URL         string `json:"url"`
+		Description string `json:"description"`
+	}
+
+	openapiTag struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}
+
+	openapiOp struct {
+		OperationID string                      `json:"operationId"`
+		Summary     string                      `json:"summary"`
+		Tags        []string                    `json:"tags"`
+		Parameters  []*openapiParam             `json:"parameters,omitempty"`
+		RequestBody *openapiRequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*openapiResponse `json:"responses"`
+	}
+
+	openapiParam struct {
+		Name        string        `json:"name"`
+		In          string        `json:"in"`
+		Description string        `json:"description,omitempty"`
+		Required    bool          `json:"required"`
+		Schema      openapiSchema `json:"schema"`
+	}
+
+	openapiRequestBody struct {
+		Required bool                        `json:"required"`
+		Content  map[string]openapiMediaType `json:"content"`
+	}
+
+	openapiResponse struct {
+		Description string                      `json:"description"`
+		Content     map[string]openapiMediaType `json:"content,omitempty"`
+	}
+
+	openapiMediaType struct {
+		Schema openapiSchema `json:"schema"`
+	}
+
+	openapiComponents struct {
+		Schemas         map[string]openapiSchema `json:"schemas"`
+		SecuritySchemes map[string]openapiSchema `json:"securitySchemes"`
+	}
+
+	openapiSchema map[string]interface{}
+)
+
+const (
+	openapiVersion = "3.1.0"
+
+	// kinds of responses
+	//
+	// Set explicitly on the API definition (response: <kind>) or
+	// inferred from the method and parameters
+	restResponseObject  = "object"
+	restResponseSet     = "set"
+	restResponseSuccess = "success"
+	restResponseBinary  = "binary"
+)
+
+// generates one OpenAPI document for all REST definitions
+func genOpenAPI(dst string, dd ...*restDef) (err error) {
+	var (
+		doc *openapiDoc
+		buf []byte
+	)
+
+	if doc, err = openapi(dd...); err != nil {
+		return
+	}
+
+	if buf, err = json.MarshalIndent(doc, "", "  "); err != nil {
+		return
+	}
+
+	return os.WriteFile(dst, append(buf, '\n'), 0644)
+}
+
+func openapi(dd ...*restDef) (doc *openapiDoc, err error) {
+	doc = &openapiDoc{
+		OpenAPI: openapiVersion,
+		Servers: []openapiServer{{URL: "/api", Description: "Corteza REST API"}},
+		Security: []map[string][]string{
+			{"bearerAuth": {}},
+		},
+		Paths: make(map[string]map[string]*openapiOp),
+		Components: openapiComponents{
+			Schemas: map[string]openapiSchema{
+				"Response": {
+					"type":        "object",
+					"description": "Successful response",
+					"properties":  map[string]interface{}{"response": openapiSchema{}},
+					"required":    []string{"response"},
+				},
+				"SetResponse": {
+					"type":        "object",
+					"description": "Successful response with a paged list of items",
+					"properties": map[string]interface{}{
+						"response": openapiSchema{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"filter": openapiSchema{"type": "object"},
+								"set":    openapiSchema{"type": "array", "items": openapiSchema{"type": "object"}},
+							},
+						},
+					},
+					"required": []string{"response"},
+				},
+				"Success": {
+					"type":        "object",
+					"description": "Successful response without payload",
+					"properties": map[string]interface{}{
+						"success": openapiSchema{
+							"type":       "object",
+							"properties": map[string]interface{}{"message": openapiSchema{"type": "string"}},
+						},
+					},
+					"required": []string{"success"},
+				},
+				"Error": {
+					"type":        "object",
+					"description": "Error response",
+					"properties": map[string]interface{}{
+						"error": openapiSchema{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"message": openapiSchema{"type": "string"},
+								"meta":    openapiSchema{"type": "object"},
+							},
+							"required": []string{"message"},
+						},
+					},
+					"required": []string{"error"},
+				},
+			},
+			SecuritySchemes: map[string]openapiSchema{
+				"bearerAuth": {
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+			},
+		},
+	}
+
+	doc.Info.Title = "Corteza REST API"
+	doc.Info.Description = "Generated from the REST API definitions (rest.yaml)"
+	// replaced with the actual server version when served
+	doc.Info.Version = "0.0.0"
+	doc.Info.License.Name = "Apache 2.0"
+	doc.Info.License.Identifier = "Apache-2.0"
+
+	// make sure output does not depend on the order of the files
+	sort.Slice(dd, func(i, j int) bool { return dd[i].outputDir < dd[j].outputDir })
+
+	for _, d := range dd {
+		var (
+			app = path.Base(d.outputDir)
+
+			// resource types (structs) from the app's types package
+			resources map[string]openapiSchema
+		)
+
+		if resources, err = openapiResources(path.Join(d.outputDir, "types")); err != nil {
+			return nil, fmt.Errorf("%s: %w", app, err)
+		}
+
+		for _, e := range d.Endpoints {
+			tag := fmt.Sprintf("%s: %s", export(app), e.Title)
+			doc.Tags = append(doc.Tags, openapiTag{Name: tag, Description: strings.TrimSpace(e.Description)})
+
+			// endpoints are named after the resource they manage
+			// (entrypoint user => types.User); endpoints without a matching
+			// struct (auth, settings, stats...) use generic response schemas
+			var resource string
+			if schema, has := resources[export(e.Entrypoint)]; has {
+				resource = export(app, e.Entrypoint)
+				doc.Components.Schemas[resource] = schema
+				doc.Components.Schemas[resource+"Response"] = openapiResourceResponse(resource)
+				doc.Components.Schemas[resource+"SetResponse"] = openapiResourceSetResponse(resource)
+			}
+
+			for _, a := range e.Apis {
+				var (
+					p      = "/" + app + e.Path + a.Path
+					method = strings.ToLower(a.Method)
+					op     *openapiOp
+				)
+
+				if op, err = openapiOperation(app, e, a, resource); err != nil {
+					return nil, fmt.Errorf("%s %s: %w", a.Method, p, err)
+				}
+
+				op.Tags = []string{tag}
+
+				if doc.Paths[p] == nil {
+					doc.Paths[p] = make(map[string]*openapiOp)
+				}
+
+				if doc.Paths[p][method] != nil {
+					return nil, fmt.Errorf("duplicate operation %s %s", a.Method, p)
+				}
+
+				doc.Paths[p][method] = op
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// openapiOperation converts API definition to OpenAPI operation
+//
+// When resource is set, reads, creates, updates and lists reference
+// resource's component schema instead of the generic response
+func openapiOperation(app string, e *restEndpointDef, a *restEndpointApi, resource string) (op *openapiOp, err error) {
+	op = &openapiOp{
+		OperationID: camelCase(app, export(e.Entrypoint), export(a.Name)),
+		Summary:     a.Title,
+		Responses: map[string]*openapiResponse{
+			"default": {
+				Description: "Error",
+				Content:     openapiJSON(openapiRef("Error")),
+			},
+		},
+	}
+
+	for _, p := range a.Params.Path {
+		op.Parameters = append(op.Parameters, &openapiParam{
+			Name:        p.Name,
+			In:          "path",
+			Description: p.Title,
+			// path parameters are always required
+			Required: true,
+			Schema:   openapiTypeSchema(p.Type),
+		})
+	}
+
+	for _, p := range a.Params.Get {
+		op.Parameters = append(op.Parameters, &openapiParam{
+			Name:        p.Name,
+			In:          "query",
+			Description: p.Title,
+			Required:    p.Required,
+			Schema:      openapiTypeSchema(p.Type),
+		})
+	}
+
+	for _, p := range a.Params.Header {
+		op.Parameters = append(op.Parameters, &openapiParam{
+			Name:        p.Name,
+			In:          "header",
+			Description: p.Title,
+			Required:    p.Required,
+			Schema:      openapiTypeSchema(p.Type),
+		})
+	}
+
+	if len(a.Params.Post) > 0 {
+		op.RequestBody = openapiBody(a.Params.Post)
+	}
+
+	switch kind := a.responseKind(); {
+	case kind == restResponseObject && resource != "" && a.returnsResource():
+		op.Responses["200"] = &openapiResponse{Description: "OK", Content: openapiJSON(openapiRef(resource + "Response"))}
+	case kind == restResponseObject:
+		op.Responses["200"] = &openapiResponse{Description: "OK", Content: openapiJSON(openapiRef("Response"))}
+	case kind == restResponseSet && resource != "" && a.Name == "list":
+		op.Responses["200"] = &openapiResponse{Description: "OK", Content: openapiJSON(openapiRef(resource + "SetResponse"))}
+	case kind == restResponseSet:
+		op.Responses["200"] = &openapiResponse{Description: "OK", Content: openapiJSON(openapiRef("SetResponse"))}
+	case kind == restResponseSuccess:
+		op.Responses["200"] = &openapiResponse{Description: "OK", Content: openapiJSON(openapiRef("Success"))}
+	case kind == restResponseBinary:
+		op.Responses["200"] = &openapiResponse{
+			Description: "OK",
+			Content: map[string]openapiMediaType{
+				"application/octet-stream": {Schema: openapiSchema{"type": "string", "contentEncoding": "binary"}},
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown response kind %q", kind)
+	}
+
+	return
+}
+
+// request body schema with all post parameters
+//
+// Requests with uploads are multipart forms, all others
+// can be sent as JSON or as an url-encoded form
+func openapiBody(pp []*restEndpointParamDef) *openapiRequestBody {
+	var (
+		upload   bool
+		required = make([]string, 0)
+		props    = make(map[string]interface{})
+		schema   = openapiSchema{"type": "object", "properties": props}
+		body     = &openapiRequestBody{}
+	)
+
+	for _, p := range pp {
+		s := openapiTypeSchema(p.Type)
+		if p.Title != "" {
+			s["description"] = p.Title
+		}
+
+		props[p.Name] = s
+
+		if p.Required {
+			required = append(required, p.Name)
+		}
+
+		upload = upload || p.IsUpload()
+	}
+
+	if len(required) > 0 {
+		schema["required"] = required
+		body.Required = true
+	}
+
+	if upload {
+		body.Content = map[string]openapiMediaType{"multipart/form-data": {Schema: schema}}
+	} else {
+		body.Content = map[string]openapiMediaType{
+			"application/json":                  {Schema: schema},
+			"application/x-www-form-urlencoded": {Schema: schema},
+		}
+	}
+
+	return body
+}
+
+// converts Go type from the REST definition to JSON schema
+func openapiTypeSchema(t string) openapiSchema {
+	switch t {
+	case "string", "types.UserKind", "types.IconType":
+		return openapiSchema{"type": "string"}
+	case "bool":
+		return openapiSchema{"type": "boolean"}
+	case "int", "int64":
+		return openapiSchema{"type": "integer", "format": "int64"}
+	case "uint":
+		return openapiSchema{"type": "integer", "minimum": 0}
+	case "uint64":
+		// IDs are too big for JSON numbers
+		return openapiSchema{"type": "string", "pattern": "^[0-9]+$"}
+	case "float", "float64":
+		return openapiSchema{"type": "number"}
+	case "time.Time", "*time.Time":
+		return openapiSchema{"type": "string", "format": "date-time"}
+	case "*multipart.FileHeader":
+		return openapiSchema{"type": "string", "contentMediaType": "application/octet-stream"}
+	case "filter.State":
+		return openapiSchema{"type": "integer", "enum": []int{0, 1, 2}}
+	case "map[string]string":
+		return openapiSchema{"type": "object", "additionalProperties": openapiSchema{"type": "string"}}
+	case "sqlxTypes.JSONText", "json.RawMessage":
+		// any JSON value
+		return openapiSchema{}
+	}
+
+	switch {
+	case strings.HasPrefix(t, "[]"):
+		return openapiSchema{"type": "array", "items": openapiTypeSchema(strings.TrimPrefix(t, "[]"))}
+	case strings.HasSuffix(t, "Set"):
+		return openapiSchema{"type": "array", "items": openapiSchema{"type": "object"}}
+	default:
+		// structs, maps and other complex types
+		return openapiSchema{"type": "object"}
+	}
+}
+
+func openapiRef(name string) openapiSchema {
+	return openapiSchema{"$ref": "#/components/schemas/" + name}
+}
+
+func openapiJSON(s openapiSchema) map[string]openapiMediaType {
+	return map[string]openapiMediaType{"application/json": {Schema: s}}
+}
+
+// responseKind returns explicitly set kind of response or infers it
+//
+// Paged lists (with pageCursor parameter) return sets
+// and deletes return success message
+func (a *restEndpointApi) responseKind() string {
+	if a.Response != "" {
+		return a.Response
+	}
+
+	for _, p := range a.Params.Get {
+		if p.Name == "pageCursor" {
+			return restResponseSet
+		}
+	}
+
+	if strings.ToUpper(a.Method) == http.MethodDelete {
+		return restResponseSuccess
+	}
+
+	return restResponseObject
+}
+
+// returnsResource reports if API returns the resource of the endpoint
+func (a *restEndpointApi) returnsResource() bool {
+	switch a.Name {
+	case "read", "create", "update":
+		return true
+	}
+
+	return false
+}
+
+func openapiResourceResponse(resource string) openapiSchema {
+	return openapiSchema{
+		"type":        "object",
+		"description": "Successful response with " + resource,
+		"properties":  map[string]interface{}{"response": openapiRef(resource)},
+		"required":    []string{"response"},
+	}
+}
+
+func openapiResourceSetResponse(resource string) openapiSchema {
+	return openapiSchema{
+		"type":        "object",
+		"description": "Successful response with a paged list of " + resource,
+		"properties": map[string]interface{}{
+			"response": openapiSchema{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"filter": openapiSchema{"type": "object"},
+					"set":    openapiSchema{"type": "array", "items": openapiRef(resource)},
+				},
+			},
+		},
+		"required": []string{"response"},
+	}
+}
+
+// openapiResources parses Go files in the types package and
+// converts structs to JSON schemas, using JSON tags for property names
+//
+// Missing types package is not considered an error
+func openapiResources(dir string) (rr map[string]openapiSchema, err error) {
+	var (
+		pkgs map[string]*ast.Package
+
+		// all type declarations, used to resolve named (non-struct) types
+		decl = make(map[string]ast.Expr)
+	)
+
+	rr = make(map[string]openapiSchema)
+
+	pkgs, err = parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if os.IsNotExist(err) {
+		return rr, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				if ts, is := n.(*ast.TypeSpec); is {
+					decl[ts.Name.Name] = ts.Type
+				}
+
+				return true
+			})
+		}
+	}
+
+	for name, t := range decl {
+		if st, is := t.(*ast.StructType); is && ast.IsExported(name) {
+			rr[name] = openapiStructSchema(st, decl)
+		}
+	}
+
+	return
+}
+
+func openapiStructSchema(st *ast.StructType, decl map[string]ast.Expr) openapiSchema {
+	props := make(map[string]interface{})
+
+	for _, f := range st.Fields.List {
+		var (
+			name string
+			opts []string
+		)
+
+		if f.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("json")
+			opts = strings.Split(tag, ",")
+			name = opts[0]
+		}
+
+		if name == "-" || len(f.Names) == 0 {
+			// skip ignored and embedded fields
+			continue
+		}
+
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+
+			p := name
+			if p == "" {
+				p = n.Name
+			}
+
+			s := openapiTypeSchema(openapiResolveType(f.Type, decl))
+			for i := 1; i < len(opts); i++ {
+				if opts[i] == "string" && s["type"] == "integer" {
+					// numbers encoded as strings
+					s = openapiSchema{"type": "string", "pattern": "^[0-9]+$"}
+				}
+			}
+
+			props[p] = s
+		}
+	}
+
+	return openapiSchema{"type": "object", "properties": props}
+}
+
+// resolves locally declared named types to their underlying
+// type (UserKind => string) so they can be converted to JSON schema
+func openapiResolveType(t ast.Expr, decl map[string]ast.Expr) string {
+	for i := 0; i < 5; i++ {
+		id, is := t.(*ast.Ident)
+		if !is || decl[id.Name] == nil {
+			break
+		}
+
+		if _, is = decl[id.Name].(*ast.StructType); is {
+			break
+		}
+
+		t = decl[id.Name]
+	}
+
+	return types.ExprString(t)
+}
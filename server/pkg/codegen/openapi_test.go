@@ -0,0 +1,147 @@
+package codegen
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenapiTypeSchema(t *testing.T) {
+	tcc := []struct {
+		typ    string
+		schema openapiSchema
+	}{
+		{"string", openapiSchema{"type": "string"}},
+		{"uint64", openapiSchema{"type": "string", "pattern": "^[0-9]+$"}},
+		{"[]uint64", openapiSchema{"type": "array", "items": openapiSchema{"type": "string", "pattern": "^[0-9]+$"}}},
+		{"*time.Time", openapiSchema{"type": "string", "format": "date-time"}},
+		{"sqlxTypes.JSONText", openapiSchema{}},
+		{"types.RecordValueSet", openapiSchema{"type": "array", "items": openapiSchema{"type": "object"}}},
+		{"*types.UserMeta", openapiSchema{"type": "object"}},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.typ, func(t *testing.T) {
+			require.Equal(t, tc.schema, openapiTypeSchema(tc.typ))
+		})
+	}
+}
+
+func TestOpenapi(t *testing.T) {
+	var (
+		req = require.New(t)
+		dir = path.Join(t.TempDir(), "system")
+		def = &restDef{
+			outputDir: dir,
+			Endpoints: []*restEndpointDef{{
+				Title:      "Users",
+				Path:       "/users",
+				Entrypoint: "user",
+				Apis: []*restEndpointApi{
+					{
+						Name:   "list",
+						Method: "GET",
+						Path:   "/",
+						Params: restEndpointParamsDef{Get: []*restEndpointParamDef{
+							{Name: "query", Type: "string"},
+							{Name: "pageCursor", Type: "string"},
+						}},
+					},
+					{
+						Name:   "update",
+						Method: "PUT",
+						Path:   "/{userID}",
+						Params: restEndpointParamsDef{
+							Path:   []*restEndpointParamDef{{Name: "userID", Type: "uint64"}},
+							Post:   []*restEndpointParamDef{{Name: "email", Type: "string", Required: true}},
+							Header: []*restEndpointParamDef{{Name: "If-Match", Type: "string"}},
+						},
+					},
+					{
+						Name:   "sessionsRemove",
+						Method: "POST",
+						Path:   "/{userID}/sessions/remove",
+						Params: restEndpointParamsDef{
+							Path: []*restEndpointParamDef{{Name: "userID", Type: "uint64"}},
+						},
+					},
+					{
+						Name:   "delete",
+						Method: "DELETE",
+						Path:   "/{userID}",
+						Params: restEndpointParamsDef{
+							Path: []*restEndpointParamDef{{Name: "userID", Type: "uint64"}},
+						},
+					},
+				},
+			}},
+		}
+	)
+
+	req.NoError(os.MkdirAll(path.Join(dir, "types"), 0755))
+	req.NoError(os.WriteFile(path.Join(dir, "types", "user.go"), []byte(`package types
+
+type (
+	User struct {
+		ID       uint64   `+"`json:\"userID,string\"`"+`
+		Email    string   `+"`json:\"email\"`"+`
+		Kind     UserKind `+"`json:\"kind\"`"+`
+		Meta     *UserMeta
+		password string
+	}
+
+	UserMeta struct{}
+
+	UserKind string
+)
+`), 0644))
+
+	doc, err := openapi(def)
+	req.NoError(err)
+	req.Equal("3.1.0", doc.OpenAPI)
+	req.Len(doc.Tags, 1)
+	req.Equal("System: Users", doc.Tags[0].Name)
+
+	list := doc.Paths["/system/users/"]["get"]
+	req.NotNil(list)
+	req.Equal("systemUserList", list.OperationID)
+	req.Len(list.Parameters, 2)
+	req.Equal(openapiRef("SystemUserSetResponse"), list.Responses["200"].Content["application/json"].Schema)
+
+	update := doc.Paths["/system/users/{userID}"]["put"]
+	req.NotNil(update)
+	req.True(update.Parameters[0].Required)
+	req.Equal("header", update.Parameters[1].In)
+	req.Equal("If-Match", update.Parameters[1].Name)
+	req.True(update.RequestBody.Required)
+	req.Equal([]string{"email"}, update.RequestBody.Content["application/json"].Schema["required"])
+	req.Equal(openapiRef("SystemUserResponse"), update.Responses["200"].Content["application/json"].Schema)
+
+	// operations that do not return the resource use generic response
+	sessions := doc.Paths["/system/users/{userID}/sessions/remove"]["post"]
+	req.NotNil(sessions)
+	req.Equal(openapiRef("Response"), sessions.Responses["200"].Content["application/json"].Schema)
+
+	del := doc.Paths["/system/users/{userID}"]["delete"]
+	req.NotNil(del)
+	req.Equal(openapiRef("Success"), del.Responses["200"].Content["application/json"].Schema)
+
+	req.Equal(openapiSchema{"type": "object", "properties": map[string]interface{}{
+		"userID": openapiSchema{"type": "string", "pattern": "^[0-9]+$"},
+		"email":  openapiSchema{"type": "string"},
+		"kind":   openapiSchema{"type": "string"},
+		"Meta":   openapiSchema{"type": "object"},
+	}}, doc.Components.Schemas["SystemUser"])
+
+	req.Equal(
+		openapiRef("SystemUser"),
+		doc.Components.Schemas["SystemUserSetResponse"]["properties"].(map[string]interface{})["response"].(openapiSchema)["properties"].(map[string]interface{})["set"].(openapiSchema)["items"],
+	)
+
+	// same operation defined twice
+	def.Endpoints[0].Apis = append(def.Endpoints[0].Apis, def.Endpoints[0].Apis[0])
+	_, err = openapi(def)
+	req.Error(err)
+}
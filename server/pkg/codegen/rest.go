@@ -42,12 +42,17 @@ type (
 		Title  string                `yaml:"title"`
 		Path   string                `yaml:"path"`
 		Params restEndpointParamsDef `yaml:"parameters,omitempty"`
+
+		// kind of response (object, set, success, binary)
+		// used for OpenAPI document; inferred when not set
+		Response string `yaml:"response,omitempty"`
 	}
 
 	restEndpointParamsDef struct {
-		Post []*restEndpointParamDef `yaml:"post"`
-		Path []*restEndpointParamDef `yaml:"path"`
-		Get  []*restEndpointParamDef `yaml:"get"`
+		Post   []*restEndpointParamDef `yaml:"post"`
+		Path   []*restEndpointParamDef `yaml:"path"`
+		Get    []*restEndpointParamDef `yaml:"get"`
+		Header []*restEndpointParamDef `yaml:"header"`
 	}
 
 	restEndpointParamDef struct {
@@ -89,6 +94,7 @@ func procRest(mm ...string) (dd []*restDef, err error) {
 					a.Params.Path = append(e.Params.Path, a.Params.Path...)
 					a.Params.Post = append(e.Params.Post, a.Params.Post...)
 					a.Params.Get = append(e.Params.Get, a.Params.Get...)
+					a.Params.Header = append(e.Params.Header, a.Params.Header...)
 				}
 			}
 
@@ -162,6 +168,11 @@ func (d *restEndpointParamsDef) All() []*restEndpointParamDef {
 		pp = append(pp, p)
 	}
 
+	for _, p := range d.Header {
+		p.Origin = "HEADER"
+		pp = append(pp, p)
+	}
+
 	return pp
 }
 
@@ -188,6 +199,11 @@ func (d *restEndpointParamDef) IsString() bool {
 }
 
 func (d *restEndpointParamDef) FieldTag() string {
+	if d.Origin == "HEADER" {
+		// header params are never read from the JSON payload
+		return "`json:\"-\"`"
+	}
+
 	switch d.Type {
 	case "uint64":
 		return "`json:\",string\"`"
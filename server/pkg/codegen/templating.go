@@ -99,7 +99,7 @@ func kebabCase(pp string) (out string) {
 
 // PubIdent returns published identifier by uppercasing
 // input, cammelcasing it and removing ident unfriendly characters
-var nonIdentChars = regexp.MustCompile(`[\s\\/]+`)
+var nonIdentChars = regexp.MustCompile(`[\s\\/-]+`)
 
 func export(pp ...string) (out string) {
 	for _, p := range pp {
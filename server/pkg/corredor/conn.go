@@ -9,6 +9,7 @@ import (
 	"os"
 
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -92,6 +93,13 @@ func NewConnection(ctx context.Context, opt options.CorredorOpt, logger *zap.Log
 		dialOpts = append(dialOpts, grpc.WithBackoffMaxDelay(opt.MaxBackoffDelay))
 	}
 
+	// propagate trace context with every call
+	dialOpts = append(
+		dialOpts,
+		grpc.WithChainUnaryInterceptor(tracing.GrpcUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.GrpcStreamClientInterceptor()),
+	)
+
 	log.Info("connecting to corredor", zap.String("addr", opt.Addr))
 
 	return grpc.DialContext(ctx, opt.Addr, dialOpts...)
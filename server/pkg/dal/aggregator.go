@@ -3,12 +3,9 @@ package dal
 import (
 	"context"
 	"fmt"
-	"math"
-	"strings"
 
 	"github.com/cortezaproject/corteza/server/pkg/ql"
 	"github.com/modern-go/reflect2"
-	"github.com/spf13/cast"
 )
 
 type (
@@ -19,19 +16,15 @@ type (
 	// aggregation operations over the evaluated values.
 	//
 	// The aggregator computes the values on the fly (for the ones it can).
+	// Aggregate functions are provided by the aggregate function registry;
+	// see RegisterAggregateFunction.
 	aggregator struct {
-		// aggregates holds output aggregation values
-		// @note we'll use float64 for all values, but it might make more sense to split it up
-		//       in the future. For now, it'll be ok.
-		aggregates []float64
-
-		// counts holds the number of values for each aggregate including multi value fields.
-		// Counts are currently only used for average.
-		counts []int
+		// accumulators hold the aggregation state for each aggregate
+		accumulators []AggregateAccumulator
 
 		// def provides a list of aggregates.
 		//
-		// Each index corresponds to the aggregates and counts slices
+		// Each index corresponds to the accumulators slice
 		def []aggregateDef
 		// scanned indicates whether the aggregator has been scanned since
 		// we need to block writes after the first scan.
@@ -42,34 +35,21 @@ type (
 	aggregateDef struct {
 		outIdent string
 
-		aggOp string
+		aggOp  *AggregateFunction
+		params []any
 
 		inIdent string
 		eval    evaluator
 	}
 )
 
-var (
-	// aggregateFunctionIndex specifies all of the registered aggregate functions
-	//
-	// @todo consider making this expandable via some registry/plugin/...
-	aggregateFunctionIndex = map[string]bool{
-		"count": true,
-		"sum":   true,
-		"min":   true,
-		"max":   true,
-		"avg":   true,
-	}
-)
-
 // Aggregator initializes a new aggregator for the given set of mappings
 //
 // The aggregator is not routine safe; consider defining multiple aggregators
 // and then combining them together.
 func Aggregator() *aggregator {
 	return &aggregator{
-		aggregates: make([]float64, 0, 16),
-		counts:     make([]int, 0, 16),
+		accumulators: make([]AggregateAccumulator, 0, 16),
 	}
 }
 
@@ -102,10 +82,17 @@ func (a *aggregator) AddAggregate(ident string, expr *ql.ASTNode) (err error) {
 
 	// Take it from the expression
 	// - agg. op.
-	def.aggOp, expr, err = unpackExpressionNode(expr)
+	def.aggOp, def.params, expr, err = unpackExpressionNode(expr)
 	if err != nil {
 		return
 	}
+
+	// - initial accumulator; also validates function params
+	acc, err := def.aggOp.Accumulator(def.params...)
+	if err != nil {
+		return fmt.Errorf("invalid aggregate function %s: %v", def.aggOp.Ident, err)
+	}
+
 	// Prepare a runner in case we're not simply copying values
 	if inIdent == "" {
 		// - make evaluator
@@ -117,8 +104,7 @@ func (a *aggregator) AddAggregate(ident string, expr *ql.ASTNode) (err error) {
 		}
 	}
 
-	a.aggregates = append(a.aggregates, 0)
-	a.counts = append(a.counts, 0)
+	a.accumulators = append(a.accumulators, acc)
 	a.def = append(a.def, def)
 	return
 }
@@ -145,18 +131,13 @@ func (a *aggregator) Aggregate(ctx context.Context, v ValueGetter) (err error) {
 
 // Scan scans the aggregated values into the setter
 func (a *aggregator) Scan(s ValueSetter) (err error) {
-	// On first scan, complete partial aggregates
-	if !a.scanned {
-		a.completePartials()
-	}
-
 	a.scanned = true
 
 	// Set the values
 	for i, attr := range a.def {
 		// @note each aggregated value can be at most one so no need for multi-value
 		//       suport here.
-		err = s.SetValue(attr.outIdent, 0, a.aggregates[i])
+		err = s.SetValue(attr.outIdent, 0, a.value(i))
 		if err != nil {
 			return
 		}
@@ -165,26 +146,20 @@ func (a *aggregator) Scan(s ValueSetter) (err error) {
 	return
 }
 
+// value returns the current aggregated value of the i-th aggregate
+func (a *aggregator) value(i int) any {
+	return a.accumulators[i].Result()
+}
+
 // aggregate applies the provided value into the requested aggregate
 func (a *aggregator) aggregate(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	switch attr.aggOp {
-	case "count":
-		return a.count(ctx, attr, i, v)
-
-	case "sum":
-		return a.sum(ctx, attr, i, v)
-
-	case "min":
-		return a.min(ctx, attr, i, v)
-
-	case "max":
-		return a.max(ctx, attr, i, v)
-
-	case "avg":
-		return a.avg(ctx, attr, i, v)
-	}
+	return a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
+		if isNil {
+			return
+		}
 
-	return fmt.Errorf("unsupported aggregate function: %s", attr.aggOp)
+		a.accumulators[i].Add(v)
+	})
 }
 
 // walkValues traverses the available values for the specified attribute
@@ -215,116 +190,11 @@ func (a *aggregator) walkValues(ctx context.Context, r ValueGetter, cc map[strin
 	return nil
 }
 
-// Aggregate methods
-
-func (a *aggregator) count(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	err = a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
-		if isNil {
-			return
-		}
-
-		a.aggregates[i]++
-		a.counts[i]++
-	})
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (a *aggregator) sum(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	err = a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
-		if isNil {
-			return
-		}
-		a.aggregates[i] += cast.ToFloat64(v)
-		a.counts[i]++
-	})
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (a *aggregator) min(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	err = a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
-		if isNil {
-			return
-		}
-
-		if a.counts[i] == 0 {
-			a.aggregates[i] = cast.ToFloat64(v)
-		} else {
-			a.aggregates[i] = math.Min(a.aggregates[i], cast.ToFloat64(v))
-		}
-		a.counts[i]++
-	})
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (a *aggregator) max(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	err = a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
-		if isNil {
-			return
-		}
-
-		if a.counts[i] == 0 {
-			a.aggregates[i] = cast.ToFloat64(v)
-		} else {
-			a.aggregates[i] = math.Max(a.aggregates[i], cast.ToFloat64(v))
-		}
-		a.counts[i]++
-	})
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (a *aggregator) avg(ctx context.Context, attr aggregateDef, i int, v ValueGetter) (err error) {
-	err = a.walkValues(ctx, v, v.CountValues(), attr, func(v any, isNil bool) {
-		if isNil {
-			return
-		}
-
-		a.aggregates[i] += cast.ToFloat64(v)
-
-		a.counts[i]++
-	})
-	if err != nil {
-		return
-	}
-
-	return
-}
-
-func (a *aggregator) completePartials() {
-	a.completeAverage()
-}
-
-func (a *aggregator) completeAverage() {
-	for i, attr := range a.def {
-		if attr.aggOp == "avg" {
-			if a.counts[i] == 0 {
-				return
-			}
-			a.aggregates[i] = a.aggregates[i] / float64(a.counts[i])
-		}
-	}
-}
-
 // Utilities
 
 func unpackMappingSource(n *ql.ASTNode) (ident string, expr *ql.ASTNode, err error) {
 	// Check if first arg of agg. fnc. is an attr.
-	if len(n.Args) == 1 && n.Args[0].Symbol != "" {
+	if len(n.Args) > 0 && n.Args[0].Symbol != "" {
 		return n.Args[0].Symbol, n, nil
 	}
 
@@ -332,17 +202,28 @@ func unpackMappingSource(n *ql.ASTNode) (ident string, expr *ql.ASTNode, err err
 	return
 }
 
-func unpackExpressionNode(n *ql.ASTNode) (aggOp string, expr *ql.ASTNode, err error) {
+func unpackExpressionNode(n *ql.ASTNode) (aggOp *AggregateFunction, params []any, expr *ql.ASTNode, err error) {
 	if n.Ref != "" {
-		aggOp = strings.ToLower(n.Ref)
+		aggOp = aggregateFunction(n.Ref)
 	}
-	if !aggregateFunctionIndex[aggOp] {
+	if aggOp == nil {
 		err = fmt.Errorf("root expression must be an aggregate function")
 		return
 	}
 
-	if len(n.Args) > 0 {
-		expr = n.Args[0]
+	if len(n.Args) == 0 {
+		return
+	}
+	expr = n.Args[0]
+
+	// Any additional arguments are function params; those must be literals
+	for _, a := range n.Args[1:] {
+		if a.Value == nil {
+			err = fmt.Errorf("aggregate function %s arguments must be literal values", aggOp.Ident)
+			return
+		}
+
+		params = append(params, a.Value.V.Get())
 	}
 	return
 }
@@ -351,9 +232,9 @@ func unpackExpressionNode(n *ql.ASTNode) (aggOp string, expr *ql.ASTNode, err er
 //
 // Don't use it in production code.
 func (a *aggregator) reset() {
-	for i := 0; i < len(a.aggregates); i++ {
-		a.aggregates[i] = 0
-		a.counts[i] = 0
+	for i, def := range a.def {
+		// params were already validated when adding the aggregate
+		a.accumulators[i], _ = def.aggOp.Accumulator(def.params...)
 	}
 	a.scanned = false
 }
@@ -0,0 +1,345 @@
+package dal
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+)
+
+type (
+	// AggregateFunction defines an aggregate function the aggregator can perform
+	//
+	// Aggregate functions are used as the root of the aggregate expression; for
+	// example sum(a + b) or percentile(a, 0.95).
+	AggregateFunction struct {
+		// Ident is the function name as used in expressions
+		Ident string
+
+		// OutType is the type of the aggregated value
+		//
+		// When not set, the type of the aggregated expression is used.
+		OutType Type
+
+		// Accumulator initializes a new accumulator for the provided function
+		// params (literal arguments following the aggregated expression).
+		//
+		// The function should validate the params as it is called when
+		// the aggregate is defined.
+		Accumulator func(params ...any) (AggregateAccumulator, error)
+	}
+
+	// AggregateAccumulator accumulates the values of a single aggregation group
+	AggregateAccumulator interface {
+		// Add adds a non-nil value to the accumulator
+		Add(v any)
+
+		// Result returns the aggregated value
+		Result() any
+	}
+
+	aggCount struct {
+		n float64
+	}
+
+	aggCountDistinct struct {
+		seen map[string]bool
+	}
+
+	aggSum struct {
+		sum float64
+	}
+
+	aggMin struct {
+		v   float64
+		has bool
+	}
+
+	aggMax struct {
+		v   float64
+		has bool
+	}
+
+	aggAvg struct {
+		sum float64
+		n   int
+	}
+
+	aggPercentile struct {
+		p  float64
+		vv []float64
+	}
+
+	aggStddev struct {
+		// Welford's online algorithm
+		n    int
+		mean float64
+		m2   float64
+	}
+
+	aggFirst struct {
+		v   any
+		has bool
+	}
+
+	aggLast struct {
+		v any
+	}
+
+	aggStringAgg struct {
+		sep string
+		vv  []string
+	}
+)
+
+var (
+	aggregateFunctionRegistry = map[string]*AggregateFunction{}
+	aggregateFunctionMux      sync.RWMutex
+
+	// baseAggregateFunctions are the aggregate functions all of the connections
+	// supporting aggregation are expected to perform
+	baseAggregateFunctions = map[string]bool{
+		"count": true,
+		"sum":   true,
+		"min":   true,
+		"max":   true,
+		"avg":   true,
+	}
+)
+
+func init() {
+	RegisterAggregateFunction(
+		&AggregateFunction{
+			Ident:       "count",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggCount{} }),
+		},
+		&AggregateFunction{
+			Ident:       "count_distinct",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggCountDistinct{seen: make(map[string]bool)} }),
+		},
+		&AggregateFunction{
+			Ident:       "sum",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggSum{} }),
+		},
+		&AggregateFunction{
+			Ident:       "min",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggMin{} }),
+		},
+		&AggregateFunction{
+			Ident:       "max",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggMax{} }),
+		},
+		&AggregateFunction{
+			Ident:       "avg",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggAvg{} }),
+		},
+		&AggregateFunction{
+			Ident:       "median",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggPercentile{p: 0.5} }),
+		},
+		&AggregateFunction{
+			Ident:       "percentile",
+			OutType:     &TypeNumber{},
+			Accumulator: newAggPercentile,
+		},
+		&AggregateFunction{
+			Ident:       "stddev",
+			OutType:     &TypeNumber{},
+			Accumulator: noParams(func() AggregateAccumulator { return &aggStddev{} }),
+		},
+		&AggregateFunction{
+			Ident:       "first",
+			Accumulator: noParams(func() AggregateAccumulator { return &aggFirst{} }),
+		},
+		&AggregateFunction{
+			Ident:       "last",
+			Accumulator: noParams(func() AggregateAccumulator { return &aggLast{} }),
+		},
+		&AggregateFunction{
+			Ident:       "string_agg",
+			OutType:     &TypeText{},
+			Accumulator: newAggStringAgg,
+		},
+	)
+}
+
+// RegisterAggregateFunction registers (or replaces) aggregate functions
+//
+// Connections which natively support the function should list it in
+// their aggregate operation analysis; otherwise the aggregation is
+// performed by the DAL.
+func RegisterAggregateFunction(ff ...*AggregateFunction) {
+	aggregateFunctionMux.Lock()
+	defer aggregateFunctionMux.Unlock()
+
+	for _, f := range ff {
+		aggregateFunctionRegistry[strings.ToLower(f.Ident)] = f
+	}
+}
+
+// AggregateFunctions returns the identifiers of all registered aggregate functions
+func AggregateFunctions() (out []string) {
+	aggregateFunctionMux.RLock()
+	defer aggregateFunctionMux.RUnlock()
+
+	out = make([]string, 0, len(aggregateFunctionRegistry))
+	for ident := range aggregateFunctionRegistry {
+		out = append(out, ident)
+	}
+
+	sort.Strings(out)
+	return
+}
+
+// aggregateFunction returns the registered aggregate function or nil
+func aggregateFunction(ident string) *AggregateFunction {
+	aggregateFunctionMux.RLock()
+	defer aggregateFunctionMux.RUnlock()
+
+	return aggregateFunctionRegistry[strings.ToLower(ident)]
+}
+
+// noParams wraps the accumulator constructor for functions without params
+func noParams(fn func() AggregateAccumulator) func(...any) (AggregateAccumulator, error) {
+	return func(params ...any) (AggregateAccumulator, error) {
+		if len(params) > 0 {
+			return nil, fmt.Errorf("function does not accept additional arguments")
+		}
+
+		return fn(), nil
+	}
+}
+
+func newAggPercentile(params ...any) (AggregateAccumulator, error) {
+	if len(params) != 1 {
+		return nil, fmt.Errorf("percentile requires exactly one additional argument")
+	}
+
+	p, err := cast.ToFloat64E(params[0])
+	if err != nil || p < 0 || p > 1 {
+		return nil, fmt.Errorf("percentile must be a number between 0 and 1")
+	}
+
+	return &aggPercentile{p: p}, nil
+}
+
+func newAggStringAgg(params ...any) (AggregateAccumulator, error) {
+	switch len(params) {
+	case 0:
+		return &aggStringAgg{sep: ","}, nil
+	case 1:
+		sep, ok := params[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("string_agg separator must be a string")
+		}
+		return &aggStringAgg{sep: sep}, nil
+	}
+
+	return nil, fmt.Errorf("string_agg accepts at most one additional argument")
+}
+
+// Accumulators
+
+func (a *aggCount) Add(v any)   { a.n++ }
+func (a *aggCount) Result() any { return a.n }
+
+func (a *aggCountDistinct) Add(v any)   { a.seen[cast.ToString(v)] = true }
+func (a *aggCountDistinct) Result() any { return float64(len(a.seen)) }
+
+func (a *aggSum) Add(v any)   { a.sum += cast.ToFloat64(v) }
+func (a *aggSum) Result() any { return a.sum }
+
+func (a *aggMin) Add(v any) {
+	if !a.has {
+		a.v, a.has = cast.ToFloat64(v), true
+		return
+	}
+
+	a.v = math.Min(a.v, cast.ToFloat64(v))
+}
+
+func (a *aggMin) Result() any { return a.v }
+
+func (a *aggMax) Add(v any) {
+	if !a.has {
+		a.v, a.has = cast.ToFloat64(v), true
+		return
+	}
+
+	a.v = math.Max(a.v, cast.ToFloat64(v))
+}
+
+func (a *aggMax) Result() any { return a.v }
+
+func (a *aggAvg) Add(v any) {
+	a.sum += cast.ToFloat64(v)
+	a.n++
+}
+
+func (a *aggAvg) Result() any {
+	if a.n == 0 {
+		return float64(0)
+	}
+
+	return a.sum / float64(a.n)
+}
+
+func (a *aggPercentile) Add(v any) { a.vv = append(a.vv, cast.ToFloat64(v)) }
+
+// Result returns the continuous percentile (linear interpolation between
+// the closest ranks) to match SQL's PERCENTILE_CONT
+func (a *aggPercentile) Result() any {
+	if len(a.vv) == 0 {
+		return float64(0)
+	}
+
+	sort.Float64s(a.vv)
+
+	rank := a.p * float64(len(a.vv)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+
+	return a.vv[lo] + (a.vv[hi]-a.vv[lo])*(rank-float64(lo))
+}
+
+func (a *aggStddev) Add(v any) {
+	x := cast.ToFloat64(v)
+
+	a.n++
+	d := x - a.mean
+	a.mean += d / float64(a.n)
+	a.m2 += d * (x - a.mean)
+}
+
+// Result returns the population standard deviation
+func (a *aggStddev) Result() any {
+	if a.n == 0 {
+		return float64(0)
+	}
+
+	return math.Sqrt(a.m2 / float64(a.n))
+}
+
+func (a *aggFirst) Add(v any) {
+	if !a.has {
+		a.v, a.has = v, true
+	}
+}
+
+func (a *aggFirst) Result() any { return a.v }
+
+func (a *aggLast) Add(v any)   { a.v = v }
+func (a *aggLast) Result() any { return a.v }
+
+func (a *aggStringAgg) Add(v any)   { a.vv = append(a.vv, cast.ToString(v)) }
+func (a *aggStringAgg) Result() any { return strings.Join(a.vv, a.sep) }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/spf13/cast"
 	"github.com/stretchr/testify/require"
 )
 
@@ -147,6 +148,95 @@ func TestAggregator(t *testing.T) {
 			},
 		},
 
+		{
+			name: "count distinct",
+			rows: []simpleRow{
+				{"v": 1},
+				{"v": 5},
+				{"v": nil},
+				{"v": 5},
+			},
+			out: simpleRow{"count": float64(2)},
+			attrubutes: []simpleAttribute{
+				{ident: "count", expr: "count_distinct(v)"},
+			},
+		},
+		{
+			name: "median",
+			rows: []simpleRow{
+				{"v": 35},
+				{"v": 1},
+				{"v": nil},
+				{"v": 5},
+				{"v": 11},
+			},
+			out: simpleRow{"median": float64(8)},
+			attrubutes: []simpleAttribute{
+				{ident: "median", expr: "median(v)"},
+			},
+		},
+		{
+			name: "percentile",
+			rows: []simpleRow{
+				{"v": 10},
+				{"v": 20},
+				{"v": 30},
+				{"v": 40},
+				{"v": 50},
+			},
+			out: simpleRow{"p90": float64(46), "p0": float64(10)},
+			attrubutes: []simpleAttribute{
+				{ident: "p90", expr: "percentile(v, 0.9)"},
+				{ident: "p0", expr: "percentile(v, 0)"},
+			},
+		},
+		{
+			name: "stddev",
+			rows: []simpleRow{
+				{"v": 2},
+				{"v": 4},
+				{"v": 4},
+				{"v": 4},
+				{"v": 5},
+				{"v": 5},
+				{"v": 7},
+				{"v": 9},
+			},
+			out: simpleRow{"stddev": float64(2)},
+			attrubutes: []simpleAttribute{
+				{ident: "stddev", expr: "stddev(v)"},
+			},
+		},
+		{
+			name: "first and last",
+			rows: []simpleRow{
+				{"v": nil},
+				{"v": "a"},
+				{"v": "b"},
+				{"v": "c"},
+				{"v": nil},
+			},
+			out: simpleRow{"first": "a", "last": "c"},
+			attrubutes: []simpleAttribute{
+				{ident: "first", expr: "first(v)"},
+				{ident: "last", expr: "last(v)"},
+			},
+		},
+		{
+			name: "string agg",
+			rows: []simpleRow{
+				{"v": "a"},
+				{"v": nil},
+				{"v": "b"},
+				{"v": 3},
+			},
+			out: simpleRow{"default": "a,b,3", "custom": "a; b; 3"},
+			attrubutes: []simpleAttribute{
+				{ident: "default", expr: "string_agg(v)"},
+				{ident: "custom", expr: "string_agg(v, '; ')"},
+			},
+		},
+
 		// With a nested expression
 		// @todo tests to assure nil values; omitting due to the gval issue
 		{
@@ -188,4 +278,48 @@ func TestAggregatorInit(t *testing.T) {
 		agg := Aggregator()
 		require.Error(t, agg.AddAggregateE("count", "div(v)"))
 	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		agg := Aggregator()
+		require.Error(t, agg.AddAggregateE("p", "percentile(v)"))
+		require.Error(t, agg.AddAggregateE("p", "percentile(v, 2)"))
+		require.Error(t, agg.AddAggregateE("p", "percentile(v, w)"))
+		require.Error(t, agg.AddAggregateE("s", "sum(v, 1)"))
+		require.Error(t, agg.AddAggregateE("s", "string_agg(v, 1)"))
+	})
+}
+
+func TestAggregatorRegisterFunction(t *testing.T) {
+	RegisterAggregateFunction(&AggregateFunction{
+		Ident:   "test_product",
+		OutType: &TypeNumber{},
+		Accumulator: noParams(func() AggregateAccumulator {
+			return &testAggProduct{p: 1}
+		}),
+	})
+	defer func() {
+		aggregateFunctionMux.Lock()
+		delete(aggregateFunctionRegistry, "test_product")
+		aggregateFunctionMux.Unlock()
+	}()
+
+	require.Contains(t, AggregateFunctions(), "test_product")
+
+	ctx := context.Background()
+	agg := Aggregator()
+	require.NoError(t, agg.AddAggregateE("p", "test_product(v)"))
+	for _, r := range []simpleRow{{"v": 2}, {"v": 3}, {"v": 4}} {
+		require.NoError(t, agg.Aggregate(ctx, r))
+	}
+
+	out := make(simpleRow)
+	require.NoError(t, agg.Scan(out))
+	require.Equal(t, simpleRow{"p": float64(24)}, out)
+}
+
+type testAggProduct struct {
+	p float64
 }
+
+func (a *testAggProduct) Add(v any)   { a.p *= cast.ToFloat64(v) }
+func (a *testAggProduct) Result() any { return a.p }
@@ -187,12 +187,15 @@ func (def *Aggregate) init(ctx context.Context, src Iterator) (exec *aggregate,
 	}
 	// - aggregates
 	for i, attr := range def.OutAttributes {
-		// @todo change when needed; currently, all aggregates are numbers
-		attr.Type = &TypeNumber{}
+		// Aggregate types are determined by the aggregate function
+		attr.Type = nil
 		attr, err = prepAttr(attr)
 		if err != nil {
 			return
 		}
+		if attr.Type == nil {
+			attr.Type = &TypeNumber{}
+		}
 
 		def.OutAttributes[i] = attr
 		idtf := attr.Identifier
@@ -257,6 +260,17 @@ func (def *Aggregate) determineAttrType(base AggregateAttr, ss []AttributeMappin
 		}
 
 		if a.Ref != "" {
+			// Aggregate functions with undefined output types (such as first)
+			// output the type of the aggregated expression
+			if af := aggregateFunction(a.Ref); af != nil {
+				if af.OutType == nil {
+					return true, a, nil
+				}
+
+				t = af.OutType
+				return false, a, nil
+			}
+
 			tmp := refToGvalExp[strings.ToLower(a.Ref)]
 			if tmp == nil || tmp.OutType == nil || tmp.OutTypeUnknown {
 				return true, a, nil
@@ -297,3 +311,44 @@ func (a AggregateAttr) toSimpleAttr() SimpleAttr {
 		Src:  "",
 	}
 }
+
+// aggregateFunctionsUsed returns the identifiers of aggregate functions
+// used by the aggregate attributes
+func aggregateFunctionsUsed(def *Aggregate) (out []string) {
+	var (
+		pp   = newQlParser()
+		seen = make(map[string]bool)
+		n    *ql.ASTNode
+		err  error
+	)
+
+	for _, attr := range def.OutAttributes {
+		n = attr.Expression
+		if attr.RawExpr != "" {
+			n, err = pp.Parse(attr.RawExpr)
+			if err != nil {
+				// invalid expressions are reported when the step is initialized
+				continue
+			}
+		}
+
+		if n == nil {
+			continue
+		}
+
+		n.Traverse(func(a *ql.ASTNode) (bool, *ql.ASTNode, error) {
+			if a.Ref == "" {
+				return true, a, nil
+			}
+
+			f := strings.ToLower(a.Ref)
+			if aggregateFunction(f) != nil && !seen[f] {
+				seen[f] = true
+				out = append(out, f)
+			}
+			return true, a, nil
+		})
+	}
+
+	return
+}
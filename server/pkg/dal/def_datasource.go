@@ -27,9 +27,7 @@ type (
 		// clobbered lists all of the steps that are offloaded into the datasource.
 		// The list is provided in order; the first step is the first step which should execute
 		// in the report pipeline.
-		//
-		// @todo change to generic step; doing this because I can for now
-		clobbered []*Aggregate
+		clobbered []PipelineStep
 
 		// provided in the init step so we can omit some code in the exec step
 		// @todo consider removing this
@@ -75,6 +73,9 @@ func (def *Datasource) Analyze(ctx context.Context) (err error) {
 	if _, ok := a[OpAnalysisJoin]; ok {
 		def.analysis[OpAnalysisJoin] = a[OpAnalysisJoin]
 	}
+	if _, ok := a[OpAnalysisWindow]; ok {
+		def.analysis[OpAnalysisWindow] = a[OpAnalysisWindow]
+	}
 
 	return
 }
@@ -129,23 +130,50 @@ func (def *Datasource) init(ctx context.Context) (err error) {
 	if len(def.clobbered) > 0 {
 		// @todo currently, we can only do one; change this when we tweak the DB
 		//       offloading code.
-		ag := def.clobbered[0]
+		switch cs := def.clobbered[0].(type) {
+		case *Aggregate:
+			// Invoke the aggregation's init to perform the validation and preparation logic
+			var wa *aggregate
+			wa, err = cs.init(ctx, nil)
+			if err != nil {
+				return
+			}
 
-		// Invoke the aggregation's init to perform the validation and preparation logic
-		var wa *aggregate
-		wa, err = ag.init(ctx, nil)
-		if err != nil {
-			return
-		}
+			// Preprocess the filters to conform to connection API
+			f, having, err := def.getAggregationFilters(def.filter, wa.filter)
+			if err != nil {
+				return err
+			}
 
-		// Preprocess the filters to conform to connection API
-		f, having, err := def.getAggregationFilters(def.filter, wa.filter)
-		if err != nil {
+			def.auxIter, err = def.connection.connection.Aggregate(ctx, def.model, f, wa.groupDefs, wa.aggregateDefs, having)
+			return err
+
+		case *Window:
+			// Invoke the window's init to perform the validation and preparation logic
+			var ww *window
+			ww, err = cs.init(ctx, nil)
+			if err != nil {
+				return
+			}
+
+			// The base filter is applied to the dataset before the window functions
+			// are calculated; the order and limit of the window's filter are
+			// applied to the final output.
+			f := def.filter
+			f.orderBy = nil
+			f.limit = 0
+			f.cursor = nil
+
+			attrs := make([]string, 0, len(def.OutAttributes))
+			for _, a := range def.OutAttributes {
+				attrs = append(attrs, a.Identifier())
+			}
+
+			def.auxIter, err = def.connection.connection.Window(ctx, def.model, f, attrs, cs.PartitionBy, cs.OrderBy, cs.OutAttributes, ww.filter)
 			return err
 		}
 
-		def.auxIter, err = def.connection.connection.Aggregate(ctx, def.model, f, wa.groupDefs, wa.aggregateDefs, having)
-		return err
+		return fmt.Errorf("cannot offload step %s", def.clobbered[0].Identifier())
 	}
 
 	def.auxIter, err = def.connection.connection.Search(ctx, def.model, def.filter)
@@ -198,7 +226,7 @@ func (def *Datasource) outAttrsFromModel(model *Model) (attrs []AttributeMapping
 	return
 }
 
-func (def *Datasource) shouldClobberAggregation() bool {
+func (def *Datasource) shouldClobberAggregation(ag *Aggregate) bool {
 	costs, ok := def.analysis[OpAnalysisAggregate]
 	if !ok {
 		return false
@@ -207,7 +235,36 @@ func (def *Datasource) shouldClobberAggregation() bool {
 	// @todo more to it; check and compare costs; for now we know that all rdbms
 	//       offloads will be faster.
 
-	_ = costs
+	// All of the used aggregate functions must be natively supported
+	for _, f := range aggregateFunctionsUsed(ag) {
+		if !costs.supportsFunction(f) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (def *Datasource) shouldClobberWindow(w *Window) bool {
+	costs, ok := def.analysis[OpAnalysisWindow]
+	if !ok {
+		return false
+	}
+
+	// All of the used window functions must be natively supported
+	for _, f := range windowFunctionsUsed(w) {
+		if !costs.Functions[f] {
+			return false
+		}
+	}
+
+	// Offloaded rows are shaped by the model so the attributes must not be
+	// renamed or multi-value
+	for _, a := range def.OutAttributes {
+		if a.Identifier() != a.Source() || a.Properties().IsMultivalue {
+			return false
+		}
+	}
 
 	return true
 }
@@ -224,7 +281,15 @@ func (def *Datasource) offloadedAttributes() [][]AttributeMapping {
 func (def *Datasource) clobber(s PipelineStep) (ok bool) {
 	switch cs := s.(type) {
 	case *Aggregate:
-		if !def.shouldClobberAggregation() {
+		if !def.shouldClobberAggregation(cs) {
+			return false
+		}
+
+		def.clobbered = append(def.clobbered, cs)
+		return true
+
+	case *Window:
+		if !def.shouldClobberWindow(cs) {
 			return false
 		}
 
@@ -13,8 +13,11 @@ type (
 	//
 	// The join step produces an SQL left join-like output where all right rows
 	// have a corresponding left row.
+	// The Type can be used to also produce rows without a corresponding row
+	// on the other side; see JoinType.
 	Join struct {
 		Ident    string
+		Type     JoinType
 		RelLeft  string
 		RelRight string
 		// @todo allow multiple join predicates; for now (for easier indexing)
@@ -33,6 +36,10 @@ type (
 		analysis map[string]OpAnalysis
 	}
 
+	// JoinType determines which rows without a corresponding row on the other
+	// side the join step produces
+	JoinType string
+
 	// JoinPredicate determines the attributes the two datasets should get joined on
 	JoinPredicate struct {
 		Left  string
@@ -51,6 +58,22 @@ type (
 	}
 )
 
+const (
+	// JoinInner produces only the rows with a corresponding row on both sides.
+	//
+	// This is also how the join step behaves when the type is omitted.
+	JoinInner JoinType = "inner"
+
+	// JoinLeft additionally produces left rows without a corresponding right row
+	JoinLeft JoinType = "left"
+
+	// JoinRight additionally produces right rows without a corresponding left row
+	JoinRight JoinType = "right"
+
+	// JoinFull additionally produces rows without a corresponding row on either side
+	JoinFull JoinType = "full"
+)
+
 func (def *Join) Identifier() string {
 	return def.Ident
 }
@@ -120,6 +143,19 @@ func (def *Join) init(ctx context.Context, left, right Iterator) (exec *joinLeft
 		rightSource: right,
 	}
 
+	switch def.Type {
+	case "", JoinInner:
+	case JoinLeft:
+		exec.keepLeft = true
+	case JoinRight:
+		exec.keepRight = true
+	case JoinFull:
+		exec.keepLeft = true
+		exec.keepRight = true
+	default:
+		return nil, fmt.Errorf("unknown join type %s", def.Type)
+	}
+
 	// Convert the provided filter into an internal filter
 	if def.Filter != nil {
 		def.filter, err = toInternalFilter(def.Filter)
@@ -0,0 +1,192 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+)
+
+type (
+	// Union produces a series of rows from all of the provided sources.
+	//
+	// All sources must provide the attributes the union step outputs and their
+	// types must be compatible.
+	// Rows of all sources are produced (like UNION ALL); duplicates are not removed.
+	Union struct {
+		Ident      string
+		RelSources []string
+		Filter     filter.Filter
+		filter     internalFilter
+
+		OutAttributes []AttributeMapping
+
+		// SourceAttributes holds attributes of each of the sources in the
+		// order the sources are defined in
+		SourceAttributes [][]AttributeMapping
+
+		rels     []PipelineStep
+		analysis map[string]OpAnalysis
+	}
+)
+
+func (def *Union) Identifier() string {
+	return def.Ident
+}
+
+func (def *Union) Sources() []string {
+	return def.RelSources
+}
+
+func (def *Union) Attributes() [][]AttributeMapping {
+	return [][]AttributeMapping{def.OutAttributes}
+}
+
+func (def *Union) Analyze(ctx context.Context) (err error) {
+	// @todo proper analysis; for now we'll leave this as defaults
+	def.analysis = map[string]OpAnalysis{
+		OpAnalysisIterate: {
+			ScanCost:   CostUnknown,
+			SearchCost: CostUnknown,
+			FilterCost: CostUnknown,
+			SortCost:   CostUnknown,
+			OutputSize: SizeUnknown,
+		},
+	}
+
+	return
+}
+
+func (def *Union) Analysis() map[string]OpAnalysis {
+	return def.analysis
+}
+
+func (def *Union) Optimize(req internalFilter) (res internalFilter, err error) {
+	err = fmt.Errorf("not implemented")
+	return
+}
+
+// iterator initializes an iterator based on the provided pipeline step definition
+func (def *Union) iterator(ctx context.Context, sources ...Iterator) (out Iterator, err error) {
+	exec, err := def.init(ctx, sources...)
+	if err != nil {
+		return
+	}
+
+	return exec, exec.init(ctx)
+}
+
+// dryrun performs step execution without interacting with the data
+// @todo consider rewording this
+func (def *Union) dryrun(ctx context.Context) (err error) {
+	_, err = def.init(ctx)
+	return
+}
+
+func (def *Union) init(ctx context.Context, sources ...Iterator) (exec *union, err error) {
+	exec = &union{
+		sources: sources,
+	}
+
+	if len(def.RelSources) < 2 && len(def.SourceAttributes) < 2 {
+		return nil, fmt.Errorf("union requires at least two sources")
+	}
+
+	// Convert the provided filter into an internal filter
+	if def.Filter != nil {
+		def.filter, err = toInternalFilter(def.Filter)
+		if err != nil {
+			return
+		}
+	}
+
+	// Collect attributes from the underlaying steps in case own are not provided
+	if len(def.SourceAttributes) == 0 {
+		def.SourceAttributes = make([][]AttributeMapping, len(def.rels))
+		for i, r := range def.rels {
+			def.SourceAttributes[i] = collectAttributes(r)
+		}
+	}
+
+	// When the output attributes are not provided, the attributes of the
+	// first source are used
+	if len(def.OutAttributes) == 0 && len(def.SourceAttributes) > 0 {
+		for _, a := range def.SourceAttributes[0] {
+			def.OutAttributes = append(def.OutAttributes, SimpleAttr{
+				Ident: a.Identifier(),
+				Src:   a.Identifier(),
+				Props: a.Properties(),
+			})
+		}
+	}
+
+	if len(def.OutAttributes) == 0 {
+		return nil, fmt.Errorf("no attributes specified")
+	}
+
+	// Make sure all of the sources are compatible
+	for i, aa := range def.SourceAttributes {
+		srcAttrs := make(map[string]AttributeMapping, len(aa))
+		for _, a := range aa {
+			srcAttrs[a.Identifier()] = a
+		}
+
+		for _, a := range def.OutAttributes {
+			sa, ok := srcAttrs[a.Source()]
+			if !ok {
+				return nil, fmt.Errorf("attribute %s does not exist in source %s", a.Source(), def.sourceIdent(i))
+			}
+
+			if !unionTypesCompatible(a.Properties().Type, sa.Properties().Type) {
+				return nil, fmt.Errorf("attribute %s of source %s is not compatible", a.Source(), def.sourceIdent(i))
+			}
+		}
+	}
+
+	// Assure and attempt to correct the provided sort to conform with the data set and the
+	// paging cursor (if any)
+	def.filter, err = assureSort(def.filter, collectPrimaryAttributes(def.OutAttributes))
+	if err != nil {
+		return
+	}
+
+	outAttrs := indexAttrs(def.OutAttributes...)
+	for _, s := range def.filter.OrderBy() {
+		if _, ok := outAttrs[s.Column]; !ok {
+			return nil, fmt.Errorf("order attribute %s does not exist", s.Column)
+		}
+	}
+
+	exec.filter = def.filter
+	exec.def = *def
+	return
+}
+
+// sourceIdent returns the identifier of the i-th source for error reporting
+func (def *Union) sourceIdent(i int) string {
+	if i < len(def.RelSources) {
+		return def.RelSources[i]
+	}
+
+	return fmt.Sprintf("#%d", i)
+}
+
+// unionTypesCompatible checks if values of the two types can be
+// combined in the same attribute
+func unionTypesCompatible(a, b Type) bool {
+	if a == nil || b == nil {
+		return true
+	}
+
+	ta, tb := a.Type(), b.Type()
+	if ta == tb {
+		return true
+	}
+
+	// identifiers and references are both stored as IDs
+	isID := func(t AttributeType) bool {
+		return t == AttributeTypeID || t == AttributeTypeRef
+	}
+
+	return isID(ta) && isID(tb)
+}
@@ -0,0 +1,301 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+)
+
+type (
+	// Window extends the rows of the source with the values of window functions
+	// calculated over the row's partition.
+	//
+	// Rows are partitioned by the PartitionBy attributes and ordered by the
+	// OrderBy within each partition.
+	// When the OrderBy is specified, aggregate functions (such as sum) produce
+	// running values over the rows up to (and including) the current row and its
+	// peers; otherwise, they are calculated over the entire partition.
+	//
+	// The filter is applied after the window functions are calculated so it can
+	// be used to, for example, get the top N rows of each partition.
+	Window struct {
+		Ident     string
+		RelSource string
+		Filter    filter.Filter
+		filter    internalFilter
+
+		PartitionBy []string
+		OrderBy     filter.SortExprSet
+
+		OutAttributes []WindowAttr
+
+		SourceAttributes []AttributeMapping
+
+		rel      PipelineStep
+		analysis map[string]OpAnalysis
+	}
+
+	// WindowAttr outlines the window function attribute definitions
+	//
+	// The expression's root must be a window function; row_number(), rank(),
+	// dense_rank(), lag(expr[, offset[, default]]), lead(expr[, offset[, default]])
+	// or any of the aggregate functions.
+	WindowAttr struct {
+		RawExpr string
+
+		Identifier string
+		Label      string
+		Expression *ql.ASTNode
+		Type       Type
+	}
+)
+
+var (
+	// windowFunctions are the functions which are not aggregate functions
+	// but can be used in the window step
+	windowFunctions = map[string]bool{
+		"row_number": true,
+		"rank":       true,
+		"dense_rank": true,
+		"lag":        true,
+		"lead":       true,
+	}
+)
+
+func (def *Window) Identifier() string {
+	return def.Ident
+}
+
+func (def *Window) Sources() []string {
+	return []string{def.RelSource}
+}
+
+func (def *Window) Attributes() [][]AttributeMapping {
+	src := def.SourceAttributes
+	if len(src) == 0 && def.rel != nil {
+		src = collectAttributes(def.rel)
+	}
+
+	out := make([]AttributeMapping, 0, len(src)+len(def.OutAttributes))
+
+	for _, a := range src {
+		out = append(out, SimpleAttr{
+			Ident: a.Identifier(),
+			Src:   a.Identifier(),
+			Props: a.Properties(),
+		})
+	}
+
+	for _, a := range def.OutAttributes {
+		out = append(out, a.toSimpleAttr())
+	}
+
+	return [][]AttributeMapping{out}
+}
+
+func (def *Window) Analyze(ctx context.Context) (err error) {
+	// @todo proper analysis; for now we'll leave this as defaults
+	def.analysis = map[string]OpAnalysis{
+		OpAnalysisIterate: {
+			ScanCost:   CostUnknown,
+			SearchCost: CostUnknown,
+			FilterCost: CostUnknown,
+			SortCost:   CostUnknown,
+			OutputSize: SizeUnknown,
+		},
+	}
+	return
+}
+
+func (def *Window) Analysis() map[string]OpAnalysis {
+	return def.analysis
+}
+
+func (def *Window) Optimize(req internalFilter) (res internalFilter, err error) {
+	err = fmt.Errorf("not implemented")
+	return
+}
+
+// iterator initializes an iterator based on the provided pipeline step definition
+func (def *Window) iterator(ctx context.Context, src Iterator) (out Iterator, err error) {
+	exec, err := def.init(ctx, src)
+	if err != nil {
+		return
+	}
+
+	return exec, exec.init(ctx)
+}
+
+// dryrun performs step execution without interacting with the data
+// @todo consider rewording this
+func (def *Window) dryrun(ctx context.Context) (err error) {
+	_, err = def.init(ctx, nil)
+	return
+}
+
+func (def *Window) init(ctx context.Context, src Iterator) (exec *window, err error) {
+	exec = &window{
+		source: src,
+	}
+
+	// Convert the provided filter into an internal filter
+	if def.Filter != nil {
+		def.filter, err = toInternalFilter(def.Filter)
+		if err != nil {
+			return
+		}
+	}
+
+	// Collect attributes from the underlaying step in case own are not provided
+	if len(def.SourceAttributes) == 0 {
+		def.SourceAttributes = collectAttributes(def.rel)
+	}
+
+	if len(def.OutAttributes) == 0 {
+		return nil, fmt.Errorf("no window attributes specified")
+	}
+
+	srcAttrs := indexAttrs(def.SourceAttributes...)
+
+	// Validate partitioning and ordering
+	for _, p := range def.PartitionBy {
+		if !srcAttrs[p] {
+			return nil, fmt.Errorf("partition attribute %s does not exist", p)
+		}
+	}
+	for _, s := range def.OrderBy {
+		if !srcAttrs[s.Column] {
+			return nil, fmt.Errorf("window order attribute %s does not exist", s.Column)
+		}
+	}
+
+	pp := newQlParser(func(ident ql.Ident) (_ ql.Ident, err error) {
+		if !srcAttrs[ident.Value] {
+			return ident, fmt.Errorf("unknown attribute %s", ident.Value)
+		}
+		return ident, nil
+	})
+
+	// Convert & validate window function definitions
+	outAttrs := make(map[string]bool, len(def.SourceAttributes)+len(def.OutAttributes))
+	indexAttrsInto(outAttrs, def.SourceAttributes...)
+	for i, attr := range def.OutAttributes {
+		if attr.RawExpr != "" {
+			attr.Expression, err = pp.Parse(attr.RawExpr)
+			if err != nil {
+				return
+			}
+		}
+		if attr.Expression == nil {
+			return nil, fmt.Errorf("window attribute %s has no expression", attr.Identifier)
+		}
+
+		if outAttrs[attr.Identifier] {
+			return nil, fmt.Errorf("duplicate attribute %s", attr.Identifier)
+		}
+		outAttrs[attr.Identifier] = true
+
+		var wd windowDef
+		wd, err = makeWindowDef(attr, srcAttrs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window attribute %s: %w", attr.Identifier, err)
+		}
+
+		if attr.Type == nil {
+			attr.Type = def.determineAttrType(attr, def.SourceAttributes)
+		}
+
+		def.OutAttributes[i] = attr
+		exec.defs = append(exec.defs, wd)
+	}
+
+	// Assure and attempt to correct the provided sort to conform with the data set and the
+	// paging cursor (if any)
+	def.filter, err = assureSort(def.filter, collectPrimaryAttributes(def.SourceAttributes))
+	if err != nil {
+		return
+	}
+
+	for _, s := range def.filter.OrderBy() {
+		if !outAttrs[s.Column] {
+			return nil, fmt.Errorf("order attribute %s does not exist", s.Column)
+		}
+	}
+
+	exec.filter = def.filter
+	exec.def = *def
+	return
+}
+
+// determineAttrType determines the output type of the window function
+//
+// Ranking functions produce numbers, offset functions produce the type of
+// the expression and aggregate functions use their output type.
+func (def *Window) determineAttrType(attr WindowAttr, ss []AttributeMapping) Type {
+	n := attr.Expression
+	fnc := strings.ToLower(n.Ref)
+
+	switch fnc {
+	case "row_number", "rank", "dense_rank":
+		return &TypeNumber{}
+	}
+
+	if af := aggregateFunction(fnc); af != nil && af.OutType != nil {
+		return af.OutType
+	}
+
+	// Offset functions and aggregate functions without the output type
+	// produce the type of the expression
+	if len(n.Args) > 0 && n.Args[0].Symbol != "" {
+		for _, s := range ss {
+			if s.Identifier() == n.Args[0].Symbol {
+				return s.Properties().Type
+			}
+		}
+	}
+
+	return &TypeNumber{}
+}
+
+// windowFunctionsUsed returns the window functions used by the window attributes
+func windowFunctionsUsed(def *Window) (out []string) {
+	var (
+		pp  = newQlParser()
+		n   *ql.ASTNode
+		err error
+	)
+
+	for _, attr := range def.OutAttributes {
+		n = attr.Expression
+		if attr.RawExpr != "" {
+			n, err = pp.Parse(attr.RawExpr)
+			if err != nil {
+				// invalid expressions are reported when the step is initialized
+				continue
+			}
+		}
+
+		if n == nil {
+			continue
+		}
+
+		out = append(out, strings.ToLower(n.Ref))
+	}
+
+	return
+}
+
+func (a WindowAttr) toSimpleAttr() SimpleAttr {
+	return SimpleAttr{
+		Ident: a.Identifier,
+		Src:   a.Identifier,
+		Props: MapProperties{
+			Label:    a.Label,
+			Type:     a.Type,
+			Nullable: true,
+		},
+	}
+}
@@ -58,6 +58,13 @@ type (
 		// Aggregate returns the iterator with aggregated data from the base model
 		Aggregate(ctx context.Context, m *Model, f filter.Filter, groupBy []AggregateAttr, aggrExpr []AggregateAttr, having *ql.ASTNode) (i Iterator, _ error)
 
+		// Window returns the iterator with the base model's attributes extended with
+		// the window function values
+		//
+		// The f filter is applied to the base model before the window functions are
+		// calculated; the outer filter is applied to the output.
+		Window(ctx context.Context, m *Model, f filter.Filter, attrs []string, partitionBy []string, orderBy filter.SortExprSet, out []WindowAttr, outer filter.Filter) (i Iterator, _ error)
+
 		// Delete deletes the given value
 		Delete(ctx context.Context, m *Model, pkv ValueGetter) error
 
@@ -90,6 +97,16 @@ type (
 		ApplyAlteration(ctx context.Context, sch *Model, aa ...*Alteration) []error
 	}
 
+	// ConditionalUpdater is implemented by connections that can
+	// check stored values and update them in a single operation
+	ConditionalUpdater interface {
+		// UpdateIf updates the given value when stored attribute values
+		// match the expected ones
+		//
+		// Returns false when nothing was updated
+		UpdateIf(ctx context.Context, m *Model, r ValueGetter, expected map[string]any) (bool, error)
+	}
+
 	ConnectionCloser interface {
 		// Close closes the store connection allowing the driver to perform potential
 		// cleanup operations
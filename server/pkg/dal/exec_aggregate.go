@@ -401,7 +401,7 @@ func (s *aggregate) sortGroups() {
 				va = ga.key[x]
 			} else {
 				x := inKeys(s.def.OutAttributes, o.Column)
-				va = ga.agg.aggregates[x]
+				va = ga.agg.value(x)
 			}
 
 			x = inKeys(s.def.Group, o.Column)
@@ -409,7 +409,7 @@ func (s *aggregate) sortGroups() {
 				vb = gb.key[x]
 			} else {
 				x := inKeys(s.def.OutAttributes, o.Column)
-				vb = gb.agg.aggregates[x]
+				vb = gb.agg.value(x)
 			}
 
 			cmp := compareValues(va, vb)
@@ -59,6 +59,33 @@ func TestStepAggregate(t *testing.T) {
 
 			f: internalFilter{orderBy: filter.SortExprSet{{Column: "k1"}}},
 		},
+		{
+			name:             "registered aggregate functions",
+			sourceAttributes: basicAttrs,
+			group: []simpleAttribute{{
+				ident: "k1",
+			}},
+			outAttributes: []simpleAttribute{{
+				ident: "med",
+				expr:  "median(v1)",
+			}, {
+				ident: "txts",
+				expr:  "string_agg(txt, '|')",
+			}},
+
+			inSimple: []simpleRow{
+				{"k1": "g1", "v1": 10, "txt": "foo"},
+				{"k1": "g1", "v1": 20, "txt": "fas"},
+				{"k1": "g2", "v1": 12, "txt": "bar"},
+			},
+
+			out: []simpleRow{
+				{"k1": "g2", "med": float64(12), "txts": "bar"},
+				{"k1": "g1", "med": float64(15), "txts": "foo|fas"},
+			},
+
+			f: internalFilter{orderBy: filter.SortExprSet{{Column: "med"}}},
+		},
 		{
 			name:             "basic one key group rename values",
 			sourceAttributes: basicAttrs,
@@ -36,6 +36,13 @@ type (
 		// Index to keep track of related rows
 		relIndex *relIndex
 
+		// keepLeft and keepRight denote if rows without a corresponding row
+		// on the other side should also be produced; see exec_join_outer.go
+		keepLeft     bool
+		keepRight    bool
+		rightRows    []*Row
+		matchedRight map[*Row]bool
+
 		// Output placeholder for sorted rows
 		// @todo consider a generic slice for cases when sorting is not needed.
 		//       This will probably save up on memory/time since we don't even need
@@ -91,6 +98,8 @@ func (xs *joinLeft) More(limit uint, v ValueGetter) (err error) {
 	// @todo adjust based on aggregation plan; reuse buffered, etc.
 	xs.relIndex.Clear()
 	xs.outSorted = btree.NewGenericOptions[ValueGetter](makeRowComparator(xs.filter.OrderBy()...), btree.Options{NoLocks: true})
+	xs.rightRows = nil
+	xs.matchedRight = nil
 	xs.scanRow = nil
 	xs.planned = false
 	xs.i = 0
@@ -230,6 +239,14 @@ func (xs *joinLeft) pullEntireSource(ctx context.Context) (err error) {
 		return
 	}
 
+	// Lastly the right rows no left row was joined with
+	if xs.keepRight {
+		err = xs.joinUnmatchedRight(ctx)
+		if err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -250,6 +267,10 @@ func (xs *joinLeft) pullEntireRightSource(ctx context.Context) (err error) {
 		if err != nil {
 			return
 		}
+
+		if xs.keepRight {
+			xs.rightRows = append(xs.rightRows, r)
+		}
 	}
 	return xs.rightSource.Err()
 }
@@ -282,12 +303,24 @@ func (xs *joinLeft) pullEntireLeftSource(ctx context.Context) (err error) {
 //			 Benchmarking shows that using a slice is negligibly faster if faster at all.
 func (xs *joinLeft) joinRight(ctx context.Context, left *Row) (err error) {
 	bb, ok, err := xs.getRelatedBuffers(left)
-	if err != nil || !ok {
+	if err != nil {
+		return
+	}
+
+	if len(bb) == 0 && xs.keepLeft {
+		return xs.joinUnmatchedLeft(ctx, left)
+	}
+
+	if !ok {
 		return
 	}
 
 	for _, b := range bb {
 		for _, right := range b.rows {
+			if xs.keepRight {
+				xs.markMatchedRight(right)
+			}
+
 			// Merge the two
 			xs.mergeRows(xs.def.OutAttributes, right, left, right)
 
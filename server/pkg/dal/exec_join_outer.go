@@ -0,0 +1,66 @@
+package dal
+
+import (
+	"context"
+)
+
+// Outer join specifics of the join executor
+//
+// Rows without a corresponding row on the other side are merged with
+// an empty row so the attributes of the missing side are omitted.
+
+// joinUnmatchedLeft produces the output row for the left row without any
+// corresponding right rows
+func (xs *joinLeft) joinUnmatchedLeft(ctx context.Context, left *Row) (err error) {
+	out := emptyJoinRow()
+
+	xs.mergeRows(xs.def.OutAttributes, out, left, emptyJoinRow())
+	return xs.keepUnmatched(ctx, out)
+}
+
+// joinUnmatchedRight produces the output rows for the right rows which were
+// not joined with any of the left rows
+//
+// The function should be called after the entire left source is processed.
+func (xs *joinLeft) joinUnmatchedRight(ctx context.Context) (err error) {
+	for _, right := range xs.rightRows {
+		if xs.matchedRight[right] {
+			continue
+		}
+
+		xs.mergeRows(xs.def.OutAttributes, right, emptyJoinRow(), right)
+		if err = xs.keepUnmatched(ctx, right); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// markMatchedRight marks the right row as joined with at least one left row
+func (xs *joinLeft) markMatchedRight(r *Row) {
+	if xs.matchedRight == nil {
+		xs.matchedRight = make(map[*Row]bool, len(xs.rightRows))
+	}
+
+	xs.matchedRight[r] = true
+}
+
+// keepUnmatched filters and outputs the unmatched row
+func (xs *joinLeft) keepUnmatched(ctx context.Context, r *Row) (err error) {
+	k, err := xs.keep(ctx, r)
+	if err != nil || !k {
+		return
+	}
+
+	xs.outSorted.Set(r)
+	return
+}
+
+// emptyJoinRow returns a row without values to stand in for the missing side
+func emptyJoinRow() *Row {
+	return &Row{
+		counters: make(map[string]uint),
+		values:   make(valueSet),
+	}
+}
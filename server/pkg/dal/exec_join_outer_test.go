@@ -0,0 +1,246 @@
+package dal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepJoinOuter(t *testing.T) {
+	var (
+		outAttrs = []simpleAttribute{
+			{ident: "l_pk", t: TypeID{}, primary: true},
+			{ident: "l_val", t: TypeText{}},
+			{ident: "f_pk", t: TypeID{}, primary: true},
+			{ident: "f_fk", t: TypeRef{}},
+			{ident: "f_val", t: TypeText{}},
+		}
+		leftAttrs = []simpleAttribute{
+			{ident: "l_pk", t: TypeID{}},
+			{ident: "l_val", t: TypeText{}},
+		}
+		rightAttrs = []simpleAttribute{
+			{ident: "f_pk", t: TypeID{}},
+			{ident: "f_fk", t: TypeRef{}},
+			{ident: "f_val", t: TypeText{}},
+		}
+
+		lIn = []simpleRow{
+			{"l_pk": 1, "l_val": "l1 v1"},
+			{"l_pk": 2, "l_val": "l2 v1"},
+		}
+		fIn = []simpleRow{
+			{"f_pk": 1, "f_fk": 1, "f_val": "f1 v1"},
+			{"f_pk": 2, "f_fk": 9999, "f_val": "f2 v1"},
+		}
+
+		matched    = simpleRow{"l_pk": 1, "l_val": "l1 v1", "f_pk": 1, "f_fk": 1, "f_val": "f1 v1"}
+		leftOnly   = simpleRow{"l_pk": 2, "l_val": "l2 v1"}
+		rightOnly  = simpleRow{"f_pk": 2, "f_fk": 9999, "f_val": "f2 v1"}
+		defaultOrd = filter.SortExprSet{{Column: "l_pk"}, {Column: "f_pk"}}
+	)
+
+	tcc := []struct {
+		name     string
+		joinType JoinType
+		f        internalFilter
+		out      []simpleRow
+	}{
+		{
+			name: "default",
+			out:  []simpleRow{matched},
+		},
+		{
+			name:     "inner",
+			joinType: JoinInner,
+			out:      []simpleRow{matched},
+		},
+		{
+			name:     "left",
+			joinType: JoinLeft,
+			out:      []simpleRow{matched, leftOnly},
+		},
+		{
+			name:     "right",
+			joinType: JoinRight,
+			out:      []simpleRow{rightOnly, matched},
+		},
+		{
+			name:     "full",
+			joinType: JoinFull,
+			out:      []simpleRow{rightOnly, matched, leftOnly},
+		},
+		{
+			name:     "full desc",
+			joinType: JoinFull,
+			f: internalFilter{
+				orderBy: filter.SortExprSet{{Column: "l_pk", Descending: true}, {Column: "f_pk", Descending: true}},
+			},
+			out: []simpleRow{leftOnly, matched, rightOnly},
+		},
+		{
+			name:     "full filtered",
+			joinType: JoinFull,
+			f: internalFilter{
+				expression: "f_val == 'f2 v1' || l_val == 'l2 v1'",
+			},
+			out: []simpleRow{rightOnly, leftOnly},
+		},
+		{
+			name:     "full limit",
+			joinType: JoinFull,
+			f: internalFilter{
+				limit: 2,
+			},
+			out: []simpleRow{rightOnly, matched},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			l := InMemoryBuffer()
+			for _, r := range lIn {
+				require.NoError(t, l.Add(ctx, r))
+			}
+
+			f := InMemoryBuffer()
+			for _, r := range fIn {
+				require.NoError(t, f.Add(ctx, r))
+			}
+
+			if len(tc.f.orderBy) == 0 {
+				tc.f.orderBy = defaultOrd
+			}
+
+			def := Join{
+				Ident:           "foo",
+				Type:            tc.joinType,
+				On:              JoinPredicate{Left: "l_pk", Right: "f_fk"},
+				OutAttributes:   saToMapping(outAttrs...),
+				LeftAttributes:  saToMapping(leftAttrs...),
+				RightAttributes: saToMapping(rightAttrs...),
+				Filter:          tc.f,
+			}
+
+			xs, err := def.iterator(ctx, l, f)
+			require.NoError(t, err)
+
+			i := 0
+			for xs.Next(ctx) {
+				out := simpleRow{}
+				require.NoError(t, xs.Scan(out))
+				require.Equal(t, tc.out[i], out)
+				i++
+			}
+			require.NoError(t, xs.Err())
+			require.Equal(t, len(tc.out), i)
+		})
+	}
+
+	t.Run("unknown type", func(t *testing.T) {
+		def := Join{
+			Ident:           "foo",
+			Type:            "sideways",
+			On:              JoinPredicate{Left: "l_pk", Right: "f_fk"},
+			OutAttributes:   saToMapping(outAttrs...),
+			LeftAttributes:  saToMapping(leftAttrs...),
+			RightAttributes: saToMapping(rightAttrs...),
+		}
+
+		err := def.dryrun(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sideways")
+	})
+}
+
+func TestStepJoinOuter_paging(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		outAttrs = []simpleAttribute{
+			{ident: "l_pk", primary: true},
+			{ident: "l_val"},
+			{ident: "f_pk", primary: true},
+			{ident: "f_fk"},
+		}
+		leftAttrs = []simpleAttribute{
+			{ident: "l_pk", t: TypeID{}},
+			{ident: "l_val", t: TypeText{}},
+		}
+		rightAttrs = []simpleAttribute{
+			{ident: "f_pk", t: TypeID{}},
+			{ident: "f_fk", t: TypeRef{}},
+		}
+
+		buffL = InMemoryBuffer()
+		buffR = InMemoryBuffer()
+
+		f = internalFilter{
+			limit:   2,
+			orderBy: filter.SortExprSet{{Column: "f_pk"}, {Column: "l_pk"}},
+		}
+
+		def Join
+
+		prep = func(f internalFilter) {
+			def = Join{
+				Type:            JoinFull,
+				Filter:          f,
+				On:              JoinPredicate{Left: "l_pk", Right: "f_fk"},
+				OutAttributes:   saToMapping(outAttrs...),
+				LeftAttributes:  saToMapping(leftAttrs...),
+				RightAttributes: saToMapping(rightAttrs...),
+			}
+		}
+
+		check = func(iter Iterator, assert []simpleRow) (first, last simpleRow) {
+			i := 0
+			for iter.Next(ctx) {
+				out := simpleRow{}
+				require.NoError(t, iter.Scan(out))
+				require.Equal(t, assert[i], out)
+				if i == 0 {
+					first = out
+				}
+				last = out
+				i++
+			}
+			require.NoError(t, iter.Err())
+			require.Equal(t, len(assert), i)
+			return
+		}
+	)
+
+	for _, r := range []simpleRow{{"l_pk": 1, "l_val": "l1"}, {"l_pk": 2, "l_val": "l2"}} {
+		require.NoError(t, buffL.Add(ctx, r))
+	}
+	for _, r := range []simpleRow{{"f_pk": 1, "f_fk": 1}, {"f_pk": 2, "f_fk": 3}, {"f_pk": 3, "f_fk": 4}} {
+		require.NoError(t, buffR.Add(ctx, r))
+	}
+
+	// First page; the unmatched left row has no right primary key so it goes first
+	prep(f)
+	aa, err := def.iterator(ctx, buffL, buffR)
+	require.NoError(t, err)
+	_, last := check(aa, []simpleRow{
+		{"l_pk": 2, "l_val": "l2"},
+		{"l_pk": 1, "l_val": "l1", "f_pk": 1, "f_fk": 1},
+	})
+
+	// Second page
+	require.NoError(t, buffL.Seek(ctx, 0))
+	require.NoError(t, buffR.Seek(ctx, 0))
+	f.cursor, err = aa.ForwardCursor(last)
+	require.NoError(t, err)
+
+	prep(f)
+	aa, err = def.iterator(ctx, buffL, buffR)
+	require.NoError(t, err)
+	check(aa, []simpleRow{
+		{"f_pk": 2, "f_fk": 3},
+		{"f_pk": 3, "f_fk": 4},
+	})
+}
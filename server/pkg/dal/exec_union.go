@@ -0,0 +1,266 @@
+package dal
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/tidwall/btree"
+)
+
+type (
+	// union pulls all of the sources into memory, sorts and pages the rows
+	//
+	// Considerations for optimizations
+	// - When data is provided in a satisfactory order, merge the sources
+	//   instead of pulling them entirely.
+	union struct {
+		def    Union
+		filter internalFilter
+
+		sources []Iterator
+		err     error
+		scanRow ValueGetter
+		planned bool
+
+		rowTester tester
+
+		// Output placeholder for sorted rows
+		outSorted *btree.Generic[*ordinalRow]
+		i         int
+
+		// first and last row scanned from the current page; used to
+		// add the ordinals to the paging cursors
+		firstScanned, lastScanned *ordinalRow
+	}
+)
+
+const (
+	// unionSourceOrdinal and unionRowOrdinal are the internal attributes
+	// holding the row's source index and the row index within the source
+	//
+	// They are added to the paging cursors so rows which are equal by the
+	// sort and the primary attributes don't get skipped or repeated when
+	// they span across pages.
+	unionSourceOrdinal = "__union_src"
+	unionRowOrdinal    = "__union_ord"
+)
+
+func (xs *union) init(ctx context.Context) (err error) {
+	xs.rowTester, err = prepareGenericRowTester(xs.filter)
+	if err != nil {
+		return
+	}
+
+	xs.outSorted = btree.NewGenericOptions[*ordinalRow](makeOrdinalRowComparator(xs.filter.OrderBy()...), btree.Options{NoLocks: true})
+
+	return xs.applyPlan(ctx)
+}
+
+func (xs *union) Next(ctx context.Context) (more bool) {
+	xs.err = xs.applyPlan(ctx)
+	if xs.err != nil {
+		return false
+	}
+
+	more, xs.err = xs.next(ctx)
+	return
+}
+
+func (xs *union) More(limit uint, v ValueGetter) (err error) {
+	xs.filter.cursor, err = xs.ForwardCursor(v)
+	if err != nil {
+		return
+	}
+
+	// Redo row tester
+	xs.rowTester, err = prepareGenericRowTester(xs.filter)
+	if err != nil {
+		return
+	}
+
+	// Redo the state
+	xs.outSorted = btree.NewGenericOptions[*ordinalRow](makeOrdinalRowComparator(xs.filter.OrderBy()...), btree.Options{NoLocks: true})
+	xs.scanRow = nil
+	xs.planned = false
+	xs.i = 0
+	xs.firstScanned = nil
+	xs.lastScanned = nil
+
+	return
+}
+
+func (xs *union) Err() error { return xs.err }
+
+func (xs *union) Scan(s ValueSetter) (err error) {
+	if r, ok := xs.scanRow.(*ordinalRow); ok {
+		if xs.firstScanned == nil {
+			xs.firstScanned = r
+		}
+		xs.lastScanned = r
+	}
+
+	for k, cc := range xs.scanRow.CountValues() {
+		if k == unionSourceOrdinal || k == unionRowOrdinal {
+			continue
+		}
+
+		for i := uint(0); i < cc; i++ {
+			// @note internal row won't raise errors so we can safely omit them
+			v, _ := xs.scanRow.GetValue(k, i)
+			err = s.SetValue(k, i, v)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func (xs *union) Close() (err error) {
+	if xs == nil {
+		return
+	}
+
+	for _, c := range xs.sources {
+		if c != nil {
+			err = c.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return
+}
+
+func (xs *union) BackCursor(v ValueGetter) (pc *filter.PagingCursor, err error) {
+	pc, err = filter.PagingCursorFrom(xs.filter.OrderBy(), v, collectPrimaryAttributes(xs.def.OutAttributes)...)
+	if err != nil {
+		return nil, err
+	}
+
+	pc.ROrder = true
+	pc.LThen = xs.filter.OrderBy().Reversed()
+
+	xs.setCursorOrdinals(pc, xs.firstScanned, v)
+	return
+}
+
+func (xs *union) ForwardCursor(v ValueGetter) (pc *filter.PagingCursor, err error) {
+	pc, err = filter.PagingCursorFrom(xs.filter.OrderBy(), v, collectPrimaryAttributes(xs.def.OutAttributes)...)
+	if err != nil {
+		return nil, err
+	}
+
+	xs.setCursorOrdinals(pc, xs.lastScanned, v)
+	return
+}
+
+// // // // // // // // // // // // // // // // // // // // // // // // //
+// Utility methods
+
+// next prepares the next scan row
+func (xs *union) next(ctx context.Context) (more bool, err error) {
+	if xs.filter.limit > 0 && xs.i >= int(xs.filter.limit) {
+		return false, nil
+	}
+
+	if xs.i >= xs.outSorted.Len() {
+		return false, nil
+	}
+
+	xs.scanRow, _ = xs.outSorted.GetAt(xs.i)
+	xs.i++
+	return true, nil
+}
+
+// applyPlan runs plan specific logic to prepare the state
+func (xs *union) applyPlan(ctx context.Context) (err error) {
+	if xs.planned || xs.err != nil {
+		return
+	}
+
+	xs.planned = true
+	for i, src := range xs.sources {
+		if err = xs.pullEntireSource(ctx, i, src); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// pullEntireSource pulls the source into memory mapping the rows to the
+// output attributes
+//
+// All rows are kept; rows that are equal by the sort order
+// are produced in the order of the sources
+func (xs *union) pullEntireSource(ctx context.Context, i int, src Iterator) (err error) {
+	for ord := 0; src.Next(ctx); ord++ {
+		r := &Row{
+			counters: make(map[string]uint),
+			values:   make(valueSet),
+		}
+
+		err = src.Scan(r)
+		if err != nil {
+			return
+		}
+
+		out := &Row{
+			counters: make(map[string]uint),
+			values:   make(valueSet),
+		}
+		out.SetValue(unionSourceOrdinal, 0, i)
+		out.SetValue(unionRowOrdinal, 0, ord)
+
+		for _, a := range xs.def.OutAttributes {
+			for c := uint(0); c < r.CountValues()[a.Source()]; c++ {
+				v, _ := r.GetValue(a.Source(), c)
+				out.SetValue(a.Identifier(), c, v)
+			}
+		}
+
+		k, err := xs.keep(ctx, out)
+		if err != nil {
+			return err
+		}
+		if !k {
+			continue
+		}
+
+		xs.outSorted.Set(&ordinalRow{Row: out, src: i, ord: ord})
+	}
+
+	return src.Err()
+}
+
+// setCursorOrdinals adds the ordinals of the scanned row to the paging cursor
+//
+// The ordinals are only added when v is the row we've scanned; a cursor for
+// an arbitrary row falls back to the sort and the primary attributes.
+func (xs *union) setCursorOrdinals(pc *filter.PagingCursor, r *ordinalRow, v ValueGetter) {
+	if r == nil {
+		return
+	}
+
+	rc, vc := r.CountValues(), v.CountValues()
+	for _, a := range xs.def.OutAttributes {
+		if compareGetters(r, v, rc, vc, a.Identifier()) != 0 {
+			return
+		}
+	}
+
+	pc.Set(unionSourceOrdinal, r.src, false)
+	pc.Set(unionRowOrdinal, r.ord, false)
+}
+
+// keep checks if the row should be kept or discarded
+func (xs *union) keep(ctx context.Context, r *Row) (bool, error) {
+	if xs.rowTester == nil {
+		return true, nil
+	}
+
+	return xs.rowTester.Test(ctx, r)
+}
@@ -0,0 +1,378 @@
+package dal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepUnion(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		srcAttrs = []simpleAttribute{
+			{ident: "pk", t: TypeID{}, primary: true},
+			{ident: "val", t: TypeText{}},
+		}
+	)
+
+	tcc := []struct {
+		name string
+
+		outAttributes []simpleAttribute
+		in            [][]simpleRow
+		f             internalFilter
+
+		out []simpleRow
+	}{
+		{
+			name: "concatenate",
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "a1"}, {"pk": 2, "val": "a2"}},
+				{{"pk": 3, "val": "b1"}},
+			},
+			out: []simpleRow{
+				{"pk": 1, "val": "a1"},
+				{"pk": 2, "val": "a2"},
+				{"pk": 3, "val": "b1"},
+			},
+		},
+		{
+			name: "sorted",
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "b"}, {"pk": 2, "val": "d"}},
+				{{"pk": 3, "val": "a"}, {"pk": 4, "val": "c"}},
+			},
+			f: internalFilter{
+				orderBy: filter.SortExprSet{{Column: "val", Descending: true}},
+			},
+			out: []simpleRow{
+				{"pk": 2, "val": "d"},
+				{"pk": 4, "val": "c"},
+				{"pk": 1, "val": "b"},
+				{"pk": 3, "val": "a"},
+			},
+		},
+		{
+			name: "filtered",
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}},
+				{{"pk": 3, "val": "a"}},
+			},
+			f: internalFilter{
+				expression: "val == 'a'",
+			},
+			out: []simpleRow{
+				{"pk": 1, "val": "a"},
+				{"pk": 3, "val": "a"},
+			},
+		},
+		{
+			name: "same primary",
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "a"}},
+				{{"pk": 1, "val": "b"}},
+			},
+			out: []simpleRow{
+				{"pk": 1, "val": "a"},
+				{"pk": 1, "val": "b"},
+			},
+		},
+		{
+			name: "no primary",
+			outAttributes: []simpleAttribute{
+				{ident: "pk"},
+				{ident: "val"},
+			},
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}},
+				{{"pk": 3, "val": "c"}},
+			},
+			out: []simpleRow{
+				{"pk": 1, "val": "a"},
+				{"pk": 2, "val": "b"},
+				{"pk": 3, "val": "c"},
+			},
+		},
+		{
+			name: "no primary, sorted",
+			outAttributes: []simpleAttribute{
+				{ident: "pk"},
+				{ident: "val"},
+			},
+			in: [][]simpleRow{
+				{{"pk": 2, "val": "a"}, {"pk": 1, "val": "b"}},
+				{{"pk": 3, "val": "a"}},
+			},
+			f: internalFilter{
+				orderBy: filter.SortExprSet{{Column: "val"}},
+			},
+			out: []simpleRow{
+				{"pk": 2, "val": "a"},
+				{"pk": 3, "val": "a"},
+				{"pk": 1, "val": "b"},
+			},
+		},
+		{
+			name: "renamed attributes",
+			outAttributes: []simpleAttribute{
+				{ident: "id", source: "pk", primary: true},
+			},
+			in: [][]simpleRow{
+				{{"pk": 1, "val": "a"}},
+				{{"pk": 2, "val": "b"}},
+			},
+			out: []simpleRow{
+				{"id": 1},
+				{"id": 2},
+			},
+		},
+		{
+			name: "empty source",
+			in: [][]simpleRow{
+				{},
+				{{"pk": 1, "val": "a"}},
+			},
+			out: []simpleRow{
+				{"pk": 1, "val": "a"},
+			},
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				sources []Iterator
+				srcDefs [][]AttributeMapping
+			)
+
+			for _, rr := range tc.in {
+				b := InMemoryBuffer()
+				for _, r := range rr {
+					require.NoError(t, b.Add(ctx, r))
+				}
+
+				sources = append(sources, b)
+				srcDefs = append(srcDefs, saToMapping(srcAttrs...))
+			}
+
+			def := Union{
+				Ident:            "un",
+				OutAttributes:    saToMapping(tc.outAttributes...),
+				SourceAttributes: srcDefs,
+				Filter:           tc.f,
+			}
+
+			xs, err := def.iterator(ctx, sources...)
+			require.NoError(t, err)
+
+			i := 0
+			for xs.Next(ctx) {
+				out := simpleRow{}
+				require.NoError(t, xs.Scan(out))
+				require.Equal(t, tc.out[i], out)
+				i++
+			}
+			require.NoError(t, xs.Err())
+			require.Equal(t, len(tc.out), i)
+		})
+	}
+}
+
+func TestStepUnionValidation(t *testing.T) {
+	ctx := context.Background()
+
+	run := func(out []simpleAttribute, src ...[]simpleAttribute) error {
+		def := Union{
+			Ident:         "un",
+			OutAttributes: saToMapping(out...),
+		}
+		for _, s := range src {
+			def.SourceAttributes = append(def.SourceAttributes, saToMapping(s...))
+		}
+
+		return def.dryrun(ctx)
+	}
+
+	t.Run("single source", func(t *testing.T) {
+		err := run(nil, []simpleAttribute{{ident: "pk"}})
+		require.Error(t, err)
+	})
+
+	t.Run("missing attribute", func(t *testing.T) {
+		err := run(nil,
+			[]simpleAttribute{{ident: "pk"}, {ident: "val"}},
+			[]simpleAttribute{{ident: "pk"}},
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "val")
+	})
+
+	t.Run("incompatible types", func(t *testing.T) {
+		err := run(nil,
+			[]simpleAttribute{{ident: "pk", t: TypeID{}}, {ident: "val", t: TypeText{}}},
+			[]simpleAttribute{{ident: "pk", t: TypeRef{}}, {ident: "val", t: TypeNumber{}}},
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "val")
+	})
+
+	t.Run("sort attribute does not exist", func(t *testing.T) {
+		def := Union{
+			Ident: "un",
+			SourceAttributes: [][]AttributeMapping{
+				saToMapping(simpleAttribute{ident: "pk"}),
+				saToMapping(simpleAttribute{ident: "pk"}),
+			},
+			Filter: internalFilter{orderBy: filter.SortExprSet{{Column: "i_not_yes"}}},
+		}
+
+		err := def.dryrun(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "i_not_yes")
+	})
+}
+
+func TestStepUnion_paging(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		attrs = []simpleAttribute{{ident: "pk", primary: true}, {ident: "val"}}
+
+		buffA = InMemoryBuffer()
+		buffB = InMemoryBuffer()
+
+		f = internalFilter{
+			limit:   2,
+			orderBy: filter.SortExprSet{{Column: "pk"}},
+		}
+
+		prep = func(f internalFilter) *Union {
+			return &Union{
+				Ident:            "un",
+				Filter:           f,
+				SourceAttributes: [][]AttributeMapping{saToMapping(attrs...), saToMapping(attrs...)},
+			}
+		}
+
+		check = func(iter Iterator, assert []simpleRow) (first, last simpleRow) {
+			i := 0
+			for iter.Next(ctx) {
+				out := simpleRow{}
+				require.NoError(t, iter.Scan(out))
+				require.Equal(t, assert[i], out)
+				if i == 0 {
+					first = out
+				}
+				last = out
+				i++
+			}
+			require.NoError(t, iter.Err())
+			require.Equal(t, len(assert), i)
+			return
+		}
+	)
+
+	for _, r := range []simpleRow{{"pk": 1, "val": "a"}, {"pk": 3, "val": "c"}} {
+		require.NoError(t, buffA.Add(ctx, r))
+	}
+	for _, r := range []simpleRow{{"pk": 2, "val": "b"}, {"pk": 4, "val": "d"}} {
+		require.NoError(t, buffB.Add(ctx, r))
+	}
+
+	rewind := func() {
+		require.NoError(t, buffA.Seek(ctx, 0))
+		require.NoError(t, buffB.Seek(ctx, 0))
+	}
+
+	// First page
+	aa, err := prep(f).iterator(ctx, buffA, buffB)
+	require.NoError(t, err)
+	_, last := check(aa, []simpleRow{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}})
+
+	// Second page
+	rewind()
+	f.cursor, err = aa.ForwardCursor(last)
+	require.NoError(t, err)
+
+	aa, err = prep(f).iterator(ctx, buffA, buffB)
+	require.NoError(t, err)
+	first, _ := check(aa, []simpleRow{{"pk": 3, "val": "c"}, {"pk": 4, "val": "d"}})
+
+	// Back to the first page
+	rewind()
+	f.cursor, err = aa.BackCursor(first)
+	require.NoError(t, err)
+
+	aa, err = prep(f).iterator(ctx, buffA, buffB)
+	require.NoError(t, err)
+	check(aa, []simpleRow{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}})
+}
+
+func TestStepUnion_pagingEqualRows(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		attrs = []simpleAttribute{{ident: "pk", primary: true}, {ident: "val"}}
+
+		buffA = InMemoryBuffer()
+		buffB = InMemoryBuffer()
+
+		f = internalFilter{
+			limit:   2,
+			orderBy: filter.SortExprSet{{Column: "val"}},
+		}
+
+		prep = func(f internalFilter) *Union {
+			return &Union{
+				Ident:            "un",
+				Filter:           f,
+				SourceAttributes: [][]AttributeMapping{saToMapping(attrs...), saToMapping(attrs...)},
+			}
+		}
+
+		read = func(iter Iterator) (out []simpleRow) {
+			for iter.Next(ctx) {
+				r := simpleRow{}
+				require.NoError(t, iter.Scan(r))
+				out = append(out, r)
+			}
+			require.NoError(t, iter.Err())
+			return
+		}
+	)
+
+	for _, r := range []simpleRow{{"pk": 1, "val": "a"}, {"pk": 1, "val": "a"}} {
+		require.NoError(t, buffA.Add(ctx, r))
+	}
+	for _, r := range []simpleRow{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}} {
+		require.NoError(t, buffB.Add(ctx, r))
+	}
+
+	rewind := func() {
+		require.NoError(t, buffA.Seek(ctx, 0))
+		require.NoError(t, buffB.Seek(ctx, 0))
+	}
+
+	aa, err := prep(f).iterator(ctx, buffA, buffB)
+	require.NoError(t, err)
+	page := read(aa)
+	require.Equal(t, []simpleRow{{"pk": 1, "val": "a"}, {"pk": 1, "val": "a"}}, page)
+
+	cur, err := aa.ForwardCursor(page[len(page)-1])
+	require.NoError(t, err)
+
+	// Make sure the ordinals survive the encoding
+	enc, err := cur.MarshalJSON()
+	require.NoError(t, err)
+	f.cursor = &filter.PagingCursor{}
+	require.NoError(t, f.cursor.UnmarshalJSON(enc))
+
+	rewind()
+	aa, err = prep(f).iterator(ctx, buffA, buffB)
+	require.NoError(t, err)
+	page = read(aa)
+	require.Equal(t, []simpleRow{{"pk": 1, "val": "a"}, {"pk": 2, "val": "b"}}, page)
+}
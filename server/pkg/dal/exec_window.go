@@ -0,0 +1,466 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+	"github.com/modern-go/reflect2"
+	"github.com/spf13/cast"
+	"github.com/tidwall/btree"
+)
+
+type (
+	// window pulls the source into memory, partitions and sorts the rows
+	// and calculates the window functions
+	//
+	// Considerations for optimizations
+	// - When data is provided ordered by the partition, process one
+	//   partition at a time instead of pulling the entire source.
+	window struct {
+		def    Window
+		filter internalFilter
+		defs   []windowDef
+
+		source  Iterator
+		err     error
+		scanRow ValueGetter
+		planned bool
+
+		rowTester tester
+
+		// Output placeholder for sorted rows
+		outSorted *btree.Generic[*ordinalRow]
+		i         int
+	}
+
+	// windowDef is the prepared window function
+	windowDef struct {
+		ident string
+		fnc   string
+
+		// argIdent or argEval are used to get the value of the function's
+		// first argument; when neither is set, the row itself is used
+		argIdent string
+		argEval  evaluator
+
+		// offset and dflt are used by the offset functions (lag and lead)
+		offset int
+		dflt   any
+
+		aggOp  *AggregateFunction
+		params []any
+	}
+)
+
+func (xs *window) init(ctx context.Context) (err error) {
+	xs.rowTester, err = prepareGenericRowTester(xs.filter)
+	if err != nil {
+		return
+	}
+
+	xs.outSorted = btree.NewGenericOptions[*ordinalRow](makeOrdinalRowComparator(xs.filter.OrderBy()...), btree.Options{NoLocks: true})
+
+	return xs.applyPlan(ctx)
+}
+
+func (xs *window) Next(ctx context.Context) (more bool) {
+	xs.err = xs.applyPlan(ctx)
+	if xs.err != nil {
+		return false
+	}
+
+	more, xs.err = xs.next(ctx)
+	return
+}
+
+func (xs *window) More(limit uint, v ValueGetter) (err error) {
+	xs.filter.cursor, err = filter.PagingCursorFrom(xs.filter.OrderBy(), v, collectPrimaryAttributes(xs.def.SourceAttributes)...)
+	if err != nil {
+		return
+	}
+
+	// Redo row tester
+	xs.rowTester, err = prepareGenericRowTester(xs.filter)
+	if err != nil {
+		return
+	}
+
+	// Redo the state
+	xs.outSorted = btree.NewGenericOptions[*ordinalRow](makeOrdinalRowComparator(xs.filter.OrderBy()...), btree.Options{NoLocks: true})
+	xs.scanRow = nil
+	xs.planned = false
+	xs.i = 0
+
+	return
+}
+
+func (xs *window) Err() error { return xs.err }
+
+func (xs *window) Scan(s ValueSetter) (err error) {
+	for k, cc := range xs.scanRow.CountValues() {
+		for i := uint(0); i < cc; i++ {
+			// @note internal row won't raise errors so we can safely omit them
+			v, _ := xs.scanRow.GetValue(k, i)
+			err = s.SetValue(k, i, v)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func (xs *window) Close() error {
+	if xs.source != nil {
+		return xs.source.Close()
+	}
+	return nil
+}
+
+func (xs *window) BackCursor(v ValueGetter) (pc *filter.PagingCursor, err error) {
+	pc, err = filter.PagingCursorFrom(xs.filter.OrderBy(), v, collectPrimaryAttributes(xs.def.SourceAttributes)...)
+	if err != nil {
+		return nil, err
+	}
+
+	pc.ROrder = true
+	pc.LThen = xs.filter.OrderBy().Reversed()
+
+	return
+}
+
+func (xs *window) ForwardCursor(v ValueGetter) (pc *filter.PagingCursor, err error) {
+	return filter.PagingCursorFrom(xs.filter.OrderBy(), v, collectPrimaryAttributes(xs.def.SourceAttributes)...)
+}
+
+// // // // // // // // // // // // // // // // // // // // // // // // //
+// Utility methods
+
+// next prepares the next scan row
+func (xs *window) next(ctx context.Context) (more bool, err error) {
+	if xs.filter.limit > 0 && xs.i >= int(xs.filter.limit) {
+		return false, nil
+	}
+
+	if xs.i >= xs.outSorted.Len() {
+		return false, nil
+	}
+
+	xs.scanRow, _ = xs.outSorted.GetAt(xs.i)
+	xs.i++
+	return true, nil
+}
+
+// applyPlan runs plan specific logic to prepare the state
+func (xs *window) applyPlan(ctx context.Context) (err error) {
+	if xs.planned || xs.err != nil {
+		return
+	}
+
+	xs.planned = true
+
+	partitions, err := xs.pullPartitions(ctx)
+	if err != nil {
+		return
+	}
+
+	// rows that are equal by the output sort order are kept
+	// in the partition and window order
+	less := makeRowComparator(xs.def.OrderBy...)
+	for p, pp := range partitions {
+		sort.SliceStable(pp, func(i, j int) bool {
+			return less(pp[i], pp[j])
+		})
+
+		for _, d := range xs.defs {
+			if err = xs.calculate(ctx, d, pp, less); err != nil {
+				return
+			}
+		}
+
+		for i, r := range pp {
+			k, err := xs.keep(ctx, r)
+			if err != nil {
+				return err
+			}
+			if !k {
+				continue
+			}
+
+			xs.outSorted.Set(&ordinalRow{Row: r, src: p, ord: i})
+		}
+	}
+
+	return
+}
+
+// pullPartitions pulls the entire source into memory and partitions the rows
+//
+// Partitions are returned in the order they first occur in the source.
+func (xs *window) pullPartitions(ctx context.Context) (out [][]*Row, err error) {
+	ix := make(map[string]int)
+
+	for xs.source.Next(ctx) {
+		r := &Row{
+			counters: make(map[string]uint),
+			values:   make(valueSet),
+		}
+
+		err = xs.source.Scan(r)
+		if err != nil {
+			return
+		}
+
+		k := xs.partitionKey(r)
+		p, ok := ix[k]
+		if !ok {
+			p = len(out)
+			ix[k] = p
+			out = append(out, nil)
+		}
+
+		out[p] = append(out[p], r)
+	}
+
+	return out, xs.source.Err()
+}
+
+// partitionKey returns a key which is the same for all rows of the partition
+func (xs *window) partitionKey(r *Row) string {
+	if len(xs.def.PartitionBy) == 0 {
+		return ""
+	}
+
+	kk := make([]string, len(xs.def.PartitionBy))
+	for i, p := range xs.def.PartitionBy {
+		v, _ := r.GetValue(p, 0)
+		if reflect2.IsNil(v) {
+			kk[i] = "\x00"
+			continue
+		}
+
+		kk[i] = fmt.Sprintf("%T:%v", v, v)
+	}
+
+	return strings.Join(kk, "\x01")
+}
+
+// calculate calculates the window function for the rows of the sorted partition
+func (xs *window) calculate(ctx context.Context, d windowDef, pp []*Row, less func(a, b ValueGetter) bool) (err error) {
+	peers := func(a, b *Row) bool {
+		return !less(a, b) && !less(b, a)
+	}
+
+	switch d.fnc {
+	case "row_number":
+		for i, r := range pp {
+			r.SetValue(d.ident, 0, float64(i+1))
+		}
+
+	case "rank":
+		rank := 0
+		for i, r := range pp {
+			if i == 0 || !peers(pp[i-1], r) {
+				rank = i + 1
+			}
+			r.SetValue(d.ident, 0, float64(rank))
+		}
+
+	case "dense_rank":
+		rank := 0
+		for i, r := range pp {
+			if i == 0 || !peers(pp[i-1], r) {
+				rank++
+			}
+			r.SetValue(d.ident, 0, float64(rank))
+		}
+
+	case "lag", "lead":
+		offset := d.offset
+		if d.fnc == "lag" {
+			offset = -offset
+		}
+
+		// values are collected before they are set in case the argument
+		// uses the window attribute itself
+		vv := make([]any, len(pp))
+		for i := range pp {
+			j := i + offset
+			if j < 0 || j >= len(pp) {
+				vv[i] = d.dflt
+				continue
+			}
+
+			vv[i], err = d.argValue(ctx, pp[j])
+			if err != nil {
+				return
+			}
+		}
+
+		for i, r := range pp {
+			r.SetValue(d.ident, 0, vv[i])
+		}
+
+	default:
+		// Aggregate functions; peers are processed together so they
+		// all get the same value
+		var acc AggregateAccumulator
+		acc, err = d.aggOp.Accumulator(d.params...)
+		if err != nil {
+			return
+		}
+
+		for s := 0; s < len(pp); {
+			e := s + 1
+			for e < len(pp) && peers(pp[s], pp[e]) {
+				e++
+			}
+
+			for _, r := range pp[s:e] {
+				var v any
+				v, err = d.argValue(ctx, r)
+				if err != nil {
+					return
+				}
+				if !reflect2.IsNil(v) {
+					acc.Add(v)
+				}
+			}
+
+			res := acc.Result()
+			for _, r := range pp[s:e] {
+				r.SetValue(d.ident, 0, res)
+			}
+
+			s = e
+		}
+	}
+
+	return
+}
+
+// keep checks if the row should be kept or discarded
+func (xs *window) keep(ctx context.Context, r *Row) (bool, error) {
+	if xs.rowTester == nil {
+		return true, nil
+	}
+
+	return xs.rowTester.Test(ctx, r)
+}
+
+// argValue returns the value of the function's first argument for the row
+func (d windowDef) argValue(ctx context.Context, r *Row) (any, error) {
+	switch {
+	case d.argIdent != "":
+		v, _ := r.GetValue(d.argIdent, 0)
+		return v, nil
+
+	case d.argEval != nil:
+		return d.argEval.Eval(ctx, r)
+	}
+
+	return r, nil
+}
+
+// makeWindowDef validates and prepares the window function definition
+func makeWindowDef(attr WindowAttr, srcAttrs map[string]bool) (out windowDef, err error) {
+	n := attr.Expression
+
+	out = windowDef{
+		ident: attr.Identifier,
+		fnc:   strings.ToLower(n.Ref),
+	}
+
+	if out.fnc == "" {
+		err = fmt.Errorf("root expression must be a window function")
+		return
+	}
+
+	prepArg := func(n *ql.ASTNode) (err error) {
+		if n.Symbol != "" {
+			if !srcAttrs[n.Symbol] {
+				return fmt.Errorf("unknown attribute %s", n.Symbol)
+			}
+
+			out.argIdent = n.Symbol
+			return
+		}
+
+		out.argEval, err = newRunnerGvalParsed(n)
+		return
+	}
+
+	switch out.fnc {
+	case "row_number", "rank", "dense_rank":
+		if len(n.Args) > 0 {
+			err = fmt.Errorf("function %s does not accept arguments", out.fnc)
+		}
+		return
+
+	case "lag", "lead":
+		if len(n.Args) == 0 || len(n.Args) > 3 {
+			err = fmt.Errorf("function %s accepts one to three arguments", out.fnc)
+			return
+		}
+
+		out.offset = 1
+		if len(n.Args) > 1 {
+			if n.Args[1].Value == nil {
+				err = fmt.Errorf("function %s offset must be a literal value", out.fnc)
+				return
+			}
+
+			out.offset, err = cast.ToIntE(n.Args[1].Value.V.Get())
+			if err != nil || out.offset < 0 {
+				err = fmt.Errorf("function %s offset must be a non-negative integer", out.fnc)
+				return
+			}
+		}
+
+		if len(n.Args) > 2 {
+			if n.Args[2].Value == nil {
+				err = fmt.Errorf("function %s default must be a literal value", out.fnc)
+				return
+			}
+
+			out.dflt = n.Args[2].Value.V.Get()
+		}
+
+		err = prepArg(n.Args[0])
+		return
+	}
+
+	var expr *ql.ASTNode
+	out.aggOp, out.params, expr, err = unpackExpressionNode(n)
+	if err != nil {
+		err = fmt.Errorf("unknown window function %s", out.fnc)
+		return
+	}
+
+	// Make sure the params are ok
+	if _, err = out.aggOp.Accumulator(out.params...); err != nil {
+		return
+	}
+
+	if expr != nil {
+		err = prepArg(expr)
+	}
+	return
+}
+
+// collectPrimaryAttributes returns identifiers of all of the primary attributes
+func collectPrimaryAttributes(mm []AttributeMapping) (out []string) {
+	out = make([]string, 0, 2)
+	for _, m := range mm {
+		if m.Properties().IsPrimary {
+			out = append(out, m.Identifier())
+		}
+	}
+
+	return
+}
@@ -0,0 +1,345 @@
+package dal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepWindow(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		srcAttrs = []simpleAttribute{
+			{ident: "pk", t: TypeID{}, primary: true},
+			{ident: "grp", t: TypeText{}},
+			{ident: "val", t: TypeNumber{}},
+		}
+
+		in = []simpleRow{
+			{"pk": 1, "grp": "a", "val": 10},
+			{"pk": 2, "grp": "b", "val": 5},
+			{"pk": 3, "grp": "a", "val": 30},
+			{"pk": 4, "grp": "a", "val": 20},
+			{"pk": 5, "grp": "b", "val": 5},
+			{"pk": 6, "grp": "a", "val": 20},
+		}
+	)
+
+	tcc := []struct {
+		name string
+
+		partitionBy []string
+		orderBy     filter.SortExprSet
+		attrs       []simpleAttribute
+		f           internalFilter
+
+		out []simpleRow
+	}{
+		{
+			name:        "row number",
+			partitionBy: []string{"grp"},
+			orderBy:     filter.SortExprSet{{Column: "val"}, {Column: "pk"}},
+			attrs:       []simpleAttribute{{ident: "rn", expr: "row_number()"}},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "rn": float64(1)},
+				{"pk": 2, "grp": "b", "val": 5, "rn": float64(1)},
+				{"pk": 3, "grp": "a", "val": 30, "rn": float64(4)},
+				{"pk": 4, "grp": "a", "val": 20, "rn": float64(2)},
+				{"pk": 5, "grp": "b", "val": 5, "rn": float64(2)},
+				{"pk": 6, "grp": "a", "val": 20, "rn": float64(3)},
+			},
+		},
+		{
+			name:        "rank and dense rank",
+			partitionBy: []string{"grp"},
+			orderBy:     filter.SortExprSet{{Column: "val", Descending: true}},
+			attrs: []simpleAttribute{
+				{ident: "rnk", expr: "rank()"},
+				{ident: "drnk", expr: "dense_rank()"},
+			},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "rnk": float64(4), "drnk": float64(3)},
+				{"pk": 2, "grp": "b", "val": 5, "rnk": float64(1), "drnk": float64(1)},
+				{"pk": 3, "grp": "a", "val": 30, "rnk": float64(1), "drnk": float64(1)},
+				{"pk": 4, "grp": "a", "val": 20, "rnk": float64(2), "drnk": float64(2)},
+				{"pk": 5, "grp": "b", "val": 5, "rnk": float64(1), "drnk": float64(1)},
+				{"pk": 6, "grp": "a", "val": 20, "rnk": float64(2), "drnk": float64(2)},
+			},
+		},
+		{
+			name:    "lag",
+			orderBy: filter.SortExprSet{{Column: "pk"}},
+			attrs:   []simpleAttribute{{ident: "prev", expr: "lag(val)"}},
+			f: internalFilter{
+				limit: 3,
+			},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "prev": nil},
+				{"pk": 2, "grp": "b", "val": 5, "prev": 10},
+				{"pk": 3, "grp": "a", "val": 30, "prev": 5},
+			},
+		},
+		{
+			name:    "lead",
+			orderBy: filter.SortExprSet{{Column: "pk"}},
+			attrs: []simpleAttribute{
+				{ident: "next2", expr: "lead(val, 2, 0)"},
+			},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "next2": 30},
+				{"pk": 2, "grp": "b", "val": 5, "next2": 20},
+				{"pk": 3, "grp": "a", "val": 30, "next2": 5},
+				{"pk": 4, "grp": "a", "val": 20, "next2": 20},
+				{"pk": 5, "grp": "b", "val": 5, "next2": int64(0)},
+				{"pk": 6, "grp": "a", "val": 20, "next2": int64(0)},
+			},
+		},
+		{
+			name:        "running sum includes peers",
+			partitionBy: []string{"grp"},
+			orderBy:     filter.SortExprSet{{Column: "val"}},
+			attrs:       []simpleAttribute{{ident: "total", expr: "sum(val)"}},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "total": float64(10)},
+				{"pk": 2, "grp": "b", "val": 5, "total": float64(10)},
+				{"pk": 3, "grp": "a", "val": 30, "total": float64(80)},
+				{"pk": 4, "grp": "a", "val": 20, "total": float64(50)},
+				{"pk": 5, "grp": "b", "val": 5, "total": float64(10)},
+				{"pk": 6, "grp": "a", "val": 20, "total": float64(50)},
+			},
+		},
+		{
+			name:        "partition aggregate without order",
+			partitionBy: []string{"grp"},
+			attrs:       []simpleAttribute{{ident: "total", expr: "sum(val)"}},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "total": float64(80)},
+				{"pk": 2, "grp": "b", "val": 5, "total": float64(10)},
+				{"pk": 3, "grp": "a", "val": 30, "total": float64(80)},
+				{"pk": 4, "grp": "a", "val": 20, "total": float64(80)},
+				{"pk": 5, "grp": "b", "val": 5, "total": float64(10)},
+				{"pk": 6, "grp": "a", "val": 20, "total": float64(80)},
+			},
+		},
+		{
+			name:        "expression argument",
+			partitionBy: []string{"grp"},
+			orderBy:     filter.SortExprSet{{Column: "pk"}},
+			attrs:       []simpleAttribute{{ident: "delta", expr: "lag(val - 1)"}},
+			out: []simpleRow{
+				{"pk": 1, "grp": "a", "val": 10, "delta": nil},
+				{"pk": 2, "grp": "b", "val": 5, "delta": nil},
+				{"pk": 3, "grp": "a", "val": 30, "delta": float64(9)},
+				{"pk": 4, "grp": "a", "val": 20, "delta": float64(29)},
+				{"pk": 5, "grp": "b", "val": 5, "delta": float64(4)},
+				{"pk": 6, "grp": "a", "val": 20, "delta": float64(19)},
+			},
+		},
+		{
+			name:        "top N per partition",
+			partitionBy: []string{"grp"},
+			orderBy:     filter.SortExprSet{{Column: "val", Descending: true}, {Column: "pk"}},
+			attrs:       []simpleAttribute{{ident: "rn", expr: "row_number()"}},
+			f: internalFilter{
+				expression: "rn <= 2",
+				orderBy:    filter.SortExprSet{{Column: "grp"}, {Column: "rn"}},
+			},
+			out: []simpleRow{
+				{"pk": 3, "grp": "a", "val": 30, "rn": float64(1)},
+				{"pk": 4, "grp": "a", "val": 20, "rn": float64(2)},
+				{"pk": 2, "grp": "b", "val": 5, "rn": float64(1)},
+				{"pk": 5, "grp": "b", "val": 5, "rn": float64(2)},
+			},
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			b := InMemoryBuffer()
+			for _, r := range in {
+				require.NoError(t, b.Add(ctx, r))
+			}
+
+			def := Window{
+				Ident:            "win",
+				PartitionBy:      tc.partitionBy,
+				OrderBy:          tc.orderBy,
+				OutAttributes:    saToWindowAttr(tc.attrs...),
+				SourceAttributes: saToMapping(srcAttrs...),
+				Filter:           tc.f,
+			}
+
+			xs, err := def.iterator(ctx, b)
+			require.NoError(t, err)
+
+			i := 0
+			for xs.Next(ctx) {
+				out := simpleRow{}
+				require.NoError(t, xs.Scan(out))
+				require.Equal(t, tc.out[i], out)
+				i++
+			}
+			require.NoError(t, xs.Err())
+			require.Equal(t, len(tc.out), i)
+		})
+	}
+
+	t.Run("no primary attribute", func(t *testing.T) {
+		// like the output of the aggregation step
+		var (
+			b   = InMemoryBuffer()
+			req = require.New(t)
+		)
+
+		for _, r := range []simpleRow{{"grp": "a", "val": 10}, {"grp": "a", "val": 10}, {"grp": "b", "val": 10}} {
+			req.NoError(b.Add(ctx, r))
+		}
+
+		def := Window{
+			Ident:       "win",
+			PartitionBy: []string{"grp"},
+			OrderBy:     filter.SortExprSet{{Column: "val"}},
+			OutAttributes: saToWindowAttr(
+				simpleAttribute{ident: "rn", expr: "row_number()"},
+			),
+			SourceAttributes: saToMapping(
+				simpleAttribute{ident: "grp", t: TypeText{}},
+				simpleAttribute{ident: "val", t: TypeNumber{}},
+			),
+		}
+
+		xs, err := def.iterator(ctx, b)
+		req.NoError(err)
+
+		out := make([]simpleRow, 0, 3)
+		for xs.Next(ctx) {
+			r := simpleRow{}
+			req.NoError(xs.Scan(r))
+			out = append(out, r)
+		}
+		req.NoError(xs.Err())
+		req.Equal([]simpleRow{
+			{"grp": "a", "val": 10, "rn": float64(1)},
+			{"grp": "a", "val": 10, "rn": float64(2)},
+			{"grp": "b", "val": 10, "rn": float64(1)},
+		}, out)
+	})
+}
+
+func TestStepWindowValidation(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		srcAttrs = []simpleAttribute{
+			{ident: "pk", t: TypeID{}, primary: true},
+			{ident: "val", t: TypeNumber{}},
+		}
+	)
+
+	run := func(w Window) error {
+		w.Ident = "win"
+		w.SourceAttributes = saToMapping(srcAttrs...)
+		return w.dryrun(ctx)
+	}
+
+	tcc := []struct {
+		name string
+		w    Window
+		err  string
+	}{
+		{
+			name: "no window attributes",
+			err:  "no window attributes specified",
+		},
+		{
+			name: "unknown partition attribute",
+			w: Window{
+				PartitionBy:   []string{"i_not_exist"},
+				OutAttributes: []WindowAttr{{Identifier: "rn", RawExpr: "row_number()"}},
+			},
+			err: "i_not_exist",
+		},
+		{
+			name: "unknown order attribute",
+			w: Window{
+				OrderBy:       filter.SortExprSet{{Column: "i_not_exist"}},
+				OutAttributes: []WindowAttr{{Identifier: "rn", RawExpr: "row_number()"}},
+			},
+			err: "i_not_exist",
+		},
+		{
+			name: "unknown function",
+			w: Window{
+				OutAttributes: []WindowAttr{{Identifier: "x", RawExpr: "ntile(val)"}},
+			},
+			err: "unknown window function ntile",
+		},
+		{
+			name: "not a function",
+			w: Window{
+				OutAttributes: []WindowAttr{{Identifier: "x", RawExpr: "val"}},
+			},
+			err: "root expression must be a window function",
+		},
+		{
+			name: "ranking function with arguments",
+			w: Window{
+				OutAttributes: []WindowAttr{{Identifier: "x", RawExpr: "rank(val)"}},
+			},
+			err: "function rank does not accept arguments",
+		},
+		{
+			name: "negative offset",
+			w: Window{
+				OutAttributes: []WindowAttr{{Identifier: "x", RawExpr: "lag(val, -1)"}},
+			},
+			err: "offset",
+		},
+		{
+			name: "duplicate attribute",
+			w: Window{
+				OutAttributes: []WindowAttr{{Identifier: "val", RawExpr: "row_number()"}},
+			},
+			err: "duplicate attribute val",
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			err := run(tc.w)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	t.Run("output types", func(t *testing.T) {
+		w := Window{
+			Ident:            "win",
+			SourceAttributes: saToMapping(srcAttrs...),
+			OutAttributes: []WindowAttr{
+				{Identifier: "rn", RawExpr: "row_number()"},
+				{Identifier: "prev", RawExpr: "lag(pk)"},
+				{Identifier: "agg", RawExpr: "string_agg(val)"},
+			},
+		}
+		require.NoError(t, w.dryrun(ctx))
+
+		require.IsType(t, &TypeNumber{}, w.OutAttributes[0].Type)
+		require.IsType(t, TypeID{}, w.OutAttributes[1].Type)
+		require.IsType(t, &TypeText{}, w.OutAttributes[2].Type)
+	})
+}
+
+func saToWindowAttr(sa ...simpleAttribute) (out []WindowAttr) {
+	for _, a := range sa {
+		out = append(out, WindowAttr{
+			Identifier: a.ident,
+			RawExpr:    a.expr,
+		})
+	}
+
+	return
+}
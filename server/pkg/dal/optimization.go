@@ -17,6 +17,13 @@ type (
 		SortCost   opCost
 
 		OutputSize dsSize
+
+		// Functions lists the functions the operation can natively perform
+		//
+		// Used by the aggregate and window operations; when not set, the aggregate
+		// operation supports only the base aggregate functions (count, sum, min,
+		// max, avg) and the window operation supports none.
+		Functions map[string]bool
 	}
 
 	ppStepWrap struct {
@@ -56,8 +63,18 @@ const (
 	OpAnalysisIterate   string = "iterate"
 	OpAnalysisAggregate string = "aggregate"
 	OpAnalysisJoin      string = "join"
+	OpAnalysisWindow    string = "window"
 )
 
+// supportsFunction checks if the operation can natively perform the function
+func (a OpAnalysis) supportsFunction(f string) bool {
+	if a.Functions == nil {
+		return baseAggregateFunctions[f]
+	}
+
+	return a.Functions[f]
+}
+
 // wrapPpSteps wraps the pipeline steps in a more processing friendly format
 // and returns a slice of leave nodes
 //
@@ -75,6 +92,10 @@ func wrapPpSteps(pp Pipeline) (leaves []*ppStepWrap) {
 			ix[p].child = append(ix[p].child, ix[c.rel])
 			ix[c.rel].parent = ix[p]
 
+		case *Window:
+			ix[p].child = append(ix[p].child, ix[c.rel])
+			ix[c.rel].parent = ix[p]
+
 		case *Join:
 			ix[p].child = append(ix[p].child, ix[c.relLeft])
 			ix[c.relLeft].parent = ix[p]
@@ -89,6 +110,12 @@ func wrapPpSteps(pp Pipeline) (leaves []*ppStepWrap) {
 			ix[p].child = append(ix[p].child, ix[c.relRight])
 			ix[c.relRight].parent = ix[p]
 
+		case *Union:
+			for _, r := range c.rels {
+				ix[p].child = append(ix[p].child, ix[r])
+				ix[r].parent = ix[p]
+			}
+
 		case *Datasource:
 			continue
 
@@ -115,6 +142,9 @@ func unwrapPpSteps(n *ppStepWrap, seen map[*ppStepWrap]bool) (out Pipeline) {
 		case *Aggregate:
 			s.rel = c.step
 			s.RelSource = c.step.Identifier()
+		case *Window:
+			s.rel = c.step
+			s.RelSource = c.step.Identifier()
 		case *Join:
 			if i == 0 {
 				s.relLeft = c.step
@@ -131,6 +161,9 @@ func unwrapPpSteps(n *ppStepWrap, seen map[*ppStepWrap]bool) (out Pipeline) {
 				s.relRight = c.step
 				s.RelRight = c.step.Identifier()
 			}
+		case *Union:
+			s.rels[i] = c.step
+			s.RelSources[i] = c.step.Identifier()
 		}
 	}
 
@@ -46,6 +46,117 @@ func TestClobberStep(t *testing.T) {
 		require.Len(t, c.clobbered, 1)
 	})
 
+	t.Run("agg ds unsupported function", func(t *testing.T) {
+		ds := &Datasource{
+			Ident:    "ds_1",
+			analysis: makeAnalysisDsAggregate(),
+		}
+		agg := &Aggregate{
+			Ident:         "agg_1",
+			RelSource:     "ds_1",
+			rel:           ds,
+			OutAttributes: saToAggAttr(simpleAttribute{ident: "m", expr: "median(v)"}),
+		}
+
+		out, err := pipelineClobberSteps(Pipeline{agg, ds})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		require.Empty(t, ds.clobbered)
+	})
+
+	t.Run("agg ds supported function", func(t *testing.T) {
+		ds := &Datasource{
+			Ident: "ds_1",
+			analysis: map[string]OpAnalysis{
+				OpAnalysisAggregate: {Functions: map[string]bool{"sum": true, "median": true}},
+			},
+		}
+		agg := &Aggregate{
+			Ident:     "agg_1",
+			RelSource: "ds_1",
+			rel:       ds,
+			OutAttributes: saToAggAttr(
+				simpleAttribute{ident: "m", expr: "median(v)"},
+				simpleAttribute{ident: "s", expr: "sum(v)"},
+			),
+		}
+
+		out, err := pipelineClobberSteps(Pipeline{agg, ds})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Len(t, ds.clobbered, 1)
+	})
+
+	t.Run("window ds", func(t *testing.T) {
+		ds := &Datasource{
+			Ident: "ds_1",
+			analysis: map[string]OpAnalysis{
+				OpAnalysisWindow: {Functions: map[string]bool{"row_number": true, "sum": true}},
+			},
+		}
+		win := &Window{
+			Ident:     "win_1",
+			RelSource: "ds_1",
+			rel:       ds,
+			OutAttributes: []WindowAttr{
+				{Identifier: "rn", RawExpr: "row_number()"},
+				{Identifier: "s", RawExpr: "sum(v)"},
+			},
+		}
+
+		out, err := pipelineClobberSteps(Pipeline{win, ds})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Len(t, ds.clobbered, 1)
+	})
+
+	t.Run("window ds unsupported function", func(t *testing.T) {
+		ds := &Datasource{
+			Ident: "ds_1",
+			analysis: map[string]OpAnalysis{
+				OpAnalysisWindow: {Functions: map[string]bool{"row_number": true}},
+			},
+		}
+		win := &Window{
+			Ident:     "win_1",
+			RelSource: "ds_1",
+			rel:       ds,
+			OutAttributes: []WindowAttr{
+				{Identifier: "m", RawExpr: "median(v)"},
+			},
+		}
+
+		out, err := pipelineClobberSteps(Pipeline{win, ds})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		require.Empty(t, ds.clobbered)
+	})
+
+	t.Run("window ds not supported", func(t *testing.T) {
+		ds := &Datasource{
+			Ident:    "ds_1",
+			analysis: makeAnalysisDsAggregate(),
+		}
+		win := &Window{
+			Ident:     "win_1",
+			RelSource: "ds_1",
+			rel:       ds,
+			OutAttributes: []WindowAttr{
+				{Identifier: "rn", RawExpr: "row_number()"},
+			},
+		}
+
+		out, err := pipelineClobberSteps(Pipeline{win, ds})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		require.Empty(t, ds.clobbered)
+	})
+
 	t.Run("agg agg ds", func(t *testing.T) {
 		// @note for now we're only offloading one aggregation
 		ds := &Datasource{
@@ -95,6 +95,21 @@ func (pp Pipeline) LinkSteps() (err error) {
 				if rs.relRight == nil {
 					return fmt.Errorf("link: missing right relation %s", rs.relRight)
 				}
+
+			case *Window:
+				rs.rel = steps[rs.RelSource]
+				if rs.rel == nil {
+					return fmt.Errorf("window: missing source relation %s", rs.RelSource)
+				}
+
+			case *Union:
+				rs.rels = make([]PipelineStep, len(rs.RelSources))
+				for i, src := range rs.RelSources {
+					rs.rels[i] = steps[src]
+					if rs.rels[i] == nil {
+						return fmt.Errorf("union: missing source relation %s", src)
+					}
+				}
 			}
 		}
 		return
@@ -151,11 +166,20 @@ func (pp Pipeline) slice(s PipelineStep) (out Pipeline) {
 	case *Aggregate:
 		return append(out, pp.slice(n.rel)...)
 
+	case *Window:
+		return append(out, pp.slice(n.rel)...)
+
 	case *Join:
 		return append(out, append(pp.slice(n.relLeft), pp.slice(n.relRight)...)...)
 
 	case *Link:
 		return append(out, append(pp.slice(n.relLeft), pp.slice(n.relRight)...)...)
+
+	case *Union:
+		for _, r := range n.rels {
+			out = append(out, pp.slice(r)...)
+		}
+		return
 	}
 
 	return
@@ -76,28 +76,20 @@ var (
 			OutType: &TypeBoolean{},
 		},
 		"lt": {
-			Handler: func(args ...string) string {
-				return fmt.Sprintf("%s < %s", args[0], args[1])
-			},
+			Handler: makeNullSafeCompHandler("<"),
 			OutType: &TypeBoolean{},
 		},
 		"le": {
-			Handler: func(args ...string) string {
-				return fmt.Sprintf("%s <= %s", args[0], args[1])
-			},
+			Handler: makeNullSafeCompHandler("<="),
 			OutType: &TypeBoolean{},
 		},
 		"gt": {
-			Handler: func(args ...string) string {
-				return fmt.Sprintf("%s > %s", args[0], args[1])
-			},
+			Handler: makeNullSafeCompHandler(">"),
 			OutType: &TypeBoolean{},
 		},
 		"ge": {
 			// Handler: makeGenericCompHandler(">="),
-			Handler: func(args ...string) string {
-				return fmt.Sprintf("%s >= %s", args[0], args[1])
-			},
+			Handler: makeNullSafeCompHandler(">="),
 			OutType: &TypeBoolean{},
 		},
 
@@ -156,25 +148,41 @@ var (
 			OutType: &TypeNumber{},
 		},
 
-		// @todo implement; the commented versions are not good enough
-		// "like": {
-		// 	Handler: func(args ...string) string {
-		// 		// @todo better regex construction
-		// 		nn := strings.Replace(strings.Trim(args[1], "\""), "%", ".*", -1)
-		// 		nn = strings.Replace(nn, "_", ".[1]", -1)
-
-		// 		return fmt.Sprintf("%s =~ ^%s$", args[0], nn)
-		// 	},
-		// },
-		// "nlike": {
-		// 	Handler: func(args ...string) string {
-		// 		// @todo better regex construction
-		// 		nn := strings.Replace(args[1], "%", ".*", -1)
-		// 		nn = strings.Replace(nn, "_", ".[1]", -1)
-
-		// 		return fmt.Sprintf("!(%s =~ ^%s$)", args[0], nn)
-		// 	},
-		// },
+		// - string matching
+		//
+		// LIKE is case-insensitive in all of the supported SQL dialects so
+		// like and ilike behave the same.
+		// Negated variants don't match nil values, same as in SQL.
+		"like": {
+			Handler: func(args ...string) string {
+				return fmt.Sprintf("ilike(%s, %s)", args[0], args[1])
+			},
+			OutType: &TypeBoolean{},
+		},
+		"nlike": {
+			Handler: makeNullSafeNegatedHandler("ilike"),
+			OutType: &TypeBoolean{},
+		},
+		"ilike": {
+			Handler: func(args ...string) string {
+				return fmt.Sprintf("ilike(%s, %s)", args[0], args[1])
+			},
+			OutType: &TypeBoolean{},
+		},
+		"nilike": {
+			Handler: makeNullSafeNegatedHandler("ilike"),
+			OutType: &TypeBoolean{},
+		},
+		"regex": {
+			Handler: func(args ...string) string {
+				return fmt.Sprintf("regex(%s, %s)", args[0], args[1])
+			},
+			OutType: &TypeBoolean{},
+		},
+		"nregex": {
+			Handler: makeNullSafeNegatedHandler("regex"),
+			OutType: &TypeBoolean{},
+		},
 
 		// "is": {
 		// 	Handler: func(args ...string) string {
@@ -281,6 +289,25 @@ var (
 	}
 )
 
+// makeNullSafeCompHandler returns a handler for the comparison operator which
+// evaluates to false when any of the operands is nil (as SQL does) instead of
+// failing the evaluation
+//
+// Nil values are common for rows produced by outer joins.
+func makeNullSafeCompHandler(op string) func(...string) string {
+	return func(args ...string) string {
+		return fmt.Sprintf("(!isNil(%[1]s) && !isNil(%[2]s) && %[1]s %[3]s %[2]s)", args[0], args[1], op)
+	}
+}
+
+// makeNullSafeNegatedHandler returns a handler negating the given matching
+// function which evaluates to false when any of the operands is nil (as SQL does)
+func makeNullSafeNegatedHandler(fnc string) func(...string) string {
+	return func(args ...string) string {
+		return fmt.Sprintf("(!isNil(%[1]s) && !isNil(%[2]s) && !%[3]s(%[1]s, %[2]s))", args[0], args[1], fnc)
+	}
+}
+
 // newConverterGval initializes a new gval exp. converter
 func newConverterGval(ii ...ql.IdentHandler) converterGval {
 	if globalGvalConverter.parser == nil {
@@ -357,6 +384,8 @@ func newGval(e string) (gval.Evaluable, error) {
 		gval.Function("int", gvalfnc.CastInt),
 		gval.Function("string", gvalfnc.CastString),
 		gval.Function("concat", gvalfnc.ConcatStrings),
+		gval.Function("ilike", gvalfnc.ILike),
+		gval.Function("regex", gvalfnc.Regex),
 		gval.Function("has", arrHas),
 	).NewEvaluable(e)
 }
@@ -416,7 +445,7 @@ func (c converterGval) convert(n *ql.ASTNode) (_ string, err error) {
 	case n.Value != nil:
 		// @todo I don't think this is quite ok, but it works for now so it'll do for now
 		if n.Value.V.Type() == "String" {
-			return fmt.Sprintf("\"%v\"", n.Value.V.Get()), nil
+			return fmt.Sprintf("%q", fmt.Sprintf("%v", n.Value.V.Get())), nil
 		}
 		return fmt.Sprintf("%v", n.Value.V.Get()), nil
 	}
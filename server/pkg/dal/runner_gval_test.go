@@ -167,6 +167,60 @@ func TestHandlers(t *testing.T) {
 			in:   simpleRow{},
 			out:  dy,
 		},
+		{
+			name: "like",
+			expr: `name LIKE 'J_hn%'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  true,
+		},
+		{
+			name: "like escaped wildcard",
+			expr: `name LIKE '100\\%'`,
+			in:   simpleRow{"name": "1000"},
+			out:  false,
+		},
+		{
+			name: "not like",
+			expr: `name NOT LIKE '%doe'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  false,
+		},
+		{
+			name: "ilike",
+			expr: `name ILIKE '%DOE'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  true,
+		},
+		{
+			name: "regex",
+			expr: `name REGEX '^jo.n'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  true,
+		},
+		{
+			name: "regex is case sensitive",
+			expr: `name REGEX '^JOHN'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  false,
+		},
+		{
+			name: "not regex",
+			expr: `name NOT REGEX 'x'`,
+			in:   simpleRow{"name": "john doe"},
+			out:  true,
+		},
+		{
+			name: "like nil",
+			expr: `name LIKE '%'`,
+			in:   simpleRow{"name": nil},
+			out:  false,
+		},
+		{
+			name: "not like nil",
+			expr: `name NOT LIKE 'a'`,
+			in:   simpleRow{"name": nil},
+			out:  false,
+		},
 	}
 
 	for _, tc := range tcc {
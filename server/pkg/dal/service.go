@@ -8,6 +8,8 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/filter"
 	"github.com/cortezaproject/corteza/server/pkg/id"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -51,6 +53,7 @@ type (
 
 		Create(ctx context.Context, mf ModelRef, operations OperationSet, rr ...ValueGetter) (err error)
 		Update(ctx context.Context, mf ModelRef, operations OperationSet, rr ...ValueGetter) (err error)
+		UpdateIf(ctx context.Context, mf ModelRef, operations OperationSet, expected map[string]any, r ValueGetter) (ok bool, err error)
 		Search(ctx context.Context, mf ModelRef, operations OperationSet, f filter.Filter) (iter Iterator, err error)
 		Lookup(ctx context.Context, mf ModelRef, operations OperationSet, lookup ValueGetter, dst ValueSetter) (err error)
 		Count(ctx context.Context, mf ModelRef, operations OperationSet, f filter.Filter) (uint, error)
@@ -470,6 +473,31 @@ func (svc *service) Update(ctx context.Context, mf ModelRef, operations Operatio
 	return
 }
 
+// UpdateIf updates data entry when stored attribute values match the expected ones
+//
+// Returns false when data entry was changed in the meantime
+func (svc *service) UpdateIf(ctx context.Context, mf ModelRef, operations OperationSet, expected map[string]any, r ValueGetter) (ok bool, err error) {
+	if err = svc.canOpData(mf); err != nil {
+		return false, fmt.Errorf("cannot update data entry: %w", err)
+	}
+
+	model, cw, err := svc.storeOpPrep(ctx, mf, operations)
+	if err != nil {
+		return false, fmt.Errorf("cannot update data entry: %w", err)
+	}
+
+	cu, is := cw.connection.(ConditionalUpdater)
+	if !is {
+		return false, fmt.Errorf("cannot update data entry: connection does not support conditional updates")
+	}
+
+	if ok, err = cu.UpdateIf(ctx, model, r, expected); err != nil {
+		return false, fmt.Errorf("cannot update data entry: %w", err)
+	}
+
+	return
+}
+
 func (svc *service) FindModel(mr ModelRef) *Model {
 	return svc.getModelByRef(mr)
 }
@@ -507,6 +535,9 @@ func (svc *service) Count(ctx context.Context, mf ModelRef, operations Operation
 // Run returns an iterator based on the provided Pipeline
 // @todo consider moving the Search method to utilize this also
 func (svc *service) Run(ctx context.Context, pp Pipeline) (iter Iterator, err error) {
+	ctx, span := tracing.Start(ctx, "dal pipeline run", attribute.Int("dal.pipeline.steps", len(pp)))
+	defer func() { tracing.End(span, err) }()
+
 	pp, err = svc.pipelinePrerun(ctx, pp)
 	if err != nil {
 		return
@@ -541,7 +572,13 @@ func (svc *service) Dryrun(ctx context.Context, pp Pipeline) (err error) {
 }
 
 // run is the recursive counterpart to run
+//
+// Step spans cover preparation of the step's iterator;
+// data is pulled from the iterators after the span ends
 func (svc *service) run(ctx context.Context, s PipelineStep, dry bool) (it Iterator, err error) {
+	ctx, span := tracing.Start(ctx, "dal step", stepSpanAttributes(s, dry)...)
+	defer func() { tracing.End(span, err) }()
+
 	switch s := s.(type) {
 	case *Datasource:
 		err = s.init(ctx)
@@ -564,6 +601,17 @@ func (svc *service) run(ctx context.Context, s PipelineStep, dry bool) (it Itera
 		}
 		return s.iterator(ctx, it)
 
+	case *Window:
+		it, err = svc.run(ctx, s.rel, dry)
+		if err != nil {
+			return
+		}
+
+		if dry {
+			return nil, s.dryrun(ctx)
+		}
+		return s.iterator(ctx, it)
+
 	case *Join:
 		var left Iterator
 		var right Iterator
@@ -597,11 +645,51 @@ func (svc *service) run(ctx context.Context, s PipelineStep, dry bool) (it Itera
 			return nil, s.dryrun(ctx)
 		}
 		return s.iterator(ctx, left, right)
+
+	case *Union:
+		var sources []Iterator
+		for _, r := range s.rels {
+			it, err = svc.run(ctx, r, dry)
+			if err != nil {
+				return
+			}
+			sources = append(sources, it)
+		}
+
+		if dry {
+			return nil, s.dryrun(ctx)
+		}
+		return s.iterator(ctx, sources...)
 	}
 
 	return nil, fmt.Errorf("unsupported step")
 }
 
+func stepSpanAttributes(s PipelineStep, dry bool) []attribute.KeyValue {
+	aa := []attribute.KeyValue{attribute.Bool("dal.step.dryrun", dry)}
+
+	switch s.(type) {
+	case *Datasource:
+		aa = append(aa, attribute.String("dal.step.type", "datasource"))
+	case *Aggregate:
+		aa = append(aa, attribute.String("dal.step.type", "aggregate"))
+	case *Window:
+		aa = append(aa, attribute.String("dal.step.type", "window"))
+	case *Join:
+		aa = append(aa, attribute.String("dal.step.type", "join"))
+	case *Link:
+		aa = append(aa, attribute.String("dal.step.type", "link"))
+	case *Union:
+		aa = append(aa, attribute.String("dal.step.type", "union"))
+	}
+
+	if s != nil {
+		aa = append(aa, attribute.String("dal.step.ident", s.Identifier()))
+	}
+
+	return aa
+}
+
 func collectAttributes(s PipelineStep) (out []AttributeMapping) {
 	switch s := s.(type) {
 	case *Datasource:
@@ -617,9 +705,15 @@ func collectAttributes(s PipelineStep) (out []AttributeMapping) {
 		}
 		return
 
+	case *Window:
+		return s.Attributes()[0]
+
 	case *Join:
 		return s.OutAttributes
 
+	case *Union:
+		return s.OutAttributes
+
 	case *Link:
 		panic("impossible state; link can not be nested")
 	}
@@ -33,6 +33,17 @@ type (
 	}
 
 	valueSet map[string][]any
+
+	// ordinalRow is a row with its position in the step's input
+	//
+	// Used by steps that need to keep rows that are equal by the sort order
+	ordinalRow struct {
+		*Row
+
+		// src is the index of the source the row was read from
+		// and ord the index of the row within the source
+		src, ord int
+	}
 )
 
 const (
@@ -409,6 +420,30 @@ func prepareGenericRowTester(f internalFilter) (_ tester, err error) {
 	)
 }
 
+// makeOrdinalRowComparator returns an ordinalRow comparator for the given sort expr
+//
+// Rows that are equal by the sort expr are ordered by their source and the
+// order they were read in so none of them are lost when stored in a btree
+func makeOrdinalRowComparator(ss ...*filter.SortExpr) func(a, b *ordinalRow) bool {
+	less := makeRowComparator(ss...)
+
+	return func(a, b *ordinalRow) bool {
+		if less(a, b) {
+			return true
+		}
+
+		if less(b, a) {
+			return false
+		}
+
+		if a.src != b.src {
+			return a.src < b.src
+		}
+
+		return a.ord < b.ord
+	}
+}
+
 // makeRowComparator returns a ValueGetter comparator for the given sort expr
 func makeRowComparator(ss ...*filter.SortExpr) func(a, b ValueGetter) bool {
 	return func(a, b ValueGetter) bool {
@@ -22,6 +22,10 @@ type (
 		ID     string
 		Values map[string]string
 
+		// MultiValues holds all values of multi-value fields;
+		// Values only hold the last one
+		MultiValues map[string][]string
+
 		Ts *Timestamps
 		Us *Userstamps
 
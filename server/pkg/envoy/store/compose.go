@@ -234,6 +234,15 @@ func (d *composeDecoder) decodeComposeRecord(ctx context.Context, s store.Storer
 		return rr
 	}
 
+	mapMultiValues := func(r *types.Record) map[string][]string {
+		rr := make(map[string][]string)
+		for _, v := range r.Values {
+			rr[v.Name] = append(rr[v.Name], v.Value)
+		}
+
+		return rr
+	}
+
 	// Prepare a series of resource.ComposeRecord instances; one for each provided filter
 	for _, f := range ff {
 		aux := *f
@@ -289,10 +298,11 @@ func (d *composeDecoder) decodeComposeRecord(ctx context.Context, s store.Storer
 				for _, n := range rr {
 					// Create a raw record
 					r := &resource.ComposeRecordRaw{
-						ID:     strconv.FormatUint(n.ID, 10),
-						Values: mapValues(n),
-						Ts:     resource.MakeTimestampsCUDA(&n.CreatedAt, n.UpdatedAt, n.DeletedAt, nil),
-						Us:     resource.MakeUserstampsCUDO(n.CreatedBy, n.UpdatedBy, n.DeletedBy, n.OwnedBy),
+						ID:          strconv.FormatUint(n.ID, 10),
+						Values:      mapValues(n),
+						MultiValues: mapMultiValues(n),
+						Ts:          resource.MakeTimestampsCUDA(&n.CreatedAt, n.UpdatedAt, n.DeletedAt, nil),
+						Us:          resource.MakeUserstampsCUDO(n.CreatedBy, n.UpdatedBy, n.DeletedBy, n.OwnedBy),
 					}
 
 					err = cb(r)
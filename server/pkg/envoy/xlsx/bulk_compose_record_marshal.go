@@ -0,0 +1,321 @@
+package xlsx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cortezaproject/corteza/server/compose/types"
+	"github.com/cortezaproject/corteza/server/pkg/envoy"
+	"github.com/cortezaproject/corteza/server/pkg/envoy/resource"
+	"github.com/xuri/excelize/v2"
+)
+
+type (
+	bulkComposeRecordEncoder struct {
+		encoderConfig *EncoderConfig
+
+		res *resource.ComposeRecord
+
+		tz *time.Location
+
+		// cell styles for typed date & time cells
+		styleDateTime int
+		styleDate     int
+		styleTime     int
+	}
+)
+
+const (
+	// excel's builtin number formats
+	numFmtDate     = 14
+	numFmtTime     = 21
+	numFmtDateTime = 22
+)
+
+var (
+	sysFields = []string{"ownedBy", "createdAt", "createdBy", "updatedAt", "updatedBy", "deletedAt", "deletedBy"}
+
+	sheetNameCleaner = strings.NewReplacer(
+		":", " ", "\\", " ", "/", " ", "?", " ",
+		"*", " ", "[", " ", "]", " ",
+	)
+)
+
+func bulkComposeRecordEncoderFromResource(rec *resource.ComposeRecord, cfg *EncoderConfig) *bulkComposeRecordEncoder {
+	return &bulkComposeRecordEncoder{
+		encoderConfig: cfg,
+
+		res: rec,
+	}
+}
+
+// Prepare prepares the composeRecord to be encoded
+//
+// Any validation, additional constraining should be performed here.
+func (n *bulkComposeRecordEncoder) Prepare(ctx context.Context, state *envoy.ResourceState) (err error) {
+	_, ok := state.Res.(*resource.ComposeRecord)
+	if !ok {
+		return encoderErrInvalidResource(types.RecordResourceType, state.Res.ResourceType())
+	}
+
+	n.tz = time.UTC
+	if n.encoderConfig.Timezone != "" {
+		if n.tz, err = time.LoadLocation(n.encoderConfig.Timezone); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Encode encodes the composeRecord to the workbook
+//
+// Records are written to a single sheet, named after the module.
+// Number, DateTime and Bool fields are written as typed cells;
+// values of multi-value fields are written into a single cell, one value per line.
+func (n *bulkComposeRecordEncoder) Encode(ctx context.Context, w io.Writer, state *envoy.ResourceState) (err error) {
+	var (
+		all = len(n.encoderConfig.Fields) == 0
+
+		f     = excelize.NewFile()
+		sheet = sheetName(n.res.RelMod)
+		sw    *excelize.StreamWriter
+	)
+	defer f.Close()
+
+	if err = f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+		return err
+	}
+
+	if err = n.prepareStyles(f); err != nil {
+		return err
+	}
+
+	if sw, err = f.NewStreamWriter(sheet); err != nil {
+		return err
+	}
+
+	// Generate header & cell index
+	hh := make([]interface{}, 0, 100)
+	hh = append(hh, "id")
+
+	fx := make(map[string]int)
+	// 1 sys fields
+	for _, sf := range sysFields {
+		if all || n.encoderConfig.Fields[sf] {
+			fx[sf] = len(hh)
+			hh = append(hh, sf)
+		}
+	}
+
+	// 2 module fields
+	for _, f := range n.res.RelMod.Fields {
+		if all || n.encoderConfig.Fields[f.Name] {
+			fx[f.Name] = len(hh)
+			hh = append(hh, f.Name)
+		}
+	}
+
+	if err = sw.SetRow("A1", hh); err != nil {
+		return err
+	}
+
+	rowIx := 1
+	err = n.res.Walker(func(r *resource.ComposeRecordRaw) (err error) {
+		row := make([]interface{}, len(hh))
+		row[0] = r.ID
+
+		if r.Us != nil {
+			stamps := map[string]*resource.Userstamp{
+				"ownedBy":   r.Us.OwnedBy,
+				"createdBy": r.Us.CreatedBy,
+				"updatedBy": r.Us.UpdatedBy,
+				"deletedBy": r.Us.DeletedBy,
+			}
+
+			for k, us := range stamps {
+				cell, has := fx[k]
+				if us == nil || !has {
+					continue
+				}
+
+				if row[cell], err = n.res.UserFlakes.GetByStamp(us).Model(); err != nil {
+					return err
+				}
+			}
+		}
+
+		if r.Ts != nil {
+			stamps := map[string]*resource.Timestamp{
+				"createdAt": r.Ts.CreatedAt,
+				"updatedAt": r.Ts.UpdatedAt,
+				"deletedAt": r.Ts.DeletedAt,
+			}
+
+			for k, ts := range stamps {
+				cell, has := fx[k]
+				if ts == nil || ts.T == nil || !has {
+					continue
+				}
+
+				row[cell] = excelize.Cell{StyleID: n.styleDateTime, Value: n.localTime(*ts.T)}
+			}
+		}
+
+		for k, v := range r.Values {
+			// Skip fields we don't want
+			if !all && !n.encoderConfig.Fields[k] {
+				continue
+			}
+
+			cell, has := fx[k]
+			if !has {
+				return fmt.Errorf("unknown cell %s", k)
+			}
+			f := n.res.RelMod.Fields.FindByName(k)
+			if f == nil {
+				return fmt.Errorf("field %s not found", k)
+			}
+
+			if f.Multi {
+				vv, has := r.MultiValues[k]
+				if !has {
+					vv = []string{v}
+				}
+
+				if row[cell], err = n.encodeMultiValue(f, vv); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if row[cell], err = n.encodeValue(f, v); err != nil {
+				return err
+			}
+		}
+
+		rowIx++
+		return sw.SetRow(fmt.Sprintf("A%d", rowIx), row)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = sw.Flush(); err != nil {
+		return err
+	}
+
+	_, err = f.WriteTo(w)
+	return err
+}
+
+// encodeValue converts the raw record value into a (typed) cell
+//
+// Values that can not be converted to the type of the field
+// are written as they are.
+func (n *bulkComposeRecordEncoder) encodeValue(f *types.ModuleField, v string) (_ interface{}, err error) {
+	switch f.Kind {
+	case "User":
+		return n.res.UserFlakes.GetByKey(v).Model()
+
+	case "Number":
+		if num, err := strconv.ParseFloat(v, 64); err == nil {
+			return num, nil
+		}
+
+	case "Bool":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+
+	case "DateTime":
+		switch {
+		case f.IsTimeOnly():
+			if t, err := time.Parse("15:04:05", v); err == nil {
+				// time-only cells are a fraction of the excel's epoch day
+				return excelize.Cell{StyleID: n.styleTime, Value: t.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)).Hours() / 24}, nil
+			}
+
+		case f.IsDateOnly():
+			if t, err := time.Parse("2006-01-02", v); err == nil {
+				return excelize.Cell{StyleID: n.styleDate, Value: t}, nil
+			}
+
+		default:
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return excelize.Cell{StyleID: n.styleDateTime, Value: n.localTime(t)}, nil
+			}
+		}
+	}
+
+	return v, nil
+}
+
+// encodeMultiValue converts all values of a multi-value field into a single cell
+//
+// Values are written as a JSON array of strings so that
+// values with new lines or any other delimiter are preserved.
+func (n *bulkComposeRecordEncoder) encodeMultiValue(f *types.ModuleField, vv []string) (_ interface{}, err error) {
+	out := make([]string, len(vv))
+	for i, v := range vv {
+		out[i] = v
+		if f.Kind == "User" {
+			if out[i], err = n.res.UserFlakes.GetByKey(v).Model(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	bb, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bb), nil
+}
+
+func (n *bulkComposeRecordEncoder) prepareStyles(f *excelize.File) (err error) {
+	if n.styleDateTime, err = f.NewStyle(&excelize.Style{NumFmt: numFmtDateTime}); err != nil {
+		return
+	}
+	if n.styleDate, err = f.NewStyle(&excelize.Style{NumFmt: numFmtDate}); err != nil {
+		return
+	}
+	if n.styleTime, err = f.NewStyle(&excelize.Style{NumFmt: numFmtTime}); err != nil {
+		return
+	}
+
+	return
+}
+
+// localTime converts the timestamp to the wall clock of the configured timezone
+//
+// Excel cells do not hold the timezone so the timestamp is
+// "moved" to UTC while keeping the local date and time.
+func (n *bulkComposeRecordEncoder) localTime(t time.Time) time.Time {
+	t = t.In(n.tz)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// sheetName returns a valid sheet name for the given module
+func sheetName(mod *types.Module) string {
+	name := strings.Trim(strings.TrimSpace(sheetNameCleaner.Replace(mod.Name)), "'")
+	if name == "" {
+		name = mod.Handle
+	}
+	if name == "" {
+		return "Sheet1"
+	}
+
+	if utf8.RuneCountInString(name) > excelize.MaxSheetNameLength {
+		name = string([]rune(name)[:excelize.MaxSheetNameLength])
+	}
+
+	return name
+}
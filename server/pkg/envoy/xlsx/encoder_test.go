@@ -0,0 +1,135 @@
+package xlsx
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/compose/types"
+	"github.com/cortezaproject/corteza/server/pkg/envoy"
+	"github.com/cortezaproject/corteza/server/pkg/envoy/resource"
+	systemTypes "github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestBulkComposeRecordEncoder(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		createdAt = time.Date(2022, 3, 4, 12, 0, 0, 0, time.UTC)
+
+		rr = resource.ComposeRecordRawSet{
+			{
+				ID: "1",
+				Values: map[string]string{
+					"str":   "foo",
+					"num":   "4.2",
+					"bool":  "1",
+					"date":  "2022-03-04",
+					"dt":    "2022-03-04T13:14:15Z",
+					"multi": "line 1\nline 2",
+					"users": "11",
+				},
+				MultiValues: map[string][]string{
+					"multi": {"a", "line 1\nline 2"},
+					"users": {"10", "11"},
+				},
+				Ts: &resource.Timestamps{
+					CreatedAt: &resource.Timestamp{T: &createdAt},
+				},
+			},
+		}
+
+		rec = resource.NewComposeRecordSet(func(f func(r *resource.ComposeRecordRaw) error) error {
+			for _, r := range rr {
+				if err := f(r); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, "ns", "mod")
+
+		buf = &bytes.Buffer{}
+	)
+
+	rec.RelMod = &types.Module{
+		Name: "Test: module",
+		Fields: types.ModuleFieldSet{
+			{Name: "str", Kind: "String"},
+			{Name: "num", Kind: "Number"},
+			{Name: "bool", Kind: "Bool"},
+			{Name: "date", Kind: "DateTime", Options: types.ModuleFieldOptions{"onlyDate": true}},
+			{Name: "dt", Kind: "DateTime"},
+			{Name: "multi", Kind: "String", Multi: true},
+			{Name: "users", Kind: "User", Multi: true},
+		},
+	}
+
+	ux := make(resource.UserstampIndex)
+	ux.Add(
+		&systemTypes.User{ID: 10, Handle: "u10"},
+		&systemTypes.User{ID: 11, Handle: "u11"},
+	)
+	rec.SetUserFlakes(ux)
+
+	enc := bulkComposeRecordEncoderFromResource(rec, &EncoderConfig{
+		Fields:   map[string]bool{"createdAt": true, "str": true, "num": true, "bool": true, "date": true, "dt": true, "multi": true, "users": true},
+		Timezone: "Europe/Ljubljana",
+	})
+
+	state := &envoy.ResourceState{Res: rec}
+	req.NoError(enc.Prepare(ctx, state))
+	req.NoError(enc.Encode(ctx, buf, state))
+
+	f, err := excelize.OpenReader(buf)
+	req.NoError(err)
+	defer f.Close()
+
+	req.Equal([]string{"Test  module"}, f.GetSheetList())
+
+	rows, err := f.GetRows("Test  module", excelize.Options{RawCellValue: true})
+	req.NoError(err)
+	req.Len(rows, 2)
+	req.Equal([]string{"id", "createdAt", "str", "num", "bool", "date", "dt", "multi", "users"}, rows[0])
+
+	cellType := func(cell string) excelize.CellType {
+		ct, err := f.GetCellType("Test  module", cell)
+		req.NoError(err)
+		return ct
+	}
+
+	req.Equal("foo", rows[1][2])
+
+	req.Equal(excelize.CellTypeUnset, cellType("D2"))
+	req.Equal("4.2", rows[1][3])
+
+	req.Equal(excelize.CellTypeBool, cellType("E2"))
+	req.Equal("1", rows[1][4])
+
+	// dates are numbers formatted as dates
+	// in the configured timezone
+	date := func(cell, raw string, numFmt int) time.Time {
+		style, err := f.GetCellStyle("Test  module", cell)
+		req.NoError(err)
+		s, err := f.GetStyle(style)
+		req.NoError(err)
+		req.Equal(numFmt, s.NumFmt)
+
+		num, err := strconv.ParseFloat(raw, 64)
+		req.NoError(err)
+		t, err := excelize.ExcelDateToTime(num, false)
+		req.NoError(err)
+		return t.Round(time.Second)
+	}
+
+	req.Equal(time.Date(2022, 3, 4, 13, 0, 0, 0, time.UTC), date("B2", rows[1][1], numFmtDateTime))
+	req.Equal(time.Date(2022, 3, 4, 0, 0, 0, 0, time.UTC), date("F2", rows[1][5], numFmtDate))
+	req.Equal(time.Date(2022, 3, 4, 14, 14, 15, 0, time.UTC), date("G2", rows[1][6], numFmtDateTime))
+
+	req.Equal(`["a","line 1\nline 2"]`, rows[1][7])
+	req.Equal(`["u10","u11"]`, rows[1][8])
+}
@@ -177,6 +177,12 @@ func (wrap *automationWorkflowStep) UnmarshalYAML(n *yaml.Node) (err error) {
 		case "results":
 			wrap.res.Results, err = unmarshalExprSet(v)
 			return err
+		case "retry":
+			wrap.res.Retry, err = unmarshalStepRetry(v)
+			return err
+		case "event":
+			wrap.res.Event, err = unmarshalStepEvent(v)
+			return err
 		case "meta":
 			return v.Decode(&wrap.res.Meta)
 		}
@@ -212,6 +218,54 @@ func (wrap *automationWorkflowPath) UnmarshalYAML(n *yaml.Node) (err error) {
 	})
 }
 
+func unmarshalStepRetry(n *yaml.Node) (*types.WorkflowStepRetry, error) {
+	r := &types.WorkflowStepRetry{}
+
+	err := y7s.EachMap(n, func(k, v *yaml.Node) error {
+		switch strings.ToLower(k.Value) {
+		case "maxattempts":
+			return y7s.DecodeScalar(v, "retry max attempts", &r.MaxAttempts)
+		case "backoff":
+			return y7s.DecodeScalar(v, "retry backoff", &r.Backoff)
+		case "delay":
+			return y7s.DecodeScalar(v, "retry delay", &r.Delay)
+		case "maxdelay":
+			return y7s.DecodeScalar(v, "retry max delay", &r.MaxDelay)
+		case "jitter":
+			return y7s.DecodeScalar(v, "retry jitter", &r.Jitter)
+		case "retryon":
+			return v.Decode(&r.RetryOn)
+		case "retryonstatus":
+			return v.Decode(&r.RetryOnStatus)
+		}
+
+		return nil
+	})
+
+	return r, err
+}
+
+func unmarshalStepEvent(n *yaml.Node) (*types.WorkflowStepEvent, error) {
+	e := &types.WorkflowStepEvent{}
+
+	err := y7s.EachMap(n, func(k, v *yaml.Node) error {
+		switch strings.ToLower(k.Value) {
+		case "resourcetype", "resource":
+			return y7s.DecodeScalar(v, "event resource type", &e.ResourceType)
+		case "eventtype", "event":
+			return y7s.DecodeScalar(v, "event type", &e.EventType)
+		case "correlate":
+			return y7s.DecodeScalar(v, "event correlation", &e.Correlate)
+		case "constraint":
+			return y7s.DecodeScalar(v, "event constraint", &e.Constraint)
+		}
+
+		return nil
+	})
+
+	return e, err
+}
+
 func unmarshalExprSet(n *yaml.Node) ([]*types.Expr, error) {
 	ee := make([]*types.Expr, 0, 10)
 
@@ -16,6 +16,12 @@ type (
 		Ident() string
 	}
 
+	// MultiValueProvider is implemented by providers that hold all of the
+	// values of a multi-value field in a single value (xlsx cells)
+	MultiValueProvider interface {
+		SplitMultiValue(raw string) []string
+	}
+
 	Datasource interface {
 		Next(ctx context.Context, out map[string]string) (ident []string, more bool, err error)
 		Reset(ctx context.Context) error
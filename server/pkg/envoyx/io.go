@@ -11,6 +11,7 @@ import (
 
 	"github.com/cortezaproject/corteza/server/pkg/envoyx/csv"
 	"github.com/cortezaproject/corteza/server/pkg/envoyx/json"
+	"github.com/cortezaproject/corteza/server/pkg/envoyx/xlsx"
 )
 
 func (svc *Service) decodeIo(ctx context.Context, p DecodeParams) (nodes NodeSet, providers []Provider, err error) {
@@ -183,5 +184,17 @@ func (nvyx *Service) decodeFile(ctx context.Context, p DecodeParams, basePath, p
 		providers = append(providers, aux)
 	}
 
+	// Each (non-empty) sheet of the workbook is its own provider
+	if xlsx.CanDecodeFile(f) || xlsx.CanDecodeExt(f.Name()) {
+		f.Seek(0, 0)
+		aux, err := xlsx.SheetDecoders(f, providerIdent)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, a := range aux {
+			providers = append(providers, a)
+		}
+	}
+
 	return
 }
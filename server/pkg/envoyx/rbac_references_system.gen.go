@@ -139,3 +139,16 @@ func SystemDalConnectionRbacReferences(dalConnection string) (res *Ref, pp []*Re
 
 	return
 }
+
+// SystemWebhookRbacReferences generates RBAC references
+//
+// Resources with "envoy: false" are skipped
+//
+// This function is auto-generated
+func SystemWebhookRbacReferences(webhook string) (res *Ref, pp []*Ref, err error) {
+	if webhook != "*" {
+		res = &Ref{ResourceType: types.WebhookResourceType, Identifiers: MakeIdentifiers(webhook)}
+	}
+
+	return
+}
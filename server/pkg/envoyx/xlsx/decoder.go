@@ -0,0 +1,425 @@
+package xlsx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/xuri/excelize/v2"
+)
+
+type (
+	decoder struct {
+		ident string
+		sheet string
+
+		header []string
+		rows   [][]string
+		pos    int
+	}
+
+	// workbook wraps the parsed xlsx document
+	// and helps us with converting typed cells back to their raw values
+	workbook struct {
+		file     *excelize.File
+		date1904 bool
+
+		// cell style index: kind of the cell format
+		formats map[int]cellFormat
+	}
+
+	cellFormat int
+)
+
+const (
+	cellFormatGeneral cellFormat = iota
+	cellFormatDate
+	cellFormatTime
+	cellFormatDateTime
+)
+
+const (
+	// SheetIdentDelimiter separates the file identifier from the sheet name
+	// for all but the first sheet of the workbook
+	SheetIdentDelimiter = "#"
+
+	mime = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+var (
+	// strips out escaped characters that would be mistaken for date & time tokens
+	numFmtCleaner = strings.NewReplacer("\\y", "", "\\d", "", "\\h", "", "\\s", "")
+)
+
+func CanDecodeFile(f io.Reader) bool {
+	m, err := mimetype.DetectReader(f)
+	if err != nil {
+		return false
+	}
+
+	return CanDecodeExt(m.Extension())
+}
+
+func CanDecodeMime(m string) bool {
+	return m == mime
+}
+
+func CanDecodeExt(ext string) bool {
+	pt := strings.Split(ext, ".")
+	return strings.TrimSpace(pt[len(pt)-1]) == "xlsx"
+}
+
+// Sheets returns the names of all the sheets in the workbook
+func Sheets(r io.Reader) (out []string, err error) {
+	wb, err := openWorkbook(r)
+	if err != nil {
+		return
+	}
+	defer wb.file.Close()
+
+	return wb.file.GetSheetList(), nil
+}
+
+// Decoder inits a new xlsx decoder for the given sheet
+//
+// When sheet is not specified, the active sheet of the workbook is used.
+// The entire sheet is loaded into memory.
+func Decoder(r io.Reader, ident, sheet string) (out *decoder, err error) {
+	wb, err := openWorkbook(r)
+	if err != nil {
+		return
+	}
+	defer wb.file.Close()
+
+	if sheet == "" {
+		sheet = wb.file.GetSheetName(wb.file.GetActiveSheetIndex())
+	}
+
+	if idx, _ := wb.file.GetSheetIndex(sheet); idx < 0 {
+		return nil, fmt.Errorf("sheet %s not found", sheet)
+	}
+
+	return wb.decoder(ident, sheet)
+}
+
+// SheetDecoders inits a new xlsx decoder for each non-empty sheet of the workbook
+//
+// The first decoder uses the given identifier; the rest of them
+// are suffixed with the name of the sheet (file.xlsx#Sheet2).
+func SheetDecoders(r io.Reader, ident string) (out []*decoder, err error) {
+	wb, err := openWorkbook(r)
+	if err != nil {
+		return
+	}
+	defer wb.file.Close()
+
+	var d *decoder
+	for _, sheet := range wb.file.GetSheetList() {
+		sIdent := ident
+		if len(out) > 0 {
+			sIdent = ident + SheetIdentDelimiter + sheet
+		}
+
+		d, err = wb.decoder(sIdent, sheet)
+		if err != nil {
+			return
+		}
+
+		if len(d.header) == 0 {
+			continue
+		}
+
+		out = append(out, d)
+	}
+
+	return
+}
+
+// Cleanup should be called before we stop using the decoder
+func (d *decoder) Cleanup() error {
+	return nil
+}
+
+// SetIdent overwrites the system defined identifier
+func (d *decoder) SetIdent(ident string) {
+	d.ident = ident
+}
+
+// Ident returns the assigned identifier
+func (d *decoder) Ident() string {
+	return d.ident
+}
+
+// Sheet returns the name of the decoded sheet
+func (d *decoder) Sheet() string {
+	return d.sheet
+}
+
+// Fields returns every available field in this dataset
+func (d *decoder) Fields() []string {
+	return d.header
+}
+
+// Reset resets the decoder to the start
+func (d *decoder) Reset(_ context.Context) error {
+	d.pos = 0
+	return nil
+}
+
+// Next returns the field: value mapping for the next row
+func (d *decoder) Next(_ context.Context, out map[string]string) (more bool, err error) {
+	if d.pos >= len(d.rows) {
+		return false, nil
+	}
+
+	for i, h := range d.header {
+		out[h] = d.rows[d.pos][i]
+	}
+
+	d.pos++
+	return true, nil
+}
+
+// SplitMultiValue splits the cell value of a multi-value field into individual values
+//
+// Cells hold all of the values as a JSON array of strings;
+// same as the record exports produce them.
+// Cells that are not JSON arrays hold a single value.
+func (d *decoder) SplitMultiValue(raw string) (out []string) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return
+	}
+
+	var vv []string
+	if !strings.HasPrefix(raw, "[") || json.Unmarshal([]byte(raw), &vv) != nil {
+		return []string{raw}
+	}
+
+	for _, v := range vv {
+		if v == "" {
+			continue
+		}
+
+		out = append(out, v)
+	}
+
+	return
+}
+
+// Count returns the total number of rows in the dataset
+func (d *decoder) Count() uint64 {
+	return uint64(len(d.rows))
+}
+
+func openWorkbook(r io.Reader) (wb *workbook, err error) {
+	wb = &workbook{
+		formats: make(map[int]cellFormat),
+	}
+
+	if wb.file, err = excelize.OpenReader(r); err != nil {
+		return nil, err
+	}
+
+	props, err := wb.file.GetWorkbookProps()
+	if err != nil {
+		wb.file.Close()
+		return nil, err
+	}
+
+	wb.date1904 = props.Date1904 != nil && *props.Date1904
+	return
+}
+
+// decoder reads the sheet and prepares the decoder
+//
+// The first row is used as the header; empty rows are omitted.
+func (wb *workbook) decoder(ident, sheet string) (out *decoder, err error) {
+	out = &decoder{
+		ident: ident,
+		sheet: sheet,
+	}
+
+	rr, err := wb.file.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return
+	}
+
+	if len(rr) == 0 {
+		return
+	}
+
+	for _, h := range rr[0] {
+		out.header = append(out.header, strings.TrimSpace(h))
+	}
+
+	for r, raw := range rr[1:] {
+		var (
+			row   = make([]string, len(out.header))
+			empty = true
+		)
+
+		for c := range row {
+			if c >= len(raw) || raw[c] == "" {
+				continue
+			}
+
+			empty = false
+			if row[c], err = wb.value(sheet, c+1, r+2, raw[c]); err != nil {
+				return
+			}
+		}
+
+		if !empty {
+			out.rows = append(out.rows, row)
+		}
+	}
+
+	return
+}
+
+// value converts the raw cell value into the value record values expect
+//
+// Dates and times are stored as numbers and are only recognized by
+// the number format of the cell.
+func (wb *workbook) value(sheet string, col, row int, raw string) (string, error) {
+	cell, err := excelize.CoordinatesToCellName(col, row)
+	if err != nil {
+		return "", err
+	}
+
+	ct, err := wb.file.GetCellType(sheet, cell)
+	if err != nil {
+		return "", err
+	}
+
+	if ct != excelize.CellTypeUnset && ct != excelize.CellTypeNumber {
+		return raw, nil
+	}
+
+	num, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		// not a number after all
+		return raw, nil
+	}
+
+	style, err := wb.file.GetCellStyle(sheet, cell)
+	if err != nil {
+		return "", err
+	}
+
+	format := wb.cellFormat(style)
+	if format == cellFormatGeneral {
+		if strings.ContainsAny(raw, "eE") {
+			// expand the scientific notation
+			return strconv.FormatFloat(num, 'f', -1, 64), nil
+		}
+
+		return raw, nil
+	}
+
+	t, err := excelize.ExcelDateToTime(num, wb.date1904)
+	if err != nil {
+		return "", err
+	}
+
+	t = t.Round(time.Second)
+	switch format {
+	case cellFormatDate:
+		return t.Format("2006-01-02"), nil
+	case cellFormatTime:
+		return t.Format("15:04:05"), nil
+	default:
+		return t.Format(time.RFC3339), nil
+	}
+}
+
+// cellFormat determines the kind of the number format of the given cell style
+func (wb *workbook) cellFormat(style int) (f cellFormat) {
+	if f, ok := wb.formats[style]; ok {
+		return f
+	}
+
+	defer func() {
+		wb.formats[style] = f
+	}()
+
+	ss := wb.file.Styles
+	if ss == nil || ss.CellXfs == nil || style < 0 || style >= len(ss.CellXfs.Xf) {
+		return
+	}
+
+	xf := ss.CellXfs.Xf[style]
+	if xf.NumFmtID == nil {
+		return
+	}
+
+	id := *xf.NumFmtID
+	switch {
+	case id >= 14 && id <= 17, id >= 27 && id <= 36, id >= 50 && id <= 58:
+		return cellFormatDate
+	case id >= 18 && id <= 21, id >= 45 && id <= 47:
+		return cellFormatTime
+	case id == 22:
+		return cellFormatDateTime
+	}
+
+	if ss.NumFmts == nil {
+		return
+	}
+
+	for _, nf := range ss.NumFmts.NumFmt {
+		if nf.NumFmtID == id {
+			return numFmtCodeFormat(nf.FormatCode)
+		}
+	}
+
+	return
+}
+
+// numFmtCodeFormat determines the kind of the custom number format
+func numFmtCodeFormat(code string) cellFormat {
+	var (
+		b     strings.Builder
+		quote bool
+		brack bool
+	)
+
+	code = numFmtCleaner.Replace(strings.ToLower(code))
+	for _, c := range code {
+		switch {
+		case c == '"':
+			quote = !quote
+		case quote:
+		case c == '[':
+			brack = true
+		case c == ']':
+			brack = false
+		case brack:
+		default:
+			b.WriteRune(c)
+		}
+	}
+
+	code = b.String()
+	var (
+		hasDate = strings.ContainsAny(code, "yd")
+		hasTime = strings.ContainsAny(code, "hs")
+	)
+
+	switch {
+	case hasDate && hasTime:
+		return cellFormatDateTime
+	case hasDate:
+		return cellFormatDate
+	case hasTime:
+		return cellFormatTime
+	}
+
+	return cellFormatGeneral
+}
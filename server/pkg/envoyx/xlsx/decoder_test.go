@@ -0,0 +1,160 @@
+package xlsx
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestDecoder(t *testing.T) {
+	req := require.New(t)
+
+	t.Run("init & meta", func(t *testing.T) {
+		dc, err := Decoder(testReader(t), "test.xlsx", "")
+		req.NoError(err)
+
+		req.Equal("Records", dc.Sheet())
+		req.Equal(uint64(2), dc.Count())
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		dc, err := Decoder(testReader(t), "test.xlsx", "")
+		req.NoError(err)
+
+		req.Equal([]string{"str", "num", "bool", "date", "time", "datetime", "custom", "multi"}, dc.Fields())
+	})
+
+	t.Run("iterate", func(t *testing.T) {
+		dc, err := Decoder(testReader(t), "test.xlsx", "")
+		req.NoError(err)
+
+		aux := make(map[string]string)
+		var more bool
+
+		more, err = dc.Next(nil, aux)
+		req.NoError(err)
+		req.True(more)
+		req.Equal("r1", aux["str"])
+		req.Equal("4.2", aux["num"])
+		req.Equal("1", aux["bool"])
+		req.Equal("2022-03-04", aux["date"])
+		req.Equal("13:14:15", aux["time"])
+		req.Equal("2022-03-04T13:14:15Z", aux["datetime"])
+		req.Equal("2022-03-04", aux["custom"])
+		req.Equal(`["a","line 1\nline 2"]`, aux["multi"])
+		req.Equal([]string{"a", "line 1\nline 2"}, dc.SplitMultiValue(aux["multi"]))
+		req.Equal([]string{"single"}, dc.SplitMultiValue("single"))
+		req.Empty(dc.SplitMultiValue(" "))
+		req.Empty(dc.SplitMultiValue(`[""]`))
+
+		// the empty row in between is skipped
+		more, err = dc.Next(nil, aux)
+		req.NoError(err)
+		req.True(more)
+		req.Equal("r3", aux["str"])
+		req.Equal("0.0001", aux["num"])
+		req.Equal("0", aux["bool"])
+
+		more, err = dc.Next(nil, aux)
+		req.NoError(err)
+		req.False(more)
+
+		req.NoError(dc.Reset(nil))
+		more, err = dc.Next(nil, aux)
+		req.NoError(err)
+		req.True(more)
+		req.Equal("r1", aux["str"])
+	})
+
+	t.Run("sheet", func(t *testing.T) {
+		dc, err := Decoder(testReader(t), "test.xlsx", "Other")
+		req.NoError(err)
+
+		req.Equal([]string{"f1"}, dc.Fields())
+		req.Equal(uint64(1), dc.Count())
+
+		_, err = Decoder(testReader(t), "test.xlsx", "Missing")
+		req.Error(err)
+	})
+
+	t.Run("sheets", func(t *testing.T) {
+		ss, err := Sheets(testReader(t))
+		req.NoError(err)
+		req.Equal([]string{"Records", "Empty", "Other"}, ss)
+
+		dd, err := SheetDecoders(testReader(t), "test.xlsx")
+		req.NoError(err)
+		req.Len(dd, 2)
+		req.Equal("test.xlsx", dd[0].Ident())
+		req.Equal("test.xlsx#Other", dd[1].Ident())
+	})
+}
+
+func TestNumFmtCodeFormat(t *testing.T) {
+	tcc := []struct {
+		code string
+		f    cellFormat
+	}{
+		{"General", cellFormatGeneral},
+		{"0.00", cellFormatGeneral},
+		{`[$€-2] #,##0.00`, cellFormatGeneral},
+		{`0 "days"`, cellFormatGeneral},
+		{"yyyy-mm-dd", cellFormatDate},
+		{"dd/mm/yyyy", cellFormatDate},
+		{"hh:mm:ss", cellFormatTime},
+		{"[Red]h:mm AM/PM", cellFormatTime},
+		{"yyyy-mm-dd hh:mm", cellFormatDateTime},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.code, func(t *testing.T) {
+			require.Equal(t, tc.f, numFmtCodeFormat(tc.code))
+		})
+	}
+}
+
+func testReader(t *testing.T) io.Reader {
+	var (
+		req = require.New(t)
+		f   = excelize.NewFile()
+		err error
+	)
+
+	customFmt := "dd.mm.yyyy"
+	custom, err := f.NewStyle(&excelize.Style{CustomNumFmt: &customFmt})
+	req.NoError(err)
+	date, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	req.NoError(err)
+	tm, err := f.NewStyle(&excelize.Style{NumFmt: 21})
+	req.NoError(err)
+	datetime, err := f.NewStyle(&excelize.Style{NumFmt: 22})
+	req.NoError(err)
+
+	ts := time.Date(2022, 3, 4, 13, 14, 15, 0, time.UTC)
+
+	req.NoError(f.SetSheetName("Sheet1", "Records"))
+	req.NoError(f.SetSheetRow("Records", "A1", &[]interface{}{"str", "num", "bool", "date", "time", "datetime", "custom", "multi"}))
+	req.NoError(f.SetSheetRow("Records", "A2", &[]interface{}{"r1", 4.2, true, ts, ts, ts, ts, `["a","line 1\nline 2"]`}))
+	req.NoError(f.SetSheetRow("Records", "A4", &[]interface{}{"r3", 0.0001, false}))
+	req.NoError(f.SetCellStyle("Records", "D2", "D2", date))
+	req.NoError(f.SetCellStyle("Records", "E2", "E2", tm))
+	req.NoError(f.SetCellStyle("Records", "F2", "F2", datetime))
+	req.NoError(f.SetCellStyle("Records", "G2", "G2", custom))
+
+	_, err = f.NewSheet("Empty")
+	req.NoError(err)
+
+	_, err = f.NewSheet("Other")
+	req.NoError(err)
+	req.NoError(f.SetSheetRow("Other", "A1", &[]interface{}{"f1"}))
+	req.NoError(f.SetSheetRow("Other", "A2", &[]interface{}{"v1"}))
+
+	buf, err := f.WriteToBuffer()
+	req.NoError(err)
+
+	return bytes.NewReader(buf.Bytes())
+}
@@ -1,8 +1,12 @@
 package gvalfnc
 
 import (
+	"container/list"
+	"regexp"
 	"strings"
+	"sync"
 
+	"github.com/modern-go/reflect2"
 	"github.com/spf13/cast"
 )
 
@@ -15,3 +19,186 @@ func ConcatStrings(parts ...any) (string, error) {
 
 	return strings.Join(pp, ""), nil
 }
+
+type (
+	// patternCache keeps the most recently used compiled patterns
+	patternCache struct {
+		mux  sync.Mutex
+		size int
+
+		// most recently used patterns are at the front
+		order *list.List
+		index map[string]*list.Element
+	}
+
+	cachedPattern struct {
+		src string
+		re  *regexp.Regexp
+	}
+)
+
+const (
+	// max number of compiled patterns kept in the cache
+	patternCacheSize = 256
+)
+
+var (
+	// compiled LIKE and regex patterns
+	patterns = newPatternCache(patternCacheSize)
+)
+
+// ILike checks if the value matches the SQL LIKE pattern, ignoring the case
+//
+// The % wildcard matches any sequence of characters and the _ wildcard
+// matches any single character; wildcards are escaped with a backslash.
+// Nil values (or patterns) never match.
+func ILike(v, pattern any) (bool, error) {
+	return like(v, pattern, true)
+}
+
+// Regex checks if the value contains a match of the regular expression
+//
+// Nil values (or patterns) never match.
+func Regex(v, pattern any) (bool, error) {
+	if reflect2.IsNil(v) || reflect2.IsNil(pattern) {
+		return false, nil
+	}
+
+	p, err := cast.ToStringE(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	re, err := compilePattern(p)
+	if err != nil {
+		return false, err
+	}
+
+	s, err := cast.ToStringE(v)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(s), nil
+}
+
+func like(v, pattern any, insensitive bool) (bool, error) {
+	if reflect2.IsNil(v) || reflect2.IsNil(pattern) {
+		return false, nil
+	}
+
+	p, err := cast.ToStringE(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	re, err := compilePattern(likeToRegexp(p, insensitive))
+	if err != nil {
+		return false, err
+	}
+
+	s, err := cast.ToStringE(v)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(s), nil
+}
+
+// likeToRegexp converts the LIKE pattern to the equivalent regular expression
+//
+// Backslash escapes the wildcards; the same escape character
+// is explicitly set on all database dialects
+func likeToRegexp(pattern string, insensitive bool) string {
+	var (
+		b        strings.Builder
+		escaping bool
+	)
+
+	// % and _ should match new lines as well
+	b.WriteString("(?s")
+	if insensitive {
+		b.WriteString("i")
+	}
+	b.WriteString(")^")
+
+	for _, r := range pattern {
+		switch {
+		case escaping:
+			escaping = false
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		case r == '\\':
+			escaping = true
+		case r == '%':
+			b.WriteString(".*")
+		case r == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	if escaping {
+		// trailing escape character matches itself
+		b.WriteString(regexp.QuoteMeta(`\`))
+	}
+
+	b.WriteString("$")
+	return b.String()
+}
+
+func compilePattern(p string) (re *regexp.Regexp, err error) {
+	if re = patterns.get(p); re != nil {
+		return
+	}
+
+	if re, err = regexp.Compile(p); err != nil {
+		return
+	}
+
+	patterns.add(p, re)
+	return
+}
+
+func newPatternCache(size int) *patternCache {
+	return &patternCache{
+		size:  size,
+		order: list.New(),
+		index: make(map[string]*list.Element),
+	}
+}
+
+// get returns the compiled pattern or nil when it is not cached
+func (c *patternCache) get(src string) *regexp.Regexp {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	e, ok := c.index[src]
+	if !ok {
+		return nil
+	}
+
+	c.order.MoveToFront(e)
+	return e.Value.(*cachedPattern).re
+}
+
+// add caches the compiled pattern and evicts the least recently used one
+// when the cache is full
+func (c *patternCache) add(src string, re *regexp.Regexp) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e, ok := c.index[src]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.index[src] = c.order.PushFront(&cachedPattern{src: src, re: re})
+	if c.order.Len() <= c.size {
+		return
+	}
+
+	e := c.order.Back()
+	c.order.Remove(e)
+	delete(c.index, e.Value.(*cachedPattern).src)
+}
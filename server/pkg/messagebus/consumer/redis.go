@@ -0,0 +1,205 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/messagebus/types"
+	"github.com/redis/go-redis/v9"
+)
+
+type (
+	// RedisConsumer writes messages to a redis stream and reads
+	// them back through a consumer group
+	//
+	// Messages that are read but not acknowledged within RedeliverAfter
+	// are claimed and returned again by the next Read
+	RedisConsumer struct {
+		queue  string
+		handle types.ConsumerType
+		client redis.Cmdable
+
+		stream   string
+		group    string
+		consumer string
+		maxLen   int64
+		block    time.Duration
+		minIdle  time.Duration
+		count    int64
+
+		groupReady bool
+	}
+)
+
+const (
+	redisPayloadField = "payload"
+
+	redisDefaultGroup   = "corteza"
+	redisDefaultBlock   = 5 * time.Second
+	redisMaxBlock       = 30 * time.Second
+	redisDefaultMinIdle = time.Minute
+	redisReadCount      = 10
+)
+
+func NewRedisConsumer(q string, meta types.QueueMeta, client redis.Cmdable) *RedisConsumer {
+	h := &RedisConsumer{
+		queue:  q,
+		handle: types.ConsumerRedis,
+		client: client,
+
+		stream:  q,
+		group:   redisDefaultGroup,
+		block:   redisDefaultBlock,
+		minIdle: redisDefaultMinIdle,
+		maxLen:  meta.MaxLen,
+		count:   redisReadCount,
+	}
+
+	if meta.Stream != "" {
+		h.stream = meta.Stream
+	}
+
+	if meta.Group != "" {
+		h.group = meta.Group
+	}
+
+	if meta.PollDelay != nil && *meta.PollDelay > 0 {
+		h.block = *meta.PollDelay
+	}
+
+	// keep blocking reads short so that consumer
+	// notices changes and stops in reasonable time
+	if h.block > redisMaxBlock {
+		h.block = redisMaxBlock
+	}
+
+	if meta.RedeliverAfter != nil && *meta.RedeliverAfter > 0 {
+		h.minIdle = *meta.RedeliverAfter
+	}
+
+	// consumer name needs to be unique inside the group
+	// so that pending messages can be tracked per server instance
+	host, _ := os.Hostname()
+	h.consumer = fmt.Sprintf("%s-%d", host, os.Getpid())
+
+	return h
+}
+
+func (cq *RedisConsumer) Write(ctx context.Context, p []byte) (err error) {
+	args := &redis.XAddArgs{
+		Stream: cq.stream,
+		Values: map[string]interface{}{redisPayloadField: p},
+	}
+
+	if cq.maxLen > 0 {
+		args.MaxLen = cq.maxLen
+		args.Approx = true
+	}
+
+	return cq.client.XAdd(ctx, args).Err()
+}
+
+// Read returns unacknowledged messages that timed out and new messages from the stream
+//
+// When there is nothing to read, call blocks up to poll delay
+// or until the context is canceled
+func (cq *RedisConsumer) Read(ctx context.Context) (mm []types.QueueMessage, err error) {
+	if !cq.groupReady {
+		// existing group is not an error
+		err = cq.client.XGroupCreateMkStream(ctx, cq.stream, cq.group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, fmt.Errorf("could not create consumer group: %w", err)
+		}
+
+		cq.groupReady = true
+	}
+
+	sm, _, err := cq.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   cq.stream,
+		Group:    cq.group,
+		Consumer: cq.consumer,
+		MinIdle:  cq.minIdle,
+		Start:    "0-0",
+		Count:    cq.count,
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("could not claim pending messages: %w", err)
+	}
+
+	if len(sm) == 0 {
+		if sm, err = cq.readGroup(ctx); err != nil {
+			return nil, fmt.Errorf("could not read messages: %w", err)
+		}
+	}
+
+	mm = make([]types.QueueMessage, len(sm))
+	for i, m := range sm {
+		payload, _ := m.Values[redisPayloadField].(string)
+		mm[i] = types.QueueMessage{
+			ID:      m.ID,
+			Queue:   cq.queue,
+			Payload: []byte(payload),
+		}
+	}
+
+	return
+}
+
+// Ack acknowledges processed messages
+func (cq *RedisConsumer) Ack(ctx context.Context, mm ...types.QueueMessage) (err error) {
+	if len(mm) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(mm))
+	for _, m := range mm {
+		ids = append(ids, m.ID)
+	}
+
+	return cq.client.XAck(ctx, cq.stream, cq.group, ids...).Err()
+}
+
+// readGroup reads new (never delivered) messages for the consumer
+//
+// Client does not interrupt blocking read when the context is canceled;
+// we stop waiting for it and let it time out on its own
+func (cq *RedisConsumer) readGroup(ctx context.Context) ([]redis.XMessage, error) {
+	var (
+		done = make(chan struct{})
+
+		ss  []redis.XStream
+		err error
+	)
+
+	go func() {
+		defer close(done)
+		ss, err = cq.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    cq.group,
+			Consumer: cq.consumer,
+			Streams:  []string{cq.stream, ">"},
+			Count:    cq.count,
+			Block:    cq.block,
+		}).Result()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-done:
+	}
+
+	if errors.Is(err, redis.Nil) {
+		// nothing to read
+		return nil, nil
+	}
+
+	if err != nil || len(ss) == 0 {
+		return nil, err
+	}
+
+	return ss[0].Messages, nil
+}
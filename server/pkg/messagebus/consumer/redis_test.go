@@ -0,0 +1,109 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cortezaproject/corteza/server/pkg/messagebus/types"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handlerRedisReadWrite(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+	)
+
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	defer client.Close()
+
+	h := NewRedisConsumer("foobar", types.QueueMeta{
+		Stream:         "corteza:foobar",
+		PollDelay:      makeDelay(10 * time.Millisecond),
+		RedeliverAfter: makeDelay(time.Hour),
+	}, client)
+
+	// messages written before the first read are not lost
+	req.NoError(h.Write(ctx, []byte("foo")))
+	req.NoError(h.Write(ctx, []byte("bar")))
+
+	mm, err := h.Read(ctx)
+	req.NoError(err)
+	req.Len(mm, 2)
+	req.Equal("foobar", mm[0].Queue)
+	req.Equal([]byte("foo"), mm[0].Payload)
+	req.Equal([]byte("bar"), mm[1].Payload)
+	req.NotEmpty(mm[0].ID)
+
+	req.NoError(h.Ack(ctx, mm...))
+
+	mm, err = h.Read(ctx)
+	req.NoError(err)
+	req.Empty(mm)
+}
+
+func Test_handlerRedisRedelivery(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+	)
+
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	defer client.Close()
+
+	h := NewRedisConsumer("foobar", types.QueueMeta{
+		PollDelay:      makeDelay(10 * time.Millisecond),
+		RedeliverAfter: makeDelay(20 * time.Millisecond),
+	}, client)
+
+	req.NoError(h.Write(ctx, []byte("foo")))
+
+	mm, err := h.Read(ctx)
+	req.NoError(err)
+	req.Len(mm, 1)
+
+	// not acked and not idle long enough
+	again, err := h.Read(ctx)
+	req.NoError(err)
+	req.Empty(again)
+
+	time.Sleep(30 * time.Millisecond)
+
+	again, err = h.Read(ctx)
+	req.NoError(err)
+	req.Len(again, 1)
+	req.Equal(mm[0].ID, again[0].ID)
+	req.Equal([]byte("foo"), again[0].Payload)
+
+	req.NoError(h.Ack(ctx, again...))
+
+	time.Sleep(30 * time.Millisecond)
+
+	again, err = h.Read(ctx)
+	req.NoError(err)
+	req.Empty(again)
+}
+
+func Test_handlerRedisReadCanceled(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+	)
+
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	defer client.Close()
+
+	h := NewRedisConsumer("foobar", types.QueueMeta{PollDelay: makeDelay(time.Hour)}, client)
+	req.Equal(redisMaxBlock, h.block)
+
+	ctx, cancel := context.WithCancel(ctx)
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := h.Read(ctx)
+	req.ErrorIs(err, context.Canceled)
+	req.Less(time.Since(start), time.Second)
+}
@@ -4,17 +4,24 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
 	"github.com/cortezaproject/corteza/server/pkg/messagebus/consumer"
 	"github.com/cortezaproject/corteza/server/pkg/messagebus/store"
 	"github.com/cortezaproject/corteza/server/pkg/messagebus/types"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
 var (
 	// global service
 	gMbus *messageBus
+
+	// how long to wait before reading again
+	// when reading from the queue fails
+	readRetryDelay = 5 * time.Second
 )
 
 type (
@@ -25,6 +32,9 @@ type (
 		mutex     sync.Mutex
 		queues    types.QueueSet
 
+		// shared between all redis consumers, initialized on first use
+		redis *redis.Client
+
 		in     chan types.Message
 		quit   chan bool
 		reload chan bool
@@ -72,8 +82,14 @@ func (mb *messageBus) Init(ctx context.Context, storer types.QueueServicer) {
 // Listen sets read and write channel listeners,
 // used on boot
 func (mb *messageBus) Listen(ctx context.Context) {
+	// readers are stopped when queues are reloaded
+	rctx, cancel := context.WithCancel(ctx)
+	mb.read(rctx)
+
 	// check in channel and act accordingly
 	go func() {
+		defer cancel()
+
 		for {
 			select {
 			case t := <-mb.in:
@@ -184,7 +200,7 @@ func (mb *messageBus) initQueues(ctx context.Context) error {
 	for _, q := range list {
 		mb.logger.Debug("initializing queue", zap.String("queue", q.Queue))
 
-		c, err := mb.initConsumer(ctx, q.Queue, q.Consumer)
+		c, err := mb.initConsumer(ctx, q.Queue, q.Consumer, types.QueueMeta(q.Meta))
 
 		if err != nil {
 			mb.logger.Warn("could not init consumer for queue", zap.String("queue", q.Queue), zap.Error(err))
@@ -205,8 +221,76 @@ func (mb *messageBus) initQueues(ctx context.Context) error {
 	return nil
 }
 
+// read starts a reader for each queue that can be read from
+// and should dispatch events for messages
+func (mb *messageBus) read(ctx context.Context) {
+	mb.mutex.Lock()
+	defer mb.mutex.Unlock()
+
+	for _, q := range mb.queues {
+		if !q.CanDispatch() {
+			continue
+		}
+
+		r, isReader := q.Consumer.(types.Reader)
+		a, isAcker := q.Consumer.(types.Acknowledger)
+		if !isReader || !isAcker {
+			continue
+		}
+
+		go mb.consume(ctx, q.Name, r, a)
+	}
+}
+
+// consume reads messages from the queue and dispatches onMessage event for each one
+//
+// Message is acknowledged only after all handlers finish without an error,
+// otherwise it is left for redelivery
+func (mb *messageBus) consume(ctx context.Context, q string, r types.Reader, a types.Acknowledger) {
+	log := mb.logger.With(zap.String("queue", q))
+	log.Debug("reading from queue")
+
+	for {
+		if ctx.Err() != nil {
+			log.Debug("stopped reading from queue")
+			return
+		}
+
+		mm, err := r.Read(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+
+			log.Warn("could not read messages from queue", zap.Error(err))
+
+			select {
+			case <-time.After(readRetryDelay):
+			case <-ctx.Done():
+			}
+
+			continue
+		}
+
+		for _, m := range mm {
+			err = eventbus.Service().WaitFor(ctx, mb.qservicer.CreateQueueEvent(q, m.Payload))
+			if err != nil {
+				log.Warn("could not process message, will be redelivered", zap.String("message", m.ID), zap.Error(err))
+				continue
+			}
+
+			if err = a.Ack(ctx, m); err != nil {
+				log.Warn("could not acknowledge message", zap.String("message", m.ID), zap.Error(err))
+				continue
+			}
+
+			log.Debug("message processed", zap.String("message", m.ID))
+		}
+	}
+}
+
 // initHandler returns a new instance for a specific handler
-func (mb *messageBus) initConsumer(ctx context.Context, q string, c string) (cns types.Consumer, err error) {
+func (mb *messageBus) initConsumer(ctx context.Context, q string, c string, meta types.QueueMeta) (cns types.Consumer, err error) {
 	switch c {
 	case string(types.ConsumerEventbus):
 		cns = consumer.NewEventbusConsumer(q, mb.qservicer)
@@ -216,8 +300,36 @@ func (mb *messageBus) initConsumer(ctx context.Context, q string, c string) (cns
 		cns = consumer.NewStoreConsumer(q, mb.qservicer)
 		return
 
+	case string(types.ConsumerRedis):
+		var client *redis.Client
+		if client, err = mb.redisClient(); err != nil {
+			return
+		}
+
+		cns = consumer.NewRedisConsumer(q, meta, client)
+		return
+
 	default:
 		err = fmt.Errorf("message queue consumer %s not implemented", c)
 		return
 	}
 }
+
+// redisClient returns the client shared between all redis consumers
+func (mb *messageBus) redisClient() (c *redis.Client, err error) {
+	if mb.redis != nil {
+		return mb.redis, nil
+	}
+
+	if mb.opts.RedisURL == "" {
+		return nil, fmt.Errorf("redis URL not configured (MESSAGEBUS_REDIS_URL)")
+	}
+
+	opt, err := redis.ParseURL(mb.opts.RedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse redis URL: %w", err)
+	}
+
+	mb.redis = redis.NewClient(opt)
+	return mb.redis, nil
+}
@@ -2,13 +2,17 @@ package messagebus
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/messagebus/consumer"
 	"github.com/cortezaproject/corteza/server/pkg/messagebus/types"
 	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -16,6 +20,15 @@ import (
 type (
 	mockStorer struct{}
 
+	mockQueueEvent struct {
+		queue   string
+		payload []byte
+	}
+
+	mockQueueServicer struct {
+		types.QueueStorer
+	}
+
 	// only use this one to get .messages
 	// from mockQueueHandler
 	messageTesterHandler interface {
@@ -88,6 +101,76 @@ func Test_consume(t *testing.T) {
 	req.Equal(expectedDb, mockDb)
 }
 
+func Test_consumeRedis(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		eb  = eventbus.New()
+
+		mux      sync.Mutex
+		attempts = map[string]int{}
+		done     = make(chan string, 10)
+	)
+
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	defer client.Close()
+
+	eventbus.Set(eb)
+	defer eventbus.Set(eventbus.New())
+
+	// fail first attempt for each message so that it gets redelivered
+	eb.Register(func(ctx context.Context, ev eventbus.Event) error {
+		p := string(ev.(*mockQueueEvent).payload)
+
+		mux.Lock()
+		defer mux.Unlock()
+
+		attempts[p]++
+		if attempts[p] == 1 {
+			return fmt.Errorf("failed to process %s", p)
+		}
+
+		done <- p
+		return nil
+	}, eventbus.For("queue"), eventbus.On("onMessage"))
+
+	mb := New(mOptions, logger)
+	mb.qservicer = &mockQueueServicer{}
+	mb.queues["foobar"] = &types.Queue{
+		Name: "foobar",
+		Meta: types.QueueMeta{DispatchEvents: true},
+		Consumer: consumer.NewRedisConsumer("foobar", types.QueueMeta{
+			PollDelay:      makeDelay(10 * time.Millisecond),
+			RedeliverAfter: makeDelay(20 * time.Millisecond),
+		}, client),
+	}
+
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	mb.Listen(lctx)
+
+	mb.Push("foobar", []byte("foo"))
+
+	select {
+	case p := <-done:
+		req.Equal("foo", p)
+	case <-time.After(5 * time.Second):
+		req.FailNow("message was not redelivered")
+	}
+
+	mux.Lock()
+	req.Equal(2, attempts["foo"])
+	mux.Unlock()
+}
+
+func (mqs *mockQueueServicer) CreateQueueEvent(q string, p []byte) eventbus.Event {
+	return &mockQueueEvent{queue: q, payload: p}
+}
+
+func (ev *mockQueueEvent) ResourceType() string                    { return "queue" }
+func (ev *mockQueueEvent) EventType() string                       { return "onMessage" }
+func (ev *mockQueueEvent) Match(c eventbus.ConstraintMatcher) bool { return true }
+
 func (mh *mockQueueHandler) Notification(ctx context.Context) <-chan interface{} {
 	return mh.notification(ctx)
 }
@@ -122,3 +205,7 @@ func now() *time.Time {
 	t := time.Now()
 	return &t
 }
+
+func makeDelay(d time.Duration) *time.Duration {
+	return &d
+}
@@ -23,4 +23,11 @@ type (
 	Reader interface {
 		Read(ctx context.Context) ([]QueueMessage, error)
 	}
+
+	// Acknowledger confirms that messages were processed
+	//
+	// Messages that are read but never acknowledged are redelivered
+	Acknowledger interface {
+		Ack(ctx context.Context, mm ...QueueMessage) error
+	}
 )
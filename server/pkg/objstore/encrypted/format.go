@@ -0,0 +1,320 @@
+package encrypted
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Encrypted files are stored in the following format:
+//
+//	header | chunk 0 | chunk 1 | ... | chunk n
+//
+// The header holds the data key of the file, wrapped (encrypted)
+// with the master key:
+//
+//	magic (4) | version (1) | master key ID (8) | chunk size (4) | nonce (12) | wrapped data key (32 + 16)
+//
+// Contents are split into chunks of the same size (last one can be shorter)
+// and each of them is sealed separately with AES-GCM and the data key.
+// Chunk index and the last-chunk flag are part of the nonce, so
+// chunks can not be reordered and the file can not be truncated unnoticed.
+//
+// Since the size of the sealed chunks is known, any chunk can be
+// located and decrypted on its own; this is what makes seeking possible.
+
+const (
+	formatVersion = 1
+
+	keySize   = 32
+	keyIDSize = 8
+	nonceSize = 12
+	tagSize   = 16
+
+	headerPrefixSize = 4 + 1 + keyIDSize + 4
+	headerSize       = headerPrefixSize + nonceSize + keySize + tagSize
+
+	defaultChunkSize = 64 << 10
+)
+
+var (
+	magic = [4]byte{'C', 'Z', 'E', 'F'}
+
+	errNotEncrypted = fmt.Errorf("file is not encrypted")
+)
+
+type (
+	header struct {
+		keyID      [keyIDSize]byte
+		chunkSize  uint32
+		nonce      [nonceSize]byte
+		wrappedKey []byte
+	}
+)
+
+// prefix returns the part of the header that is authenticated
+// when the data key is wrapped
+func (h header) prefix() []byte {
+	buf := make([]byte, headerPrefixSize)
+	copy(buf, magic[:])
+	buf[4] = formatVersion
+	copy(buf[5:], h.keyID[:])
+	binary.BigEndian.PutUint32(buf[5+keyIDSize:], h.chunkSize)
+	return buf
+}
+
+func (h header) marshal() []byte {
+	buf := bytes.NewBuffer(h.prefix())
+	buf.Write(h.nonce[:])
+	buf.Write(h.wrappedKey)
+	return buf.Bytes()
+}
+
+// readHeader reads and parses the header
+//
+// errNotEncrypted is returned for files without one
+func readHeader(r io.Reader) (h header, err error) {
+	buf := make([]byte, headerSize)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// too short to be encrypted
+			err = errNotEncrypted
+		}
+
+		return
+	}
+
+	if !bytes.Equal(buf[:4], magic[:]) {
+		return h, errNotEncrypted
+	}
+
+	if buf[4] != formatVersion {
+		return h, fmt.Errorf("unsupported encryption format version %d", buf[4])
+	}
+
+	copy(h.keyID[:], buf[5:])
+	h.chunkSize = binary.BigEndian.Uint32(buf[5+keyIDSize:])
+	copy(h.nonce[:], buf[headerPrefixSize:])
+	h.wrappedKey = buf[headerPrefixSize+nonceSize:]
+
+	if h.chunkSize == 0 {
+		return h, fmt.Errorf("invalid chunk size")
+	}
+
+	return
+}
+
+// chunkNonce returns nonce for the chunk with the given index
+func chunkNonce(idx uint64, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonce, idx)
+	if last {
+		nonce[nonceSize-1] = 1
+	}
+
+	return nonce
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func newDataKey() ([]byte, error) {
+	dk := make([]byte, keySize)
+	if _, err := rand.Read(dk); err != nil {
+		return nil, err
+	}
+
+	return dk, nil
+}
+
+// encryptedSize calculates the size of the encrypted file
+func encryptedSize(size, chunkSize int64) int64 {
+	chunks := (size + chunkSize - 1) / chunkSize
+	if chunks == 0 {
+		// empty files still have one (empty) chunk
+		chunks = 1
+	}
+
+	return headerSize + size + chunks*tagSize
+}
+
+// decryptedSize calculates the size of the original file
+func decryptedSize(size, chunkSize int64) (int64, error) {
+	var (
+		body   = size - headerSize
+		sealed = chunkSize + tagSize
+		full   = body / sealed
+		rem    = body % sealed
+	)
+
+	switch {
+	case body < tagSize, rem > 0 && rem < tagSize:
+		return 0, fmt.Errorf("encrypted file is corrupted")
+	case rem == 0:
+		return full * chunkSize, nil
+	default:
+		return full*chunkSize + rem - tagSize, nil
+	}
+}
+
+// encrypt writes header and sealed chunks of the source to the writer
+func encrypt(w io.Writer, src io.Reader, h header, aead cipher.AEAD) (err error) {
+	if _, err = w.Write(h.marshal()); err != nil {
+		return
+	}
+
+	var (
+		br  = bufio.NewReader(src)
+		buf = make([]byte, h.chunkSize)
+		out = make([]byte, 0, int(h.chunkSize)+tagSize)
+
+		n    int
+		last bool
+	)
+
+	for idx := uint64(0); ; idx++ {
+		n, err = io.ReadFull(br, buf)
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			last = true
+		case err != nil:
+			return
+		default:
+			// full chunk; peek if there is anything left
+			if _, err = br.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return
+			}
+		}
+
+		if _, err = w.Write(aead.Seal(out[:0], chunkNonce(idx, last), buf[:n], nil)); err != nil {
+			return
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+type (
+	// reader decrypts the file chunk by chunk as it is read
+	reader struct {
+		src       io.ReadSeekCloser
+		aead      cipher.AEAD
+		chunkSize int64
+
+		// size of the decrypted file
+		size   int64
+		offset int64
+
+		// decrypted chunk that is currently loaded
+		chunk    []byte
+		chunkIdx int64
+
+		buf []byte
+	}
+)
+
+func newReader(src io.ReadSeekCloser, h header, aead cipher.AEAD) (*reader, error) {
+	total, err := src.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reader{
+		src:       src,
+		aead:      aead,
+		chunkSize: int64(h.chunkSize),
+		chunkIdx:  -1,
+		buf:       make([]byte, int(h.chunkSize)+tagSize),
+	}
+
+	if r.size, err = decryptedSize(total, r.chunkSize); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *reader) Read(p []byte) (n int, err error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	idx := r.offset / r.chunkSize
+	if idx != r.chunkIdx {
+		if err = r.load(idx); err != nil {
+			return
+		}
+	}
+
+	n = copy(p, r.chunk[r.offset-idx*r.chunkSize:])
+	r.offset += int64(n)
+	return
+}
+
+// load reads and decrypts the chunk with the given index
+func (r *reader) load(idx int64) (err error) {
+	var (
+		last   = (r.size-1)/r.chunkSize == idx
+		sealed = r.chunkSize + tagSize
+		n      = sealed
+	)
+
+	if last {
+		n = r.size - idx*r.chunkSize + tagSize
+	}
+
+	if _, err = r.src.Seek(headerSize+idx*sealed, io.SeekStart); err != nil {
+		return
+	}
+
+	if _, err = io.ReadFull(r.src, r.buf[:n]); err != nil {
+		return
+	}
+
+	r.chunk, err = r.aead.Open(r.chunk[:0], chunkNonce(uint64(idx), last), r.buf[:n], nil)
+	if err != nil {
+		r.chunkIdx = -1
+		return fmt.Errorf("could not decrypt file: %w", err)
+	}
+
+	r.chunkIdx = idx
+	return
+}
+
+func (r *reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position")
+	}
+
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *reader) Close() error {
+	return r.src.Close()
+}
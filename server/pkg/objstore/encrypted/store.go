@@ -0,0 +1,306 @@
+package encrypted
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
+)
+
+type (
+	// Rewrapper is implemented by the encrypted store
+	Rewrapper interface {
+		// Rewrap re-wraps the data key of the file with the active master key
+		//
+		// Files that are not encrypted yet are encrypted.
+		// Returns false when the file did not need to be changed.
+		Rewrap(filename string) (bool, error)
+	}
+
+	masterKey struct {
+		id   [keyIDSize]byte
+		key  []byte
+		aead cipher.AEAD
+	}
+
+	store struct {
+		objstore.Store
+
+		// active key wraps data keys of all new files
+		active *masterKey
+		keys   map[[keyIDSize]byte]*masterKey
+
+		chunkSize uint32
+	}
+)
+
+// ParseKeys parses comma separated list of base64 encoded master keys
+func ParseKeys(s string) (kk [][]byte, err error) {
+	for _, k := range strings.Split(s, ",") {
+		if k = strings.TrimSpace(k); k == "" {
+			continue
+		}
+
+		var key []byte
+		if key, err = base64.StdEncoding.DecodeString(k); err != nil {
+			return nil, fmt.Errorf("could not decode encryption key: %w", err)
+		}
+
+		kk = append(kk, key)
+	}
+
+	return
+}
+
+// New wraps the object store and encrypts all files before they are stored
+//
+// Each file is encrypted with its own data key that is wrapped with
+// the first of the given master keys and stored along with the file.
+// Rest of the master keys are used only for unwrapping data keys of
+// the files that were not re-wrapped (see Rewrap) after the rotation.
+//
+// Files stored before the encryption was enabled are read as they are.
+func New(s objstore.Store, keys ...[]byte) (*store, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one encryption key is required")
+	}
+
+	es := &store{
+		Store:     s,
+		keys:      make(map[[keyIDSize]byte]*masterKey),
+		chunkSize: defaultChunkSize,
+	}
+
+	for i, key := range keys {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("encryption key #%d must be %d bytes long", i+1, keySize)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+
+		mk := &masterKey{key: key, aead: aead}
+		sum := sha256.Sum256(key)
+		copy(mk.id[:], sum[:])
+
+		es.keys[mk.id] = mk
+		if es.active == nil {
+			es.active = mk
+		}
+	}
+
+	return es, nil
+}
+
+func (s *store) Save(name string, f io.Reader) error {
+	return s.SaveStream(name, f, objstore.Meta{Size: -1})
+}
+
+func (s *store) SaveStream(name string, f io.Reader, meta objstore.Meta) (err error) {
+	dk, err := newDataKey()
+	if err != nil {
+		return
+	}
+
+	h, err := s.wrap(dk, s.chunkSize)
+	if err != nil {
+		return
+	}
+
+	aead, err := newAEAD(dk)
+	if err != nil {
+		return
+	}
+
+	if meta.Size >= 0 {
+		meta.Size = encryptedSize(meta.Size, int64(s.chunkSize))
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(encrypt(pw, f, h, aead))
+	}()
+
+	// unblock the encryption when the store stops reading
+	defer pr.Close()
+
+	return s.Store.SaveStream(name, pr, meta)
+}
+
+func (s *store) Stat(name string) (*objstore.Meta, error) {
+	meta, err := s.Store.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fh, err := s.Store.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fh.Close()
+
+	h, err := readHeader(fh)
+	switch {
+	case errors.Is(err, errNotEncrypted):
+		return meta, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if meta.Size, err = decryptedSize(meta.Size, int64(h.chunkSize)); err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// Open returns handle that decrypts the file as it is read
+//
+// Handle supports seeking; only the chunks that are read are decrypted.
+func (s *store) Open(name string) (io.ReadSeekCloser, error) {
+	fh, err := s.Store.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := readHeader(fh)
+	if errors.Is(err, errNotEncrypted) {
+		// stored before the encryption was enabled
+		if _, err = fh.Seek(0, io.SeekStart); err != nil {
+			fh.Close()
+			return nil, err
+		}
+
+		return fh, nil
+	}
+
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+
+	dk, err := s.unwrap(h)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+
+	aead, err := newAEAD(dk)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+
+	r, err := newReader(fh, h, aead)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Rewrap re-wraps the data key of the file with the active master key
+//
+// Contents of the file are not re-encrypted, only the header is replaced.
+// Since object stores can not modify files in place, the whole file
+// is copied to a temporary file first and then stored again.
+func (s *store) Rewrap(name string) (changed bool, err error) {
+	meta, err := s.Store.Stat(name)
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "corteza-rewrap-*")
+	if err != nil {
+		return
+	}
+
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err = s.copyTo(tmp, name); err != nil {
+		return
+	}
+
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+
+	h, err := readHeader(tmp)
+	switch {
+	case errors.Is(err, errNotEncrypted):
+		if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+			return
+		}
+
+		return true, s.SaveStream(name, tmp, *meta)
+
+	case err != nil:
+		return
+
+	case h.keyID == s.active.id:
+		return false, nil
+	}
+
+	dk, err := s.unwrap(h)
+	if err != nil {
+		return
+	}
+
+	if h, err = s.wrap(dk, h.chunkSize); err != nil {
+		return
+	}
+
+	// the rest of the file (sealed chunks) is copied as it is
+	return true, s.Store.SaveStream(name, io.MultiReader(bytes.NewReader(h.marshal()), tmp), *meta)
+}
+
+func (s *store) copyTo(w io.Writer, name string) error {
+	fh, err := s.Store.Open(name)
+	if err != nil {
+		return err
+	}
+
+	defer fh.Close()
+
+	_, err = io.Copy(w, fh)
+	return err
+}
+
+// wrap encrypts the data key with the active master key
+func (s *store) wrap(dk []byte, chunkSize uint32) (h header, err error) {
+	h = header{keyID: s.active.id, chunkSize: chunkSize}
+	if _, err = rand.Read(h.nonce[:]); err != nil {
+		return
+	}
+
+	h.wrappedKey = s.active.aead.Seal(nil, h.nonce[:], dk, h.prefix())
+	return
+}
+
+// unwrap decrypts the data key with the master key it was wrapped with
+func (s *store) unwrap(h header) ([]byte, error) {
+	mk, ok := s.keys[h.keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %x not found", h.keyID)
+	}
+
+	dk, err := mk.aead.Open(nil, h.nonce[:], h.wrappedKey, h.prefix())
+	if err != nil {
+		return nil, fmt.Errorf("could not unwrap data key: %w", err)
+	}
+
+	return dk, nil
+}
@@ -0,0 +1,185 @@
+package encrypted
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
+	"github.com/cortezaproject/corteza/server/pkg/objstore/plain"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	var (
+		key1 = bytes.Repeat([]byte{1}, keySize)
+		key2 = bytes.Repeat([]byte{2}, keySize)
+	)
+
+	setup := func(t *testing.T, keys ...[]byte) (afero.Fs, objstore.Store, *store) {
+		fs := afero.NewMemMapFs()
+		ps, err := plain.NewWithAfero(fs, "test")
+		require.NoError(t, err)
+
+		es, err := New(ps, keys...)
+		require.NoError(t, err)
+
+		// use tiny chunks so we do not need lots of test data
+		es.chunkSize = 4
+		return fs, ps, es
+	}
+
+	read := func(t *testing.T, s objstore.Store, name string) string {
+		fh, err := s.Open(name)
+		require.NoError(t, err)
+		defer fh.Close()
+
+		bb, err := io.ReadAll(fh)
+		require.NoError(t, err)
+		return string(bb)
+	}
+
+	t.Run("keys", func(t *testing.T) {
+		req := require.New(t)
+
+		kk, err := ParseKeys("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=, AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=")
+		req.NoError(err)
+		req.Equal([][]byte{key1, key2}, kk)
+
+		_, err = ParseKeys("not-base64")
+		req.Error(err)
+
+		_, err = New(nil)
+		req.Error(err)
+
+		_, err = New(nil, []byte("short"))
+		req.Error(err)
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		_, ps, es := setup(t, key1)
+
+		for _, content := range []string{"", "1", "123", "1234", "12345", "1234567890123"} {
+			req := require.New(t)
+
+			req.NoError(es.SaveStream("test/f.txt", strings.NewReader(content), objstore.Meta{Size: int64(len(content))}))
+			req.NotEqual(content, read(t, ps, "test/f.txt"))
+			req.Equal(content, read(t, es, "test/f.txt"))
+
+			raw, err := ps.Stat("test/f.txt")
+			req.NoError(err)
+			req.Equal(encryptedSize(int64(len(content)), 4), raw.Size)
+
+			meta, err := es.Stat("test/f.txt")
+			req.NoError(err)
+			req.Equal(int64(len(content)), meta.Size)
+		}
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		req := require.New(t)
+		_, _, es := setup(t, key1)
+
+		req.NoError(es.Save("test/f.txt", strings.NewReader("0123456789")))
+
+		fh, err := es.Open("test/f.txt")
+		req.NoError(err)
+		defer fh.Close()
+
+		size, err := fh.Seek(0, io.SeekEnd)
+		req.NoError(err)
+		req.Equal(int64(10), size)
+
+		_, err = fh.Seek(3, io.SeekStart)
+		req.NoError(err)
+
+		buf := make([]byte, 5)
+		_, err = io.ReadFull(fh, buf)
+		req.NoError(err)
+		req.Equal("34567", string(buf))
+
+		_, err = fh.Seek(-2, io.SeekCurrent)
+		req.NoError(err)
+		bb, err := io.ReadAll(fh)
+		req.NoError(err)
+		req.Equal("6789", string(bb))
+	})
+
+	t.Run("tampering", func(t *testing.T) {
+		req := require.New(t)
+		fs, _, es := setup(t, key1)
+
+		req.NoError(es.Save("test/f.txt", strings.NewReader("0123456789")))
+		raw, err := afero.ReadFile(fs, "test/f.txt")
+		req.NoError(err)
+
+		// flipped bit
+		mod := append([]byte{}, raw...)
+		mod[headerSize+1] ^= 1
+		req.NoError(afero.WriteFile(fs, "test/f.txt", mod, 0644))
+
+		fh, err := es.Open("test/f.txt")
+		req.NoError(err)
+		_, err = io.ReadAll(fh)
+		req.Error(err)
+
+		// truncated at the chunk boundary
+		req.NoError(afero.WriteFile(fs, "test/f.txt", raw[:headerSize+2*(4+tagSize)], 0644))
+
+		fh, err = es.Open("test/f.txt")
+		req.NoError(err)
+		_, err = io.ReadAll(fh)
+		req.Error(err)
+	})
+
+	t.Run("unencrypted files", func(t *testing.T) {
+		req := require.New(t)
+		_, ps, es := setup(t, key1)
+
+		req.NoError(ps.Save("test/f.txt", strings.NewReader("plain")))
+		req.Equal("plain", read(t, es, "test/f.txt"))
+
+		changed, err := es.Rewrap("test/f.txt")
+		req.NoError(err)
+		req.True(changed)
+		req.NotEqual("plain", read(t, ps, "test/f.txt"))
+		req.Equal("plain", read(t, es, "test/f.txt"))
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		req := require.New(t)
+		fs, ps, es := setup(t, key1)
+
+		req.NoError(es.Save("test/f.txt", strings.NewReader("0123456789")))
+		before, err := afero.ReadFile(fs, "test/f.txt")
+		req.NoError(err)
+
+		changed, err := es.Rewrap("test/f.txt")
+		req.NoError(err)
+		req.False(changed)
+
+		// new key is added as the active one
+		rotated, err := New(ps, key2, key1)
+		req.NoError(err)
+		req.Equal("0123456789", read(t, rotated, "test/f.txt"))
+
+		changed, err = rotated.Rewrap("test/f.txt")
+		req.NoError(err)
+		req.True(changed)
+
+		after, err := afero.ReadFile(fs, "test/f.txt")
+		req.NoError(err)
+		req.NotEqual(before[:headerSize], after[:headerSize])
+		req.Equal(before[headerSize:], after[headerSize:])
+
+		// old key is no longer needed
+		latest, err := New(ps, key2)
+		req.NoError(err)
+		req.Equal("0123456789", read(t, latest, "test/f.txt"))
+
+		_, err = es.Open("test/f.txt")
+		req.Error(err)
+	})
+}
@@ -2,25 +2,66 @@ package objstore
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"time"
 )
 
-type Store interface {
-	// Original returns URL to the original file
-	Original(id uint64, ext string) string
+type (
+	Store interface {
+		// Original returns URL to the original file
+		Original(id uint64, ext string) string
 
-	// Preview returns URL to the preview (of the original) file
-	Preview(id uint64, ext string) string
+		// Preview returns URL to the preview (of the original) file
+		Preview(id uint64, ext string) string
 
-	// Save stores the file
-	Save(filename string, f io.Reader) error
+		// Save stores the file
+		Save(filename string, f io.Reader) error
 
-	// Remove deletes the file
-	Remove(filename string) error
+		// SaveStream stores the file along with its metadata
+		//
+		// Size of the file can be -1 when not known upfront;
+		// stores that support it upload the file in parts
+		// without buffering all of it.
+		SaveStream(filename string, f io.Reader, meta Meta) error
 
-	// Open returns file handle
-	Open(filename string) (io.ReadSeekCloser, error)
+		// Stat returns metadata of the stored file
+		Stat(filename string) (*Meta, error)
 
-	// Healthcheck checks health status of the store
-	Healthcheck(ctx context.Context) error
-}
+		// Remove deletes the file
+		Remove(filename string) error
+
+		// Open returns file handle
+		Open(filename string) (io.ReadSeekCloser, error)
+
+		// Healthcheck checks health status of the store
+		Healthcheck(ctx context.Context) error
+	}
+
+	// Presigner is implemented by stores that can generate
+	// time-limited URLs for downloading files directly from the storage
+	Presigner interface {
+		// Presign returns presigned URL to the file
+		//
+		// ErrPresignNotSupported is returned when the store is not configured for it
+		Presign(filename string, opt PresignOptions) (string, error)
+	}
+
+	Meta struct {
+		Size        int64
+		ContentType string
+		ModTime     time.Time
+	}
+
+	PresignOptions struct {
+		// Name of the file used for the content disposition of the response
+		Name string
+
+		// Download forces the content disposition of the response to attachment
+		Download bool
+	}
+)
+
+var (
+	ErrPresignNotSupported = fmt.Errorf("presigned URLs not supported")
+)
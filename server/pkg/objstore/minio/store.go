@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
+	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
 	minio "github.com/minio/minio-go/v6"
 	"github.com/minio/minio-go/v6/pkg/encrypt"
 	"github.com/minio/minio-go/v6/pkg/s3utils"
@@ -21,6 +24,11 @@ type (
 		SecretAccessKey string
 
 		ServerSideEncryptKey []byte
+
+		// PresignTTL enables presigned URLs when set
+		//
+		// Presigned URLs are not supported when server-side encryption is used
+		PresignTTL time.Duration
 	}
 
 	minioClient interface {
@@ -29,6 +37,8 @@ type (
 		PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 		RemoveObject(bucketName, objectName string) error
 		GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (*minio.Object, error)
+		StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+		PresignedGetObject(bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
 	}
 
 	store struct {
@@ -39,6 +49,8 @@ type (
 		mc  minioClient
 		sse encrypt.ServerSide
 
+		presignTTL time.Duration
+
 		originalFn func(id uint64, ext string) string
 		previewFn  func(id uint64, ext string) string
 	}
@@ -69,6 +81,8 @@ func newWithClient(mc minioClient, bucket, pathPrefix, component string, opt Opt
 		component:  component,
 		mc:         mc,
 
+		presignTTL: opt.PresignTTL,
+
 		originalFn: defOriginalFn,
 		previewFn:  defPreviewFn,
 	}
@@ -109,12 +123,10 @@ func (s *store) check(name string) error {
 }
 
 func (s store) Original(id uint64, ext string) string {
-	// @todo presigned URL
 	return s.originalFn(id, ext)
 }
 
 func (s store) Preview(id uint64, ext string) string {
-	// @todo presigned URL
 	return s.previewFn(id, ext)
 
 }
@@ -127,6 +139,49 @@ func (s store) Save(name string, f io.Reader) (err error) {
 	return err
 }
 
+func (s store) SaveStream(name string, f io.Reader, meta objstore.Meta) (err error) {
+	// minio client takes care of the multipart uploads
+	// when the size is unknown or too large for a single request
+	_, err = s.mc.PutObject(s.bucket, s.getObjectName(name), f, meta.Size, minio.PutObjectOptions{
+		ContentType:          meta.ContentType,
+		ServerSideEncryption: s.sse,
+	})
+
+	return err
+}
+
+func (s store) Stat(name string) (*objstore.Meta, error) {
+	info, err := s.mc.StatObject(s.bucket, s.getObjectName(name), minio.StatObjectOptions{
+		GetObjectOptions: minio.GetObjectOptions{ServerSideEncryption: s.sse},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &objstore.Meta{
+		Size:        info.Size,
+		ContentType: info.ContentType,
+		ModTime:     info.LastModified,
+	}, nil
+}
+
+func (s store) Presign(name string, opt objstore.PresignOptions) (string, error) {
+	if s.presignTTL == 0 || s.sse != nil {
+		// SSE-C keys would have to be sent along with the request
+		return "", objstore.ErrPresignNotSupported
+	}
+
+	params := url.Values{}
+	params.Set("response-content-disposition", objstore.ContentDisposition(opt.Name, opt.Download))
+
+	u, err := s.mc.PresignedGetObject(s.bucket, s.getObjectName(name), s.presignTTL, params)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
 func (s store) Remove(name string) error {
 	return s.mc.RemoveObject(s.bucket, s.getObjectName(name))
 }
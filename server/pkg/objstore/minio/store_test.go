@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/minio/minio-go/v6"
 	"github.com/minio/minio-go/v6/pkg/s3utils"
@@ -35,6 +37,14 @@ func (t testMinio) GetObject(bucketName, objectName string, opts minio.GetObject
 	return
 }
 
+func (t testMinio) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (out minio.ObjectInfo, err error) {
+	return
+}
+
+func (t testMinio) PresignedGetObject(bucketName, objectName string, expires time.Duration, reqParams url.Values) (out *url.URL, err error) {
+	return
+}
+
 func TestBucketName(t *testing.T) {
 	type (
 		tf struct {
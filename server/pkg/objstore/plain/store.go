@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"mime"
 	"path"
 	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
 	"github.com/spf13/afero"
 )
 
@@ -81,6 +83,31 @@ func (s *store) Save(filename string, contents io.Reader) (err error) {
 	return afero.WriteReader(s.fs, filename, contents)
 }
 
+// SaveStream stores the file
+//
+// Metadata is not stored; content type is resolved from the file extension
+func (s *store) SaveStream(filename string, contents io.Reader, _ objstore.Meta) (err error) {
+	return s.Save(filename, contents)
+}
+
+func (s *store) Stat(filename string) (*objstore.Meta, error) {
+	// check filename for validity
+	if err := s.check(filename); err != nil {
+		return nil, err
+	}
+
+	info, err := s.fs.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objstore.Meta{
+		Size:        info.Size(),
+		ContentType: mime.TypeByExtension(path.Ext(filename)),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
 func (s *store) Remove(filename string) error {
 	// check filename for validity
 	if err := s.check(filename); err != nil {
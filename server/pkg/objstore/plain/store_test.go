@@ -56,6 +56,17 @@ func TestStore(t *testing.T) {
 		require.True(t, err != nil, "Expected error when opening file outside of namespace")
 	}
 
+	// stat a file
+	{
+		meta, err := store.Stat("test/123.jpg")
+		require.True(t, err == nil, "Unexpected error when stating file: %+v", err)
+		require.True(t, meta.Size == 24, "Unexpected file size: %d", meta.Size)
+		require.True(t, meta.ContentType == "image/jpeg", "Unexpected content type: %s", meta.ContentType)
+
+		_, err = store.Stat("test/1234.jpg")
+		require.True(t, err != nil, "Expected error when stating non-existent file")
+	}
+
 	// delete a file
 	{
 		err := store.Remove("test/123.jpg")
@@ -0,0 +1,279 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/credentials"
+	"github.com/minio/minio-go/v6/pkg/s3utils"
+)
+
+type (
+	Options struct {
+		Endpoint  string
+		Region    string
+		Secure    bool
+		Strict    bool
+		PathStyle bool
+
+		AccessKeyID     string
+		SecretAccessKey string
+
+		// PartSize (in bytes) of the multipart uploads
+		PartSize int64
+
+		// PresignTTL enables presigned URLs when set
+		PresignTTL time.Duration
+	}
+
+	store struct {
+		bucket     string
+		pathPrefix string
+		component  string
+
+		core *minio.Core
+
+		partSize   int64
+		presignTTL time.Duration
+
+		originalFn func(id uint64, ext string) string
+		previewFn  func(id uint64, ext string) string
+	}
+)
+
+const (
+	// S3 does not accept parts smaller than 5MiB (except the last one)
+	minPartSize = 5 << 20
+
+	defaultRegion = "us-east-1"
+)
+
+var (
+	defPreviewFn = func(id uint64, ext string) string {
+		return fmt.Sprintf("%d_preview.%s", id, ext)
+	}
+
+	defOriginalFn = func(id uint64, ext string) string {
+		return fmt.Sprintf("%d.%s", id, ext)
+	}
+)
+
+// New initializes S3-compatible object store
+//
+// Files are uploaded with multipart uploads when they are larger than
+// the configured part size or when their size is not known upfront.
+func New(bucket, pathPrefix, component string, opt Options) (s *store, err error) {
+	if opt.Region == "" {
+		opt.Region = defaultRegion
+	}
+
+	lookup := minio.BucketLookupAuto
+	if opt.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+
+	client, err := minio.NewWithOptions(opt.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(opt.AccessKeyID, opt.SecretAccessKey, ""),
+		Secure:       opt.Secure,
+		Region:       opt.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s = &store{
+		bucket:     bucket,
+		pathPrefix: pathPrefix,
+		component:  component,
+		core:       &minio.Core{Client: client},
+
+		partSize:   opt.PartSize,
+		presignTTL: opt.PresignTTL,
+
+		originalFn: defOriginalFn,
+		previewFn:  defPreviewFn,
+	}
+
+	if s.partSize < minPartSize {
+		s.partSize = minPartSize
+	}
+
+	if err = s3utils.CheckValidBucketName(s.bucket); err != nil {
+		return nil, err
+	}
+
+	if e, err := s.core.BucketExists(s.bucket); err != nil {
+		return nil, err
+	} else if !e {
+		if opt.Strict {
+			return nil, fmt.Errorf("bucket %q does not exist", s.bucket)
+		}
+
+		if err = s.core.MakeBucket(s.bucket, opt.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+func (s *store) check(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("Invalid name when trying to store object: '%s' (for %s)", name, s.bucket)
+	}
+
+	return nil
+}
+
+func (s store) Original(id uint64, ext string) string {
+	return s.originalFn(id, ext)
+}
+
+func (s store) Preview(id uint64, ext string) string {
+	return s.previewFn(id, ext)
+}
+
+func (s store) Save(name string, f io.Reader) error {
+	return s.SaveStream(name, f, objstore.Meta{Size: -1})
+}
+
+func (s store) SaveStream(name string, f io.Reader, meta objstore.Meta) (err error) {
+	if err = s.check(name); err != nil {
+		return
+	}
+
+	var (
+		object = s.getObjectName(name)
+		opts   = minio.PutObjectOptions{ContentType: meta.ContentType}
+	)
+
+	if meta.Size >= 0 && meta.Size <= s.partSize {
+		// no need to complicate things for small files
+		_, err = s.core.PutObject(s.bucket, object, f, meta.Size, "", "", opts)
+		return
+	}
+
+	return s.multipartUpload(object, f, opts)
+}
+
+// multipartUpload streams the contents to the storage part by part
+//
+// Only one part is held in memory at a time. Upload is aborted on failure
+// so that no orphaned parts are left behind.
+func (s store) multipartUpload(object string, f io.Reader, opts minio.PutObjectOptions) (err error) {
+	uploadID, err := s.core.NewMultipartUpload(s.bucket, object, opts)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			_ = s.core.AbortMultipartUpload(s.bucket, object, uploadID)
+		}
+	}()
+
+	var (
+		buf   = make([]byte, s.partSize)
+		parts []minio.CompletePart
+
+		n    int
+		rErr error
+		part minio.ObjectPart
+	)
+
+	for partID := 1; ; partID++ {
+		n, rErr = io.ReadFull(f, buf)
+		if rErr == io.EOF && partID > 1 {
+			break
+		}
+
+		if rErr != nil && rErr != io.EOF && rErr != io.ErrUnexpectedEOF {
+			return rErr
+		}
+
+		part, err = s.core.PutObjectPart(s.bucket, object, uploadID, partID, bytes.NewReader(buf[:n]), int64(n), "", "", nil)
+		if err != nil {
+			return
+		}
+
+		parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+
+		if rErr != nil {
+			// short read; that was the last part
+			break
+		}
+	}
+
+	_, err = s.core.CompleteMultipartUpload(s.bucket, object, uploadID, parts)
+	return
+}
+
+func (s store) Stat(name string) (*objstore.Meta, error) {
+	info, err := s.core.StatObject(s.bucket, s.getObjectName(name), minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &objstore.Meta{
+		Size:        info.Size,
+		ContentType: info.ContentType,
+		ModTime:     info.LastModified,
+	}, nil
+}
+
+func (s store) Remove(name string) error {
+	return s.core.RemoveObject(s.bucket, s.getObjectName(name))
+}
+
+func (s store) Open(name string) (io.ReadSeekCloser, error) {
+	return s.core.Client.GetObject(s.bucket, s.getObjectName(name), minio.GetObjectOptions{})
+}
+
+func (s store) Presign(name string, opt objstore.PresignOptions) (string, error) {
+	if s.presignTTL == 0 {
+		return "", objstore.ErrPresignNotSupported
+	}
+
+	params := url.Values{}
+	params.Set("response-content-disposition", objstore.ContentDisposition(opt.Name, opt.Download))
+
+	u, err := s.core.PresignedGetObject(s.bucket, s.getObjectName(name), s.presignTTL, params)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (s *store) Healthcheck(_ context.Context) error {
+	if s == nil {
+		return fmt.Errorf("uninitialized")
+	}
+
+	if e, err := s.core.BucketExists(s.bucket); err != nil {
+		return err
+	} else if !e {
+		return fmt.Errorf("bucket %q does not exist", s.bucket)
+	}
+
+	return nil
+}
+
+// getObjectName prefix path to object name
+func (s *store) getObjectName(name string) (out string) {
+	path := strings.Replace(s.pathPrefix, "{component}", s.component, 1)
+	return fmt.Sprintf("%s%s", path, name)
+}
+
+// GetBucket return bucket name based on storage option bucket, separator or bucketName
+func GetBucket(bucket, component string) string {
+	return strings.Replace(bucket, "{component}", component, 1)
+}
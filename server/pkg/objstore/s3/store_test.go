@@ -0,0 +1,281 @@
+package s3
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/objstore"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// s3StandIn is a minimal in-memory S3 API (path-style addressing)
+	// covering the calls the store makes
+	s3StandIn struct {
+		l sync.Mutex
+
+		buckets map[string]bool
+		objects map[string]*standInObject
+		uploads map[string]map[int][]byte
+
+		// counters
+		putObject int
+		putPart   int
+		aborted   int
+	}
+
+	standInObject struct {
+		data        []byte
+		contentType string
+		modTime     time.Time
+	}
+)
+
+func TestStore(t *testing.T) {
+	var (
+		req = require.New(t)
+		ss  = newS3StandIn()
+		srv = httptest.NewServer(ss)
+	)
+	defer srv.Close()
+
+	s, err := New("test-bucket", "{component}/", "compose", Options{
+		Endpoint:  strings.TrimPrefix(srv.URL, "http://"),
+		PathStyle: true,
+
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	req.NoError(err)
+	req.True(ss.buckets["test-bucket"])
+	req.NoError(s.Healthcheck(nil))
+
+	// use tiny parts so we do not need megabytes of test data
+	s.partSize = 4
+
+	t.Run("single request", func(t *testing.T) {
+		req := require.New(t)
+
+		req.NoError(s.SaveStream("1.txt", strings.NewReader("foo"), objstore.Meta{Size: 3, ContentType: "text/plain"}))
+		req.Equal(1, ss.putObject)
+		req.Equal("foo", string(ss.objects["test-bucket/compose/1.txt"].data))
+
+		meta, err := s.Stat("1.txt")
+		req.NoError(err)
+		req.Equal(int64(3), meta.Size)
+		req.Equal("text/plain", meta.ContentType)
+
+		fh, err := s.Open("1.txt")
+		req.NoError(err)
+		defer fh.Close()
+
+		bb, err := io.ReadAll(fh)
+		req.NoError(err)
+		req.Equal("foo", string(bb))
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		req := require.New(t)
+
+		req.NoError(s.Save("2.txt", strings.NewReader("0123456789")))
+		req.Equal(3, ss.putPart)
+		req.Equal("0123456789", string(ss.objects["test-bucket/compose/2.txt"].data))
+		req.Empty(ss.uploads)
+
+		req.NoError(s.Remove("2.txt"))
+		_, err := s.Stat("2.txt")
+		req.Error(err)
+	})
+
+	t.Run("multipart abort", func(t *testing.T) {
+		req := require.New(t)
+
+		err := s.Save("3.txt", io.MultiReader(strings.NewReader("012345"), &failingReader{}))
+		req.Error(err)
+		req.Equal(1, ss.aborted)
+		req.Empty(ss.uploads)
+		req.Nil(ss.objects["test-bucket/compose/3.txt"])
+	})
+
+	t.Run("presign", func(t *testing.T) {
+		req := require.New(t)
+
+		_, err := s.Presign("1.txt", objstore.PresignOptions{Name: "foo.txt"})
+		req.ErrorIs(err, objstore.ErrPresignNotSupported)
+
+		s.presignTTL = time.Minute
+		defer func() { s.presignTTL = 0 }()
+
+		u, err := s.Presign("1.txt", objstore.PresignOptions{Name: "foo.txt", Download: true})
+		req.NoError(err)
+
+		pu, err := url.Parse(u)
+		req.NoError(err)
+		req.Equal("/test-bucket/compose/1.txt", pu.Path)
+		req.Equal("60", pu.Query().Get("X-Amz-Expires"))
+		req.NotEmpty(pu.Query().Get("X-Amz-Signature"))
+		req.Equal("attachment; filename=foo.txt", pu.Query().Get("response-content-disposition"))
+
+		rsp, err := http.Get(u)
+		req.NoError(err)
+		defer rsp.Body.Close()
+
+		bb, err := io.ReadAll(rsp.Body)
+		req.NoError(err)
+		req.Equal("foo", string(bb))
+		req.Equal("attachment; filename=foo.txt", rsp.Header.Get("Content-Disposition"))
+	})
+}
+
+type (
+	failingReader struct{}
+)
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("read failed")
+}
+
+func newS3StandIn() *s3StandIn {
+	return &s3StandIn{
+		buckets: make(map[string]bool),
+		objects: make(map[string]*standInObject),
+		uploads: make(map[string]map[int][]byte),
+	}
+}
+
+func (ss *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ss.l.Lock()
+	defer ss.l.Unlock()
+
+	var (
+		q          = r.URL.Query()
+		path       = strings.Trim(r.URL.Path, "/")
+		bucket     = strings.SplitN(path, "/", 2)[0]
+		isBucket   = !strings.Contains(path, "/")
+		uploadID   = q.Get("uploadId")
+		body       = readStandInBody(r)
+		writeXML   = func(v interface{}) { _ = xml.NewEncoder(w).Encode(v) }
+		notFound   = func() { w.WriteHeader(http.StatusNotFound) }
+		objectETag = func(data []byte) string { return fmt.Sprintf(`"%x"`, len(data)) }
+	)
+
+	switch {
+	case isBucket && r.Method == http.MethodHead:
+		if !ss.buckets[bucket] {
+			notFound()
+		}
+
+	case isBucket && r.Method == http.MethodPut:
+		ss.buckets[bucket] = true
+
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		uploadID = strconv.Itoa(len(ss.uploads) + ss.aborted + ss.putPart + 1)
+		ss.uploads[uploadID] = make(map[int][]byte)
+		writeXML(struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: path, UploadId: uploadID})
+
+	case r.Method == http.MethodPut && uploadID != "":
+		partID, _ := strconv.Atoi(q.Get("partNumber"))
+		ss.uploads[uploadID][partID] = body
+		ss.putPart++
+		w.Header().Set("ETag", objectETag(body))
+
+	case r.Method == http.MethodPost && uploadID != "":
+		var (
+			parts = ss.uploads[uploadID]
+			ids   = make([]int, 0, len(parts))
+			data  []byte
+		)
+		for id := range parts {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			data = append(data, parts[id]...)
+		}
+
+		delete(ss.uploads, uploadID)
+		ss.objects[path] = &standInObject{data: data, modTime: time.Now()}
+		writeXML(struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: path, ETag: objectETag(data)})
+
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(ss.uploads, uploadID)
+		ss.aborted++
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		ss.putObject++
+		ss.objects[path] = &standInObject{data: body, contentType: r.Header.Get("Content-Type"), modTime: time.Now()}
+		w.Header().Set("ETag", objectETag(body))
+
+	case r.Method == http.MethodDelete:
+		delete(ss.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		obj := ss.objects[path]
+		if obj == nil {
+			notFound()
+			return
+		}
+
+		if cd := q.Get("response-content-disposition"); cd != "" {
+			w.Header().Set("Content-Disposition", cd)
+		}
+		w.Header().Set("ETag", objectETag(obj.data))
+		w.Header().Set("Content-Type", obj.contentType)
+		http.ServeContent(w, r, path, obj.modTime, bytes.NewReader(obj.data))
+
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readStandInBody reads the request body, decoding the signed chunks
+// when the payload is streamed
+func readStandInBody(r *http.Request) []byte {
+	body, _ := io.ReadAll(r.Body)
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return body
+	}
+
+	var (
+		out  []byte
+		rest = body
+	)
+
+	for {
+		head := bytes.SplitN(rest, []byte("\r\n"), 2)
+		if len(head) < 2 {
+			return out
+		}
+
+		size, err := strconv.ParseInt(string(bytes.SplitN(head[0], []byte(";"), 2)[0]), 16, 64)
+		if err != nil || size == 0 {
+			return out
+		}
+
+		out = append(out, head[1][:size]...)
+		rest = head[1][size+2:]
+	}
+}
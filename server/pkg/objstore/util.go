@@ -51,3 +51,13 @@ func FromAny(file *multipart.FileHeader, url string) (io.ReadCloser, error) {
 	}
 	return FromURL(url)
 }
+
+// ContentDisposition returns the value of the content disposition header
+// for serving the file with the given name
+func ContentDisposition(name string, download bool) string {
+	if download {
+		return "attachment; filename=" + url.QueryEscape(name)
+	}
+
+	return "inline; filename=" + url.QueryEscape(name)
+}
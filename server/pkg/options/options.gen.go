@@ -184,8 +184,9 @@ type (
 	}
 
 	MessagebusOpt struct {
-		Enabled    bool `env:"MESSAGEBUS_ENABLED"`
-		LogEnabled bool `env:"MESSAGEBUS_LOG_ENABLED"`
+		Enabled    bool   `env:"MESSAGEBUS_ENABLED"`
+		LogEnabled bool   `env:"MESSAGEBUS_LOG_ENABLED"`
+		RedisURL   string `env:"MESSAGEBUS_REDIS_URL"`
 	}
 
 	MonitorOpt struct {
@@ -193,15 +194,27 @@ type (
 	}
 
 	ObjectStoreOpt struct {
-		Path            string `env:"STORAGE_PATH"`
-		MinioEndpoint   string `env:"MINIO_ENDPOINT"`
-		MinioSecure     bool   `env:"MINIO_SECURE"`
-		MinioAccessKey  string `env:"MINIO_ACCESS_KEY"`
-		MinioSecretKey  string `env:"MINIO_SECRET_KEY"`
-		MinioSSECKey    string `env:"MINIO_SSEC_KEY"`
-		MinioBucket     string `env:"MINIO_BUCKET"`
-		MinioPathPrefix string `env:"MINIO_PATH_PREFIX"`
-		MinioStrict     bool   `env:"MINIO_STRICT"`
+		Path            string        `env:"STORAGE_PATH"`
+		MinioEndpoint   string        `env:"MINIO_ENDPOINT"`
+		MinioSecure     bool          `env:"MINIO_SECURE"`
+		MinioAccessKey  string        `env:"MINIO_ACCESS_KEY"`
+		MinioSecretKey  string        `env:"MINIO_SECRET_KEY"`
+		MinioSSECKey    string        `env:"MINIO_SSEC_KEY"`
+		MinioBucket     string        `env:"MINIO_BUCKET"`
+		MinioPathPrefix string        `env:"MINIO_PATH_PREFIX"`
+		MinioStrict     bool          `env:"MINIO_STRICT"`
+		S3Endpoint      string        `env:"S3_ENDPOINT"`
+		S3Region        string        `env:"S3_REGION"`
+		S3Secure        bool          `env:"S3_SECURE"`
+		S3AccessKey     string        `env:"S3_ACCESS_KEY"`
+		S3SecretKey     string        `env:"S3_SECRET_KEY"`
+		S3Bucket        string        `env:"S3_BUCKET"`
+		S3PathPrefix    string        `env:"S3_PATH_PREFIX"`
+		S3PathStyle     bool          `env:"S3_PATH_STYLE"`
+		S3PartSize      int           `env:"S3_PART_SIZE"`
+		S3Strict        bool          `env:"S3_STRICT"`
+		PresignTtl      time.Duration `env:"STORAGE_PRESIGN_TTL"`
+		EncryptionKeys  string        `env:"STORAGE_ENCRYPTION_KEYS"`
 	}
 
 	ProvisionOpt struct {
@@ -227,6 +240,16 @@ type (
 		RendererGotenbergEnabled bool   `env:"TEMPLATE_RENDERER_GOTENBERG_ENABLED"`
 	}
 
+	TracingOpt struct {
+		Enabled      bool    `env:"TRACING_ENABLED"`
+		Exporter     string  `env:"TRACING_EXPORTER"`
+		OtlpEndpoint string  `env:"TRACING_OTLP_ENDPOINT"`
+		OtlpProtocol string  `env:"TRACING_OTLP_PROTOCOL"`
+		OtlpInsecure bool    `env:"TRACING_OTLP_INSECURE"`
+		ServiceName  string  `env:"TRACING_SERVICE_NAME"`
+		SampleRatio  float64 `env:"TRACING_SAMPLE_RATIO"`
+	}
+
 	UpgradeOpt struct {
 		Debug  bool `env:"UPGRADE_DEBUG"`
 		Always bool `env:"UPGRADE_ALWAYS"`
@@ -241,6 +264,15 @@ type (
 		ServicesProbeInterval time.Duration `env:"WAIT_FOR_SERVICES_PROBE_INTERVAL"`
 	}
 
+	WebhookOpt struct {
+		Enabled         bool          `env:"WEBHOOK_ENABLED"`
+		Timeout         time.Duration `env:"WEBHOOK_TIMEOUT"`
+		MaxAttempts     int           `env:"WEBHOOK_MAX_ATTEMPTS"`
+		RetryBackoff    time.Duration `env:"WEBHOOK_RETRY_BACKOFF"`
+		RetryBackoffMax time.Duration `env:"WEBHOOK_RETRY_BACKOFF_MAX"`
+		RetryInterval   time.Duration `env:"WEBHOOK_RETRY_INTERVAL"`
+	}
+
 	WebsocketOpt struct {
 		LogEnabled  bool          `env:"WEBSOCKET_LOG_ENABLED"`
 		Timeout     time.Duration `env:"WEBSOCKET_TIMEOUT"`
@@ -829,6 +861,10 @@ func ObjectStore() (o *ObjectStoreOpt) {
 		Path:        "var/store",
 		MinioSecure: true,
 		MinioBucket: "{component}",
+		S3Region:    "us-east-1",
+		S3Secure:    true,
+		S3Bucket:    "{component}",
+		S3PartSize:  16,
 	}
 
 	// Custom defaults
@@ -932,6 +968,37 @@ func Template() (o *TemplateOpt) {
 	return
 }
 
+// Tracing initializes and returns a TracingOpt with default values
+//
+// This function is auto-generated
+func Tracing() (o *TracingOpt) {
+	o = &TracingOpt{
+		Exporter:     "otlp",
+		OtlpEndpoint: "localhost:4317",
+		OtlpProtocol: "grpc",
+		ServiceName:  "corteza-server",
+		SampleRatio:  1,
+	}
+
+	// Custom defaults
+	func(o interface{}) {
+		if def, ok := o.(interface{ Defaults() }); ok {
+			def.Defaults()
+		}
+	}(o)
+
+	fill(o)
+
+	// Custom cleanup
+	func(o interface{}) {
+		if def, ok := o.(interface{ Cleanup() }); ok {
+			def.Cleanup()
+		}
+	}(o)
+
+	return
+}
+
 // Upgrade initializes and returns a UpgradeOpt with default values
 //
 // This function is auto-generated
@@ -989,6 +1056,38 @@ func WaitFor() (o *WaitForOpt) {
 	return
 }
 
+// Webhook initializes and returns a WebhookOpt with default values
+//
+// This function is auto-generated
+func Webhook() (o *WebhookOpt) {
+	o = &WebhookOpt{
+		Enabled:         true,
+		Timeout:         time.Second * 10,
+		MaxAttempts:     8,
+		RetryBackoff:    time.Second * 30,
+		RetryBackoffMax: time.Hour * 6,
+		RetryInterval:   time.Second * 15,
+	}
+
+	// Custom defaults
+	func(o interface{}) {
+		if def, ok := o.(interface{ Defaults() }); ok {
+			def.Defaults()
+		}
+	}(o)
+
+	fill(o)
+
+	// Custom cleanup
+	func(o interface{}) {
+		if def, ok := o.(interface{ Cleanup() }); ok {
+			def.Cleanup()
+		}
+	}(o)
+
+	return
+}
+
 // Websocket initializes and returns a WebsocketOpt with default values
 //
 // This function is auto-generated
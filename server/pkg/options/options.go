@@ -12,12 +12,14 @@ type (
 		Upgrade     UpgradeOpt
 		Provision   ProvisionOpt
 		Sentry      SentryOpt
+		Tracing     TracingOpt
 		ObjStore    ObjectStoreOpt
 		Corredor    CorredorOpt
 		Monitor     MonitorOpt
 		WaitFor     WaitForOpt
 		HTTPServer  HttpServerOpt
 		Websocket   WebsocketOpt
+		Webhook     WebhookOpt
 		Eventbus    EventbusOpt
 		Messagebus  MessagebusOpt
 		Federation  FederationOpt
@@ -45,12 +47,14 @@ func Init() *Options {
 		Upgrade:     *Upgrade(),
 		Provision:   *Provision(),
 		Sentry:      *Sentry(),
+		Tracing:     *Tracing(),
 		ObjStore:    *ObjectStore(),
 		Corredor:    *Corredor(),
 		Monitor:     *Monitor(),
 		WaitFor:     *WaitFor(),
 		HTTPServer:  *HttpServer(),
 		Websocket:   *Websocket(),
+		Webhook:     *Webhook(),
 		Eventbus:    *Eventbus(),
 		Messagebus:  *Messagebus(),
 		Federation:  *Federation(),
@@ -31,6 +31,7 @@ func TestScanner_ScanSimple(t *testing.T) {
 		{s: `'escaped \' quote'`, tok: LSTRING, lit: "escaped ' quote"},
 		{s: `'double \\ escape'`, tok: LSTRING, lit: "double \\ escape"},
 		{s: `12345`, tok: LNUMBER, lit: "12345"},
+		{s: `123.45`, tok: LNUMBER, lit: "123.45"},
 
 		// Identifiers
 		{s: `foo`, tok: IDENT, lit: `foo`},
@@ -71,6 +72,10 @@ func TestScanner_ScanComplex(t *testing.T) {
 			[]tokenCode{IDENT, WS, OPERATOR, WS, LSTRING}},
 		{`foo NOT LIKE 'abc%'`,
 			[]tokenCode{IDENT, WS, OPERATOR, WS, OPERATOR, WS, LSTRING}},
+		{`foo ILIKE 'abc%'`,
+			[]tokenCode{IDENT, WS, OPERATOR, WS, LSTRING}},
+		{`foo NOT REGEX '^abc'`,
+			[]tokenCode{IDENT, WS, OPERATOR, WS, OPERATOR, WS, LSTRING}},
 		{`foo DESC`,
 			[]tokenCode{IDENT, WS, KEYWORD}},
 		{`year(now())-1`,
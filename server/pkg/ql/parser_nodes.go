@@ -93,8 +93,12 @@ var (
 		`!`: {name: `not`, weight: 0},
 
 		// str comp.
-		`LIKE`:     {name: `like`, weight: 40},
-		`NOT LIKE`: {name: `nlike`, weight: 40},
+		`LIKE`:      {name: `like`, weight: 40},
+		`NOT LIKE`:  {name: `nlike`, weight: 40},
+		`ILIKE`:     {name: `ilike`, weight: 40},
+		`NOT ILIKE`: {name: `nilike`, weight: 40},
+		`REGEX`:     {name: `regex`, weight: 40},
+		`NOT REGEX`: {name: `nregex`, weight: 40},
 
 		// range comp.
 		`BETWEEN`:     {name: `between`, weight: 40},
@@ -89,7 +89,7 @@ func (TokenConsumerIdent) Consume(s RuneReader) Token {
 		return Token{code: LNULL}
 	case "TRUE", "FALSE":
 		return Token{code: LBOOL, literal: lit}
-	case "IS", "LIKE", "NOT", "AND", "OR", "XOR", "IN", "BETWEEN":
+	case "IS", "LIKE", "ILIKE", "REGEX", "NOT", "AND", "OR", "XOR", "IN", "BETWEEN":
 		return Token{code: OPERATOR, literal: lit}
 	case "DESC", "ASC", "INTERVAL":
 		return Token{code: KEYWORD, literal: lit}
@@ -137,9 +137,15 @@ func (str TokenConsumerNumber) Consume(s RuneReader) Token {
 	var buf bytes.Buffer
 	buf.WriteRune(s.read())
 
+	// numbers may contain one decimal point
+	var decimal bool
+
 	for {
 		if ch := s.read(); ch == eof {
 			break
+		} else if ch == '.' && !decimal {
+			decimal = true
+			_, _ = buf.WriteRune(ch)
 		} else if !isDigit(ch) {
 			s.unread()
 			break
@@ -1,5 +1,9 @@
 package rbac
 
+import (
+	"strings"
+)
+
 // General permission stuff, types, constants
 
 type (
@@ -32,7 +36,8 @@ func (a Access) String() string {
 }
 
 func (a *Access) UnmarshalJSON(data []byte) error {
-	switch string(data) {
+	// value can be passed with or without quotes
+	switch strings.Trim(string(data), `"`) {
 	case "allow":
 		*a = Allow
 	case "deny":
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cortezaproject/corteza/server/pkg/cast2"
 )
@@ -15,6 +16,13 @@ type (
 		Operation string `json:"operation"`
 		Access    Access `json:"access,string"`
 
+		// Optional validity window; rule is only considered
+		// when checked between NotBefore and NotAfter
+		//
+		// Recurring windows (e.g. business hours) are not supported yet
+		NotBefore *time.Time `json:"notBefore,omitempty"`
+		NotAfter  *time.Time `json:"notAfter,omitempty"`
+
 		// Do we need to flush it to storage?
 		dirty bool
 	}
@@ -23,7 +31,40 @@ type (
 )
 
 func (r Rule) String() string {
-	return fmt.Sprintf("%s %d to %s on %s", r.Access, r.RoleID, r.Operation, r.Resource)
+	out := fmt.Sprintf("%s %d to %s on %s", r.Access, r.RoleID, r.Operation, r.Resource)
+
+	if r.NotBefore != nil {
+		out += " from " + r.NotBefore.Format(time.RFC3339)
+	}
+
+	if r.NotAfter != nil {
+		out += " until " + r.NotAfter.Format(time.RFC3339)
+	}
+
+	return out
+}
+
+// ValidAt checks if the given time is inside the rule's validity window
+func (r Rule) ValidAt(t time.Time) bool {
+	if r.NotBefore != nil && t.Before(*r.NotBefore) {
+		return false
+	}
+
+	if r.NotAfter != nil && !t.Before(*r.NotAfter) {
+		return false
+	}
+
+	return true
+}
+
+// Expired checks if the rule's validity window ended before the given time
+func (r Rule) Expired(t time.Time) bool {
+	return r.NotAfter != nil && !t.Before(*r.NotAfter)
+}
+
+// Timed returns true if the rule has a validity window
+func (r Rule) Timed() bool {
+	return r.NotBefore != nil || r.NotAfter != nil
 }
 
 func (set RuleSet) Len() int      { return len(set) }
@@ -81,17 +122,17 @@ func (set RuleSet) FilterResource(rr ...Resource) (out RuleSet) {
 
 // AllowRule helper func to create allow rule
 func AllowRule(id uint64, r, o string) *Rule {
-	return &Rule{id, r, o, Allow, false}
+	return &Rule{RoleID: id, Resource: r, Operation: o, Access: Allow}
 }
 
 // DenyRule helper func to create deny rule
 func DenyRule(id uint64, r, o string) *Rule {
-	return &Rule{id, r, o, Deny, false}
+	return &Rule{RoleID: id, Resource: r, Operation: o, Access: Deny}
 }
 
 // InheritRule helper func to create inherit rule
 func InheritRule(id uint64, r, o string) *Rule {
-	return &Rule{id, r, o, Inherit, false}
+	return &Rule{RoleID: id, Resource: r, Operation: o, Access: Inherit}
 }
 
 func (u *Rule) SetValue(name string, pos uint, v any) (err error) {
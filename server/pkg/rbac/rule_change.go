@@ -0,0 +1,216 @@
+package rbac
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/sql"
+)
+
+type (
+	// RuleState holds access and validity window of the rule
+	// before or after the change
+	RuleState struct {
+		Access    Access     `json:"access,string"`
+		NotBefore *time.Time `json:"notBefore,omitempty"`
+		NotAfter  *time.Time `json:"notAfter,omitempty"`
+	}
+
+	// RuleChange records a single rule changed with Grant
+	//
+	// All rules changed with the same Grant share the change set ID;
+	// change sets are used as versions of the rules
+	RuleChange struct {
+		ID          uint64    `json:"changeID,string"`
+		ChangeSetID uint64    `json:"changeSetID,string"`
+		Timestamp   time.Time `json:"timestamp"`
+
+		// ID of the user (if not anonymous) that changed the rule;
+		// 0 for changes done by the system (expired rules)
+		ActorID uint64 `json:"actorID,string"`
+
+		RoleID    uint64 `json:"roleID,string"`
+		Resource  string `json:"resource"`
+		Operation string `json:"operation"`
+
+		Before RuleState `json:"before"`
+		After  RuleState `json:"after"`
+	}
+
+	RuleChangeSet []*RuleChange
+
+	RuleChangeFilter struct {
+		ChangeSetID []uint64 `json:"changeSetID"`
+		RoleID      []uint64 `json:"roleID"`
+
+		// Only changes made after the change set
+		AfterChangeSetID uint64 `json:"afterChangeSetID,string"`
+
+		// Only changes made after the given time
+		ChangedAfter *time.Time `json:"changedAfter,omitempty"`
+
+		Limit uint `json:"limit"`
+
+		// Standard helpers for sorting
+		filter.Sorting
+	}
+)
+
+// ruleState returns state of the rule; nil rule is in the Inherit state
+func ruleState(r *Rule) RuleState {
+	if r == nil {
+		return RuleState{Access: Inherit}
+	}
+
+	return RuleState{Access: r.Access, NotBefore: r.NotBefore, NotAfter: r.NotAfter}
+}
+
+// Equal checks if both states have the same access and validity window
+func (s RuleState) Equal(o RuleState) bool {
+	return s.Access == o.Access &&
+		eqTime(s.NotBefore, o.NotBefore) &&
+		eqTime(s.NotAfter, o.NotAfter)
+}
+
+func (s *RuleState) Scan(src any) error          { return sql.ParseJSON(src, s) }
+func (s RuleState) Value() (driver.Value, error) { return json.Marshal(s) }
+
+// Rule returns rule in the state after the change
+func (c RuleChange) Rule() *Rule {
+	return c.rule(c.After)
+}
+
+// rule returns rule for the changed role, resource and operation in the given state
+func (c RuleChange) rule(s RuleState) *Rule {
+	return &Rule{
+		RoleID:    c.RoleID,
+		Resource:  c.Resource,
+		Operation: c.Operation,
+		Access:    s.Access,
+		NotBefore: s.NotBefore,
+		NotAfter:  s.NotAfter,
+	}
+}
+
+// Squash combines all changes made after the from change set up to (and
+// including) the to change set into one change per rule
+//
+// Before state of each rule is taken from its oldest change and after state
+// from its newest change. Rules that ended up in the same state are omitted.
+// When to is 0, all changes after from are combined.
+func (set RuleChangeSet) Squash(from, to uint64) (out RuleChangeSet) {
+	var (
+		cc  = make(RuleChangeSet, 0, len(set))
+		idx = make(map[string]*RuleChange)
+	)
+
+	for _, c := range set {
+		if c.ChangeSetID > from && (to == 0 || c.ChangeSetID <= to) {
+			cc = append(cc, c)
+		}
+	}
+
+	sort.SliceStable(cc, func(i, j int) bool {
+		return cc[i].ChangeSetID < cc[j].ChangeSetID
+	})
+
+	for _, c := range cc {
+		k := c.Rule().key()
+		if sq, ok := idx[k]; ok {
+			sq.ChangeSetID, sq.Timestamp, sq.ActorID = c.ChangeSetID, c.Timestamp, c.ActorID
+			sq.After = c.After
+			continue
+		}
+
+		sq := *c
+		idx[k] = &sq
+		out = append(out, &sq)
+	}
+
+	var (
+		dd = out[:0]
+	)
+
+	for _, c := range out {
+		if !c.Before.Equal(c.After) {
+			dd = append(dd, c)
+		}
+	}
+
+	return dd
+}
+
+// Snapshot returns rules as they were right after the given change set
+//
+// Set must contain all changes made after the change set; rules
+// that did not exist at the time are returned with Inherit access
+// so that granting the snapshot removes them.
+func (set RuleChangeSet) Snapshot(changeSetID uint64) (out RuleSet) {
+	for _, c := range set.Squash(changeSetID, 0) {
+		out = append(out, c.rule(c.Before))
+	}
+
+	return
+}
+
+// SnapshotAt returns rules as they were at the given time
+//
+// Set must contain all changes made after the given time;
+// see Snapshot for details
+func (set RuleChangeSet) SnapshotAt(at time.Time) RuleSet {
+	var (
+		cc = make(RuleChangeSet, 0, len(set))
+	)
+
+	for _, c := range set {
+		if c.Timestamp.After(at) {
+			cc = append(cc, c)
+		}
+	}
+
+	return cc.Snapshot(0)
+}
+
+// Replaced returns the rule that was overwritten by the current state of the given rule
+//
+// Set must contain all changes of the rule. Changes are followed back from the
+// newest one (the one that put the rule in its current state); rules that were
+// overwritten but expired before the given time are skipped.
+// When no such rule exists, rule with Inherit access is returned.
+func (set RuleChangeSet) Replaced(r *Rule, at time.Time) *Rule {
+	var (
+		k  = r.key()
+		cc = make(RuleChangeSet, 0, len(set))
+	)
+
+	for _, c := range set {
+		if c.Rule().key() == k {
+			cc = append(cc, c)
+		}
+	}
+
+	sort.SliceStable(cc, func(i, j int) bool {
+		return cc[i].ChangeSetID > cc[j].ChangeSetID
+	})
+
+	for _, c := range cc {
+		if c.Before.Access == Inherit {
+			break
+		}
+
+		if rr := c.rule(c.Before); !rr.Expired(at) {
+			return rr
+		}
+	}
+
+	return InheritRule(r.RoleID, r.Resource, r.Operation)
+}
+
+// key returns unique identifier of the rule
+func (r Rule) key() string {
+	return strconv.FormatUint(r.RoleID, 10) + "\x00" + r.Resource + "\x00" + r.Operation
+}
@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleChangeSet_Squash(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		until = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+
+		allow   = RuleState{Access: Allow}
+		deny    = RuleState{Access: Deny}
+		inherit = RuleState{Access: Inherit}
+		timed   = RuleState{Access: Allow, NotAfter: &until}
+
+		set = RuleChangeSet{
+			{ChangeSetID: 4, RoleID: 1, Resource: "res", Operation: "op1", Before: deny, After: allow},
+			{ChangeSetID: 1, RoleID: 1, Resource: "res", Operation: "op1", Before: inherit, After: allow},
+			{ChangeSetID: 2, RoleID: 1, Resource: "res", Operation: "op1", Before: allow, After: deny},
+			{ChangeSetID: 2, RoleID: 1, Resource: "res", Operation: "op2", Before: inherit, After: allow},
+			{ChangeSetID: 3, RoleID: 1, Resource: "res", Operation: "op2", Before: allow, After: timed},
+		}
+	)
+
+	// op1 ends up in the same state as after the first change set
+	sq := set.Squash(1, 0)
+	req.Len(sq, 1)
+	req.Equal("op2", sq[0].Operation)
+	req.Equal(inherit, sq[0].Before)
+	req.Equal(timed, sq[0].After)
+	req.Equal(uint64(3), sq[0].ChangeSetID)
+
+	sq = set.Squash(1, 2)
+	req.Len(sq, 2)
+	req.Equal(allow, sq[0].Before)
+	req.Equal(deny, sq[0].After)
+
+	// op1 is already in the same state
+	rr := set.Snapshot(1)
+	req.Len(rr, 1)
+	req.Equal("op2", rr[0].Operation)
+	req.Equal(Inherit, rr[0].Access)
+
+	rr = set.Snapshot(0)
+	req.Len(rr, 2)
+	req.Equal(Inherit, rr[0].Access)
+}
@@ -3,6 +3,7 @@ package rbac
 import (
 	"sort"
 	"strings"
+	"time"
 )
 
 type (
@@ -149,7 +150,8 @@ func (t *ruleIndex) empty() bool {
 	return t == nil || t.children == nil || len(t.children) == 0
 }
 
-func (t *ruleIndex) matchingRule(role uint64, op, res string) (out *Rule) {
+// matchingRule returns the most specific rule for the role that is valid at the given time
+func (t *ruleIndex) matchingRule(role uint64, op, res string, at time.Time) (out *Rule) {
 	set := RuleSet(t.get(role, op, res))
 	sort.Sort(set)
 
@@ -158,6 +160,11 @@ func (t *ruleIndex) matchingRule(role uint64, op, res string) (out *Rule) {
 			continue
 		}
 
+		if !s.ValidAt(at) {
+			// rule outside of its validity window
+			continue
+		}
+
 		return s
 	}
 
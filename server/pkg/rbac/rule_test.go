@@ -3,6 +3,7 @@ package rbac
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -52,3 +53,27 @@ func TestRuleSet_FilterResource(t *testing.T) {
 	req.Len(rr.FilterResource(NewResource(":::/*/*/*")), 1)
 	req.Len(rr.FilterResource(NewResource(":::/*")), 0)
 }
+
+func TestRule_ValidAt(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		from  = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		until = from.Add(time.Hour)
+
+		r = Rule{NotBefore: &from, NotAfter: &until}
+	)
+
+	req.True(Rule{}.ValidAt(from))
+	req.False(Rule{}.Expired(from))
+	req.False(Rule{}.Timed())
+	req.True(r.Timed())
+
+	req.False(r.ValidAt(from.Add(-time.Second)))
+	req.True(r.ValidAt(from))
+	req.True(r.ValidAt(until.Add(-time.Second)))
+	req.False(r.ValidAt(until))
+
+	req.False(r.Expired(from))
+	req.True(r.Expired(until))
+}
@@ -1,5 +1,16 @@
 package rbac
 
+import (
+	"time"
+)
+
+var (
+	// now returns the time rules are checked against
+	//
+	// Replaceable for testing
+	now = time.Now
+)
+
 // function checks all given rules
 //
 //   - indexRules are rules optimized for quick lookup.
@@ -14,6 +25,13 @@ package rbac
 //   - trace is optional; when not nil, function will update trace struct
 //     with information as it traverses and checks the rules
 func check(indexedRules *ruleIndex, rolesByKind partRoles, op, res string, trace *Trace) Access {
+	return checkAt(indexedRules, rolesByKind, op, res, now(), trace)
+}
+
+// checkAt checks all given rules with validity window checked against the given time
+//
+// See check() for details
+func checkAt(indexedRules *ruleIndex, rolesByKind partRoles, op, res string, at time.Time, trace *Trace) Access {
 	baseTraceInfo(trace, res, op, rolesByKind)
 
 	if member(rolesByKind, AnonymousRole) && len(rolesByKind) > 1 {
@@ -67,7 +85,7 @@ func check(indexedRules *ruleIndex, rolesByKind partRoles, op, res string, trace
 		allowed = false
 
 		for r := range rolesByKind[kind] {
-			match = indexedRules.matchingRule(r, op, res)
+			match = indexedRules.matchingRule(r, op, res, at)
 
 			// check all rules for each role the security-context
 			if match == nil {
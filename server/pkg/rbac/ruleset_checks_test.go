@@ -2,6 +2,7 @@ package rbac
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -236,3 +237,54 @@ func Test_checkWithTrace(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkTimed(t *testing.T) {
+	var (
+		ref   = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		past  = ref.Add(-time.Hour)
+		until = ref.Add(time.Hour)
+
+		rr = []*Role{
+			{id: 1, kind: CommonRole},
+		}
+
+		cc = []struct {
+			name string
+			exp  Access
+			set  RuleSet
+		}{
+			{
+				"allow inside validity window",
+				Allow,
+				RuleSet{{RoleID: 1, Resource: "res", Operation: "op", Access: Allow, NotBefore: &past, NotAfter: &until}},
+			},
+			{
+				"inherit before validity window",
+				Inherit,
+				RuleSet{{RoleID: 1, Resource: "res", Operation: "op", Access: Allow, NotBefore: &until}},
+			},
+			{
+				"inherit after validity window",
+				Inherit,
+				RuleSet{{RoleID: 1, Resource: "res", Operation: "op", Access: Deny, NotAfter: &past}},
+			},
+			{
+				"fallback to less specific rule when specific one is not valid",
+				Deny,
+				RuleSet{
+					{RoleID: 1, Resource: "res", Operation: "op", Access: Allow, NotAfter: &past},
+					{RoleID: 1, Resource: "*", Operation: "op", Access: Deny},
+				},
+			},
+		}
+	)
+
+	now = func() time.Time { return ref }
+	defer func() { now = time.Now }()
+
+	for _, c := range cc {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.exp.String(), check(buildRuleIndex(c.set), partitionRoles(rr...), "op", "res", nil).String())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package rbac
 
 import (
+	"time"
+
 	"github.com/cortezaproject/corteza/server/pkg/slice"
 )
 
@@ -25,8 +27,13 @@ func merge(base RuleSet, new ...*Rule) (out RuleSet) {
 			// Never go beyond the last base rule (blen)
 			for o = 0; o < blen; o++ {
 				if eq(out[o], rule) {
-					out[o].dirty = out[o].Access != rule.Access
+					out[o].dirty = out[o].Access != rule.Access ||
+						!eqTime(out[o].NotBefore, rule.NotBefore) ||
+						!eqTime(out[o].NotAfter, rule.NotAfter)
+
 					out[o].Access = rule.Access
+					out[o].NotBefore = rule.NotBefore
+					out[o].NotAfter = rule.NotAfter
 
 					// only one rule can match so proceed with next new rule
 					continue newRules
@@ -55,6 +62,14 @@ func eq(a, b *Rule) bool {
 		a.Operation == b.Operation
 }
 
+func eqTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
 func ruleByRole(base RuleSet, roleID uint64) (out RuleSet) {
 	for _, r := range base {
 		if r.RoleID == roleID {
@@ -65,6 +80,18 @@ func ruleByRole(base RuleSet, roleID uint64) (out RuleSet) {
 	return
 }
 
+// expired returns copies of all rules with the validity window ended before the given time
+func expired(set RuleSet, t time.Time) (out RuleSet) {
+	for _, r := range set {
+		if r.Access != Inherit && r.Expired(t) {
+			var c = *r
+			out = append(out, &c)
+		}
+	}
+
+	return
+}
+
 // Dirty returns list of deleted (Access==Inherit) and changed (dirty) rules
 func flushable(set RuleSet) (deletable, updatable, final RuleSet) {
 	deletable, updatable, final = RuleSet{}, RuleSet{}, RuleSet{}
@@ -102,6 +129,8 @@ func (set RuleSet) sigRoles(res string, op string) (aRR, dRR []uint64) {
 	)
 
 	var (
+		at = now()
+
 		rr = map[Access]map[int][]uint64{
 			Allow: {
 				dirRes: make([]uint64, 0),
@@ -117,7 +146,7 @@ func (set RuleSet) sigRoles(res string, op string) (aRR, dRR []uint64) {
 	// Extract all relevant rules (by op and resource) and group them by
 	// access and distance (rules for direct resources and rules for indirect resources)
 	for _, r := range set {
-		if r.Operation != op {
+		if r.Operation != op || !r.ValidAt(at) {
 			continue
 		}
 
@@ -2,10 +2,14 @@ package rbac
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/pkg/sentry"
 	"go.uber.org/zap"
 )
@@ -23,7 +27,21 @@ type (
 
 		roles []*Role
 
-		store rbacRulesStore
+		store RulesStore
+
+		// when set, rules and their changes are flushed in one transaction
+		tx RulesStoreTx
+
+		// called with rules removed after their validity window ended
+		onExpire []func(context.Context, RuleSet)
+
+		// when set, expiry ticks are claimed before expired rules
+		// are removed so that only one of the instances removes them
+		claims expiryClaimer
+	}
+
+	expiryClaimer interface {
+		Claim(ctx context.Context, job string, ttl time.Duration) (bool, error)
 	}
 
 	// RuleFilter is a dummy struct to satisfy store codegen
@@ -41,12 +59,25 @@ type (
 var (
 	// Global RBAC service
 	gRBAC *service
+
+	// generates IDs for rule changes
+	//
+	// Replaceable for testing
+	nextID = id.Next
 )
 
 const (
 	watchInterval = time.Hour
 
-	RuleResourceType = "corteza::generic:rbac-rule"
+	// how often rules with ended validity window are removed
+	expireInterval = time.Minute
+
+	// name of the claimed job and how long claims for expiry ticks are kept
+	expireJob      = "rbac-expire"
+	expireClaimTTL = time.Hour
+
+	RuleResourceType       = "corteza::generic:rbac-rule"
+	RuleChangeResourceType = "corteza::generic:rbac-rule-change"
 )
 
 // Global returns global RBAC service
@@ -63,7 +94,7 @@ func SetGlobal(svc *service) {
 //
 // service{} struct preloads, checks, grants and flushes privileges to and from store
 // It acts as a caching layer
-func NewService(logger *zap.Logger, s rbacRulesStore) (svc *service) {
+func NewService(logger *zap.Logger, s RulesStore) (svc *service) {
 	svc = &service{
 		l: &sync.RWMutex{},
 		f: make(chan bool),
@@ -109,6 +140,54 @@ func (svc *service) Check(ses Session, op string, res Resource) (a Access) {
 
 // Trace checks RBAC rules and returns all decision trace log
 func (svc *service) Trace(ses Session, op string, res Resource) *Trace {
+	return traceAt(svc.indexed, svc.roles, ses, op, res, now())
+}
+
+// At returns snapshot of RBAC rules as they were at the given time
+//
+// Current rules are reverted with all changes recorded after the given
+// time; rules with validity window are evaluated against that time.
+func (svc *service) At(ctx context.Context, at time.Time) (*Snapshot, error) {
+	if svc.store == nil {
+		return nil, fmt.Errorf("rule changes not available (no store)")
+	}
+
+	svc.l.RLock()
+	var (
+		rules = make(RuleSet, 0, len(svc.rules))
+		roles = svc.roles
+	)
+
+	// rules are merged with the snapshot; copy them
+	// so that the current rules stay intact
+	for _, r := range svc.rules {
+		c := *r
+		rules = append(rules, &c)
+	}
+	svc.l.RUnlock()
+
+	cc, _, err := svc.store.SearchRbacRuleChanges(ctx, RuleChangeFilter{ChangedAfter: &at})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		snapshot = make(RuleSet, 0, len(rules))
+	)
+
+	// rules that did not exist at the time are in the Inherit state
+	for _, r := range merge(rules, cc.SnapshotAt(at)...) {
+		if r.Access != Inherit {
+			snapshot = append(snapshot, r)
+		}
+	}
+
+	return &Snapshot{at: at, roles: roles, indexed: buildRuleIndex(snapshot)}, nil
+}
+
+// traceAt checks indexed rules with validity window checked
+// against the given time and returns all decision trace log
+func traceAt(indexed *ruleIndex, roles []*Role, ses Session, op string, res Resource, at time.Time) *Trace {
 	var (
 		t = new(Trace)
 	)
@@ -118,7 +197,7 @@ func (svc *service) Trace(ses Session, op string, res Resource) *Trace {
 		// AND trace is done on a resource with wildcards
 		ctxRolesDebug := partRoles{ContextRole: make(map[uint64]bool)}
 		for _, memberOf := range ses.Roles() {
-			for _, role := range svc.roles {
+			for _, role := range roles {
 				if role.kind != ContextRole {
 					continue
 				}
@@ -150,10 +229,10 @@ func (svc *service) Trace(ses Session, op string, res Resource) *Trace {
 	}
 
 	var (
-		fRoles = getContextRoles(ses, res, svc.roles)
+		fRoles = getContextRoles(ses, res, roles)
 	)
 
-	_ = check(svc.indexed, fRoles, op, res.RbacResource(), t)
+	_ = checkAt(indexed, fRoles, op, res.RbacResource(), at, t)
 
 	return t
 }
@@ -165,12 +244,19 @@ func (svc *service) Grant(ctx context.Context, rules ...*Rule) (err error) {
 	svc.l.Lock()
 	defer svc.l.Unlock()
 
+	for _, r := range rules {
+		if r.NotBefore != nil && r.NotAfter != nil && !r.NotAfter.After(*r.NotBefore) {
+			return fmt.Errorf("invalid validity window for rule %s", r)
+		}
+	}
+
 	for _, r := range rules {
 		svc.logger.Debug(r.Access.String() + " " + r.Operation + " on " + r.Resource + " to " + strconv.FormatUint(r.RoleID, 10))
 	}
 
+	cc := svc.changes(auth.GetIdentityFromContext(ctx).Identity(), rules...)
 	svc.grant(rules...)
-	return svc.flush(ctx)
+	return svc.flush(ctx, cc)
 }
 
 func (svc *service) grant(rules ...*Rule) {
@@ -178,19 +264,132 @@ func (svc *service) grant(rules ...*Rule) {
 	svc.indexed = buildRuleIndex(svc.rules)
 }
 
+// changes returns all changes the given rules make to the current rules
+//
+// Needs to be called before rules are merged; all changes
+// are part of the same change set and are attributed to the given actor
+func (svc *service) changes(actorID uint64, rules ...*Rule) (cc RuleChangeSet) {
+	if svc.store == nil {
+		// nowhere to record the changes
+		return
+	}
+
+	var (
+		idx = make(map[string]*RuleChange)
+
+		changeSetID = nextID()
+		ts          = now()
+	)
+
+	for _, r := range rules {
+		k := r.key()
+		if c, ok := idx[k]; ok {
+			c.After = ruleState(r)
+			continue
+		}
+
+		idx[k] = &RuleChange{
+			ID:          nextID(),
+			ChangeSetID: changeSetID,
+			Timestamp:   ts,
+			ActorID:     actorID,
+			RoleID:      r.RoleID,
+			Resource:    r.Resource,
+			Operation:   r.Operation,
+			Before:      ruleState(nil),
+			After:       ruleState(r),
+		}
+
+		cc = append(cc, idx[k])
+	}
+
+	for _, r := range svc.rules {
+		if c, ok := idx[r.key()]; ok {
+			c.Before = ruleState(r)
+		}
+	}
+
+	var (
+		dd = cc[:0]
+	)
+
+	for _, c := range cc {
+		if !c.Before.Equal(c.After) {
+			dd = append(dd, c)
+		}
+	}
+
+	return dd
+}
+
+// RuleChanges returns recorded rule changes
+func (svc *service) RuleChanges(ctx context.Context, f RuleChangeFilter) (RuleChangeSet, RuleChangeFilter, error) {
+	if svc.store == nil {
+		return nil, f, fmt.Errorf("rule changes not available (no store)")
+	}
+
+	return svc.store.SearchRbacRuleChanges(ctx, f)
+}
+
+// Rollback restores rules to the state right after the given change set
+//
+// Rollback can be limited to rules of one role and/or to rules on resources
+// matching the given resource (wildcards can be used to match the entire subtree).
+// Rollback is granted as a new change set and returns the restored rules.
+func (svc *service) Rollback(ctx context.Context, changeSetID, roleID uint64, resource string) (rr RuleSet, err error) {
+	if svc.store == nil {
+		return nil, fmt.Errorf("rule changes not available (no store)")
+	}
+
+	f := RuleChangeFilter{AfterChangeSetID: changeSetID}
+	if roleID > 0 {
+		f.RoleID = []uint64{roleID}
+	}
+
+	cc, _, err := svc.store.SearchRbacRuleChanges(ctx, f)
+	if err != nil {
+		return
+	}
+
+	for _, r := range cc.Snapshot(changeSetID) {
+		if roleID > 0 && r.RoleID != roleID {
+			continue
+		}
+
+		if resource != "" && !matchResource(resource, r.Resource) {
+			continue
+		}
+
+		rr = append(rr, r)
+	}
+
+	if len(rr) == 0 {
+		return
+	}
+
+	return rr, svc.Grant(ctx, rr...)
+}
+
 // Watch reloads RBAC rules in intervals and on request
 func (svc *service) Watch(ctx context.Context) {
 	go func() {
 		defer sentry.Recover()
 
-		var ticker = time.NewTicker(watchInterval)
+		var (
+			ticker       = time.NewTicker(watchInterval)
+			expireTicker = time.NewTicker(expireInterval)
+		)
+
 		defer ticker.Stop()
+		defer expireTicker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
 				svc.Reload(ctx)
+			case <-expireTicker.C:
+				svc.expireTick(ctx, now())
 			case <-svc.f:
 				svc.Reload(ctx)
 			}
@@ -200,6 +399,139 @@ func (svc *service) Watch(ctx context.Context) {
 	svc.logger.Debug("watcher initialized")
 }
 
+// Coordinate expiry with other instances sharing the same claims store
+//
+// Each expiry tick is handled by only one of the instances
+func (svc *service) Coordinate(c expiryClaimer) {
+	svc.l.Lock()
+	defer svc.l.Unlock()
+	svc.claims = c
+}
+
+// Transactional configures the transaction that rules and their changes are flushed in
+//
+// Without it, rules and their changes are flushed one after another
+func (svc *service) Transactional(tx RulesStoreTx) {
+	svc.l.Lock()
+	defer svc.l.Unlock()
+	svc.tx = tx
+}
+
+// expireTick claims the tick and removes expired rules
+//
+// Instances that do not claim the tick keep expired rules
+// until the next reload; checks ignore them anyway
+func (svc *service) expireTick(ctx context.Context, at time.Time) {
+	svc.l.RLock()
+	c := svc.claims
+	svc.l.RUnlock()
+
+	if c != nil {
+		claimed, err := c.Claim(ctx, scheduler.ClaimJob(expireJob, at, expireInterval), expireClaimTTL)
+		if err != nil {
+			svc.logger.Warn("failed to claim rule expiry", zap.Error(err))
+			return
+		}
+
+		if !claimed {
+			// handled by another instance
+			return
+		}
+	}
+
+	if err := svc.Expire(ctx); err != nil {
+		svc.logger.Error("could not remove expired rules", zap.Error(err))
+	}
+}
+
+// OnExpire registers function that is called with all rules
+// removed after their validity window ended
+func (svc *service) OnExpire(fn func(context.Context, RuleSet)) {
+	svc.l.Lock()
+	defer svc.l.Unlock()
+	svc.onExpire = append(svc.onExpire, fn)
+}
+
+// Expire removes all rules with the validity window that ended
+//
+// Rules that were overwritten by the expired rules are restored (see replaced).
+// Expired rules are already ignored by the checks; this only keeps
+// the store clean and notifies registered functions (see OnExpire)
+func (svc *service) Expire(ctx context.Context) (err error) {
+	svc.l.Lock()
+
+	var (
+		rr = expired(svc.rules, now())
+		ii RuleSet
+	)
+
+	if len(rr) == 0 {
+		svc.l.Unlock()
+		return
+	}
+
+	if ii, err = svc.replaced(ctx, rr, now()); err != nil {
+		svc.l.Unlock()
+		return
+	}
+
+	// expiry is done by the system and not by the user
+	// that happens to be in the context; recorded with actor ID 0
+	cc := svc.changes(0, ii...)
+	svc.grant(ii...)
+	err = svc.flush(ctx, cc)
+
+	fns := svc.onExpire
+	svc.l.Unlock()
+
+	if err != nil {
+		return
+	}
+
+	svc.logger.Debug("removed expired rules", zap.Int("count", len(rr)))
+
+	for _, fn := range fns {
+		fn(ctx, rr)
+	}
+
+	return
+}
+
+// replaced returns rules that were overwritten by the given expired rules
+//
+// Granting a rule with a validity window overwrites the existing rule for the
+// same role, resource and operation. Overwritten rule is looked up in the
+// recorded changes so that it can be restored when the granted rule expires.
+// Expired rules that did not overwrite anything are returned as Inherit rules.
+func (svc *service) replaced(ctx context.Context, rr RuleSet, at time.Time) (out RuleSet, err error) {
+	var (
+		cc RuleChangeSet
+
+		f    = RuleChangeFilter{}
+		seen = make(map[uint64]bool)
+	)
+
+	for _, r := range rr {
+		if !seen[r.RoleID] {
+			seen[r.RoleID] = true
+			f.RoleID = append(f.RoleID, r.RoleID)
+		}
+	}
+
+	if svc.store != nil {
+		if cc, _, err = svc.store.SearchRbacRuleChanges(ctx, f); err != nil {
+			return nil, fmt.Errorf("could not load changes of expired rules: %w", err)
+		}
+	}
+
+	out = make(RuleSet, len(rr))
+	for i, r := range rr {
+		out[i] = cc.Replaced(r, at)
+	}
+
+	return
+}
+
 // FindRulesByRoleID returns all RBAC rules that belong to a role
 func (svc *service) FindRulesByRoleID(roleID uint64) (rr RuleSet) {
 	svc.l.RLock()
@@ -270,8 +602,8 @@ func (svc *service) UpdateRoles(rr ...*Role) {
 	svc.roles = rr
 }
 
-// flush pushes all changed rules to the store (if service is configured with one)
-func (svc *service) flush(ctx context.Context) (err error) {
+// flush pushes all changed rules and the given rule changes to the store (if service is configured with one)
+func (svc *service) flush(ctx context.Context, cc RuleChangeSet) (err error) {
 	if svc.store == nil {
 		svc.logger.Debug("rule flushing disabled (no store)")
 		return
@@ -279,12 +611,26 @@ func (svc *service) flush(ctx context.Context) (err error) {
 
 	deletable, updatable, final := flushable(svc.rules)
 
-	err = svc.store.DeleteRbacRule(ctx, deletable...)
-	if err != nil {
-		return
-	}
+	// rules and their changes are stored together
+	// or not at all
+	err = svc.inTx(ctx, func(ctx context.Context, s RulesStore) (err error) {
+		err = s.DeleteRbacRule(ctx, deletable...)
+		if err != nil {
+			return
+		}
+
+		err = s.UpsertRbacRule(ctx, updatable...)
+		if err != nil {
+			return
+		}
+
+		if len(cc) == 0 {
+			return
+		}
+
+		return s.CreateRbacRuleChange(ctx, cc...)
+	})
 
-	err = svc.store.UpsertRbacRule(ctx, updatable...)
 	if err != nil {
 		return
 	}
@@ -295,12 +641,22 @@ func (svc *service) flush(ctx context.Context) (err error) {
 		"flushed rules",
 		zap.Int("deleted", len(deletable)),
 		zap.Int("updated", len(updatable)),
+		zap.Int("changed", len(cc)),
 		zap.Int("final", len(final)),
 	)
 
 	return
 }
 
+// inTx runs the function in a transaction when the service is configured with one
+func (svc *service) inTx(ctx context.Context, fn func(context.Context, RulesStore) error) error {
+	if svc.tx == nil {
+		return fn(ctx, svc.store)
+	}
+
+	return svc.tx(ctx, fn)
+}
+
 // SignificantRoles returns two list of significant roles.
 //
 // See sigRoles on rules for more details
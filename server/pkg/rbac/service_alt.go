@@ -3,6 +3,7 @@ package rbac
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type (
@@ -29,6 +30,10 @@ func (ServiceAllowAll) Trace(Session, string, Resource) *Trace {
 	return nil
 }
 
+func (ServiceAllowAll) At(context.Context, time.Time) (*Snapshot, error) {
+	return nil, fmt.Errorf("ServiceAllowAll does not support rule snapshots")
+}
+
 func (ServiceAllowAll) CloneRulesByRoleID(context.Context, uint64, ...uint64) error {
 	return fmt.Errorf(" ServiceAllowAll does not support rule clonning")
 }
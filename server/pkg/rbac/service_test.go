@@ -6,8 +6,12 @@ import (
 	"math"
 	"math/rand"
 	"testing"
+	"time"
 
+	"github.com/cortezaproject/corteza/server/pkg/auth"
 	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -18,8 +22,340 @@ type (
 		res   Resource
 		op    string
 	}
+
+	testRulesStore struct {
+		upserted RuleSet
+		deleted  RuleSet
+		changes  RuleChangeSet
+
+		failChanges bool
+	}
 )
 
+func (s *testRulesStore) SearchRbacRules(context.Context, RuleFilter) (RuleSet, RuleFilter, error) {
+	return nil, RuleFilter{}, nil
+}
+
+func (s *testRulesStore) UpsertRbacRule(_ context.Context, rr ...*Rule) error {
+	s.upserted = append(s.upserted, rr...)
+	return nil
+}
+
+func (s *testRulesStore) DeleteRbacRule(_ context.Context, rr ...*Rule) error {
+	s.deleted = append(s.deleted, rr...)
+	return nil
+}
+
+func (s *testRulesStore) TruncateRbacRules(context.Context) error { return nil }
+
+func (s *testRulesStore) SearchRbacRuleChanges(_ context.Context, f RuleChangeFilter) (out RuleChangeSet, _ RuleChangeFilter, _ error) {
+	for _, c := range s.changes {
+		if c.ChangeSetID <= f.AfterChangeSetID {
+			continue
+		}
+
+		if f.ChangedAfter != nil && !c.Timestamp.After(*f.ChangedAfter) {
+			continue
+		}
+
+		if len(f.RoleID) > 0 && !hasID(f.RoleID, c.RoleID) {
+			continue
+		}
+
+		out = append(out, c)
+	}
+
+	return out, f, nil
+}
+
+func (s *testRulesStore) CreateRbacRuleChange(_ context.Context, cc ...*RuleChange) error {
+	if s.failChanges {
+		return fmt.Errorf("could not create rule changes")
+	}
+
+	s.changes = append(s.changes, cc...)
+	return nil
+}
+
+func hasID(ii []uint64, id uint64) bool {
+	for _, i := range ii {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sequential IDs for testing
+func testNextID() func() uint64 {
+	var last uint64
+	return func() uint64 {
+		last++
+		return last
+	}
+}
+
+func TestService_Expire(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.Authenticated(42))
+
+		ref   = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		past  = ref.Add(-time.Hour)
+		until = ref.Add(time.Hour)
+
+		s   = &testRulesStore{}
+		svc = NewService(zap.NewNop(), s)
+
+		expired RuleSet
+	)
+
+	now = func() time.Time { return ref }
+	nextID = testNextID()
+	defer func() { now, nextID = time.Now, id.Next }()
+
+	svc.OnExpire(func(_ context.Context, rr RuleSet) { expired = append(expired, rr...) })
+
+	req.Error(svc.Grant(ctx, &Rule{RoleID: 1, Resource: "res", Operation: "op", Access: Allow, NotBefore: &until, NotAfter: &past}))
+
+	req.NoError(svc.Grant(ctx,
+		&Rule{RoleID: 1, Resource: "res", Operation: "op1", Access: Allow, NotAfter: &past},
+		&Rule{RoleID: 1, Resource: "res", Operation: "op2", Access: Allow, NotAfter: &until},
+		AllowRule(1, "res", "op3"),
+	))
+
+	req.NoError(svc.Expire(ctx))
+	req.Len(s.deleted, 1)
+	req.Equal("op1", s.deleted[0].Operation)
+	req.Len(svc.Rules(), 2)
+
+	req.Len(expired, 1)
+	req.Equal("op1", expired[0].Operation)
+	req.Equal(Allow, expired[0].Access)
+
+	// expiry is recorded as a change
+	req.Len(s.changes, 4)
+	req.Equal(Allow, s.changes[3].Before.Access)
+	req.Equal(Inherit, s.changes[3].After.Access)
+
+	// as a separate change set done by the system
+	req.NotEqual(s.changes[0].ChangeSetID, s.changes[3].ChangeSetID)
+	req.Equal(uint64(42), s.changes[0].ActorID)
+	req.Zero(s.changes[3].ActorID)
+
+	// nothing left to expire
+	req.NoError(svc.Expire(ctx))
+	req.Len(expired, 1)
+}
+
+func TestService_GrantTransactional(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   = &testRulesStore{}
+		svc = NewService(zap.NewNop(), s)
+	)
+
+	nextID = testNextID()
+	defer func() { nextID = id.Next }()
+
+	svc.Transactional(func(ctx context.Context, fn func(context.Context, RulesStore) error) error {
+		// writes are kept only when all of them succeed
+		tx := &testRulesStore{failChanges: s.failChanges}
+		if err := fn(ctx, tx); err != nil {
+			return err
+		}
+
+		s.upserted = append(s.upserted, tx.upserted...)
+		s.deleted = append(s.deleted, tx.deleted...)
+		s.changes = append(s.changes, tx.changes...)
+		return nil
+	})
+
+	req.NoError(svc.Grant(ctx, AllowRule(1, "res", "op1")))
+	req.Len(s.upserted, 1)
+	req.Len(s.changes, 1)
+
+	s.failChanges = true
+	req.Error(svc.Grant(ctx, AllowRule(1, "res", "op2")))
+	req.Len(s.upserted, 1)
+	req.Len(s.changes, 1)
+}
+
+func TestService_ExpireRestoresReplaced(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		ref   = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		past  = ref.Add(-time.Hour)
+		until = ref.Add(time.Hour)
+
+		s   = &testRulesStore{}
+		svc = NewService(zap.NewNop(), s)
+
+		access = func(op string) Access {
+			for _, r := range svc.Rules() {
+				if r.Operation == op {
+					return r.Access
+				}
+			}
+
+			return Inherit
+		}
+	)
+
+	nextID = testNextID()
+	defer func() { now, nextID = time.Now, id.Next }()
+
+	now = func() time.Time { return ref.Add(-2 * time.Hour) }
+	req.NoError(svc.Grant(ctx,
+		DenyRule(1, "res", "op1"),
+		DenyRule(1, "res", "op2"),
+	))
+
+	// time-limited access overwrites the permanent rules
+	req.NoError(svc.Grant(ctx,
+		&Rule{RoleID: 1, Resource: "res", Operation: "op1", Access: Allow, NotAfter: &past},
+		&Rule{RoleID: 1, Resource: "res", Operation: "op2", Access: Allow, NotAfter: &past},
+	))
+
+	// op2 is extended before the first window ends
+	req.NoError(svc.Grant(ctx,
+		&Rule{RoleID: 1, Resource: "res", Operation: "op2", Access: Allow, NotAfter: &until},
+	))
+
+	now = func() time.Time { return ref }
+	req.NoError(svc.Expire(ctx))
+	req.Empty(s.deleted)
+	req.Equal(Deny, access("op1"))
+	req.Equal(Allow, access("op2"))
+
+	// the expired window of op2 is skipped
+	now = func() time.Time { return until }
+	req.NoError(svc.Expire(ctx))
+	req.Empty(s.deleted)
+	req.Equal(Deny, access("op2"))
+
+	last := s.changes[len(s.changes)-1]
+	req.Equal(Allow, last.Before.Access)
+	req.Equal(Deny, last.After.Access)
+	req.Nil(last.After.NotAfter)
+}
+
+type testExpiryClaimer map[string]bool
+
+func (c testExpiryClaimer) Claim(_ context.Context, job string, _ time.Duration) (bool, error) {
+	if c[job] {
+		return false, nil
+	}
+
+	c[job] = true
+	return true, nil
+}
+
+func TestService_ExpireClaimed(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		ref  = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		past = ref.Add(-time.Hour)
+
+		// instances sharing the same store and claims
+		s      = &testRulesStore{}
+		claims = testExpiryClaimer{}
+		svc1   = NewService(zap.NewNop(), s)
+		svc2   = NewService(zap.NewNop(), s)
+
+		expired int
+	)
+
+	now = func() time.Time { return ref }
+	nextID = testNextID()
+	defer func() { now, nextID = time.Now, id.Next }()
+
+	for _, svc := range []*service{svc1, svc2} {
+		svc.Coordinate(claims)
+		svc.OnExpire(func(_ context.Context, rr RuleSet) { expired += len(rr) })
+		req.NoError(svc.Grant(ctx, &Rule{RoleID: 1, Resource: "res", Operation: "op", Access: Allow, NotAfter: &past}))
+	}
+
+	svc1.expireTick(ctx, ref)
+	svc2.expireTick(ctx, ref)
+
+	req.Equal(1, expired)
+	req.Len(s.deleted, 1)
+}
+
+func TestService_Rollback(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   = &testRulesStore{}
+		svc = NewService(zap.NewNop(), s)
+
+		changeSetID = func() uint64 { return s.changes[len(s.changes)-1].ChangeSetID }
+	)
+
+	nextID = testNextID()
+	defer func() { nextID = id.Next }()
+
+	req.NoError(svc.Grant(ctx,
+		AllowRule(1, "res/1", "read"),
+		AllowRule(2, "res/1", "read"),
+	))
+
+	req.Len(s.changes, 2)
+	req.Equal(s.changes[0].ChangeSetID, s.changes[1].ChangeSetID)
+	v1 := changeSetID()
+
+	// re-granting the same rules is not a change
+	req.NoError(svc.Grant(ctx, AllowRule(1, "res/1", "read")))
+	req.Len(s.changes, 2)
+
+	req.NoError(svc.Grant(ctx,
+		DenyRule(1, "res/1", "read"),
+		InheritRule(2, "res/1", "read"),
+		AllowRule(1, "res/2", "read"),
+	))
+	req.Len(s.changes, 5)
+
+	// rollback limited to role 2
+	rr, err := svc.Rollback(ctx, v1, 2, "")
+	req.NoError(err)
+	req.Len(rr, 1)
+	req.Equal(Allow, rr[0].Access)
+	req.Len(svc.Rules(), 3)
+
+	// rollback of role 1 on a single resource
+	rr, err = svc.Rollback(ctx, v1, 1, "res/1")
+	req.NoError(err)
+	req.Len(rr, 1)
+	req.Equal(Allow, rr[0].Access)
+
+	// full rollback removes rules created later
+	rr, err = svc.Rollback(ctx, v1, 0, "res/*")
+	req.NoError(err)
+	req.Len(rr, 1)
+	req.Equal("res/2", rr[0].Resource)
+	req.Equal(Inherit, rr[0].Access)
+
+	req.Len(svc.Rules(), 2)
+	for _, r := range svc.Rules() {
+		req.Equal("res/1", r.Resource)
+		req.Equal(Allow, r.Access)
+	}
+
+	// nothing left to roll back
+	rr, err = svc.Rollback(ctx, v1, 0, "")
+	req.NoError(err)
+	req.Empty(rr)
+}
+
 // goos: linux
 // goarch: amd64
 // pkg: github.com/cortezaproject/corteza/server/pkg/rbac
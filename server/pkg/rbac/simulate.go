@@ -0,0 +1,158 @@
+package rbac
+
+import (
+	"sort"
+	"time"
+)
+
+type (
+	// SimulationSubject is a user or a (hypothetical) set of roles
+	// that permissions are evaluated for
+	//
+	// When At is set, rules are evaluated as they were at that time
+	// (reconstructed from the rule change history)
+	SimulationSubject struct {
+		UserID uint64
+		Roles  []uint64
+		At     time.Time
+	}
+
+	// Snapshot holds rules as they were at some point in time
+	Snapshot struct {
+		at      time.Time
+		roles   []*Role
+		indexed *ruleIndex
+	}
+
+	// Evaluation is a single entry of the access matrix
+	Evaluation struct {
+		Resource   string     `json:"resource"`
+		Operation  string     `json:"operation"`
+		Access     Access     `json:"access"`
+		Resolution resolution `json:"resolution,omitempty"`
+
+		// Rule that decided the access; nil when no rule matched
+		Rule *Rule `json:"rule,omitempty"`
+	}
+
+	EvaluationSet []*Evaluation
+
+	// EvaluationDiff holds two evaluations of the same operation on the same resource
+	EvaluationDiff struct {
+		Resource  string      `json:"resource"`
+		Operation string      `json:"operation"`
+		A         *Evaluation `json:"a"`
+		B         *Evaluation `json:"b"`
+	}
+
+	EvaluationDiffSet []*EvaluationDiff
+)
+
+// Trace checks rules from the snapshot and returns all decision trace log
+func (s *Snapshot) Trace(ses Session, op string, res Resource) *Trace {
+	return traceAt(s.indexed, s.roles, ses, op, res, s.at)
+}
+
+// Evaluate converts check traces to access matrix entries
+//
+// Entries are sorted by resource and operation
+func Evaluate(tt ...*Trace) (out EvaluationSet) {
+	out = make(EvaluationSet, 0, len(tt))
+	for _, t := range tt {
+		if t == nil {
+			continue
+		}
+
+		out = append(out, &Evaluation{
+			Resource:   t.Resource,
+			Operation:  t.Operation,
+			Access:     t.Access,
+			Resolution: t.Resolution,
+			Rule:       decidingRule(t),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Resource != out[j].Resource {
+			return out[i].Resource < out[j].Resource
+		}
+
+		return out[i].Operation < out[j].Operation
+	})
+
+	return
+}
+
+// Diff returns all operations on resources with different access in a and b
+//
+// Operations evaluated only in one of the sets are compared to Inherit
+func Diff(a, b EvaluationSet) (out EvaluationDiffSet) {
+	var (
+		key = func(e *Evaluation) string { return e.Resource + "\x00" + e.Operation }
+
+		idx = make(map[string]*EvaluationDiff)
+		add = func(e *Evaluation) *EvaluationDiff {
+			k := key(e)
+			if _, ok := idx[k]; !ok {
+				idx[k] = &EvaluationDiff{Resource: e.Resource, Operation: e.Operation}
+				out = append(out, idx[k])
+			}
+
+			return idx[k]
+		}
+
+		access = func(e *Evaluation) Access {
+			if e == nil {
+				return Inherit
+			}
+
+			return e.Access
+		}
+	)
+
+	for _, e := range a {
+		add(e).A = e
+	}
+
+	for _, e := range b {
+		add(e).B = e
+	}
+
+	// keep only the differences
+	var (
+		dd = out[:0]
+	)
+
+	for _, d := range out {
+		if access(d.A) != access(d.B) {
+			dd = append(dd, d)
+		}
+	}
+
+	out = dd
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Resource != out[j].Resource {
+			return out[i].Resource < out[j].Resource
+		}
+
+		return out[i].Operation < out[j].Operation
+	})
+
+	return
+}
+
+// decidingRule returns the rule from the trace that resulted in the trace's access
+//
+// Check stops on the first deny and allows only when all matching rules
+// of the most relevant role kind allow, so the first matching rule
+// with the same access is the deciding one.
+func decidingRule(t *Trace) *Rule {
+	for _, r := range t.Rules {
+		if r.Access == t.Access {
+			return r
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEvaluateTraces(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		deny  = DenyRule(2, "res", "op")
+		allow = AllowRule(1, "res", "op")
+
+		ee = Evaluate(
+			&Trace{Resource: "res", Operation: "read", Access: Inherit, Resolution: noMatch},
+			nil,
+			&Trace{Resource: "res", Operation: "op", Access: Deny, Rules: RuleSet{allow, deny}},
+			&Trace{Resource: "abc", Operation: "op", Access: Allow, Rules: RuleSet{allow}},
+		)
+	)
+
+	req.Len(ee, 3)
+
+	req.Equal("abc", ee[0].Resource)
+	req.Equal(allow, ee[0].Rule)
+
+	req.Equal("op", ee[1].Operation)
+	req.Equal(deny, ee[1].Rule)
+
+	req.Equal("read", ee[2].Operation)
+	req.Nil(ee[2].Rule)
+	req.Equal(noMatch, ee[2].Resolution)
+}
+
+func TestDiff(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		a = EvaluationSet{
+			{Resource: "res", Operation: "read", Access: Allow},
+			{Resource: "res", Operation: "update", Access: Allow},
+			{Resource: "res", Operation: "delete", Access: Deny},
+		}
+
+		b = EvaluationSet{
+			{Resource: "res", Operation: "read", Access: Allow},
+			{Resource: "res", Operation: "update", Access: Deny},
+			{Resource: "res", Operation: "create", Access: Allow},
+		}
+
+		dd = Diff(a, b)
+	)
+
+	req.Len(dd, 3)
+
+	req.Equal("create", dd[0].Operation)
+	req.Nil(dd[0].A)
+	req.Equal(Allow, dd[0].B.Access)
+
+	req.Equal("delete", dd[1].Operation)
+	req.Equal(Deny, dd[1].A.Access)
+	req.Nil(dd[1].B)
+
+	req.Equal("update", dd[2].Operation)
+	req.Equal(Allow, dd[2].A.Access)
+	req.Equal(Deny, dd[2].B.Access)
+
+	req.Empty(Diff(a, a))
+}
+
+func TestService_At(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		ref   = time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+		from  = ref.Add(time.Hour)
+		until = from.Add(time.Hour)
+
+		svc = NewService(zap.NewNop(), &testRulesStore{})
+		ses = ParamsToSession(ctx, 0, 1)
+		res = NewResource("res")
+
+		traceAt = func(at time.Time, op string) Access {
+			s, err := svc.At(ctx, at)
+			req.NoError(err)
+			return s.Trace(ses, op, res).Access
+		}
+	)
+
+	defer func() { now, nextID = time.Now, id.Next }()
+	nextID = testNextID()
+
+	svc.UpdateRoles(CommonRole.Make(1, "common"))
+
+	// rules are granted, changed and revoked an hour apart
+	now = func() time.Time { return ref }
+	req.NoError(svc.Grant(ctx,
+		AllowRule(1, "res", "op"),
+		&Rule{RoleID: 1, Resource: "res", Operation: "timed", Access: Allow, NotBefore: &from, NotAfter: &until},
+	))
+
+	now = func() time.Time { return ref.Add(time.Hour) }
+	req.NoError(svc.Grant(ctx, DenyRule(1, "res", "op")))
+
+	now = func() time.Time { return ref.Add(2 * time.Hour) }
+	req.NoError(svc.Grant(ctx, InheritRule(1, "res", "op")))
+
+	req.Equal(Inherit, traceAt(ref.Add(-time.Minute), "op"))
+	req.Equal(Allow, traceAt(ref.Add(time.Minute), "op"))
+	req.Equal(Deny, traceAt(ref.Add(time.Hour+time.Minute), "op"))
+	req.Equal(Inherit, traceAt(ref.Add(2*time.Hour+time.Minute), "op"))
+
+	// validity window is checked against the same time
+	req.Equal(Inherit, traceAt(from.Add(-time.Minute), "timed"))
+	req.Equal(Allow, traceAt(from.Add(time.Minute), "timed"))
+	req.Equal(Inherit, traceAt(until, "timed"))
+
+	// current rules are left intact
+	req.Equal(Inherit, svc.Trace(ses, "op", res).Access)
+}
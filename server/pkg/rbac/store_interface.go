@@ -5,11 +5,19 @@ import (
 )
 
 type (
-	// All
-	rbacRulesStore interface {
+	// RulesStore loads and flushes the rules and their changes
+	RulesStore interface {
 		SearchRbacRules(ctx context.Context, f RuleFilter) (RuleSet, RuleFilter, error)
 		UpsertRbacRule(ctx context.Context, rr ...*Rule) error
 		DeleteRbacRule(ctx context.Context, rr ...*Rule) error
 		TruncateRbacRules(ctx context.Context) error
+
+		SearchRbacRuleChanges(ctx context.Context, f RuleChangeFilter) (RuleChangeSet, RuleChangeFilter, error)
+		CreateRbacRuleChange(ctx context.Context, rr ...*RuleChange) error
 	}
+
+	// RulesStoreTx runs the function in a store transaction
+	//
+	// Store passed to the function is bound to the transaction
+	RulesStoreTx func(ctx context.Context, fn func(context.Context, RulesStore) error) error
 )
@@ -0,0 +1,212 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type (
+	// Claim marks a job as taken by one of the instances
+	//
+	// Job is unique (primary key); first instance that stores the claim
+	// for the job runs it, the rest skip it
+	Claim struct {
+		Job       string    `json:"job"`
+		ClaimedBy string    `json:"claimedBy"`
+		ClaimedAt time.Time `json:"claimedAt"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	ClaimSet []*Claim
+
+	ClaimFilter struct {
+		Job []string `json:"job"`
+
+		// Only claims that expired before the given time
+		ExpiredBefore *time.Time `json:"expiredBefore,omitempty"`
+
+		Limit uint `json:"limit"`
+	}
+
+	// claims coordinates jobs between instances sharing the same store
+	claims struct {
+		log   *zap.Logger
+		store claimStore
+
+		// identifies the instance that claimed the job
+		node string
+
+		l         sync.Mutex
+		lastPurge time.Time
+	}
+)
+
+const (
+	ClaimResourceType = "corteza::generic:scheduler-claim"
+
+	// how often expired claims are removed
+	claimPurgeInterval = time.Minute
+
+	// max number of expired claims removed at once
+	claimPurgeBatch = 500
+)
+
+var (
+	// Global claims
+	gClaims *claims
+)
+
+// SetupClaims configures global job coordination
+//
+// Instances sharing the same store claim jobs before running them
+// so that each job runs exactly once across the cluster
+func SetupClaims(log *zap.Logger, s claimStore) {
+	gClaims = NewClaims(log, s, "")
+}
+
+// Claims returns global job coordination
+//
+// When claims are not configured, nil is returned; all jobs
+// claimed on nil claims are granted
+func Claims() *claims {
+	return gClaims
+}
+
+// NewClaims initializes job coordination with the given store
+//
+// Node identifies the instance; when empty, hostname and process ID are used
+func NewClaims(log *zap.Logger, s claimStore, node string) *claims {
+	if node == "" {
+		host, _ := os.Hostname()
+		node = fmt.Sprintf("%s:%d", host, os.Getpid())
+	}
+
+	return &claims{
+		log:   log.Named("scheduler.claims").With(zap.String("node", node)),
+		store: s,
+		node:  node,
+	}
+}
+
+// ClaimJob builds job identifier from the job name and the time slot
+//
+// Instances with slightly skewed clocks running the same job
+// around the same time end up with the same identifier
+func ClaimJob(job string, at time.Time, interval time.Duration) string {
+	return fmt.Sprintf("%s@%d", job, at.Round(interval).Unix())
+}
+
+// Claim tries to claim the job for this instance
+//
+// Returns true when job was claimed and should be run by the caller.
+// Claim expires after the given TTL; job must not be retried after
+// the claim expires.
+func (c *claims) Claim(ctx context.Context, job string, ttl time.Duration) (bool, error) {
+	if c == nil {
+		return true, nil
+	}
+
+	var (
+		ts = now().UTC()
+
+		claim = &Claim{
+			Job:       job,
+			ClaimedBy: c.node,
+			ClaimedAt: ts,
+			ExpiresAt: ts.Add(ttl),
+		}
+	)
+
+	c.purge(ctx, ts)
+
+	if err := c.store.CreateSchedulerClaim(ctx, claim); err != nil {
+		// creating claim fails when job is already claimed
+		// by another instance; make sure that is the case
+		if existing, _ := c.store.LookupSchedulerClaimByJob(ctx, job); existing != nil {
+			c.log.Debug("job claimed by another instance",
+				zap.String("job", job),
+				zap.String("claimedBy", existing.ClaimedBy),
+			)
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not claim job %s: %w", job, err)
+	}
+
+	return true, nil
+}
+
+// Renew extends claims of this instance on the given jobs
+//
+// Long-running jobs need to renew their claims before they expire.
+// Jobs that are no longer claimed by this instance (claim expired and
+// was taken by another instance) are returned.
+func (c *claims) Renew(ctx context.Context, ttl time.Duration, jobs ...string) (lost []string, err error) {
+	if c == nil {
+		return
+	}
+
+	var (
+		ts = now().UTC()
+
+		renewed bool
+	)
+
+	for _, job := range jobs {
+		// claim is renewed only if still held by this instance
+		renewed, err = c.store.RenewSchedulerClaim(ctx, &Claim{Job: job, ClaimedBy: c.node, ExpiresAt: ts.Add(ttl)})
+		if err != nil {
+			return nil, fmt.Errorf("could not renew claim on job %s: %w", job, err)
+		}
+
+		if !renewed {
+			lost = append(lost, job)
+		}
+	}
+
+	return
+}
+
+// Release removes claims of this instance on the given jobs
+//
+// Released jobs can be claimed by other instances right away
+func (c *claims) Release(ctx context.Context, jobs ...string) (err error) {
+	if c == nil {
+		return
+	}
+
+	for _, job := range jobs {
+		// claims held by other instances are left as they are
+		if _, err = c.store.ReleaseSchedulerClaim(ctx, &Claim{Job: job, ClaimedBy: c.node}); err != nil {
+			return fmt.Errorf("could not release claim on job %s: %w", job, err)
+		}
+	}
+
+	return
+}
+
+// purge removes expired claims at most once per purge interval
+func (c *claims) purge(ctx context.Context, ts time.Time) {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	if ts.Sub(c.lastPurge) < claimPurgeInterval {
+		return
+	}
+
+	c.lastPurge = ts
+
+	cc, _, err := c.store.SearchSchedulerClaims(ctx, ClaimFilter{ExpiredBefore: &ts, Limit: claimPurgeBatch})
+	if err == nil && len(cc) > 0 {
+		err = c.store.DeleteSchedulerClaim(ctx, cc...)
+	}
+
+	if err != nil {
+		c.log.Warn("failed to remove expired claims", zap.Error(err))
+	}
+}
@@ -0,0 +1,146 @@
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// several instances, each with its own connection to the same database,
+// claim the same jobs at the same time
+func TestClaims_Claim(t *testing.T) {
+	const (
+		instances = 4
+		jobs      = 20
+	)
+
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		claim = make([]func(context.Context, string, time.Duration) (bool, error), instances)
+
+		wg      sync.WaitGroup
+		mux     sync.Mutex
+		granted = make(map[string][]int)
+		errs    []error
+	)
+
+	for i := range claim {
+		s, err := sqlite.ConnectInMemory(ctx)
+		req.NoError(err)
+
+		if i == 0 {
+			req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+			req.NoError(s.TruncateSchedulerClaims(ctx))
+		}
+
+		claim[i] = scheduler.NewClaims(zap.NewNop(), s, fmt.Sprintf("node-%d", i)).Claim
+	}
+
+	for j := 0; j < jobs; j++ {
+		job := scheduler.ClaimJob("test", time.Now().Add(time.Duration(j)*time.Minute), time.Minute)
+
+		for i := range claim {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				ok, err := claim[i](ctx, job, time.Hour)
+
+				mux.Lock()
+				defer mux.Unlock()
+
+				if err != nil {
+					errs = append(errs, err)
+				} else if ok {
+					granted[job] = append(granted[job], i)
+				}
+			}(i)
+		}
+	}
+
+	wg.Wait()
+
+	req.Empty(errs)
+	req.Len(granted, jobs)
+	for job, ii := range granted {
+		req.Len(ii, 1, "job %s claimed by more than one instance", job)
+	}
+}
+
+func TestClaims_ClaimNotConfigured(t *testing.T) {
+	ok, err := scheduler.Claims().Claim(context.Background(), "job", time.Hour)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestClaims_RenewRelease(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		job = "automation-session:42"
+	)
+
+	s, err := sqlite.ConnectInMemory(ctx)
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(s.TruncateSchedulerClaims(ctx))
+
+	var (
+		owner = scheduler.NewClaims(zap.NewNop(), s, "node-0")
+		other = scheduler.NewClaims(zap.NewNop(), s, "node-1")
+	)
+
+	ok, err := owner.Claim(ctx, job, time.Minute)
+	req.NoError(err)
+	req.True(ok)
+
+	lost, err := owner.Renew(ctx, time.Hour, job)
+	req.NoError(err)
+	req.Empty(lost)
+
+	c, err := s.LookupSchedulerClaimByJob(ctx, job)
+	req.NoError(err)
+	req.True(c.ExpiresAt.After(time.Now().Add(time.Minute)))
+
+	lost, err = other.Renew(ctx, time.Hour, job)
+	req.NoError(err)
+	req.Equal([]string{job}, lost)
+
+	// only the owner can release the claim
+	req.NoError(other.Release(ctx, job))
+	ok, err = other.Claim(ctx, job, time.Minute)
+	req.NoError(err)
+	req.False(ok)
+
+	req.NoError(owner.Release(ctx, job))
+	ok, err = other.Claim(ctx, job, time.Minute)
+	req.NoError(err)
+	req.True(ok)
+
+	// previous owner can not renew or release the claim taken over by another instance
+	lost, err = owner.Renew(ctx, time.Hour, job)
+	req.NoError(err)
+	req.Equal([]string{job}, lost)
+
+	req.NoError(owner.Release(ctx, job))
+	c, err = s.LookupSchedulerClaimByJob(ctx, job)
+	req.NoError(err)
+	req.Equal("node-1", c.ClaimedBy)
+	req.True(c.ExpiresAt.Before(time.Now().Add(time.Hour)))
+
+	// released claim can not be renewed
+	req.NoError(other.Release(ctx, job))
+	lost, err = other.Renew(ctx, time.Hour, job)
+	req.NoError(err)
+	req.Equal([]string{job}, lost)
+}
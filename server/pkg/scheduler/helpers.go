@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cortezaproject/corteza/server/pkg/logger"
@@ -48,6 +49,24 @@ func onInterval(now time.Time, ii ...string) (bool, error) {
 	return false, nil
 }
 
+// NextInterval parses the crontab expression and returns the first time
+// after the given time that matches it
+//
+// Location of the given time is used to evaluate the expression.
+func NextInterval(after time.Time, i string) (time.Time, error) {
+	exp, err := cronexpr.Parse(i)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := exp.Next(after)
+	if next.IsZero() {
+		return next, fmt.Errorf("crontab expression %q never matches", i)
+	}
+
+	return next, nil
+}
+
 // OnTimestamp parses all given strings as RFC3339 timestamps and returns true if any of them matches current time
 func OnTimestamp(tt ...string) bool {
 	match, err := onTimestamp(now(), tt...)
@@ -77,6 +77,74 @@ func TestOnInterval(t *testing.T) {
 	OnInterval(":P")
 }
 
+func TestNextInterval(t *testing.T) {
+	cases := []struct {
+		name  string
+		after string
+		i     string
+		next  string
+		err   bool
+	}{
+		{
+			"next minute",
+			"2019-10-10T10:11:00Z",
+			"* * * * *",
+			"2019-10-10T10:12:00Z",
+			false,
+		},
+		{
+			"not full minute",
+			"2019-10-10T10:11:30Z",
+			"*/5 * * * *",
+			"2019-10-10T10:15:00Z",
+			false,
+		},
+		{
+			"next day",
+			"2019-10-10T10:11:00Z",
+			"0 8 * * *",
+			"2019-10-11T08:00:00Z",
+			false,
+		},
+		{
+			"timezone",
+			"2019-10-10T10:11:00+02:00",
+			"0 8 * * *",
+			"2019-10-11T08:00:00+02:00",
+			false,
+		},
+		{
+			"never",
+			"2019-10-10T10:11:00Z",
+			"0 0 30 2 *",
+			"",
+			true,
+		},
+		{
+			"invalid format",
+			"2019-10-10T10:11:00Z",
+			":P",
+			"",
+			true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			after, err := time.Parse(time.RFC3339, c.after)
+			assert.NoError(t, err)
+
+			next, err := NextInterval(after, c.i)
+			if c.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.next, next.Format(time.RFC3339))
+		})
+	}
+}
+
 func TestOnTimestamp(t *testing.T) {
 	cases := []struct {
 		name  string
@@ -18,6 +18,10 @@ type (
 		interval   time.Duration
 		dispatcher dispatcher
 
+		// when set, ticks are claimed before events are dispatched
+		// so that only one of the instances dispatches them
+		claims *claims
+
 		// Read & write locking
 		l sync.RWMutex
 
@@ -35,6 +39,10 @@ const (
 
 	// There should not be more than 2 per each service (<no of services> * 2 [interval + timestamp])
 	maxEvents = 16
+
+	// name of the claimed job and how long claims for dispatched ticks are kept
+	tickJob      = "scheduler"
+	tickClaimTTL = time.Hour
 )
 
 var (
@@ -82,6 +90,15 @@ func (svc *service) OnTick(events ...eventbus.Event) {
 	svc.events = append(svc.events, events...)
 }
 
+// Coordinate dispatching with other instances sharing the same claims store
+//
+// Each tick is dispatched by only one of the instances
+func (svc *service) Coordinate(c *claims) {
+	svc.l.Lock()
+	defer svc.l.Unlock()
+	svc.claims = c
+}
+
 func (svc *service) Stop() {
 	svc.l.Lock()
 	defer svc.l.Unlock()
@@ -137,12 +154,12 @@ func (svc *service) watch(ctx context.Context) {
 	defer svc.Stop()
 
 	// start with first interval
-	svc.dispatch(ctx)
+	svc.dispatch(ctx, now())
 
 	for {
 		select {
 		case <-svc.ticker().C:
-			svc.dispatch(ctx)
+			svc.dispatch(ctx, now())
 
 		case <-ctx.Done():
 			svc.log.Debug("done")
@@ -161,10 +178,21 @@ func (svc *service) Started() (started bool) {
 	return svc.ticker() != nil
 }
 
-func (svc *service) dispatch(ctx context.Context) {
+func (svc *service) dispatch(ctx context.Context, at time.Time) {
 	svc.l.RLock()
 	defer svc.l.RUnlock()
 
+	claimed, err := svc.claims.Claim(ctx, ClaimJob(tickJob, at, svc.interval), tickClaimTTL)
+	if err != nil {
+		svc.log.Warn("failed to claim scheduled tick", zap.Error(err))
+		return
+	}
+
+	if !claimed {
+		// dispatched by another instance
+		return
+	}
+
 	ee := make([]eventbus.Event, len(svc.events))
 	for e := range svc.events {
 		ee[e] = svc.events[e]
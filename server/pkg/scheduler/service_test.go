@@ -2,6 +2,8 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,8 +19,87 @@ type (
 		eType string
 		match func(matcher eventbus.ConstraintMatcher) bool
 	}
+
+	mockDispatcher struct {
+		l     sync.Mutex
+		count int
+	}
+
+	// mockClaimStore mimics primary key on the claimed job
+	mockClaimStore struct {
+		l  sync.Mutex
+		cc map[string]*Claim
+	}
 )
 
+func (d *mockDispatcher) WaitFor(context.Context, eventbus.Event) error {
+	d.l.Lock()
+	defer d.l.Unlock()
+	d.count++
+	return nil
+}
+
+func (s *mockClaimStore) SearchSchedulerClaims(_ context.Context, f ClaimFilter) (set ClaimSet, _ ClaimFilter, _ error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for _, c := range s.cc {
+		if f.ExpiredBefore == nil || c.ExpiresAt.Before(*f.ExpiredBefore) {
+			set = append(set, c)
+		}
+	}
+	return set, f, nil
+}
+
+func (s *mockClaimStore) LookupSchedulerClaimByJob(_ context.Context, job string) (*Claim, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if c, ok := s.cc[job]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+
+func (s *mockClaimStore) CreateSchedulerClaim(_ context.Context, rr ...*Claim) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for _, c := range rr {
+		if _, ok := s.cc[c.Job]; ok {
+			return fmt.Errorf("duplicate")
+		}
+		s.cc[c.Job] = c
+	}
+	return nil
+}
+
+func (s *mockClaimStore) RenewSchedulerClaim(_ context.Context, claim *Claim) (bool, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if c, ok := s.cc[claim.Job]; ok && c.ClaimedBy == claim.ClaimedBy {
+		c.ExpiresAt = claim.ExpiresAt
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *mockClaimStore) ReleaseSchedulerClaim(_ context.Context, claim *Claim) (bool, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if c, ok := s.cc[claim.Job]; ok && c.ClaimedBy == claim.ClaimedBy {
+		delete(s.cc, claim.Job)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *mockClaimStore) DeleteSchedulerClaim(_ context.Context, rr ...*Claim) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for _, c := range rr {
+		delete(s.cc, c.Job)
+	}
+	return nil
+}
+
 func (e mockEvent) ResourceType() string {
 	return e.rType
 }
@@ -60,3 +141,49 @@ func TestMainServiceFunctions(t *testing.T) {
 	time.Sleep(actionWait)
 	r.False(gScheduler.Started())
 }
+
+func TestCoordinatedDispatch(t *testing.T) {
+	const (
+		instances = 3
+		interval  = time.Minute
+	)
+
+	var (
+		r   = require.New(t)
+		ctx = context.Background()
+		s   = &mockClaimStore{cc: make(map[string]*Claim)}
+		d   = &mockDispatcher{}
+
+		tick = time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+
+		svcs = make([]*service, instances)
+	)
+
+	for i := range svcs {
+		svcs[i] = NewService(zap.NewNop(), d, interval)
+		svcs[i].OnTick(&mockEvent{}, &mockEvent{})
+		svcs[i].Coordinate(NewClaims(zap.NewNop(), s, fmt.Sprintf("node-%d", i)))
+	}
+
+	// instances tick at roughly the same time
+	for i, svc := range svcs {
+		svc.dispatch(ctx, tick.Add(time.Duration(i-1)*time.Second))
+	}
+
+	// and on the next tick
+	for _, svc := range svcs {
+		svc.dispatch(ctx, tick.Add(interval))
+	}
+
+	r.Eventually(func() bool {
+		d.l.Lock()
+		defer d.l.Unlock()
+		return d.count == 4
+	}, time.Second, time.Millisecond)
+
+	// nothing else is dispatched
+	time.Sleep(10 * time.Millisecond)
+	d.l.Lock()
+	defer d.l.Unlock()
+	r.Equal(4, d.count)
+}
@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+)
+
+type (
+	claimStore interface {
+		SearchSchedulerClaims(ctx context.Context, f ClaimFilter) (ClaimSet, ClaimFilter, error)
+		LookupSchedulerClaimByJob(ctx context.Context, job string) (*Claim, error)
+		CreateSchedulerClaim(ctx context.Context, rr ...*Claim) error
+		DeleteSchedulerClaim(ctx context.Context, rr ...*Claim) error
+		RenewSchedulerClaim(ctx context.Context, claim *Claim) (bool, error)
+		ReleaseSchedulerClaim(ctx context.Context, claim *Claim) (bool, error)
+	}
+)
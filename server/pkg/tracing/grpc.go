@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	// carrier for trace context over gRPC metadata
+	metadataCarrier metadata.MD
+)
+
+// GrpcUnaryClientInterceptor starts client span for each unary call
+// and propagates trace context with the outgoing metadata
+func GrpcUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+		ctx, span := startGrpcSpan(ctx, method)
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		End(span, err)
+		return
+	}
+}
+
+// GrpcStreamClientInterceptor propagates trace context with the outgoing metadata
+// of the streaming calls
+//
+// Span covers only the stream setup
+func GrpcStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (cs grpc.ClientStream, err error) {
+		ctx, span := startGrpcSpan(ctx, method)
+		cs, err = streamer(ctx, desc, cc, method, opts...)
+		End(span, err)
+		return
+	}
+}
+
+func startGrpcSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	var (
+		// method is in /package.service/method format
+		service, name, _ = strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	)
+
+	ctx, span := Tracer().Start(
+		ctx,
+		strings.TrimPrefix(method, "/"),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCService(service),
+			semconv.RPCMethod(name),
+		),
+	)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func (c metadataCarrier) Get(key string) string {
+	if vv := metadata.MD(c).Get(key); len(vv) > 0 {
+		return vv[0]
+	}
+
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	out := make([]string, 0, len(c))
+	for k := range c {
+		out = append(out, k)
+	}
+
+	return out
+}
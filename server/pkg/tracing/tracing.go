@@ -0,0 +1,145 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// name of the tracer used for all spans created by corteza
+	instrumentationName = "github.com/cortezaproject/corteza/server"
+)
+
+var (
+	// set when tracing is enabled
+	provider *sdktrace.TracerProvider
+)
+
+// Setup configures exporter and registers global tracer provider
+//
+// When tracing is disabled, global (noop) provider is left intact
+// and all spans created through this package are discarded
+func Setup(ctx context.Context, opt options.TracingOpt) (err error) {
+	// propagation is configured even when tracing is disabled
+	// so that trace context is not lost between services
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !opt.Enabled {
+		return nil
+	}
+
+	exp, err := makeExporter(ctx, opt)
+	if err != nil {
+		return
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opt.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(opt.ServiceName),
+			semconv.ServiceVersion(version.Version),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+	return nil
+}
+
+// Enabled returns true when traces are collected and exported
+func Enabled() bool {
+	return provider != nil
+}
+
+// Shutdown flushes all pending spans and stops the exporter
+func Shutdown(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+
+	return provider.Shutdown(ctx)
+}
+
+func makeExporter(ctx context.Context, opt options.TracingOpt) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(opt.Exporter) {
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+
+	case "otlp", "":
+		switch strings.ToLower(opt.OtlpProtocol) {
+		case "grpc", "":
+			oo := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opt.OtlpEndpoint)}
+			if opt.OtlpInsecure {
+				oo = append(oo, otlptracegrpc.WithInsecure())
+			}
+
+			return otlptracegrpc.New(ctx, oo...)
+
+		case "http":
+			oo := []otlptracehttp.Option{otlptracehttp.WithEndpoint(opt.OtlpEndpoint)}
+			if opt.OtlpInsecure {
+				oo = append(oo, otlptracehttp.WithInsecure())
+			}
+
+			return otlptracehttp.New(ctx, oo...)
+
+		default:
+			return nil, fmt.Errorf("unsupported OTLP protocol %q", opt.OtlpProtocol)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter %q", opt.Exporter)
+	}
+}
+
+// Tracer returns tracer from the global provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.Version))
+}
+
+// Start creates a new span as a child of the span in the context (if any)
+func Start(ctx context.Context, name string, aa ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(aa...))
+}
+
+// End records the error (if any) and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// WithSpanContext returns context with the given span context as the parent
+// of the spans that will be started from it
+//
+// Used when work is done outside the original context (async workers)
+func WithSpanContext(ctx context.Context, sc trace.SpanContext) context.Context {
+	if !sc.IsValid() {
+		return ctx
+	}
+
+	return trace.ContextWithSpanContext(ctx, sc)
+}
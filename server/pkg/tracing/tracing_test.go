@@ -0,0 +1,166 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// registers span recorder as a global tracer provider
+func recorder(t *testing.T) *tracetest.SpanRecorder {
+	var (
+		sr = tracetest.NewSpanRecorder()
+		tp = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+		prevTP = otel.GetTracerProvider()
+		prevP  = otel.GetTextMapPropagator()
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevP)
+	})
+
+	return sr
+}
+
+func TestSetup(t *testing.T) {
+	req := require.New(t)
+
+	req.NoError(Setup(context.Background(), options.TracingOpt{}))
+	req.False(Enabled())
+
+	req.Error(Setup(context.Background(), options.TracingOpt{Enabled: true, Exporter: "zipkin"}))
+	req.Error(Setup(context.Background(), options.TracingOpt{Enabled: true, OtlpProtocol: "udp"}))
+	req.False(Enabled())
+}
+
+func TestEnd(t *testing.T) {
+	var (
+		req = require.New(t)
+		sr  = recorder(t)
+	)
+
+	_, span := Start(context.Background(), "ok")
+	End(span, nil)
+
+	_, span = Start(context.Background(), "failed")
+	End(span, context.Canceled)
+
+	ss := sr.Ended()
+	req.Len(ss, 2)
+	req.Equal(codes.Unset, ss[0].Status().Code)
+	req.Equal(codes.Error, ss[1].Status().Code)
+	req.Len(ss[1].Events(), 1)
+}
+
+func TestHttpMiddleware(t *testing.T) {
+	var (
+		req = require.New(t)
+		sr  = recorder(t)
+		r   = chi.NewRouter()
+
+		parent = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+		})
+
+		handled trace.SpanContext
+	)
+
+	r.Use(HttpMiddleware)
+	r.Get("/records/{recordID}", func(w http.ResponseWriter, r *http.Request) {
+		handled = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	hr := httptest.NewRequest(http.MethodGet, "/records/42", nil)
+	otel.GetTextMapPropagator().Inject(trace.ContextWithSpanContext(context.Background(), parent), propagation.HeaderCarrier(hr.Header))
+
+	r.ServeHTTP(httptest.NewRecorder(), hr)
+
+	ss := sr.Ended()
+	req.Len(ss, 1)
+	req.Equal("GET /records/{recordID}", ss[0].Name())
+	req.Equal(trace.SpanKindServer, ss[0].SpanKind())
+	req.Equal(parent.TraceID(), ss[0].SpanContext().TraceID())
+	req.Equal(parent.SpanID(), ss[0].Parent().SpanID())
+	req.Equal(ss[0].SpanContext().SpanID(), handled.SpanID())
+	req.Equal(codes.Error, ss[0].Status().Code)
+}
+
+func TestTransport(t *testing.T) {
+	var (
+		req = require.New(t)
+		sr  = recorder(t)
+
+		received string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+
+	ctx, span := Start(context.Background(), "parent")
+	hr, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	req.NoError(err)
+
+	rsp, err := Client(http.DefaultClient).Do(hr)
+	req.NoError(err)
+	req.NoError(rsp.Body.Close())
+	span.End()
+
+	// original request must not be modified
+	req.Empty(hr.Header.Get("traceparent"))
+
+	ss := sr.Ended()
+	req.Len(ss, 2)
+	req.Equal(trace.SpanKindClient, ss[0].SpanKind())
+	req.Equal(span.SpanContext().SpanID(), ss[0].Parent().SpanID())
+	req.Contains(received, ss[0].SpanContext().SpanID().String())
+}
+
+func TestGrpcUnaryClientInterceptor(t *testing.T) {
+	var (
+		req = require.New(t)
+		sr  = recorder(t)
+
+		md metadata.MD
+	)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "foo", "bar")
+
+	err := GrpcUnaryClientInterceptor()(ctx, "/corredor.ServerScripts/Exec", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		},
+	)
+
+	req.NoError(err)
+
+	ss := sr.Ended()
+	req.Len(ss, 1)
+	req.Equal("corredor.ServerScripts/Exec", ss[0].Name())
+	req.Equal([]string{"bar"}, md.Get("foo"))
+	req.Len(md.Get("traceparent"), 1)
+	req.Contains(md.Get("traceparent")[0], ss[0].SpanContext().SpanID().String())
+}
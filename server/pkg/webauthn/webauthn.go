@@ -0,0 +1,393 @@
+// Package webauthn implements relying party side of the
+// Web Authentication (FIDO2) ceremonies
+//
+// It covers what is needed for registering authenticators (security keys,
+// platform authenticators, passkeys) and verifying assertions for
+// second-factor and passwordless authentication.
+//
+// Parsing and verification of the authenticator responses is done
+// with the github.com/go-webauthn/webauthn protocol package; this package
+// only prepares the options and maps the results to stored credentials.
+//
+// Attestation is not requested; statements are verified when present, but
+// attestation certificates are not validated against any trust anchors.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+type (
+	// RelyingParty holds RP identity used in all ceremonies
+	RelyingParty struct {
+		// Effective domain (host) of the RP, e.g. "example.com"
+		ID string
+
+		// Human-palatable name, shown by the browser
+		Name string
+
+		// Allowed origins of the client data, e.g. "https://example.com"
+		Origins []string
+
+		// How long the client should wait for the user, defaults to 2 minutes
+		Timeout time.Duration
+	}
+
+	// User is the account the credential is registered for
+	User struct {
+		ID          []byte
+		Name        string
+		DisplayName string
+	}
+
+	// Credential is a registered public key credential
+	//
+	// It is serialized and stored by the caller
+	Credential struct {
+		ID                []byte   `json:"id"`
+		PublicKey         []byte   `json:"publicKey"`
+		SignCount         uint32   `json:"signCount"`
+		AAGUID            []byte   `json:"aaguid,omitempty"`
+		Transports        []string `json:"transports,omitempty"`
+		AttestationFormat string   `json:"attestationFormat,omitempty"`
+
+		// Discoverable (resident) credential that can be used for passwordless login
+		UserVerified bool `json:"userVerified"`
+	}
+
+	// Base64URL is binary data encoded as unpadded base64url in JSON
+	Base64URL []byte
+
+	CredentialParameter struct {
+		Type string `json:"type"`
+		Alg  int64  `json:"alg"`
+	}
+
+	CredentialDescriptor struct {
+		Type       string    `json:"type"`
+		ID         Base64URL `json:"id"`
+		Transports []string  `json:"transports,omitempty"`
+	}
+
+	// CreationOptions are passed to navigator.credentials.create()
+	CreationOptions struct {
+		Challenge Base64URL `json:"challenge"`
+		RP        struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"rp"`
+		User struct {
+			ID          Base64URL `json:"id"`
+			Name        string    `json:"name"`
+			DisplayName string    `json:"displayName"`
+		} `json:"user"`
+		PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+		Timeout                int64                  `json:"timeout"`
+		ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials,omitempty"`
+		AuthenticatorSelection struct {
+			ResidentKey        string `json:"residentKey"`
+			RequireResidentKey bool   `json:"requireResidentKey"`
+			UserVerification   string `json:"userVerification"`
+		} `json:"authenticatorSelection"`
+		Attestation string `json:"attestation"`
+	}
+
+	// RequestOptions are passed to navigator.credentials.get()
+	RequestOptions struct {
+		Challenge        Base64URL              `json:"challenge"`
+		Timeout          int64                  `json:"timeout"`
+		RPID             string                 `json:"rpId"`
+		AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
+		UserVerification string                 `json:"userVerification"`
+	}
+
+	// RegistrationResponse is JSON encoded result of navigator.credentials.create()
+	//
+	// It is parsed and verified by VerifyRegistration
+	RegistrationResponse struct {
+		protocol.CredentialCreationResponse
+		raw []byte
+	}
+
+	// AssertionResponse is JSON encoded result of navigator.credentials.get()
+	//
+	// It is parsed and verified by VerifyAssertion
+	AssertionResponse struct {
+		protocol.CredentialAssertionResponse
+		raw []byte
+	}
+)
+
+const (
+	ChallengeLength = 32
+
+	credentialType = "public-key"
+
+	defaultTimeout = 2 * time.Minute
+	defaultRPName  = "Corteza"
+)
+
+var (
+	ErrMissingChallenge   = errors.New("webauthn: challenge missing")
+	ErrCredentialMismatch = errors.New("webauthn: credential mismatch")
+	ErrClonedCredential   = errors.New("webauthn: signature counter did not increase, authenticator might be cloned")
+
+	// supportedAlgorithms are offered to the authenticator in order of preference
+	supportedAlgorithms = []webauthncose.COSEAlgorithmIdentifier{
+		webauthncose.AlgES256,
+		webauthncose.AlgEdDSA,
+		webauthncose.AlgES384,
+		webauthncose.AlgES512,
+		webauthncose.AlgPS256,
+		webauthncose.AlgRS256,
+	}
+)
+
+// NewChallenge generates random challenge
+func NewChallenge() ([]byte, error) {
+	c := make([]byte, ChallengeLength)
+	if _, err := rand.Read(c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// CreationOptions prepares options for registering a new credential
+//
+// When passkey is true, authenticator is asked to create a discoverable
+// credential with user verification, so that it can be used for passwordless login
+func (rp RelyingParty) CreationOptions(challenge []byte, u User, exclude []*Credential, passkey bool) *CreationOptions {
+	o := &CreationOptions{
+		Challenge:   challenge,
+		Timeout:     rp.timeout(),
+		Attestation: "none",
+	}
+
+	o.RP.ID = rp.ID
+	o.RP.Name = rp.name()
+	o.User.ID = u.ID
+	o.User.Name = u.Name
+	o.User.DisplayName = u.DisplayName
+
+	for _, alg := range supportedAlgorithms {
+		o.PubKeyCredParams = append(o.PubKeyCredParams, CredentialParameter{Type: credentialType, Alg: int64(alg)})
+	}
+
+	for _, c := range exclude {
+		o.ExcludeCredentials = append(o.ExcludeCredentials, c.descriptor())
+	}
+
+	o.AuthenticatorSelection.ResidentKey = "discouraged"
+	o.AuthenticatorSelection.UserVerification = "discouraged"
+	if passkey {
+		o.AuthenticatorSelection.ResidentKey = "preferred"
+		o.AuthenticatorSelection.UserVerification = "preferred"
+	}
+
+	return o
+}
+
+// RequestOptions prepares options for getting an assertion
+//
+// With empty list of allowed credentials, user can pick any discoverable
+// credential (passwordless login)
+func (rp RelyingParty) RequestOptions(challenge []byte, allow []*Credential, requireUV bool) *RequestOptions {
+	o := &RequestOptions{
+		Challenge:        challenge,
+		Timeout:          rp.timeout(),
+		RPID:             rp.ID,
+		UserVerification: "discouraged",
+	}
+
+	if requireUV {
+		o.UserVerification = "required"
+	}
+
+	for _, c := range allow {
+		o.AllowCredentials = append(o.AllowCredentials, c.descriptor())
+	}
+
+	return o
+}
+
+// VerifyRegistration verifies result of the registration ceremony
+// and returns the new credential
+func (rp RelyingParty) VerifyRegistration(r *RegistrationResponse, challenge []byte) (c *Credential, err error) {
+	if r == nil {
+		return nil, fmt.Errorf("webauthn: registration response missing")
+	}
+
+	if len(challenge) == 0 {
+		return nil, ErrMissingChallenge
+	}
+
+	pcc, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(r.raw))
+	if err != nil {
+		return nil, protocolError(err)
+	}
+
+	if err = pcc.Verify(encodeChallenge(challenge), false, rp.ID, rp.origins()); err != nil {
+		return nil, protocolError(err)
+	}
+
+	var (
+		att = pcc.Response.AttestationObject
+		ad  = att.AuthData
+	)
+
+	if !bytes.Equal(pcc.RawID, ad.AttData.CredentialID) {
+		return nil, ErrCredentialMismatch
+	}
+
+	c = &Credential{
+		ID:                ad.AttData.CredentialID,
+		PublicKey:         ad.AttData.CredentialPublicKey,
+		SignCount:         ad.Counter,
+		AAGUID:            ad.AttData.AAGUID,
+		AttestationFormat: att.Format,
+		UserVerified:      ad.Flags.UserVerified(),
+	}
+
+	for _, t := range pcc.Transports {
+		c.Transports = append(c.Transports, string(t))
+	}
+
+	return c, nil
+}
+
+// VerifyAssertion verifies result of the authentication ceremony
+// against the stored credential
+//
+// On success, credential's signature counter is updated and should be stored
+func (rp RelyingParty) VerifyAssertion(r *AssertionResponse, challenge []byte, c *Credential, requireUV bool) (err error) {
+	if r == nil || c == nil {
+		return fmt.Errorf("webauthn: assertion response or credential missing")
+	}
+
+	if !bytes.Equal(r.RawID, c.ID) {
+		return ErrCredentialMismatch
+	}
+
+	if len(challenge) == 0 {
+		return ErrMissingChallenge
+	}
+
+	pca, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(r.raw))
+	if err != nil {
+		return protocolError(err)
+	}
+
+	if err = pca.Verify(encodeChallenge(challenge), rp.ID, rp.origins(), "", requireUV, c.PublicKey); err != nil {
+		return protocolError(err)
+	}
+
+	// authenticators that do not implement the counter always report 0
+	counter := pca.Response.AuthenticatorData.Counter
+	if (counter != 0 || c.SignCount != 0) && counter <= c.SignCount {
+		return ErrClonedCredential
+	}
+
+	c.SignCount = counter
+	return nil
+}
+
+func (r *RegistrationResponse) UnmarshalJSON(data []byte) error {
+	r.raw = append([]byte{}, data...)
+	return json.Unmarshal(data, &r.CredentialCreationResponse)
+}
+
+func (r *AssertionResponse) UnmarshalJSON(data []byte) error {
+	r.raw = append([]byte{}, data...)
+	return json.Unmarshal(data, &r.CredentialAssertionResponse)
+}
+
+// CredentialID returns ID of the credential used in the assertion
+func (r *AssertionResponse) CredentialID() []byte {
+	return r.RawID
+}
+
+// UserHandle returns user handle (user ID) the authenticator
+// returned with the assertion, if any
+func (r *AssertionResponse) UserHandle() []byte {
+	return r.AssertionResponse.UserHandle
+}
+
+// origins returns allowed origins in the form the protocol package compares them
+func (rp RelyingParty) origins() []string {
+	oo := make([]string, len(rp.Origins))
+	for i, o := range rp.Origins {
+		oo[i] = strings.TrimSuffix(o, "/")
+	}
+
+	return oo
+}
+
+func (rp RelyingParty) timeout() int64 {
+	if rp.Timeout > 0 {
+		return rp.Timeout.Milliseconds()
+	}
+
+	return defaultTimeout.Milliseconds()
+}
+
+func (rp RelyingParty) name() string {
+	if rp.Name != "" {
+		return rp.Name
+	}
+
+	return defaultRPName
+}
+
+func (c *Credential) descriptor() CredentialDescriptor {
+	return CredentialDescriptor{Type: credentialType, ID: c.ID, Transports: c.Transports}
+}
+
+func (b Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+func (b *Base64URL) UnmarshalJSON(data []byte) (err error) {
+	var s string
+	if err = json.Unmarshal(data, &s); err != nil {
+		return
+	}
+
+	*b, err = decodeBase64URL(s)
+	return
+}
+
+func (b Base64URL) String() string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeBase64URL decodes base64url with or without padding
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// challenge as it is expected in the client data
+func encodeChallenge(c []byte) string {
+	return base64.RawURLEncoding.EncodeToString(c)
+}
+
+// protocolError adds debug info the protocol package
+// keeps separately to the error message
+func protocolError(err error) error {
+	var pErr *protocol.Error
+	if errors.As(err, &pErr) && strings.TrimSpace(pErr.DevInfo) != "" {
+		return fmt.Errorf("webauthn: %w (%s)", err, strings.TrimSpace(pErr.DevInfo))
+	}
+
+	return fmt.Errorf("webauthn: %w", err)
+}
@@ -0,0 +1,412 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// software authenticator used for testing
+	testAuthenticator struct {
+		rpID      string
+		origin    string
+		credID    []byte
+		ecKey     *ecdsa.PrivateKey
+		edKey     ed25519.PrivateKey
+		signCount uint32
+		flags     protocol.AuthenticatorFlags
+	}
+
+	// JSON encoded result of the ceremony as sent by the browser
+	testResponse map[string]interface{}
+)
+
+var (
+	testRP = RelyingParty{
+		ID:      "example.com",
+		Origins: []string{"https://example.com"},
+	}
+)
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return &testAuthenticator{
+		rpID:   testRP.ID,
+		origin: testRP.Origins[0],
+		credID: id,
+		ecKey:  k,
+		flags:  protocol.FlagUserPresent,
+	}
+}
+
+func (a *testAuthenticator) coseKey() []byte {
+	if a.edKey != nil {
+		return cborEncode(map[int]interface{}{
+			1:  int(webauthncose.OctetKey),
+			3:  int(webauthncose.AlgEdDSA),
+			-1: int(webauthncose.Ed25519),
+			-2: []byte(a.edKey.Public().(ed25519.PublicKey)),
+		})
+	}
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	a.ecKey.X.FillBytes(x)
+	a.ecKey.Y.FillBytes(y)
+
+	return cborEncode(map[int]interface{}{
+		1:  int(webauthncose.EllipticKey),
+		3:  int(webauthncose.AlgES256),
+		-1: int(webauthncose.P256),
+		-2: x,
+		-3: y,
+	})
+}
+
+func (a *testAuthenticator) authData(attested bool) []byte {
+	h := sha256.Sum256([]byte(a.rpID))
+
+	buf := append([]byte{}, h[:]...)
+	flags := a.flags
+	if attested {
+		flags |= protocol.FlagAttestedCredentialData
+	}
+
+	buf = append(buf, byte(flags))
+	buf = binary.BigEndian.AppendUint32(buf, a.signCount)
+
+	if attested {
+		buf = append(buf, make([]byte, 16)...)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(len(a.credID)))
+		buf = append(buf, a.credID...)
+		buf = append(buf, a.coseKey()...)
+	}
+
+	return buf
+}
+
+func (a *testAuthenticator) clientData(typ string, challenge []byte) []byte {
+	cd, _ := json.Marshal(map[string]string{
+		"type":      typ,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    a.origin,
+	})
+
+	return cd
+}
+
+func (a *testAuthenticator) sign(data []byte) []byte {
+	if a.edKey != nil {
+		return ed25519.Sign(a.edKey, data)
+	}
+
+	h := sha256.Sum256(data)
+	sig, _ := ecdsa.SignASN1(rand.Reader, a.ecKey, h[:])
+	return sig
+}
+
+// registration returns JSON encoded result of navigator.credentials.create()
+//
+// Packed (self) attestation is signed by the signer authenticator
+func (a *testAuthenticator) registration(challenge []byte, format string, signer *testAuthenticator) testResponse {
+	var (
+		cd      = a.clientData("webauthn.create", challenge)
+		ad      = a.authData(true)
+		stmt    = map[string]interface{}{}
+		cdHash  = sha256.Sum256(cd)
+		payload = append(append([]byte{}, ad...), cdHash[:]...)
+	)
+
+	if format == "packed" {
+		stmt["alg"] = int(webauthncose.AlgES256)
+		stmt["sig"] = signer.sign(payload)
+	}
+
+	return testResponse{
+		"id":         base64.RawURLEncoding.EncodeToString(a.credID),
+		"rawId":      base64.RawURLEncoding.EncodeToString(a.credID),
+		"type":       "public-key",
+		"transports": []string{"usb"},
+		"response": map[string]string{
+			"clientDataJSON": base64.RawURLEncoding.EncodeToString(cd),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(cborEncode(map[string]interface{}{
+				"fmt":      format,
+				"attStmt":  stmt,
+				"authData": ad,
+			})),
+		},
+	}
+}
+
+func (a *testAuthenticator) register(challenge []byte, format string) *RegistrationResponse {
+	return a.registration(challenge, format, a).registration()
+}
+
+// assertion returns JSON encoded result of navigator.credentials.get()
+func (a *testAuthenticator) assertion(challenge []byte) testResponse {
+	a.signCount++
+
+	var (
+		cd     = a.clientData("webauthn.get", challenge)
+		ad     = a.authData(false)
+		cdHash = sha256.Sum256(cd)
+		sig    = a.sign(append(append([]byte{}, ad...), cdHash[:]...))
+	)
+
+	return testResponse{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(cd),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(ad),
+			"signature":         base64.RawURLEncoding.EncodeToString(sig),
+		},
+	}
+}
+
+func (a *testAuthenticator) assert(challenge []byte) *AssertionResponse {
+	return a.assertion(challenge).assertion()
+}
+
+func (r testResponse) registration() *RegistrationResponse {
+	out := &RegistrationResponse{}
+	r.decode(out)
+	return out
+}
+
+func (r testResponse) assertion() *AssertionResponse {
+	out := &AssertionResponse{}
+	r.decode(out)
+	return out
+}
+
+func (r testResponse) decode(dst interface{}) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	if err = json.Unmarshal(raw, dst); err != nil {
+		panic(err)
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	for _, format := range []string{"none", "packed"} {
+		t.Run(format, func(t *testing.T) {
+			var (
+				req     = require.New(t)
+				a       = newTestAuthenticator(t)
+				ch, err = NewChallenge()
+			)
+
+			req.NoError(err)
+
+			c, err := testRP.VerifyRegistration(a.register(ch, format), ch)
+			req.NoError(err)
+			req.Equal(a.credID, c.ID)
+			req.Equal(format, c.AttestationFormat)
+			req.Equal([]string{"usb"}, c.Transports)
+			req.False(c.UserVerified)
+		})
+	}
+
+	t.Run("ed25519", func(t *testing.T) {
+		var (
+			req = require.New(t)
+			a   = newTestAuthenticator(t)
+			ch  = []byte("challenge")
+		)
+
+		_, a.edKey, _ = ed25519.GenerateKey(rand.Reader)
+
+		c, err := testRP.VerifyRegistration(a.register(ch, "none"), ch)
+		req.NoError(err)
+		req.NoError(testRP.VerifyAssertion(a.assert(ch), ch, c, false))
+	})
+}
+
+func TestRegistration_invalid(t *testing.T) {
+	var (
+		ch = []byte("challenge")
+	)
+
+	tcc := []struct {
+		name   string
+		mod    func(a *testAuthenticator)
+		modRsp func(r testResponse)
+		err    string
+	}{
+		{
+			name: "challenge",
+			modRsp: func(r testResponse) {
+				cd := []byte(`{"type":"webauthn.create","challenge":"Zm9v","origin":"https://example.com"}`)
+				r["response"].(map[string]string)["clientDataJSON"] = base64.RawURLEncoding.EncodeToString(cd)
+			},
+			err: "Error validating challenge",
+		},
+		{
+			name: "origin",
+			mod:  func(a *testAuthenticator) { a.origin = "https://evil.com" },
+			err:  "Error validating origin",
+		},
+		{
+			name: "rp ID",
+			mod:  func(a *testAuthenticator) { a.rpID = "evil.com" },
+			err:  "RP Hash mismatch",
+		},
+		{
+			name: "user presence",
+			mod:  func(a *testAuthenticator) { a.flags = 0 },
+			err:  "User presence flag not set",
+		},
+		{
+			name: "raw ID",
+			modRsp: func(r testResponse) {
+				r["rawId"] = "Zm9v"
+			},
+			err: ErrCredentialMismatch.Error(),
+		},
+		{
+			name: "packed signature",
+			modRsp: func(r testResponse) {
+				// attestation statement signed by a different key
+				var (
+					a     = newTestAuthenticator(t)
+					other = newTestAuthenticator(t)
+				)
+
+				for k, v := range a.registration(ch, "packed", other) {
+					r[k] = v
+				}
+			},
+			err: "verify signature",
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			a := newTestAuthenticator(t)
+			if tc.mod != nil {
+				tc.mod(a)
+			}
+
+			r := a.registration(ch, "none", a)
+			if tc.modRsp != nil {
+				tc.modRsp(r)
+			}
+
+			_, err := testRP.VerifyRegistration(r.registration(), ch)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	_, err := testRP.VerifyRegistration(newTestAuthenticator(t).register(ch, "none"), nil)
+	require.ErrorIs(t, err, ErrMissingChallenge)
+}
+
+func TestAssertion(t *testing.T) {
+	var (
+		req = require.New(t)
+		a   = newTestAuthenticator(t)
+		ch  = []byte("registration")
+	)
+
+	c, err := testRP.VerifyRegistration(a.register(ch, "none"), ch)
+	req.NoError(err)
+
+	ch = []byte("assertion")
+	req.NoError(testRP.VerifyAssertion(a.assert(ch), ch, c, false))
+	req.Equal(uint32(1), c.SignCount)
+
+	err = testRP.VerifyAssertion(a.assert(ch), []byte("other"), c, false)
+	req.ErrorContains(err, "Error validating challenge")
+
+	// user verification required but not performed
+	err = testRP.VerifyAssertion(a.assert(ch), ch, c, true)
+	req.ErrorContains(err, "User verification required")
+
+	a.flags |= protocol.FlagUserVerified
+	req.NoError(testRP.VerifyAssertion(a.assert(ch), ch, c, true))
+
+	// replayed (or cloned) counter
+	a.signCount = 0
+	req.ErrorIs(testRP.VerifyAssertion(a.assert(ch), ch, c, false), ErrClonedCredential)
+
+	// signature by a different key
+	r := a.assertion(ch)
+	r["response"].(map[string]string)["signature"] = base64.RawURLEncoding.EncodeToString(newTestAuthenticator(t).sign([]byte("foo")))
+	req.ErrorContains(testRP.VerifyAssertion(r.assertion(), ch, c, false), "Error validating the assertion signature")
+
+	// different credential
+	r = a.assertion(ch)
+	r["rawId"] = "Zm9v"
+	req.ErrorIs(testRP.VerifyAssertion(r.assertion(), ch, c, false), ErrCredentialMismatch)
+
+	req.Equal(a.credID, a.assert(ch).CredentialID())
+	req.Empty(a.assert(ch).UserHandle())
+}
+
+func TestOptions(t *testing.T) {
+	var (
+		req = require.New(t)
+		c   = &Credential{ID: []byte{1, 2, 3}}
+	)
+
+	co := testRP.CreationOptions([]byte("ch"), User{ID: []byte("42"), Name: "foo"}, []*Credential{c}, true)
+	req.Equal("Corteza", co.RP.Name)
+	req.Equal("preferred", co.AuthenticatorSelection.ResidentKey)
+	req.Len(co.ExcludeCredentials, 1)
+
+	raw, err := json.Marshal(co)
+	req.NoError(err)
+	req.Contains(string(raw), `"challenge":"Y2g"`)
+	req.Contains(string(raw), `"id":"AQID"`)
+
+	ro := testRP.RequestOptions([]byte("ch"), nil, true)
+	req.Equal("required", ro.UserVerification)
+	req.Empty(ro.AllowCredentials)
+}
+
+func TestBase64URL(t *testing.T) {
+	var (
+		req = require.New(t)
+		b   Base64URL
+	)
+
+	req.NoError(json.Unmarshal([]byte(`"AQID"`), &b))
+	req.Equal(Base64URL{1, 2, 3}, b)
+
+	// padded input
+	req.NoError(json.Unmarshal([]byte(`"AQI="`), &b))
+	req.Equal(Base64URL{1, 2}, b)
+
+	req.Error(json.Unmarshal([]byte(`"***"`), &b))
+}
+
+func cborEncode(v interface{}) []byte {
+	out, err := webauthncbor.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
@@ -0,0 +1,86 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+)
+
+type (
+	awaiting struct {
+		// correlation key of the awaited event
+		key string
+
+		// when not zero, state is resumed without the event
+		resumeAt time.Time
+
+		// state to be resumed
+		state *State
+	}
+
+	// AwaitingState describes state that is waiting for the event
+	AwaitingState struct {
+		SessionID uint64
+		StateID   uint64
+		Step      Step
+		Key       string
+		Scope     *expr.Vars
+	}
+)
+
+// Await suspends the state until the event is delivered to it
+//
+// When timeout is not zero, state is resumed with "timeout" set on
+// the input if the event is not delivered by then
+func Await(key string, timeout time.Time) *awaiting {
+	return &awaiting{key: key, resumeAt: timeout}
+}
+
+func (a *awaiting) toAwaiting(sessionID uint64) *AwaitingState {
+	return &AwaitingState{
+		SessionID: sessionID,
+		StateID:   a.state.stateId,
+		Step:      a.state.step,
+		Key:       a.key,
+		Scope:     a.state.scope,
+	}
+}
+
+// Awaiting returns all states that are waiting for the event
+func (s *Session) Awaiting() (out []*AwaitingState) {
+	defer s.mux.RUnlock()
+	s.mux.RLock()
+
+	out = make([]*AwaitingState, 0, len(s.awaiting))
+	for _, a := range s.awaiting {
+		out = append(out, a.toAwaiting(s.id))
+	}
+
+	return
+}
+
+// Deliver resumes the state that is waiting for the event
+//
+// Input holds event's payload and is passed to the awaiting step.
+func (s *Session) Deliver(ctx context.Context, stateId uint64, input *expr.Vars) error {
+	defer s.mux.Unlock()
+	s.mux.Lock()
+
+	a, has := s.awaiting[stateId]
+	if !has {
+		return fmt.Errorf("state is not awaiting an event")
+	}
+
+	delete(s.awaiting, stateId)
+
+	if input == nil {
+		input = &expr.Vars{}
+	}
+
+	input.Set("resumed", true)
+	a.state.input = input
+
+	return s.enqueue(ctx, a.state)
+}
@@ -0,0 +1,148 @@
+package wfexec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_Await(t *testing.T) {
+	var (
+		unit = time.Millisecond
+
+		// builds a graph with step that waits for the event
+		// and continues on the 1st path when event is delivered
+		// and on the 2nd one on timeout
+		graph = func(timeout time.Duration) (*Graph, Step) {
+			var (
+				g = NewGraph()
+
+				received = &sesTestStep{name: "received"}
+				timedOut = &sesTestStep{name: "timedOut"}
+				await    = &sesTestStep{name: "await", exec: func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+					switch {
+					case !r.Input.Has("resumed"):
+						var until time.Time
+						if timeout > 0 {
+							until = now().Add(timeout)
+						}
+
+						return Await("key-42", until), nil
+
+					case r.Input.Has("timeout"):
+						return timedOut, nil
+					}
+
+					return Branch(received, r.Input), nil
+				}}
+			)
+
+			for i, s := range []Step{await, received, timedOut} {
+				s.SetID(uint64(i + 1))
+			}
+
+			g.AddStep(await, received, timedOut)
+			g.AddStep(received)
+			g.AddStep(timedOut)
+
+			return g, await
+		}
+
+		awaiting = func(ctx context.Context, req *require.Assertions, ses *Session) *AwaitingState {
+			req.NoError(ses.Wait(ctx))
+			req.Equal(SessionDelayed, ses.Status())
+			req.True(ses.Suspended())
+
+			aa := ses.Awaiting()
+			req.Len(aa, 1)
+			req.Equal("key-42", aa[0].Key)
+			req.Equal(ses.ID(), aa[0].SessionID)
+			return aa[0]
+		}
+	)
+
+	t.Run("event delivered", func(t *testing.T) {
+		var (
+			req      = require.New(t)
+			ctx, cfn = context.WithTimeout(context.Background(), time.Second*5)
+			g, start = graph(0)
+			ses      = NewSession(ctx, g, SetWorkerIntervalSuspended(unit))
+		)
+
+		defer cfn()
+
+		req.NoError(ses.Exec(ctx, start, nil))
+		aw := awaiting(ctx, req, ses)
+
+		req.NoError(ses.Deliver(ctx, aw.StateID, expr.Must(expr.NewVars(map[string]interface{}{"payload": "foo"})).(*expr.Vars)))
+		req.Error(ses.Deliver(ctx, aw.StateID, nil), "event must not be delivered twice")
+
+		req.NoError(ses.WaitUntil(ctx, SessionCompleted, SessionFailed))
+		req.Contains(ses.Result().Dict(), "received")
+		req.NotContains(ses.Result().Dict(), "timedOut")
+		req.Equal("foo", expr.Must(expr.Select(ses.Result(), "payload")).Get())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		var (
+			req      = require.New(t)
+			ctx, cfn = context.WithTimeout(context.Background(), time.Second*5)
+			g, start = graph(unit * 5)
+			ses      = NewSession(ctx, g, SetWorkerIntervalSuspended(unit))
+		)
+
+		defer cfn()
+
+		req.NoError(ses.Exec(ctx, start, nil))
+		req.NoError(ses.WaitUntil(ctx, SessionCompleted, SessionFailed))
+		req.Contains(ses.Result().Dict(), "timedOut")
+		req.NotContains(ses.Result().Dict(), "received")
+		req.Empty(ses.Awaiting())
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		var (
+			req      = require.New(t)
+			ctx, cfn = context.WithTimeout(context.Background(), time.Second*5)
+			g, start = graph(time.Hour)
+			ses      = NewSession(ctx, g, SetWorkerIntervalSuspended(unit))
+
+			ss       *SessionSnapshot
+			restored *Session
+			err      error
+		)
+
+		defer cfn()
+
+		req.NoError(ses.Exec(ctx, start, nil))
+		aw := awaiting(ctx, req, ses)
+
+		ss, err = ses.Snapshot()
+		req.NoError(err)
+		req.Len(ss.States, 1)
+		req.NotNil(ss.States[0].Await)
+		req.Equal("key-42", ss.States[0].Await.Key)
+
+		// through JSON, like when session is stored
+		enc, err := json.Marshal(ss)
+		req.NoError(err)
+		ss = &SessionSnapshot{}
+		req.NoError(json.Unmarshal(enc, ss))
+
+		g, _ = graph(time.Hour)
+		restored, err = RestoreSession(ctx, g, ss, SetWorkerIntervalSuspended(unit))
+		req.NoError(err)
+
+		raw := awaiting(ctx, req, restored)
+		req.Equal(aw.StateID, raw.StateID)
+		req.Equal(start.ID(), raw.Step.ID())
+
+		req.NoError(restored.Deliver(ctx, raw.StateID, nil))
+		req.NoError(restored.WaitUntil(ctx, SessionCompleted, SessionFailed))
+		req.Contains(restored.Result().Dict(), "received")
+	})
+}
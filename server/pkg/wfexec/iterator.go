@@ -44,6 +44,17 @@ type (
 
 		h IteratorHandler
 	}
+
+	// loop wraps the iterator that was started on a state
+	//
+	// It keeps the request the iterator step was executed with and number
+	// of iterations so that the loop can be restored from the snapshot
+	loop struct {
+		i Iterator
+
+		req        *ExecRequest
+		iterations uint
+	}
 )
 
 const (
@@ -114,3 +125,17 @@ func GenericResourceNextCheck(useLimit bool, ptr, buffSize, total, limit uint, h
 	// if we can get more...
 	return hasMore
 }
+
+func (l *loop) Is(s Step) bool                                { return l.i.Is(s) }
+func (l *loop) Start(ctx context.Context, s *expr.Vars) error { return l.i.Start(ctx, s) }
+func (l *loop) Break() Step                                   { return l.i.Break() }
+func (l *loop) Iterator() Step                                { return l.i.Iterator() }
+
+// Next counts the iterations and calls Next on the wrapped iterator
+func (l *loop) Next(ctx context.Context, scope *expr.Vars) (next Step, out *expr.Vars, err error) {
+	if next, out, err = l.i.Next(ctx, scope); err == nil && next != nil {
+		l.iterations++
+	}
+
+	return
+}
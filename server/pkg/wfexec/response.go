@@ -22,11 +22,20 @@ type (
 
 		// state to be resumed
 		state *State
+
+		// failed step is executed again when resumed
+		retry bool
 	}
 
 	// when session is resumed from a delay we'll replace
 	// delay step on state with the a generic step that will return resumed{}
 	resumed struct{}
+
+	// results of the step that continues on one of its children
+	branch struct {
+		results *expr.Vars
+		next    Step
+	}
 )
 
 func Delay(until time.Time) *delayed {
@@ -37,6 +46,11 @@ func Resume() *resumed {
 	return &resumed{}
 }
 
+// Branch continues with the given child step and sets step results
+func Branch(next Step, results *expr.Vars) *branch {
+	return &branch{next: next, results: results}
+}
+
 func ErrorHandler(h Step, results *expr.Vars) *errHandler {
 	return &errHandler{handler: h, results: results}
 }
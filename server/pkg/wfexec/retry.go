@@ -0,0 +1,107 @@
+package wfexec
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+type (
+	// RetryPolicy controls re-execution of the failed step
+	//
+	// Step is retried by delaying the state so that
+	// waiting for the next attempt does not block the worker
+	RetryPolicy struct {
+		// Total number of attempts, including the first one
+		MaxAttempts uint
+
+		Backoff Backoff
+
+		// Delay before the first retry
+		Delay time.Duration
+
+		// Upper limit of the delay between attempts, ignored when zero
+		MaxDelay time.Duration
+
+		// Random portion of the delay (0-1) that is subtracted
+		// from the delay to spread retries of concurrent sessions
+		Jitter float64
+
+		// Returns true if the step should be retried on the error;
+		// all errors are retried when not set
+		Retryable func(error) bool
+	}
+
+	RetryableStep interface {
+		Step
+		RetryPolicy() *RetryPolicy
+	}
+
+	Backoff string
+
+	retryStep struct {
+		Step
+		policy *RetryPolicy
+	}
+)
+
+const (
+	BackoffConstant    Backoff = "constant"
+	BackoffLinear      Backoff = "linear"
+	BackoffExponential Backoff = "exponential"
+)
+
+var (
+	// wrapper around rand.Float64 that will aid testing
+	jitter = rand.Float64
+)
+
+// Retry wraps the step and re-executes it according to the policy when it fails
+func Retry(s Step, p *RetryPolicy) RetryableStep {
+	return &retryStep{Step: s, policy: p}
+}
+
+func (s *retryStep) RetryPolicy() *RetryPolicy { return s.policy }
+
+// Next returns delay before the next attempt
+//
+// Attempt is the number of the failed attempt (starting with 1); false is
+// returned when there are no more attempts left or error is not retryable
+func (p RetryPolicy) Next(ctx context.Context, attempt uint, err error) (time.Duration, bool) {
+	switch {
+	case ctx.Err() != nil:
+		// canceled sessions are never retried
+		return 0, false
+	case attempt >= p.MaxAttempts:
+		return 0, false
+	case p.Retryable != nil && !p.Retryable(err):
+		return 0, false
+	}
+
+	return p.delay(attempt), true
+}
+
+func (p RetryPolicy) delay(attempt uint) (d time.Duration) {
+	d = p.Delay
+
+	switch p.Backoff {
+	case BackoffLinear:
+		d *= time.Duration(attempt)
+
+	case BackoffExponential, "":
+		for i := uint(1); i < attempt && d < math.MaxInt64/2; i++ {
+			d *= 2
+		}
+	}
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(jitter() * p.Jitter * float64(d))
+	}
+
+	return
+}
@@ -0,0 +1,175 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Next(t *testing.T) {
+	var (
+		ctx = context.Background()
+		err = fmt.Errorf("failed")
+	)
+
+	defer func(fn func() float64) { jitter = fn }(jitter)
+	jitter = func() float64 { return 1 }
+
+	tcc := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt uint
+		delay   time.Duration
+		retry   bool
+	}{
+		{
+			name:    "constant",
+			policy:  RetryPolicy{MaxAttempts: 5, Backoff: BackoffConstant, Delay: time.Second},
+			attempt: 3,
+			delay:   time.Second,
+			retry:   true,
+		},
+		{
+			name:    "linear",
+			policy:  RetryPolicy{MaxAttempts: 5, Backoff: BackoffLinear, Delay: time.Second},
+			attempt: 3,
+			delay:   3 * time.Second,
+			retry:   true,
+		},
+		{
+			name:    "exponential by default",
+			policy:  RetryPolicy{MaxAttempts: 5, Delay: time.Second},
+			attempt: 4,
+			delay:   8 * time.Second,
+			retry:   true,
+		},
+		{
+			name:    "capped with max delay",
+			policy:  RetryPolicy{MaxAttempts: 5, Delay: time.Second, MaxDelay: 5 * time.Second},
+			attempt: 4,
+			delay:   5 * time.Second,
+			retry:   true,
+		},
+		{
+			name:    "with jitter",
+			policy:  RetryPolicy{MaxAttempts: 5, Delay: time.Second, Jitter: .5},
+			attempt: 2,
+			delay:   time.Second,
+			retry:   true,
+		},
+		{
+			name:    "no attempts left",
+			policy:  RetryPolicy{MaxAttempts: 3, Delay: time.Second},
+			attempt: 3,
+		},
+		{
+			name:    "not retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, Delay: time.Second, Retryable: func(error) bool { return false }},
+			attempt: 1,
+		},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			delay, retry := tc.policy.Next(ctx, tc.attempt, err)
+			require.Equal(t, tc.retry, retry)
+			require.Equal(t, tc.delay, delay)
+		})
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, retry := RetryPolicy{MaxAttempts: 3}.Next(ctx, 1, err)
+		require.False(t, retry)
+	})
+}
+
+func TestSession_Retry(t *testing.T) {
+	var (
+		unit = time.Millisecond
+
+		run = func(t *testing.T, failures int, p *RetryPolicy) (ses *Session, ff []*Frame) {
+			var (
+				ctx = context.Background()
+				req = require.New(t)
+				wf  = NewGraph()
+				mux sync.Mutex
+
+				executed int
+				step     = Retry(&sesTestStep{name: "flaky", exec: func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+					if executed++; executed <= failures {
+						return nil, fmt.Errorf("failure #%d", executed)
+					}
+
+					return expr.NewVars(map[string]interface{}{"executed": executed})
+				}}, p)
+			)
+
+			ses = NewSession(ctx, wf,
+				SetWorkerIntervalSuspended(unit),
+				SetHandler(func(_ SessionStatus, st *State, _ *Session) error {
+					mux.Lock()
+					defer mux.Unlock()
+					ff = append(ff, st.MakeFrame())
+					return nil
+				}),
+			)
+
+			ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
+			defer cancelFn()
+
+			wf.AddStep(step)
+			req.NoError(ses.Exec(ctx, step, nil))
+			_ = ses.WaitUntil(ctx, SessionFailed, SessionCompleted)
+
+			mux.Lock()
+			defer mux.Unlock()
+			return ses, ff
+		}
+	)
+
+	t.Run("succeeds after failed attempts", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, ff := run(t, 2, &RetryPolicy{MaxAttempts: 3, Delay: unit})
+		req.NoError(ses.Error())
+		req.Equal(SessionCompleted, ses.Status())
+		req.Equal(3, expr.Must(expr.Select(ses.Result(), "executed")).Get())
+
+		// each attempt is recorded
+		req.Len(ff, 4)
+		req.Equal(uint(1), ff[0].Attempt)
+		req.Equal("retry scheduled", ff[0].Action)
+		req.Equal("failure #1", ff[0].Error)
+		req.Equal(uint(2), ff[1].Attempt)
+		req.Equal("failure #2", ff[1].Error)
+		req.Equal(uint(3), ff[2].Attempt)
+		req.Empty(ff[2].Error)
+	})
+
+	t.Run("fails when attempts are exhausted", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, ff := run(t, 5, &RetryPolicy{MaxAttempts: 3, Delay: unit})
+		req.Error(ses.Error())
+		req.Contains(ses.Error().Error(), "failure #3")
+		req.Equal(SessionFailed, ses.Status())
+		req.Len(ff, 3)
+	})
+
+	t.Run("fails on non-retryable error", func(t *testing.T) {
+		req := require.New(t)
+
+		ses, ff := run(t, 5, &RetryPolicy{MaxAttempts: 3, Delay: unit, Retryable: func(error) bool { return false }})
+		req.Error(ses.Error())
+		req.Contains(ses.Error().Error(), "failure #1")
+		req.Len(ff, 1)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"sync"
 	"time"
 
@@ -11,6 +12,9 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/expr"
 	"github.com/cortezaproject/corteza/server/pkg/id"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -41,6 +45,18 @@ type (
 		// prompted
 		prompted map[uint64]*prompted
 
+		// states waiting for the event
+		awaiting map[uint64]*awaiting
+
+		// queued states and states that are being executed
+		pending map[uint64]*State
+
+		// states that reached join gateway and are waiting for the rest of the paths
+		joined map[uint64]*State
+
+		// locks pending and joined states
+		pendingLock sync.Mutex
+
 		// how often we check for delayed states and how often idle stat is checked in Wait()
 		workerIntervalSuspended time.Duration
 		workerIntervalWaiter    time.Duration
@@ -63,6 +79,9 @@ type (
 
 		// This keeps track of workflow calls
 		callStack []uint64
+
+		// span that started the session; step spans are its children
+		traceParent trace.SpanContext
 	}
 
 	StateChangeHandler func(SessionStatus, *State, *Session) error
@@ -88,6 +107,9 @@ type (
 
 		Action string `json:"action,omitempty"`
 		Error  string `json:"error,omitempty"`
+
+		// Execution attempt of the step with retry policy
+		Attempt uint `json:"attempt,omitempty"`
 	}
 
 	// ExecRequest is passed to Exec() functions and contains all information
@@ -161,15 +183,27 @@ func (s SessionStatus) String() string {
 }
 
 func NewSession(ctx context.Context, g *Graph, oo ...SessionOpt) *Session {
+	s := newSession(g, nextID(), oo...)
+	s.callStack = append(s.callStack, s.id)
+
+	go s.worker(ctx)
+
+	return s
+}
+
+func newSession(g *Graph, sessionID uint64, oo ...SessionOpt) *Session {
 	s := &Session{
 		g:        g,
-		id:       nextID(),
+		id:       sessionID,
 		started:  *now(),
 		qState:   make(chan *State, sessionStateChanBuf),
 		qErr:     make(chan error, 1),
 		execLock: make(chan struct{}, sessionConcurrentExec),
 		delayed:  make(map[uint64]*delayed),
 		prompted: make(map[uint64]*prompted),
+		awaiting: make(map[uint64]*awaiting),
+		pending:  make(map[uint64]*State),
+		joined:   make(map[uint64]*State),
 
 		// Setting this one to something higher since it'll need external interaction
 		workerIntervalSuspended: time.Millisecond * 100,
@@ -194,10 +228,6 @@ func NewSession(ctx context.Context, g *Graph, oo ...SessionOpt) *Session {
 	s.log = s.log.
 		With(logger.Uint64("sessionID", s.id))
 
-	s.callStack = append(s.callStack, s.id)
-
-	go s.worker(ctx)
-
 	return s
 }
 
@@ -216,7 +246,7 @@ func (s *Session) Status() SessionStatus {
 	case len(s.prompted) > 0:
 		return SessionPrompted
 
-	case len(s.delayed) > 0:
+	case len(s.delayed) > 0, len(s.awaiting) > 0:
 		return SessionDelayed
 
 	case s.result == nil:
@@ -275,6 +305,10 @@ func (s *Session) Exec(ctx context.Context, step Step, scope *expr.Vars) error {
 		scope, _ = expr.NewVars(nil)
 	}
 
+	// session runs in its own context,
+	// keep the caller's trace for the step spans
+	s.traceParent = trace.SpanContextFromContext(ctx)
+
 	return s.enqueue(ctx, NewState(s, auth.GetIdentityFromContext(ctx), nil, step, scope))
 }
 
@@ -389,8 +423,15 @@ func (s *Session) enqueue(ctx context.Context, st *State) error {
 		st.stateId = nextID()
 	}
 
+	s.handover(nil, st)
+	return s.push(ctx, st)
+}
+
+// push sends (already tracked) state to the queue
+func (s *Session) push(ctx context.Context, st *State) error {
 	select {
 	case <-ctx.Done():
+		s.handover(st)
 		return ctx.Err()
 
 	case s.qState <- st:
@@ -468,6 +509,8 @@ func (s *Session) worker(ctx context.Context) {
 		case st := <-s.qState:
 			s.log.Debug("pulled state from queue", logger.Uint64("stateID", st.stateId))
 			if st.step == nil {
+				s.handover(st)
+
 				// When there are any suspended steps we shouldn't kill the worker
 				// as those need to be processed.
 				if s.Suspended() {
@@ -501,6 +544,11 @@ func (s *Session) worker(ctx context.Context) {
 				return
 			}
 
+			// state is modified during the execution;
+			// keep the unchanged copy for the session snapshot
+			pending := *st
+			s.handover(st, &pending)
+
 			// add empty struct to chan to lock and to have control over number of concurrent go processes
 			// this will block if number of items in execLock chan reached value of sessionConcurrentExec
 			s.execLock <- struct{}{}
@@ -523,6 +571,10 @@ func (s *Session) worker(ctx context.Context) {
 					st.err = err
 				}
 
+				// next states replace the executed one in one go
+				// so that session snapshot never holds both
+				s.handover(st, nxt...)
+
 				st.completed = now()
 
 				status := s.Status()
@@ -576,7 +628,7 @@ func (s *Session) worker(ctx context.Context) {
 					} else {
 						log.Debug("next step queued", logger.Uint64("nextStepId", 0))
 					}
-					if err = s.enqueue(ctx, n); err != nil {
+					if err = s.push(ctx, n); err != nil {
 						log.Error("unable to enqueue", zap.Error(err))
 						return
 					}
@@ -622,9 +674,16 @@ func (s *Session) Prompted() bool {
 	return len(s.prompted) > 0
 }
 
-// Suspended returns true if the workflow has either delayed or prompted steps
+// Awaits returns true if the workflow has steps waiting for the event
+func (s *Session) Awaits() bool {
+	defer s.mux.RUnlock()
+	s.mux.RLock()
+	return len(s.awaiting) > 0
+}
+
+// Suspended returns true if the workflow has delayed, prompted or awaiting steps
 func (s *Session) Suspended() bool {
-	return s.Delayed() || s.Prompted()
+	return s.Delayed() || s.Prompted() || s.Awaits()
 }
 
 func (s *Session) queueScheduledSuspended() {
@@ -638,12 +697,33 @@ func (s *Session) queueScheduledSuspended() {
 
 		delete(s.delayed, id)
 
-		// Set state input when step is resumed
-		sus.state.input = &expr.Vars{}
-		sus.state.input.Set("resumed", true)
-		sus.state.input.Set("resumeAt", sus.resumeAt)
+		if !sus.retry {
+			// Set state input when step is resumed
+			sus.state.input = &expr.Vars{}
+			sus.state.input.Set("resumed", true)
+			sus.state.input.Set("resumeAt", sus.resumeAt)
+		}
+
+		s.handover(nil, sus.state)
 		s.qState <- sus.state
 	}
+
+	for id, aw := range s.awaiting {
+		if aw.resumeAt.IsZero() || aw.resumeAt.After(*now()) {
+			continue
+		}
+
+		delete(s.awaiting, id)
+
+		// event did not arrive in time
+		aw.state.input = &expr.Vars{}
+		aw.state.input.Set("resumed", true)
+		aw.state.input.Set("resumeAt", aw.resumeAt)
+		aw.state.input.Set("timeout", true)
+
+		s.handover(nil, aw.state)
+		s.qState <- aw.state
+	}
 }
 
 // executes single step, resolves response and schedule following steps for execution
@@ -679,10 +759,34 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 		scope  = (&expr.Vars{}).MustMerge(st.scope)
 
 		currLoop = st.loopCurr()
+
+		span trace.Span
+	)
+
+	ctx, span = tracing.Start(
+		tracing.WithSpanContext(ctx, s.traceParent),
+		"workflow step",
+		attribute.String("workflow.id", strconv.FormatUint(s.workflowID, 10)),
+		attribute.String("workflow.session.id", strconv.FormatUint(s.id, 10)),
+		attribute.String("workflow.state.id", strconv.FormatUint(st.stateId, 10)),
 	)
 
+	defer func() {
+		span.SetAttributes(attribute.String("workflow.step.action", st.action))
+		if err != nil {
+			tracing.End(span, err)
+		} else {
+			// handled step errors are recorded as well
+			tracing.End(span, st.err)
+		}
+	}()
+
 	if st.step != nil {
 		log = log.With(logger.Uint64("stepID", st.step.ID()))
+		span.SetAttributes(
+			attribute.String("workflow.step.id", strconv.FormatUint(st.step.ID(), 10)),
+			attribute.String("workflow.step.type", fmt.Sprintf("%T", st.step)),
+		)
 	}
 
 	{
@@ -694,17 +798,48 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 			ctx = logger.ContextWithValue(ctx, log)
 			stepCtx := SetContextCallStack(ctx, s.callStack)
 
+			rs, retryable := st.step.(RetryableStep)
+			if retryable {
+				st.attempt++
+				st.attemptErr = nil
+			}
+
 			result, st.err = st.step.Exec(stepCtx, st.MakeRequest())
 
+			if retryable && st.err != nil {
+				if wait, ok := rs.RetryPolicy().Next(ctx, st.attempt, st.err); ok {
+					// failed attempt is recorded, state is delayed
+					// and step is executed again when resumed
+					st.action = "retry scheduled"
+					st.attemptErr, st.err = st.err, nil
+
+					log.Debug("step retry scheduled",
+						zap.Uint("attempt", st.attempt),
+						zap.Duration("delay", wait),
+						zap.Error(st.attemptErr),
+					)
+
+					s.mux.Lock()
+					s.delayed[st.stateId] = &delayed{resumeAt: now().Add(wait), state: st, retry: true}
+					s.mux.Unlock()
+					return
+				}
+			}
+
 			if iterator, isIterator := result.(Iterator); isIterator && st.err == nil {
 				// Exec fn returned an iterator, adding loop to stack
-				st.newLoop(iterator)
+				result = st.newLoop(iterator)
 				if err = iterator.Start(ctx, scope); err != nil {
 					return
 				}
 			}
 		}
 
+		if _, is := result.(*partial); !is && st.err == nil {
+			// join gateway (if this is one) merged all the paths
+			s.unpark(st.step)
+		}
+
 		if st.err != nil {
 			if st.errHandler == nil {
 				// no error handler set
@@ -798,6 +933,7 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 			st.action = "partial"
 			// *partial is returned when step needs to be executed again
 			// it's used mainly for join gateway step that should be called multiple times (one for each parent path)
+			s.park(st)
 			return
 
 		case *termination:
@@ -806,6 +942,7 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 			log.Debug("termination", zap.Int("delayed", len(s.delayed)))
 			s.mux.Lock()
 			s.delayed = nil
+			s.awaiting = nil
 			s.mux.Unlock()
 			return []*State{FinalState(s, scope)}, nil
 
@@ -819,6 +956,23 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 			s.mux.Unlock()
 			return
 
+		case *awaiting:
+			st.action = "awaiting"
+			log.Debug("session awaiting event", zap.String("key", result.key))
+
+			result.state = st
+			s.mux.Lock()
+			s.awaiting[st.stateId] = result
+			s.mux.Unlock()
+			return
+
+		case *branch:
+			st.action = "branch"
+			// step selected one of its children
+			st.results = result.results
+			scope = scope.MustMerge(st.results)
+			st.next = Steps{result.next}
+
 		case *resumed:
 			st.action = "resumed"
 			log.Debug("session resumed")
@@ -893,6 +1047,42 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 	return nxt, nil
 }
 
+// handover stops tracking the executed state and starts tracking next states
+//
+// Executed state can be nil when new states are queued
+func (s *Session) handover(executed *State, nxt ...*State) {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	if executed != nil {
+		delete(s.pending, executed.stateId)
+	}
+
+	for _, n := range nxt {
+		s.pending[n.stateId] = n
+	}
+}
+
+// park keeps the state that reached join gateway until all other paths do the same
+func (s *Session) park(st *State) {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	s.joined[st.stateId] = st
+}
+
+// unpark removes all states parked on the join gateway step
+func (s *Session) unpark(step Step) {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	for id, st := range s.joined {
+		if st.step == step {
+			delete(s.joined, id)
+		}
+	}
+}
+
 func SetWorkerIntervalSuspended(i time.Duration) SessionOpt {
 	return func(s *Session) {
 		s.workerIntervalSuspended = i
@@ -8,6 +8,9 @@ import (
 
 	"github.com/cortezaproject/corteza/server/pkg/expr"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/atomic"
 )
 
@@ -275,6 +278,38 @@ func TestSession_ExecStepWithParents(t *testing.T) {
 	req.Equal(SessionFailed, ses.Status())
 }
 
+func TestSession_Tracing(t *testing.T) {
+	var (
+		req = require.New(t)
+		sr  = tracetest.NewSpanRecorder()
+		wf  = NewGraph()
+
+		s1 = &sesTestStep{name: "s1"}
+		s2 = &sesTestStep{name: "s2"}
+	)
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	defer otel.SetTracerProvider(prev)
+
+	// session context is not related to the caller's context
+	ses := NewSession(context.Background(), wf)
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "caller")
+	defer span.End()
+
+	wf.AddStep(s1, s2)
+	req.NoError(ses.Exec(ctx, s1, nil))
+	req.NoError(ses.Wait(ctx))
+
+	ss := sr.Ended()
+	req.Len(ss, 2)
+	for _, s := range ss {
+		req.Equal("workflow step", s.Name())
+		req.Equal(span.SpanContext().SpanID(), s.Parent().SpanID())
+	}
+}
+
 func bmSessionSimpleStepSequence(c uint64, b *testing.B) {
 	var (
 		ctx = context.Background()
@@ -0,0 +1,479 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+)
+
+type (
+	// SessionSnapshot holds serializable state of the session
+	//
+	// It is used to persist the session and to restore it later,
+	// for example, after the server restart.
+	SessionSnapshot struct {
+		SessionID  uint64   `json:"sessionID,string"`
+		WorkflowID uint64   `json:"workflowID,string"`
+		CallStack  []uint64 `json:"callStack"`
+
+		States []*StateSnapshot `json:"states"`
+
+		// Loops states are in; referenced from states by index
+		Loops []*LoopSnapshot `json:"loops,omitempty"`
+	}
+
+	// StateSnapshot holds serializable state of the queued, delayed,
+	// prompted, awaiting or joined (waiting on the join gateway) state
+	StateSnapshot struct {
+		StateID   uint64     `json:"stateID,string"`
+		CreatedAt time.Time  `json:"createdAt"`
+		Completed *time.Time `json:"completed,omitempty"`
+
+		OwnerID    uint64   `json:"ownerID,string,omitempty"`
+		OwnerRoles []uint64 `json:"ownerRoles,omitempty"`
+
+		ParentID     uint64 `json:"parentID,string,omitempty"`
+		StepID       uint64 `json:"stepID,string,omitempty"`
+		ErrHandlerID uint64 `json:"errHandlerID,string,omitempty"`
+		ErrHandled   bool   `json:"errHandled,omitempty"`
+
+		// Execution attempt of the step with retry policy
+		Attempt uint `json:"attempt,omitempty"`
+
+		Input   *expr.Vars `json:"input,omitempty"`
+		Scope   *expr.Vars `json:"scope,omitempty"`
+		Results *expr.Vars `json:"results,omitempty"`
+
+		// Loops (outermost first) the state is in
+		Loops []int `json:"loops,omitempty"`
+
+		// Set when state is delayed
+		Delay *DelaySnapshot `json:"delay,omitempty"`
+
+		// Set when state is prompted
+		Prompt *PromptSnapshot `json:"prompt,omitempty"`
+
+		// Set when state is waiting for the event
+		Await *AwaitSnapshot `json:"await,omitempty"`
+	}
+
+	DelaySnapshot struct {
+		ResumeAt time.Time `json:"resumeAt"`
+
+		// Set when failed step is retried
+		Retry bool `json:"retry,omitempty"`
+	}
+
+	AwaitSnapshot struct {
+		Key      string    `json:"key,omitempty"`
+		ResumeAt time.Time `json:"resumeAt,omitempty"`
+	}
+
+	// LoopSnapshot holds serializable state of the loop
+	//
+	// Iterators can not be serialized; iterator step is executed
+	// again with the same input and scope on restore and iterator
+	// is moved forward by the number of iterations
+	LoopSnapshot struct {
+		StepID     uint64     `json:"stepID,string"`
+		Input      *expr.Vars `json:"input,omitempty"`
+		Scope      *expr.Vars `json:"scope,omitempty"`
+		Iterations uint       `json:"iterations"`
+	}
+
+	PromptSnapshot struct {
+		OwnerID uint64     `json:"ownerID,string"`
+		Ref     string     `json:"ref"`
+		Payload *expr.Vars `json:"payload,omitempty"`
+		Sent    bool       `json:"sent"`
+	}
+)
+
+// Snapshot returns serializable state of the session
+//
+// Snapshot holds all delayed, prompted, awaiting and joined states and states that are
+// queued or being executed. States that are being executed are restored as
+// queued and executed again.
+//
+// Loops are stored with the iterator step and the number of iterations
+// and are restored by moving a new iterator to the same position.
+func (s *Session) Snapshot() (*SessionSnapshot, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	var (
+		ss = &SessionSnapshot{
+			SessionID:  s.id,
+			WorkflowID: s.workflowID,
+			CallStack:  append([]uint64{}, s.callStack...),
+		}
+
+		index = make(map[uint64]*StateSnapshot)
+
+		// states in the same loop share the iterator
+		loopIndex = make(map[*loop]int)
+
+		add = func(st *State) (*StateSnapshot, error) {
+			if sn, ok := index[st.stateId]; ok {
+				return sn, nil
+			}
+
+			sn, err := st.snapshot()
+			if err != nil {
+				return nil, fmt.Errorf("state %d can not be serialized: %w", st.stateId, err)
+			}
+
+			for _, i := range st.loops {
+				l, ok := i.(*loop)
+				if !ok {
+					return nil, fmt.Errorf("loop of state %d can not be serialized", st.stateId)
+				}
+
+				if _, ok = loopIndex[l]; !ok {
+					lsn, err := l.snapshot()
+					if err != nil {
+						return nil, fmt.Errorf("loop of state %d can not be serialized: %w", st.stateId, err)
+					}
+
+					loopIndex[l] = len(ss.Loops)
+					ss.Loops = append(ss.Loops, lsn)
+				}
+
+				sn.Loops = append(sn.Loops, loopIndex[l])
+			}
+
+			index[st.stateId] = sn
+			ss.States = append(ss.States, sn)
+			return sn, nil
+		}
+	)
+
+	// suspended states first; state that was just suspended
+	// might still be marked as pending
+	for _, d := range s.delayed {
+		sn, err := add(d.state)
+		if err != nil {
+			return nil, err
+		}
+
+		sn.Delay = &DelaySnapshot{ResumeAt: d.resumeAt, Retry: d.retry}
+	}
+
+	for _, p := range s.prompted {
+		sn, err := add(p.state)
+		if err != nil {
+			return nil, err
+		}
+
+		payload, err := cloneVars(p.payload)
+		if err != nil {
+			return nil, fmt.Errorf("prompt payload of state %d can not be serialized: %w", p.state.stateId, err)
+		}
+
+		sn.Prompt = &PromptSnapshot{
+			OwnerID: p.ownerId,
+			Ref:     p.ref,
+			Payload: payload,
+			Sent:    p.sent,
+		}
+	}
+
+	for _, a := range s.awaiting {
+		sn, err := add(a.state)
+		if err != nil {
+			return nil, err
+		}
+
+		sn.Await = &AwaitSnapshot{Key: a.key, ResumeAt: a.resumeAt}
+	}
+
+	for _, m := range []map[uint64]*State{s.joined, s.pending} {
+		for _, st := range m {
+			if _, err := add(st); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(ss.States, func(i, j int) bool {
+		return ss.States[i].StateID < ss.States[j].StateID
+	})
+
+	return ss, nil
+}
+
+// RestoreSession creates session from the snapshot and continues with the execution
+//
+// Delayed, prompted and awaiting states are suspended again, all other states are queued.
+// Steps are looked up by ID on the given graph.
+func RestoreSession(ctx context.Context, g *Graph, ss *SessionSnapshot, oo ...SessionOpt) (*Session, error) {
+	var (
+		s = newSession(g, ss.SessionID, oo...)
+
+		queued = make([]*State, 0, len(ss.States))
+
+		loops = make([]*loop, len(ss.Loops))
+	)
+
+	s.workflowID = ss.WorkflowID
+	s.callStack = append([]uint64{}, ss.CallStack...)
+
+	for i, lsn := range ss.Loops {
+		l, err := lsn.restore(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		loops[i] = l
+	}
+
+	for _, sn := range ss.States {
+		st, err := sn.restore(s, loops)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case sn.Delay != nil:
+			s.delayed[st.stateId] = &delayed{resumeAt: sn.Delay.ResumeAt, state: st, retry: sn.Delay.Retry}
+
+		case sn.Prompt != nil:
+			s.prompted[st.stateId] = &prompted{
+				payload: sn.Prompt.Payload,
+				ownerId: sn.Prompt.OwnerID,
+				state:   st,
+				sent:    sn.Prompt.Sent,
+				ref:     sn.Prompt.Ref,
+			}
+
+		case sn.Await != nil:
+			s.awaiting[st.stateId] = &awaiting{key: sn.Await.Key, resumeAt: sn.Await.ResumeAt, state: st}
+
+		default:
+			queued = append(queued, st)
+		}
+	}
+
+	go s.worker(ctx)
+
+	for _, st := range queued {
+		if err := s.enqueue(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// ResolveTypes resolves types of all variables in the snapshot
+//
+// Variables are unresolved after snapshot is decoded from JSON
+func (ss *SessionSnapshot) ResolveTypes(resolver func(typ string) expr.Type) (err error) {
+	var vv []*expr.Vars
+
+	for _, sn := range ss.States {
+		vv = append(vv, sn.Input, sn.Scope, sn.Results)
+		if sn.Prompt != nil {
+			vv = append(vv, sn.Prompt.Payload)
+		}
+	}
+
+	for _, lsn := range ss.Loops {
+		vv = append(vv, lsn.Input, lsn.Scope)
+	}
+
+	for _, v := range vv {
+		if v == nil {
+			continue
+		}
+
+		if err = v.ResolveTypes(resolver); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (s *State) snapshot() (sn *StateSnapshot, err error) {
+	sn = &StateSnapshot{
+		StateID:    s.stateId,
+		CreatedAt:  s.created,
+		ErrHandled: s.errHandled,
+		Attempt:    s.attempt,
+	}
+
+	if sn.Input, err = cloneVars(s.input); err != nil {
+		return nil, fmt.Errorf("could not copy input: %w", err)
+	}
+
+	if sn.Scope, err = cloneVars(s.scope); err != nil {
+		return nil, fmt.Errorf("could not copy scope: %w", err)
+	}
+
+	if sn.Results, err = cloneVars(s.results); err != nil {
+		return nil, fmt.Errorf("could not copy results: %w", err)
+	}
+
+	if s.owner != nil {
+		sn.OwnerID = s.owner.Identity()
+		sn.OwnerRoles = s.owner.Roles()
+	}
+
+	if s.parent != nil {
+		sn.ParentID = s.parent.ID()
+	}
+
+	if s.step != nil {
+		sn.StepID = s.step.ID()
+	} else {
+		// final state
+		sn.Completed = s.completed
+	}
+
+	if s.errHandler != nil {
+		sn.ErrHandlerID = s.errHandler.ID()
+	}
+
+	return sn, nil
+}
+
+func (sn *StateSnapshot) restore(s *Session, loops []*loop) (st *State, err error) {
+	var (
+		lookup = func(stepID uint64) (Step, error) {
+			if stepID == 0 {
+				return nil, nil
+			}
+
+			if step := s.g.StepByID(stepID); step != nil {
+				return step, nil
+			}
+
+			return nil, fmt.Errorf("step %d of state %d not found", stepID, sn.StateID)
+		}
+	)
+
+	st = &State{
+		stateId:    sn.StateID,
+		sessionId:  s.id,
+		created:    sn.CreatedAt,
+		completed:  sn.Completed,
+		errHandled: sn.ErrHandled,
+		attempt:    sn.Attempt,
+		input:      sn.Input,
+		scope:      sn.Scope,
+		results:    sn.Results,
+
+		loops: make([]Iterator, 0, 4),
+	}
+
+	if sn.OwnerID > 0 {
+		st.owner = auth.Authenticated(sn.OwnerID, sn.OwnerRoles...)
+	}
+
+	if st.parent, err = lookup(sn.ParentID); err != nil {
+		return
+	}
+
+	if st.step, err = lookup(sn.StepID); err != nil {
+		return
+	}
+
+	if st.errHandler, err = lookup(sn.ErrHandlerID); err != nil {
+		return
+	}
+
+	for _, i := range sn.Loops {
+		if i < 0 || i >= len(loops) {
+			return nil, fmt.Errorf("loop %d of state %d not found", i, sn.StateID)
+		}
+
+		st.loops = append(st.loops, loops[i])
+	}
+
+	return st, s.canEnqueue(st)
+}
+
+func (l *loop) snapshot() (sn *LoopSnapshot, err error) {
+	sn = &LoopSnapshot{
+		StepID:     l.Iterator().ID(),
+		Iterations: l.iterations,
+	}
+
+	if sn.Input, err = cloneVars(l.req.Input); err != nil {
+		return nil, fmt.Errorf("could not copy input: %w", err)
+	}
+
+	if sn.Scope, err = cloneVars(l.req.Scope); err != nil {
+		return nil, fmt.Errorf("could not copy scope: %w", err)
+	}
+
+	return
+}
+
+// restore executes the iterator step again and moves
+// the new iterator to the position of the snapshotted one
+//
+// Iterator can yield different items if the data
+// it iterates over changed in the meantime.
+func (sn *LoopSnapshot) restore(ctx context.Context, s *Session) (l *loop, err error) {
+	var (
+		step = s.g.StepByID(sn.StepID)
+		rsp  ExecResponse
+	)
+
+	if step == nil {
+		return nil, fmt.Errorf("iterator step %d not found", sn.StepID)
+	}
+
+	l = &loop{req: &ExecRequest{SessionID: s.id, Input: sn.Input, Scope: sn.Scope}}
+
+	if rsp, err = step.Exec(SetContextCallStack(ctx, s.callStack), l.req); err != nil {
+		return nil, fmt.Errorf("could not restore iterator step %d: %w", sn.StepID, err)
+	}
+
+	var ok bool
+	if l.i, ok = rsp.(Iterator); !ok {
+		return nil, fmt.Errorf("step %d is not an iterator", sn.StepID)
+	}
+
+	scope := (&expr.Vars{}).MustMerge(sn.Scope)
+	if err = l.Start(ctx, scope); err != nil {
+		return nil, fmt.Errorf("could not start iterator step %d: %w", sn.StepID, err)
+	}
+
+	for l.iterations < sn.Iterations {
+		next, _, err := l.Next(ctx, scope)
+		if err != nil {
+			return nil, fmt.Errorf("could not restore iterator step %d: %w", sn.StepID, err)
+		}
+
+		if next == nil {
+			return nil, fmt.Errorf("iterator step %d ended before iteration %d", sn.StepID, sn.Iterations)
+		}
+	}
+
+	return l, nil
+}
+
+// cloneVars un-references variables so that
+// snapshot is not affected by further execution
+func cloneVars(vars *expr.Vars) (*expr.Vars, error) {
+	if vars == nil {
+		return nil, nil
+	}
+
+	aux, err := vars.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	return aux.(*expr.Vars), nil
+}
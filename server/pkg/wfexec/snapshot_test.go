@@ -0,0 +1,313 @@
+package wfexec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_SnapshotRestore(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.Authenticated(42, 1))
+
+		// builds a fresh graph with the same step IDs
+		// like the one we get after workflow is converted again on restart
+		graph = func() (*Graph, Step, Step) {
+			var (
+				g = NewGraph()
+
+				start  = &sesTestStep{name: "start"}
+				split  = &sesTestStep{name: "split"}
+				prompt = &sesTestStep{name: "prompt", exec: func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+					if r.Input == nil {
+						return Prompt(42, "approve", nil), nil
+					}
+
+					return expr.NewVars(map[string]interface{}{"approved": r.Input.Dict()["approved"]})
+				}}
+				join = JoinGateway(split, prompt)
+				end  = &sesTestStep{name: "end"}
+			)
+
+			for i, s := range []Step{start, split, prompt, join, end} {
+				s.SetID(uint64(i + 1))
+			}
+
+			g.AddStep(start, split, prompt)
+			g.AddStep(split, join)
+			g.AddStep(prompt, join)
+			g.AddStep(join, end)
+			g.AddStep(end)
+
+			return g, start, join
+		}
+
+		resolver = func(typ string) expr.Type {
+			for _, t := range []expr.Type{&expr.String{}, &expr.Integer{}, &expr.Boolean{}} {
+				if t.Type() == typ {
+					return t
+				}
+			}
+
+			return &expr.Any{}
+		}
+
+		g1, start, join = graph()
+
+		execCtx, shutdown = context.WithCancel(context.Background())
+
+		ses = NewSession(execCtx, g1, SetWorkflowID(7))
+
+		snapshot *SessionSnapshot
+	)
+
+	req.NoError(ses.Exec(ctx, start, nil))
+
+	// wait for the prompt and for the split path to reach the join gateway
+	req.Eventually(func() bool {
+		ss, err := ses.Snapshot()
+		if err != nil || len(ss.States) != 2 || !ses.Prompted() {
+			return false
+		}
+
+		for _, st := range ss.States {
+			if st.Prompt == nil && st.StepID != join.ID() {
+				return false
+			}
+		}
+
+		return true
+	}, time.Second, time.Millisecond)
+
+	ss, err := ses.Snapshot()
+	req.NoError(err)
+	req.Equal(ses.ID(), ss.SessionID)
+	req.Equal(uint64(7), ss.WorkflowID)
+
+	for _, st := range ss.States {
+		if st.Prompt != nil {
+			req.Equal(uint64(42), st.OwnerID)
+			req.Equal(uint64(42), st.Prompt.OwnerID)
+		} else {
+			// split path waiting on the join gateway
+			req.Equal(join.ID(), st.StepID)
+		}
+	}
+
+	// session is lost
+	shutdown()
+
+	// and restored from serialized snapshot
+	enc, err := json.Marshal(ss)
+	req.NoError(err)
+	req.NoError(json.Unmarshal(enc, &snapshot))
+	req.NoError(snapshot.ResolveTypes(resolver))
+
+	g2, _, _ := graph()
+	restored, err := RestoreSession(context.Background(), g2, snapshot)
+	req.NoError(err)
+	req.Equal(ses.ID(), restored.ID())
+	req.True(restored.Prompted())
+
+	pp := restored.UserPendingPrompts(42)
+	req.Len(pp, 1)
+	req.Equal("approve", pp[0].Ref)
+
+	input, _ := expr.NewVars(map[string]interface{}{"approved": true})
+	_, err = restored.Resume(ctx, pp[0].StateID, input)
+	req.NoError(err)
+	req.NoError(restored.Wait(ctx))
+
+	req.NotNil(restored.Result())
+	req.Contains(restored.Result().Dict(), "split")
+	req.Contains(restored.Result().Dict(), "end")
+	req.Equal(true, restored.Result().Dict()["approved"])
+}
+
+type (
+	snapshotTestIteratorStep struct {
+		StepIdentifier
+		items      []string
+		next, exit Step
+	}
+
+	snapshotTestIterator struct {
+		items []string
+		ptr   int
+	}
+)
+
+func (s *snapshotTestIteratorStep) Exec(context.Context, *ExecRequest) (ExecResponse, error) {
+	return GenericIterator(s, s.next, s.exit, &snapshotTestIterator{items: s.items}), nil
+}
+
+func (s *snapshotTestIteratorStep) EvalResults(_ context.Context, results *expr.Vars) (*expr.Vars, error) {
+	return results, nil
+}
+
+func (i *snapshotTestIterator) Start(context.Context, *expr.Vars) error {
+	i.ptr = 0
+	return nil
+}
+
+func (i *snapshotTestIterator) More(context.Context, *expr.Vars) (bool, error) {
+	return i.ptr < len(i.items), nil
+}
+
+func (i *snapshotTestIterator) Next(context.Context, *expr.Vars) (*expr.Vars, error) {
+	i.ptr++
+	return expr.NewVars(map[string]interface{}{"item": i.items[i.ptr-1]})
+}
+
+func TestSession_SnapshotRestoreIterator(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.Authenticated(42, 1))
+
+		// prompt inside the iterator, one for each item
+		graph = func() (*Graph, Step) {
+			var (
+				g = NewGraph()
+
+				end    = &sesTestStep{name: "end"}
+				prompt = &sesTestStep{name: "prompt", exec: func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+					item := r.Scope.Dict()["item"].(string)
+					if r.Input == nil {
+						return Prompt(42, "approve", nil), nil
+					}
+
+					return expr.NewVars(map[string]interface{}{"approved-" + item: r.Input.Dict()["approved"]})
+				}}
+				iter = &snapshotTestIteratorStep{items: []string{"a", "b", "c"}, next: prompt, exit: end}
+			)
+
+			for i, s := range []Step{iter, prompt, end} {
+				s.SetID(uint64(i + 1))
+			}
+
+			g.AddStep(iter, prompt, end)
+			g.AddStep(prompt)
+			g.AddStep(end)
+
+			return g, iter
+		}
+
+		resolver = func(typ string) expr.Type {
+			for _, t := range []expr.Type{&expr.String{}, &expr.Boolean{}} {
+				if t.Type() == typ {
+					return t
+				}
+			}
+
+			return &expr.Any{}
+		}
+
+		approve = func(ses *Session) {
+			req.Eventually(ses.Prompted, time.Second, time.Millisecond)
+
+			pp := ses.UserPendingPrompts(42)
+			req.Len(pp, 1)
+
+			input, _ := expr.NewVars(map[string]interface{}{"approved": true})
+			_, err := ses.Resume(ctx, pp[0].StateID, input)
+			req.NoError(err)
+		}
+
+		g1, iter = graph()
+
+		execCtx, shutdown = context.WithCancel(context.Background())
+
+		ses = NewSession(execCtx, g1)
+
+		snapshot *SessionSnapshot
+	)
+
+	req.NoError(ses.Exec(ctx, iter, nil))
+
+	// first item is approved, session waits for the approval of the second one
+	approve(ses)
+
+	var ss *SessionSnapshot
+	req.Eventually(func() bool {
+		var err error
+		ss, err = ses.Snapshot()
+		req.NoError(err)
+		return len(ss.States) == 1 && ss.States[0].Prompt != nil && ss.States[0].Scope.Dict()["item"] == "b"
+	}, time.Second, time.Millisecond)
+
+	req.Len(ss.Loops, 1)
+	req.Equal(iter.ID(), ss.Loops[0].StepID)
+	req.Equal(uint(2), ss.Loops[0].Iterations)
+	req.Equal([]int{0}, ss.States[0].Loops)
+
+	// session is lost
+	shutdown()
+
+	// and restored from serialized snapshot
+	enc, err := json.Marshal(ss)
+	req.NoError(err)
+	req.NoError(json.Unmarshal(enc, &snapshot))
+	req.NoError(snapshot.ResolveTypes(resolver))
+
+	g2, _ := graph()
+	restored, err := RestoreSession(context.Background(), g2, snapshot)
+	req.NoError(err)
+
+	// second item is approved and iterator continues with the third one
+	approve(restored)
+	approve(restored)
+	req.NoError(restored.Wait(ctx))
+
+	req.NotNil(restored.Result())
+	req.Contains(restored.Result().Dict(), "end")
+	req.Equal(true, restored.Result().Dict()["approved-a"])
+	req.Equal(true, restored.Result().Dict()["approved-b"])
+	req.Equal(true, restored.Result().Dict()["approved-c"])
+}
+
+func TestSession_SnapshotRestoreMissingStep(t *testing.T) {
+	var (
+		req = require.New(t)
+		g   = NewGraph()
+	)
+
+	_, err := RestoreSession(context.Background(), g, &SessionSnapshot{
+		SessionID: 1,
+		States:    []*StateSnapshot{{StateID: 2, StepID: 3}},
+	})
+
+	req.Error(err)
+}
+
+type snapshotTestUncloneable struct {
+	*expr.String
+}
+
+func (snapshotTestUncloneable) Clone() (expr.TypedValue, error) {
+	return nil, fmt.Errorf("can not clone")
+}
+
+func TestSession_SnapshotUncloneable(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx, cfn = context.WithCancel(context.Background())
+		ses      = NewSession(ctx, NewGraph())
+		scope    = &expr.Vars{}
+	)
+
+	defer cfn()
+
+	scope.Set("foo", snapshotTestUncloneable{expr.Must(expr.NewString("bar")).(*expr.String)})
+	ses.delayed[1] = &delayed{state: &State{stateId: 1, scope: scope}}
+
+	_, err := ses.Snapshot()
+	req.Error(err)
+	req.Contains(err.Error(), "can not clone")
+}
@@ -54,6 +54,12 @@ type (
 		loops []Iterator
 
 		action string
+
+		// number of the last execution attempt of the step with retry policy
+		attempt uint
+
+		// error of the failed attempt that is retried
+		attemptErr error
 	}
 )
 
@@ -106,8 +112,10 @@ func (s State) MakeRequest() *ExecRequest {
 	}
 }
 
-func (s *State) newLoop(i Iterator) {
-	s.loops = append(s.loops, i)
+func (s *State) newLoop(i Iterator) *loop {
+	l := &loop{i: i, req: s.MakeRequest()}
+	s.loops = append(s.loops, l)
+	return l
 }
 
 // ends loop and returns step that leads out of the loop
@@ -152,6 +160,7 @@ func (s State) MakeFrame() *Frame {
 		StateID:   s.stateId,
 		NextSteps: s.next.IDs(),
 		Action:    s.action,
+		Attempt:   s.attempt,
 	}
 
 	var wg sync.WaitGroup
@@ -176,6 +185,8 @@ func (s State) MakeFrame() *Frame {
 
 	if s.err != nil {
 		f.Error = s.err.Error()
+	} else if s.attemptErr != nil {
+		f.Error = s.attemptErr.Error()
 	}
 
 	if s.step != nil {
@@ -16,6 +16,7 @@ import (
 	flagType "github.com/cortezaproject/corteza/server/pkg/flag/types"
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"time"
 )
@@ -156,20 +157,22 @@ type (
 
 	// auxAutomationSession is an auxiliary structure used for transporting to/from RDBMS store
 	auxAutomationSession struct {
-		ID           uint64                       `db:"id"`
-		WorkflowID   uint64                       `db:"workflow_id"`
-		Status       automationType.SessionStatus `db:"status"`
-		EventType    string                       `db:"event_type"`
-		ResourceType string                       `db:"resource_type"`
-		Input        *expr.Vars                   `db:"input"`
-		Output       *expr.Vars                   `db:"output"`
-		Stacktrace   automationType.Stacktrace    `db:"stacktrace"`
-		CreatedBy    uint64                       `db:"created_by"`
-		CreatedAt    time.Time                    `db:"created_at"`
-		PurgeAt      *time.Time                   `db:"purge_at"`
-		SuspendedAt  *time.Time                   `db:"suspended_at"`
-		CompletedAt  *time.Time                   `db:"completed_at"`
-		Error        string                       `db:"error"`
+		ID              uint64                       `db:"id"`
+		WorkflowID      uint64                       `db:"workflow_id"`
+		WorkflowVersion uint                         `db:"workflow_version"`
+		Status          automationType.SessionStatus `db:"status"`
+		EventType       string                       `db:"event_type"`
+		ResourceType    string                       `db:"resource_type"`
+		Input           *expr.Vars                   `db:"input"`
+		Output          *expr.Vars                   `db:"output"`
+		Stacktrace      automationType.Stacktrace    `db:"stacktrace"`
+		State           *automationType.SessionState `db:"state"`
+		CreatedBy       uint64                       `db:"created_by"`
+		CreatedAt       time.Time                    `db:"created_at"`
+		PurgeAt         *time.Time                   `db:"purge_at"`
+		SuspendedAt     *time.Time                   `db:"suspended_at"`
+		CompletedAt     *time.Time                   `db:"completed_at"`
+		Error           string                       `db:"error"`
 	}
 
 	// auxAutomationTrigger is an auxiliary structure used for transporting to/from RDBMS store
@@ -194,24 +197,38 @@ type (
 
 	// auxAutomationWorkflow is an auxiliary structure used for transporting to/from RDBMS store
 	auxAutomationWorkflow struct {
-		ID           uint64                          `db:"id"`
-		Handle       string                          `db:"handle"`
-		Meta         *automationType.WorkflowMeta    `db:"meta"`
-		Enabled      bool                            `db:"enabled"`
-		Trace        bool                            `db:"trace"`
-		KeepSessions int                             `db:"keep_sessions"`
-		Scope        *expr.Vars                      `db:"scope"`
-		Steps        automationType.WorkflowStepSet  `db:"steps"`
-		Paths        automationType.WorkflowPathSet  `db:"paths"`
-		Issues       automationType.WorkflowIssueSet `db:"issues"`
-		RunAs        uint64                          `db:"run_as"`
-		OwnedBy      uint64                          `db:"owned_by"`
-		CreatedAt    time.Time                       `db:"created_at"`
-		UpdatedAt    *time.Time                      `db:"updated_at"`
-		DeletedAt    *time.Time                      `db:"deleted_at"`
-		CreatedBy    uint64                          `db:"created_by"`
-		UpdatedBy    uint64                          `db:"updated_by"`
-		DeletedBy    uint64                          `db:"deleted_by"`
+		ID               uint64                          `db:"id"`
+		Handle           string                          `db:"handle"`
+		Meta             *automationType.WorkflowMeta    `db:"meta"`
+		Enabled          bool                            `db:"enabled"`
+		Trace            bool                            `db:"trace"`
+		KeepSessions     int                             `db:"keep_sessions"`
+		Scope            *expr.Vars                      `db:"scope"`
+		Steps            automationType.WorkflowStepSet  `db:"steps"`
+		Paths            automationType.WorkflowPathSet  `db:"paths"`
+		Issues           automationType.WorkflowIssueSet `db:"issues"`
+		PublishedVersion uint                            `db:"published_version"`
+		RunAs            uint64                          `db:"run_as"`
+		OwnedBy          uint64                          `db:"owned_by"`
+		CreatedAt        time.Time                       `db:"created_at"`
+		UpdatedAt        *time.Time                      `db:"updated_at"`
+		DeletedAt        *time.Time                      `db:"deleted_at"`
+		CreatedBy        uint64                          `db:"created_by"`
+		UpdatedBy        uint64                          `db:"updated_by"`
+		DeletedBy        uint64                          `db:"deleted_by"`
+	}
+
+	// auxAutomationWorkflowVersion is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationWorkflowVersion struct {
+		ID          uint64                         `db:"id"`
+		WorkflowID  uint64                         `db:"workflow_id"`
+		Version     uint                           `db:"version"`
+		Description string                         `db:"description"`
+		Scope       *expr.Vars                     `db:"scope"`
+		Steps       automationType.WorkflowStepSet `db:"steps"`
+		Paths       automationType.WorkflowPathSet `db:"paths"`
+		CreatedAt   time.Time                      `db:"created_at"`
+		CreatedBy   uint64                         `db:"created_by"`
 	}
 
 	// auxComposeAttachment is an auxiliary structure used for transporting to/from RDBMS store
@@ -534,6 +551,21 @@ type (
 		Resource  string          `db:"resource"`
 		Operation string          `db:"operation"`
 		Access    rbacType.Access `db:"access"`
+		NotBefore *time.Time      `db:"not_before"`
+		NotAfter  *time.Time      `db:"not_after"`
+	}
+
+	// auxRbacRuleChange is an auxiliary structure used for transporting to/from RDBMS store
+	auxRbacRuleChange struct {
+		ID          uint64             `db:"id"`
+		ChangeSetID uint64             `db:"change_set_id"`
+		Timestamp   time.Time          `db:"timestamp"`
+		ActorID     uint64             `db:"actor_id"`
+		RoleID      uint64             `db:"role_id"`
+		Resource    string             `db:"resource"`
+		Operation   string             `db:"operation"`
+		Before      rbacType.RuleState `db:"before"`
+		After       rbacType.RuleState `db:"after"`
 	}
 
 	// auxReminder is an auxiliary structure used for transporting to/from RDBMS store
@@ -570,6 +602,39 @@ type (
 		DeletedBy uint64                         `db:"deleted_by"`
 	}
 
+	// auxReportDelivery is an auxiliary structure used for transporting to/from RDBMS store
+	auxReportDelivery struct {
+		ID             uint64                              `db:"id"`
+		SubscriptionID uint64                              `db:"subscription_id"`
+		ReportID       uint64                              `db:"report_id"`
+		Format         systemType.ReportSubscriptionFormat `db:"format"`
+		Recipients     systemType.ReportDeliveryRecipients `db:"recipients"`
+		Status         systemType.ReportDeliveryStatus     `db:"status"`
+		Error          string                              `db:"error"`
+		CreatedAt      time.Time                           `db:"created_at"`
+		CompletedAt    *time.Time                          `db:"completed_at"`
+	}
+
+	// auxReportSubscription is an auxiliary structure used for transporting to/from RDBMS store
+	auxReportSubscription struct {
+		ID         uint64                                  `db:"id"`
+		ReportID   uint64                                  `db:"report_id"`
+		ScenarioID uint64                                  `db:"scenario_id"`
+		Schedule   string                                  `db:"schedule"`
+		Format     systemType.ReportSubscriptionFormat     `db:"format"`
+		Enabled    bool                                    `db:"enabled"`
+		Recipients systemType.ReportSubscriptionRecipients `db:"recipients"`
+		Meta       *systemType.ReportSubscriptionMeta      `db:"meta"`
+		LastRunAt  *time.Time                              `db:"last_run_at"`
+		OwnedBy    uint64                                  `db:"owned_by"`
+		CreatedAt  time.Time                               `db:"created_at"`
+		UpdatedAt  *time.Time                              `db:"updated_at"`
+		DeletedAt  *time.Time                              `db:"deleted_at"`
+		CreatedBy  uint64                                  `db:"created_by"`
+		UpdatedBy  uint64                                  `db:"updated_by"`
+		DeletedBy  uint64                                  `db:"deleted_by"`
+	}
+
 	// auxResourceActivity is an auxiliary structure used for transporting to/from RDBMS store
 	auxResourceActivity struct {
 		ID             uint64    `db:"id"`
@@ -614,6 +679,14 @@ type (
 		RoleID uint64 `db:"role_id"`
 	}
 
+	// auxSchedulerClaim is an auxiliary structure used for transporting to/from RDBMS store
+	auxSchedulerClaim struct {
+		Job       string    `db:"job"`
+		ClaimedBy string    `db:"claimed_by"`
+		ClaimedAt time.Time `db:"claimed_at"`
+		ExpiresAt time.Time `db:"expires_at"`
+	}
+
 	// auxSettingValue is an auxiliary structure used for transporting to/from RDBMS store
 	auxSettingValue struct {
 		OwnedBy   uint64    `db:"owned_by"`
@@ -654,6 +727,40 @@ type (
 		UpdatedAt      *time.Time           `db:"updated_at"`
 		DeletedAt      *time.Time           `db:"deleted_at"`
 	}
+
+	// auxWebhook is an auxiliary structure used for transporting to/from RDBMS store
+	auxWebhook struct {
+		ID        uint64                     `db:"id"`
+		Handle    string                     `db:"handle"`
+		URL       string                     `db:"url"`
+		Secret    string                     `db:"secret"`
+		Enabled   bool                       `db:"enabled"`
+		Events    systemType.WebhookEventSet `db:"events"`
+		Meta      *systemType.WebhookMeta    `db:"meta"`
+		CreatedAt time.Time                  `db:"created_at"`
+		UpdatedAt *time.Time                 `db:"updated_at"`
+		DeletedAt *time.Time                 `db:"deleted_at"`
+		CreatedBy uint64                     `db:"created_by"`
+		UpdatedBy uint64                     `db:"updated_by"`
+		DeletedBy uint64                     `db:"deleted_by"`
+	}
+
+	// auxWebhookDelivery is an auxiliary structure used for transporting to/from RDBMS store
+	auxWebhookDelivery struct {
+		ID             uint64                           `db:"id"`
+		WebhookID      uint64                           `db:"webhook_id"`
+		ResourceType   string                           `db:"resource_type"`
+		EventType      string                           `db:"event_type"`
+		Payload        rawJson                          `db:"payload"`
+		Status         systemType.WebhookDeliveryStatus `db:"status"`
+		Attempts       uint                             `db:"attempts"`
+		ResponseStatus int                              `db:"response_status"`
+		Error          string                           `db:"error"`
+		NextAttemptAt  *time.Time                       `db:"next_attempt_at"`
+		LastAttemptAt  *time.Time                       `db:"last_attempt_at"`
+		CreatedAt      time.Time                        `db:"created_at"`
+		UpdatedAt      *time.Time                       `db:"updated_at"`
+	}
 )
 
 // encodes Actionlog to auxActionlog
@@ -1157,12 +1264,14 @@ func (aux *auxAuthSession) scan(row scanner) error {
 func (aux *auxAutomationSession) encode(res *automationType.Session) (_ error) {
 	aux.ID = res.ID
 	aux.WorkflowID = res.WorkflowID
+	aux.WorkflowVersion = res.WorkflowVersion
 	aux.Status = res.Status
 	aux.EventType = res.EventType
 	aux.ResourceType = res.ResourceType
 	aux.Input = res.Input
 	aux.Output = res.Output
 	aux.Stacktrace = res.Stacktrace
+	aux.State = res.State
 	aux.CreatedBy = res.CreatedBy
 	aux.CreatedAt = res.CreatedAt
 	aux.PurgeAt = res.PurgeAt
@@ -1179,12 +1288,14 @@ func (aux auxAutomationSession) decode() (res *automationType.Session, _ error)
 	res = new(automationType.Session)
 	res.ID = aux.ID
 	res.WorkflowID = aux.WorkflowID
+	res.WorkflowVersion = aux.WorkflowVersion
 	res.Status = aux.Status
 	res.EventType = aux.EventType
 	res.ResourceType = aux.ResourceType
 	res.Input = aux.Input
 	res.Output = aux.Output
 	res.Stacktrace = aux.Stacktrace
+	res.State = aux.State
 	res.CreatedBy = aux.CreatedBy
 	res.CreatedAt = aux.CreatedAt
 	res.PurgeAt = aux.PurgeAt
@@ -1201,12 +1312,14 @@ func (aux *auxAutomationSession) scan(row scanner) error {
 	return row.Scan(
 		&aux.ID,
 		&aux.WorkflowID,
+		&aux.WorkflowVersion,
 		&aux.Status,
 		&aux.EventType,
 		&aux.ResourceType,
 		&aux.Input,
 		&aux.Output,
 		&aux.Stacktrace,
+		&aux.State,
 		&aux.CreatedBy,
 		&aux.CreatedAt,
 		&aux.PurgeAt,
@@ -1301,6 +1414,7 @@ func (aux *auxAutomationWorkflow) encode(res *automationType.Workflow) (_ error)
 	aux.Steps = res.Steps
 	aux.Paths = res.Paths
 	aux.Issues = res.Issues
+	aux.PublishedVersion = res.PublishedVersion
 	aux.RunAs = res.RunAs
 	aux.OwnedBy = res.OwnedBy
 	aux.CreatedAt = res.CreatedAt
@@ -1327,6 +1441,7 @@ func (aux auxAutomationWorkflow) decode() (res *automationType.Workflow, _ error
 	res.Steps = aux.Steps
 	res.Paths = aux.Paths
 	res.Issues = aux.Issues
+	res.PublishedVersion = aux.PublishedVersion
 	res.RunAs = aux.RunAs
 	res.OwnedBy = aux.OwnedBy
 	res.CreatedAt = aux.CreatedAt
@@ -1353,6 +1468,7 @@ func (aux *auxAutomationWorkflow) scan(row scanner) error {
 		&aux.Steps,
 		&aux.Paths,
 		&aux.Issues,
+		&aux.PublishedVersion,
 		&aux.RunAs,
 		&aux.OwnedBy,
 		&aux.CreatedAt,
@@ -1364,6 +1480,56 @@ func (aux *auxAutomationWorkflow) scan(row scanner) error {
 	)
 }
 
+// encodes AutomationWorkflowVersion to auxAutomationWorkflowVersion
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowVersion) encode(res *automationType.WorkflowVersion) (_ error) {
+	aux.ID = res.ID
+	aux.WorkflowID = res.WorkflowID
+	aux.Version = res.Version
+	aux.Description = res.Description
+	aux.Scope = res.Scope
+	aux.Steps = res.Steps
+	aux.Paths = res.Paths
+	aux.CreatedAt = res.CreatedAt
+	aux.CreatedBy = res.CreatedBy
+	return
+}
+
+// decodes AutomationWorkflowVersion from auxAutomationWorkflowVersion
+//
+// This function is auto-generated
+func (aux auxAutomationWorkflowVersion) decode() (res *automationType.WorkflowVersion, _ error) {
+	res = new(automationType.WorkflowVersion)
+	res.ID = aux.ID
+	res.WorkflowID = aux.WorkflowID
+	res.Version = aux.Version
+	res.Description = aux.Description
+	res.Scope = aux.Scope
+	res.Steps = aux.Steps
+	res.Paths = aux.Paths
+	res.CreatedAt = aux.CreatedAt
+	res.CreatedBy = aux.CreatedBy
+	return
+}
+
+// scans row and fills auxAutomationWorkflowVersion fields
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowVersion) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.WorkflowID,
+		&aux.Version,
+		&aux.Description,
+		&aux.Scope,
+		&aux.Steps,
+		&aux.Paths,
+		&aux.CreatedAt,
+		&aux.CreatedBy,
+	)
+}
+
 // encodes ComposeAttachment to auxComposeAttachment
 //
 // This function is auto-generated
@@ -2556,6 +2722,8 @@ func (aux *auxRbacRule) encode(res *rbacType.Rule) (_ error) {
 	aux.Resource = res.Resource
 	aux.Operation = res.Operation
 	aux.Access = res.Access
+	aux.NotBefore = res.NotBefore
+	aux.NotAfter = res.NotAfter
 	return
 }
 
@@ -2568,6 +2736,8 @@ func (aux auxRbacRule) decode() (res *rbacType.Rule, _ error) {
 	res.Resource = aux.Resource
 	res.Operation = aux.Operation
 	res.Access = aux.Access
+	res.NotBefore = aux.NotBefore
+	res.NotAfter = aux.NotAfter
 	return
 }
 
@@ -2580,6 +2750,58 @@ func (aux *auxRbacRule) scan(row scanner) error {
 		&aux.Resource,
 		&aux.Operation,
 		&aux.Access,
+		&aux.NotBefore,
+		&aux.NotAfter,
+	)
+}
+
+// encodes RbacRuleChange to auxRbacRuleChange
+//
+// This function is auto-generated
+func (aux *auxRbacRuleChange) encode(res *rbacType.RuleChange) (_ error) {
+	aux.ID = res.ID
+	aux.ChangeSetID = res.ChangeSetID
+	aux.Timestamp = res.Timestamp
+	aux.ActorID = res.ActorID
+	aux.RoleID = res.RoleID
+	aux.Resource = res.Resource
+	aux.Operation = res.Operation
+	aux.Before = res.Before
+	aux.After = res.After
+	return
+}
+
+// decodes RbacRuleChange from auxRbacRuleChange
+//
+// This function is auto-generated
+func (aux auxRbacRuleChange) decode() (res *rbacType.RuleChange, _ error) {
+	res = new(rbacType.RuleChange)
+	res.ID = aux.ID
+	res.ChangeSetID = aux.ChangeSetID
+	res.Timestamp = aux.Timestamp
+	res.ActorID = aux.ActorID
+	res.RoleID = aux.RoleID
+	res.Resource = aux.Resource
+	res.Operation = aux.Operation
+	res.Before = aux.Before
+	res.After = aux.After
+	return
+}
+
+// scans row and fills auxRbacRuleChange fields
+//
+// This function is auto-generated
+func (aux *auxRbacRuleChange) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.ChangeSetID,
+		&aux.Timestamp,
+		&aux.ActorID,
+		&aux.RoleID,
+		&aux.Resource,
+		&aux.Operation,
+		&aux.Before,
+		&aux.After,
 	)
 }
 
@@ -2707,6 +2929,127 @@ func (aux *auxReport) scan(row scanner) error {
 	)
 }
 
+// encodes ReportDelivery to auxReportDelivery
+//
+// This function is auto-generated
+func (aux *auxReportDelivery) encode(res *systemType.ReportDelivery) (_ error) {
+	aux.ID = res.ID
+	aux.SubscriptionID = res.SubscriptionID
+	aux.ReportID = res.ReportID
+	aux.Format = res.Format
+	aux.Recipients = res.Recipients
+	aux.Status = res.Status
+	aux.Error = res.Error
+	aux.CreatedAt = res.CreatedAt
+	aux.CompletedAt = res.CompletedAt
+	return
+}
+
+// decodes ReportDelivery from auxReportDelivery
+//
+// This function is auto-generated
+func (aux auxReportDelivery) decode() (res *systemType.ReportDelivery, _ error) {
+	res = new(systemType.ReportDelivery)
+	res.ID = aux.ID
+	res.SubscriptionID = aux.SubscriptionID
+	res.ReportID = aux.ReportID
+	res.Format = aux.Format
+	res.Recipients = aux.Recipients
+	res.Status = aux.Status
+	res.Error = aux.Error
+	res.CreatedAt = aux.CreatedAt
+	res.CompletedAt = aux.CompletedAt
+	return
+}
+
+// scans row and fills auxReportDelivery fields
+//
+// This function is auto-generated
+func (aux *auxReportDelivery) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.SubscriptionID,
+		&aux.ReportID,
+		&aux.Format,
+		&aux.Recipients,
+		&aux.Status,
+		&aux.Error,
+		&aux.CreatedAt,
+		&aux.CompletedAt,
+	)
+}
+
+// encodes ReportSubscription to auxReportSubscription
+//
+// This function is auto-generated
+func (aux *auxReportSubscription) encode(res *systemType.ReportSubscription) (_ error) {
+	aux.ID = res.ID
+	aux.ReportID = res.ReportID
+	aux.ScenarioID = res.ScenarioID
+	aux.Schedule = res.Schedule
+	aux.Format = res.Format
+	aux.Enabled = res.Enabled
+	aux.Recipients = res.Recipients
+	aux.Meta = res.Meta
+	aux.LastRunAt = res.LastRunAt
+	aux.OwnedBy = res.OwnedBy
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	aux.DeletedAt = res.DeletedAt
+	aux.CreatedBy = res.CreatedBy
+	aux.UpdatedBy = res.UpdatedBy
+	aux.DeletedBy = res.DeletedBy
+	return
+}
+
+// decodes ReportSubscription from auxReportSubscription
+//
+// This function is auto-generated
+func (aux auxReportSubscription) decode() (res *systemType.ReportSubscription, _ error) {
+	res = new(systemType.ReportSubscription)
+	res.ID = aux.ID
+	res.ReportID = aux.ReportID
+	res.ScenarioID = aux.ScenarioID
+	res.Schedule = aux.Schedule
+	res.Format = aux.Format
+	res.Enabled = aux.Enabled
+	res.Recipients = aux.Recipients
+	res.Meta = aux.Meta
+	res.LastRunAt = aux.LastRunAt
+	res.OwnedBy = aux.OwnedBy
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	res.DeletedAt = aux.DeletedAt
+	res.CreatedBy = aux.CreatedBy
+	res.UpdatedBy = aux.UpdatedBy
+	res.DeletedBy = aux.DeletedBy
+	return
+}
+
+// scans row and fills auxReportSubscription fields
+//
+// This function is auto-generated
+func (aux *auxReportSubscription) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.ReportID,
+		&aux.ScenarioID,
+		&aux.Schedule,
+		&aux.Format,
+		&aux.Enabled,
+		&aux.Recipients,
+		&aux.Meta,
+		&aux.LastRunAt,
+		&aux.OwnedBy,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+		&aux.DeletedAt,
+		&aux.CreatedBy,
+		&aux.UpdatedBy,
+		&aux.DeletedBy,
+	)
+}
+
 // encodes ResourceActivity to auxResourceActivity
 //
 // This function is auto-generated
@@ -2883,6 +3226,41 @@ func (aux *auxRoleMember) scan(row scanner) error {
 	)
 }
 
+// encodes SchedulerClaim to auxSchedulerClaim
+//
+// This function is auto-generated
+func (aux *auxSchedulerClaim) encode(res *schedulerType.Claim) (_ error) {
+	aux.Job = res.Job
+	aux.ClaimedBy = res.ClaimedBy
+	aux.ClaimedAt = res.ClaimedAt
+	aux.ExpiresAt = res.ExpiresAt
+	return
+}
+
+// decodes SchedulerClaim from auxSchedulerClaim
+//
+// This function is auto-generated
+func (aux auxSchedulerClaim) decode() (res *schedulerType.Claim, _ error) {
+	res = new(schedulerType.Claim)
+	res.Job = aux.Job
+	res.ClaimedBy = aux.ClaimedBy
+	res.ClaimedAt = aux.ClaimedAt
+	res.ExpiresAt = aux.ExpiresAt
+	return
+}
+
+// scans row and fills auxSchedulerClaim fields
+//
+// This function is auto-generated
+func (aux *auxSchedulerClaim) scan(row scanner) error {
+	return row.Scan(
+		&aux.Job,
+		&aux.ClaimedBy,
+		&aux.ClaimedAt,
+		&aux.ExpiresAt,
+	)
+}
+
 // encodes SettingValue to auxSettingValue
 //
 // This function is auto-generated
@@ -3038,3 +3416,127 @@ func (aux *auxUser) scan(row scanner) error {
 		&aux.DeletedAt,
 	)
 }
+
+// encodes Webhook to auxWebhook
+//
+// This function is auto-generated
+func (aux *auxWebhook) encode(res *systemType.Webhook) (_ error) {
+	aux.ID = res.ID
+	aux.Handle = res.Handle
+	aux.URL = res.URL
+	aux.Secret = res.Secret
+	aux.Enabled = res.Enabled
+	aux.Events = res.Events
+	aux.Meta = res.Meta
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	aux.DeletedAt = res.DeletedAt
+	aux.CreatedBy = res.CreatedBy
+	aux.UpdatedBy = res.UpdatedBy
+	aux.DeletedBy = res.DeletedBy
+	return
+}
+
+// decodes Webhook from auxWebhook
+//
+// This function is auto-generated
+func (aux auxWebhook) decode() (res *systemType.Webhook, _ error) {
+	res = new(systemType.Webhook)
+	res.ID = aux.ID
+	res.Handle = aux.Handle
+	res.URL = aux.URL
+	res.Secret = aux.Secret
+	res.Enabled = aux.Enabled
+	res.Events = aux.Events
+	res.Meta = aux.Meta
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	res.DeletedAt = aux.DeletedAt
+	res.CreatedBy = aux.CreatedBy
+	res.UpdatedBy = aux.UpdatedBy
+	res.DeletedBy = aux.DeletedBy
+	return
+}
+
+// scans row and fills auxWebhook fields
+//
+// This function is auto-generated
+func (aux *auxWebhook) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.Handle,
+		&aux.URL,
+		&aux.Secret,
+		&aux.Enabled,
+		&aux.Events,
+		&aux.Meta,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+		&aux.DeletedAt,
+		&aux.CreatedBy,
+		&aux.UpdatedBy,
+		&aux.DeletedBy,
+	)
+}
+
+// encodes WebhookDelivery to auxWebhookDelivery
+//
+// This function is auto-generated
+func (aux *auxWebhookDelivery) encode(res *systemType.WebhookDelivery) (_ error) {
+	aux.ID = res.ID
+	aux.WebhookID = res.WebhookID
+	aux.ResourceType = res.ResourceType
+	aux.EventType = res.EventType
+	aux.Payload = res.Payload
+	aux.Status = res.Status
+	aux.Attempts = res.Attempts
+	aux.ResponseStatus = res.ResponseStatus
+	aux.Error = res.Error
+	aux.NextAttemptAt = res.NextAttemptAt
+	aux.LastAttemptAt = res.LastAttemptAt
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	return
+}
+
+// decodes WebhookDelivery from auxWebhookDelivery
+//
+// This function is auto-generated
+func (aux auxWebhookDelivery) decode() (res *systemType.WebhookDelivery, _ error) {
+	res = new(systemType.WebhookDelivery)
+	res.ID = aux.ID
+	res.WebhookID = aux.WebhookID
+	res.ResourceType = aux.ResourceType
+	res.EventType = aux.EventType
+	res.Payload = aux.Payload
+	res.Status = aux.Status
+	res.Attempts = aux.Attempts
+	res.ResponseStatus = aux.ResponseStatus
+	res.Error = aux.Error
+	res.NextAttemptAt = aux.NextAttemptAt
+	res.LastAttemptAt = aux.LastAttemptAt
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	return
+}
+
+// scans row and fills auxWebhookDelivery fields
+//
+// This function is auto-generated
+func (aux *auxWebhookDelivery) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.WebhookID,
+		&aux.ResourceType,
+		&aux.EventType,
+		&aux.Payload,
+		&aux.Status,
+		&aux.Attempts,
+		&aux.ResponseStatus,
+		&aux.Error,
+		&aux.NextAttemptAt,
+		&aux.LastAttemptAt,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+	)
+}
@@ -3,10 +3,13 @@ package rdbms
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"time"
 
 	"github.com/cortezaproject/corteza/server/pkg/sentry"
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/instrumentation"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
@@ -40,6 +43,18 @@ func Connect(ctx context.Context, log *zap.Logger, cfg *ConnConfig) (db *sqlx.DB
 		return
 	}
 
+	if tracing.Enabled() {
+		// wrap the driver so that all queries, execs
+		// and transactions are traced
+		var c driver.Connector
+		if c, err = instrumentation.Tracing(base.Driver(), cfg.DriverName, cfg.DataSourceName); err != nil {
+			return
+		}
+
+		_ = base.Close()
+		base = sql.OpenDB(c)
+	}
+
 	db = sqlx.NewDb(base, cfg.DriverName)
 	log.Debug(
 		"setting database connection parameters",
@@ -0,0 +1,74 @@
+package rdbms
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// RenewSchedulerClaim updates expiration of the claim
+//
+// Claim is updated only when it is still held by the same instance;
+// returns false when the claim was lost (expired and removed or taken by another instance)
+//
+// Affected rows are not used to tell if the claim was updated since some
+// drivers (MySQL) only count changed rows; claim is looked up after the update instead.
+func (s Store) RenewSchedulerClaim(ctx context.Context, claim *schedulerType.Claim) (renewed bool, err error) {
+	err = s.Tx(ctx, func(ctx context.Context, tx store.Storer) (err error) {
+		ts := tx.(*Store)
+
+		err = ts.Exec(ctx, ts.Dialect.GOQU().Update(schedulerClaimTable).
+			Set(goqu.Record{"expires_at": claim.ExpiresAt}).
+			Where(schedulerClaimOwnerCond(claim)))
+		if err != nil {
+			return
+		}
+
+		renewed, err = ts.schedulerClaimHeld(ctx, claim)
+		return
+	})
+
+	return
+}
+
+// ReleaseSchedulerClaim removes the claim
+//
+// Claim is removed only when it is still held by the same instance;
+// returns false when there was nothing to remove
+func (s Store) ReleaseSchedulerClaim(ctx context.Context, claim *schedulerType.Claim) (released bool, err error) {
+	err = s.Tx(ctx, func(ctx context.Context, tx store.Storer) (err error) {
+		ts := tx.(*Store)
+
+		if released, err = ts.schedulerClaimHeld(ctx, claim); err != nil || !released {
+			return
+		}
+
+		return ts.Exec(ctx, schedulerClaimDeleteQuery(ts.Dialect.GOQU(), schedulerClaimOwnerCond(claim)))
+	})
+
+	return
+}
+
+// schedulerClaimHeld checks if the claim is held by the instance
+func (s *Store) schedulerClaimHeld(ctx context.Context, claim *schedulerType.Claim) (bool, error) {
+	c, err := s.LookupSchedulerClaimByJob(ctx, claim.Job)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return c.ClaimedBy == claim.ClaimedBy, nil
+}
+
+func schedulerClaimOwnerCond(claim *schedulerType.Claim) goqu.Ex {
+	return goqu.Ex{
+		"job":        claim.Job,
+		"claimed_by": claim.ClaimedBy,
+	}
+}
@@ -96,6 +96,13 @@ func (c *connection) Update(ctx context.Context, m *dal.Model, r dal.ValueGetter
 	})
 }
 
+func (c *connection) UpdateIf(ctx context.Context, m *dal.Model, r dal.ValueGetter, expected map[string]any) (ok bool, err error) {
+	return ok, c.withModel(m, func(m *model) error {
+		ok, err = m.UpdateIf(ctx, r, expected)
+		return err
+	})
+}
+
 func (c *connection) Lookup(ctx context.Context, m *dal.Model, pkv dal.ValueGetter, r dal.ValueSetter) (err error) {
 	return c.withModel(m, func(m *model) error {
 		return m.Lookup(ctx, pkv, r)
@@ -131,9 +138,21 @@ func (c *connection) Analyze(ctx context.Context, m *dal.Model) (a map[string]da
 				SearchCost: dal.CostCheep,
 				FilterCost: dal.CostCheep,
 				SortCost:   dal.CostCheep,
+
+				Functions: c.dialect.Nuances().AggregateFunctions,
 			},
 		}
 
+		if wf := c.dialect.Nuances().WindowFunctions; len(wf) > 0 {
+			a[dal.OpAnalysisWindow] = dal.OpAnalysis{
+				ScanCost:   dal.CostCheep,
+				SearchCost: dal.CostCheep,
+				FilterCost: dal.CostCheep,
+				SortCost:   dal.CostCheep,
+
+				Functions: wf,
+			}
+		}
 	}
 
 	return
@@ -146,6 +165,13 @@ func (c *connection) Aggregate(ctx context.Context, m *dal.Model, f filter.Filte
 	})
 }
 
+func (c *connection) Window(ctx context.Context, m *dal.Model, f filter.Filter, attrs []string, partitionBy []string, orderBy filter.SortExprSet, out []dal.WindowAttr, outer filter.Filter) (i dal.Iterator, _ error) {
+	return i, c.withModel(m, func(m *model) (err error) {
+		i, err = m.Window(f, attrs, partitionBy, orderBy, out, outer)
+		return
+	})
+}
+
 func (c *connection) Delete(ctx context.Context, m *dal.Model, pkv dal.ValueGetter) (err error) {
 	return c.withModel(m, func(m *model) error {
 		return m.Delete(ctx, pkv)
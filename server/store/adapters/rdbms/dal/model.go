@@ -8,6 +8,7 @@ import (
 
 	"github.com/cortezaproject/corteza/server/pkg/errors"
 	"github.com/modern-go/reflect2"
+	"github.com/spf13/cast"
 
 	"github.com/cortezaproject/corteza/server/pkg/dal"
 	"github.com/cortezaproject/corteza/server/pkg/filter"
@@ -194,6 +195,45 @@ func (d *model) Update(ctx context.Context, r dal.ValueGetter) error {
 	return err
 }
 
+// UpdateIf updates the row only when stored attribute values match the expected ones
+//
+// Values are checked in the same statement so that the row
+// can not be changed between the check and the update.
+//
+// Some databases (MySQL) do not count matched rows that were not changed
+// so the update should always modify at least one value (like revision).
+func (d *model) UpdateIf(ctx context.Context, r dal.ValueGetter, expected map[string]any) (bool, error) {
+	var (
+		upd = d.updateSql(r)
+	)
+
+	for ident, val := range expected {
+		attrExpr, err := d.table.AttributeExpression(ident)
+		if err != nil {
+			return false, err
+		}
+
+		upd = upd.Where(exp.NewBooleanExpression(exp.EqOp, attrExpr, val))
+	}
+
+	sql, args, err := upd.ToSQL()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := d.conn.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (d *model) Delete(ctx context.Context, r dal.ValueGetter) error {
 	sql, args, err := d.deleteSql(r).ToSQL()
 	if err != nil {
@@ -370,6 +410,76 @@ func (d *model) Aggregate(f filter.Filter, groupBy []dal.AggregateAttr, aggrExpr
 	return
 }
 
+// Window constructs SELECT sql with window functions
+//
+// Selected attributes are extended with window function values. The query
+// is wrapped into a sub-query so the outer filter, sorting and paging can
+// use the window function values.
+func (d *model) Window(f filter.Filter, attrs []string, partitionBy []string, orderBy filter.SortExprSet, out []dal.WindowAttr, outer filter.Filter) (i *iterator, err error) {
+	if len(out) == 0 {
+		return nil, fmt.Errorf("can not run window without window functions")
+	}
+
+	i = &iterator{
+		cursor:  outer.Cursor(),
+		sorting: outer.OrderBy(),
+		limit:   outer.Limit(),
+	}
+
+	var (
+		// source model; how data we are reading from is shaped
+		srcModel = &dal.Model{}
+
+		// destination model; how data we are reading into is shaped
+		dstModel = &dal.Model{}
+	)
+
+	for _, ident := range attrs {
+		attr := d.model.Attributes.FindByIdent(ident)
+		if attr == nil {
+			return nil, fmt.Errorf("unknown attribute %q", ident)
+		}
+
+		srcModel.Attributes = append(srcModel.Attributes, &dal.Attribute{
+			Ident:      attr.Ident,
+			Type:       attr.Type,
+			PrimaryKey: attr.PrimaryKey,
+			Filterable: true,
+			Sortable:   true,
+		})
+
+		dstModel.Attributes = append(dstModel.Attributes, &dal.Attribute{
+			Ident:      attr.Ident,
+			Type:       attr.Type,
+			PrimaryKey: attr.PrimaryKey,
+		})
+	}
+
+	for _, c := range out {
+		srcModel.Attributes = append(srcModel.Attributes, &dal.Attribute{
+			Ident:      c.Identifier,
+			Type:       c.Type,
+			Filterable: true,
+			Sortable:   true,
+		})
+
+		dstModel.Attributes = append(dstModel.Attributes, &dal.Attribute{
+			Ident: c.Identifier,
+			Type:  c.Type,
+		})
+	}
+
+	i.src = Model(srcModel, d.conn, d.dialect)
+	i.dst = Model(dstModel, d.conn, d.dialect)
+
+	i.query = i.src.applyFiltersToQuery(d.windowSql(f, attrs, partitionBy, orderBy, out), outer)
+	if err = i.query.Error(); err != nil {
+		return
+	}
+
+	return
+}
+
 func (d *model) Lookup(ctx context.Context, pkv dal.ValueGetter, r dal.ValueSetter) (err error) {
 	query, args, err := d.lookupSql(pkv).ToSQL()
 	if err != nil {
@@ -677,6 +787,134 @@ func (m *model) aggregateSql(f filter.Filter, groupBy []dal.AggregateAttr, out [
 	return
 }
 
+// windowSql constructs the SELECT sql with the window function values
+//
+// The returned query selects from the sub-query so the window function
+// values can be used the same way as the attributes.
+func (d *model) windowSql(f filter.Filter, attrs []string, partitionBy []string, orderBy filter.SortExprSet, out []dal.WindowAttr) (q *goqu.SelectDataset) {
+	const (
+		subQueryAlias = "windowed"
+	)
+
+	var (
+		err  error
+		expr exp.Expression
+
+		selected []any
+
+		over     []string
+		overArgs []any
+	)
+
+	q = d.dialect.GOQU().From(d.table.Ident())
+	q = d.applyFiltersToQuery(q, f)
+
+	for _, ident := range attrs {
+		if expr, err = d.table.AttributeExpression(ident); err != nil {
+			return q.SetError(err)
+		}
+
+		selected = append(selected, exp.NewAliasExpression(expr, ident))
+	}
+
+	// Window definition
+	if len(partitionBy) > 0 {
+		pp := make([]string, len(partitionBy))
+		for i, ident := range partitionBy {
+			if expr, err = d.table.AttributeExpression(ident); err != nil {
+				return q.SetError(err)
+			}
+
+			pp[i] = "?"
+			overArgs = append(overArgs, expr)
+		}
+
+		over = append(over, "PARTITION BY "+strings.Join(pp, ", "))
+	}
+
+	if len(orderBy) > 0 {
+		oo := make([]string, len(orderBy))
+		for i, s := range orderBy {
+			if expr, err = d.table.AttributeExpression(s.Column); err != nil {
+				return q.SetError(err)
+			}
+
+			oo[i] = "?"
+			if s.Descending {
+				overArgs = append(overArgs, d.dialect.OrderedExpression(expr, exp.DescSortDir, exp.NullsLastSortType))
+			} else {
+				overArgs = append(overArgs, d.dialect.OrderedExpression(expr, exp.AscDir, exp.NullsFirstSortType))
+			}
+		}
+
+		over = append(over, "ORDER BY "+strings.Join(oo, ", "))
+	}
+
+	for _, c := range out {
+		if expr, err = d.windowFunctionExpr(c.Expression); err != nil {
+			return q.SetError(err)
+		}
+
+		expr = exp.NewLiteralExpression(
+			"? OVER ("+strings.Join(over, " ")+")",
+			append([]any{expr}, overArgs...)...,
+		)
+
+		selected = append(selected, exp.NewAliasExpression(expr, c.Identifier))
+	}
+
+	return d.dialect.GOQU().From(q.Select(selected...).As(subQueryAlias))
+}
+
+// windowFunctionExpr converts the window function (without the window definition)
+func (d *model) windowFunctionExpr(n *ql.ASTNode) (out exp.Expression, err error) {
+	if n == nil {
+		return nil, fmt.Errorf("window function not defined")
+	}
+
+	fnc := strings.ToLower(n.Ref)
+	switch fnc {
+	case "row_number", "rank", "dense_rank":
+		return exp.NewLiteralExpression(strings.ToUpper(fnc) + "()"), nil
+
+	case "lag", "lead":
+		if len(n.Args) == 0 {
+			return nil, fmt.Errorf("function %s requires an argument", fnc)
+		}
+
+		var (
+			args   = make([]any, 0, 2)
+			offset = 1
+		)
+
+		if out, err = d.convertQuery(n.Args[0]); err != nil {
+			return
+		}
+		args = append(args, out)
+
+		if len(n.Args) > 1 && n.Args[1].Value != nil {
+			// offset is validated by the DAL; interpolate it to avoid
+			// issues with parameter types
+			offset = cast.ToInt(n.Args[1].Value.V.Get())
+		}
+
+		tpl := fmt.Sprintf("%s(?, %d)", strings.ToUpper(fnc), offset)
+		if len(n.Args) > 2 {
+			if out, err = d.convertQuery(n.Args[2]); err != nil {
+				return
+			}
+
+			tpl = fmt.Sprintf("%s(?, %d, ?)", strings.ToUpper(fnc), offset)
+			args = append(args, out)
+		}
+
+		return exp.NewLiteralExpression(tpl, args...), nil
+	}
+
+	// aggregate functions
+	return d.convertQuery(n)
+}
+
 func (d *model) lookupSql(pkv dal.ValueGetter) *goqu.SelectDataset {
 	var (
 		sel       = d.selectSql().Limit(1)
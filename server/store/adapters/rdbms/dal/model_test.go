@@ -758,3 +758,52 @@ func TestModel_AggregateWithCursors(t *testing.T) {
 	}
 
 }
+
+func TestModel_UpdateIf(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx = context.Background()
+
+		baseModel = &dal.Model{
+			Ident: t.Name(),
+			Attributes: []*dal.Attribute{
+				dal.PrimaryAttribute("id", &dal.CodecAlias{Ident: "id"}),
+				{Ident: "item", Type: &dal.TypeText{}},
+				{Ident: "revision", Type: &dal.TypeNumber{}},
+			},
+		}
+
+		m = Model(baseModel, s.DB, s.Dialect)
+
+		table, err = s.DataDefiner.ConvertModel(baseModel)
+
+		row = kv{}
+	)
+
+	req.NoError(err)
+	table.Temporary = true
+	req.NoError(s.DataDefiner.TableCreate(ctx, table))
+	req.NoError(truncate(ctx, table.Ident))
+
+	req.NoError(m.Create(ctx, &kv{"id": 1, "item": "i1", "revision": 1}))
+
+	t.Log("stale revision is not updated")
+	ok, err := m.UpdateIf(ctx, &kv{"id": 1, "item": "stale", "revision": 3}, map[string]any{"revision": 2})
+	req.NoError(err)
+	req.False(ok)
+
+	t.Log("current revision is updated")
+	ok, err = m.UpdateIf(ctx, &kv{"id": 1, "item": "i1.1", "revision": 2}, map[string]any{"revision": 1})
+	req.NoError(err)
+	req.True(ok)
+
+	req.NoError(m.Lookup(ctx, &kv{"id": 1}, row))
+	req.Equal("i1.1", cast.ToString(row["item"]))
+	req.Equal(2, cast.ToInt(row["revision"]))
+
+	t.Log("same revision can not be updated twice")
+	ok, err = m.UpdateIf(ctx, &kv{"id": 1, "item": "i1.2", "revision": 2}, map[string]any{"revision": 1})
+	req.NoError(err)
+	req.False(ok)
+}
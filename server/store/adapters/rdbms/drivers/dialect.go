@@ -29,6 +29,19 @@ type (
 
 		// @todo change this around; temporary fix as not sure how I'd rewrite
 		ExpandedJsonColumnSelector func(ident string) exp.Expression
+
+		// AggregateFunctions lists the aggregate functions the database
+		// can perform; aggregations using any other function are performed
+		// by the DAL.
+		//
+		// When not set, only the base aggregate functions are offloaded.
+		AggregateFunctions map[string]bool
+
+		// WindowFunctions lists the window functions the database can perform;
+		// window steps using any other function are performed by the DAL.
+		//
+		// When not set, window steps are not offloaded.
+		WindowFunctions map[string]bool
 	}
 
 	Dialect interface {
@@ -100,6 +113,36 @@ func init() {
 	goqu.SetDefaultPrepared(true)
 }
 
+// AggregateFunctions returns the base aggregate functions
+// extended with the provided ones
+func AggregateFunctions(ff ...string) map[string]bool {
+	out := map[string]bool{
+		"count": true,
+		"sum":   true,
+		"min":   true,
+		"max":   true,
+		"avg":   true,
+	}
+
+	for _, f := range ff {
+		out[f] = true
+	}
+
+	return out
+}
+
+// WindowFunctions returns the base window functions
+// extended with the provided ones
+func WindowFunctions(ff ...string) map[string]bool {
+	out := AggregateFunctions("row_number", "rank", "dense_rank", "lag", "lead")
+
+	for _, f := range ff {
+		out[f] = true
+	}
+
+	return out
+}
+
 // @note copied to data_definer_test to avoid import cycle; if modified, fixup both parts
 func IndexFieldModifiers(attr *dal.Attribute, quoteIdent func(i string) string, mm ...dal.IndexFieldModifier) (string, error) {
 	var (
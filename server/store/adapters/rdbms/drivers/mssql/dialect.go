@@ -32,6 +32,8 @@ var (
 	nuances = drivers.Nuances{
 		HavingClauseMustUseAlias: true,
 		TwoStepUpsert:            true,
+
+		AggregateFunctions: drivers.AggregateFunctions("count_distinct", "stddev", "string_agg"),
 	}
 )
 
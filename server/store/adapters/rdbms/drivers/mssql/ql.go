@@ -16,6 +16,17 @@ var (
 			},
 		},
 
+		"regex": {
+			HandlerE: func(e ...exp.Expression) (exp.Expression, error) {
+				return nil, fmt.Errorf("regular expressions are not supported on this database")
+			},
+		},
+		"nregex": {
+			HandlerE: func(e ...exp.Expression) (exp.Expression, error) {
+				return nil, fmt.Errorf("regular expressions are not supported on this database")
+			},
+		},
+
 		"date_add": {
 			HandlerE: func(e ...exp.Expression) (exp.Expression, error) {
 				return nil, fmt.Errorf("@todo not implemented")
@@ -70,6 +81,23 @@ var (
 			},
 		},
 
+		// aggregates
+		"stddev": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewSQLFunctionExpression("STDEVP", args[0])
+			},
+		},
+		"string_agg": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				var sep exp.Expression = exp.NewLiteralExpression("','")
+				if len(args) > 1 {
+					sep = args[1]
+				}
+
+				return exp.NewLiteralExpression("STRING_AGG(CAST(? AS NVARCHAR(MAX)), ?)", args[0], sep)
+			},
+		},
+
 		"timestamp": {
 			Handler: func(args ...exp.Expression) exp.Expression {
 				return exp.NewLiteralExpression("CONVERT(DATETIME, ?)", args[0])
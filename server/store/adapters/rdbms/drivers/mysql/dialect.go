@@ -31,6 +31,12 @@ var (
 
 	nuances = drivers.Nuances{
 		HavingClauseMustUseAlias: false,
+
+		AggregateFunctions: drivers.AggregateFunctions("count_distinct", "stddev", "string_agg"),
+
+		// Window functions are not available before MySQL 8.0 so the
+		// window steps are performed by the DAL
+		WindowFunctions: nil,
 	}
 )
 
@@ -403,7 +409,7 @@ func (d mysqlDialect) ExprHandler(n *ql.ASTNode, args ...exp.Expression) (expr e
 	case "nin":
 		return drivers.OpHandlerNotIn(d, n, args...)
 
-	case "like", "nlike":
+	case "like", "nlike", "ilike", "nilike":
 		for a := range args {
 			args[a] = exp.NewLiteralExpression("LOWER(?)", args[a])
 		}
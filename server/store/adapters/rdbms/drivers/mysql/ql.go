@@ -1,6 +1,9 @@
 package mysql
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/ql"
 	"github.com/doug-martin/goqu/v9/exp"
 )
@@ -12,5 +15,45 @@ var (
 				return exp.NewSQLFunctionExpression("STD", args[0])
 			},
 		},
+
+		// aggregates
+		"stddev": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewSQLFunctionExpression("STDDEV_POP", args[0])
+			},
+		},
+		"string_agg": {
+			HandlerE: func(args ...exp.Expression) (exp.Expression, error) {
+				sep := ","
+				if len(args) > 1 {
+					var err error
+					if sep, err = groupConcatSeparator(args[1]); err != nil {
+						return nil, err
+					}
+				}
+
+				// GROUP_CONCAT's separator can't be bound via value placeholders
+				// so it needs to be interpolated into the expression
+				return exp.NewLiteralExpression(fmt.Sprintf("GROUP_CONCAT(? SEPARATOR '%s')", sep), args[0]), nil
+			},
+		},
 	}.ExprHandlers()
 )
+
+// groupConcatSeparator extracts the separator from the literal expression
+//
+// Separators with quotes and backslashes are rejected since they are
+// interpolated into the query
+func groupConcatSeparator(e exp.Expression) (string, error) {
+	le, ok := e.(exp.LiteralExpression)
+	if !ok || len(le.Args()) == 0 {
+		return "", fmt.Errorf("string_agg separator must be a string literal")
+	}
+
+	sep, ok := le.Args()[0].(string)
+	if !ok || strings.ContainsAny(sep, `'"\`) {
+		return "", fmt.Errorf("unsupported string_agg separator")
+	}
+
+	return sep, nil
+}
@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/ql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter(t *testing.T) {
+	const SELECT = "SELECT "
+	var (
+		conv = ql.Converter(ql.RefHandler(dialect.ExprHandler))
+
+		cases = []struct {
+			qry  string
+			sql  string
+			args []any
+		}{
+			{
+				qry:  `'abc' ILIKE 'A%'`,
+				sql:  "(LOWER(?) LIKE LOWER(?) ESCAPE ?)",
+				args: []any{"abc", "A%", `\`},
+			},
+			{
+				qry:  `'abc' REGEX '^a'`,
+				sql:  "(? REGEXP BINARY ?)",
+				args: []any{"abc", "^a"},
+			},
+			{
+				qry:  `count_distinct(42)`,
+				sql:  "COUNT(DISTINCT ?)",
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `stddev(42)`,
+				sql:  "STDDEV_POP(?)",
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `string_agg('a')`,
+				sql:  "GROUP_CONCAT(? SEPARATOR ',')",
+				args: []any{"a"},
+			},
+			{
+				qry:  `string_agg('a', '; ')`,
+				sql:  "GROUP_CONCAT(? SEPARATOR '; ')",
+				args: []any{"a"},
+			},
+		}
+	)
+
+	for _, c := range cases {
+		t.Run(c.qry, func(t *testing.T) {
+			req := require.New(t)
+
+			ee, err := conv.Parse(c.qry)
+			req.NoError(err)
+
+			sql, args, err := dialect.GOQU().Select(ee).ToSQL()
+			req.NoError(err)
+
+			p := strings.Index(sql, SELECT)
+			req.Zero(p)
+
+			sql = sql[p+len(SELECT):]
+
+			req.Equal(c.sql, sql)
+			req.Equal(c.args, args)
+		})
+	}
+
+	t.Run("string_agg with quoted separator", func(t *testing.T) {
+		_, err := conv.Parse(`string_agg('a', '"')`)
+		require.EqualError(t, err, "unsupported string_agg separator")
+	})
+}
@@ -30,6 +30,9 @@ var (
 
 	nuances = drivers.Nuances{
 		HavingClauseMustUseAlias: true,
+
+		AggregateFunctions: drivers.AggregateFunctions("count_distinct", "median", "percentile", "stddev", "string_agg"),
+		WindowFunctions:    drivers.WindowFunctions("stddev"),
 	}
 )
 
@@ -374,7 +377,7 @@ func (d postgresDialect) ExprHandler(n *ql.ASTNode, args ...exp.Expression) (exp
 	case "nin":
 		return drivers.OpHandlerNotIn(d, n, args...)
 
-	case "like", "nlike":
+	case "like", "nlike", "ilike", "nilike":
 		if dalType, ok := n.Args[0].Meta["dal.Attribute"].(*dal.Attribute); ok {
 			col, err := d.AttributeToColumn(dalType)
 			if err != nil {
@@ -384,12 +387,18 @@ func (d postgresDialect) ExprHandler(n *ql.ASTNode, args ...exp.Expression) (exp
 			// if the type is id (numeric) data type, then cast it to text
 			if col.Type.Name == "NUMERIC" {
 				op := "LIKE"
-				if ref == "nlike" {
+				if ref == "nlike" || ref == "nilike" {
 					op = "NOT LIKE"
 				}
 				return castColumnDataToText(op, args...)
 			}
 		}
+
+		// LIKE is case-sensitive in postgres
+		if ref == "nlike" || ref == "nilike" {
+			return ql.LikeExpression("NOT ILIKE", args...), nil
+		}
+		return ql.LikeExpression("ILIKE", args...), nil
 	}
 
 	return ref2exp.RefHandler(n, args...)
@@ -134,6 +134,33 @@ var (
 			},
 		},
 
+		// aggregates
+		"median": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewLiteralExpression("PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY ?)", args[0])
+			},
+		},
+		"percentile": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewLiteralExpression("PERCENTILE_CONT(?::DOUBLE PRECISION) WITHIN GROUP (ORDER BY ?)", args[1], args[0])
+			},
+		},
+		"stddev": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewSQLFunctionExpression("STDDEV_POP", args[0])
+			},
+		},
+		"string_agg": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				var sep exp.Expression = exp.NewLiteralExpression("','")
+				if len(args) > 1 {
+					sep = args[1]
+				}
+
+				return exp.NewLiteralExpression("STRING_AGG(?::TEXT, ?::TEXT)", args[0], sep)
+			},
+		},
+
 		// functions currently unsupported in PostgreSQL store backend
 		// "STD": {
 		//	Handler: func(args ...exp.Expression) exp.Expression {
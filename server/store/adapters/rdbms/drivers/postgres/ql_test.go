@@ -60,6 +60,46 @@ func TestConverter(t *testing.T) {
 				sql:  `TO_CHAR($1::TIMESTAMPTZ, $2::TEXT)`,
 				args: []any{"2022-07-21", "Dy"},
 			},
+			{
+				qry:  `'abc' ILIKE 'A%'`,
+				sql:  `($1 ILIKE $2 ESCAPE $3)`,
+				args: []any{"abc", "A%", `\`},
+			},
+			{
+				qry:  `'abc' REGEX '^a'`,
+				sql:  `($1 ~ $2)`,
+				args: []any{"abc", "^a"},
+			},
+			{
+				qry:  `'abc' NOT REGEX '^a'`,
+				sql:  `($1 !~ $2)`,
+				args: []any{"abc", "^a"},
+			},
+			{
+				qry:  `count_distinct(42)`,
+				sql:  `COUNT(DISTINCT $1)`,
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `median(42)`,
+				sql:  `PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY $1)`,
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `percentile(42, 0.9)`,
+				sql:  `PERCENTILE_CONT($1::DOUBLE PRECISION) WITHIN GROUP (ORDER BY $2)`,
+				args: []any{0.9, int64(42)},
+			},
+			{
+				qry:  `stddev(42)`,
+				sql:  `STDDEV_POP($1)`,
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `string_agg('a', ';')`,
+				sql:  `STRING_AGG($1::TEXT, $2::TEXT)`,
+				args: []any{"a", ";"},
+			},
 		}
 	)
 
@@ -30,6 +30,9 @@ var (
 	nuances = drivers.Nuances{
 		HavingClauseMustUseAlias: true,
 
+		AggregateFunctions: drivers.AggregateFunctions("count_distinct", "string_agg"),
+		WindowFunctions:    drivers.WindowFunctions(),
+
 		ExpandedJsonColumnSelector: func(ident string) exp.Expression {
 			return exp.NewLiteralExpression(fmt.Sprintf(`%s.value`, ident))
 		},
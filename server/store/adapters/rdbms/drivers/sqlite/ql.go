@@ -88,6 +88,18 @@ var (
 			},
 		},
 
+		// aggregates
+		"string_agg": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				var sep exp.Expression = exp.NewLiteralExpression("','")
+				if len(args) > 1 {
+					sep = args[1]
+				}
+
+				return exp.NewSQLFunctionExpression("GROUP_CONCAT", args[0], sep)
+			},
+		},
+
 		// functions currently unsupported in SQLite store backend
 		// "DATE_ADD": {
 		//	Handler: func(args ...exp.Expression) exp.Expression {
@@ -65,6 +65,26 @@ func TestConverter(t *testing.T) {
 				sql:  `STRFTIME(?, ?)`,
 				args: []any{"%d", "2022-07-21"},
 			},
+			{
+				qry:  `'abc' ILIKE 'A%'`,
+				sql:  `(? LIKE ? ESCAPE ?)`,
+				args: []any{"abc", "A%", `\`},
+			},
+			{
+				qry:  `'abc' NOT REGEX '^a'`,
+				sql:  `(? NOT REGEXP ?)`,
+				args: []any{"abc", "^a"},
+			},
+			{
+				qry:  `count_distinct(42)`,
+				sql:  `COUNT(DISTINCT ?)`,
+				args: []any{int64(42)},
+			},
+			{
+				qry:  `string_agg('a', ';')`,
+				sql:  `GROUP_CONCAT(?, ?)`,
+				args: []any{"a", ";"},
+			},
 		}
 	)
 
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/dal"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMatchingConformance makes sure the in-memory DAL evaluator
+// matches the same rows as the database does for pattern matching operators
+func TestMatchingConformance(t *testing.T) {
+	var (
+		ctx = context.Background()
+		log = logger.Default()
+	)
+
+	var (
+		req = require.New(t)
+	)
+
+	const (
+		dalConnID    = 1
+		dalTableName = "lil_dal_matching_test"
+	)
+
+	_, err := conn.db.Exec("DROP TABLE IF EXISTS " + dalTableName)
+	req.NoError(err)
+
+	_, err = conn.db.Exec("CREATE TABLE " + dalTableName + " (id BIGINT PRIMARY KEY, name TEXT)")
+	req.NoError(err)
+
+	defer func() {
+		_, _ = conn.db.Exec("DROP TABLE IF EXISTS " + dalTableName)
+	}()
+
+	cw := dal.MakeConnection(
+		dalConnID,
+		conn.store.ToDalConn(),
+		dal.ConnectionParams{},
+		dal.ConnectionConfig{},
+	)
+
+	id.Init(ctx)
+
+	svc, err := dal.New(log, true)
+	req.NoError(err)
+	req.NoError(svc.ReplaceConnection(ctx, cw, true))
+
+	model := &dal.Model{
+		Ident:        dalTableName,
+		ResourceID:   42,
+		ConnectionID: dalConnID,
+		Attributes: dal.AttributeSet{
+			&dal.Attribute{
+				Ident:      "ID",
+				PrimaryKey: true,
+				Type:       &dal.TypeID{},
+				Store:      &dal.CodecAlias{Ident: "id"},
+			},
+
+			&dal.Attribute{
+				Ident:      "name",
+				Filterable: true,
+				Type:       &dal.TypeText{},
+				Store:      &dal.CodecAlias{Ident: "name"},
+			},
+		},
+	}
+
+	_, err = svc.ReplaceModel(ctx, nil, model)
+	req.NoError(err)
+	req.Empty(svc.SearchModelIssues(model.ResourceID))
+
+	mr := dal.ModelRef{ConnectionID: dalConnID, ResourceID: model.ResourceID}
+
+	for i, name := range []string{"John Doe", "jane doe", "50% off", "under_score", "Ab.c"} {
+		req.NoError(svc.Create(ctx, mr, nil, (&dal.Row{}).
+			WithValue("ID", 0, uint64(i+1)).
+			WithValue("name", 0, name),
+		))
+	}
+
+	collect := func(iter dal.Iterator) (out []uint64) {
+		for iter.Next(ctx) {
+			r := &dal.Row{}
+			req.NoError(iter.Scan(r))
+
+			id, err := r.GetValue("ID", 0)
+			req.NoError(err)
+			out = append(out, cast.ToUint64(id))
+		}
+		req.NoError(iter.Err())
+		req.NoError(iter.Close())
+
+		sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+		return
+	}
+
+	tcc := []struct {
+		expr string
+		out  []uint64
+	}{
+		{expr: `name LIKE 'j%'`, out: []uint64{1, 2}},
+		{expr: `name LIKE '%DOE'`, out: []uint64{1, 2}},
+		{expr: `name NOT LIKE '%doe'`, out: []uint64{3, 4, 5}},
+		{expr: `name ILIKE 'JANE%'`, out: []uint64{2}},
+		{expr: `name NOT ILIKE '%e'`, out: []uint64{3, 5}},
+		{expr: `name LIKE 'ab_c'`, out: []uint64{5}},
+		{expr: `name LIKE '50\\%%'`, out: []uint64{3}},
+		{expr: `name LIKE '50\\%'`, out: nil},
+		{expr: `name LIKE 'under\\_%'`, out: []uint64{4}},
+		{expr: `name LIKE 'ab\\_c'`, out: nil},
+		{expr: `name NOT LIKE '%\\_%'`, out: []uint64{1, 2, 3, 5}},
+		{expr: `name REGEX '^J'`, out: []uint64{1}},
+		{expr: `name REGEX 'h[a-z] '`, out: []uint64{1}},
+		{expr: `name NOT REGEX 'doe'`, out: []uint64{1, 3, 4, 5}},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.expr, func(t *testing.T) {
+			req = require.New(t)
+			f := filter.Generic(filter.WithExpression(tc.expr))
+
+			// database
+			iter, err := svc.Search(ctx, mr, nil, f)
+			req.NoError(err)
+			req.Equal(tc.out, collect(iter), "database")
+
+			// in-memory; the union step can not be offloaded into the database
+			// so the filter is evaluated by the DAL
+			//
+			// second source returns no rows; it is there only to satisfy the union
+			iter, err = svc.Run(ctx, dal.Pipeline{
+				&dal.Datasource{Ident: "a", ModelRef: mr},
+				&dal.Datasource{Ident: "b", ModelRef: mr, Filter: filter.Generic(filter.WithExpression("false"))},
+				&dal.Union{Ident: "u", RelSources: []string{"a", "b"}, Filter: f},
+			})
+			req.NoError(err)
+			req.Equal(tc.out, collect(iter), "in-memory")
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/dal"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/modern-go/reflect2"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWindowConformance makes sure the window step offloaded to the database
+// produces the same rows as the window step performed by the DAL
+func TestWindowConformance(t *testing.T) {
+	var (
+		ctx = context.Background()
+		log = logger.Default()
+	)
+
+	var (
+		req = require.New(t)
+	)
+
+	if !conn.isSQLite && !conn.isPostgres {
+		t.Skip("window functions are not offloaded to this database")
+	}
+
+	const (
+		dalConnID    = 1
+		dalTableName = "lil_dal_window_test"
+	)
+
+	_, err := conn.db.Exec("DROP TABLE IF EXISTS " + dalTableName)
+	req.NoError(err)
+
+	_, err = conn.db.Exec("CREATE TABLE " + dalTableName + " (id BIGINT PRIMARY KEY, grp TEXT, val NUMERIC)")
+	req.NoError(err)
+
+	defer func() {
+		_, _ = conn.db.Exec("DROP TABLE IF EXISTS " + dalTableName)
+	}()
+
+	cw := dal.MakeConnection(
+		dalConnID,
+		conn.store.ToDalConn(),
+		dal.ConnectionParams{},
+		dal.ConnectionConfig{},
+	)
+
+	id.Init(ctx)
+
+	svc, err := dal.New(log, true)
+	req.NoError(err)
+	req.NoError(svc.ReplaceConnection(ctx, cw, true))
+
+	model := &dal.Model{
+		Ident:        dalTableName,
+		ResourceID:   43,
+		ConnectionID: dalConnID,
+		Attributes: dal.AttributeSet{
+			&dal.Attribute{
+				Ident:      "ID",
+				PrimaryKey: true,
+				Type:       &dal.TypeID{},
+				Store:      &dal.CodecAlias{Ident: "id"},
+			},
+			&dal.Attribute{
+				Ident:      "grp",
+				Filterable: true,
+				Sortable:   true,
+				Type:       &dal.TypeText{},
+				Store:      &dal.CodecAlias{Ident: "grp"},
+			},
+			&dal.Attribute{
+				Ident:      "val",
+				Filterable: true,
+				Sortable:   true,
+				Type:       &dal.TypeNumber{},
+				Store:      &dal.CodecAlias{Ident: "val"},
+			},
+		},
+	}
+
+	_, err = svc.ReplaceModel(ctx, nil, model)
+	req.NoError(err)
+	req.Empty(svc.SearchModelIssues(model.ResourceID))
+
+	mr := dal.ModelRef{ConnectionID: dalConnID, ResourceID: model.ResourceID}
+
+	for i, r := range []struct {
+		grp string
+		val float64
+	}{
+		{"a", 10}, {"b", 5}, {"a", 30}, {"a", 20}, {"b", 5}, {"a", 20}, {"c", 1},
+	} {
+		req.NoError(svc.Create(ctx, mr, nil, (&dal.Row{}).
+			WithValue("ID", 0, uint64(i+1)).
+			WithValue("grp", 0, r.grp).
+			WithValue("val", 0, r.val),
+		))
+	}
+
+	collect := func(iter dal.Iterator) (out []map[string]any) {
+		for iter.Next(ctx) {
+			r := &dal.Row{}
+			req.NoError(iter.Scan(r))
+
+			aux := make(map[string]any)
+			for k := range r.CountValues() {
+				v, err := r.GetValue(k, 0)
+				req.NoError(err)
+
+				switch {
+				case reflect2.IsNil(v):
+					aux[k] = nil
+				case k == "grp":
+					aux[k] = cast.ToString(v)
+				default:
+					aux[k] = cast.ToFloat64(v)
+				}
+			}
+			out = append(out, aux)
+		}
+		req.NoError(iter.Err())
+		req.NoError(iter.Close())
+		return
+	}
+
+	window := func(src string) *dal.Window {
+		return &dal.Window{
+			Ident:       "w",
+			RelSource:   src,
+			PartitionBy: []string{"grp"},
+			OrderBy:     filter.SortExprSet{{Column: "val", Descending: true}},
+			Filter: filter.Generic(
+				filter.WithExpression("drnk <= 2"),
+				filter.WithOrderBy(filter.SortExprSet{{Column: "grp"}, {Column: "ID"}}),
+			),
+			OutAttributes: []dal.WindowAttr{
+				{Identifier: "rn", RawExpr: "row_number()"},
+				{Identifier: "rnk", RawExpr: "rank()"},
+				{Identifier: "drnk", RawExpr: "dense_rank()"},
+				{Identifier: "prev", RawExpr: "lag(val)"},
+				{Identifier: "next", RawExpr: "lead(val, 1, 0)"},
+				{Identifier: "total", RawExpr: "sum(val)"},
+			},
+		}
+	}
+
+	// database
+	iter, err := svc.Run(ctx, dal.Pipeline{
+		window("a"),
+		&dal.Datasource{Ident: "a", ModelRef: mr},
+	})
+	req.NoError(err)
+	db := collect(iter)
+
+	// in-memory; the union step can not be offloaded into the database
+	// so the window is calculated by the DAL
+	//
+	// second source returns no rows; it is there only to satisfy the union
+	iter, err = svc.Run(ctx, dal.Pipeline{
+		window("u"),
+		&dal.Union{Ident: "u", RelSources: []string{"a", "b"}},
+		&dal.Datasource{Ident: "a", ModelRef: mr},
+		&dal.Datasource{Ident: "b", ModelRef: mr, Filter: filter.Generic(filter.WithExpression("false"))},
+	})
+	req.NoError(err)
+	mem := collect(iter)
+
+	req.Len(mem, 6)
+	for i, r := range mem {
+		// row numbers of peers are not deterministic
+		delete(r, "rn")
+		delete(db[i], "rn")
+	}
+
+	req.Equal(mem, db)
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
 	"github.com/cortezaproject/corteza/server/pkg/filter"
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
+	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -52,6 +54,39 @@ func DefaultFilters() (f *extendedFilters) {
 		return ee, f, err
 	}
 
+	f.RbacRuleChange = func(s *Store, f rbacType.RuleChangeFilter) (ee []goqu.Expression, _ rbacType.RuleChangeFilter, err error) {
+		if ee, f, err = RbacRuleChangeFilter(s.Dialect, f); err != nil {
+			return
+		}
+
+		// make sure we always sort ID, descending
+		if f.Sorting, err = filter.NewSorting("id DESC"); err != nil {
+			return
+		}
+
+		if f.AfterChangeSetID > 0 {
+			ee = append(ee, goqu.C("rel_change_set").Gt(f.AfterChangeSetID))
+		}
+
+		if f.ChangedAfter != nil {
+			ee = append(ee, goqu.C("ts").Gt(f.ChangedAfter))
+		}
+
+		return ee, f, err
+	}
+
+	f.SchedulerClaim = func(s *Store, f schedulerType.ClaimFilter) (ee []goqu.Expression, _ schedulerType.ClaimFilter, err error) {
+		if ee, f, err = SchedulerClaimFilter(s.Dialect, f); err != nil {
+			return
+		}
+
+		if f.ExpiredBefore != nil {
+			ee = append(ee, goqu.C("expires_at").Lt(f.ExpiredBefore))
+		}
+
+		return ee, f, err
+	}
+
 	f.Application = func(s *Store, f systemType.ApplicationFilter) (ee []goqu.Expression, _ systemType.ApplicationFilter, err error) {
 		if ee, f, err = ApplicationFilter(s.Dialect, f); err != nil {
 			return
@@ -76,6 +111,18 @@ func DefaultFilters() (f *extendedFilters) {
 		return ee, f, err
 	}
 
+	f.AutomationWorkflowVersion = func(s *Store, f automationType.WorkflowVersionFilter) (ee []goqu.Expression, _ automationType.WorkflowVersionFilter, err error) {
+		if ee, f, err = AutomationWorkflowVersionFilter(s.Dialect, f); err != nil {
+			return
+		}
+
+		if len(f.Version) > 0 {
+			ee = append(ee, goqu.C("version").In(f.Version))
+		}
+
+		return ee, f, err
+	}
+
 	f.ComposeAttachment = func(s *Store, f composeType.AttachmentFilter) (ee []goqu.Expression, _ composeType.AttachmentFilter, err error) {
 		if ee, f, err = ComposeAttachmentFilter(s.Dialect, f); err != nil {
 			return
@@ -368,6 +415,34 @@ func DefaultFilters() (f *extendedFilters) {
 		return ee, f, nil
 	}
 
+	f.WebhookDelivery = func(s *Store, f systemType.WebhookDeliveryFilter) (ee []goqu.Expression, _ systemType.WebhookDeliveryFilter, err error) {
+		if ee, f, err = WebhookDeliveryFilter(s.Dialect, f); err != nil {
+			return
+		}
+
+		if len(f.Status) > 0 {
+			ee = append(ee, goqu.C("status").In(f.Status))
+		}
+
+		if f.DueAt != nil {
+			ee = append(ee, goqu.C("next_attempt_at").Lte(f.DueAt))
+		}
+
+		return ee, f, nil
+	}
+
+	f.ReportDelivery = func(s *Store, f systemType.ReportDeliveryFilter) (ee []goqu.Expression, _ systemType.ReportDeliveryFilter, err error) {
+		if ee, f, err = ReportDeliveryFilter(s.Dialect, f); err != nil {
+			return
+		}
+
+		if len(f.Status) > 0 {
+			ee = append(ee, goqu.C("status").In(f.Status))
+		}
+
+		return ee, f, nil
+	}
+
 	return
 }
 
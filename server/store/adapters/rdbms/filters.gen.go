@@ -15,6 +15,7 @@ import (
 	flagType "github.com/cortezaproject/corteza/server/pkg/flag/types"
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"github.com/doug-martin/goqu/v9"
@@ -66,6 +67,9 @@ type (
 		// optional automationWorkflow filter function called after the generated function
 		AutomationWorkflow func(*Store, automationType.WorkflowFilter) ([]goqu.Expression, automationType.WorkflowFilter, error)
 
+		// optional automationWorkflowVersion filter function called after the generated function
+		AutomationWorkflowVersion func(*Store, automationType.WorkflowVersionFilter) ([]goqu.Expression, automationType.WorkflowVersionFilter, error)
+
 		// optional composeAttachment filter function called after the generated function
 		ComposeAttachment func(*Store, composeType.AttachmentFilter) ([]goqu.Expression, composeType.AttachmentFilter, error)
 
@@ -135,12 +139,21 @@ type (
 		// optional rbacRule filter function called after the generated function
 		RbacRule func(*Store, rbacType.RuleFilter) ([]goqu.Expression, rbacType.RuleFilter, error)
 
+		// optional rbacRuleChange filter function called after the generated function
+		RbacRuleChange func(*Store, rbacType.RuleChangeFilter) ([]goqu.Expression, rbacType.RuleChangeFilter, error)
+
 		// optional reminder filter function called after the generated function
 		Reminder func(*Store, systemType.ReminderFilter) ([]goqu.Expression, systemType.ReminderFilter, error)
 
 		// optional report filter function called after the generated function
 		Report func(*Store, systemType.ReportFilter) ([]goqu.Expression, systemType.ReportFilter, error)
 
+		// optional reportDelivery filter function called after the generated function
+		ReportDelivery func(*Store, systemType.ReportDeliveryFilter) ([]goqu.Expression, systemType.ReportDeliveryFilter, error)
+
+		// optional reportSubscription filter function called after the generated function
+		ReportSubscription func(*Store, systemType.ReportSubscriptionFilter) ([]goqu.Expression, systemType.ReportSubscriptionFilter, error)
+
 		// optional resourceActivity filter function called after the generated function
 		ResourceActivity func(*Store, discoveryType.ResourceActivityFilter) ([]goqu.Expression, discoveryType.ResourceActivityFilter, error)
 
@@ -153,6 +166,9 @@ type (
 		// optional roleMember filter function called after the generated function
 		RoleMember func(*Store, systemType.RoleMemberFilter) ([]goqu.Expression, systemType.RoleMemberFilter, error)
 
+		// optional schedulerClaim filter function called after the generated function
+		SchedulerClaim func(*Store, schedulerType.ClaimFilter) ([]goqu.Expression, schedulerType.ClaimFilter, error)
+
 		// optional settingValue filter function called after the generated function
 		SettingValue func(*Store, systemType.SettingsFilter) ([]goqu.Expression, systemType.SettingsFilter, error)
 
@@ -161,6 +177,12 @@ type (
 
 		// optional user filter function called after the generated function
 		User func(*Store, systemType.UserFilter) ([]goqu.Expression, systemType.UserFilter, error)
+
+		// optional webhook filter function called after the generated function
+		Webhook func(*Store, systemType.WebhookFilter) ([]goqu.Expression, systemType.WebhookFilter, error)
+
+		// optional webhookDelivery filter function called after the generated function
+		WebhookDelivery func(*Store, systemType.WebhookDeliveryFilter) ([]goqu.Expression, systemType.WebhookDeliveryFilter, error)
 	}
 )
 
@@ -295,6 +317,10 @@ func ApplicationFilter(d drivers.Dialect, f systemType.ApplicationFilter) (ee []
 // This function is auto-generated
 func AttachmentFilter(d drivers.Dialect, f systemType.AttachmentFilter) (ee []goqu.Expression, _ systemType.AttachmentFilter, err error) {
 
+	if expr := stateNilComparison(d, "deleted_at", f.Deleted); expr != nil {
+		ee = append(ee, expr)
+	}
+
 	if val := strings.TrimSpace(f.Kind); len(val) > 0 {
 		ee = append(ee, goqu.C("kind").Eq(f.Kind))
 	}
@@ -491,6 +517,25 @@ func AutomationWorkflowFilter(d drivers.Dialect, f automationType.WorkflowFilter
 	return ee, f, err
 }
 
+// AutomationWorkflowVersionFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationWorkflowVersions() and can be extended
+// by setting Store.Filters.AutomationWorkflowVersion. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationWorkflowVersionFilter(d drivers.Dialect, f automationType.WorkflowVersionFilter) (ee []goqu.Expression, _ automationType.WorkflowVersionFilter, err error) {
+
+	if len(f.WorkflowID) > 0 {
+		ee = append(ee, goqu.C("rel_workflow").In(f.WorkflowID))
+	}
+
+	// @todo codegen warning: filtering by Version ([]uint) not supported,
+	//       see rdbms.go.tpl and add an exception
+
+	return ee, f, err
+}
+
 // ComposeAttachmentFilter returns logical expressions
 //
 // This function is called from Store.QueryComposeAttachments() and can be extended
@@ -1148,6 +1193,26 @@ func RbacRuleFilter(d drivers.Dialect, f rbacType.RuleFilter) (ee []goqu.Express
 	return ee, f, err
 }
 
+// RbacRuleChangeFilter returns logical expressions
+//
+// This function is called from Store.QueryRbacRuleChanges() and can be extended
+// by setting Store.Filters.RbacRuleChange. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func RbacRuleChangeFilter(d drivers.Dialect, f rbacType.RuleChangeFilter) (ee []goqu.Expression, _ rbacType.RuleChangeFilter, err error) {
+
+	if len(f.ChangeSetID) > 0 {
+		ee = append(ee, goqu.C("rel_change_set").In(f.ChangeSetID))
+	}
+
+	if len(f.RoleID) > 0 {
+		ee = append(ee, goqu.C("rel_role").In(f.RoleID))
+	}
+
+	return ee, f, err
+}
+
 // ReminderFilter returns logical expressions
 //
 // This function is called from Store.QueryReminders() and can be extended
@@ -1202,6 +1267,58 @@ func ReportFilter(d drivers.Dialect, f systemType.ReportFilter) (ee []goqu.Expre
 	return ee, f, err
 }
 
+// ReportDeliveryFilter returns logical expressions
+//
+// This function is called from Store.QueryReportDeliverys() and can be extended
+// by setting Store.Filters.ReportDelivery. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ReportDeliveryFilter(d drivers.Dialect, f systemType.ReportDeliveryFilter) (ee []goqu.Expression, _ systemType.ReportDeliveryFilter, err error) {
+
+	if len(f.DeliveryID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.DeliveryID))
+	}
+
+	if len(f.SubscriptionID) > 0 {
+		ee = append(ee, goqu.C("rel_subscription").In(f.SubscriptionID))
+	}
+
+	if len(f.ReportID) > 0 {
+		ee = append(ee, goqu.C("rel_report").In(f.ReportID))
+	}
+
+	return ee, f, err
+}
+
+// ReportSubscriptionFilter returns logical expressions
+//
+// This function is called from Store.QueryReportSubscriptions() and can be extended
+// by setting Store.Filters.ReportSubscription. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ReportSubscriptionFilter(d drivers.Dialect, f systemType.ReportSubscriptionFilter) (ee []goqu.Expression, _ systemType.ReportSubscriptionFilter, err error) {
+
+	if expr := stateNilComparison(d, "deleted_at", f.Deleted); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if expr := stateFalseComparison(d, "enabled", f.Disabled); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if len(f.SubscriptionID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.SubscriptionID))
+	}
+
+	if len(f.ReportID) > 0 {
+		ee = append(ee, goqu.C("rel_report").In(f.ReportID))
+	}
+
+	return ee, f, err
+}
+
 // ResourceActivityFilter returns logical expressions
 //
 // This function is called from Store.QueryResourceActivitys() and can be extended
@@ -1305,6 +1422,22 @@ func RoleMemberFilter(d drivers.Dialect, f systemType.RoleMemberFilter) (ee []go
 	return ee, f, err
 }
 
+// SchedulerClaimFilter returns logical expressions
+//
+// This function is called from Store.QuerySchedulerClaims() and can be extended
+// by setting Store.Filters.SchedulerClaim. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func SchedulerClaimFilter(d drivers.Dialect, f schedulerType.ClaimFilter) (ee []goqu.Expression, _ schedulerType.ClaimFilter, err error) {
+
+	if ss := trimStringSlice(f.Job); len(ss) > 0 {
+		ee = append(ee, goqu.C("job").In(ss))
+	}
+
+	return ee, f, err
+}
+
 // SettingValueFilter returns logical expressions
 //
 // This function is called from Store.QuerySettingValues() and can be extended
@@ -1417,6 +1550,61 @@ func UserFilter(d drivers.Dialect, f systemType.UserFilter) (ee []goqu.Expressio
 	return ee, f, err
 }
 
+// WebhookFilter returns logical expressions
+//
+// This function is called from Store.QueryWebhooks() and can be extended
+// by setting Store.Filters.Webhook. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func WebhookFilter(d drivers.Dialect, f systemType.WebhookFilter) (ee []goqu.Expression, _ systemType.WebhookFilter, err error) {
+
+	if expr := stateNilComparison(d, "deleted_at", f.Deleted); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if expr := stateFalseComparison(d, "enabled", f.Disabled); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if val := strings.TrimSpace(f.Handle); len(val) > 0 {
+		ee = append(ee, goqu.C("handle").Eq(f.Handle))
+	}
+
+	if len(f.WebhookID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.WebhookID))
+	}
+
+	if f.Query != "" {
+		ee = append(ee, goqu.Or(
+			goqu.C("handle").ILike("%"+f.Query+"%"),
+			goqu.C("url").ILike("%"+f.Query+"%"),
+		))
+	}
+
+	return ee, f, err
+}
+
+// WebhookDeliveryFilter returns logical expressions
+//
+// This function is called from Store.QueryWebhookDeliverys() and can be extended
+// by setting Store.Filters.WebhookDelivery. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func WebhookDeliveryFilter(d drivers.Dialect, f systemType.WebhookDeliveryFilter) (ee []goqu.Expression, _ systemType.WebhookDeliveryFilter, err error) {
+
+	if len(f.DeliveryID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.DeliveryID))
+	}
+
+	if len(f.WebhookID) > 0 {
+		ee = append(ee, goqu.C("rel_webhook").In(f.WebhookID))
+	}
+
+	return ee, f, err
+}
+
 // trimStringSlice is a utility to trim all of the string slice elements and omit empty ones
 func trimStringSlice(in []string) []string {
 	out := make([]string, 0, len(in))
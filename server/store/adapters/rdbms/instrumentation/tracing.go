@@ -0,0 +1,133 @@
+package instrumentation
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+
+	"github.com/cortezaproject/corteza/server/pkg/tracing"
+	"github.com/ngrok/sqlmw"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Tracing instrumentation wrapper for sql driver
+	//
+	// Creates a span for each query, exec and transaction
+	// Query arguments are never added to the spans
+	tracer struct {
+		sqlmw.NullInterceptor
+		system attribute.KeyValue
+	}
+
+	// connector for drivers that do not implement driver.DriverContext
+	dsnConnector struct {
+		dsn    string
+		driver driver.Driver
+	}
+)
+
+// Tracing wraps the driver and returns connector for sql.OpenDB
+func Tracing(base driver.Driver, driverName, dsn string) (driver.Connector, error) {
+	d := sqlmw.Driver(base, &tracer{system: dbSystem(driverName)})
+
+	if dc, ok := d.(driver.DriverContext); ok {
+		return dc.OpenConnector(dsn)
+	}
+
+	return &dsnConnector{dsn: dsn, driver: d}, nil
+}
+
+// translates driver name to db.system attribute
+func dbSystem(driverName string) attribute.KeyValue {
+	switch {
+	case strings.HasPrefix(driverName, "postgres"):
+		return semconv.DBSystemPostgreSQL
+	case strings.HasPrefix(driverName, "mysql"):
+		return semconv.DBSystemMySQL
+	case strings.HasPrefix(driverName, "sqlite"):
+		return semconv.DBSystemSqlite
+	case strings.HasPrefix(driverName, "sqlserver"):
+		return semconv.DBSystemMSSQL
+	default:
+		return semconv.DBSystemOtherSQL
+	}
+}
+
+func (t tracer) start(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	aa := []attribute.KeyValue{t.system, semconv.DBOperation(name)}
+	if query != "" {
+		aa = append(aa, semconv.DBStatement(query))
+	}
+
+	return tracing.Tracer().Start(ctx, "db "+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(aa...))
+}
+
+// driver.ErrSkip is not an error, it instructs caller to take an alternative path
+func (tracer) end(span trace.Span, err error) {
+	if errors.Is(err, driver.ErrSkip) {
+		err = nil
+	}
+
+	tracing.End(span, err)
+}
+
+func (t tracer) ConnBeginTx(ctx context.Context, conn driver.ConnBeginTx, txOpts driver.TxOptions) (tx driver.Tx, err error) {
+	ctx, span := t.start(ctx, "begin", "")
+	tx, err = conn.BeginTx(ctx, txOpts)
+	t.end(span, err)
+	return
+}
+
+func (t tracer) ConnExecContext(ctx context.Context, conn driver.ExecerContext, query string, args []driver.NamedValue) (result driver.Result, err error) {
+	ctx, span := t.start(ctx, "exec", query)
+	result, err = conn.ExecContext(ctx, query, args)
+	t.end(span, err)
+	return
+}
+
+func (t tracer) ConnQueryContext(ctx context.Context, conn driver.QueryerContext, query string, args []driver.NamedValue) (rows driver.Rows, err error) {
+	ctx, span := t.start(ctx, "query", query)
+	rows, err = conn.QueryContext(ctx, query, args)
+	t.end(span, err)
+	return
+}
+
+func (t tracer) StmtExecContext(ctx context.Context, stmt driver.StmtExecContext, query string, args []driver.NamedValue) (result driver.Result, err error) {
+	ctx, span := t.start(ctx, "exec", query)
+	result, err = stmt.ExecContext(ctx, args)
+	t.end(span, err)
+	return
+}
+
+func (t tracer) StmtQueryContext(ctx context.Context, stmt driver.StmtQueryContext, query string, args []driver.NamedValue) (rows driver.Rows, err error) {
+	ctx, span := t.start(ctx, "query", query)
+	rows, err = stmt.QueryContext(ctx, args)
+	t.end(span, err)
+	return
+}
+
+func (t tracer) TxCommit(ctx context.Context, tx driver.Tx) (err error) {
+	_, span := t.start(ctx, "commit", "")
+	err = tx.Commit()
+	t.end(span, err)
+	return
+}
+
+func (t tracer) TxRollback(ctx context.Context, tx driver.Tx) (err error) {
+	_, span := t.start(ctx, "rollback", "")
+	err = tx.Rollback()
+	t.end(span, err)
+	return
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
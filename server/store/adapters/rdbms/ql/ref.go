@@ -9,6 +9,14 @@ import (
 	"github.com/doug-martin/goqu/v9/exp"
 )
 
+const (
+	// LikeEscape is the escape character in LIKE patterns
+	//
+	// Not all databases have a default one (SQLite, MSSQL)
+	// so it is always set explicitly
+	LikeEscape = `\`
+)
+
 type (
 	ExprHandlerMap map[string]*ExprHandler
 
@@ -173,12 +181,32 @@ var (
 		// @todo better negation?
 		"like": {
 			Handler: func(args ...exp.Expression) exp.Expression {
-				return exp.NewBooleanExpression(exp.ILikeOp, args[0], args[1])
+				return LikeExpression("LIKE", args...)
 			},
 		},
 		"nlike": {
 			Handler: func(args ...exp.Expression) exp.Expression {
-				return exp.NewBooleanExpression(exp.NotILikeOp, args[0], args[1])
+				return LikeExpression("NOT LIKE", args...)
+			},
+		},
+		"ilike": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return LikeExpression("LIKE", args...)
+			},
+		},
+		"nilike": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return LikeExpression("NOT LIKE", args...)
+			},
+		},
+		"regex": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewBooleanExpression(exp.RegexpLikeOp, args[0], args[1])
+			},
+		},
+		"nregex": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewBooleanExpression(exp.RegexpNotLikeOp, args[0], args[1])
 			},
 		},
 
@@ -293,6 +321,11 @@ var (
 				return exp.NewSQLFunctionExpression("MAX", args[0])
 			},
 		},
+		"count_distinct": {
+			Handler: func(args ...exp.Expression) exp.Expression {
+				return exp.NewLiteralExpression("COUNT(DISTINCT ?)", args[0])
+			},
+		},
 
 		"std": {
 			HandlerE: func(args ...exp.Expression) (exp.Expression, error) {
@@ -333,3 +366,9 @@ func (ee ExprHandlerMap) RefHandler(n *ql.ASTNode, args ...exp.Expression) (exp.
 
 	return ee[r].HandlerE(args...)
 }
+
+// LikeExpression builds pattern matching expression with the
+// operator (LIKE, NOT LIKE, ILIKE...) and an explicit escape character
+func LikeExpression(op string, args ...exp.Expression) exp.Expression {
+	return exp.NewLiteralExpression("(? "+op+" ? ESCAPE ?)", args[0], args[1], LikeEscape)
+}
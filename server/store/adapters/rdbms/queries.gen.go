@@ -15,6 +15,7 @@ import (
 	flagType "github.com/cortezaproject/corteza/server/pkg/flag/types"
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"github.com/doug-martin/goqu/v9"
 )
@@ -1027,12 +1028,14 @@ var (
 		return d.Select(
 			"id",
 			"rel_workflow",
+			"workflow_version",
 			"status",
 			"event_type",
 			"resource_type",
 			"input",
 			"output",
 			"stacktrace",
+			"state",
 			"created_by",
 			"created_at",
 			"purge_at",
@@ -1048,20 +1051,22 @@ var (
 	automationSessionInsertQuery = func(d goqu.DialectWrapper, res *automationType.Session) *goqu.InsertDataset {
 		return d.Insert(automationSessionTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"rel_workflow":  res.WorkflowID,
-				"status":        res.Status,
-				"event_type":    res.EventType,
-				"resource_type": res.ResourceType,
-				"input":         res.Input,
-				"output":        res.Output,
-				"stacktrace":    res.Stacktrace,
-				"created_by":    res.CreatedBy,
-				"created_at":    res.CreatedAt,
-				"purge_at":      res.PurgeAt,
-				"suspended_at":  res.SuspendedAt,
-				"completed_at":  res.CompletedAt,
-				"error":         res.Error,
+				"id":               res.ID,
+				"rel_workflow":     res.WorkflowID,
+				"workflow_version": res.WorkflowVersion,
+				"status":           res.Status,
+				"event_type":       res.EventType,
+				"resource_type":    res.ResourceType,
+				"input":            res.Input,
+				"output":           res.Output,
+				"stacktrace":       res.Stacktrace,
+				"state":            res.State,
+				"created_by":       res.CreatedBy,
+				"created_at":       res.CreatedAt,
+				"purge_at":         res.PurgeAt,
+				"suspended_at":     res.SuspendedAt,
+				"completed_at":     res.CompletedAt,
+				"error":            res.Error,
 			})
 	}
 
@@ -1075,19 +1080,21 @@ var (
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"rel_workflow":  res.WorkflowID,
-						"status":        res.Status,
-						"event_type":    res.EventType,
-						"resource_type": res.ResourceType,
-						"input":         res.Input,
-						"output":        res.Output,
-						"stacktrace":    res.Stacktrace,
-						"created_by":    res.CreatedBy,
-						"created_at":    res.CreatedAt,
-						"purge_at":      res.PurgeAt,
-						"suspended_at":  res.SuspendedAt,
-						"completed_at":  res.CompletedAt,
-						"error":         res.Error,
+						"rel_workflow":     res.WorkflowID,
+						"workflow_version": res.WorkflowVersion,
+						"status":           res.Status,
+						"event_type":       res.EventType,
+						"resource_type":    res.ResourceType,
+						"input":            res.Input,
+						"output":           res.Output,
+						"stacktrace":       res.Stacktrace,
+						"state":            res.State,
+						"created_by":       res.CreatedBy,
+						"created_at":       res.CreatedAt,
+						"purge_at":         res.PurgeAt,
+						"suspended_at":     res.SuspendedAt,
+						"completed_at":     res.CompletedAt,
+						"error":            res.Error,
 					},
 				),
 			)
@@ -1099,19 +1106,21 @@ var (
 	automationSessionUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Session) *goqu.UpdateDataset {
 		return d.Update(automationSessionTable).
 			Set(goqu.Record{
-				"rel_workflow":  res.WorkflowID,
-				"status":        res.Status,
-				"event_type":    res.EventType,
-				"resource_type": res.ResourceType,
-				"input":         res.Input,
-				"output":        res.Output,
-				"stacktrace":    res.Stacktrace,
-				"created_by":    res.CreatedBy,
-				"created_at":    res.CreatedAt,
-				"purge_at":      res.PurgeAt,
-				"suspended_at":  res.SuspendedAt,
-				"completed_at":  res.CompletedAt,
-				"error":         res.Error,
+				"rel_workflow":     res.WorkflowID,
+				"workflow_version": res.WorkflowVersion,
+				"status":           res.Status,
+				"event_type":       res.EventType,
+				"resource_type":    res.ResourceType,
+				"input":            res.Input,
+				"output":           res.Output,
+				"stacktrace":       res.Stacktrace,
+				"state":            res.State,
+				"created_by":       res.CreatedBy,
+				"created_at":       res.CreatedAt,
+				"purge_at":         res.PurgeAt,
+				"suspended_at":     res.SuspendedAt,
+				"completed_at":     res.CompletedAt,
+				"error":            res.Error,
 			}).
 			Where(automationSessionPrimaryKeys(res))
 	}
@@ -1291,6 +1300,7 @@ var (
 			"steps",
 			"paths",
 			"issues",
+			"published_version",
 			"run_as",
 			"owned_by",
 			"created_at",
@@ -1308,24 +1318,25 @@ var (
 	automationWorkflowInsertQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.InsertDataset {
 		return d.Insert(automationWorkflowTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"enabled":       res.Enabled,
-				"trace":         res.Trace,
-				"keep_sessions": res.KeepSessions,
-				"scope":         res.Scope,
-				"steps":         res.Steps,
-				"paths":         res.Paths,
-				"issues":        res.Issues,
-				"run_as":        res.RunAs,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"id":                res.ID,
+				"handle":            res.Handle,
+				"meta":              res.Meta,
+				"enabled":           res.Enabled,
+				"trace":             res.Trace,
+				"keep_sessions":     res.KeepSessions,
+				"scope":             res.Scope,
+				"steps":             res.Steps,
+				"paths":             res.Paths,
+				"issues":            res.Issues,
+				"published_version": res.PublishedVersion,
+				"run_as":            res.RunAs,
+				"owned_by":          res.OwnedBy,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"deleted_at":        res.DeletedAt,
+				"created_by":        res.CreatedBy,
+				"updated_by":        res.UpdatedBy,
+				"deleted_by":        res.DeletedBy,
 			})
 	}
 
@@ -1339,23 +1350,24 @@ var (
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"handle":        res.Handle,
-						"meta":          res.Meta,
-						"enabled":       res.Enabled,
-						"trace":         res.Trace,
-						"keep_sessions": res.KeepSessions,
-						"scope":         res.Scope,
-						"steps":         res.Steps,
-						"paths":         res.Paths,
-						"issues":        res.Issues,
-						"run_as":        res.RunAs,
-						"owned_by":      res.OwnedBy,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
-						"created_by":    res.CreatedBy,
-						"updated_by":    res.UpdatedBy,
-						"deleted_by":    res.DeletedBy,
+						"handle":            res.Handle,
+						"meta":              res.Meta,
+						"enabled":           res.Enabled,
+						"trace":             res.Trace,
+						"keep_sessions":     res.KeepSessions,
+						"scope":             res.Scope,
+						"steps":             res.Steps,
+						"paths":             res.Paths,
+						"issues":            res.Issues,
+						"published_version": res.PublishedVersion,
+						"run_as":            res.RunAs,
+						"owned_by":          res.OwnedBy,
+						"created_at":        res.CreatedAt,
+						"updated_at":        res.UpdatedAt,
+						"deleted_at":        res.DeletedAt,
+						"created_by":        res.CreatedBy,
+						"updated_by":        res.UpdatedBy,
+						"deleted_by":        res.DeletedBy,
 					},
 				),
 			)
@@ -1367,23 +1379,24 @@ var (
 	automationWorkflowUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.UpdateDataset {
 		return d.Update(automationWorkflowTable).
 			Set(goqu.Record{
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"enabled":       res.Enabled,
-				"trace":         res.Trace,
-				"keep_sessions": res.KeepSessions,
-				"scope":         res.Scope,
-				"steps":         res.Steps,
-				"paths":         res.Paths,
-				"issues":        res.Issues,
-				"run_as":        res.RunAs,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"handle":            res.Handle,
+				"meta":              res.Meta,
+				"enabled":           res.Enabled,
+				"trace":             res.Trace,
+				"keep_sessions":     res.KeepSessions,
+				"scope":             res.Scope,
+				"steps":             res.Steps,
+				"paths":             res.Paths,
+				"issues":            res.Issues,
+				"published_version": res.PublishedVersion,
+				"run_as":            res.RunAs,
+				"owned_by":          res.OwnedBy,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"deleted_at":        res.DeletedAt,
+				"created_by":        res.CreatedBy,
+				"updated_by":        res.UpdatedBy,
+				"deleted_by":        res.DeletedBy,
 			}).
 			Where(automationWorkflowPrimaryKeys(res))
 	}
@@ -1411,6 +1424,110 @@ var (
 		}
 	}
 
+	// automationWorkflowVersionTable represents automationWorkflowVersions store table
+	//
+	// This value is auto-generated
+	automationWorkflowVersionTable = goqu.T("automation_workflow_versions")
+
+	// automationWorkflowVersionSelectQuery assembles select query for fetching automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"rel_workflow",
+			"version",
+			"description",
+			"scope",
+			"steps",
+			"paths",
+			"created_at",
+			"created_by",
+		).From(automationWorkflowVersionTable)
+	}
+
+	// automationWorkflowVersionInsertQuery assembles query inserting automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionInsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowVersion) *goqu.InsertDataset {
+		return d.Insert(automationWorkflowVersionTable).
+			Rows(goqu.Record{
+				"id":           res.ID,
+				"rel_workflow": res.WorkflowID,
+				"version":      res.Version,
+				"description":  res.Description,
+				"scope":        res.Scope,
+				"steps":        res.Steps,
+				"paths":        res.Paths,
+				"created_at":   res.CreatedAt,
+				"created_by":   res.CreatedBy,
+			})
+	}
+
+	// automationWorkflowVersionUpsertQuery assembles (insert+on-conflict) query for replacing automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionUpsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowVersion) *goqu.InsertDataset {
+		var target = `,id`
+
+		return automationWorkflowVersionInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_workflow": res.WorkflowID,
+						"version":      res.Version,
+						"description":  res.Description,
+						"scope":        res.Scope,
+						"steps":        res.Steps,
+						"paths":        res.Paths,
+						"created_at":   res.CreatedAt,
+						"created_by":   res.CreatedBy,
+					},
+				),
+			)
+	}
+
+	// automationWorkflowVersionUpdateQuery assembles query for updating automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionUpdateQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowVersion) *goqu.UpdateDataset {
+		return d.Update(automationWorkflowVersionTable).
+			Set(goqu.Record{
+				"rel_workflow": res.WorkflowID,
+				"version":      res.Version,
+				"description":  res.Description,
+				"scope":        res.Scope,
+				"steps":        res.Steps,
+				"paths":        res.Paths,
+				"created_at":   res.CreatedAt,
+				"created_by":   res.CreatedBy,
+			}).
+			Where(automationWorkflowVersionPrimaryKeys(res))
+	}
+
+	// automationWorkflowVersionDeleteQuery assembles delete query for removing automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationWorkflowVersionTable).Where(ee...)
+	}
+
+	// automationWorkflowVersionDeleteQuery assembles delete query for removing automationWorkflowVersions
+	//
+	// This function is auto-generated
+	automationWorkflowVersionTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationWorkflowVersionTable)
+	}
+
+	// automationWorkflowVersionPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	automationWorkflowVersionPrimaryKeys = func(res *automationType.WorkflowVersion) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
 	// composeAttachmentTable represents composeAttachments store table
 	//
 	// This value is auto-generated
@@ -3820,6 +3937,8 @@ var (
 			"resource",
 			"operation",
 			"access",
+			"not_before",
+			"not_after",
 		).From(rbacRuleTable)
 	}
 
@@ -3829,10 +3948,12 @@ var (
 	rbacRuleInsertQuery = func(d goqu.DialectWrapper, res *rbacType.Rule) *goqu.InsertDataset {
 		return d.Insert(rbacRuleTable).
 			Rows(goqu.Record{
-				"rel_role":  res.RoleID,
-				"resource":  res.Resource,
-				"operation": res.Operation,
-				"access":    res.Access,
+				"rel_role":   res.RoleID,
+				"resource":   res.Resource,
+				"operation":  res.Operation,
+				"access":     res.Access,
+				"not_before": res.NotBefore,
+				"not_after":  res.NotAfter,
 			})
 	}
 
@@ -3846,7 +3967,9 @@ var (
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"access": res.Access,
+						"access":     res.Access,
+						"not_before": res.NotBefore,
+						"not_after":  res.NotAfter,
 					},
 				),
 			)
@@ -3858,7 +3981,9 @@ var (
 	rbacRuleUpdateQuery = func(d goqu.DialectWrapper, res *rbacType.Rule) *goqu.UpdateDataset {
 		return d.Update(rbacRuleTable).
 			Set(goqu.Record{
-				"access": res.Access,
+				"access":     res.Access,
+				"not_before": res.NotBefore,
+				"not_after":  res.NotAfter,
 			}).
 			Where(rbacRulePrimaryKeys(res))
 	}
@@ -3888,6 +4013,110 @@ var (
 		}
 	}
 
+	// rbacRuleChangeTable represents rbacRuleChanges store table
+	//
+	// This value is auto-generated
+	rbacRuleChangeTable = goqu.T("rbac_rule_changes")
+
+	// rbacRuleChangeSelectQuery assembles select query for fetching rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"rel_change_set",
+			"ts",
+			"actor_id",
+			"rel_role",
+			"resource",
+			"operation",
+			"before",
+			"after",
+		).From(rbacRuleChangeTable)
+	}
+
+	// rbacRuleChangeInsertQuery assembles query inserting rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeInsertQuery = func(d goqu.DialectWrapper, res *rbacType.RuleChange) *goqu.InsertDataset {
+		return d.Insert(rbacRuleChangeTable).
+			Rows(goqu.Record{
+				"id":             res.ID,
+				"rel_change_set": res.ChangeSetID,
+				"ts":             res.Timestamp,
+				"actor_id":       res.ActorID,
+				"rel_role":       res.RoleID,
+				"resource":       res.Resource,
+				"operation":      res.Operation,
+				"before":         res.Before,
+				"after":          res.After,
+			})
+	}
+
+	// rbacRuleChangeUpsertQuery assembles (insert+on-conflict) query for replacing rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeUpsertQuery = func(d goqu.DialectWrapper, res *rbacType.RuleChange) *goqu.InsertDataset {
+		var target = `,id`
+
+		return rbacRuleChangeInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_change_set": res.ChangeSetID,
+						"ts":             res.Timestamp,
+						"actor_id":       res.ActorID,
+						"rel_role":       res.RoleID,
+						"resource":       res.Resource,
+						"operation":      res.Operation,
+						"before":         res.Before,
+						"after":          res.After,
+					},
+				),
+			)
+	}
+
+	// rbacRuleChangeUpdateQuery assembles query for updating rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeUpdateQuery = func(d goqu.DialectWrapper, res *rbacType.RuleChange) *goqu.UpdateDataset {
+		return d.Update(rbacRuleChangeTable).
+			Set(goqu.Record{
+				"rel_change_set": res.ChangeSetID,
+				"ts":             res.Timestamp,
+				"actor_id":       res.ActorID,
+				"rel_role":       res.RoleID,
+				"resource":       res.Resource,
+				"operation":      res.Operation,
+				"before":         res.Before,
+				"after":          res.After,
+			}).
+			Where(rbacRuleChangePrimaryKeys(res))
+	}
+
+	// rbacRuleChangeDeleteQuery assembles delete query for removing rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(rbacRuleChangeTable).Where(ee...)
+	}
+
+	// rbacRuleChangeDeleteQuery assembles delete query for removing rbacRuleChanges
+	//
+	// This function is auto-generated
+	rbacRuleChangeTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(rbacRuleChangeTable)
+	}
+
+	// rbacRuleChangePrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	rbacRuleChangePrimaryKeys = func(res *rbacType.RuleChange) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
 	// reminderTable represents reminders store table
 	//
 	// This value is auto-generated
@@ -4128,136 +4357,372 @@ var (
 		}
 	}
 
-	// resourceActivityTable represents resourceActivitys store table
+	// reportDeliveryTable represents reportDeliverys store table
 	//
 	// This value is auto-generated
-	resourceActivityTable = goqu.T("resource_activity_log")
+	reportDeliveryTable = goqu.T("report_deliverys")
 
-	// resourceActivitySelectQuery assembles select query for fetching resourceActivitys
+	// reportDeliverySelectQuery assembles select query for fetching reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivitySelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	reportDeliverySelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"ts",
-			"resource_type",
-			"resource_action",
-			"rel_resource",
-			"meta",
-		).From(resourceActivityTable)
+			"rel_subscription",
+			"rel_report",
+			"format",
+			"recipients",
+			"status",
+			"error",
+			"created_at",
+			"completed_at",
+		).From(reportDeliveryTable)
 	}
 
-	// resourceActivityInsertQuery assembles query inserting resourceActivitys
+	// reportDeliveryInsertQuery assembles query inserting reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivityInsertQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.InsertDataset {
-		return d.Insert(resourceActivityTable).
+	reportDeliveryInsertQuery = func(d goqu.DialectWrapper, res *systemType.ReportDelivery) *goqu.InsertDataset {
+		return d.Insert(reportDeliveryTable).
 			Rows(goqu.Record{
-				"id":              res.ID,
-				"ts":              res.Timestamp,
-				"resource_type":   res.ResourceType,
-				"resource_action": res.ResourceAction,
-				"rel_resource":    res.ResourceID,
-				"meta":            res.Meta,
+				"id":               res.ID,
+				"rel_subscription": res.SubscriptionID,
+				"rel_report":       res.ReportID,
+				"format":           res.Format,
+				"recipients":       res.Recipients,
+				"status":           res.Status,
+				"error":            res.Error,
+				"created_at":       res.CreatedAt,
+				"completed_at":     res.CompletedAt,
 			})
 	}
 
-	// resourceActivityUpsertQuery assembles (insert+on-conflict) query for replacing resourceActivitys
+	// reportDeliveryUpsertQuery assembles (insert+on-conflict) query for replacing reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivityUpsertQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.InsertDataset {
+	reportDeliveryUpsertQuery = func(d goqu.DialectWrapper, res *systemType.ReportDelivery) *goqu.InsertDataset {
 		var target = `,id`
 
-		return resourceActivityInsertQuery(d, res).
+		return reportDeliveryInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"ts":              res.Timestamp,
-						"resource_type":   res.ResourceType,
-						"resource_action": res.ResourceAction,
-						"rel_resource":    res.ResourceID,
-						"meta":            res.Meta,
+						"rel_subscription": res.SubscriptionID,
+						"rel_report":       res.ReportID,
+						"format":           res.Format,
+						"recipients":       res.Recipients,
+						"status":           res.Status,
+						"error":            res.Error,
+						"created_at":       res.CreatedAt,
+						"completed_at":     res.CompletedAt,
 					},
 				),
 			)
 	}
 
-	// resourceActivityUpdateQuery assembles query for updating resourceActivitys
+	// reportDeliveryUpdateQuery assembles query for updating reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivityUpdateQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.UpdateDataset {
-		return d.Update(resourceActivityTable).
+	reportDeliveryUpdateQuery = func(d goqu.DialectWrapper, res *systemType.ReportDelivery) *goqu.UpdateDataset {
+		return d.Update(reportDeliveryTable).
 			Set(goqu.Record{
-				"ts":              res.Timestamp,
-				"resource_type":   res.ResourceType,
-				"resource_action": res.ResourceAction,
-				"rel_resource":    res.ResourceID,
-				"meta":            res.Meta,
+				"rel_subscription": res.SubscriptionID,
+				"rel_report":       res.ReportID,
+				"format":           res.Format,
+				"recipients":       res.Recipients,
+				"status":           res.Status,
+				"error":            res.Error,
+				"created_at":       res.CreatedAt,
+				"completed_at":     res.CompletedAt,
 			}).
-			Where(resourceActivityPrimaryKeys(res))
+			Where(reportDeliveryPrimaryKeys(res))
 	}
 
-	// resourceActivityDeleteQuery assembles delete query for removing resourceActivitys
+	// reportDeliveryDeleteQuery assembles delete query for removing reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivityDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(resourceActivityTable).Where(ee...)
+	reportDeliveryDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(reportDeliveryTable).Where(ee...)
 	}
 
-	// resourceActivityDeleteQuery assembles delete query for removing resourceActivitys
+	// reportDeliveryDeleteQuery assembles delete query for removing reportDeliverys
 	//
 	// This function is auto-generated
-	resourceActivityTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(resourceActivityTable)
+	reportDeliveryTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(reportDeliveryTable)
 	}
 
-	// resourceActivityPrimaryKeys assembles set of conditions for all primary keys
+	// reportDeliveryPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	resourceActivityPrimaryKeys = func(res *discoveryType.ResourceActivity) goqu.Ex {
+	reportDeliveryPrimaryKeys = func(res *systemType.ReportDelivery) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// resourceTranslationTable represents resourceTranslations store table
+	// reportSubscriptionTable represents reportSubscriptions store table
 	//
 	// This value is auto-generated
-	resourceTranslationTable = goqu.T("resource_translations")
+	reportSubscriptionTable = goqu.T("report_subscriptions")
 
-	// resourceTranslationSelectQuery assembles select query for fetching resourceTranslations
+	// reportSubscriptionSelectQuery assembles select query for fetching reportSubscriptions
 	//
 	// This function is auto-generated
-	resourceTranslationSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	reportSubscriptionSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"lang",
-			"resource",
-			"k",
-			"message",
+			"rel_report",
+			"scenario_id",
+			"schedule",
+			"format",
+			"enabled",
+			"recipients",
+			"meta",
+			"last_run_at",
+			"owned_by",
 			"created_at",
 			"updated_at",
 			"deleted_at",
-			"owned_by",
 			"created_by",
 			"updated_by",
 			"deleted_by",
-		).From(resourceTranslationTable)
+		).From(reportSubscriptionTable)
 	}
 
-	// resourceTranslationInsertQuery assembles query inserting resourceTranslations
+	// reportSubscriptionInsertQuery assembles query inserting reportSubscriptions
 	//
 	// This function is auto-generated
-	resourceTranslationInsertQuery = func(d goqu.DialectWrapper, res *systemType.ResourceTranslation) *goqu.InsertDataset {
-		return d.Insert(resourceTranslationTable).
+	reportSubscriptionInsertQuery = func(d goqu.DialectWrapper, res *systemType.ReportSubscription) *goqu.InsertDataset {
+		return d.Insert(reportSubscriptionTable).
 			Rows(goqu.Record{
-				"id":         res.ID,
-				"lang":       res.Lang,
-				"resource":   res.Resource,
-				"k":          res.K,
-				"message":    res.Message,
-				"created_at": res.CreatedAt,
-				"updated_at": res.UpdatedAt,
+				"id":          res.ID,
+				"rel_report":  res.ReportID,
+				"scenario_id": res.ScenarioID,
+				"schedule":    res.Schedule,
+				"format":      res.Format,
+				"enabled":     res.Enabled,
+				"recipients":  res.Recipients,
+				"meta":        res.Meta,
+				"last_run_at": res.LastRunAt,
+				"owned_by":    res.OwnedBy,
+				"created_at":  res.CreatedAt,
+				"updated_at":  res.UpdatedAt,
+				"deleted_at":  res.DeletedAt,
+				"created_by":  res.CreatedBy,
+				"updated_by":  res.UpdatedBy,
+				"deleted_by":  res.DeletedBy,
+			})
+	}
+
+	// reportSubscriptionUpsertQuery assembles (insert+on-conflict) query for replacing reportSubscriptions
+	//
+	// This function is auto-generated
+	reportSubscriptionUpsertQuery = func(d goqu.DialectWrapper, res *systemType.ReportSubscription) *goqu.InsertDataset {
+		var target = `,id`
+
+		return reportSubscriptionInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_report":  res.ReportID,
+						"scenario_id": res.ScenarioID,
+						"schedule":    res.Schedule,
+						"format":      res.Format,
+						"enabled":     res.Enabled,
+						"recipients":  res.Recipients,
+						"meta":        res.Meta,
+						"last_run_at": res.LastRunAt,
+						"owned_by":    res.OwnedBy,
+						"created_at":  res.CreatedAt,
+						"updated_at":  res.UpdatedAt,
+						"deleted_at":  res.DeletedAt,
+						"created_by":  res.CreatedBy,
+						"updated_by":  res.UpdatedBy,
+						"deleted_by":  res.DeletedBy,
+					},
+				),
+			)
+	}
+
+	// reportSubscriptionUpdateQuery assembles query for updating reportSubscriptions
+	//
+	// This function is auto-generated
+	reportSubscriptionUpdateQuery = func(d goqu.DialectWrapper, res *systemType.ReportSubscription) *goqu.UpdateDataset {
+		return d.Update(reportSubscriptionTable).
+			Set(goqu.Record{
+				"rel_report":  res.ReportID,
+				"scenario_id": res.ScenarioID,
+				"schedule":    res.Schedule,
+				"format":      res.Format,
+				"enabled":     res.Enabled,
+				"recipients":  res.Recipients,
+				"meta":        res.Meta,
+				"last_run_at": res.LastRunAt,
+				"owned_by":    res.OwnedBy,
+				"created_at":  res.CreatedAt,
+				"updated_at":  res.UpdatedAt,
+				"deleted_at":  res.DeletedAt,
+				"created_by":  res.CreatedBy,
+				"updated_by":  res.UpdatedBy,
+				"deleted_by":  res.DeletedBy,
+			}).
+			Where(reportSubscriptionPrimaryKeys(res))
+	}
+
+	// reportSubscriptionDeleteQuery assembles delete query for removing reportSubscriptions
+	//
+	// This function is auto-generated
+	reportSubscriptionDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(reportSubscriptionTable).Where(ee...)
+	}
+
+	// reportSubscriptionDeleteQuery assembles delete query for removing reportSubscriptions
+	//
+	// This function is auto-generated
+	reportSubscriptionTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(reportSubscriptionTable)
+	}
+
+	// reportSubscriptionPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	reportSubscriptionPrimaryKeys = func(res *systemType.ReportSubscription) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// resourceActivityTable represents resourceActivitys store table
+	//
+	// This value is auto-generated
+	resourceActivityTable = goqu.T("resource_activity_log")
+
+	// resourceActivitySelectQuery assembles select query for fetching resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivitySelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"ts",
+			"resource_type",
+			"resource_action",
+			"rel_resource",
+			"meta",
+		).From(resourceActivityTable)
+	}
+
+	// resourceActivityInsertQuery assembles query inserting resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivityInsertQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.InsertDataset {
+		return d.Insert(resourceActivityTable).
+			Rows(goqu.Record{
+				"id":              res.ID,
+				"ts":              res.Timestamp,
+				"resource_type":   res.ResourceType,
+				"resource_action": res.ResourceAction,
+				"rel_resource":    res.ResourceID,
+				"meta":            res.Meta,
+			})
+	}
+
+	// resourceActivityUpsertQuery assembles (insert+on-conflict) query for replacing resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivityUpsertQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.InsertDataset {
+		var target = `,id`
+
+		return resourceActivityInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"ts":              res.Timestamp,
+						"resource_type":   res.ResourceType,
+						"resource_action": res.ResourceAction,
+						"rel_resource":    res.ResourceID,
+						"meta":            res.Meta,
+					},
+				),
+			)
+	}
+
+	// resourceActivityUpdateQuery assembles query for updating resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivityUpdateQuery = func(d goqu.DialectWrapper, res *discoveryType.ResourceActivity) *goqu.UpdateDataset {
+		return d.Update(resourceActivityTable).
+			Set(goqu.Record{
+				"ts":              res.Timestamp,
+				"resource_type":   res.ResourceType,
+				"resource_action": res.ResourceAction,
+				"rel_resource":    res.ResourceID,
+				"meta":            res.Meta,
+			}).
+			Where(resourceActivityPrimaryKeys(res))
+	}
+
+	// resourceActivityDeleteQuery assembles delete query for removing resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivityDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(resourceActivityTable).Where(ee...)
+	}
+
+	// resourceActivityDeleteQuery assembles delete query for removing resourceActivitys
+	//
+	// This function is auto-generated
+	resourceActivityTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(resourceActivityTable)
+	}
+
+	// resourceActivityPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	resourceActivityPrimaryKeys = func(res *discoveryType.ResourceActivity) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// resourceTranslationTable represents resourceTranslations store table
+	//
+	// This value is auto-generated
+	resourceTranslationTable = goqu.T("resource_translations")
+
+	// resourceTranslationSelectQuery assembles select query for fetching resourceTranslations
+	//
+	// This function is auto-generated
+	resourceTranslationSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"lang",
+			"resource",
+			"k",
+			"message",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"owned_by",
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(resourceTranslationTable)
+	}
+
+	// resourceTranslationInsertQuery assembles query inserting resourceTranslations
+	//
+	// This function is auto-generated
+	resourceTranslationInsertQuery = func(d goqu.DialectWrapper, res *systemType.ResourceTranslation) *goqu.InsertDataset {
+		return d.Insert(resourceTranslationTable).
+			Rows(goqu.Record{
+				"id":         res.ID,
+				"lang":       res.Lang,
+				"resource":   res.Resource,
+				"k":          res.K,
+				"message":    res.Message,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
 				"deleted_at": res.DeletedAt,
 				"owned_by":   res.OwnedBy,
 				"created_by": res.CreatedBy,
@@ -4509,6 +4974,90 @@ var (
 		}
 	}
 
+	// schedulerClaimTable represents schedulerClaims store table
+	//
+	// This value is auto-generated
+	schedulerClaimTable = goqu.T("scheduler_claims")
+
+	// schedulerClaimSelectQuery assembles select query for fetching schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"job",
+			"claimed_by",
+			"claimed_at",
+			"expires_at",
+		).From(schedulerClaimTable)
+	}
+
+	// schedulerClaimInsertQuery assembles query inserting schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimInsertQuery = func(d goqu.DialectWrapper, res *schedulerType.Claim) *goqu.InsertDataset {
+		return d.Insert(schedulerClaimTable).
+			Rows(goqu.Record{
+				"job":        res.Job,
+				"claimed_by": res.ClaimedBy,
+				"claimed_at": res.ClaimedAt,
+				"expires_at": res.ExpiresAt,
+			})
+	}
+
+	// schedulerClaimUpsertQuery assembles (insert+on-conflict) query for replacing schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimUpsertQuery = func(d goqu.DialectWrapper, res *schedulerType.Claim) *goqu.InsertDataset {
+		var target = `,job`
+
+		return schedulerClaimInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"claimed_by": res.ClaimedBy,
+						"claimed_at": res.ClaimedAt,
+						"expires_at": res.ExpiresAt,
+					},
+				),
+			)
+	}
+
+	// schedulerClaimUpdateQuery assembles query for updating schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimUpdateQuery = func(d goqu.DialectWrapper, res *schedulerType.Claim) *goqu.UpdateDataset {
+		return d.Update(schedulerClaimTable).
+			Set(goqu.Record{
+				"claimed_by": res.ClaimedBy,
+				"claimed_at": res.ClaimedAt,
+				"expires_at": res.ExpiresAt,
+			}).
+			Where(schedulerClaimPrimaryKeys(res))
+	}
+
+	// schedulerClaimDeleteQuery assembles delete query for removing schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(schedulerClaimTable).Where(ee...)
+	}
+
+	// schedulerClaimDeleteQuery assembles delete query for removing schedulerClaims
+	//
+	// This function is auto-generated
+	schedulerClaimTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(schedulerClaimTable)
+	}
+
+	// schedulerClaimPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	schedulerClaimPrimaryKeys = func(res *schedulerType.Claim) goqu.Ex {
+		return goqu.Ex{
+			"job": res.Job,
+		}
+	}
+
 	// settingValueTable represents settingValues store table
 	//
 	// This value is auto-generated
@@ -4827,4 +5376,244 @@ var (
 			"id": res.ID,
 		}
 	}
+
+	// webhookTable represents webhooks store table
+	//
+	// This value is auto-generated
+	webhookTable = goqu.T("webhooks")
+
+	// webhookSelectQuery assembles select query for fetching webhooks
+	//
+	// This function is auto-generated
+	webhookSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"handle",
+			"url",
+			"secret",
+			"enabled",
+			"events",
+			"meta",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(webhookTable)
+	}
+
+	// webhookInsertQuery assembles query inserting webhooks
+	//
+	// This function is auto-generated
+	webhookInsertQuery = func(d goqu.DialectWrapper, res *systemType.Webhook) *goqu.InsertDataset {
+		return d.Insert(webhookTable).
+			Rows(goqu.Record{
+				"id":         res.ID,
+				"handle":     res.Handle,
+				"url":        res.URL,
+				"secret":     res.Secret,
+				"enabled":    res.Enabled,
+				"events":     res.Events,
+				"meta":       res.Meta,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+				"deleted_at": res.DeletedAt,
+				"created_by": res.CreatedBy,
+				"updated_by": res.UpdatedBy,
+				"deleted_by": res.DeletedBy,
+			})
+	}
+
+	// webhookUpsertQuery assembles (insert+on-conflict) query for replacing webhooks
+	//
+	// This function is auto-generated
+	webhookUpsertQuery = func(d goqu.DialectWrapper, res *systemType.Webhook) *goqu.InsertDataset {
+		var target = `,id`
+
+		return webhookInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"handle":     res.Handle,
+						"url":        res.URL,
+						"secret":     res.Secret,
+						"enabled":    res.Enabled,
+						"events":     res.Events,
+						"meta":       res.Meta,
+						"created_at": res.CreatedAt,
+						"updated_at": res.UpdatedAt,
+						"deleted_at": res.DeletedAt,
+						"created_by": res.CreatedBy,
+						"updated_by": res.UpdatedBy,
+						"deleted_by": res.DeletedBy,
+					},
+				),
+			)
+	}
+
+	// webhookUpdateQuery assembles query for updating webhooks
+	//
+	// This function is auto-generated
+	webhookUpdateQuery = func(d goqu.DialectWrapper, res *systemType.Webhook) *goqu.UpdateDataset {
+		return d.Update(webhookTable).
+			Set(goqu.Record{
+				"handle":     res.Handle,
+				"url":        res.URL,
+				"secret":     res.Secret,
+				"enabled":    res.Enabled,
+				"events":     res.Events,
+				"meta":       res.Meta,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+				"deleted_at": res.DeletedAt,
+				"created_by": res.CreatedBy,
+				"updated_by": res.UpdatedBy,
+				"deleted_by": res.DeletedBy,
+			}).
+			Where(webhookPrimaryKeys(res))
+	}
+
+	// webhookDeleteQuery assembles delete query for removing webhooks
+	//
+	// This function is auto-generated
+	webhookDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(webhookTable).Where(ee...)
+	}
+
+	// webhookDeleteQuery assembles delete query for removing webhooks
+	//
+	// This function is auto-generated
+	webhookTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(webhookTable)
+	}
+
+	// webhookPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	webhookPrimaryKeys = func(res *systemType.Webhook) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// webhookDeliveryTable represents webhookDeliverys store table
+	//
+	// This value is auto-generated
+	webhookDeliveryTable = goqu.T("webhook_deliverys")
+
+	// webhookDeliverySelectQuery assembles select query for fetching webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliverySelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"rel_webhook",
+			"resource_type",
+			"event_type",
+			"payload",
+			"status",
+			"attempts",
+			"response_status",
+			"error",
+			"next_attempt_at",
+			"last_attempt_at",
+			"created_at",
+			"updated_at",
+		).From(webhookDeliveryTable)
+	}
+
+	// webhookDeliveryInsertQuery assembles query inserting webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliveryInsertQuery = func(d goqu.DialectWrapper, res *systemType.WebhookDelivery) *goqu.InsertDataset {
+		return d.Insert(webhookDeliveryTable).
+			Rows(goqu.Record{
+				"id":              res.ID,
+				"rel_webhook":     res.WebhookID,
+				"resource_type":   res.ResourceType,
+				"event_type":      res.EventType,
+				"payload":         res.Payload,
+				"status":          res.Status,
+				"attempts":        res.Attempts,
+				"response_status": res.ResponseStatus,
+				"error":           res.Error,
+				"next_attempt_at": res.NextAttemptAt,
+				"last_attempt_at": res.LastAttemptAt,
+				"created_at":      res.CreatedAt,
+				"updated_at":      res.UpdatedAt,
+			})
+	}
+
+	// webhookDeliveryUpsertQuery assembles (insert+on-conflict) query for replacing webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliveryUpsertQuery = func(d goqu.DialectWrapper, res *systemType.WebhookDelivery) *goqu.InsertDataset {
+		var target = `,id`
+
+		return webhookDeliveryInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_webhook":     res.WebhookID,
+						"resource_type":   res.ResourceType,
+						"event_type":      res.EventType,
+						"payload":         res.Payload,
+						"status":          res.Status,
+						"attempts":        res.Attempts,
+						"response_status": res.ResponseStatus,
+						"error":           res.Error,
+						"next_attempt_at": res.NextAttemptAt,
+						"last_attempt_at": res.LastAttemptAt,
+						"created_at":      res.CreatedAt,
+						"updated_at":      res.UpdatedAt,
+					},
+				),
+			)
+	}
+
+	// webhookDeliveryUpdateQuery assembles query for updating webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliveryUpdateQuery = func(d goqu.DialectWrapper, res *systemType.WebhookDelivery) *goqu.UpdateDataset {
+		return d.Update(webhookDeliveryTable).
+			Set(goqu.Record{
+				"rel_webhook":     res.WebhookID,
+				"resource_type":   res.ResourceType,
+				"event_type":      res.EventType,
+				"payload":         res.Payload,
+				"status":          res.Status,
+				"attempts":        res.Attempts,
+				"response_status": res.ResponseStatus,
+				"error":           res.Error,
+				"next_attempt_at": res.NextAttemptAt,
+				"last_attempt_at": res.LastAttemptAt,
+				"created_at":      res.CreatedAt,
+				"updated_at":      res.UpdatedAt,
+			}).
+			Where(webhookDeliveryPrimaryKeys(res))
+	}
+
+	// webhookDeliveryDeleteQuery assembles delete query for removing webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliveryDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(webhookDeliveryTable).Where(ee...)
+	}
+
+	// webhookDeliveryDeleteQuery assembles delete query for removing webhookDeliverys
+	//
+	// This function is auto-generated
+	webhookDeliveryTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(webhookDeliveryTable)
+	}
+
+	// webhookDeliveryPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	webhookDeliveryPrimaryKeys = func(res *systemType.WebhookDelivery) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
 )
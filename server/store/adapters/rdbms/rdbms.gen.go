@@ -22,6 +22,7 @@ import (
 	flagType "github.com/cortezaproject/corteza/server/pkg/flag/types"
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/store"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"github.com/doug-martin/goqu/v9"
@@ -42,6 +43,7 @@ var (
 	_ store.AutomationSessions         = &Store{}
 	_ store.AutomationTriggers         = &Store{}
 	_ store.AutomationWorkflows        = &Store{}
+	_ store.AutomationWorkflowVersions = &Store{}
 	_ store.ComposeAttachments         = &Store{}
 	_ store.ComposeCharts              = &Store{}
 	_ store.ComposeModules             = &Store{}
@@ -65,15 +67,21 @@ var (
 	_ store.Queues                     = &Store{}
 	_ store.QueueMessages              = &Store{}
 	_ store.RbacRules                  = &Store{}
+	_ store.RbacRuleChanges            = &Store{}
 	_ store.Reminders                  = &Store{}
 	_ store.Reports                    = &Store{}
+	_ store.ReportDeliverys            = &Store{}
+	_ store.ReportSubscriptions        = &Store{}
 	_ store.ResourceActivitys          = &Store{}
 	_ store.ResourceTranslations       = &Store{}
 	_ store.Roles                      = &Store{}
 	_ store.RoleMembers                = &Store{}
+	_ store.SchedulerClaims            = &Store{}
 	_ store.SettingValues              = &Store{}
 	_ store.Templates                  = &Store{}
 	_ store.Users                      = &Store{}
+	_ store.Webhooks                   = &Store{}
+	_ store.WebhookDeliverys           = &Store{}
 )
 
 // CreateActionlog creates one or more rows in actionlog collection
@@ -6211,16 +6219,16 @@ func (s *Store) checkAutomationWorkflowConstraints(ctx context.Context, res *aut
 	return nil
 }
 
-// CreateComposeAttachment creates one or more rows in composeAttachment collection
+// CreateAutomationWorkflowVersion creates one or more rows in automationWorkflowVersion collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) CreateAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowVersionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowVersionInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -6228,16 +6236,16 @@ func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpdateComposeAttachment updates one or more existing entries in composeAttachment collection
+// UpdateAutomationWorkflowVersion updates one or more existing entries in automationWorkflowVersion collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) UpdateAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowVersionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeAttachmentUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowVersionUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -6245,12 +6253,12 @@ func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpsertComposeAttachment updates one or more existing entries in composeAttachment collection
+// UpsertAutomationWorkflowVersion updates one or more existing entries in automationWorkflowVersion collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) UpsertAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowVersionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -6258,7 +6266,7 @@ func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composeAttachmentUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, automationWorkflowVersionUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -6268,12 +6276,12 @@ func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.
 				continue
 			}
 
-			err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, automationWorkflowVersionInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composeAttachmentUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, automationWorkflowVersionUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -6283,12 +6291,12 @@ func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// DeleteComposeAttachment Deletes one or more entries from composeAttachment collection
+// DeleteAutomationWorkflowVersion Deletes one or more entries from automationWorkflowVersion collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) DeleteAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect.GOQU(), composeAttachmentPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationWorkflowVersionDeleteQuery(s.Dialect.GOQU(), automationWorkflowVersionPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -6296,24 +6304,24 @@ func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.
 	return nil
 }
 
-// DeleteComposeAttachmentByID deletes single entry from composeAttachment collection
+// DeleteAutomationWorkflowVersionByID deletes single entry from automationWorkflowVersion collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeAttachmentByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteAutomationWorkflowVersionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationWorkflowVersionDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeAttachments Deletes all rows from the composeAttachment collection
-func (s *Store) TruncateComposeAttachments(ctx context.Context) error {
-	return s.Exec(ctx, composeAttachmentTruncateQuery(s.Dialect.GOQU()))
+// TruncateAutomationWorkflowVersions Deletes all rows from the automationWorkflowVersion collection
+func (s *Store) TruncateAutomationWorkflowVersions(ctx context.Context) error {
+	return s.Exec(ctx, automationWorkflowVersionTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposeAttachments returns (filtered) set of ComposeAttachments
+// SearchAutomationWorkflowVersions returns (filtered) set of AutomationWorkflowVersions
 //
 // This function is auto-generated
-func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (set composeType.AttachmentSet, _ composeType.AttachmentFilter, err error) {
+func (s *Store) SearchAutomationWorkflowVersions(ctx context.Context, f automationType.WorkflowVersionFilter) (set automationType.WorkflowVersionSet, _ automationType.WorkflowVersionFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -6341,7 +6349,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeAttachments fn used for cursor creation;
+	// Original are passed to the etchFullPageOfAutomationWorkflowVersions fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -6351,7 +6359,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationWorkflowVersions(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -6367,8 +6375,8 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet composeType.AttachmentSet
-			if navSet, _, _, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort); err != nil {
+			var navSet automationType.WorkflowVersionSet
+			if navSet, _, _, err = s.fetchFullPageOfAutomationWorkflowVersions(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -6380,7 +6388,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeAttachments collects all requested results.
+// fetchFullPageOfAutomationWorkflowVersions collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -6392,13 +6400,13 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeAttachments(
+func (s *Store) fetchFullPageOfAutomationWorkflowVersions(
 	ctx context.Context,
-	filter composeType.AttachmentFilter,
+	filter automationType.WorkflowVersionFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Attachment, prev, next *filter.PagingCursor, err error) {
+) (set []*automationType.WorkflowVersion, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Attachment
+		aux []*automationType.WorkflowVersion
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -6417,10 +6425,10 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.AttachmentFilter
+		tryFilter automationType.WorkflowVersionFilter
 	)
 
-	set = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
+	set = make([]*automationType.WorkflowVersion, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -6433,7 +6441,7 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeAttachments(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryAutomationWorkflowVersions(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -6463,7 +6471,7 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectAutomationWorkflowVersionCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -6494,35 +6502,33 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeAttachmentCursorValues(set[0], filter.Sort...)
+		prev = s.collectAutomationWorkflowVersionCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectAutomationWorkflowVersionCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeAttachments queries the database, converts and checks each row and returns collected set
+// QueryAutomationWorkflowVersions queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeAttachments(
+func (s *Store) QueryAutomationWorkflowVersions(
 	ctx context.Context,
-	f composeType.AttachmentFilter,
-) (_ []*composeType.Attachment, more bool, err error) {
+	f automationType.WorkflowVersionFilter,
+) (_ []*automationType.WorkflowVersion, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
-		res         *composeType.Attachment
-		aux         *auxComposeAttachment
+		set         = make([]*automationType.WorkflowVersion, 0, DefaultSliceCapacity)
+		res         *automationType.WorkflowVersion
+		aux         *auxAutomationWorkflowVersion
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -6530,16 +6536,16 @@ func (s *Store) QueryComposeAttachments(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeAttachment != nil {
+	if s.Filters.AutomationWorkflowVersion != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeAttachment(s, f)
+		tExpr, f, err = s.Filters.AutomationWorkflowVersion(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeAttachmentFilter(s.Dialect, f)
+		tExpr, f, err = AutomationWorkflowVersionFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeAttachment: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationWorkflowVersion: %w", err)
 		return
 	}
 
@@ -6547,18 +6553,18 @@ func (s *Store) QueryComposeAttachments(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeAttachmentFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableAutomationWorkflowVersionFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := composeAttachmentSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := automationWorkflowVersionSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeAttachmentFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeAttachment: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableAutomationWorkflowVersionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationWorkflowVersion: %w", err)
 		return
 	}
 
@@ -6572,12 +6578,12 @@ func (s *Store) QueryComposeAttachments(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowVersion: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowVersion: %w", err)
 		return
 	}
 
@@ -6591,32 +6597,22 @@ func (s *Store) QueryComposeAttachments(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not query AutomationWorkflowVersion: %w", err)
 			return
 		}
 
-		aux = new(auxComposeAttachment)
+		aux = new(auxAutomationWorkflowVersion)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationWorkflowVersion: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not decode AutomationWorkflowVersion: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
@@ -6624,15 +6620,16 @@ func (s *Store) QueryComposeAttachments(
 
 }
 
-// LookupComposeAttachmentByID
+// LookupAutomationWorkflowVersionByWorkflowIDVersion searches for workflow version by workflow ID and version number
 //
 // This function is auto-generated
-func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *composeType.Attachment, err error) {
+func (s *Store) LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx context.Context, workflowID uint64, version uint) (_ *automationType.WorkflowVersion, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeAttachment)
-		lookup = composeAttachmentSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAutomationWorkflowVersion)
+		lookup = automationWorkflowVersionSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_workflow").Eq(workflowID),
+			goqu.I("version").Eq(version),
 		).Limit(1)
 	)
 
@@ -6664,29 +6661,22 @@ func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *
 	return aux.decode()
 }
 
-// sortableComposeAttachmentFields returns all <no value> columns flagged as sortable
+// sortableAutomationWorkflowVersionFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeAttachmentFields() map[string]string {
+func (Store) sortableAutomationWorkflowVersionFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
 		"id":         "id",
-		"kind":       "kind",
-		"name":       "name",
-		"owner_id":   "owner_id",
-		"ownerid":    "owner_id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"version":    "version",
 	}
 }
 
-// collectComposeAttachmentCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationWorkflowVersionCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -6698,7 +6688,7 @@ func (Store) sortableComposeAttachmentFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationWorkflowVersionCursorValues(res *automationType.WorkflowVersion, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -6712,18 +6702,10 @@ func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment
 				case "id":
 					pkID = true
 					return res.ID
-				case "ownerID":
-					return res.OwnerID
-				case "kind":
-					return res.Kind
-				case "name":
-					return res.Name
+				case "version":
+					return res.Version
 				case "createdAt":
 					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
 				}
 				return nil
 			}
@@ -6756,27 +6738,27 @@ func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment
 
 }
 
-// checkComposeAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationWorkflowVersionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeAttachmentConstraints(ctx context.Context, res *composeType.Attachment) (err error) {
+func (s *Store) checkAutomationWorkflowVersionConstraints(ctx context.Context, res *automationType.WorkflowVersion) (err error) {
 	return nil
 }
 
-// CreateComposeChart creates one or more rows in composeChart collection
+// CreateComposeAttachment creates one or more rows in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeChartInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -6784,16 +6766,16 @@ func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// UpdateComposeChart updates one or more existing entries in composeChart collection
+// UpdateComposeAttachment updates one or more existing entries in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeChartUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeAttachmentUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -6801,12 +6783,12 @@ func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// UpsertComposeChart updates one or more existing entries in composeChart collection
+// UpsertComposeAttachment updates one or more existing entries in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -6814,7 +6796,7 @@ func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composeChartUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composeAttachmentUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -6824,12 +6806,12 @@ func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart
 				continue
 			}
 
-			err = s.Exec(ctx, composeChartInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composeChartUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeAttachmentUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -6839,12 +6821,12 @@ func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// DeleteComposeChart Deletes one or more entries from composeChart collection
+// DeleteComposeAttachment Deletes one or more entries from composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeChartDeleteQuery(s.Dialect.GOQU(), composeChartPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect.GOQU(), composeAttachmentPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -6852,24 +6834,24 @@ func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return nil
 }
 
-// DeleteComposeChartByID deletes single entry from composeChart collection
+// DeleteComposeAttachmentByID deletes single entry from composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeChartByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeChartDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposeAttachmentByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeCharts Deletes all rows from the composeChart collection
-func (s *Store) TruncateComposeCharts(ctx context.Context) error {
-	return s.Exec(ctx, composeChartTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposeAttachments Deletes all rows from the composeAttachment collection
+func (s *Store) TruncateComposeAttachments(ctx context.Context) error {
+	return s.Exec(ctx, composeAttachmentTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposeCharts returns (filtered) set of ComposeCharts
+// SearchComposeAttachments returns (filtered) set of ComposeAttachments
 //
 // This function is auto-generated
-func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilter) (set composeType.ChartSet, _ composeType.ChartFilter, err error) {
+func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (set composeType.AttachmentSet, _ composeType.AttachmentFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -6897,7 +6879,7 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeCharts fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposeAttachments fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -6907,7 +6889,7 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeCharts(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -6923,8 +6905,8 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet composeType.ChartSet
-			if navSet, _, _, err = s.fetchFullPageOfComposeCharts(ctx, f, sort); err != nil {
+			var navSet composeType.AttachmentSet
+			if navSet, _, _, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -6936,7 +6918,7 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeCharts collects all requested results.
+// fetchFullPageOfComposeAttachments collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -6948,13 +6930,13 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeCharts(
+func (s *Store) fetchFullPageOfComposeAttachments(
 	ctx context.Context,
-	filter composeType.ChartFilter,
+	filter composeType.AttachmentFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Chart, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Attachment, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Chart
+		aux []*composeType.Attachment
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -6973,10 +6955,10 @@ func (s *Store) fetchFullPageOfComposeCharts(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.ChartFilter
+		tryFilter composeType.AttachmentFilter
 	)
 
-	set = make([]*composeType.Chart, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -6989,7 +6971,7 @@ func (s *Store) fetchFullPageOfComposeCharts(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeCharts(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposeAttachments(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -7019,7 +7001,7 @@ func (s *Store) fetchFullPageOfComposeCharts(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -7050,35 +7032,35 @@ func (s *Store) fetchFullPageOfComposeCharts(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeChartCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposeAttachmentCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeCharts queries the database, converts and checks each row and returns collected set
+// QueryComposeAttachments queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeCharts(
+func (s *Store) QueryComposeAttachments(
 	ctx context.Context,
-	f composeType.ChartFilter,
-) (_ []*composeType.Chart, more bool, err error) {
+	f composeType.AttachmentFilter,
+) (_ []*composeType.Attachment, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.Chart, 0, DefaultSliceCapacity)
-		res         *composeType.Chart
-		aux         *auxComposeChart
+		set         = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
+		res         *composeType.Attachment
+		aux         *auxComposeAttachment
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -7086,16 +7068,16 @@ func (s *Store) QueryComposeCharts(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeChart != nil {
+	if s.Filters.ComposeAttachment != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeChart(s, f)
+		tExpr, f, err = s.Filters.ComposeAttachment(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeChartFilter(s.Dialect, f)
+		tExpr, f, err = ComposeAttachmentFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeChart: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeAttachment: %w", err)
 		return
 	}
 
@@ -7103,18 +7085,18 @@ func (s *Store) QueryComposeCharts(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeChartFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeAttachmentFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := composeChartSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := composeAttachmentSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeChartFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeChart: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposeAttachmentFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeAttachment: %w", err)
 		return
 	}
 
@@ -7128,12 +7110,12 @@ func (s *Store) QueryComposeCharts(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 		return
 	}
 
@@ -7147,19 +7129,19 @@ func (s *Store) QueryComposeCharts(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeChart: %w", err)
+			err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 			return
 		}
 
-		aux = new(auxComposeChart)
+		aux = new(auxComposeAttachment)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeChart: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeAttachment: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeChart: %w", err)
+			err = fmt.Errorf("could not decode ComposeAttachment: %w", err)
 			return
 		}
 
@@ -7180,16 +7162,14 @@ func (s *Store) QueryComposeCharts(
 
 }
 
-// LookupComposeChartByID searches for compose chart by ID
-//
-// It returns compose chart even if deleted
+// LookupComposeAttachmentByID
 //
 // This function is auto-generated
-func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *composeType.Chart, err error) {
+func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *composeType.Attachment, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeChart)
-		lookup = composeChartSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposeAttachment)
+		lookup = composeAttachmentSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -7222,69 +7202,29 @@ func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *compo
 	return aux.decode()
 }
 
-// LookupComposeChartByNamespaceIDHandle searches for compose chart by handle (case-insensitive)
+// sortableComposeAttachmentFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (s *Store) LookupComposeChartByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Chart, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeChart)
-		lookup = composeChartSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableComposeChartFields returns all <no value> columns flagged as sortable
-//
-// # Notes
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableComposeChartFields() map[string]string {
+func (Store) sortableComposeAttachmentFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
-		"handle":     "handle",
 		"id":         "id",
+		"kind":       "kind",
 		"name":       "name",
+		"owner_id":   "owner_id",
+		"ownerid":    "owner_id",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeChartCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeAttachmentCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -7296,7 +7236,7 @@ func (Store) sortableComposeChartFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -7310,9 +7250,10 @@ func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*f
 				case "id":
 					pkID = true
 					return res.ID
-				case "handle":
-					hasUnique = true
-					return res.Handle
+				case "ownerID":
+					return res.OwnerID
+				case "kind":
+					return res.Kind
 				case "name":
 					return res.Name
 				case "createdAt":
@@ -7353,27 +7294,27 @@ func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*f
 
 }
 
-// checkComposeChartConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeChartConstraints(ctx context.Context, res *composeType.Chart) (err error) {
+func (s *Store) checkComposeAttachmentConstraints(ctx context.Context, res *composeType.Attachment) (err error) {
 	return nil
 }
 
-// CreateComposeModule creates one or more rows in composeModule collection
+// CreateComposeChart creates one or more rows in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeChartInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -7381,16 +7322,16 @@ func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// UpdateComposeModule updates one or more existing entries in composeModule collection
+// UpdateComposeChart updates one or more existing entries in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeChartUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -7398,12 +7339,12 @@ func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// UpsertComposeModule updates one or more existing entries in composeModule collection
+// UpsertComposeChart updates one or more existing entries in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -7411,7 +7352,7 @@ func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Modu
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composeModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composeChartUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -7421,12 +7362,12 @@ func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Modu
 				continue
 			}
 
-			err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeChartInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composeModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeChartUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -7436,12 +7377,12 @@ func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// DeleteComposeModule Deletes one or more entries from composeModule collection
+// DeleteComposeChart Deletes one or more entries from composeChart collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeModuleDeleteQuery(s.Dialect.GOQU(), composeModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeChartDeleteQuery(s.Dialect.GOQU(), composeChartPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -7449,24 +7390,24 @@ func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return nil
 }
 
-// DeleteComposeModuleByID deletes single entry from composeModule collection
+// DeleteComposeChartByID deletes single entry from composeChart collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposeChartByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeChartDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeModules Deletes all rows from the composeModule collection
-func (s *Store) TruncateComposeModules(ctx context.Context) error {
-	return s.Exec(ctx, composeModuleTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposeCharts Deletes all rows from the composeChart collection
+func (s *Store) TruncateComposeCharts(ctx context.Context) error {
+	return s.Exec(ctx, composeChartTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposeModules returns (filtered) set of ComposeModules
+// SearchComposeCharts returns (filtered) set of ComposeCharts
 //
 // This function is auto-generated
-func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFilter) (set composeType.ModuleSet, _ composeType.ModuleFilter, err error) {
+func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilter) (set composeType.ChartSet, _ composeType.ChartFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -7494,7 +7435,7 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeModules fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposeCharts fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -7504,7 +7445,7 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeModules(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeCharts(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -7520,8 +7461,8 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet composeType.ModuleSet
-			if navSet, _, _, err = s.fetchFullPageOfComposeModules(ctx, f, sort); err != nil {
+			var navSet composeType.ChartSet
+			if navSet, _, _, err = s.fetchFullPageOfComposeCharts(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -7533,7 +7474,7 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeModules collects all requested results.
+// fetchFullPageOfComposeCharts collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -7545,13 +7486,13 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeModules(
+func (s *Store) fetchFullPageOfComposeCharts(
 	ctx context.Context,
-	filter composeType.ModuleFilter,
+	filter composeType.ChartFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Module, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Chart, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Module
+		aux []*composeType.Chart
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -7570,10 +7511,10 @@ func (s *Store) fetchFullPageOfComposeModules(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.ModuleFilter
+		tryFilter composeType.ChartFilter
 	)
 
-	set = make([]*composeType.Module, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Chart, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -7586,7 +7527,7 @@ func (s *Store) fetchFullPageOfComposeModules(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeModules(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposeCharts(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -7616,7 +7557,7 @@ func (s *Store) fetchFullPageOfComposeModules(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -7647,35 +7588,35 @@ func (s *Store) fetchFullPageOfComposeModules(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeModuleCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposeChartCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeModules queries the database, converts and checks each row and returns collected set
+// QueryComposeCharts queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeModules(
+func (s *Store) QueryComposeCharts(
 	ctx context.Context,
-	f composeType.ModuleFilter,
-) (_ []*composeType.Module, more bool, err error) {
+	f composeType.ChartFilter,
+) (_ []*composeType.Chart, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.Module, 0, DefaultSliceCapacity)
-		res         *composeType.Module
-		aux         *auxComposeModule
+		set         = make([]*composeType.Chart, 0, DefaultSliceCapacity)
+		res         *composeType.Chart
+		aux         *auxComposeChart
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -7683,16 +7624,16 @@ func (s *Store) QueryComposeModules(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeModule != nil {
+	if s.Filters.ComposeChart != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeModule(s, f)
+		tExpr, f, err = s.Filters.ComposeChart(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeModuleFilter(s.Dialect, f)
+		tExpr, f, err = ComposeChartFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeModule: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeChart: %w", err)
 		return
 	}
 
@@ -7700,18 +7641,18 @@ func (s *Store) QueryComposeModules(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeModuleFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeChartFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := composeModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := composeChartSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeModule: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposeChartFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeChart: %w", err)
 		return
 	}
 
@@ -7725,12 +7666,12 @@ func (s *Store) QueryComposeModules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeModule: %w", err)
+		err = fmt.Errorf("could not query ComposeChart: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeModule: %w", err)
+		err = fmt.Errorf("could not query ComposeChart: %w", err)
 		return
 	}
 
@@ -7744,19 +7685,19 @@ func (s *Store) QueryComposeModules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeModule: %w", err)
+			err = fmt.Errorf("could not query ComposeChart: %w", err)
 			return
 		}
 
-		aux = new(auxComposeModule)
+		aux = new(auxComposeChart)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeModule: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeChart: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeModule: %w", err)
+			err = fmt.Errorf("could not decode ComposeChart: %w", err)
 			return
 		}
 
@@ -7777,17 +7718,17 @@ func (s *Store) QueryComposeModules(
 
 }
 
-// LookupComposeModuleByNamespaceIDHandle searches for compose module by handle (case-insensitive)
+// LookupComposeChartByID searches for compose chart by ID
+//
+// It returns compose chart even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposeModuleByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Module, err error) {
+func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *composeType.Chart, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		aux    = new(auxComposeChart)
+		lookup = composeChartSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -7819,16 +7760,16 @@ func (s *Store) LookupComposeModuleByNamespaceIDHandle(ctx context.Context, name
 	return aux.decode()
 }
 
-// LookupComposeModuleByNamespaceIDName searches for compose module by name (case-insensitive)
+// LookupComposeChartByNamespaceIDHandle searches for compose chart by handle (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namespaceID uint64, name string) (_ *composeType.Module, err error) {
+func (s *Store) LookupComposeChartByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Chart, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposeChart)
+		lookup = composeChartSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("rel_namespace").Eq(namespaceID),
-			goqu.I("name").Eq(name),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
 	)
@@ -7861,55 +7802,13 @@ func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namesp
 	return aux.decode()
 }
 
-// LookupComposeModuleByID searches for compose module by ID
-//
-// It returns compose module even if deleted
-//
-// This function is auto-generated
-func (s *Store) LookupComposeModuleByID(ctx context.Context, id uint64) (_ *composeType.Module, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableComposeModuleFields returns all <no value> columns flagged as sortable
+// sortableComposeChartFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeModuleFields() map[string]string {
+func (Store) sortableComposeChartFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -7923,7 +7822,7 @@ func (Store) sortableComposeModuleFields() map[string]string {
 	}
 }
 
-// collectComposeModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeChartCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -7935,7 +7834,7 @@ func (Store) sortableComposeModuleFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -7992,59 +7891,27 @@ func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...
 
 }
 
-// checkComposeModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeChartConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeModuleConstraints(ctx context.Context, res *composeType.Module) (err error) {
-	err = func() (err error) {
+func (s *Store) checkComposeChartConstraints(ctx context.Context, res *composeType.Chart) (err error) {
+	return nil
+}
 
-		if res.NamespaceID == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupComposeModuleByNamespaceIDHandle(ctx, res.NamespaceID, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
-	return nil
-}
-
-// CreateComposeModuleField creates one or more rows in composeModuleField collection
+// CreateComposeModule creates one or more rows in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -8052,16 +7919,16 @@ func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpdateComposeModuleField updates one or more existing entries in composeModuleField collection
+// UpdateComposeModule updates one or more existing entries in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleFieldUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -8069,12 +7936,12 @@ func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpsertComposeModuleField updates one or more existing entries in composeModuleField collection
+// UpsertComposeModule updates one or more existing entries in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -8082,7 +7949,7 @@ func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composeModuleFieldUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composeModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -8092,12 +7959,12 @@ func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType
 				continue
 			}
 
-			err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composeModuleFieldUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -8107,12 +7974,12 @@ func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// DeleteComposeModuleField Deletes one or more entries from composeModuleField collection
+// DeleteComposeModule Deletes one or more entries from composeModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect.GOQU(), composeModuleFieldPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeModuleDeleteQuery(s.Dialect.GOQU(), composeModulePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -8120,126 +7987,385 @@ func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType
 	return nil
 }
 
-// DeleteComposeModuleFieldByID deletes single entry from composeModuleField collection
+// DeleteComposeModuleByID deletes single entry from composeModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleFieldByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposeModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeModuleFields Deletes all rows from the composeModuleField collection
-func (s *Store) TruncateComposeModuleFields(ctx context.Context) error {
-	return s.Exec(ctx, composeModuleFieldTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposeModules Deletes all rows from the composeModule collection
+func (s *Store) TruncateComposeModules(ctx context.Context) error {
+	return s.Exec(ctx, composeModuleTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposeModuleFields returns (filtered) set of ComposeModuleFields
+// SearchComposeModules returns (filtered) set of ComposeModules
 //
 // This function is auto-generated
-func (s *Store) SearchComposeModuleFields(ctx context.Context, f composeType.ModuleFieldFilter) (set composeType.ModuleFieldSet, _ composeType.ModuleFieldFilter, err error) {
+func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFilter) (set composeType.ModuleSet, _ composeType.ModuleFilter, err error) {
 
-	set, _, err = s.QueryComposeModuleFields(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposeModules fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeModules(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
 
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet composeType.ModuleSet
+			if navSet, _, _, err = s.fetchFullPageOfComposeModules(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
 	return set, f, nil
 }
 
-// QueryComposeModuleFields queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfComposeModules collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryComposeModuleFields(
+func (s *Store) fetchFullPageOfComposeModules(
 	ctx context.Context,
-	f composeType.ModuleFieldFilter,
-) (_ []*composeType.ModuleField, more bool, err error) {
+	filter composeType.ModuleFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.Module, prev, next *filter.PagingCursor, err error) {
 	var (
-		set         = make([]*composeType.ModuleField, 0, DefaultSliceCapacity)
-		res         *composeType.ModuleField
-		aux         *auxComposeModuleField
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		aux []*composeType.Module
 
-	if s.Filters.ComposeModuleField != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.ComposeModuleField(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ComposeModuleFieldFilter(s.Dialect, f)
-	}
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeModuleField: %w", err)
-		return
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	expr = append(expr, tExpr...)
+		reqItems = filter.Limit
 
-	query := composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(expr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-		return
-	}
+		tryFilter composeType.ModuleFilter
+	)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-		return
-	}
+	set = make([]*composeType.Module, 0, DefaultSliceCapacity)
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
 		}
-	}()
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-			return
+		if aux, hasNext, err = s.QueryComposeModules(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
 		}
 
-		aux = new(auxComposeModuleField)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeModuleField: %w", err)
-			return
+		if len(aux) == 0 {
+			// nothing fetched
+			break
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeModuleField: %w", err)
-			return
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
 		}
 
-		set = append(set, res)
-	}
+		collected := uint(len(set))
 
-	return set, false, err
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
 
-}
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
 
-// LookupComposeModuleFieldByModuleIDName searches for compose module field by name (case-insensitive)
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposeModuleCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposeModules queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, moduleID uint64, name string) (_ *composeType.ModuleField, err error) {
+func (s *Store) QueryComposeModules(
+	ctx context.Context,
+	f composeType.ModuleFilter,
+) (_ []*composeType.Module, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*composeType.Module, 0, DefaultSliceCapacity)
+		res         *composeType.Module
+		aux         *auxComposeModule
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.ComposeModule != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeModule(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeModuleFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeModule: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeModuleFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composeModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposeModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeModule: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeModule: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeModule: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeModule: %w", err)
+			return
+		}
+
+		aux = new(auxComposeModule)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeModule: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeModule: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupComposeModuleByNamespaceIDHandle searches for compose module by handle (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposeModuleByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Module, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeModuleField)
-		lookup = composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_module").Eq(moduleID),
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupComposeModuleByNamespaceIDName searches for compose module by name (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namespaceID uint64, name string) (_ *composeType.Module, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
 			goqu.I("name").Eq(name),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
@@ -8273,14 +8399,16 @@ func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, modu
 	return aux.decode()
 }
 
-// LookupComposeModuleFieldByID searches for compose module field by ID
+// LookupComposeModuleByID searches for compose module by ID
+//
+// It returns compose module even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposeModuleFieldByID(ctx context.Context, id uint64) (_ *composeType.ModuleField, err error) {
+func (s *Store) LookupComposeModuleByID(ctx context.Context, id uint64) (_ *composeType.Module, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeModuleField)
-		lookup = composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -8313,29 +8441,27 @@ func (s *Store) LookupComposeModuleFieldByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableComposeModuleFieldFields returns all <no value> columns flagged as sortable
+// sortableComposeModuleFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeModuleFieldFields() map[string]string {
+func (Store) sortableComposeModuleFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
+		"handle":     "handle",
 		"id":         "id",
-		"kind":       "kind",
-		"label":      "label",
 		"name":       "name",
-		"place":      "place",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeModuleFieldCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeModuleCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -8347,7 +8473,7 @@ func (Store) sortableComposeModuleFieldFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleField, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -8361,14 +8487,11 @@ func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleFie
 				case "id":
 					pkID = true
 					return res.ID
-				case "place":
-					return res.Place
-				case "kind":
-					return res.Kind
+				case "handle":
+					hasUnique = true
+					return res.Handle
 				case "name":
 					return res.Name
-				case "label":
-					return res.Label
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -8407,23 +8530,23 @@ func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleFie
 
 }
 
-// checkComposeModuleFieldConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *composeType.ModuleField) (err error) {
+func (s *Store) checkComposeModuleConstraints(ctx context.Context, res *composeType.Module) (err error) {
 	err = func() (err error) {
 
-		if res.ModuleID == 0 {
+		if res.NamespaceID == 0 {
 			// skip check on empty values
 			return nil
 		}
 
 		// handling string type as default
-		if len(res.Name) == 0 {
+		if len(res.Handle) == 0 {
 			// skip check on empty values
 			return nil
 		}
@@ -8433,7 +8556,7 @@ func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *com
 			return nil
 		}
 
-		ex, err := s.LookupComposeModuleFieldByModuleIDName(ctx, res.ModuleID, res.Name)
+		ex, err := s.LookupComposeModuleByNamespaceIDHandle(ctx, res.NamespaceID, res.Handle)
 		if err == nil && ex != nil && ex.ID != res.ID {
 			return store.ErrNotUnique.Stack(1)
 		} else if !errors.IsNotFound(err) {
@@ -8450,16 +8573,16 @@ func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *com
 	return nil
 }
 
-// CreateComposeNamespace creates one or more rows in composeNamespace collection
+// CreateComposeModuleField creates one or more rows in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -8467,16 +8590,16 @@ func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// UpdateComposeNamespace updates one or more existing entries in composeNamespace collection
+// UpdateComposeModuleField updates one or more existing entries in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeNamespaceUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -8484,12 +8607,12 @@ func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// UpsertComposeNamespace updates one or more existing entries in composeNamespace collection
+// UpsertComposeModuleField updates one or more existing entries in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -8497,7 +8620,7 @@ func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.N
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composeNamespaceUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composeModuleFieldUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -8507,12 +8630,12 @@ func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.N
 				continue
 			}
 
-			err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composeNamespaceUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeModuleFieldUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -8522,12 +8645,12 @@ func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// DeleteComposeNamespace Deletes one or more entries from composeNamespace collection
+// DeleteComposeModuleField Deletes one or more entries from composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect.GOQU(), composeNamespacePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect.GOQU(), composeModuleFieldPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -8535,350 +8658,134 @@ func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return nil
 }
 
-// DeleteComposeNamespaceByID deletes single entry from composeNamespace collection
+// DeleteComposeModuleFieldByID deletes single entry from composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeNamespaceByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposeModuleFieldByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeNamespaces Deletes all rows from the composeNamespace collection
-func (s *Store) TruncateComposeNamespaces(ctx context.Context) error {
-	return s.Exec(ctx, composeNamespaceTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposeModuleFields Deletes all rows from the composeModuleField collection
+func (s *Store) TruncateComposeModuleFields(ctx context.Context) error {
+	return s.Exec(ctx, composeModuleFieldTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposeNamespaces returns (filtered) set of ComposeNamespaces
+// SearchComposeModuleFields returns (filtered) set of ComposeModuleFields
 //
 // This function is auto-generated
-func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.NamespaceFilter) (set composeType.NamespaceSet, _ composeType.NamespaceFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
-
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeNamespaces fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort)
+func (s *Store) SearchComposeModuleFields(ctx context.Context, f composeType.ModuleFieldFilter) (set composeType.ModuleFieldSet, _ composeType.ModuleFieldFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryComposeModuleFields(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
 
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
-
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet composeType.NamespaceSet
-			if navSet, _, _, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
-	}
-
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeNamespaces collects all requested results.
-//
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryComposeModuleFields queries the database, converts and checks each row and returns collected set
 //
-// # Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeNamespaces(
+func (s *Store) QueryComposeModuleFields(
 	ctx context.Context,
-	filter composeType.NamespaceFilter,
-	sort filter.SortExprSet,
-) (set []*composeType.Namespace, prev, next *filter.PagingCursor, err error) {
+	f composeType.ModuleFieldFilter,
+) (_ []*composeType.ModuleField, more bool, err error) {
 	var (
-		aux []*composeType.Namespace
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+		set         = make([]*composeType.ModuleField, 0, DefaultSliceCapacity)
+		res         *composeType.ModuleField
+		aux         *auxComposeModuleField
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
 
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+	if s.Filters.ComposeModuleField != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeModuleField(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeModuleFieldFilter(s.Dialect, f)
+	}
 
-		reqItems = filter.Limit
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeModuleField: %w", err)
+		return
+	}
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+	expr = append(expr, tExpr...)
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+	query := composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
-		tryFilter composeType.NamespaceFilter
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	set = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
+		return
+	}
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
+		return
+	}
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		if aux, hasNext, err = s.QueryComposeNamespaces(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeModuleField: %w", err)
+			return
 		}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+		aux = new(auxComposeModuleField)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeModuleField: %w", err)
+			return
 		}
 
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeModuleField: %w", err)
+			return
 		}
 
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+		set = append(set, res)
+	}
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
+	return set, false, err
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
+}
 
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
+// LookupComposeModuleFieldByModuleIDName searches for compose module field by name (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, moduleID uint64, name string) (_ *composeType.ModuleField, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeModuleField)
+		lookup = composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_module").Eq(moduleID),
+			goqu.I("name").Eq(name),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
 
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectComposeNamespaceCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryComposeNamespaces queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryComposeNamespaces(
-	ctx context.Context,
-	f composeType.NamespaceFilter,
-) (_ []*composeType.Namespace, more bool, err error) {
-	var (
-		ok bool
-
-		set         = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
-		res         *composeType.Namespace
-		aux         *auxComposeNamespace
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
-	)
-
-	if s.Filters.ComposeNamespace != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.ComposeNamespace(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ComposeNamespaceFilter(s.Dialect, f)
-	}
-
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeNamespace: %w", err)
-		return
-	}
-
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeNamespaceFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeNamespaceFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeNamespace: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
-
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
-		return
-	}
-
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeNamespace: %w", err)
-			return
-		}
-
-		aux = new(auxComposeNamespace)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeNamespace: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeNamespace: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
-		set = append(set, res)
-	}
-
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupComposeNamespaceBySlug searches for namespace by slug (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (_ *composeType.Namespace, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeNamespace)
-		lookup = composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("slug").Eq(slug),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
 	}
 
 	defer func() {
@@ -8904,16 +8811,14 @@ func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (
 	return aux.decode()
 }
 
-// LookupComposeNamespaceByID searches for compose namespace by ID
-//
-// It returns compose namespace even if deleted
+// LookupComposeModuleFieldByID searches for compose module field by ID
 //
 // This function is auto-generated
-func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *composeType.Namespace, err error) {
+func (s *Store) LookupComposeModuleFieldByID(ctx context.Context, id uint64) (_ *composeType.ModuleField, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeNamespace)
-		lookup = composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposeModuleField)
+		lookup = composeModuleFieldSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -8946,27 +8851,29 @@ func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *c
 	return aux.decode()
 }
 
-// sortableComposeNamespaceFields returns all <no value> columns flagged as sortable
+// sortableComposeModuleFieldFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeNamespaceFields() map[string]string {
+func (Store) sortableComposeModuleFieldFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
 		"id":         "id",
+		"kind":       "kind",
+		"label":      "label",
 		"name":       "name",
-		"slug":       "slug",
+		"place":      "place",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeNamespaceCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeModuleFieldCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -8978,7 +8885,7 @@ func (Store) sortableComposeNamespaceFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleField, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -8992,10 +8899,14 @@ func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace,
 				case "id":
 					pkID = true
 					return res.ID
-				case "slug":
-					return res.Slug
+				case "place":
+					return res.Place
+				case "kind":
+					return res.Kind
 				case "name":
 					return res.Name
+				case "label":
+					return res.Label
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -9034,18 +8945,23 @@ func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace,
 
 }
 
-// checkComposeNamespaceConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeModuleFieldConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *composeType.Namespace) (err error) {
+func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *composeType.ModuleField) (err error) {
 	err = func() (err error) {
 
+		if res.ModuleID == 0 {
+			// skip check on empty values
+			return nil
+		}
+
 		// handling string type as default
-		if len(res.Slug) == 0 {
+		if len(res.Name) == 0 {
 			// skip check on empty values
 			return nil
 		}
@@ -9055,7 +8971,7 @@ func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *compo
 			return nil
 		}
 
-		ex, err := s.LookupComposeNamespaceBySlug(ctx, res.Slug)
+		ex, err := s.LookupComposeModuleFieldByModuleIDName(ctx, res.ModuleID, res.Name)
 		if err == nil && ex != nil && ex.ID != res.ID {
 			return store.ErrNotUnique.Stack(1)
 		} else if !errors.IsNotFound(err) {
@@ -9072,16 +8988,16 @@ func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *compo
 	return nil
 }
 
-// CreateComposePage creates one or more rows in composePage collection
+// CreateComposeNamespace creates one or more rows in composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -9089,16 +9005,16 @@ func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// UpdateComposePage updates one or more existing entries in composePage collection
+// UpdateComposeNamespace updates one or more existing entries in composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composeNamespaceUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -9106,12 +9022,12 @@ func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// UpsertComposePage updates one or more existing entries in composePage collection
+// UpsertComposeNamespace updates one or more existing entries in composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -9119,7 +9035,7 @@ func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page)
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composePageUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composeNamespaceUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -9129,12 +9045,12 @@ func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page)
 				continue
 			}
 
-			err = s.Exec(ctx, composePageInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composePageUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composeNamespaceUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -9144,12 +9060,12 @@ func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// DeleteComposePage Deletes one or more entries from composePage collection
+// DeleteComposeNamespace Deletes one or more entries from composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composePageDeleteQuery(s.Dialect.GOQU(), composePagePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect.GOQU(), composeNamespacePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -9157,24 +9073,24 @@ func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page)
 	return nil
 }
 
-// DeleteComposePageByID deletes single entry from composePage collection
+// DeleteComposeNamespaceByID deletes single entry from composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePageByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composePageDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposeNamespaceByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposePages Deletes all rows from the composePage collection
-func (s *Store) TruncateComposePages(ctx context.Context) error {
-	return s.Exec(ctx, composePageTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposeNamespaces Deletes all rows from the composeNamespace collection
+func (s *Store) TruncateComposeNamespaces(ctx context.Context) error {
+	return s.Exec(ctx, composeNamespaceTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposePages returns (filtered) set of ComposePages
+// SearchComposeNamespaces returns (filtered) set of ComposeNamespaces
 //
 // This function is auto-generated
-func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter) (set composeType.PageSet, _ composeType.PageFilter, err error) {
+func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.NamespaceFilter) (set composeType.NamespaceSet, _ composeType.NamespaceFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -9202,7 +9118,7 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposePages fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposeNamespaces fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -9212,7 +9128,7 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePages(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -9228,8 +9144,8 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet composeType.PageSet
-			if navSet, _, _, err = s.fetchFullPageOfComposePages(ctx, f, sort); err != nil {
+			var navSet composeType.NamespaceSet
+			if navSet, _, _, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -9241,7 +9157,7 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 	return set, f, nil
 }
 
-// fetchFullPageOfComposePages collects all requested results.
+// fetchFullPageOfComposeNamespaces collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -9253,13 +9169,13 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposePages(
+func (s *Store) fetchFullPageOfComposeNamespaces(
 	ctx context.Context,
-	filter composeType.PageFilter,
+	filter composeType.NamespaceFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Page, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Namespace, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Page
+		aux []*composeType.Namespace
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -9278,10 +9194,10 @@ func (s *Store) fetchFullPageOfComposePages(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.PageFilter
+		tryFilter composeType.NamespaceFilter
 	)
 
-	set = make([]*composeType.Page, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -9294,7 +9210,7 @@ func (s *Store) fetchFullPageOfComposePages(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposePages(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposeNamespaces(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -9324,7 +9240,7 @@ func (s *Store) fetchFullPageOfComposePages(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -9355,35 +9271,35 @@ func (s *Store) fetchFullPageOfComposePages(
 	}
 
 	if hasPrev {
-		prev = s.collectComposePageCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposeNamespaceCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposePages queries the database, converts and checks each row and returns collected set
+// QueryComposeNamespaces queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposePages(
+func (s *Store) QueryComposeNamespaces(
 	ctx context.Context,
-	f composeType.PageFilter,
-) (_ []*composeType.Page, more bool, err error) {
+	f composeType.NamespaceFilter,
+) (_ []*composeType.Namespace, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.Page, 0, DefaultSliceCapacity)
-		res         *composeType.Page
-		aux         *auxComposePage
+		set         = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
+		res         *composeType.Namespace
+		aux         *auxComposeNamespace
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -9391,16 +9307,16 @@ func (s *Store) QueryComposePages(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposePage != nil {
+	if s.Filters.ComposeNamespace != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposePage(s, f)
+		tExpr, f, err = s.Filters.ComposeNamespace(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposePageFilter(s.Dialect, f)
+		tExpr, f, err = ComposeNamespaceFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposePage: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeNamespace: %w", err)
 		return
 	}
 
@@ -9408,18 +9324,18 @@ func (s *Store) QueryComposePages(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposePageFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposeNamespaceFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := composePageSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposePageFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposePage: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposeNamespaceFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeNamespace: %w", err)
 		return
 	}
 
@@ -9433,12 +9349,12 @@ func (s *Store) QueryComposePages(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposePage: %w", err)
+		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposePage: %w", err)
+		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 		return
 	}
 
@@ -9452,19 +9368,19 @@ func (s *Store) QueryComposePages(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposePage: %w", err)
+			err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 			return
 		}
 
-		aux = new(auxComposePage)
+		aux = new(auxComposeNamespace)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposePage: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeNamespace: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposePage: %w", err)
+			err = fmt.Errorf("could not decode ComposeNamespace: %w", err)
 			return
 		}
 
@@ -9485,58 +9401,15 @@ func (s *Store) QueryComposePages(
 
 }
 
-// LookupComposePageByNamespaceIDHandle searches for page by handle (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposePageByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Page, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupComposePageByNamespaceIDModuleID searches for page by moduleID
+// LookupComposeNamespaceBySlug searches for namespace by slug (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, namespaceID uint64, moduleID uint64) (_ *composeType.Page, err error) {
+func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (_ *composeType.Namespace, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			goqu.I("rel_module").Eq(moduleID),
+		aux    = new(auxComposeNamespace)
+		lookup = composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("slug").Eq(slug),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
 	)
@@ -9569,16 +9442,16 @@ func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, name
 	return aux.decode()
 }
 
-// LookupComposePageByID searches for compose page by ID
+// LookupComposeNamespaceByID searches for compose namespace by ID
 //
-// It returns compose page even if deleted
+// It returns compose namespace even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *composeType.Page, err error) {
+func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *composeType.Namespace, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposeNamespace)
+		lookup = composeNamespaceSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -9611,30 +9484,27 @@ func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *compos
 	return aux.decode()
 }
 
-// sortableComposePageFields returns all <no value> columns flagged as sortable
+// sortableComposeNamespaceFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposePageFields() map[string]string {
+func (Store) sortableComposeNamespaceFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
-		"handle":     "handle",
 		"id":         "id",
-		"self_id":    "self_id",
-		"selfid":     "self_id",
-		"title":      "title",
+		"name":       "name",
+		"slug":       "slug",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
-		"weight":     "weight",
 	}
 }
 
-// collectComposePageCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeNamespaceCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -9646,7 +9516,7 @@ func (Store) sortableComposePageFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -9660,15 +9530,10 @@ func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*fil
 				case "id":
 					pkID = true
 					return res.ID
-				case "title":
-					return res.Title
-				case "handle":
-					hasUnique = true
-					return res.Handle
-				case "selfID":
-					return res.SelfID
-				case "weight":
-					return res.Weight
+				case "slug":
+					return res.Slug
+				case "name":
+					return res.Name
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -9707,27 +9572,54 @@ func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*fil
 
 }
 
-// checkComposePageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeNamespaceConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposePageConstraints(ctx context.Context, res *composeType.Page) (err error) {
+func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *composeType.Namespace) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Slug) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupComposeNamespaceBySlug(ctx, res.Slug)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateComposePageLayout creates one or more rows in composePageLayout collection
+// CreateComposePage creates one or more rows in composePage collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
+func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageLayoutInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -9735,16 +9627,16 @@ func (s *Store) CreateComposePageLayout(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpdateComposePageLayout updates one or more existing entries in composePageLayout collection
+// UpdateComposePage updates one or more existing entries in composePage collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
+func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageLayoutUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -9752,12 +9644,12 @@ func (s *Store) UpdateComposePageLayout(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpsertComposePageLayout updates one or more existing entries in composePageLayout collection
+// UpsertComposePage updates one or more existing entries in composePage collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
+func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -9765,7 +9657,7 @@ func (s *Store) UpsertComposePageLayout(ctx context.Context, rr ...*composeType.
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, composePageLayoutUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composePageUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -9775,12 +9667,12 @@ func (s *Store) UpsertComposePageLayout(ctx context.Context, rr ...*composeType.
 				continue
 			}
 
-			err = s.Exec(ctx, composePageLayoutInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composePageInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, composePageLayoutUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composePageUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -9790,12 +9682,12 @@ func (s *Store) UpsertComposePageLayout(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// DeleteComposePageLayout Deletes one or more entries from composePageLayout collection
+// DeleteComposePage Deletes one or more entries from composePage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
+func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composePageLayoutDeleteQuery(s.Dialect.GOQU(), composePageLayoutPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composePageDeleteQuery(s.Dialect.GOQU(), composePagePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -9803,24 +9695,24 @@ func (s *Store) DeleteComposePageLayout(ctx context.Context, rr ...*composeType.
 	return nil
 }
 
-// DeleteComposePageLayoutByID deletes single entry from composePageLayout collection
+// DeleteComposePageByID deletes single entry from composePage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePageLayoutByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composePageLayoutDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposePageByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composePageDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposePageLayouts Deletes all rows from the composePageLayout collection
-func (s *Store) TruncateComposePageLayouts(ctx context.Context) error {
-	return s.Exec(ctx, composePageLayoutTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposePages Deletes all rows from the composePage collection
+func (s *Store) TruncateComposePages(ctx context.Context) error {
+	return s.Exec(ctx, composePageTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchComposePageLayouts returns (filtered) set of ComposePageLayouts
+// SearchComposePages returns (filtered) set of ComposePages
 //
 // This function is auto-generated
-func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.PageLayoutFilter) (set composeType.PageLayoutSet, _ composeType.PageLayoutFilter, err error) {
+func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter) (set composeType.PageSet, _ composeType.PageFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -9848,7 +9740,7 @@ func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.Page
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposePageLayouts fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposePages fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -9858,7 +9750,7 @@ func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.Page
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePageLayouts(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePages(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -9874,8 +9766,8 @@ func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.Page
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet composeType.PageLayoutSet
-			if navSet, _, _, err = s.fetchFullPageOfComposePageLayouts(ctx, f, sort); err != nil {
+			var navSet composeType.PageSet
+			if navSet, _, _, err = s.fetchFullPageOfComposePages(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -9887,7 +9779,7 @@ func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.Page
 	return set, f, nil
 }
 
-// fetchFullPageOfComposePageLayouts collects all requested results.
+// fetchFullPageOfComposePages collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -9899,13 +9791,13 @@ func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.Page
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposePageLayouts(
+func (s *Store) fetchFullPageOfComposePages(
 	ctx context.Context,
-	filter composeType.PageLayoutFilter,
+	filter composeType.PageFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.PageLayout, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Page, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.PageLayout
+		aux []*composeType.Page
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -9924,10 +9816,10 @@ func (s *Store) fetchFullPageOfComposePageLayouts(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.PageLayoutFilter
+		tryFilter composeType.PageFilter
 	)
 
-	set = make([]*composeType.PageLayout, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Page, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -9940,7 +9832,7 @@ func (s *Store) fetchFullPageOfComposePageLayouts(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposePageLayouts(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposePages(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -9970,7 +9862,7 @@ func (s *Store) fetchFullPageOfComposePageLayouts(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposePageLayoutCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -10001,35 +9893,35 @@ func (s *Store) fetchFullPageOfComposePageLayouts(
 	}
 
 	if hasPrev {
-		prev = s.collectComposePageLayoutCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposePageCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposePageLayoutCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposePageLayouts queries the database, converts and checks each row and returns collected set
+// QueryComposePages queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposePageLayouts(
+func (s *Store) QueryComposePages(
 	ctx context.Context,
-	f composeType.PageLayoutFilter,
-) (_ []*composeType.PageLayout, more bool, err error) {
+	f composeType.PageFilter,
+) (_ []*composeType.Page, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.PageLayout, 0, DefaultSliceCapacity)
-		res         *composeType.PageLayout
-		aux         *auxComposePageLayout
+		set         = make([]*composeType.Page, 0, DefaultSliceCapacity)
+		res         *composeType.Page
+		aux         *auxComposePage
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -10037,16 +9929,16 @@ func (s *Store) QueryComposePageLayouts(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposePageLayout != nil {
+	if s.Filters.ComposePage != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposePageLayout(s, f)
+		tExpr, f, err = s.Filters.ComposePage(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposePageLayoutFilter(s.Dialect, f)
+		tExpr, f, err = ComposePageFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposePageLayout: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposePage: %w", err)
 		return
 	}
 
@@ -10054,18 +9946,18 @@ func (s *Store) QueryComposePageLayouts(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposePageLayoutFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposePageFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := composePageSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposePageLayoutFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposePageLayout: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposePageFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposePage: %w", err)
 		return
 	}
 
@@ -10079,12 +9971,12 @@ func (s *Store) QueryComposePageLayouts(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+		err = fmt.Errorf("could not query ComposePage: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+		err = fmt.Errorf("could not query ComposePage: %w", err)
 		return
 	}
 
@@ -10098,19 +9990,19 @@ func (s *Store) QueryComposePageLayouts(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+			err = fmt.Errorf("could not query ComposePage: %w", err)
 			return
 		}
 
-		aux = new(auxComposePageLayout)
+		aux = new(auxComposePage)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposePageLayout: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposePage: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposePageLayout: %w", err)
+			err = fmt.Errorf("could not decode ComposePage: %w", err)
 			return
 		}
 
@@ -10131,14 +10023,14 @@ func (s *Store) QueryComposePageLayouts(
 
 }
 
-// LookupComposePageLayoutByNamespaceIDHandle searches for page layour by handle (case-insensitive)
+// LookupComposePageByNamespaceIDHandle searches for page by handle (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageLayoutByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.PageLayout, err error) {
+func (s *Store) LookupComposePageByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePageLayout)
-		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("rel_namespace").Eq(namespaceID),
 			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
@@ -10173,17 +10065,16 @@ func (s *Store) LookupComposePageLayoutByNamespaceIDHandle(ctx context.Context,
 	return aux.decode()
 }
 
-// LookupComposePageLayoutByNamespaceIDPageIDHandle searches for page layour by handle (case-insensitive)
+// LookupComposePageByNamespaceIDModuleID searches for page by moduleID
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageLayoutByNamespaceIDPageIDHandle(ctx context.Context, namespaceID uint64, pageID uint64, handle string) (_ *composeType.PageLayout, err error) {
+func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, namespaceID uint64, moduleID uint64) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePageLayout)
-		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("rel_namespace").Eq(namespaceID),
-			goqu.I("page_id").Eq(pageID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("rel_module").Eq(moduleID),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
 	)
@@ -10216,16 +10107,16 @@ func (s *Store) LookupComposePageLayoutByNamespaceIDPageIDHandle(ctx context.Con
 	return aux.decode()
 }
 
-// LookupComposePageLayoutByID searches for compose page layour by ID
+// LookupComposePageByID searches for compose page by ID
 //
-// It returns compose page layour even if deleted
+// It returns compose page even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageLayoutByID(ctx context.Context, id uint64) (_ *composeType.PageLayout, err error) {
+func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePageLayout)
-		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -10258,13 +10149,13 @@ func (s *Store) LookupComposePageLayoutByID(ctx context.Context, id uint64) (_ *
 	return aux.decode()
 }
 
-// sortableComposePageLayoutFields returns all <no value> columns flagged as sortable
+// sortableComposePageFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposePageLayoutFields() map[string]string {
+func (Store) sortableComposePageFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -10272,17 +10163,16 @@ func (Store) sortableComposePageLayoutFields() map[string]string {
 		"deletedat":  "deleted_at",
 		"handle":     "handle",
 		"id":         "id",
-		"page_id":    "page_id",
-		"pageid":     "page_id",
-		"parent_id":  "parent_id",
-		"parentid":   "parent_id",
+		"self_id":    "self_id",
+		"selfid":     "self_id",
+		"title":      "title",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 		"weight":     "weight",
 	}
 }
 
-// collectComposePageLayoutCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposePageCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -10294,7 +10184,7 @@ func (Store) sortableComposePageLayoutFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposePageLayoutCursorValues(res *composeType.PageLayout, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -10308,13 +10198,13 @@ func (s *Store) collectComposePageLayoutCursorValues(res *composeType.PageLayout
 				case "id":
 					pkID = true
 					return res.ID
+				case "title":
+					return res.Title
 				case "handle":
 					hasUnique = true
 					return res.Handle
-				case "pageID":
-					return res.PageID
-				case "parentID":
-					return res.ParentID
+				case "selfID":
+					return res.SelfID
 				case "weight":
 					return res.Weight
 				case "createdAt":
@@ -10355,27 +10245,27 @@ func (s *Store) collectComposePageLayoutCursorValues(res *composeType.PageLayout
 
 }
 
-// checkComposePageLayoutConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposePageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposePageLayoutConstraints(ctx context.Context, res *composeType.PageLayout) (err error) {
+func (s *Store) checkComposePageConstraints(ctx context.Context, res *composeType.Page) (err error) {
 	return nil
 }
 
-// CreateCredential creates one or more rows in credential collection
+// CreateComposePageLayout creates one or more rows in composePageLayout collection
 //
 // This function is auto-generated
-func (s *Store) CreateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+func (s *Store) CreateComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
 	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, credentialInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageLayoutInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -10383,16 +10273,16 @@ func (s *Store) CreateCredential(ctx context.Context, rr ...*systemType.Credenti
 	return
 }
 
-// UpdateCredential updates one or more existing entries in credential collection
+// UpdateComposePageLayout updates one or more existing entries in composePageLayout collection
 //
 // This function is auto-generated
-func (s *Store) UpdateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+func (s *Store) UpdateComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
 	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, credentialUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageLayoutUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -10400,12 +10290,12 @@ func (s *Store) UpdateCredential(ctx context.Context, rr ...*systemType.Credenti
 	return
 }
 
-// UpsertCredential updates one or more existing entries in credential collection
+// UpsertComposePageLayout updates one or more existing entries in composePageLayout collection
 //
 // This function is auto-generated
-func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+func (s *Store) UpsertComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
 	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageLayoutConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -10413,7 +10303,7 @@ func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credenti
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, credentialUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, composePageLayoutUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -10423,12 +10313,12 @@ func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credenti
 				continue
 			}
 
-			err = s.Exec(ctx, credentialInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composePageLayoutInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, credentialUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, composePageLayoutUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -10438,12 +10328,12 @@ func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credenti
 	return
 }
 
-// DeleteCredential Deletes one or more entries from credential collection
+// DeleteComposePageLayout Deletes one or more entries from composePageLayout collection
 //
 // This function is auto-generated
-func (s *Store) DeleteCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+func (s *Store) DeleteComposePageLayout(ctx context.Context, rr ...*composeType.PageLayout) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, credentialDeleteQuery(s.Dialect.GOQU(), credentialPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composePageLayoutDeleteQuery(s.Dialect.GOQU(), composePageLayoutPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -10451,133 +10341,3560 @@ func (s *Store) DeleteCredential(ctx context.Context, rr ...*systemType.Credenti
 	return nil
 }
 
-// DeleteCredentialByID deletes single entry from credential collection
+// DeleteComposePageLayoutByID deletes single entry from composePageLayout collection
 //
 // This function is auto-generated
-func (s *Store) DeleteCredentialByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, credentialDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteComposePageLayoutByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composePageLayoutDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateCredentials Deletes all rows from the credential collection
-func (s *Store) TruncateCredentials(ctx context.Context) error {
-	return s.Exec(ctx, credentialTruncateQuery(s.Dialect.GOQU()))
+// TruncateComposePageLayouts Deletes all rows from the composePageLayout collection
+func (s *Store) TruncateComposePageLayouts(ctx context.Context) error {
+	return s.Exec(ctx, composePageLayoutTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchCredentials returns (filtered) set of Credentials
+// SearchComposePageLayouts returns (filtered) set of ComposePageLayouts
 //
 // This function is auto-generated
-func (s *Store) SearchCredentials(ctx context.Context, f systemType.CredentialFilter) (set systemType.CredentialSet, _ systemType.CredentialFilter, err error) {
-
-	set, _, err = s.QueryCredentials(ctx, f)
-	if err != nil {
-		return nil, f, err
-	}
+func (s *Store) SearchComposePageLayouts(ctx context.Context, f composeType.PageLayoutFilter) (set composeType.PageLayoutSet, _ composeType.PageLayoutFilter, err error) {
 
-	return set, f, nil
-}
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
 
-// QueryCredentials queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryCredentials(
-	ctx context.Context,
-	f systemType.CredentialFilter,
-) (_ []*systemType.Credential, more bool, err error) {
-	var (
-		set         = make([]*systemType.Credential, 0, DefaultSliceCapacity)
-		res         *systemType.Credential
-		aux         *auxCredential
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
 
-	if s.Filters.Credential != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.Credential(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = CredentialFilter(s.Dialect, f)
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
 	}
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Credential: %w", err)
-		return
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
 	}
 
-	expr = append(expr, tExpr...)
-
-	query := credentialSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposePageLayouts fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
 	}
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query Credential: %w", err)
-		return
-	}
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePageLayouts(ctx, f, sort)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Credential: %w", err)
-		return
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
 	}
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
 
-	for rows.Next() {
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet composeType.PageLayoutSet
+			if navSet, _, _, err = s.fetchFullPageOfComposePageLayouts(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfComposePageLayouts collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfComposePageLayouts(
+	ctx context.Context,
+	filter composeType.PageLayoutFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.PageLayout, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*composeType.PageLayout
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter composeType.PageLayoutFilter
+	)
+
+	set = make([]*composeType.PageLayout, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryComposePageLayouts(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposePageLayoutCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposePageLayoutCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposePageLayoutCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposePageLayouts queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryComposePageLayouts(
+	ctx context.Context,
+	f composeType.PageLayoutFilter,
+) (_ []*composeType.PageLayout, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*composeType.PageLayout, 0, DefaultSliceCapacity)
+		res         *composeType.PageLayout
+		aux         *auxComposePageLayout
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.ComposePageLayout != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposePageLayout(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposePageLayoutFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposePageLayout: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableComposePageLayoutFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposePageLayoutFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposePageLayout: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Credential: %w", err)
+			err = fmt.Errorf("could not query ComposePageLayout: %w", err)
+			return
+		}
+
+		aux = new(auxComposePageLayout)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposePageLayout: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposePageLayout: %w", err)
 			return
 		}
 
-		aux = new(auxCredential)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Credential: %w", err)
-			return
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupComposePageLayoutByNamespaceIDHandle searches for page layour by handle (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposePageLayoutByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.PageLayout, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposePageLayout)
+		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupComposePageLayoutByNamespaceIDPageIDHandle searches for page layour by handle (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposePageLayoutByNamespaceIDPageIDHandle(ctx context.Context, namespaceID uint64, pageID uint64, handle string) (_ *composeType.PageLayout, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposePageLayout)
+		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			goqu.I("page_id").Eq(pageID),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupComposePageLayoutByID searches for compose page layour by ID
+//
+// It returns compose page layour even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupComposePageLayoutByID(ctx context.Context, id uint64) (_ *composeType.PageLayout, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposePageLayout)
+		lookup = composePageLayoutSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableComposePageLayoutFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableComposePageLayoutFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"page_id":    "page_id",
+		"pageid":     "page_id",
+		"parent_id":  "parent_id",
+		"parentid":   "parent_id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+		"weight":     "weight",
+	}
+}
+
+// collectComposePageLayoutCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectComposePageLayoutCursorValues(res *composeType.PageLayout, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "pageID":
+					return res.PageID
+				case "parentID":
+					return res.ParentID
+				case "weight":
+					return res.Weight
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkComposePageLayoutConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkComposePageLayoutConstraints(ctx context.Context, res *composeType.PageLayout) (err error) {
+	return nil
+}
+
+// CreateCredential creates one or more rows in credential collection
+//
+// This function is auto-generated
+func (s *Store) CreateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, credentialInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateCredential updates one or more existing entries in credential collection
+//
+// This function is auto-generated
+func (s *Store) UpdateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, credentialUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertCredential updates one or more existing entries in credential collection
+//
+// This function is auto-generated
+func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, credentialUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, credentialInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, credentialUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteCredential Deletes one or more entries from credential collection
+//
+// This function is auto-generated
+func (s *Store) DeleteCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, credentialDeleteQuery(s.Dialect.GOQU(), credentialPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteCredentialByID deletes single entry from credential collection
+//
+// This function is auto-generated
+func (s *Store) DeleteCredentialByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, credentialDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateCredentials Deletes all rows from the credential collection
+func (s *Store) TruncateCredentials(ctx context.Context) error {
+	return s.Exec(ctx, credentialTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchCredentials returns (filtered) set of Credentials
+//
+// This function is auto-generated
+func (s *Store) SearchCredentials(ctx context.Context, f systemType.CredentialFilter) (set systemType.CredentialSet, _ systemType.CredentialFilter, err error) {
+
+	set, _, err = s.QueryCredentials(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryCredentials queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryCredentials(
+	ctx context.Context,
+	f systemType.CredentialFilter,
+) (_ []*systemType.Credential, more bool, err error) {
+	var (
+		set         = make([]*systemType.Credential, 0, DefaultSliceCapacity)
+		res         *systemType.Credential
+		aux         *auxCredential
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.Credential != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.Credential(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = CredentialFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for Credential: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := credentialSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query Credential: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query Credential: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query Credential: %w", err)
+			return
+		}
+
+		aux = new(auxCredential)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for Credential: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode Credential: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupCredentialByID searches for credentials by ID
+//
+// It returns credentials even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemType.Credential, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxCredential)
+		lookup = credentialSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableCredentialFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableCredentialFields() map[string]string {
+	return map[string]string{
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"expires_at":   "expires_at",
+		"expiresat":    "expires_at",
+		"id":           "id",
+		"last_used_at": "last_used_at",
+		"lastusedat":   "last_used_at",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
+	}
+}
+
+// collectCredentialCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				case "lastUsedAt":
+					return res.LastUsedAt
+				case "expiresAt":
+					return res.ExpiresAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkCredentialConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkCredentialConstraints(ctx context.Context, res *systemType.Credential) (err error) {
+	return nil
+}
+
+// CreateDalConnection creates one or more rows in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDalConnection updates one or more existing entries in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalConnectionUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDalConnection updates one or more existing entries in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, dalConnectionUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, dalConnectionUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteDalConnection Deletes one or more entries from dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect.GOQU(), dalConnectionPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDalConnectionByID deletes single entry from dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalConnectionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDalConnections Deletes all rows from the dalConnection collection
+func (s *Store) TruncateDalConnections(ctx context.Context) error {
+	return s.Exec(ctx, dalConnectionTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchDalConnections returns (filtered) set of DalConnections
+//
+// This function is auto-generated
+func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnectionFilter) (set systemType.DalConnectionSet, _ systemType.DalConnectionFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfDalConnections fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalConnections(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.DalConnectionSet
+			if navSet, _, _, err = s.fetchFullPageOfDalConnections(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfDalConnections collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfDalConnections(
+	ctx context.Context,
+	filter systemType.DalConnectionFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.DalConnection, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.DalConnection
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.DalConnectionFilter
+	)
+
+	set = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryDalConnections(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectDalConnectionCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectDalConnectionCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectDalConnectionCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryDalConnections queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDalConnections(
+	ctx context.Context,
+	f systemType.DalConnectionFilter,
+) (_ []*systemType.DalConnection, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
+		res         *systemType.DalConnection
+		aux         *auxDalConnection
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.DalConnection != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DalConnection(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DalConnectionFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DalConnection: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalConnectionFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := dalConnectionSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableDalConnectionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for DalConnection: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DalConnection: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DalConnection: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DalConnection: %w", err)
+			return
+		}
+
+		aux = new(auxDalConnection)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DalConnection: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DalConnection: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupDalConnectionByID searches for connection by ID
+//
+// It returns connection even if deleted or suspended
+//
+// This function is auto-generated
+func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *systemType.DalConnection, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalConnection)
+		lookup = dalConnectionSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupDalConnectionByHandle searches for connection by handle
+//
+// It returns only valid connection (not deleted)
+//
+// This function is auto-generated
+func (s *Store) LookupDalConnectionByHandle(ctx context.Context, handle string) (_ *systemType.DalConnection, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalConnection)
+		lookup = dalConnectionSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDalConnectionFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDalConnectionFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"type":       "type",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectDalConnectionCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "type":
+					return res.Type
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDalConnectionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDalConnectionConstraints(ctx context.Context, res *systemType.DalConnection) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupDalConnectionByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
+	return nil
+}
+
+// CreateDalSchemaAlteration creates one or more rows in dalSchemaAlteration collection
+//
+// This function is auto-generated
+func (s *Store) CreateDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+	for i := range rr {
+		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSchemaAlterationInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDalSchemaAlteration updates one or more existing entries in dalSchemaAlteration collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+	for i := range rr {
+		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSchemaAlterationUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDalSchemaAlteration updates one or more existing entries in dalSchemaAlteration collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+	for i := range rr {
+		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, dalSchemaAlterationUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, dalSchemaAlterationInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, dalSchemaAlterationUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteDalSchemaAlteration Deletes one or more entries from dalSchemaAlteration collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dalSchemaAlterationDeleteQuery(s.Dialect.GOQU(), dalSchemaAlterationPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDalSchemaAlterationByID deletes single entry from dalSchemaAlteration collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSchemaAlterationByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dalSchemaAlterationDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDalSchemaAlterations Deletes all rows from the dalSchemaAlteration collection
+func (s *Store) TruncateDalSchemaAlterations(ctx context.Context) error {
+	return s.Exec(ctx, dalSchemaAlterationTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchDalSchemaAlterations returns (filtered) set of DalSchemaAlterations
+//
+// This function is auto-generated
+func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.DalSchemaAlterationFilter) (set systemType.DalSchemaAlterationSet, _ systemType.DalSchemaAlterationFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfDalSchemaAlterations fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalSchemaAlterations(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.DalSchemaAlterationSet
+			if navSet, _, _, err = s.fetchFullPageOfDalSchemaAlterations(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfDalSchemaAlterations collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfDalSchemaAlterations(
+	ctx context.Context,
+	filter systemType.DalSchemaAlterationFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.DalSchemaAlteration, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.DalSchemaAlteration
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.DalSchemaAlterationFilter
+	)
+
+	set = make([]*systemType.DalSchemaAlteration, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryDalSchemaAlterations(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectDalSchemaAlterationCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectDalSchemaAlterationCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectDalSchemaAlterationCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryDalSchemaAlterations queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDalSchemaAlterations(
+	ctx context.Context,
+	f systemType.DalSchemaAlterationFilter,
+) (_ []*systemType.DalSchemaAlteration, more bool, err error) {
+	var (
+		set         = make([]*systemType.DalSchemaAlteration, 0, DefaultSliceCapacity)
+		res         *systemType.DalSchemaAlteration
+		aux         *auxDalSchemaAlteration
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.DalSchemaAlteration != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DalSchemaAlteration(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DalSchemaAlterationFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DalSchemaAlteration: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalSchemaAlterationFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := dalSchemaAlterationSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableDalSchemaAlterationFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for DalSchemaAlteration: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+			return
+		}
+
+		aux = new(auxDalSchemaAlteration)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DalSchemaAlteration: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DalSchemaAlteration: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupDalSchemaAlterationByID searches for resource translation by ID
+// It also returns deleted resource translations.
+//
+// This function is auto-generated
+func (s *Store) LookupDalSchemaAlterationByID(ctx context.Context, id uint64) (_ *systemType.DalSchemaAlteration, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalSchemaAlteration)
+		lookup = dalSchemaAlterationSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDalSchemaAlterationFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDalSchemaAlterationFields() map[string]string {
+	return map[string]string{
+		"completed_at": "completed_at",
+		"completedat":  "completed_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"dismissed_at": "dismissed_at",
+		"dismissedat":  "dismissed_at",
+		"id":           "id",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
+	}
+}
+
+// collectDalSchemaAlterationCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDalSchemaAlterationCursorValues(res *systemType.DalSchemaAlteration, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				case "completedAt":
+					return res.CompletedAt
+				case "dismissedAt":
+					return res.DismissedAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDalSchemaAlterationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDalSchemaAlterationConstraints(ctx context.Context, res *systemType.DalSchemaAlteration) (err error) {
+	return nil
+}
+
+// CreateDalSensitivityLevel creates one or more rows in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSensitivityLevelUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, dalSensitivityLevelUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, dalSensitivityLevelUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteDalSensitivityLevel Deletes one or more entries from dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect.GOQU(), dalSensitivityLevelPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDalSensitivityLevelByID deletes single entry from dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSensitivityLevelByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDalSensitivityLevels Deletes all rows from the dalSensitivityLevel collection
+func (s *Store) TruncateDalSensitivityLevels(ctx context.Context) error {
+	return s.Exec(ctx, dalSensitivityLevelTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchDalSensitivityLevels returns (filtered) set of DalSensitivityLevels
+//
+// This function is auto-generated
+func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.DalSensitivityLevelFilter) (set systemType.DalSensitivityLevelSet, _ systemType.DalSensitivityLevelFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfDalSensitivityLevels fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalSensitivityLevels(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.DalSensitivityLevelSet
+			if navSet, _, _, err = s.fetchFullPageOfDalSensitivityLevels(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfDalSensitivityLevels collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfDalSensitivityLevels(
+	ctx context.Context,
+	filter systemType.DalSensitivityLevelFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.DalSensitivityLevel, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.DalSensitivityLevel
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.DalSensitivityLevelFilter
+	)
+
+	set = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryDalSensitivityLevels(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectDalSensitivityLevelCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectDalSensitivityLevelCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectDalSensitivityLevelCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryDalSensitivityLevels queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDalSensitivityLevels(
+	ctx context.Context,
+	f systemType.DalSensitivityLevelFilter,
+) (_ []*systemType.DalSensitivityLevel, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
+		res         *systemType.DalSensitivityLevel
+		aux         *auxDalSensitivityLevel
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.DalSensitivityLevel != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DalSensitivityLevel(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DalSensitivityLevelFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DalSensitivityLevel: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalSensitivityLevelFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := dalSensitivityLevelSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableDalSensitivityLevelFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for DalSensitivityLevel: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+			return
+		}
+
+		aux = new(auxDalSensitivityLevel)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DalSensitivityLevel: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DalSensitivityLevel: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupDalSensitivityLevelByID searches for user by ID
+//
+// It returns user even if deleted or suspended
+//
+// This function is auto-generated
+func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_ *systemType.DalSensitivityLevel, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalSensitivityLevel)
+		lookup = dalSensitivityLevelSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDalSensitivityLevelFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDalSensitivityLevelFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"level":      "level",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectDalSensitivityLevelCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensitivityLevel, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "level":
+					return res.Level
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDalSensitivityLevelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDalSensitivityLevelConstraints(ctx context.Context, res *systemType.DalSensitivityLevel) (err error) {
+	return nil
+}
+
+// CreateDataPrivacyRequest creates one or more rows in dataPrivacyRequest collection
+//
+// This function is auto-generated
+func (s *Store) CreateDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dataPrivacyRequestInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDataPrivacyRequest updates one or more existing entries in dataPrivacyRequest collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dataPrivacyRequestUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDataPrivacyRequest updates one or more existing entries in dataPrivacyRequest collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, dataPrivacyRequestUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, dataPrivacyRequestInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, dataPrivacyRequestUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteDataPrivacyRequest Deletes one or more entries from dataPrivacyRequest collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dataPrivacyRequestDeleteQuery(s.Dialect.GOQU(), dataPrivacyRequestPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDataPrivacyRequestByID deletes single entry from dataPrivacyRequest collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDataPrivacyRequestByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dataPrivacyRequestDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDataPrivacyRequests Deletes all rows from the dataPrivacyRequest collection
+func (s *Store) TruncateDataPrivacyRequests(ctx context.Context) error {
+	return s.Exec(ctx, dataPrivacyRequestTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchDataPrivacyRequests returns (filtered) set of DataPrivacyRequests
+//
+// This function is auto-generated
+func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.DataPrivacyRequestFilter) (set systemType.DataPrivacyRequestSet, _ systemType.DataPrivacyRequestFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfDataPrivacyRequests fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDataPrivacyRequests(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.DataPrivacyRequestSet
+			if navSet, _, _, err = s.fetchFullPageOfDataPrivacyRequests(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfDataPrivacyRequests collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfDataPrivacyRequests(
+	ctx context.Context,
+	filter systemType.DataPrivacyRequestFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.DataPrivacyRequest, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.DataPrivacyRequest
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.DataPrivacyRequestFilter
+	)
+
+	set = make([]*systemType.DataPrivacyRequest, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryDataPrivacyRequests(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectDataPrivacyRequestCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectDataPrivacyRequestCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectDataPrivacyRequestCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryDataPrivacyRequests queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDataPrivacyRequests(
+	ctx context.Context,
+	f systemType.DataPrivacyRequestFilter,
+) (_ []*systemType.DataPrivacyRequest, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.DataPrivacyRequest, 0, DefaultSliceCapacity)
+		res         *systemType.DataPrivacyRequest
+		aux         *auxDataPrivacyRequest
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.DataPrivacyRequest != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DataPrivacyRequest(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DataPrivacyRequestFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DataPrivacyRequest: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDataPrivacyRequestFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := dataPrivacyRequestSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableDataPrivacyRequestFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for DataPrivacyRequest: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+			return
+		}
+
+		aux = new(auxDataPrivacyRequest)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DataPrivacyRequest: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DataPrivacyRequest: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupDataPrivacyRequestByID searches for data privacy request by ID
+//
+// It returns data privacy request even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupDataPrivacyRequestByID(ctx context.Context, id uint64) (_ *systemType.DataPrivacyRequest, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDataPrivacyRequest)
+		lookup = dataPrivacyRequestSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDataPrivacyRequestFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDataPrivacyRequestFields() map[string]string {
+	return map[string]string{
+		"completed_at": "completed_at",
+		"completedat":  "completed_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"id":           "id",
+		"kind":         "kind",
+		"requested_at": "requested_at",
+		"requestedat":  "requested_at",
+		"status":       "status",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
+	}
+}
+
+// collectDataPrivacyRequestCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDataPrivacyRequestCursorValues(res *systemType.DataPrivacyRequest, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "kind":
+					return res.Kind
+				case "status":
+					return res.Status
+				case "requestedAt":
+					return res.RequestedAt
+				case "completedAt":
+					return res.CompletedAt
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDataPrivacyRequestConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDataPrivacyRequestConstraints(ctx context.Context, res *systemType.DataPrivacyRequest) (err error) {
+	return nil
+}
+
+// CreateDataPrivacyRequestComment creates one or more rows in dataPrivacyRequestComment collection
+//
+// This function is auto-generated
+func (s *Store) CreateDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dataPrivacyRequestCommentInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDataPrivacyRequestComment updates one or more existing entries in dataPrivacyRequestComment collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dataPrivacyRequestCommentUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDataPrivacyRequestComment updates one or more existing entries in dataPrivacyRequestComment collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+	for i := range rr {
+		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, dataPrivacyRequestCommentUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, dataPrivacyRequestCommentInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, dataPrivacyRequestCommentUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteDataPrivacyRequestComment Deletes one or more entries from dataPrivacyRequestComment collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dataPrivacyRequestCommentDeleteQuery(s.Dialect.GOQU(), dataPrivacyRequestCommentPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDataPrivacyRequestCommentByID deletes single entry from dataPrivacyRequestComment collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDataPrivacyRequestCommentByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dataPrivacyRequestCommentDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDataPrivacyRequestComments Deletes all rows from the dataPrivacyRequestComment collection
+func (s *Store) TruncateDataPrivacyRequestComments(ctx context.Context) error {
+	return s.Exec(ctx, dataPrivacyRequestCommentTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchDataPrivacyRequestComments returns (filtered) set of DataPrivacyRequestComments
+//
+// This function is auto-generated
+func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemType.DataPrivacyRequestCommentFilter) (set systemType.DataPrivacyRequestCommentSet, _ systemType.DataPrivacyRequestCommentFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfDataPrivacyRequestComments fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDataPrivacyRequestComments(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.DataPrivacyRequestCommentSet
+			if navSet, _, _, err = s.fetchFullPageOfDataPrivacyRequestComments(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfDataPrivacyRequestComments collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
+	ctx context.Context,
+	filter systemType.DataPrivacyRequestCommentFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.DataPrivacyRequestComment, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.DataPrivacyRequestComment
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.DataPrivacyRequestCommentFilter
+	)
+
+	set = make([]*systemType.DataPrivacyRequestComment, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryDataPrivacyRequestComments(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectDataPrivacyRequestCommentCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Credential: %w", err)
-			return
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
 		}
 
-		set = append(set, res)
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
 	}
 
-	return set, false, err
+	if hasPrev {
+		prev = s.collectDataPrivacyRequestCommentCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectDataPrivacyRequestCommentCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
 
+	return set, prev, next, nil
 }
 
-// LookupCredentialByID searches for credentials by ID
+// QueryDataPrivacyRequestComments queries the database, converts and checks each row and returns collected set
 //
-// It returns credentials even if deleted
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemType.Credential, err error) {
+func (s *Store) QueryDataPrivacyRequestComments(
+	ctx context.Context,
+	f systemType.DataPrivacyRequestCommentFilter,
+) (_ []*systemType.DataPrivacyRequestComment, more bool, err error) {
 	var (
-		rows   *sql.Rows
-		aux    = new(auxCredential)
-		lookup = credentialSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
+		ok bool
+
+		set         = make([]*systemType.DataPrivacyRequestComment, 0, DefaultSliceCapacity)
+		res         *systemType.DataPrivacyRequestComment
+		aux         *auxDataPrivacyRequestComment
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	rows, err = s.Query(ctx, lookup)
+	if s.Filters.DataPrivacyRequestComment != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DataPrivacyRequestComment(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DataPrivacyRequestCommentFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DataPrivacyRequestComment: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDataPrivacyRequestCommentFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := dataPrivacyRequestCommentSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableDataPrivacyRequestCommentFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for DataPrivacyRequestComment: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
 	if err != nil {
+		err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
 		return
 	}
 
@@ -10589,44 +13906,60 @@ func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemT
 		}
 	}()
 
-	if err = rows.Err(); err != nil {
-		return
-	}
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
+			return
+		}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
+		aux = new(auxDataPrivacyRequestComment)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DataPrivacyRequestComment: %w", err)
+			return
+		}
 
-	if err = aux.scan(rows); err != nil {
-		return
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DataPrivacyRequestComment: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
 	}
 
-	return aux.decode()
+	return set, f.Limit > 0 && count >= f.Limit, err
+
 }
 
-// sortableCredentialFields returns all <no value> columns flagged as sortable
+// sortableDataPrivacyRequestCommentFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableCredentialFields() map[string]string {
+func (Store) sortableDataPrivacyRequestCommentFields() map[string]string {
 	return map[string]string{
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"expires_at":   "expires_at",
-		"expiresat":    "expires_at",
-		"id":           "id",
-		"last_used_at": "last_used_at",
-		"lastusedat":   "last_used_at",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectCredentialCursorValues collects values from the given resource that and sets them to the cursor
+// collectDataPrivacyRequestCommentCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -10638,7 +13971,7 @@ func (Store) sortableCredentialFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectDataPrivacyRequestCommentCursorValues(res *systemType.DataPrivacyRequestComment, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -10658,10 +13991,6 @@ func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...
 					return res.UpdatedAt
 				case "deletedAt":
 					return res.DeletedAt
-				case "lastUsedAt":
-					return res.LastUsedAt
-				case "expiresAt":
-					return res.ExpiresAt
 				}
 				return nil
 			}
@@ -10694,27 +14023,27 @@ func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...
 
 }
 
-// checkCredentialConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkDataPrivacyRequestCommentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkCredentialConstraints(ctx context.Context, res *systemType.Credential) (err error) {
+func (s *Store) checkDataPrivacyRequestCommentConstraints(ctx context.Context, res *systemType.DataPrivacyRequestComment) (err error) {
 	return nil
 }
 
-// CreateDalConnection creates one or more rows in dalConnection collection
+// CreateFederationExposedModule creates one or more rows in federationExposedModule collection
 //
 // This function is auto-generated
-func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -10722,16 +14051,16 @@ func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// UpdateDalConnection updates one or more existing entries in dalConnection collection
+// UpdateFederationExposedModule updates one or more existing entries in federationExposedModule collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalConnectionUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationExposedModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -10739,12 +14068,12 @@ func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// UpsertDalConnection updates one or more existing entries in dalConnection collection
+// UpsertFederationExposedModule updates one or more existing entries in federationExposedModule collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -10752,7 +14081,7 @@ func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalCo
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, dalConnectionUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, federationExposedModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -10762,12 +14091,12 @@ func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalCo
 				continue
 			}
 
-			err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, dalConnectionUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationExposedModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -10777,12 +14106,12 @@ func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// DeleteDalConnection Deletes one or more entries from dalConnection collection
+// DeleteFederationExposedModule Deletes one or more entries from federationExposedModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect.GOQU(), dalConnectionPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect.GOQU(), federationExposedModulePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -10790,24 +14119,24 @@ func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return nil
 }
 
-// DeleteDalConnectionByID deletes single entry from dalConnection collection
+// DeleteFederationExposedModuleByID deletes single entry from federationExposedModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalConnectionByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteFederationExposedModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateDalConnections Deletes all rows from the dalConnection collection
-func (s *Store) TruncateDalConnections(ctx context.Context) error {
-	return s.Exec(ctx, dalConnectionTruncateQuery(s.Dialect.GOQU()))
+// TruncateFederationExposedModules Deletes all rows from the federationExposedModule collection
+func (s *Store) TruncateFederationExposedModules(ctx context.Context) error {
+	return s.Exec(ctx, federationExposedModuleTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchDalConnections returns (filtered) set of DalConnections
+// SearchFederationExposedModules returns (filtered) set of FederationExposedModules
 //
 // This function is auto-generated
-func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnectionFilter) (set systemType.DalConnectionSet, _ systemType.DalConnectionFilter, err error) {
+func (s *Store) SearchFederationExposedModules(ctx context.Context, f federationType.ExposedModuleFilter) (set federationType.ExposedModuleSet, _ federationType.ExposedModuleFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -10835,7 +14164,7 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfDalConnections fn used for cursor creation;
+	// Original are passed to the etchFullPageOfFederationExposedModules fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -10845,7 +14174,7 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalConnections(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -10861,8 +14190,8 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.DalConnectionSet
-			if navSet, _, _, err = s.fetchFullPageOfDalConnections(ctx, f, sort); err != nil {
+			var navSet federationType.ExposedModuleSet
+			if navSet, _, _, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -10874,7 +14203,7 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 	return set, f, nil
 }
 
-// fetchFullPageOfDalConnections collects all requested results.
+// fetchFullPageOfFederationExposedModules collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -10886,13 +14215,13 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfDalConnections(
+func (s *Store) fetchFullPageOfFederationExposedModules(
 	ctx context.Context,
-	filter systemType.DalConnectionFilter,
+	filter federationType.ExposedModuleFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.DalConnection, prev, next *filter.PagingCursor, err error) {
+) (set []*federationType.ExposedModule, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.DalConnection
+		aux []*federationType.ExposedModule
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -10911,10 +14240,10 @@ func (s *Store) fetchFullPageOfDalConnections(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.DalConnectionFilter
+		tryFilter federationType.ExposedModuleFilter
 	)
 
-	set = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
+	set = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -10927,7 +14256,7 @@ func (s *Store) fetchFullPageOfDalConnections(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryDalConnections(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryFederationExposedModules(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -10957,7 +14286,7 @@ func (s *Store) fetchFullPageOfDalConnections(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectDalConnectionCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -10988,35 +14317,35 @@ func (s *Store) fetchFullPageOfDalConnections(
 	}
 
 	if hasPrev {
-		prev = s.collectDalConnectionCursorValues(set[0], filter.Sort...)
+		prev = s.collectFederationExposedModuleCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectDalConnectionCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryDalConnections queries the database, converts and checks each row and returns collected set
+// QueryFederationExposedModules queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDalConnections(
+func (s *Store) QueryFederationExposedModules(
 	ctx context.Context,
-	f systemType.DalConnectionFilter,
-) (_ []*systemType.DalConnection, more bool, err error) {
+	f federationType.ExposedModuleFilter,
+) (_ []*federationType.ExposedModule, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
-		res         *systemType.DalConnection
-		aux         *auxDalConnection
+		set         = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
+		res         *federationType.ExposedModule
+		aux         *auxFederationExposedModule
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -11024,16 +14353,16 @@ func (s *Store) QueryDalConnections(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DalConnection != nil {
+	if s.Filters.FederationExposedModule != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DalConnection(s, f)
+		tExpr, f, err = s.Filters.FederationExposedModule(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DalConnectionFilter(s.Dialect, f)
+		tExpr, f, err = FederationExposedModuleFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DalConnection: %w", err)
+		err = fmt.Errorf("could generate filter expression for FederationExposedModule: %w", err)
 		return
 	}
 
@@ -11041,18 +14370,18 @@ func (s *Store) QueryDalConnections(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalConnectionFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationExposedModuleFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := dalConnectionSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := federationExposedModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableDalConnectionFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for DalConnection: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableFederationExposedModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationExposedModule: %w", err)
 		return
 	}
 
@@ -11066,12 +14395,12 @@ func (s *Store) QueryDalConnections(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DalConnection: %w", err)
+		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DalConnection: %w", err)
+		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
 		return
 	}
 
@@ -11085,19 +14414,19 @@ func (s *Store) QueryDalConnections(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DalConnection: %w", err)
+			err = fmt.Errorf("could not query FederationExposedModule: %w", err)
 			return
 		}
 
-		aux = new(auxDalConnection)
+		aux = new(auxFederationExposedModule)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DalConnection: %w", err)
+			err = fmt.Errorf("could not scan rows for FederationExposedModule: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DalConnection: %w", err)
+			err = fmt.Errorf("could not decode FederationExposedModule: %w", err)
 			return
 		}
 
@@ -11118,16 +14447,16 @@ func (s *Store) QueryDalConnections(
 
 }
 
-// LookupDalConnectionByID searches for connection by ID
+// LookupFederationExposedModuleByID searches for federation module by ID
 //
-// It returns connection even if deleted or suspended
+// It returns federation module
 //
 // This function is auto-generated
-func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *systemType.DalConnection, err error) {
+func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64) (_ *federationType.ExposedModule, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalConnection)
-		lookup = dalConnectionSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxFederationExposedModule)
+		lookup = federationExposedModuleSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -11160,56 +14489,13 @@ func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *syst
 	return aux.decode()
 }
 
-// LookupDalConnectionByHandle searches for connection by handle
-//
-// It returns only valid connection (not deleted)
-//
-// This function is auto-generated
-func (s *Store) LookupDalConnectionByHandle(ctx context.Context, handle string) (_ *systemType.DalConnection, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxDalConnection)
-		lookup = dalConnectionSelectQuery(s.Dialect.GOQU()).Where(
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableDalConnectionFields returns all <no value> columns flagged as sortable
+// sortableFederationExposedModuleFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDalConnectionFields() map[string]string {
+func (Store) sortableFederationExposedModuleFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -11217,13 +14503,15 @@ func (Store) sortableDalConnectionFields() map[string]string {
 		"deletedat":  "deleted_at",
 		"handle":     "handle",
 		"id":         "id",
-		"type":       "type",
+		"name":       "name",
+		"node_id":    "node_id",
+		"nodeid":     "node_id",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectDalConnectionCursorValues collects values from the given resource that and sets them to the cursor
+// collectFederationExposedModuleCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -11235,7 +14523,7 @@ func (Store) sortableDalConnectionFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.ExposedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -11252,8 +14540,10 @@ func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection,
 				case "handle":
 					hasUnique = true
 					return res.Handle
-				case "type":
-					return res.Type
+				case "name":
+					return res.Name
+				case "nodeID":
+					return res.NodeID
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -11292,54 +14582,27 @@ func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection,
 
 }
 
-// checkDalConnectionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFederationExposedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDalConnectionConstraints(ctx context.Context, res *systemType.DalConnection) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupDalConnectionByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkFederationExposedModuleConstraints(ctx context.Context, res *federationType.ExposedModule) (err error) {
 	return nil
 }
 
-// CreateDalSchemaAlteration creates one or more rows in dalSchemaAlteration collection
+// CreateFederationModuleMapping creates one or more rows in federationModuleMapping collection
 //
 // This function is auto-generated
-func (s *Store) CreateDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+func (s *Store) CreateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
 	for i := range rr {
-		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSchemaAlterationInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -11347,16 +14610,16 @@ func (s *Store) CreateDalSchemaAlteration(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpdateDalSchemaAlteration updates one or more existing entries in dalSchemaAlteration collection
+// UpdateFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
 	for i := range rr {
-		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSchemaAlterationUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationModuleMappingUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -11364,12 +14627,12 @@ func (s *Store) UpdateDalSchemaAlteration(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpsertDalSchemaAlteration updates one or more existing entries in dalSchemaAlteration collection
+// UpsertFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
 	for i := range rr {
-		if err = s.checkDalSchemaAlterationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -11377,7 +14640,7 @@ func (s *Store) UpsertDalSchemaAlteration(ctx context.Context, rr ...*systemType
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, dalSchemaAlterationUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, federationModuleMappingUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -11387,12 +14650,12 @@ func (s *Store) UpsertDalSchemaAlteration(ctx context.Context, rr ...*systemType
 				continue
 			}
 
-			err = s.Exec(ctx, dalSchemaAlterationInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, dalSchemaAlterationUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationModuleMappingUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -11402,12 +14665,12 @@ func (s *Store) UpsertDalSchemaAlteration(ctx context.Context, rr ...*systemType
 	return
 }
 
-// DeleteDalSchemaAlteration Deletes one or more entries from dalSchemaAlteration collection
+// DeleteFederationModuleMapping Deletes one or more entries from federationModuleMapping collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalSchemaAlteration(ctx context.Context, rr ...*systemType.DalSchemaAlteration) (err error) {
+func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dalSchemaAlterationDeleteQuery(s.Dialect.GOQU(), dalSchemaAlterationPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect.GOQU(), federationModuleMappingPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -11415,24 +14678,15 @@ func (s *Store) DeleteDalSchemaAlteration(ctx context.Context, rr ...*systemType
 	return nil
 }
 
-// DeleteDalSchemaAlterationByID deletes single entry from dalSchemaAlteration collection
-//
-// This function is auto-generated
-func (s *Store) DeleteDalSchemaAlterationByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dalSchemaAlterationDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
-	}))
-}
-
-// TruncateDalSchemaAlterations Deletes all rows from the dalSchemaAlteration collection
-func (s *Store) TruncateDalSchemaAlterations(ctx context.Context) error {
-	return s.Exec(ctx, dalSchemaAlterationTruncateQuery(s.Dialect.GOQU()))
+// TruncateFederationModuleMappings Deletes all rows from the federationModuleMapping collection
+func (s *Store) TruncateFederationModuleMappings(ctx context.Context) error {
+	return s.Exec(ctx, federationModuleMappingTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchDalSchemaAlterations returns (filtered) set of DalSchemaAlterations
+// SearchFederationModuleMappings returns (filtered) set of FederationModuleMappings
 //
 // This function is auto-generated
-func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.DalSchemaAlterationFilter) (set systemType.DalSchemaAlterationSet, _ systemType.DalSchemaAlterationFilter, err error) {
+func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federationType.ModuleMappingFilter) (set federationType.ModuleMappingSet, _ federationType.ModuleMappingFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -11460,7 +14714,7 @@ func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.Dal
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfDalSchemaAlterations fn used for cursor creation;
+	// Original are passed to the etchFullPageOfFederationModuleMappings fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -11470,7 +14724,7 @@ func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.Dal
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalSchemaAlterations(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -11486,8 +14740,8 @@ func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.Dal
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.DalSchemaAlterationSet
-			if navSet, _, _, err = s.fetchFullPageOfDalSchemaAlterations(ctx, f, sort); err != nil {
+			var navSet federationType.ModuleMappingSet
+			if navSet, _, _, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -11499,7 +14753,7 @@ func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.Dal
 	return set, f, nil
 }
 
-// fetchFullPageOfDalSchemaAlterations collects all requested results.
+// fetchFullPageOfFederationModuleMappings collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -11511,13 +14765,13 @@ func (s *Store) SearchDalSchemaAlterations(ctx context.Context, f systemType.Dal
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfDalSchemaAlterations(
+func (s *Store) fetchFullPageOfFederationModuleMappings(
 	ctx context.Context,
-	filter systemType.DalSchemaAlterationFilter,
+	filter federationType.ModuleMappingFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.DalSchemaAlteration, prev, next *filter.PagingCursor, err error) {
+) (set []*federationType.ModuleMapping, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.DalSchemaAlteration
+		aux []*federationType.ModuleMapping
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -11536,10 +14790,10 @@ func (s *Store) fetchFullPageOfDalSchemaAlterations(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.DalSchemaAlterationFilter
+		tryFilter federationType.ModuleMappingFilter
 	)
 
-	set = make([]*systemType.DalSchemaAlteration, 0, DefaultSliceCapacity)
+	set = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -11552,7 +14806,7 @@ func (s *Store) fetchFullPageOfDalSchemaAlterations(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryDalSchemaAlterations(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryFederationModuleMappings(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -11582,7 +14836,7 @@ func (s *Store) fetchFullPageOfDalSchemaAlterations(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectDalSchemaAlterationCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -11613,33 +14867,35 @@ func (s *Store) fetchFullPageOfDalSchemaAlterations(
 	}
 
 	if hasPrev {
-		prev = s.collectDalSchemaAlterationCursorValues(set[0], filter.Sort...)
+		prev = s.collectFederationModuleMappingCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectDalSchemaAlterationCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryDalSchemaAlterations queries the database, converts and checks each row and returns collected set
+// QueryFederationModuleMappings queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDalSchemaAlterations(
+func (s *Store) QueryFederationModuleMappings(
 	ctx context.Context,
-	f systemType.DalSchemaAlterationFilter,
-) (_ []*systemType.DalSchemaAlteration, more bool, err error) {
+	f federationType.ModuleMappingFilter,
+) (_ []*federationType.ModuleMapping, more bool, err error) {
 	var (
-		set         = make([]*systemType.DalSchemaAlteration, 0, DefaultSliceCapacity)
-		res         *systemType.DalSchemaAlteration
-		aux         *auxDalSchemaAlteration
+		ok bool
+
+		set         = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
+		res         *federationType.ModuleMapping
+		aux         *auxFederationModuleMapping
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -11647,16 +14903,16 @@ func (s *Store) QueryDalSchemaAlterations(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DalSchemaAlteration != nil {
+	if s.Filters.FederationModuleMapping != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DalSchemaAlteration(s, f)
+		tExpr, f, err = s.Filters.FederationModuleMapping(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DalSchemaAlterationFilter(s.Dialect, f)
+		tExpr, f, err = FederationModuleMappingFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DalSchemaAlteration: %w", err)
+		err = fmt.Errorf("could generate filter expression for FederationModuleMapping: %w", err)
 		return
 	}
 
@@ -11664,18 +14920,18 @@ func (s *Store) QueryDalSchemaAlterations(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalSchemaAlterationFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationModuleMappingFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := dalSchemaAlterationSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableDalSchemaAlterationFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for DalSchemaAlteration: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableFederationModuleMappingFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationModuleMapping: %w", err)
 		return
 	}
 
@@ -11689,12 +14945,12 @@ func (s *Store) QueryDalSchemaAlterations(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
 		return
 	}
 
@@ -11708,22 +14964,32 @@ func (s *Store) QueryDalSchemaAlterations(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DalSchemaAlteration: %w", err)
+			err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
 			return
 		}
 
-		aux = new(auxDalSchemaAlteration)
+		aux = new(auxFederationModuleMapping)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DalSchemaAlteration: %w", err)
+			err = fmt.Errorf("could not scan rows for FederationModuleMapping: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DalSchemaAlteration: %w", err)
+			err = fmt.Errorf("could not decode FederationModuleMapping: %w", err)
 			return
 		}
 
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
 		set = append(set, res)
 	}
 
@@ -11731,16 +14997,19 @@ func (s *Store) QueryDalSchemaAlterations(
 
 }
 
-// LookupDalSchemaAlterationByID searches for resource translation by ID
-// It also returns deleted resource translations.
+// LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID searches for module mapping by federation module id and compose module id
+//
+// It returns module mapping
 //
 // This function is auto-generated
-func (s *Store) LookupDalSchemaAlterationByID(ctx context.Context, id uint64) (_ *systemType.DalSchemaAlteration, err error) {
+func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID(ctx context.Context, federationModuleID uint64, composeModuleID uint64, composeNamespaceID uint64) (_ *federationType.ModuleMapping, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalSchemaAlteration)
-		lookup = dalSchemaAlterationSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxFederationModuleMapping)
+		lookup = federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_federation_module").Eq(federationModuleID),
+			goqu.I("rel_compose_module").Eq(composeModuleID),
+			goqu.I("rel_compose_namespace").Eq(composeNamespaceID),
 		).Limit(1)
 	)
 
@@ -11772,29 +15041,68 @@ func (s *Store) LookupDalSchemaAlterationByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableDalSchemaAlterationFields returns all <no value> columns flagged as sortable
+// LookupFederationModuleMappingByFederationModuleID searches for module mapping by federation module id
+//
+// It returns module mapping
+//
+// This function is auto-generated
+func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Context, federationModuleID uint64) (_ *federationType.ModuleMapping, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationModuleMapping)
+		lookup = federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("rel_federation_module").Eq(federationModuleID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationModuleMappingFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDalSchemaAlterationFields() map[string]string {
+func (Store) sortableFederationModuleMappingFields() map[string]string {
 	return map[string]string{
-		"completed_at": "completed_at",
-		"completedat":  "completed_at",
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"dismissed_at": "dismissed_at",
-		"dismissedat":  "dismissed_at",
-		"id":           "id",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"compose_module_id":    "compose_module_id",
+		"compose_namespace_id": "compose_namespace_id",
+		"composemoduleid":      "compose_module_id",
+		"composenamespaceid":   "compose_namespace_id",
+		"federation_module_id": "federation_module_id",
+		"federationmoduleid":   "federation_module_id",
+		"node_id":              "node_id",
+		"nodeid":               "node_id",
 	}
 }
 
-// collectDalSchemaAlterationCursorValues collects values from the given resource that and sets them to the cursor
+// collectFederationModuleMappingCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -11806,30 +15114,24 @@ func (Store) sortableDalSchemaAlterationFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDalSchemaAlterationCursorValues(res *systemType.DalSchemaAlteration, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFederationModuleMappingCursorValues(res *federationType.ModuleMapping, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
-
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "createdAt":
-					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
-				case "completedAt":
-					return res.CompletedAt
-				case "dismissedAt":
-					return res.DismissedAt
+				case "nodeID":
+					hasUnique = true
+					return res.NodeID
+				case "federationModuleID":
+					return res.FederationModuleID
+				case "composeModuleID":
+					return res.ComposeModuleID
+				case "composeNamespaceID":
+					return res.ComposeNamespaceID
 				}
 				return nil
 			}
@@ -11854,35 +15156,32 @@ func (s *Store) collectDalSchemaAlterationCursorValues(res *systemType.DalSchema
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
-	}
 
 	return cur
 
 }
 
-// checkDalSchemaAlterationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFederationModuleMappingConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDalSchemaAlterationConstraints(ctx context.Context, res *systemType.DalSchemaAlteration) (err error) {
+func (s *Store) checkFederationModuleMappingConstraints(ctx context.Context, res *federationType.ModuleMapping) (err error) {
 	return nil
 }
 
-// CreateDalSensitivityLevel creates one or more rows in dalSensitivityLevel collection
+// CreateFederationNode creates one or more rows in federationNode collection
 //
 // This function is auto-generated
-func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -11890,16 +15189,16 @@ func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpdateDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+// UpdateFederationNode updates one or more existing entries in federationNode collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSensitivityLevelUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationNodeUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -11907,12 +15206,12 @@ func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpsertDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+// UpsertFederationNode updates one or more existing entries in federationNode collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -11920,7 +15219,7 @@ func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, dalSensitivityLevelUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, federationNodeUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -11930,12 +15229,12 @@ func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType
 				continue
 			}
 
-			err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, dalSensitivityLevelUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationNodeUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -11945,12 +15244,12 @@ func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// DeleteDalSensitivityLevel Deletes one or more entries from dalSensitivityLevel collection
+// DeleteFederationNode Deletes one or more entries from federationNode collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect.GOQU(), dalSensitivityLevelPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, federationNodeDeleteQuery(s.Dialect.GOQU(), federationNodePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -11958,24 +15257,24 @@ func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return nil
 }
 
-// DeleteDalSensitivityLevelByID deletes single entry from dalSensitivityLevel collection
+// DeleteFederationNodeByID deletes single entry from federationNode collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalSensitivityLevelByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteFederationNodeByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationNodeDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateDalSensitivityLevels Deletes all rows from the dalSensitivityLevel collection
-func (s *Store) TruncateDalSensitivityLevels(ctx context.Context) error {
-	return s.Exec(ctx, dalSensitivityLevelTruncateQuery(s.Dialect.GOQU()))
+// TruncateFederationNodes Deletes all rows from the federationNode collection
+func (s *Store) TruncateFederationNodes(ctx context.Context) error {
+	return s.Exec(ctx, federationNodeTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchDalSensitivityLevels returns (filtered) set of DalSensitivityLevels
+// SearchFederationNodes returns (filtered) set of FederationNodes
 //
 // This function is auto-generated
-func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.DalSensitivityLevelFilter) (set systemType.DalSensitivityLevelSet, _ systemType.DalSensitivityLevelFilter, err error) {
+func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.NodeFilter) (set federationType.NodeSet, _ federationType.NodeFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -12003,7 +15302,7 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfDalSensitivityLevels fn used for cursor creation;
+	// Original are passed to the etchFullPageOfFederationNodes fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -12013,7 +15312,7 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDalSensitivityLevels(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodes(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -12029,8 +15328,8 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.DalSensitivityLevelSet
-			if navSet, _, _, err = s.fetchFullPageOfDalSensitivityLevels(ctx, f, sort); err != nil {
+			var navSet federationType.NodeSet
+			if navSet, _, _, err = s.fetchFullPageOfFederationNodes(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -12042,7 +15341,7 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 	return set, f, nil
 }
 
-// fetchFullPageOfDalSensitivityLevels collects all requested results.
+// fetchFullPageOfFederationNodes collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -12054,13 +15353,13 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfDalSensitivityLevels(
+func (s *Store) fetchFullPageOfFederationNodes(
 	ctx context.Context,
-	filter systemType.DalSensitivityLevelFilter,
+	filter federationType.NodeFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.DalSensitivityLevel, prev, next *filter.PagingCursor, err error) {
+) (set []*federationType.Node, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.DalSensitivityLevel
+		aux []*federationType.Node
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -12079,10 +15378,10 @@ func (s *Store) fetchFullPageOfDalSensitivityLevels(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.DalSensitivityLevelFilter
+		tryFilter federationType.NodeFilter
 	)
 
-	set = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
+	set = make([]*federationType.Node, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -12095,7 +15394,7 @@ func (s *Store) fetchFullPageOfDalSensitivityLevels(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryDalSensitivityLevels(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryFederationNodes(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -12125,7 +15424,7 @@ func (s *Store) fetchFullPageOfDalSensitivityLevels(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectDalSensitivityLevelCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectFederationNodeCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -12156,35 +15455,35 @@ func (s *Store) fetchFullPageOfDalSensitivityLevels(
 	}
 
 	if hasPrev {
-		prev = s.collectDalSensitivityLevelCursorValues(set[0], filter.Sort...)
+		prev = s.collectFederationNodeCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectDalSensitivityLevelCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectFederationNodeCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryDalSensitivityLevels queries the database, converts and checks each row and returns collected set
+// QueryFederationNodes queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDalSensitivityLevels(
+func (s *Store) QueryFederationNodes(
 	ctx context.Context,
-	f systemType.DalSensitivityLevelFilter,
-) (_ []*systemType.DalSensitivityLevel, more bool, err error) {
+	f federationType.NodeFilter,
+) (_ []*federationType.Node, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
-		res         *systemType.DalSensitivityLevel
-		aux         *auxDalSensitivityLevel
+		set         = make([]*federationType.Node, 0, DefaultSliceCapacity)
+		res         *federationType.Node
+		aux         *auxFederationNode
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -12192,16 +15491,16 @@ func (s *Store) QueryDalSensitivityLevels(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DalSensitivityLevel != nil {
+	if s.Filters.FederationNode != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DalSensitivityLevel(s, f)
+		tExpr, f, err = s.Filters.FederationNode(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DalSensitivityLevelFilter(s.Dialect, f)
+		tExpr, f, err = FederationNodeFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could generate filter expression for FederationNode: %w", err)
 		return
 	}
 
@@ -12209,18 +15508,18 @@ func (s *Store) QueryDalSensitivityLevels(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDalSensitivityLevelFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationNodeFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := dalSensitivityLevelSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := federationNodeSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableDalSensitivityLevelFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for DalSensitivityLevel: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableFederationNodeFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationNode: %w", err)
 		return
 	}
 
@@ -12234,12 +15533,12 @@ func (s *Store) QueryDalSensitivityLevels(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could not query FederationNode: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could not query FederationNode: %w", err)
 		return
 	}
 
@@ -12253,19 +15552,19 @@ func (s *Store) QueryDalSensitivityLevels(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not query FederationNode: %w", err)
 			return
 		}
 
-		aux = new(auxDalSensitivityLevel)
+		aux = new(auxFederationNode)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not scan rows for FederationNode: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not decode FederationNode: %w", err)
 			return
 		}
 
@@ -12286,16 +15585,16 @@ func (s *Store) QueryDalSensitivityLevels(
 
 }
 
-// LookupDalSensitivityLevelByID searches for user by ID
+// LookupFederationNodeByID searches for federation node by ID
 //
-// It returns user even if deleted or suspended
+// It returns federation node
 //
 // This function is auto-generated
-func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_ *systemType.DalSensitivityLevel, err error) {
+func (s *Store) LookupFederationNodeByID(ctx context.Context, id uint64) (_ *federationType.Node, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalSensitivityLevel)
-		lookup = dalSensitivityLevelSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -12328,27 +15627,113 @@ func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableDalSensitivityLevelFields returns all <no value> columns flagged as sortable
+// LookupFederationNodeByBaseURLSharedNodeID searches for node by shared-node-id and base-url
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeByBaseURLSharedNodeID(ctx context.Context, baseURL string, sharedNodeID uint64) (_ *federationType.Node, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("base_url").Eq(baseURL),
+			goqu.I("shared_node_id").Eq(sharedNodeID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupFederationNodeBySharedNodeID searches for node by shared-node-id
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeBySharedNodeID(ctx context.Context, sharedNodeID uint64) (_ *federationType.Node, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("shared_node_id").Eq(sharedNodeID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationNodeFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDalSensitivityLevelFields() map[string]string {
+func (Store) sortableFederationNodeFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"level":      "level",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"base_url":       "base_url",
+		"baseurl":        "base_url",
+		"contact":        "contact",
+		"created_at":     "created_at",
+		"createdat":      "created_at",
+		"deleted_at":     "deleted_at",
+		"deletedat":      "deleted_at",
+		"id":             "id",
+		"name":           "name",
+		"shared_node_id": "shared_node_id",
+		"sharednodeid":   "shared_node_id",
+		"status":         "status",
+		"updated_at":     "updated_at",
+		"updatedat":      "updated_at",
 	}
 }
 
-// collectDalSensitivityLevelCursorValues collects values from the given resource that and sets them to the cursor
+// collectFederationNodeCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -12360,7 +15745,7 @@ func (Store) sortableDalSensitivityLevelFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensitivityLevel, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -12374,11 +15759,16 @@ func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensit
 				case "id":
 					pkID = true
 					return res.ID
-				case "handle":
-					hasUnique = true
-					return res.Handle
-				case "level":
-					return res.Level
+				case "sharedNodeID":
+					return res.SharedNodeID
+				case "name":
+					return res.Name
+				case "baseURL":
+					return res.BaseURL
+				case "status":
+					return res.Status
+				case "contact":
+					return res.Contact
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -12417,27 +15807,27 @@ func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensit
 
 }
 
-// checkDalSensitivityLevelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFederationNodeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDalSensitivityLevelConstraints(ctx context.Context, res *systemType.DalSensitivityLevel) (err error) {
+func (s *Store) checkFederationNodeConstraints(ctx context.Context, res *federationType.Node) (err error) {
 	return nil
 }
 
-// CreateDataPrivacyRequest creates one or more rows in dataPrivacyRequest collection
+// CreateFederationNodeSync creates one or more rows in federationNodeSync collection
 //
 // This function is auto-generated
-func (s *Store) CreateDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dataPrivacyRequestInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -12445,16 +15835,16 @@ func (s *Store) CreateDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 	return
 }
 
-// UpdateDataPrivacyRequest updates one or more existing entries in dataPrivacyRequest collection
+// UpdateFederationNodeSync updates one or more existing entries in federationNodeSync collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dataPrivacyRequestUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationNodeSyncUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -12462,12 +15852,12 @@ func (s *Store) UpdateDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 	return
 }
 
-// UpsertDataPrivacyRequest updates one or more existing entries in dataPrivacyRequest collection
+// UpsertFederationNodeSync updates one or more existing entries in federationNodeSync collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -12475,7 +15865,7 @@ func (s *Store) UpsertDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, dataPrivacyRequestUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, federationNodeSyncUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -12485,12 +15875,12 @@ func (s *Store) UpsertDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 				continue
 			}
 
-			err = s.Exec(ctx, dataPrivacyRequestInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, dataPrivacyRequestUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationNodeSyncUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -12500,12 +15890,12 @@ func (s *Store) UpsertDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 	return
 }
 
-// DeleteDataPrivacyRequest Deletes one or more entries from dataPrivacyRequest collection
+// DeleteFederationNodeSync Deletes one or more entries from federationNodeSync collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDataPrivacyRequest(ctx context.Context, rr ...*systemType.DataPrivacyRequest) (err error) {
+func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dataPrivacyRequestDeleteQuery(s.Dialect.GOQU(), dataPrivacyRequestPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect.GOQU(), federationNodeSyncPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -12513,24 +15903,24 @@ func (s *Store) DeleteDataPrivacyRequest(ctx context.Context, rr ...*systemType.
 	return nil
 }
 
-// DeleteDataPrivacyRequestByID deletes single entry from dataPrivacyRequest collection
+// DeleteFederationNodeSyncByNodeID deletes single entry from federationNodeSync collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDataPrivacyRequestByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dataPrivacyRequestDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
+func (s *Store) DeleteFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) error {
+	return s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"node_id": nodeID,
 	}))
 }
 
-// TruncateDataPrivacyRequests Deletes all rows from the dataPrivacyRequest collection
-func (s *Store) TruncateDataPrivacyRequests(ctx context.Context) error {
-	return s.Exec(ctx, dataPrivacyRequestTruncateQuery(s.Dialect.GOQU()))
+// TruncateFederationNodeSyncs Deletes all rows from the federationNodeSync collection
+func (s *Store) TruncateFederationNodeSyncs(ctx context.Context) error {
+	return s.Exec(ctx, federationNodeSyncTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchDataPrivacyRequests returns (filtered) set of DataPrivacyRequests
+// SearchFederationNodeSyncs returns (filtered) set of FederationNodeSyncs
 //
 // This function is auto-generated
-func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.DataPrivacyRequestFilter) (set systemType.DataPrivacyRequestSet, _ systemType.DataPrivacyRequestFilter, err error) {
+func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.NodeSyncFilter) (set federationType.NodeSyncSet, _ federationType.NodeSyncFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -12558,7 +15948,7 @@ func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.Data
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfDataPrivacyRequests fn used for cursor creation;
+	// Original are passed to the etchFullPageOfFederationNodeSyncs fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -12568,7 +15958,7 @@ func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.Data
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDataPrivacyRequests(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -12584,8 +15974,8 @@ func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.Data
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.DataPrivacyRequestSet
-			if navSet, _, _, err = s.fetchFullPageOfDataPrivacyRequests(ctx, f, sort); err != nil {
+			var navSet federationType.NodeSyncSet
+			if navSet, _, _, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -12597,7 +15987,7 @@ func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.Data
 	return set, f, nil
 }
 
-// fetchFullPageOfDataPrivacyRequests collects all requested results.
+// fetchFullPageOfFederationNodeSyncs collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -12609,13 +15999,13 @@ func (s *Store) SearchDataPrivacyRequests(ctx context.Context, f systemType.Data
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfDataPrivacyRequests(
+func (s *Store) fetchFullPageOfFederationNodeSyncs(
 	ctx context.Context,
-	filter systemType.DataPrivacyRequestFilter,
+	filter federationType.NodeSyncFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.DataPrivacyRequest, prev, next *filter.PagingCursor, err error) {
+) (set []*federationType.NodeSync, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.DataPrivacyRequest
+		aux []*federationType.NodeSync
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -12634,10 +16024,10 @@ func (s *Store) fetchFullPageOfDataPrivacyRequests(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.DataPrivacyRequestFilter
+		tryFilter federationType.NodeSyncFilter
 	)
 
-	set = make([]*systemType.DataPrivacyRequest, 0, DefaultSliceCapacity)
+	set = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -12650,7 +16040,7 @@ func (s *Store) fetchFullPageOfDataPrivacyRequests(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryDataPrivacyRequests(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryFederationNodeSyncs(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -12680,7 +16070,7 @@ func (s *Store) fetchFullPageOfDataPrivacyRequests(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectDataPrivacyRequestCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -12711,35 +16101,35 @@ func (s *Store) fetchFullPageOfDataPrivacyRequests(
 	}
 
 	if hasPrev {
-		prev = s.collectDataPrivacyRequestCursorValues(set[0], filter.Sort...)
+		prev = s.collectFederationNodeSyncCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectDataPrivacyRequestCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryDataPrivacyRequests queries the database, converts and checks each row and returns collected set
+// QueryFederationNodeSyncs queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDataPrivacyRequests(
+func (s *Store) QueryFederationNodeSyncs(
 	ctx context.Context,
-	f systemType.DataPrivacyRequestFilter,
-) (_ []*systemType.DataPrivacyRequest, more bool, err error) {
+	f federationType.NodeSyncFilter,
+) (_ []*federationType.NodeSync, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.DataPrivacyRequest, 0, DefaultSliceCapacity)
-		res         *systemType.DataPrivacyRequest
-		aux         *auxDataPrivacyRequest
+		set         = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
+		res         *federationType.NodeSync
+		aux         *auxFederationNodeSync
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -12747,16 +16137,16 @@ func (s *Store) QueryDataPrivacyRequests(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DataPrivacyRequest != nil {
+	if s.Filters.FederationNodeSync != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DataPrivacyRequest(s, f)
+		tExpr, f, err = s.Filters.FederationNodeSync(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DataPrivacyRequestFilter(s.Dialect, f)
+		tExpr, f, err = FederationNodeSyncFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DataPrivacyRequest: %w", err)
+		err = fmt.Errorf("could generate filter expression for FederationNodeSync: %w", err)
 		return
 	}
 
@@ -12764,18 +16154,18 @@ func (s *Store) QueryDataPrivacyRequests(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDataPrivacyRequestFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationNodeSyncFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := dataPrivacyRequestSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableDataPrivacyRequestFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for DataPrivacyRequest: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableFederationNodeSyncFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationNodeSync: %w", err)
 		return
 	}
 
@@ -12789,12 +16179,12 @@ func (s *Store) QueryDataPrivacyRequests(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
 		return
 	}
 
@@ -12808,19 +16198,19 @@ func (s *Store) QueryDataPrivacyRequests(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DataPrivacyRequest: %w", err)
+			err = fmt.Errorf("could not query FederationNodeSync: %w", err)
 			return
 		}
 
-		aux = new(auxDataPrivacyRequest)
+		aux = new(auxFederationNodeSync)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DataPrivacyRequest: %w", err)
+			err = fmt.Errorf("could not scan rows for FederationNodeSync: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DataPrivacyRequest: %w", err)
+			err = fmt.Errorf("could not decode FederationNodeSync: %w", err)
 			return
 		}
 
@@ -12841,17 +16231,17 @@ func (s *Store) QueryDataPrivacyRequests(
 
 }
 
-// LookupDataPrivacyRequestByID searches for data privacy request by ID
+// LookupFederationNodeSyncByNodeID searches for sync activity by node ID
 //
-// It returns data privacy request even if deleted
+// It returns sync activity
 //
 // This function is auto-generated
-func (s *Store) LookupDataPrivacyRequestByID(ctx context.Context, id uint64) (_ *systemType.DataPrivacyRequest, err error) {
+func (s *Store) LookupFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) (_ *federationType.NodeSync, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDataPrivacyRequest)
-		lookup = dataPrivacyRequestSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxFederationNodeSync)
+		lookup = federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("node_id").Eq(nodeID),
 		).Limit(1)
 	)
 
@@ -12883,31 +16273,73 @@ func (s *Store) LookupDataPrivacyRequestByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableDataPrivacyRequestFields returns all <no value> columns flagged as sortable
+// LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus searches for activity by node, type and status
+//
+// It returns sync activity
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx context.Context, nodeID uint64, moduleID uint64, syncType string, syncStatus string) (_ *federationType.NodeSync, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNodeSync)
+		lookup = federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("node_id").Eq(nodeID),
+			goqu.I("module_id").Eq(moduleID),
+			goqu.I("sync_type").Eq(syncType),
+			goqu.I("sync_status").Eq(syncStatus),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationNodeSyncFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDataPrivacyRequestFields() map[string]string {
+func (Store) sortableFederationNodeSyncFields() map[string]string {
 	return map[string]string{
-		"completed_at": "completed_at",
-		"completedat":  "completed_at",
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"id":           "id",
-		"kind":         "kind",
-		"requested_at": "requested_at",
-		"requestedat":  "requested_at",
-		"status":       "status",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"module_id":      "module_id",
+		"moduleid":       "module_id",
+		"node_id":        "node_id",
+		"nodeid":         "node_id",
+		"sync_status":    "sync_status",
+		"sync_type":      "sync_type",
+		"syncstatus":     "sync_status",
+		"synctype":       "sync_type",
+		"time_of_action": "time_of_action",
+		"timeofaction":   "time_of_action",
 	}
 }
 
-// collectDataPrivacyRequestCursorValues collects values from the given resource that and sets them to the cursor
+// collectFederationNodeSyncCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -12919,34 +16351,28 @@ func (Store) sortableDataPrivacyRequestFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDataPrivacyRequestCursorValues(res *systemType.DataPrivacyRequest, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFederationNodeSyncCursorValues(res *federationType.NodeSync, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkNodeID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "kind":
-					return res.Kind
-				case "status":
-					return res.Status
-				case "requestedAt":
-					return res.RequestedAt
-				case "completedAt":
-					return res.CompletedAt
-				case "createdAt":
-					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
+				case "nodeID":
+					pkNodeID = true
+					return res.NodeID
+				case "moduleID":
+					return res.ModuleID
+				case "syncType":
+					return res.SyncType
+				case "syncStatus":
+					return res.SyncStatus
+				case "timeOfAction":
+					return res.TimeOfAction
 				}
 				return nil
 			}
@@ -12971,35 +16397,35 @@ func (s *Store) collectDataPrivacyRequestCursorValues(res *systemType.DataPrivac
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkNodeID {
+		collect(&filter.SortExpr{Column: "nodeID", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkDataPrivacyRequestConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFederationNodeSyncConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDataPrivacyRequestConstraints(ctx context.Context, res *systemType.DataPrivacyRequest) (err error) {
+func (s *Store) checkFederationNodeSyncConstraints(ctx context.Context, res *federationType.NodeSync) (err error) {
 	return nil
 }
 
-// CreateDataPrivacyRequestComment creates one or more rows in dataPrivacyRequestComment collection
+// CreateFederationSharedModule creates one or more rows in federationSharedModule collection
 //
 // This function is auto-generated
-func (s *Store) CreateDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dataPrivacyRequestCommentInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -13007,16 +16433,16 @@ func (s *Store) CreateDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 	return
 }
 
-// UpdateDataPrivacyRequestComment updates one or more existing entries in dataPrivacyRequestComment collection
+// UpdateFederationSharedModule updates one or more existing entries in federationSharedModule collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dataPrivacyRequestCommentUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, federationSharedModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -13024,12 +16450,12 @@ func (s *Store) UpdateDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 	return
 }
 
-// UpsertDataPrivacyRequestComment updates one or more existing entries in dataPrivacyRequestComment collection
+// UpsertFederationSharedModule updates one or more existing entries in federationSharedModule collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
 	for i := range rr {
-		if err = s.checkDataPrivacyRequestCommentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -13037,7 +16463,7 @@ func (s *Store) UpsertDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, dataPrivacyRequestCommentUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, federationSharedModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -13047,12 +16473,12 @@ func (s *Store) UpsertDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 				continue
 			}
 
-			err = s.Exec(ctx, dataPrivacyRequestCommentInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, dataPrivacyRequestCommentUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, federationSharedModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -13062,12 +16488,12 @@ func (s *Store) UpsertDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 	return
 }
 
-// DeleteDataPrivacyRequestComment Deletes one or more entries from dataPrivacyRequestComment collection
+// DeleteFederationSharedModule Deletes one or more entries from federationSharedModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDataPrivacyRequestComment(ctx context.Context, rr ...*systemType.DataPrivacyRequestComment) (err error) {
+func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dataPrivacyRequestCommentDeleteQuery(s.Dialect.GOQU(), dataPrivacyRequestCommentPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect.GOQU(), federationSharedModulePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -13075,24 +16501,24 @@ func (s *Store) DeleteDataPrivacyRequestComment(ctx context.Context, rr ...*syst
 	return nil
 }
 
-// DeleteDataPrivacyRequestCommentByID deletes single entry from dataPrivacyRequestComment collection
+// DeleteFederationSharedModuleByID deletes single entry from federationSharedModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDataPrivacyRequestCommentByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dataPrivacyRequestCommentDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteFederationSharedModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateDataPrivacyRequestComments Deletes all rows from the dataPrivacyRequestComment collection
-func (s *Store) TruncateDataPrivacyRequestComments(ctx context.Context) error {
-	return s.Exec(ctx, dataPrivacyRequestCommentTruncateQuery(s.Dialect.GOQU()))
+// TruncateFederationSharedModules Deletes all rows from the federationSharedModule collection
+func (s *Store) TruncateFederationSharedModules(ctx context.Context) error {
+	return s.Exec(ctx, federationSharedModuleTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchDataPrivacyRequestComments returns (filtered) set of DataPrivacyRequestComments
+// SearchFederationSharedModules returns (filtered) set of FederationSharedModules
 //
 // This function is auto-generated
-func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemType.DataPrivacyRequestCommentFilter) (set systemType.DataPrivacyRequestCommentSet, _ systemType.DataPrivacyRequestCommentFilter, err error) {
+func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationType.SharedModuleFilter) (set federationType.SharedModuleSet, _ federationType.SharedModuleFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -13120,7 +16546,7 @@ func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemTy
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfDataPrivacyRequestComments fn used for cursor creation;
+	// Original are passed to the etchFullPageOfFederationSharedModules fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -13130,7 +16556,7 @@ func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemTy
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfDataPrivacyRequestComments(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -13146,8 +16572,8 @@ func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemTy
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.DataPrivacyRequestCommentSet
-			if navSet, _, _, err = s.fetchFullPageOfDataPrivacyRequestComments(ctx, f, sort); err != nil {
+			var navSet federationType.SharedModuleSet
+			if navSet, _, _, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -13159,7 +16585,7 @@ func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemTy
 	return set, f, nil
 }
 
-// fetchFullPageOfDataPrivacyRequestComments collects all requested results.
+// fetchFullPageOfFederationSharedModules collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -13171,13 +16597,13 @@ func (s *Store) SearchDataPrivacyRequestComments(ctx context.Context, f systemTy
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
+func (s *Store) fetchFullPageOfFederationSharedModules(
 	ctx context.Context,
-	filter systemType.DataPrivacyRequestCommentFilter,
+	filter federationType.SharedModuleFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.DataPrivacyRequestComment, prev, next *filter.PagingCursor, err error) {
+) (set []*federationType.SharedModule, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.DataPrivacyRequestComment
+		aux []*federationType.SharedModule
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -13196,10 +16622,10 @@ func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.DataPrivacyRequestCommentFilter
+		tryFilter federationType.SharedModuleFilter
 	)
 
-	set = make([]*systemType.DataPrivacyRequestComment, 0, DefaultSliceCapacity)
+	set = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -13212,7 +16638,7 @@ func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryDataPrivacyRequestComments(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryFederationSharedModules(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -13242,7 +16668,7 @@ func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectDataPrivacyRequestCommentCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -13273,35 +16699,35 @@ func (s *Store) fetchFullPageOfDataPrivacyRequestComments(
 	}
 
 	if hasPrev {
-		prev = s.collectDataPrivacyRequestCommentCursorValues(set[0], filter.Sort...)
+		prev = s.collectFederationSharedModuleCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectDataPrivacyRequestCommentCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryDataPrivacyRequestComments queries the database, converts and checks each row and returns collected set
+// QueryFederationSharedModules queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDataPrivacyRequestComments(
+func (s *Store) QueryFederationSharedModules(
 	ctx context.Context,
-	f systemType.DataPrivacyRequestCommentFilter,
-) (_ []*systemType.DataPrivacyRequestComment, more bool, err error) {
+	f federationType.SharedModuleFilter,
+) (_ []*federationType.SharedModule, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.DataPrivacyRequestComment, 0, DefaultSliceCapacity)
-		res         *systemType.DataPrivacyRequestComment
-		aux         *auxDataPrivacyRequestComment
+		set         = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
+		res         *federationType.SharedModule
+		aux         *auxFederationSharedModule
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -13309,16 +16735,16 @@ func (s *Store) QueryDataPrivacyRequestComments(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DataPrivacyRequestComment != nil {
+	if s.Filters.FederationSharedModule != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DataPrivacyRequestComment(s, f)
+		tExpr, f, err = s.Filters.FederationSharedModule(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DataPrivacyRequestCommentFilter(s.Dialect, f)
+		tExpr, f, err = FederationSharedModuleFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DataPrivacyRequestComment: %w", err)
+		err = fmt.Errorf("could generate filter expression for FederationSharedModule: %w", err)
 		return
 	}
 
@@ -13326,18 +16752,18 @@ func (s *Store) QueryDataPrivacyRequestComments(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableDataPrivacyRequestCommentFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationSharedModuleFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := dataPrivacyRequestCommentSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := federationSharedModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableDataPrivacyRequestCommentFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for DataPrivacyRequestComment: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableFederationSharedModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationSharedModule: %w", err)
 		return
 	}
 
@@ -13351,12 +16777,12 @@ func (s *Store) QueryDataPrivacyRequestComments(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
+		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
+		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
 		return
 	}
 
@@ -13370,19 +16796,19 @@ func (s *Store) QueryDataPrivacyRequestComments(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DataPrivacyRequestComment: %w", err)
+			err = fmt.Errorf("could not query FederationSharedModule: %w", err)
 			return
 		}
 
-		aux = new(auxDataPrivacyRequestComment)
+		aux = new(auxFederationSharedModule)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DataPrivacyRequestComment: %w", err)
+			err = fmt.Errorf("could not scan rows for FederationSharedModule: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DataPrivacyRequestComment: %w", err)
+			err = fmt.Errorf("could not decode FederationSharedModule: %w", err)
 			return
 		}
 
@@ -13403,25 +16829,73 @@ func (s *Store) QueryDataPrivacyRequestComments(
 
 }
 
-// sortableDataPrivacyRequestCommentFields returns all <no value> columns flagged as sortable
+// LookupFederationSharedModuleByID searches for shared federation module by ID
+//
+// It returns shared federation module
+//
+// This function is auto-generated
+func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64) (_ *federationType.SharedModule, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationSharedModule)
+		lookup = federationSharedModuleSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationSharedModuleFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDataPrivacyRequestCommentFields() map[string]string {
+func (Store) sortableFederationSharedModuleFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"created_at":                    "created_at",
+		"createdat":                     "created_at",
+		"deleted_at":                    "deleted_at",
+		"deletedat":                     "deleted_at",
+		"external_federation_module_id": "external_federation_module_id",
+		"externalfederationmoduleid":    "external_federation_module_id",
+		"handle":                        "handle",
+		"id":                            "id",
+		"name":                          "name",
+		"node_id":                       "node_id",
+		"nodeid":                        "node_id",
+		"updated_at":                    "updated_at",
+		"updatedat":                     "updated_at",
 	}
 }
 
-// collectDataPrivacyRequestCommentCursorValues collects values from the given resource that and sets them to the cursor
+// collectFederationSharedModuleCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -13433,7 +16907,7 @@ func (Store) sortableDataPrivacyRequestCommentFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDataPrivacyRequestCommentCursorValues(res *systemType.DataPrivacyRequestComment, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.SharedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -13447,6 +16921,15 @@ func (s *Store) collectDataPrivacyRequestCommentCursorValues(res *systemType.Dat
 				case "id":
 					pkID = true
 					return res.ID
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "nodeID":
+					return res.NodeID
+				case "name":
+					return res.Name
+				case "externalFederationModuleID":
+					return res.ExternalFederationModuleID
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -13485,27 +16968,27 @@ func (s *Store) collectDataPrivacyRequestCommentCursorValues(res *systemType.Dat
 
 }
 
-// checkDataPrivacyRequestCommentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFederationSharedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDataPrivacyRequestCommentConstraints(ctx context.Context, res *systemType.DataPrivacyRequestComment) (err error) {
+func (s *Store) checkFederationSharedModuleConstraints(ctx context.Context, res *federationType.SharedModule) (err error) {
 	return nil
 }
 
-// CreateFederationExposedModule creates one or more rows in federationExposedModule collection
+// CreateFlag creates one or more rows in flag collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, flagInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -13513,16 +16996,16 @@ func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpdateFederationExposedModule updates one or more existing entries in federationExposedModule collection
+// UpdateFlag updates one or more existing entries in flag collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationExposedModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, flagUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -13530,12 +17013,12 @@ func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpsertFederationExposedModule updates one or more existing entries in federationExposedModule collection
+// UpsertFlag updates one or more existing entries in flag collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -13543,7 +17026,7 @@ func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federa
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, federationExposedModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, flagUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -13553,12 +17036,12 @@ func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federa
 				continue
 			}
 
-			err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, flagInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, federationExposedModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, flagUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -13568,12 +17051,12 @@ func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// DeleteFederationExposedModule Deletes one or more entries from federationExposedModule collection
+// DeleteFlag Deletes one or more entries from flag collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) DeleteFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect.GOQU(), federationExposedModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, flagDeleteQuery(s.Dialect.GOQU(), flagPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -13581,275 +17064,419 @@ func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federa
 	return nil
 }
 
-// DeleteFederationExposedModuleByID deletes single entry from federationExposedModule collection
+// DeleteFlagByKindResourceIDOwnedByName deletes single entry from flag collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationExposedModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
+func (s *Store) DeleteFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) error {
+	return s.Exec(ctx, flagDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"kind":         kind,
+		"rel_resource": resourceID,
+		"owned_by":     ownedBy,
+		"name":         name,
 	}))
 }
 
-// TruncateFederationExposedModules Deletes all rows from the federationExposedModule collection
-func (s *Store) TruncateFederationExposedModules(ctx context.Context) error {
-	return s.Exec(ctx, federationExposedModuleTruncateQuery(s.Dialect.GOQU()))
+// TruncateFlags Deletes all rows from the flag collection
+func (s *Store) TruncateFlags(ctx context.Context) error {
+	return s.Exec(ctx, flagTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchFlags returns (filtered) set of Flags
+//
+// This function is auto-generated
+func (s *Store) SearchFlags(ctx context.Context, f flagType.FlagFilter) (set flagType.FlagSet, _ flagType.FlagFilter, err error) {
+
+	set, _, err = s.QueryFlags(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryFlags queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFlags(
+	ctx context.Context,
+	f flagType.FlagFilter,
+) (_ []*flagType.Flag, more bool, err error) {
+	var (
+		set         = make([]*flagType.Flag, 0, DefaultSliceCapacity)
+		res         *flagType.Flag
+		aux         *auxFlag
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.Flag != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.Flag(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FlagFilter(s.Dialect, f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for Flag: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := flagSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query Flag: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query Flag: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query Flag: %w", err)
+			return
+		}
+
+		aux = new(auxFlag)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for Flag: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode Flag: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
 }
 
-// SearchFederationExposedModules returns (filtered) set of FederationExposedModules
+// LookupFlagByKindResourceIDOwnedByName searches for flag by kind, resource ID, owner and name
 //
 // This function is auto-generated
-func (s *Store) SearchFederationExposedModules(ctx context.Context, f federationType.ExposedModuleFilter) (set federationType.ExposedModuleSet, _ federationType.ExposedModuleFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
+func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) (_ *flagType.Flag, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFlag)
+		lookup = flagSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("kind").Eq(kind),
+			goqu.I("rel_resource").Eq(resourceID),
+			goqu.I("owned_by").Eq(ownedBy),
+			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
+		).Limit(1)
+	)
 
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
 
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
-	}
+	}()
 
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
+	if err = rows.Err(); err != nil {
+		return
 	}
 
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationExposedModules fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort)
-
-	f.PageCursor = nil
-	if err != nil {
-		return nil, f, err
+	if err = aux.scan(rows); err != nil {
+		return
 	}
 
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
+	return aux.decode()
+}
 
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet federationType.ExposedModuleSet
-			if navSet, _, _, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
+// sortableFlagFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFlagFields() map[string]string {
+	return map[string]string{
+		"kind":        "kind",
+		"name":        "name",
+		"owned_by":    "owned_by",
+		"ownedby":     "owned_by",
+		"resource_id": "resource_id",
+		"resourceid":  "resource_id",
 	}
-
-	return set, f, nil
 }
 
-// fetchFullPageOfFederationExposedModules collects all requested results.
+// collectFlagCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
 //
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
 //
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// # Known issues:
 //
-// # Function then moves cursor to the last item fetched
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationExposedModules(
-	ctx context.Context,
-	filter federationType.ExposedModuleFilter,
-	sort filter.SortExprSet,
-) (set []*federationType.ExposedModule, prev, next *filter.PagingCursor, err error) {
+func (s *Store) collectFlagCursorValues(res *flagType.Flag, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
-		aux []*federationType.ExposedModule
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
-		reqItems = filter.Limit
+		hasUnique bool
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+		pkKind       bool
+		pkResourceID bool
+		pkOwnedBy    bool
+		pkName       bool
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "kind":
+					pkKind = true
+					return res.Kind
+				case "resourceID":
+					pkResourceID = true
+					return res.ResourceID
+				case "ownedBy":
+					pkOwnedBy = true
+					return res.OwnedBy
+				case "name":
+					pkName = true
+					return res.Name
+				}
+				return nil
+			}
 
-		tryFilter federationType.ExposedModuleFilter
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
 	)
 
-	set = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	_ = hasUnique
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
+	collect(cc...)
+	if !hasUnique || !pkKind {
+		collect(&filter.SortExpr{Column: "kind", Descending: false})
+	}
+	if !hasUnique || !pkResourceID {
+		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
+	}
+	if !hasUnique || !pkOwnedBy {
+		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
+	}
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
+	}
 
-		if aux, hasNext, err = s.QueryFederationExposedModules(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
+	return cur
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
+}
 
-		// append fetched items
-		set = append(set, aux...)
+// checkFlagConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFlagConstraints(ctx context.Context, res *flagType.Flag) (err error) {
+	return nil
+}
 
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+// CreateLabel creates one or more rows in label collection
+//
+// This function is auto-generated
+func (s *Store) CreateLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+	for i := range rr {
+		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
+		if err = s.Exec(ctx, labelInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
 
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+	return
+}
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
+// UpdateLabel updates one or more existing entries in label collection
+//
+// This function is auto-generated
+func (s *Store) UpdateLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+	for i := range rr {
+		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
+		if err = s.Exec(ctx, labelUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
 		}
+	}
 
-		if reqItems < collected {
-			set = set[:reqItems]
+	return
+}
+
+// UpsertLabel updates one or more existing entries in label collection
+//
+// This function is auto-generated
+func (s *Store) UpsertLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+	for i := range rr {
+		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		break
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, labelUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, labelInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, labelUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
 	}
 
-	collected := len(set)
+	return
+}
 
-	if collected == 0 {
-		return nil, nil, nil, nil
+// DeleteLabel Deletes one or more entries from label collection
+//
+// This function is auto-generated
+func (s *Store) DeleteLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, labelDeleteQuery(s.Dialect.GOQU(), labelPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
 	}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
+	return nil
+}
 
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
+// DeleteLabelByKindResourceIDName deletes single entry from label collection
+//
+// This function is auto-generated
+func (s *Store) DeleteLabelByKindResourceIDName(ctx context.Context, kind string, resourceID uint64, name string) error {
+	return s.Exec(ctx, labelDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"kind":         kind,
+		"rel_resource": resourceID,
+		"name":         name,
+	}))
+}
 
-	if hasPrev {
-		prev = s.collectFederationExposedModuleCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
+// TruncateLabels Deletes all rows from the label collection
+func (s *Store) TruncateLabels(ctx context.Context) error {
+	return s.Exec(ctx, labelTruncateQuery(s.Dialect.GOQU()))
+}
 
-	if hasNext {
-		next = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
+// SearchLabels returns (filtered) set of Labels
+//
+// This function is auto-generated
+func (s *Store) SearchLabels(ctx context.Context, f labelsType.LabelFilter) (set labelsType.LabelSet, _ labelsType.LabelFilter, err error) {
+
+	set, _, err = s.QueryLabels(ctx, f)
+	if err != nil {
+		return nil, f, err
 	}
 
-	return set, prev, next, nil
+	return set, f, nil
 }
 
-// QueryFederationExposedModules queries the database, converts and checks each row and returns collected set
+// QueryLabels queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationExposedModules(
+func (s *Store) QueryLabels(
 	ctx context.Context,
-	f federationType.ExposedModuleFilter,
-) (_ []*federationType.ExposedModule, more bool, err error) {
+	f labelsType.LabelFilter,
+) (_ []*labelsType.Label, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
-		res         *federationType.ExposedModule
-		aux         *auxFederationExposedModule
+		set         = make([]*labelsType.Label, 0, DefaultSliceCapacity)
+		res         *labelsType.Label
+		aux         *auxLabel
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationExposedModule != nil {
+	if s.Filters.Label != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationExposedModule(s, f)
+		tExpr, f, err = s.Filters.Label(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationExposedModuleFilter(s.Dialect, f)
+		tExpr, f, err = LabelFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationExposedModule: %w", err)
+		err = fmt.Errorf("could generate filter expression for Label: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationExposedModuleFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := federationExposedModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationExposedModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationExposedModule: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := labelSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -13857,12 +17484,12 @@ func (s *Store) QueryFederationExposedModules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		err = fmt.Errorf("could not query Label: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		err = fmt.Errorf("could not query Label: %w", err)
 		return
 	}
 
@@ -13876,50 +17503,40 @@ func (s *Store) QueryFederationExposedModules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not query Label: %w", err)
 			return
 		}
 
-		aux = new(auxFederationExposedModule)
+		aux = new(auxLabel)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not scan rows for Label: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not decode Label: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupFederationExposedModuleByID searches for federation module by ID
-//
-// It returns federation module
+// LookupLabelByKindResourceIDName searches for label by kind, resource ID and name
 //
 // This function is auto-generated
-func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64) (_ *federationType.ExposedModule, err error) {
+func (s *Store) LookupLabelByKindResourceIDName(ctx context.Context, kind string, resourceID uint64, name string) (_ *labelsType.Label, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationExposedModule)
-		lookup = federationExposedModuleSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxLabel)
+		lookup = labelSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("kind").Eq(kind),
+			goqu.I("rel_resource").Eq(resourceID),
+			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
 		).Limit(1)
 	)
 
@@ -13951,29 +17568,22 @@ func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64
 	return aux.decode()
 }
 
-// sortableFederationExposedModuleFields returns all <no value> columns flagged as sortable
+// sortableLabelFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationExposedModuleFields() map[string]string {
+func (Store) sortableLabelFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"name":       "name",
-		"node_id":    "node_id",
-		"nodeid":     "node_id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"kind":        "kind",
+		"name":        "name",
+		"resource_id": "resource_id",
+		"resourceid":  "resource_id",
 	}
 }
 
-// collectFederationExposedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectLabelCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -13985,33 +17595,28 @@ func (Store) sortableFederationExposedModuleFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.ExposedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectLabelCursorValues(res *labelsType.Label, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkKind       bool
+		pkResourceID bool
+		pkName       bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "handle":
-					hasUnique = true
-					return res.Handle
+				case "kind":
+					pkKind = true
+					return res.Kind
+				case "resourceID":
+					pkResourceID = true
+					return res.ResourceID
 				case "name":
+					pkName = true
 					return res.Name
-				case "nodeID":
-					return res.NodeID
-				case "createdAt":
-					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
 				}
 				return nil
 			}
@@ -14036,35 +17641,41 @@ func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.E
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkKind {
+		collect(&filter.SortExpr{Column: "kind", Descending: false})
+	}
+	if !hasUnique || !pkResourceID {
+		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
+	}
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFederationExposedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkLabelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationExposedModuleConstraints(ctx context.Context, res *federationType.ExposedModule) (err error) {
+func (s *Store) checkLabelConstraints(ctx context.Context, res *labelsType.Label) (err error) {
 	return nil
 }
 
-// CreateFederationModuleMapping creates one or more rows in federationModuleMapping collection
+// CreateQueue creates one or more rows in queue collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) CreateQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
 	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, queueInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -14072,16 +17683,16 @@ func (s *Store) CreateFederationModuleMapping(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpdateFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
+// UpdateQueue updates one or more existing entries in queue collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) UpdateQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
 	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationModuleMappingUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, queueUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -14089,12 +17700,12 @@ func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpsertFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
+// UpsertQueue updates one or more existing entries in queue collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) UpsertQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
 	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -14102,7 +17713,7 @@ func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federa
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, federationModuleMappingUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, queueUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -14112,12 +17723,12 @@ func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federa
 				continue
 			}
 
-			err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, queueInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, federationModuleMappingUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, queueUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -14127,12 +17738,12 @@ func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federa
 	return
 }
 
-// DeleteFederationModuleMapping Deletes one or more entries from federationModuleMapping collection
+// DeleteQueue Deletes one or more entries from queue collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) DeleteQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect.GOQU(), federationModuleMappingPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, queueDeleteQuery(s.Dialect.GOQU(), queuePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -14140,15 +17751,24 @@ func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federa
 	return nil
 }
 
-// TruncateFederationModuleMappings Deletes all rows from the federationModuleMapping collection
-func (s *Store) TruncateFederationModuleMappings(ctx context.Context) error {
-	return s.Exec(ctx, federationModuleMappingTruncateQuery(s.Dialect.GOQU()))
+// DeleteQueueByID deletes single entry from queue collection
+//
+// This function is auto-generated
+func (s *Store) DeleteQueueByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, queueDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
 }
 
-// SearchFederationModuleMappings returns (filtered) set of FederationModuleMappings
+// TruncateQueues Deletes all rows from the queue collection
+func (s *Store) TruncateQueues(ctx context.Context) error {
+	return s.Exec(ctx, queueTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchQueues returns (filtered) set of Queues
 //
 // This function is auto-generated
-func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federationType.ModuleMappingFilter) (set federationType.ModuleMappingSet, _ federationType.ModuleMappingFilter, err error) {
+func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set systemType.QueueSet, _ systemType.QueueFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -14176,7 +17796,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationModuleMappings fn used for cursor creation;
+	// Original are passed to the etchFullPageOfQueues fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -14186,7 +17806,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfQueues(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -14202,8 +17822,8 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet federationType.ModuleMappingSet
-			if navSet, _, _, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort); err != nil {
+			var navSet systemType.QueueSet
+			if navSet, _, _, err = s.fetchFullPageOfQueues(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -14215,7 +17835,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationModuleMappings collects all requested results.
+// fetchFullPageOfQueues collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -14227,13 +17847,13 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationModuleMappings(
+func (s *Store) fetchFullPageOfQueues(
 	ctx context.Context,
-	filter federationType.ModuleMappingFilter,
+	filter systemType.QueueFilter,
 	sort filter.SortExprSet,
-) (set []*federationType.ModuleMapping, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Queue, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*federationType.ModuleMapping
+		aux []*systemType.Queue
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -14252,10 +17872,10 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter federationType.ModuleMappingFilter
+		tryFilter systemType.QueueFilter
 	)
 
-	set = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Queue, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -14268,7 +17888,7 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryFederationModuleMappings(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryQueues(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -14298,7 +17918,7 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectQueueCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -14329,35 +17949,35 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 	}
 
 	if hasPrev {
-		prev = s.collectFederationModuleMappingCursorValues(set[0], filter.Sort...)
+		prev = s.collectQueueCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectQueueCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryFederationModuleMappings queries the database, converts and checks each row and returns collected set
+// QueryQueues queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationModuleMappings(
+func (s *Store) QueryQueues(
 	ctx context.Context,
-	f federationType.ModuleMappingFilter,
-) (_ []*federationType.ModuleMapping, more bool, err error) {
+	f systemType.QueueFilter,
+) (_ []*systemType.Queue, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
-		res         *federationType.ModuleMapping
-		aux         *auxFederationModuleMapping
+		set         = make([]*systemType.Queue, 0, DefaultSliceCapacity)
+		res         *systemType.Queue
+		aux         *auxQueue
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -14365,16 +17985,16 @@ func (s *Store) QueryFederationModuleMappings(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationModuleMapping != nil {
+	if s.Filters.Queue != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationModuleMapping(s, f)
+		tExpr, f, err = s.Filters.Queue(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationModuleMappingFilter(s.Dialect, f)
+		tExpr, f, err = QueueFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could generate filter expression for Queue: %w", err)
 		return
 	}
 
@@ -14382,18 +18002,18 @@ func (s *Store) QueryFederationModuleMappings(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationModuleMappingFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableQueueFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := queueSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationModuleMappingFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationModuleMapping: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableQueueFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Queue: %w", err)
 		return
 	}
 
@@ -14407,12 +18027,12 @@ func (s *Store) QueryFederationModuleMappings(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could not query Queue: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could not query Queue: %w", err)
 		return
 	}
 
@@ -14426,19 +18046,19 @@ func (s *Store) QueryFederationModuleMappings(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not query Queue: %w", err)
 			return
 		}
 
-		aux = new(auxFederationModuleMapping)
+		aux = new(auxQueue)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not scan rows for Queue: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not decode Queue: %w", err)
 			return
 		}
 
@@ -14459,19 +18079,15 @@ func (s *Store) QueryFederationModuleMappings(
 
 }
 
-// LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID searches for module mapping by federation module id and compose module id
-//
-// It returns module mapping
+// LookupQueueByID searches for queue by ID
 //
 // This function is auto-generated
-func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID(ctx context.Context, federationModuleID uint64, composeModuleID uint64, composeNamespaceID uint64) (_ *federationType.ModuleMapping, err error) {
+func (s *Store) LookupQueueByID(ctx context.Context, id uint64) (_ *systemType.Queue, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationModuleMapping)
-		lookup = federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_federation_module").Eq(federationModuleID),
-			goqu.I("rel_compose_module").Eq(composeModuleID),
-			goqu.I("rel_compose_namespace").Eq(composeNamespaceID),
+		aux    = new(auxQueue)
+		lookup = queueSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -14503,17 +18119,15 @@ func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleID
 	return aux.decode()
 }
 
-// LookupFederationModuleMappingByFederationModuleID searches for module mapping by federation module id
-//
-// It returns module mapping
+// LookupQueueByQueue searches for queue by queue name
 //
 // This function is auto-generated
-func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Context, federationModuleID uint64) (_ *federationType.ModuleMapping, err error) {
+func (s *Store) LookupQueueByQueue(ctx context.Context, queue string) (_ *systemType.Queue, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationModuleMapping)
-		lookup = federationModuleMappingSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("rel_federation_module").Eq(federationModuleID),
+		aux    = new(auxQueue)
+		lookup = queueSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("queue").Eq(queue),
 		).Limit(1)
 	)
 
@@ -14545,26 +18159,27 @@ func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Co
 	return aux.decode()
 }
 
-// sortableFederationModuleMappingFields returns all <no value> columns flagged as sortable
+// sortableQueueFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationModuleMappingFields() map[string]string {
+func (Store) sortableQueueFields() map[string]string {
 	return map[string]string{
-		"compose_module_id":    "compose_module_id",
-		"compose_namespace_id": "compose_namespace_id",
-		"composemoduleid":      "compose_module_id",
-		"composenamespaceid":   "compose_namespace_id",
-		"federation_module_id": "federation_module_id",
-		"federationmoduleid":   "federation_module_id",
-		"node_id":              "node_id",
-		"nodeid":               "node_id",
+		"consumer":   "consumer",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"queue":      "queue",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectFederationModuleMappingCursorValues collects values from the given resource that and sets them to the cursor
+// collectQueueCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -14576,24 +18191,30 @@ func (Store) sortableFederationModuleMappingFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationModuleMappingCursorValues(res *federationType.ModuleMapping, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectQueueCursorValues(res *systemType.Queue, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
+		pkID bool
+
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "nodeID":
-					hasUnique = true
-					return res.NodeID
-				case "federationModuleID":
-					return res.FederationModuleID
-				case "composeModuleID":
-					return res.ComposeModuleID
-				case "composeNamespaceID":
-					return res.ComposeNamespaceID
+				case "id":
+					pkID = true
+					return res.ID
+				case "consumer":
+					return res.Consumer
+				case "queue":
+					return res.Queue
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
 				}
 				return nil
 			}
@@ -14618,32 +18239,35 @@ func (s *Store) collectFederationModuleMappingCursorValues(res *federationType.M
 	_ = hasUnique
 
 	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
 
 	return cur
 
 }
 
-// checkFederationModuleMappingConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkQueueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationModuleMappingConstraints(ctx context.Context, res *federationType.ModuleMapping) (err error) {
+func (s *Store) checkQueueConstraints(ctx context.Context, res *systemType.Queue) (err error) {
 	return nil
 }
 
-// CreateFederationNode creates one or more rows in federationNode collection
+// CreateQueueMessage creates one or more rows in queueMessage collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) CreateQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, queueMessageInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -14651,16 +18275,16 @@ func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// UpdateFederationNode updates one or more existing entries in federationNode collection
+// UpdateQueueMessage updates one or more existing entries in queueMessage collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) UpdateQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, queueMessageUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -14668,12 +18292,12 @@ func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// UpsertFederationNode updates one or more existing entries in federationNode collection
+// UpsertQueueMessage updates one or more existing entries in queueMessage collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) UpsertQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -14681,7 +18305,7 @@ func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, federationNodeUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, queueMessageUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -14691,12 +18315,12 @@ func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.
 				continue
 			}
 
-			err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, queueMessageInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, federationNodeUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, queueMessageUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -14706,12 +18330,12 @@ func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// DeleteFederationNode Deletes one or more entries from federationNode collection
+// DeleteQueueMessage Deletes one or more entries from queueMessage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) DeleteQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationNodeDeleteQuery(s.Dialect.GOQU(), federationNodePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, queueMessageDeleteQuery(s.Dialect.GOQU(), queueMessagePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -14719,24 +18343,24 @@ func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.
 	return nil
 }
 
-// DeleteFederationNodeByID deletes single entry from federationNode collection
+// DeleteQueueMessageByID deletes single entry from queueMessage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationNodeDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteQueueMessageByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, queueMessageDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateFederationNodes Deletes all rows from the federationNode collection
-func (s *Store) TruncateFederationNodes(ctx context.Context) error {
-	return s.Exec(ctx, federationNodeTruncateQuery(s.Dialect.GOQU()))
+// TruncateQueueMessages Deletes all rows from the queueMessage collection
+func (s *Store) TruncateQueueMessages(ctx context.Context) error {
+	return s.Exec(ctx, queueMessageTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchFederationNodes returns (filtered) set of FederationNodes
+// SearchQueueMessages returns (filtered) set of QueueMessages
 //
 // This function is auto-generated
-func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.NodeFilter) (set federationType.NodeSet, _ federationType.NodeFilter, err error) {
+func (s *Store) SearchQueueMessages(ctx context.Context, f systemType.QueueMessageFilter) (set systemType.QueueMessageSet, _ systemType.QueueMessageFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -14764,7 +18388,7 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationNodes fn used for cursor creation;
+	// Original are passed to the etchFullPageOfQueueMessages fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -14774,7 +18398,7 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodes(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfQueueMessages(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -14790,8 +18414,8 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet federationType.NodeSet
-			if navSet, _, _, err = s.fetchFullPageOfFederationNodes(ctx, f, sort); err != nil {
+			var navSet systemType.QueueMessageSet
+			if navSet, _, _, err = s.fetchFullPageOfQueueMessages(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -14803,7 +18427,7 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationNodes collects all requested results.
+// fetchFullPageOfQueueMessages collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -14815,13 +18439,13 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationNodes(
+func (s *Store) fetchFullPageOfQueueMessages(
 	ctx context.Context,
-	filter federationType.NodeFilter,
+	filter systemType.QueueMessageFilter,
 	sort filter.SortExprSet,
-) (set []*federationType.Node, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.QueueMessage, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*federationType.Node
+		aux []*systemType.QueueMessage
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -14840,10 +18464,10 @@ func (s *Store) fetchFullPageOfFederationNodes(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter federationType.NodeFilter
+		tryFilter systemType.QueueMessageFilter
 	)
 
-	set = make([]*federationType.Node, 0, DefaultSliceCapacity)
+	set = make([]*systemType.QueueMessage, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -14856,7 +18480,7 @@ func (s *Store) fetchFullPageOfFederationNodes(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryFederationNodes(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryQueueMessages(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -14886,7 +18510,7 @@ func (s *Store) fetchFullPageOfFederationNodes(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationNodeCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectQueueMessageCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -14917,35 +18541,33 @@ func (s *Store) fetchFullPageOfFederationNodes(
 	}
 
 	if hasPrev {
-		prev = s.collectFederationNodeCursorValues(set[0], filter.Sort...)
+		prev = s.collectQueueMessageCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectFederationNodeCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectQueueMessageCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryFederationNodes queries the database, converts and checks each row and returns collected set
+// QueryQueueMessages queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationNodes(
+func (s *Store) QueryQueueMessages(
 	ctx context.Context,
-	f federationType.NodeFilter,
-) (_ []*federationType.Node, more bool, err error) {
+	f systemType.QueueMessageFilter,
+) (_ []*systemType.QueueMessage, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*federationType.Node, 0, DefaultSliceCapacity)
-		res         *federationType.Node
-		aux         *auxFederationNode
+		set         = make([]*systemType.QueueMessage, 0, DefaultSliceCapacity)
+		res         *systemType.QueueMessage
+		aux         *auxQueueMessage
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -14953,16 +18575,16 @@ func (s *Store) QueryFederationNodes(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationNode != nil {
+	if s.Filters.QueueMessage != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationNode(s, f)
+		tExpr, f, err = s.Filters.QueueMessage(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationNodeFilter(s.Dialect, f)
+		tExpr, f, err = QueueMessageFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationNode: %w", err)
+		err = fmt.Errorf("could generate filter expression for QueueMessage: %w", err)
 		return
 	}
 
@@ -14970,18 +18592,18 @@ func (s *Store) QueryFederationNodes(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationNodeFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableQueueMessageFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := federationNodeSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := queueMessageSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationNodeFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationNode: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableQueueMessageFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for QueueMessage: %w", err)
 		return
 	}
 
@@ -14995,12 +18617,12 @@ func (s *Store) QueryFederationNodes(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationNode: %w", err)
+		err = fmt.Errorf("could not query QueueMessage: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationNode: %w", err)
+		err = fmt.Errorf("could not query QueueMessage: %w", err)
 		return
 	}
 
@@ -15014,32 +18636,22 @@ func (s *Store) QueryFederationNodes(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationNode: %w", err)
+			err = fmt.Errorf("could not query QueueMessage: %w", err)
 			return
 		}
 
-		aux = new(auxFederationNode)
+		aux = new(auxQueueMessage)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationNode: %w", err)
+			err = fmt.Errorf("could not scan rows for QueueMessage: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationNode: %w", err)
+			err = fmt.Errorf("could not decode QueueMessage: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
@@ -15047,155 +18659,22 @@ func (s *Store) QueryFederationNodes(
 
 }
 
-// LookupFederationNodeByID searches for federation node by ID
-//
-// It returns federation node
-//
-// This function is auto-generated
-func (s *Store) LookupFederationNodeByID(ctx context.Context, id uint64) (_ *federationType.Node, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupFederationNodeByBaseURLSharedNodeID searches for node by shared-node-id and base-url
-//
-// This function is auto-generated
-func (s *Store) LookupFederationNodeByBaseURLSharedNodeID(ctx context.Context, baseURL string, sharedNodeID uint64) (_ *federationType.Node, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("base_url").Eq(baseURL),
-			goqu.I("shared_node_id").Eq(sharedNodeID),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupFederationNodeBySharedNodeID searches for node by shared-node-id
-//
-// This function is auto-generated
-func (s *Store) LookupFederationNodeBySharedNodeID(ctx context.Context, sharedNodeID uint64) (_ *federationType.Node, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("shared_node_id").Eq(sharedNodeID),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableFederationNodeFields returns all <no value> columns flagged as sortable
+// sortableQueueMessageFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationNodeFields() map[string]string {
+func (Store) sortableQueueMessageFields() map[string]string {
 	return map[string]string{
-		"base_url":       "base_url",
-		"baseurl":        "base_url",
-		"contact":        "contact",
-		"created_at":     "created_at",
-		"createdat":      "created_at",
-		"deleted_at":     "deleted_at",
-		"deletedat":      "deleted_at",
-		"id":             "id",
-		"name":           "name",
-		"shared_node_id": "shared_node_id",
-		"sharednodeid":   "shared_node_id",
-		"status":         "status",
-		"updated_at":     "updated_at",
-		"updatedat":      "updated_at",
+		"created":   "created",
+		"id":        "id",
+		"processed": "processed",
+		"queue":     "queue",
 	}
 }
 
-// collectFederationNodeCursorValues collects values from the given resource that and sets them to the cursor
+// collectQueueMessageCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -15207,7 +18686,7 @@ func (Store) sortableFederationNodeFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectQueueMessageCursorValues(res *systemType.QueueMessage, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -15221,22 +18700,12 @@ func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc .
 				case "id":
 					pkID = true
 					return res.ID
-				case "sharedNodeID":
-					return res.SharedNodeID
-				case "name":
-					return res.Name
-				case "baseURL":
-					return res.BaseURL
-				case "status":
-					return res.Status
-				case "contact":
-					return res.Contact
-				case "createdAt":
-					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
+				case "queue":
+					return res.Queue
+				case "created":
+					return res.Created
+				case "processed":
+					return res.Processed
 				}
 				return nil
 			}
@@ -15269,27 +18738,27 @@ func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc .
 
 }
 
-// checkFederationNodeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkQueueMessageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationNodeConstraints(ctx context.Context, res *federationType.Node) (err error) {
+func (s *Store) checkQueueMessageConstraints(ctx context.Context, res *systemType.QueueMessage) (err error) {
 	return nil
 }
 
-// CreateFederationNodeSync creates one or more rows in federationNodeSync collection
+// CreateRbacRule creates one or more rows in rbacRule collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) CreateRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, rbacRuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -15297,16 +18766,16 @@ func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// UpdateFederationNodeSync updates one or more existing entries in federationNodeSync collection
+// UpdateRbacRule updates one or more existing entries in rbacRule collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) UpdateRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeSyncUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, rbacRuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -15314,12 +18783,12 @@ func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// UpsertFederationNodeSync updates one or more existing entries in federationNodeSync collection
+// UpsertRbacRule updates one or more existing entries in rbacRule collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) UpsertRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -15327,7 +18796,7 @@ func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationT
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, federationNodeSyncUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, rbacRuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -15337,12 +18806,12 @@ func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationT
 				continue
 			}
 
-			err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, rbacRuleInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, federationNodeSyncUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, rbacRuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -15352,12 +18821,12 @@ func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// DeleteFederationNodeSync Deletes one or more entries from federationNodeSync collection
+// DeleteRbacRule Deletes one or more entries from rbacRule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) DeleteRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect.GOQU(), federationNodeSyncPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, rbacRuleDeleteQuery(s.Dialect.GOQU(), rbacRulePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -15365,275 +18834,70 @@ func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationT
 	return nil
 }
 
-// DeleteFederationNodeSyncByNodeID deletes single entry from federationNodeSync collection
+// DeleteRbacRuleByRoleIDResourceOperation deletes single entry from rbacRule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) error {
-	return s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"node_id": nodeID,
+func (s *Store) DeleteRbacRuleByRoleIDResourceOperation(ctx context.Context, roleID uint64, resource string, operation string) error {
+	return s.Exec(ctx, rbacRuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"rel_role":  roleID,
+		"resource":  resource,
+		"operation": operation,
 	}))
 }
 
-// TruncateFederationNodeSyncs Deletes all rows from the federationNodeSync collection
-func (s *Store) TruncateFederationNodeSyncs(ctx context.Context) error {
-	return s.Exec(ctx, federationNodeSyncTruncateQuery(s.Dialect.GOQU()))
+// TruncateRbacRules Deletes all rows from the rbacRule collection
+func (s *Store) TruncateRbacRules(ctx context.Context) error {
+	return s.Exec(ctx, rbacRuleTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchFederationNodeSyncs returns (filtered) set of FederationNodeSyncs
+// SearchRbacRules returns (filtered) set of RbacRules
 //
 // This function is auto-generated
-func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.NodeSyncFilter) (set federationType.NodeSyncSet, _ federationType.NodeSyncFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
-
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationNodeSyncs fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort)
+func (s *Store) SearchRbacRules(ctx context.Context, f rbacType.RuleFilter) (set rbacType.RuleSet, _ rbacType.RuleFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryRbacRules(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
 
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
-
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet federationType.NodeSyncSet
-			if navSet, _, _, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
-	}
-
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationNodeSyncs collects all requested results.
-//
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// # Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfFederationNodeSyncs(
-	ctx context.Context,
-	filter federationType.NodeSyncFilter,
-	sort filter.SortExprSet,
-) (set []*federationType.NodeSync, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*federationType.NodeSync
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter federationType.NodeSyncFilter
-	)
-
-	set = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryFederationNodeSyncs(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectFederationNodeSyncCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryFederationNodeSyncs queries the database, converts and checks each row and returns collected set
+// QueryRbacRules queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationNodeSyncs(
+func (s *Store) QueryRbacRules(
 	ctx context.Context,
-	f federationType.NodeSyncFilter,
-) (_ []*federationType.NodeSync, more bool, err error) {
+	f rbacType.RuleFilter,
+) (_ []*rbacType.Rule, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
-		res         *federationType.NodeSync
-		aux         *auxFederationNodeSync
+		set         = make([]*rbacType.Rule, 0, DefaultSliceCapacity)
+		res         *rbacType.Rule
+		aux         *auxRbacRule
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationNodeSync != nil {
+	if s.Filters.RbacRule != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationNodeSync(s, f)
+		tExpr, f, err = s.Filters.RbacRule(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationNodeSyncFilter(s.Dialect, f)
+		tExpr, f, err = RbacRuleFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationNodeSync: %w", err)
+		err = fmt.Errorf("could generate filter expression for RbacRule: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationNodeSyncFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationNodeSyncFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationNodeSync: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := rbacRuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -15641,12 +18905,12 @@ func (s *Store) QueryFederationNodeSyncs(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		err = fmt.Errorf("could not query RbacRule: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		err = fmt.Errorf("could not query RbacRule: %w", err)
 		return
 	}
 
@@ -15660,100 +18924,299 @@ func (s *Store) QueryFederationNodeSyncs(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not query RbacRule: %w", err)
 			return
 		}
 
-		aux = new(auxFederationNodeSync)
+		aux = new(auxRbacRule)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not scan rows for RbacRule: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not decode RbacRule: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// sortableRbacRuleFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableRbacRuleFields() map[string]string {
+	return map[string]string{
+		"operation": "operation",
+		"resource":  "resource",
+		"role_id":   "role_id",
+		"roleid":    "role_id",
+	}
+}
+
+// collectRbacRuleCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// # Known issues:
+//
+// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+// undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectRbacRuleCursorValues(res *rbacType.Rule, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkRoleID    bool
+		pkResource  bool
+		pkOperation bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "roleID":
+					pkRoleID = true
+					return res.RoleID
+				case "resource":
+					pkResource = true
+					return res.Resource
+				case "operation":
+					pkOperation = true
+					return res.Operation
+				}
+				return nil
+			}
+
+			for _, c := range cc {
+				switch c.Modifier() {
+				case filter.COALESCE:
+					var val interface{}
+					for _, col := range c.Columns() {
+						if reflect2.IsNil(val) {
+							val = getVal(col)
+						}
+					}
+					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
+				default:
+					cur.Set(c.Column, getVal(c.Column), c.Descending)
+				}
+			}
+		}
+	)
+
+	_ = hasUnique
+
+	collect(cc...)
+	if !hasUnique || !pkRoleID {
+		collect(&filter.SortExpr{Column: "roleID", Descending: false})
+	}
+	if !hasUnique || !pkResource {
+		collect(&filter.SortExpr{Column: "resource", Descending: false})
+	}
+	if !hasUnique || !pkOperation {
+		collect(&filter.SortExpr{Column: "operation", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkRbacRuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkRbacRuleConstraints(ctx context.Context, res *rbacType.Rule) (err error) {
+	return nil
+}
+
+// CreateRbacRuleChange creates one or more rows in rbacRuleChange collection
+//
+// This function is auto-generated
+func (s *Store) CreateRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) (err error) {
+	for i := range rr {
+		if err = s.checkRbacRuleChangeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, rbacRuleChangeInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateRbacRuleChange updates one or more existing entries in rbacRuleChange collection
+//
+// This function is auto-generated
+func (s *Store) UpdateRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) (err error) {
+	for i := range rr {
+		if err = s.checkRbacRuleChangeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, rbacRuleChangeUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertRbacRuleChange updates one or more existing entries in rbacRuleChange collection
+//
+// This function is auto-generated
+func (s *Store) UpsertRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) (err error) {
+	for i := range rr {
+		if err = s.checkRbacRuleChangeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, rbacRuleChangeUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
 				return
-			} else if !ok {
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
 				continue
 			}
+
+			err = s.Exec(ctx, rbacRuleChangeInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, rbacRuleChangeUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
 		}
+	}
 
-		set = append(set, res)
+	return
+}
+
+// DeleteRbacRuleChange Deletes one or more entries from rbacRuleChange collection
+//
+// This function is auto-generated
+func (s *Store) DeleteRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, rbacRuleChangeDeleteQuery(s.Dialect.GOQU(), rbacRuleChangePrimaryKeys(rr[i]))); err != nil {
+			return
+		}
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return nil
+}
 
+// DeleteRbacRuleChangeByID deletes single entry from rbacRuleChange collection
+//
+// This function is auto-generated
+func (s *Store) DeleteRbacRuleChangeByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, rbacRuleChangeDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
 }
 
-// LookupFederationNodeSyncByNodeID searches for sync activity by node ID
+// TruncateRbacRuleChanges Deletes all rows from the rbacRuleChange collection
+func (s *Store) TruncateRbacRuleChanges(ctx context.Context) error {
+	return s.Exec(ctx, rbacRuleChangeTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchRbacRuleChanges returns (filtered) set of RbacRuleChanges
 //
-// It returns sync activity
+// This function is auto-generated
+func (s *Store) SearchRbacRuleChanges(ctx context.Context, f rbacType.RuleChangeFilter) (set rbacType.RuleChangeSet, _ rbacType.RuleChangeFilter, err error) {
+
+	set, _, err = s.QueryRbacRuleChanges(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryRbacRuleChanges queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) LookupFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) (_ *federationType.NodeSync, err error) {
+func (s *Store) QueryRbacRuleChanges(
+	ctx context.Context,
+	f rbacType.RuleChangeFilter,
+) (_ []*rbacType.RuleChange, more bool, err error) {
 	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNodeSync)
-		lookup = federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("node_id").Eq(nodeID),
-		).Limit(1)
+		set         = make([]*rbacType.RuleChange, 0, DefaultSliceCapacity)
+		res         *rbacType.RuleChange
+		aux         *auxRbacRuleChange
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
+	if s.Filters.RbacRuleChange != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.RbacRuleChange(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = RbacRuleChangeFilter(s.Dialect, f)
 	}
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for RbacRuleChange: %w", err)
 		return
 	}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
+	expr = append(expr, tExpr...)
 
-	if err = aux.scan(rows); err != nil {
+	query := rbacRuleChangeSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableRbacRuleChangeFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for RbacRuleChange: %w", err)
 		return
 	}
 
-	return aux.decode()
-}
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
-// LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus searches for activity by node, type and status
-//
-// It returns sync activity
-//
-// This function is auto-generated
-func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx context.Context, nodeID uint64, moduleID uint64, syncType string, syncStatus string) (_ *federationType.NodeSync, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNodeSync)
-		lookup = federationNodeSyncSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("node_id").Eq(nodeID),
-			goqu.I("module_id").Eq(moduleID),
-			goqu.I("sync_type").Eq(syncType),
-			goqu.I("sync_status").Eq(syncStatus),
-		).Limit(1)
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	rows, err = s.Query(ctx, lookup)
+	rows, err = s.Query(ctx, query)
 	if err != nil {
+		err = fmt.Errorf("could not query RbacRuleChange: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query RbacRuleChange: %w", err)
 		return
 	}
 
@@ -15765,43 +19228,45 @@ func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx c
 		}
 	}()
 
-	if err = rows.Err(); err != nil {
-		return
-	}
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query RbacRuleChange: %w", err)
+			return
+		}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
+		aux = new(auxRbacRuleChange)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for RbacRuleChange: %w", err)
+			return
+		}
 
-	if err = aux.scan(rows); err != nil {
-		return
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode RbacRuleChange: %w", err)
+			return
+		}
+
+		set = append(set, res)
 	}
 
-	return aux.decode()
+	return set, false, err
+
 }
 
-// sortableFederationNodeSyncFields returns all <no value> columns flagged as sortable
+// sortableRbacRuleChangeFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationNodeSyncFields() map[string]string {
+func (Store) sortableRbacRuleChangeFields() map[string]string {
 	return map[string]string{
-		"module_id":      "module_id",
-		"moduleid":       "module_id",
-		"node_id":        "node_id",
-		"nodeid":         "node_id",
-		"sync_status":    "sync_status",
-		"sync_type":      "sync_type",
-		"syncstatus":     "sync_status",
-		"synctype":       "sync_type",
-		"time_of_action": "time_of_action",
-		"timeofaction":   "time_of_action",
+		"id":        "id",
+		"timestamp": "timestamp",
 	}
 }
 
-// collectFederationNodeSyncCursorValues collects values from the given resource that and sets them to the cursor
+// collectRbacRuleChangeCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -15813,28 +19278,22 @@ func (Store) sortableFederationNodeSyncFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationNodeSyncCursorValues(res *federationType.NodeSync, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectRbacRuleChangeCursorValues(res *rbacType.RuleChange, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkNodeID bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "nodeID":
-					pkNodeID = true
-					return res.NodeID
-				case "moduleID":
-					return res.ModuleID
-				case "syncType":
-					return res.SyncType
-				case "syncStatus":
-					return res.SyncStatus
-				case "timeOfAction":
-					return res.TimeOfAction
+				case "id":
+					pkID = true
+					return res.ID
+				case "timestamp":
+					return res.Timestamp
 				}
 				return nil
 			}
@@ -15859,35 +19318,35 @@ func (s *Store) collectFederationNodeSyncCursorValues(res *federationType.NodeSy
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkNodeID {
-		collect(&filter.SortExpr{Column: "nodeID", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFederationNodeSyncConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkRbacRuleChangeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationNodeSyncConstraints(ctx context.Context, res *federationType.NodeSync) (err error) {
+func (s *Store) checkRbacRuleChangeConstraints(ctx context.Context, res *rbacType.RuleChange) (err error) {
 	return nil
 }
 
-// CreateFederationSharedModule creates one or more rows in federationSharedModule collection
+// CreateReminder creates one or more rows in reminder collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) CreateReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reminderInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -15895,16 +19354,16 @@ func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// UpdateFederationSharedModule updates one or more existing entries in federationSharedModule collection
+// UpdateReminder updates one or more existing entries in reminder collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) UpdateReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationSharedModuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reminderUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -15912,12 +19371,12 @@ func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// UpsertFederationSharedModule updates one or more existing entries in federationSharedModule collection
+// UpsertReminder updates one or more existing entries in reminder collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) UpsertReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -15925,7 +19384,7 @@ func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federat
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, federationSharedModuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, reminderUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -15935,12 +19394,12 @@ func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federat
 				continue
 			}
 
-			err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reminderInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, federationSharedModuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reminderUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -15950,12 +19409,12 @@ func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// DeleteFederationSharedModule Deletes one or more entries from federationSharedModule collection
+// DeleteReminder Deletes one or more entries from reminder collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) DeleteReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect.GOQU(), federationSharedModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, reminderDeleteQuery(s.Dialect.GOQU(), reminderPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -15963,24 +19422,24 @@ func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federat
 	return nil
 }
 
-// DeleteFederationSharedModuleByID deletes single entry from federationSharedModule collection
+// DeleteReminderByID deletes single entry from reminder collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationSharedModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteReminderByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, reminderDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateFederationSharedModules Deletes all rows from the federationSharedModule collection
-func (s *Store) TruncateFederationSharedModules(ctx context.Context) error {
-	return s.Exec(ctx, federationSharedModuleTruncateQuery(s.Dialect.GOQU()))
+// TruncateReminders Deletes all rows from the reminder collection
+func (s *Store) TruncateReminders(ctx context.Context) error {
+	return s.Exec(ctx, reminderTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchFederationSharedModules returns (filtered) set of FederationSharedModules
+// SearchReminders returns (filtered) set of Reminders
 //
 // This function is auto-generated
-func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationType.SharedModuleFilter) (set federationType.SharedModuleSet, _ federationType.SharedModuleFilter, err error) {
+func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter) (set systemType.ReminderSet, _ systemType.ReminderFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -16008,7 +19467,7 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationSharedModules fn used for cursor creation;
+	// Original are passed to the etchFullPageOfReminders fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -16018,7 +19477,7 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReminders(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -16034,8 +19493,8 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet federationType.SharedModuleSet
-			if navSet, _, _, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort); err != nil {
+			var navSet systemType.ReminderSet
+			if navSet, _, _, err = s.fetchFullPageOfReminders(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -16047,7 +19506,7 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationSharedModules collects all requested results.
+// fetchFullPageOfReminders collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -16059,13 +19518,13 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationSharedModules(
+func (s *Store) fetchFullPageOfReminders(
 	ctx context.Context,
-	filter federationType.SharedModuleFilter,
+	filter systemType.ReminderFilter,
 	sort filter.SortExprSet,
-) (set []*federationType.SharedModule, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Reminder, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*federationType.SharedModule
+		aux []*systemType.Reminder
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -16084,10 +19543,10 @@ func (s *Store) fetchFullPageOfFederationSharedModules(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter federationType.SharedModuleFilter
+		tryFilter systemType.ReminderFilter
 	)
 
-	set = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Reminder, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -16100,7 +19559,7 @@ func (s *Store) fetchFullPageOfFederationSharedModules(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryFederationSharedModules(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryReminders(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -16130,7 +19589,7 @@ func (s *Store) fetchFullPageOfFederationSharedModules(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectReminderCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -16161,35 +19620,35 @@ func (s *Store) fetchFullPageOfFederationSharedModules(
 	}
 
 	if hasPrev {
-		prev = s.collectFederationSharedModuleCursorValues(set[0], filter.Sort...)
+		prev = s.collectReminderCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectReminderCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryFederationSharedModules queries the database, converts and checks each row and returns collected set
+// QueryReminders queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationSharedModules(
+func (s *Store) QueryReminders(
 	ctx context.Context,
-	f federationType.SharedModuleFilter,
-) (_ []*federationType.SharedModule, more bool, err error) {
+	f systemType.ReminderFilter,
+) (_ []*systemType.Reminder, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
-		res         *federationType.SharedModule
-		aux         *auxFederationSharedModule
+		set         = make([]*systemType.Reminder, 0, DefaultSliceCapacity)
+		res         *systemType.Reminder
+		aux         *auxReminder
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -16197,16 +19656,16 @@ func (s *Store) QueryFederationSharedModules(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationSharedModule != nil {
+	if s.Filters.Reminder != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationSharedModule(s, f)
+		tExpr, f, err = s.Filters.Reminder(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationSharedModuleFilter(s.Dialect, f)
+		tExpr, f, err = ReminderFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationSharedModule: %w", err)
+		err = fmt.Errorf("could generate filter expression for Reminder: %w", err)
 		return
 	}
 
@@ -16214,18 +19673,18 @@ func (s *Store) QueryFederationSharedModules(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableFederationSharedModuleFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReminderFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := federationSharedModuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := reminderSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationSharedModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationSharedModule: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableReminderFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Reminder: %w", err)
 		return
 	}
 
@@ -16239,12 +19698,12 @@ func (s *Store) QueryFederationSharedModules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		err = fmt.Errorf("could not query Reminder: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		err = fmt.Errorf("could not query Reminder: %w", err)
 		return
 	}
 
@@ -16258,19 +19717,19 @@ func (s *Store) QueryFederationSharedModules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+			err = fmt.Errorf("could not query Reminder: %w", err)
 			return
 		}
 
-		aux = new(auxFederationSharedModule)
+		aux = new(auxReminder)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationSharedModule: %w", err)
+			err = fmt.Errorf("could not scan rows for Reminder: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationSharedModule: %w", err)
+			err = fmt.Errorf("could not decode Reminder: %w", err)
 			return
 		}
 
@@ -16291,16 +19750,14 @@ func (s *Store) QueryFederationSharedModules(
 
 }
 
-// LookupFederationSharedModuleByID searches for shared federation module by ID
-//
-// It returns shared federation module
+// LookupReminderByID
 //
 // This function is auto-generated
-func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64) (_ *federationType.SharedModule, err error) {
+func (s *Store) LookupReminderByID(ctx context.Context, id uint64) (_ *systemType.Reminder, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationSharedModule)
-		lookup = federationSharedModuleSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxReminder)
+		lookup = reminderSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -16333,31 +19790,32 @@ func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64)
 	return aux.decode()
 }
 
-// sortableFederationSharedModuleFields returns all <no value> columns flagged as sortable
-//
-// # Notes
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableFederationSharedModuleFields() map[string]string {
-	return map[string]string{
-		"created_at":                    "created_at",
-		"createdat":                     "created_at",
-		"deleted_at":                    "deleted_at",
-		"deletedat":                     "deleted_at",
-		"external_federation_module_id": "external_federation_module_id",
-		"externalfederationmoduleid":    "external_federation_module_id",
-		"handle":                        "handle",
-		"id":                            "id",
-		"name":                          "name",
-		"node_id":                       "node_id",
-		"nodeid":                        "node_id",
-		"updated_at":                    "updated_at",
-		"updatedat":                     "updated_at",
+// sortableReminderFields returns all <no value> columns flagged as sortable
+//
+// # Notes
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableReminderFields() map[string]string {
+	return map[string]string{
+		"assigned_at":  "assigned_at",
+		"assignedat":   "assigned_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"dismissed_at": "dismissed_at",
+		"dismissedat":  "dismissed_at",
+		"id":           "id",
+		"remind_at":    "remind_at",
+		"remindat":     "remind_at",
+		"resource":     "resource",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
 	}
 }
 
-// collectFederationSharedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectReminderCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -16369,7 +19827,7 @@ func (Store) sortableFederationSharedModuleFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.SharedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectReminderCursorValues(res *systemType.Reminder, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -16383,15 +19841,14 @@ func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.Sh
 				case "id":
 					pkID = true
 					return res.ID
-				case "handle":
-					hasUnique = true
-					return res.Handle
-				case "nodeID":
-					return res.NodeID
-				case "name":
-					return res.Name
-				case "externalFederationModuleID":
-					return res.ExternalFederationModuleID
+				case "resource":
+					return res.Resource
+				case "assignedAt":
+					return res.AssignedAt
+				case "dismissedAt":
+					return res.DismissedAt
+				case "remindAt":
+					return res.RemindAt
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -16430,27 +19887,27 @@ func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.Sh
 
 }
 
-// checkFederationSharedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkReminderConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationSharedModuleConstraints(ctx context.Context, res *federationType.SharedModule) (err error) {
+func (s *Store) checkReminderConstraints(ctx context.Context, res *systemType.Reminder) (err error) {
 	return nil
 }
 
-// CreateFlag creates one or more rows in flag collection
+// CreateReport creates one or more rows in report collection
 //
 // This function is auto-generated
-func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) CreateReport(ctx context.Context, rr ...*systemType.Report) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, flagInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -16458,16 +19915,16 @@ func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return
 }
 
-// UpdateFlag updates one or more existing entries in flag collection
+// UpdateReport updates one or more existing entries in report collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) UpdateReport(ctx context.Context, rr ...*systemType.Report) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, flagUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -16475,12 +19932,12 @@ func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return
 }
 
-// UpsertFlag updates one or more existing entries in flag collection
+// UpsertReport updates one or more existing entries in report collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) UpsertReport(ctx context.Context, rr ...*systemType.Report) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -16488,7 +19945,7 @@ func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, flagUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, reportUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -16498,99 +19955,303 @@ func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 				continue
 			}
 
-			err = s.Exec(ctx, flagInsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-		} else {
-			err = s.Exec(ctx, flagUpsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
+			err = s.Exec(ctx, reportInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, reportUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteReport Deletes one or more entries from report collection
+//
+// This function is auto-generated
+func (s *Store) DeleteReport(ctx context.Context, rr ...*systemType.Report) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, reportDeleteQuery(s.Dialect.GOQU(), reportPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteReportByID deletes single entry from report collection
+//
+// This function is auto-generated
+func (s *Store) DeleteReportByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, reportDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateReports Deletes all rows from the report collection
+func (s *Store) TruncateReports(ctx context.Context) error {
+	return s.Exec(ctx, reportTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchReports returns (filtered) set of Reports
+//
+// This function is auto-generated
+func (s *Store) SearchReports(ctx context.Context, f systemType.ReportFilter) (set systemType.ReportSet, _ systemType.ReportFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfReports fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReports(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.ReportSet
+			if navSet, _, _, err = s.fetchFullPageOfReports(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfReports collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfReports(
+	ctx context.Context,
+	filter systemType.ReportFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.Report, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.Report
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.ReportFilter
+	)
+
+	set = make([]*systemType.Report, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryReports(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
 			}
-		}
-	}
 
-	return
-}
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectReportCursorValues(set[collected-1], filter.Sort...)
 
-// DeleteFlag Deletes one or more entries from flag collection
-//
-// This function is auto-generated
-func (s *Store) DeleteFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
-	for i := range rr {
-		if err = s.Exec(ctx, flagDeleteQuery(s.Dialect.GOQU(), flagPrimaryKeys(rr[i]))); err != nil {
-			return
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
 		}
+
+		break
 	}
 
-	return nil
-}
+	collected := len(set)
 
-// DeleteFlagByKindResourceIDOwnedByName deletes single entry from flag collection
-//
-// This function is auto-generated
-func (s *Store) DeleteFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) error {
-	return s.Exec(ctx, flagDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"kind":         kind,
-		"rel_resource": resourceID,
-		"owned_by":     ownedBy,
-		"name":         name,
-	}))
-}
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
 
-// TruncateFlags Deletes all rows from the flag collection
-func (s *Store) TruncateFlags(ctx context.Context) error {
-	return s.Exec(ctx, flagTruncateQuery(s.Dialect.GOQU()))
-}
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
 
-// SearchFlags returns (filtered) set of Flags
-//
-// This function is auto-generated
-func (s *Store) SearchFlags(ctx context.Context, f flagType.FlagFilter) (set flagType.FlagSet, _ flagType.FlagFilter, err error) {
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
 
-	set, _, err = s.QueryFlags(ctx, f)
-	if err != nil {
-		return nil, f, err
+	if hasPrev {
+		prev = s.collectReportCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
 	}
 
-	return set, f, nil
+	if hasNext {
+		next = s.collectReportCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
 }
 
-// QueryFlags queries the database, converts and checks each row and returns collected set
+// QueryReports queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFlags(
+func (s *Store) QueryReports(
 	ctx context.Context,
-	f flagType.FlagFilter,
-) (_ []*flagType.Flag, more bool, err error) {
+	f systemType.ReportFilter,
+) (_ []*systemType.Report, more bool, err error) {
 	var (
-		set         = make([]*flagType.Flag, 0, DefaultSliceCapacity)
-		res         *flagType.Flag
-		aux         *auxFlag
+		ok bool
+
+		set         = make([]*systemType.Report, 0, DefaultSliceCapacity)
+		res         *systemType.Report
+		aux         *auxReport
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Flag != nil {
+	if s.Filters.Report != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Flag(s, f)
+		tExpr, f, err = s.Filters.Report(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FlagFilter(s.Dialect, f)
+		tExpr, f, err = ReportFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Flag: %w", err)
+		err = fmt.Errorf("could generate filter expression for Report: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := flagSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReportFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := reportSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableReportFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Report: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -16598,12 +20259,12 @@ func (s *Store) QueryFlags(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Flag: %w", err)
+		err = fmt.Errorf("could not query Report: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Flag: %w", err)
+		err = fmt.Errorf("could not query Report: %w", err)
 		return
 	}
 
@@ -16617,41 +20278,50 @@ func (s *Store) QueryFlags(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Flag: %w", err)
+			err = fmt.Errorf("could not query Report: %w", err)
 			return
 		}
 
-		aux = new(auxFlag)
+		aux = new(auxReport)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Flag: %w", err)
+			err = fmt.Errorf("could not scan rows for Report: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Flag: %w", err)
+			err = fmt.Errorf("could not decode Report: %w", err)
 			return
 		}
 
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupFlagByKindResourceIDOwnedByName searches for flag by kind, resource ID, owner and name
+// LookupReportByID searches for report by ID
+//
+// It returns report even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) (_ *flagType.Flag, err error) {
+func (s *Store) LookupReportByID(ctx context.Context, id uint64) (_ *systemType.Report, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFlag)
-		lookup = flagSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("kind").Eq(kind),
-			goqu.I("rel_resource").Eq(resourceID),
-			goqu.I("owned_by").Eq(ownedBy),
-			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
+		aux    = new(auxReport)
+		lookup = reportSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -16683,24 +20353,69 @@ func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind
 	return aux.decode()
 }
 
-// sortableFlagFields returns all <no value> columns flagged as sortable
+// LookupReportByHandle searches for report by handle
+//
+// It returns report if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupReportByHandle(ctx context.Context, handle string) (_ *systemType.Report, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxReport)
+		lookup = reportSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableReportFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFlagFields() map[string]string {
+func (Store) sortableReportFields() map[string]string {
 	return map[string]string{
-		"kind":        "kind",
-		"name":        "name",
-		"owned_by":    "owned_by",
-		"ownedby":     "owned_by",
-		"resource_id": "resource_id",
-		"resourceid":  "resource_id",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectFlagCursorValues collects values from the given resource that and sets them to the cursor
+// collectReportCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -16712,32 +20427,29 @@ func (Store) sortableFlagFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFlagCursorValues(res *flagType.Flag, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectReportCursorValues(res *systemType.Report, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkKind       bool
-		pkResourceID bool
-		pkOwnedBy    bool
-		pkName       bool
-
-		collect = func(cc ...*filter.SortExpr) {
-			getVal := func(col string) interface{} {
-				switch col {
-				case "kind":
-					pkKind = true
-					return res.Kind
-				case "resourceID":
-					pkResourceID = true
-					return res.ResourceID
-				case "ownedBy":
-					pkOwnedBy = true
-					return res.OwnedBy
-				case "name":
-					pkName = true
-					return res.Name
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			getVal := func(col string) interface{} {
+				switch col {
+				case "id":
+					pkID = true
+					return res.ID
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
 				}
 				return nil
 			}
@@ -16762,44 +20474,62 @@ func (s *Store) collectFlagCursorValues(res *flagType.Flag, cc ...*filter.SortEx
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkKind {
-		collect(&filter.SortExpr{Column: "kind", Descending: false})
-	}
-	if !hasUnique || !pkResourceID {
-		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
-	}
-	if !hasUnique || !pkOwnedBy {
-		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
-	}
-	if !hasUnique || !pkName {
-		collect(&filter.SortExpr{Column: "name", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFlagConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkReportConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFlagConstraints(ctx context.Context, res *flagType.Flag) (err error) {
+func (s *Store) checkReportConstraints(ctx context.Context, res *systemType.Report) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupReportByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateLabel creates one or more rows in label collection
+// CreateReportDelivery creates one or more rows in reportDelivery collection
 //
 // This function is auto-generated
-func (s *Store) CreateLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+func (s *Store) CreateReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, labelInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportDeliveryInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -16807,16 +20537,16 @@ func (s *Store) CreateLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 	return
 }
 
-// UpdateLabel updates one or more existing entries in label collection
+// UpdateReportDelivery updates one or more existing entries in reportDelivery collection
 //
 // This function is auto-generated
-func (s *Store) UpdateLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+func (s *Store) UpdateReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, labelUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportDeliveryUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -16824,12 +20554,12 @@ func (s *Store) UpdateLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 	return
 }
 
-// UpsertLabel updates one or more existing entries in label collection
+// UpsertReportDelivery updates one or more existing entries in reportDelivery collection
 //
 // This function is auto-generated
-func (s *Store) UpsertLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+func (s *Store) UpsertReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkLabelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -16837,7 +20567,7 @@ func (s *Store) UpsertLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, labelUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, reportDeliveryUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -16847,12 +20577,12 @@ func (s *Store) UpsertLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 				continue
 			}
 
-			err = s.Exec(ctx, labelInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reportDeliveryInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, labelUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reportDeliveryUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -16862,12 +20592,12 @@ func (s *Store) UpsertLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 	return
 }
 
-// DeleteLabel Deletes one or more entries from label collection
+// DeleteReportDelivery Deletes one or more entries from reportDelivery collection
 //
 // This function is auto-generated
-func (s *Store) DeleteLabel(ctx context.Context, rr ...*labelsType.Label) (err error) {
+func (s *Store) DeleteReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, labelDeleteQuery(s.Dialect.GOQU(), labelPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, reportDeliveryDeleteQuery(s.Dialect.GOQU(), reportDeliveryPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -16875,70 +20605,273 @@ func (s *Store) DeleteLabel(ctx context.Context, rr ...*labelsType.Label) (err e
 	return nil
 }
 
-// DeleteLabelByKindResourceIDName deletes single entry from label collection
+// DeleteReportDeliveryByID deletes single entry from reportDelivery collection
 //
 // This function is auto-generated
-func (s *Store) DeleteLabelByKindResourceIDName(ctx context.Context, kind string, resourceID uint64, name string) error {
-	return s.Exec(ctx, labelDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"kind":         kind,
-		"rel_resource": resourceID,
-		"name":         name,
+func (s *Store) DeleteReportDeliveryByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, reportDeliveryDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateLabels Deletes all rows from the label collection
-func (s *Store) TruncateLabels(ctx context.Context) error {
-	return s.Exec(ctx, labelTruncateQuery(s.Dialect.GOQU()))
+// TruncateReportDeliverys Deletes all rows from the reportDelivery collection
+func (s *Store) TruncateReportDeliverys(ctx context.Context) error {
+	return s.Exec(ctx, reportDeliveryTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchLabels returns (filtered) set of Labels
+// SearchReportDeliverys returns (filtered) set of ReportDeliverys
 //
 // This function is auto-generated
-func (s *Store) SearchLabels(ctx context.Context, f labelsType.LabelFilter) (set labelsType.LabelSet, _ labelsType.LabelFilter, err error) {
+func (s *Store) SearchReportDeliverys(ctx context.Context, f systemType.ReportDeliveryFilter) (set systemType.ReportDeliverySet, _ systemType.ReportDeliveryFilter, err error) {
 
-	set, _, err = s.QueryLabels(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfReportDeliverys fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReportDeliverys(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
 
-	return set, f, nil
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.ReportDeliverySet
+			if navSet, _, _, err = s.fetchFullPageOfReportDeliverys(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfReportDeliverys collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfReportDeliverys(
+	ctx context.Context,
+	filter systemType.ReportDeliveryFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.ReportDelivery, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.ReportDelivery
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.ReportDeliveryFilter
+	)
+
+	set = make([]*systemType.ReportDelivery, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryReportDeliverys(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectReportDeliveryCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectReportDeliveryCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectReportDeliveryCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
 }
 
-// QueryLabels queries the database, converts and checks each row and returns collected set
+// QueryReportDeliverys queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryLabels(
+func (s *Store) QueryReportDeliverys(
 	ctx context.Context,
-	f labelsType.LabelFilter,
-) (_ []*labelsType.Label, more bool, err error) {
+	f systemType.ReportDeliveryFilter,
+) (_ []*systemType.ReportDelivery, more bool, err error) {
 	var (
-		set         = make([]*labelsType.Label, 0, DefaultSliceCapacity)
-		res         *labelsType.Label
-		aux         *auxLabel
+		set         = make([]*systemType.ReportDelivery, 0, DefaultSliceCapacity)
+		res         *systemType.ReportDelivery
+		aux         *auxReportDelivery
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Label != nil {
+	if s.Filters.ReportDelivery != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Label(s, f)
+		tExpr, f, err = s.Filters.ReportDelivery(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = LabelFilter(s.Dialect, f)
+		tExpr, f, err = ReportDeliveryFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Label: %w", err)
+		err = fmt.Errorf("could generate filter expression for ReportDelivery: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := labelSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReportDeliveryFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := reportDeliverySelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableReportDeliveryFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ReportDelivery: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -16946,12 +20879,12 @@ func (s *Store) QueryLabels(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Label: %w", err)
+		err = fmt.Errorf("could not query ReportDelivery: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Label: %w", err)
+		err = fmt.Errorf("could not query ReportDelivery: %w", err)
 		return
 	}
 
@@ -16965,40 +20898,38 @@ func (s *Store) QueryLabels(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Label: %w", err)
+			err = fmt.Errorf("could not query ReportDelivery: %w", err)
 			return
 		}
 
-		aux = new(auxLabel)
+		aux = new(auxReportDelivery)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Label: %w", err)
+			err = fmt.Errorf("could not scan rows for ReportDelivery: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Label: %w", err)
+			err = fmt.Errorf("could not decode ReportDelivery: %w", err)
 			return
 		}
 
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupLabelByKindResourceIDName searches for label by kind, resource ID and name
+// LookupReportDeliveryByID
 //
 // This function is auto-generated
-func (s *Store) LookupLabelByKindResourceIDName(ctx context.Context, kind string, resourceID uint64, name string) (_ *labelsType.Label, err error) {
+func (s *Store) LookupReportDeliveryByID(ctx context.Context, id uint64) (_ *systemType.ReportDelivery, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxLabel)
-		lookup = labelSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("kind").Eq(kind),
-			goqu.I("rel_resource").Eq(resourceID),
-			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
+		aux    = new(auxReportDelivery)
+		lookup = reportDeliverySelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -17030,22 +20961,24 @@ func (s *Store) LookupLabelByKindResourceIDName(ctx context.Context, kind string
 	return aux.decode()
 }
 
-// sortableLabelFields returns all <no value> columns flagged as sortable
+// sortableReportDeliveryFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableLabelFields() map[string]string {
+func (Store) sortableReportDeliveryFields() map[string]string {
 	return map[string]string{
-		"kind":        "kind",
-		"name":        "name",
-		"resource_id": "resource_id",
-		"resourceid":  "resource_id",
+		"completed_at": "completed_at",
+		"completedat":  "completed_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"id":           "id",
+		"status":       "status",
 	}
 }
 
-// collectLabelCursorValues collects values from the given resource that and sets them to the cursor
+// collectReportDeliveryCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -17057,28 +20990,26 @@ func (Store) sortableLabelFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectLabelCursorValues(res *labelsType.Label, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectReportDeliveryCursorValues(res *systemType.ReportDelivery, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkKind       bool
-		pkResourceID bool
-		pkName       bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "kind":
-					pkKind = true
-					return res.Kind
-				case "resourceID":
-					pkResourceID = true
-					return res.ResourceID
-				case "name":
-					pkName = true
-					return res.Name
+				case "id":
+					pkID = true
+					return res.ID
+				case "status":
+					return res.Status
+				case "createdAt":
+					return res.CreatedAt
+				case "completedAt":
+					return res.CompletedAt
 				}
 				return nil
 			}
@@ -17103,41 +21034,35 @@ func (s *Store) collectLabelCursorValues(res *labelsType.Label, cc ...*filter.So
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkKind {
-		collect(&filter.SortExpr{Column: "kind", Descending: false})
-	}
-	if !hasUnique || !pkResourceID {
-		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
-	}
-	if !hasUnique || !pkName {
-		collect(&filter.SortExpr{Column: "name", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkLabelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkReportDeliveryConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkLabelConstraints(ctx context.Context, res *labelsType.Label) (err error) {
+func (s *Store) checkReportDeliveryConstraints(ctx context.Context, res *systemType.ReportDelivery) (err error) {
 	return nil
 }
 
-// CreateQueue creates one or more rows in queue collection
+// CreateReportSubscription creates one or more rows in reportSubscription collection
 //
 // This function is auto-generated
-func (s *Store) CreateQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
+func (s *Store) CreateReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) (err error) {
 	for i := range rr {
-		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportSubscriptionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, queueInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportSubscriptionInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -17145,16 +21070,16 @@ func (s *Store) CreateQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 	return
 }
 
-// UpdateQueue updates one or more existing entries in queue collection
+// UpdateReportSubscription updates one or more existing entries in reportSubscription collection
 //
 // This function is auto-generated
-func (s *Store) UpdateQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
+func (s *Store) UpdateReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) (err error) {
 	for i := range rr {
-		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportSubscriptionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, queueUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, reportSubscriptionUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -17162,12 +21087,12 @@ func (s *Store) UpdateQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 	return
 }
 
-// UpsertQueue updates one or more existing entries in queue collection
+// UpsertReportSubscription updates one or more existing entries in reportSubscription collection
 //
 // This function is auto-generated
-func (s *Store) UpsertQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
+func (s *Store) UpsertReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) (err error) {
 	for i := range rr {
-		if err = s.checkQueueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkReportSubscriptionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -17175,7 +21100,7 @@ func (s *Store) UpsertQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, queueUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, reportSubscriptionUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -17185,12 +21110,12 @@ func (s *Store) UpsertQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 				continue
 			}
 
-			err = s.Exec(ctx, queueInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reportSubscriptionInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, queueUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, reportSubscriptionUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -17200,12 +21125,12 @@ func (s *Store) UpsertQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 	return
 }
 
-// DeleteQueue Deletes one or more entries from queue collection
+// DeleteReportSubscription Deletes one or more entries from reportSubscription collection
 //
 // This function is auto-generated
-func (s *Store) DeleteQueue(ctx context.Context, rr ...*systemType.Queue) (err error) {
+func (s *Store) DeleteReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, queueDeleteQuery(s.Dialect.GOQU(), queuePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, reportSubscriptionDeleteQuery(s.Dialect.GOQU(), reportSubscriptionPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -17213,24 +21138,24 @@ func (s *Store) DeleteQueue(ctx context.Context, rr ...*systemType.Queue) (err e
 	return nil
 }
 
-// DeleteQueueByID deletes single entry from queue collection
+// DeleteReportSubscriptionByID deletes single entry from reportSubscription collection
 //
 // This function is auto-generated
-func (s *Store) DeleteQueueByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, queueDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteReportSubscriptionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, reportSubscriptionDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateQueues Deletes all rows from the queue collection
-func (s *Store) TruncateQueues(ctx context.Context) error {
-	return s.Exec(ctx, queueTruncateQuery(s.Dialect.GOQU()))
+// TruncateReportSubscriptions Deletes all rows from the reportSubscription collection
+func (s *Store) TruncateReportSubscriptions(ctx context.Context) error {
+	return s.Exec(ctx, reportSubscriptionTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchQueues returns (filtered) set of Queues
+// SearchReportSubscriptions returns (filtered) set of ReportSubscriptions
 //
 // This function is auto-generated
-func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set systemType.QueueSet, _ systemType.QueueFilter, err error) {
+func (s *Store) SearchReportSubscriptions(ctx context.Context, f systemType.ReportSubscriptionFilter) (set systemType.ReportSubscriptionSet, _ systemType.ReportSubscriptionFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -17258,7 +21183,7 @@ func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfQueues fn used for cursor creation;
+	// Original are passed to the etchFullPageOfReportSubscriptions fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -17268,7 +21193,7 @@ func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfQueues(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReportSubscriptions(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -17284,8 +21209,8 @@ func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.QueueSet
-			if navSet, _, _, err = s.fetchFullPageOfQueues(ctx, f, sort); err != nil {
+			var navSet systemType.ReportSubscriptionSet
+			if navSet, _, _, err = s.fetchFullPageOfReportSubscriptions(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -17297,7 +21222,7 @@ func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set
 	return set, f, nil
 }
 
-// fetchFullPageOfQueues collects all requested results.
+// fetchFullPageOfReportSubscriptions collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -17309,13 +21234,13 @@ func (s *Store) SearchQueues(ctx context.Context, f systemType.QueueFilter) (set
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfQueues(
+func (s *Store) fetchFullPageOfReportSubscriptions(
 	ctx context.Context,
-	filter systemType.QueueFilter,
+	filter systemType.ReportSubscriptionFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.Queue, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.ReportSubscription, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.Queue
+		aux []*systemType.ReportSubscription
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -17334,10 +21259,10 @@ func (s *Store) fetchFullPageOfQueues(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.QueueFilter
+		tryFilter systemType.ReportSubscriptionFilter
 	)
 
-	set = make([]*systemType.Queue, 0, DefaultSliceCapacity)
+	set = make([]*systemType.ReportSubscription, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -17350,7 +21275,7 @@ func (s *Store) fetchFullPageOfQueues(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryQueues(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryReportSubscriptions(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -17380,7 +21305,7 @@ func (s *Store) fetchFullPageOfQueues(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectQueueCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectReportSubscriptionCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -17411,35 +21336,35 @@ func (s *Store) fetchFullPageOfQueues(
 	}
 
 	if hasPrev {
-		prev = s.collectQueueCursorValues(set[0], filter.Sort...)
+		prev = s.collectReportSubscriptionCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectQueueCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectReportSubscriptionCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryQueues queries the database, converts and checks each row and returns collected set
+// QueryReportSubscriptions queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryQueues(
+func (s *Store) QueryReportSubscriptions(
 	ctx context.Context,
-	f systemType.QueueFilter,
-) (_ []*systemType.Queue, more bool, err error) {
+	f systemType.ReportSubscriptionFilter,
+) (_ []*systemType.ReportSubscription, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.Queue, 0, DefaultSliceCapacity)
-		res         *systemType.Queue
-		aux         *auxQueue
+		set         = make([]*systemType.ReportSubscription, 0, DefaultSliceCapacity)
+		res         *systemType.ReportSubscription
+		aux         *auxReportSubscription
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -17447,16 +21372,16 @@ func (s *Store) QueryQueues(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Queue != nil {
+	if s.Filters.ReportSubscription != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Queue(s, f)
+		tExpr, f, err = s.Filters.ReportSubscription(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = QueueFilter(s.Dialect, f)
+		tExpr, f, err = ReportSubscriptionFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Queue: %w", err)
+		err = fmt.Errorf("could generate filter expression for ReportSubscription: %w", err)
 		return
 	}
 
@@ -17464,18 +21389,18 @@ func (s *Store) QueryQueues(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableQueueFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReportSubscriptionFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := queueSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := reportSubscriptionSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableQueueFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Queue: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableReportSubscriptionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ReportSubscription: %w", err)
 		return
 	}
 
@@ -17489,12 +21414,12 @@ func (s *Store) QueryQueues(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Queue: %w", err)
+		err = fmt.Errorf("could not query ReportSubscription: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Queue: %w", err)
+		err = fmt.Errorf("could not query ReportSubscription: %w", err)
 		return
 	}
 
@@ -17508,19 +21433,19 @@ func (s *Store) QueryQueues(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Queue: %w", err)
+			err = fmt.Errorf("could not query ReportSubscription: %w", err)
 			return
 		}
 
-		aux = new(auxQueue)
+		aux = new(auxReportSubscription)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Queue: %w", err)
+			err = fmt.Errorf("could not scan rows for ReportSubscription: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Queue: %w", err)
+			err = fmt.Errorf("could not decode ReportSubscription: %w", err)
 			return
 		}
 
@@ -17541,55 +21466,17 @@ func (s *Store) QueryQueues(
 
 }
 
-// LookupQueueByID searches for queue by ID
+// LookupReportSubscriptionByID searches for report subscription by ID
 //
-// This function is auto-generated
-func (s *Store) LookupQueueByID(ctx context.Context, id uint64) (_ *systemType.Queue, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxQueue)
-		lookup = queueSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupQueueByQueue searches for queue by queue name
+// It returns report subscription even if deleted or disabled
 //
 // This function is auto-generated
-func (s *Store) LookupQueueByQueue(ctx context.Context, queue string) (_ *systemType.Queue, err error) {
+func (s *Store) LookupReportSubscriptionByID(ctx context.Context, id uint64) (_ *systemType.ReportSubscription, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxQueue)
-		lookup = queueSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("queue").Eq(queue),
+		aux    = new(auxReportSubscription)
+		lookup = reportSubscriptionSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -17621,27 +21508,28 @@ func (s *Store) LookupQueueByQueue(ctx context.Context, queue string) (_ *system
 	return aux.decode()
 }
 
-// sortableQueueFields returns all <no value> columns flagged as sortable
+// sortableReportSubscriptionFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableQueueFields() map[string]string {
+func (Store) sortableReportSubscriptionFields() map[string]string {
 	return map[string]string{
-		"consumer":   "consumer",
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
-		"queue":      "queue",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"created_at":  "created_at",
+		"createdat":   "created_at",
+		"deleted_at":  "deleted_at",
+		"deletedat":   "deleted_at",
+		"enabled":     "enabled",
+		"id":          "id",
+		"last_run_at": "last_run_at",
+		"lastrunat":   "last_run_at",
+		"updated_at":  "updated_at",
+		"updatedat":   "updated_at",
 	}
 }
 
-// collectQueueCursorValues collects values from the given resource that and sets them to the cursor
+// collectReportSubscriptionCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -17653,7 +21541,7 @@ func (Store) sortableQueueFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectQueueCursorValues(res *systemType.Queue, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectReportSubscriptionCursorValues(res *systemType.ReportSubscription, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -17667,10 +21555,10 @@ func (s *Store) collectQueueCursorValues(res *systemType.Queue, cc ...*filter.So
 				case "id":
 					pkID = true
 					return res.ID
-				case "consumer":
-					return res.Consumer
-				case "queue":
-					return res.Queue
+				case "enabled":
+					return res.Enabled
+				case "lastRunAt":
+					return res.LastRunAt
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -17709,27 +21597,27 @@ func (s *Store) collectQueueCursorValues(res *systemType.Queue, cc ...*filter.So
 
 }
 
-// checkQueueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkReportSubscriptionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkQueueConstraints(ctx context.Context, res *systemType.Queue) (err error) {
+func (s *Store) checkReportSubscriptionConstraints(ctx context.Context, res *systemType.ReportSubscription) (err error) {
 	return nil
 }
 
-// CreateQueueMessage creates one or more rows in queueMessage collection
+// CreateResourceActivity creates one or more rows in resourceActivity collection
 //
 // This function is auto-generated
-func (s *Store) CreateQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
+func (s *Store) CreateResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
 	for i := range rr {
-		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, queueMessageInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, resourceActivityInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -17737,16 +21625,16 @@ func (s *Store) CreateQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 	return
 }
 
-// UpdateQueueMessage updates one or more existing entries in queueMessage collection
+// UpdateResourceActivity updates one or more existing entries in resourceActivity collection
 //
 // This function is auto-generated
-func (s *Store) UpdateQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
+func (s *Store) UpdateResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
 	for i := range rr {
-		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, queueMessageUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, resourceActivityUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -17754,12 +21642,12 @@ func (s *Store) UpdateQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 	return
 }
 
-// UpsertQueueMessage updates one or more existing entries in queueMessage collection
+// UpsertResourceActivity updates one or more existing entries in resourceActivity collection
 //
 // This function is auto-generated
-func (s *Store) UpsertQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
+func (s *Store) UpsertResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
 	for i := range rr {
-		if err = s.checkQueueMessageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -17767,7 +21655,7 @@ func (s *Store) UpsertQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, queueMessageUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, resourceActivityUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -17777,12 +21665,12 @@ func (s *Store) UpsertQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 				continue
 			}
 
-			err = s.Exec(ctx, queueMessageInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, resourceActivityInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, queueMessageUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, resourceActivityUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -17792,12 +21680,12 @@ func (s *Store) UpsertQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 	return
 }
 
-// DeleteQueueMessage Deletes one or more entries from queueMessage collection
+// DeleteResourceActivity Deletes one or more entries from resourceActivity collection
 //
 // This function is auto-generated
-func (s *Store) DeleteQueueMessage(ctx context.Context, rr ...*systemType.QueueMessage) (err error) {
+func (s *Store) DeleteResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, queueMessageDeleteQuery(s.Dialect.GOQU(), queueMessagePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, resourceActivityDeleteQuery(s.Dialect.GOQU(), resourceActivityPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -17805,273 +21693,68 @@ func (s *Store) DeleteQueueMessage(ctx context.Context, rr ...*systemType.QueueM
 	return nil
 }
 
-// DeleteQueueMessageByID deletes single entry from queueMessage collection
+// DeleteResourceActivityByID deletes single entry from resourceActivity collection
 //
 // This function is auto-generated
-func (s *Store) DeleteQueueMessageByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, queueMessageDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteResourceActivityByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, resourceActivityDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateQueueMessages Deletes all rows from the queueMessage collection
-func (s *Store) TruncateQueueMessages(ctx context.Context) error {
-	return s.Exec(ctx, queueMessageTruncateQuery(s.Dialect.GOQU()))
+// TruncateResourceActivitys Deletes all rows from the resourceActivity collection
+func (s *Store) TruncateResourceActivitys(ctx context.Context) error {
+	return s.Exec(ctx, resourceActivityTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchQueueMessages returns (filtered) set of QueueMessages
+// SearchResourceActivitys returns (filtered) set of ResourceActivitys
 //
 // This function is auto-generated
-func (s *Store) SearchQueueMessages(ctx context.Context, f systemType.QueueMessageFilter) (set systemType.QueueMessageSet, _ systemType.QueueMessageFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
-
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfQueueMessages fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfQueueMessages(ctx, f, sort)
+func (s *Store) SearchResourceActivitys(ctx context.Context, f discoveryType.ResourceActivityFilter) (set discoveryType.ResourceActivitySet, _ discoveryType.ResourceActivityFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryResourceActivitys(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
 
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
-
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet systemType.QueueMessageSet
-			if navSet, _, _, err = s.fetchFullPageOfQueueMessages(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
-	}
-
 	return set, f, nil
 }
 
-// fetchFullPageOfQueueMessages collects all requested results.
-//
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// # Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfQueueMessages(
-	ctx context.Context,
-	filter systemType.QueueMessageFilter,
-	sort filter.SortExprSet,
-) (set []*systemType.QueueMessage, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*systemType.QueueMessage
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter systemType.QueueMessageFilter
-	)
-
-	set = make([]*systemType.QueueMessage, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryQueueMessages(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectQueueMessageCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectQueueMessageCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectQueueMessageCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryQueueMessages queries the database, converts and checks each row and returns collected set
+// QueryResourceActivitys queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryQueueMessages(
+func (s *Store) QueryResourceActivitys(
 	ctx context.Context,
-	f systemType.QueueMessageFilter,
-) (_ []*systemType.QueueMessage, more bool, err error) {
+	f discoveryType.ResourceActivityFilter,
+) (_ []*discoveryType.ResourceActivity, more bool, err error) {
 	var (
-		set         = make([]*systemType.QueueMessage, 0, DefaultSliceCapacity)
-		res         *systemType.QueueMessage
-		aux         *auxQueueMessage
+		set         = make([]*discoveryType.ResourceActivity, 0, DefaultSliceCapacity)
+		res         *discoveryType.ResourceActivity
+		aux         *auxResourceActivity
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.QueueMessage != nil {
+	if s.Filters.ResourceActivity != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.QueueMessage(s, f)
+		tExpr, f, err = s.Filters.ResourceActivity(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = QueueMessageFilter(s.Dialect, f)
+		tExpr, f, err = ResourceActivityFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for QueueMessage: %w", err)
-		return
-	}
-
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableQueueMessageFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := queueMessageSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableQueueMessageFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for QueueMessage: %w", err)
+		err = fmt.Errorf("could generate filter expression for ResourceActivity: %w", err)
 		return
 	}
 
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	expr = append(expr, tExpr...)
+
+	query := resourceActivitySelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -18079,12 +21762,12 @@ func (s *Store) QueryQueueMessages(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query QueueMessage: %w", err)
+		err = fmt.Errorf("could not query ResourceActivity: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query QueueMessage: %w", err)
+		err = fmt.Errorf("could not query ResourceActivity: %w", err)
 		return
 	}
 
@@ -18098,45 +21781,43 @@ func (s *Store) QueryQueueMessages(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query QueueMessage: %w", err)
+			err = fmt.Errorf("could not query ResourceActivity: %w", err)
 			return
 		}
 
-		aux = new(auxQueueMessage)
+		aux = new(auxResourceActivity)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for QueueMessage: %w", err)
+			err = fmt.Errorf("could not scan rows for ResourceActivity: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode QueueMessage: %w", err)
+			err = fmt.Errorf("could not decode ResourceActivity: %w", err)
 			return
 		}
 
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// sortableQueueMessageFields returns all <no value> columns flagged as sortable
+// sortableResourceActivityFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableQueueMessageFields() map[string]string {
+func (Store) sortableResourceActivityFields() map[string]string {
 	return map[string]string{
-		"created":   "created",
 		"id":        "id",
-		"processed": "processed",
-		"queue":     "queue",
+		"timestamp": "timestamp",
 	}
 }
 
-// collectQueueMessageCursorValues collects values from the given resource that and sets them to the cursor
+// collectResourceActivityCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -18148,7 +21829,7 @@ func (Store) sortableQueueMessageFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectQueueMessageCursorValues(res *systemType.QueueMessage, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectResourceActivityCursorValues(res *discoveryType.ResourceActivity, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -18162,12 +21843,8 @@ func (s *Store) collectQueueMessageCursorValues(res *systemType.QueueMessage, cc
 				case "id":
 					pkID = true
 					return res.ID
-				case "queue":
-					return res.Queue
-				case "created":
-					return res.Created
-				case "processed":
-					return res.Processed
+				case "timestamp":
+					return res.Timestamp
 				}
 				return nil
 			}
@@ -18200,27 +21877,27 @@ func (s *Store) collectQueueMessageCursorValues(res *systemType.QueueMessage, cc
 
 }
 
-// checkQueueMessageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkResourceActivityConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkQueueMessageConstraints(ctx context.Context, res *systemType.QueueMessage) (err error) {
+func (s *Store) checkResourceActivityConstraints(ctx context.Context, res *discoveryType.ResourceActivity) (err error) {
 	return nil
 }
 
-// CreateRbacRule creates one or more rows in rbacRule collection
+// CreateResourceTranslation creates one or more rows in resourceTranslation collection
 //
 // This function is auto-generated
-func (s *Store) CreateRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
+func (s *Store) CreateResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
 	for i := range rr {
-		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, rbacRuleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, resourceTranslationInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -18228,138 +21905,341 @@ func (s *Store) CreateRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err e
 	return
 }
 
-// UpdateRbacRule updates one or more existing entries in rbacRule collection
+// UpdateResourceTranslation updates one or more existing entries in resourceTranslation collection
 //
 // This function is auto-generated
-func (s *Store) UpdateRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
+func (s *Store) UpdateResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
 	for i := range rr {
-		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, resourceTranslationUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertResourceTranslation updates one or more existing entries in resourceTranslation collection
+//
+// This function is auto-generated
+func (s *Store) UpsertResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+	for i := range rr {
+		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, resourceTranslationUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, resourceTranslationInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, resourceTranslationUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// DeleteResourceTranslation Deletes one or more entries from resourceTranslation collection
+//
+// This function is auto-generated
+func (s *Store) DeleteResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, resourceTranslationDeleteQuery(s.Dialect.GOQU(), resourceTranslationPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteResourceTranslationByID deletes single entry from resourceTranslation collection
+//
+// This function is auto-generated
+func (s *Store) DeleteResourceTranslationByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, resourceTranslationDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateResourceTranslations Deletes all rows from the resourceTranslation collection
+func (s *Store) TruncateResourceTranslations(ctx context.Context) error {
+	return s.Exec(ctx, resourceTranslationTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchResourceTranslations returns (filtered) set of ResourceTranslations
+//
+// This function is auto-generated
+func (s *Store) SearchResourceTranslations(ctx context.Context, f systemType.ResourceTranslationFilter) (set systemType.ResourceTranslationSet, _ systemType.ResourceTranslationFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
 			return
 		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfResourceTranslations fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfResourceTranslations(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.ResourceTranslationSet
+			if navSet, _, _, err = s.fetchFullPageOfResourceTranslations(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfResourceTranslations collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfResourceTranslations(
+	ctx context.Context,
+	filter systemType.ResourceTranslationFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.ResourceTranslation, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.ResourceTranslation
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.ResourceTranslationFilter
+	)
+
+	set = make([]*systemType.ResourceTranslation, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryResourceTranslations(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
 
-		if err = s.Exec(ctx, rbacRuleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
-			return
+		if len(aux) == 0 {
+			// nothing fetched
+			break
 		}
-	}
 
-	return
-}
+		// append fetched items
+		set = append(set, aux...)
 
-// UpsertRbacRule updates one or more existing entries in rbacRule collection
-//
-// This function is auto-generated
-func (s *Store) UpsertRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
-	for i := range rr {
-		if err = s.checkRbacRuleConstraints(ctx, rr[i]); err != nil {
-			return
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
 		}
 
-		// @todo this solution is ok for now but could be problematic when we start
-		// batching together DB operations.
-		if s.Dialect.Nuances().TwoStepUpsert {
-			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, rbacRuleUpdateQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-			if c, err := rsp.RowsAffected(); err != nil {
-				return err
-			} else if c > 0 {
-				continue
-			}
+		collected := uint(len(set))
 
-			err = s.Exec(ctx, rbacRuleInsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-		} else {
-			err = s.Exec(ctx, rbacRuleUpsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
 			}
-		}
-	}
 
-	return
-}
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectResourceTranslationCursorValues(set[collected-1], filter.Sort...)
 
-// DeleteRbacRule Deletes one or more entries from rbacRule collection
-//
-// This function is auto-generated
-func (s *Store) DeleteRbacRule(ctx context.Context, rr ...*rbacType.Rule) (err error) {
-	for i := range rr {
-		if err = s.Exec(ctx, rbacRuleDeleteQuery(s.Dialect.GOQU(), rbacRulePrimaryKeys(rr[i]))); err != nil {
-			return
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
 		}
+
+		break
 	}
 
-	return nil
-}
+	collected := len(set)
 
-// DeleteRbacRuleByRoleIDResourceOperation deletes single entry from rbacRule collection
-//
-// This function is auto-generated
-func (s *Store) DeleteRbacRuleByRoleIDResourceOperation(ctx context.Context, roleID uint64, resource string, operation string) error {
-	return s.Exec(ctx, rbacRuleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"rel_role":  roleID,
-		"resource":  resource,
-		"operation": operation,
-	}))
-}
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
 
-// TruncateRbacRules Deletes all rows from the rbacRule collection
-func (s *Store) TruncateRbacRules(ctx context.Context) error {
-	return s.Exec(ctx, rbacRuleTruncateQuery(s.Dialect.GOQU()))
-}
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
 
-// SearchRbacRules returns (filtered) set of RbacRules
-//
-// This function is auto-generated
-func (s *Store) SearchRbacRules(ctx context.Context, f rbacType.RuleFilter) (set rbacType.RuleSet, _ rbacType.RuleFilter, err error) {
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
 
-	set, _, err = s.QueryRbacRules(ctx, f)
-	if err != nil {
-		return nil, f, err
+	if hasPrev {
+		prev = s.collectResourceTranslationCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
 	}
 
-	return set, f, nil
+	if hasNext {
+		next = s.collectResourceTranslationCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
 }
 
-// QueryRbacRules queries the database, converts and checks each row and returns collected set
+// QueryResourceTranslations queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryRbacRules(
+func (s *Store) QueryResourceTranslations(
 	ctx context.Context,
-	f rbacType.RuleFilter,
-) (_ []*rbacType.Rule, more bool, err error) {
+	f systemType.ResourceTranslationFilter,
+) (_ []*systemType.ResourceTranslation, more bool, err error) {
 	var (
-		set         = make([]*rbacType.Rule, 0, DefaultSliceCapacity)
-		res         *rbacType.Rule
-		aux         *auxRbacRule
+		set         = make([]*systemType.ResourceTranslation, 0, DefaultSliceCapacity)
+		res         *systemType.ResourceTranslation
+		aux         *auxResourceTranslation
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.RbacRule != nil {
+	if s.Filters.ResourceTranslation != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.RbacRule(s, f)
+		tExpr, f, err = s.Filters.ResourceTranslation(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = RbacRuleFilter(s.Dialect, f)
+		tExpr, f, err = ResourceTranslationFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for RbacRule: %w", err)
+		err = fmt.Errorf("could generate filter expression for ResourceTranslation: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := rbacRuleSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableResourceTranslationFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := resourceTranslationSelectQuery(s.Dialect.GOQU()).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableResourceTranslationFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ResourceTranslation: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -18367,12 +22247,12 @@ func (s *Store) QueryRbacRules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query RbacRule: %w", err)
+		err = fmt.Errorf("could not query ResourceTranslation: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query RbacRule: %w", err)
+		err = fmt.Errorf("could not query ResourceTranslation: %w", err)
 		return
 	}
 
@@ -18386,45 +22266,89 @@ func (s *Store) QueryRbacRules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query RbacRule: %w", err)
+			err = fmt.Errorf("could not query ResourceTranslation: %w", err)
 			return
 		}
 
-		aux = new(auxRbacRule)
+		aux = new(auxResourceTranslation)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for RbacRule: %w", err)
+			err = fmt.Errorf("could not scan rows for ResourceTranslation: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode RbacRule: %w", err)
+			err = fmt.Errorf("could not decode ResourceTranslation: %w", err)
 			return
 		}
 
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// sortableRbacRuleFields returns all <no value> columns flagged as sortable
+// LookupResourceTranslationByID searches for resource translation by ID
+// It also returns deleted resource translations.
+//
+// This function is auto-generated
+func (s *Store) LookupResourceTranslationByID(ctx context.Context, id uint64) (_ *systemType.ResourceTranslation, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxResourceTranslation)
+		lookup = resourceTranslationSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableResourceTranslationFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableRbacRuleFields() map[string]string {
+func (Store) sortableResourceTranslationFields() map[string]string {
 	return map[string]string{
-		"operation": "operation",
-		"resource":  "resource",
-		"role_id":   "role_id",
-		"roleid":    "role_id",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectRbacRuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectResourceTranslationCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -18436,28 +22360,26 @@ func (Store) sortableRbacRuleFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectRbacRuleCursorValues(res *rbacType.Rule, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectResourceTranslationCursorValues(res *systemType.ResourceTranslation, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkRoleID    bool
-		pkResource  bool
-		pkOperation bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "roleID":
-					pkRoleID = true
-					return res.RoleID
-				case "resource":
-					pkResource = true
-					return res.Resource
-				case "operation":
-					pkOperation = true
-					return res.Operation
+				case "id":
+					pkID = true
+					return res.ID
+				case "createdAt":
+					return res.CreatedAt
+				case "updatedAt":
+					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
 				}
 				return nil
 			}
@@ -18482,41 +22404,35 @@ func (s *Store) collectRbacRuleCursorValues(res *rbacType.Rule, cc ...*filter.So
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkRoleID {
-		collect(&filter.SortExpr{Column: "roleID", Descending: false})
-	}
-	if !hasUnique || !pkResource {
-		collect(&filter.SortExpr{Column: "resource", Descending: false})
-	}
-	if !hasUnique || !pkOperation {
-		collect(&filter.SortExpr{Column: "operation", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkRbacRuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkResourceTranslationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkRbacRuleConstraints(ctx context.Context, res *rbacType.Rule) (err error) {
+func (s *Store) checkResourceTranslationConstraints(ctx context.Context, res *systemType.ResourceTranslation) (err error) {
 	return nil
 }
 
-// CreateReminder creates one or more rows in reminder collection
+// CreateRole creates one or more rows in role collection
 //
 // This function is auto-generated
-func (s *Store) CreateReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
+func (s *Store) CreateRole(ctx context.Context, rr ...*systemType.Role) (err error) {
 	for i := range rr {
-		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, reminderInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, roleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -18524,16 +22440,16 @@ func (s *Store) CreateReminder(ctx context.Context, rr ...*systemType.Reminder)
 	return
 }
 
-// UpdateReminder updates one or more existing entries in reminder collection
+// UpdateRole updates one or more existing entries in role collection
 //
 // This function is auto-generated
-func (s *Store) UpdateReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
+func (s *Store) UpdateRole(ctx context.Context, rr ...*systemType.Role) (err error) {
 	for i := range rr {
-		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, reminderUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, roleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -18541,12 +22457,12 @@ func (s *Store) UpdateReminder(ctx context.Context, rr ...*systemType.Reminder)
 	return
 }
 
-// UpsertReminder updates one or more existing entries in reminder collection
+// UpsertRole updates one or more existing entries in role collection
 //
 // This function is auto-generated
-func (s *Store) UpsertReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
+func (s *Store) UpsertRole(ctx context.Context, rr ...*systemType.Role) (err error) {
 	for i := range rr {
-		if err = s.checkReminderConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -18554,7 +22470,7 @@ func (s *Store) UpsertReminder(ctx context.Context, rr ...*systemType.Reminder)
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, reminderUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, roleUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -18564,12 +22480,12 @@ func (s *Store) UpsertReminder(ctx context.Context, rr ...*systemType.Reminder)
 				continue
 			}
 
-			err = s.Exec(ctx, reminderInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, roleInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, reminderUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, roleUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -18579,12 +22495,12 @@ func (s *Store) UpsertReminder(ctx context.Context, rr ...*systemType.Reminder)
 	return
 }
 
-// DeleteReminder Deletes one or more entries from reminder collection
+// DeleteRole Deletes one or more entries from role collection
 //
 // This function is auto-generated
-func (s *Store) DeleteReminder(ctx context.Context, rr ...*systemType.Reminder) (err error) {
+func (s *Store) DeleteRole(ctx context.Context, rr ...*systemType.Role) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, reminderDeleteQuery(s.Dialect.GOQU(), reminderPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, roleDeleteQuery(s.Dialect.GOQU(), rolePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -18592,24 +22508,24 @@ func (s *Store) DeleteReminder(ctx context.Context, rr ...*systemType.Reminder)
 	return nil
 }
 
-// DeleteReminderByID deletes single entry from reminder collection
+// DeleteRoleByID deletes single entry from role collection
 //
 // This function is auto-generated
-func (s *Store) DeleteReminderByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, reminderDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteRoleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, roleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateReminders Deletes all rows from the reminder collection
-func (s *Store) TruncateReminders(ctx context.Context) error {
-	return s.Exec(ctx, reminderTruncateQuery(s.Dialect.GOQU()))
+// TruncateRoles Deletes all rows from the role collection
+func (s *Store) TruncateRoles(ctx context.Context) error {
+	return s.Exec(ctx, roleTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchReminders returns (filtered) set of Reminders
+// SearchRoles returns (filtered) set of Roles
 //
 // This function is auto-generated
-func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter) (set systemType.ReminderSet, _ systemType.ReminderFilter, err error) {
+func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set systemType.RoleSet, _ systemType.RoleFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -18637,7 +22553,7 @@ func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfReminders fn used for cursor creation;
+	// Original are passed to the etchFullPageOfRoles fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -18647,7 +22563,7 @@ func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReminders(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfRoles(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -18663,8 +22579,8 @@ func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.ReminderSet
-			if navSet, _, _, err = s.fetchFullPageOfReminders(ctx, f, sort); err != nil {
+			var navSet systemType.RoleSet
+			if navSet, _, _, err = s.fetchFullPageOfRoles(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -18676,7 +22592,7 @@ func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter
 	return set, f, nil
 }
 
-// fetchFullPageOfReminders collects all requested results.
+// fetchFullPageOfRoles collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -18688,13 +22604,13 @@ func (s *Store) SearchReminders(ctx context.Context, f systemType.ReminderFilter
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfReminders(
+func (s *Store) fetchFullPageOfRoles(
 	ctx context.Context,
-	filter systemType.ReminderFilter,
+	filter systemType.RoleFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.Reminder, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Role, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.Reminder
+		aux []*systemType.Role
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -18713,10 +22629,10 @@ func (s *Store) fetchFullPageOfReminders(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.ReminderFilter
+		tryFilter systemType.RoleFilter
 	)
 
-	set = make([]*systemType.Reminder, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Role, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -18729,7 +22645,7 @@ func (s *Store) fetchFullPageOfReminders(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryReminders(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryRoles(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -18759,7 +22675,7 @@ func (s *Store) fetchFullPageOfReminders(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectReminderCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectRoleCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -18790,35 +22706,35 @@ func (s *Store) fetchFullPageOfReminders(
 	}
 
 	if hasPrev {
-		prev = s.collectReminderCursorValues(set[0], filter.Sort...)
+		prev = s.collectRoleCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectReminderCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectRoleCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryReminders queries the database, converts and checks each row and returns collected set
+// QueryRoles queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryReminders(
+func (s *Store) QueryRoles(
 	ctx context.Context,
-	f systemType.ReminderFilter,
-) (_ []*systemType.Reminder, more bool, err error) {
+	f systemType.RoleFilter,
+) (_ []*systemType.Role, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.Reminder, 0, DefaultSliceCapacity)
-		res         *systemType.Reminder
-		aux         *auxReminder
+		set         = make([]*systemType.Role, 0, DefaultSliceCapacity)
+		res         *systemType.Role
+		aux         *auxRole
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -18826,16 +22742,16 @@ func (s *Store) QueryReminders(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Reminder != nil {
+	if s.Filters.Role != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Reminder(s, f)
+		tExpr, f, err = s.Filters.Role(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ReminderFilter(s.Dialect, f)
+		tExpr, f, err = RoleFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Reminder: %w", err)
+		err = fmt.Errorf("could generate filter expression for Role: %w", err)
 		return
 	}
 
@@ -18843,18 +22759,18 @@ func (s *Store) QueryReminders(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReminderFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableRoleFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := reminderSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := roleSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableReminderFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Reminder: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableRoleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Role: %w", err)
 		return
 	}
 
@@ -18868,12 +22784,12 @@ func (s *Store) QueryReminders(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Reminder: %w", err)
+		err = fmt.Errorf("could not query Role: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Reminder: %w", err)
+		err = fmt.Errorf("could not query Role: %w", err)
 		return
 	}
 
@@ -18887,19 +22803,19 @@ func (s *Store) QueryReminders(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Reminder: %w", err)
+			err = fmt.Errorf("could not query Role: %w", err)
 			return
 		}
 
-		aux = new(auxReminder)
+		aux = new(auxRole)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Reminder: %w", err)
+			err = fmt.Errorf("could not scan rows for Role: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Reminder: %w", err)
+			err = fmt.Errorf("could not decode Role: %w", err)
 			return
 		}
 
@@ -18920,14 +22836,16 @@ func (s *Store) QueryReminders(
 
 }
 
-// LookupReminderByID
+// LookupRoleByID searches for role by ID
+//
+// It returns role even if deleted or suspended
 //
 // This function is auto-generated
-func (s *Store) LookupReminderByID(ctx context.Context, id uint64) (_ *systemType.Reminder, err error) {
+func (s *Store) LookupRoleByID(ctx context.Context, id uint64) (_ *systemType.Role, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxReminder)
-		lookup = reminderSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxRole)
+		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -18960,32 +22878,115 @@ func (s *Store) LookupReminderByID(ctx context.Context, id uint64) (_ *systemTyp
 	return aux.decode()
 }
 
-// sortableReminderFields returns all <no value> columns flagged as sortable
+// LookupRoleByHandle searches for role by handle
+//
+// It returns only valid role (not deleted, not suspended)
+//
+// This function is auto-generated
+func (s *Store) LookupRoleByHandle(ctx context.Context, handle string) (_ *systemType.Role, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxRole)
+		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupRoleByName searches for role by name
+//
+// It returns only valid role (not deleted, not suspended)
+//
+// This function is auto-generated
+func (s *Store) LookupRoleByName(ctx context.Context, name string) (_ *systemType.Role, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxRole)
+		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("name").Eq(name),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableRoleFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableReminderFields() map[string]string {
+func (Store) sortableRoleFields() map[string]string {
 	return map[string]string{
-		"assigned_at":  "assigned_at",
-		"assignedat":   "assigned_at",
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"dismissed_at": "dismissed_at",
-		"dismissedat":  "dismissed_at",
-		"id":           "id",
-		"remind_at":    "remind_at",
-		"remindat":     "remind_at",
-		"resource":     "resource",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"archived_at": "archived_at",
+		"archivedat":  "archived_at",
+		"created_at":  "created_at",
+		"createdat":   "created_at",
+		"deleted_at":  "deleted_at",
+		"deletedat":   "deleted_at",
+		"handle":      "handle",
+		"id":          "id",
+		"name":        "name",
+		"updated_at":  "updated_at",
+		"updatedat":   "updated_at",
 	}
 }
 
-// collectReminderCursorValues collects values from the given resource that and sets them to the cursor
+// collectRoleCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -18997,7 +22998,7 @@ func (Store) sortableReminderFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectReminderCursorValues(res *systemType.Reminder, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectRoleCursorValues(res *systemType.Role, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -19011,14 +23012,13 @@ func (s *Store) collectReminderCursorValues(res *systemType.Reminder, cc ...*fil
 				case "id":
 					pkID = true
 					return res.ID
-				case "resource":
-					return res.Resource
-				case "assignedAt":
-					return res.AssignedAt
-				case "dismissedAt":
-					return res.DismissedAt
-				case "remindAt":
-					return res.RemindAt
+				case "name":
+					return res.Name
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "archivedAt":
+					return res.ArchivedAt
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
@@ -19048,380 +23048,228 @@ func (s *Store) collectReminderCursorValues(res *systemType.Reminder, cc ...*fil
 
 	_ = hasUnique
 
-	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
-	}
-
-	return cur
-
-}
-
-// checkReminderConstraints performs lookups (on valid) resource to check if any of the values on unique fields
-// already exists in the store
-//
-// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
-// on the full support (MySQL does not support conditional indexes)
-//
-// This function is auto-generated
-func (s *Store) checkReminderConstraints(ctx context.Context, res *systemType.Reminder) (err error) {
-	return nil
-}
-
-// CreateReport creates one or more rows in report collection
-//
-// This function is auto-generated
-func (s *Store) CreateReport(ctx context.Context, rr ...*systemType.Report) (err error) {
-	for i := range rr {
-		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
-			return
-		}
-
-		if err = s.Exec(ctx, reportInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
-			return
-		}
-	}
-
-	return
-}
-
-// UpdateReport updates one or more existing entries in report collection
-//
-// This function is auto-generated
-func (s *Store) UpdateReport(ctx context.Context, rr ...*systemType.Report) (err error) {
-	for i := range rr {
-		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
-			return
-		}
-
-		if err = s.Exec(ctx, reportUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
-			return
-		}
-	}
-
-	return
-}
-
-// UpsertReport updates one or more existing entries in report collection
-//
-// This function is auto-generated
-func (s *Store) UpsertReport(ctx context.Context, rr ...*systemType.Report) (err error) {
-	for i := range rr {
-		if err = s.checkReportConstraints(ctx, rr[i]); err != nil {
-			return
-		}
-
-		// @todo this solution is ok for now but could be problematic when we start
-		// batching together DB operations.
-		if s.Dialect.Nuances().TwoStepUpsert {
-			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, reportUpdateQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-			if c, err := rsp.RowsAffected(); err != nil {
-				return err
-			} else if c > 0 {
-				continue
-			}
-
-			err = s.Exec(ctx, reportInsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-		} else {
-			err = s.Exec(ctx, reportUpsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-		}
-	}
-
-	return
-}
-
-// DeleteReport Deletes one or more entries from report collection
-//
-// This function is auto-generated
-func (s *Store) DeleteReport(ctx context.Context, rr ...*systemType.Report) (err error) {
-	for i := range rr {
-		if err = s.Exec(ctx, reportDeleteQuery(s.Dialect.GOQU(), reportPrimaryKeys(rr[i]))); err != nil {
-			return
-		}
-	}
-
-	return nil
-}
-
-// DeleteReportByID deletes single entry from report collection
-//
-// This function is auto-generated
-func (s *Store) DeleteReportByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, reportDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
-	}))
-}
-
-// TruncateReports Deletes all rows from the report collection
-func (s *Store) TruncateReports(ctx context.Context) error {
-	return s.Exec(ctx, reportTruncateQuery(s.Dialect.GOQU()))
-}
-
-// SearchReports returns (filtered) set of Reports
-//
-// This function is auto-generated
-func (s *Store) SearchReports(ctx context.Context, f systemType.ReportFilter) (set systemType.ReportSet, _ systemType.ReportFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
-
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfReports fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfReports(ctx, f, sort)
-
-	f.PageCursor = nil
-	if err != nil {
-		return nil, f, err
-	}
-
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
-
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet systemType.ReportSet
-			if navSet, _, _, err = s.fetchFullPageOfReports(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
-	}
-
-	return set, f, nil
-}
-
-// fetchFullPageOfReports collects all requested results.
-//
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// # Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfReports(
-	ctx context.Context,
-	filter systemType.ReportFilter,
-	sort filter.SortExprSet,
-) (set []*systemType.Report, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*systemType.Report
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter systemType.ReportFilter
-	)
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
 
-	set = make([]*systemType.Report, 0, DefaultSliceCapacity)
+	return cur
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+}
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
+// checkRoleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkRoleConstraints(ctx context.Context, res *systemType.Role) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
 		}
 
-		if aux, hasNext, err = s.QueryReports(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
 		}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+		ex, err := s.LookupRoleByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
 		}
 
-		// append fetched items
-		set = append(set, aux...)
+		return nil
+	}()
 
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+	if err != nil {
+		return
+	}
+
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Name) == 0 {
+			// skip check on empty values
+			return nil
 		}
 
-		collected := uint(len(set))
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
 
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
+		ex, err := s.LookupRoleByName(ctx, res.Name)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
 
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+		return nil
+	}()
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectReportCursorValues(set[collected-1], filter.Sort...)
+	if err != nil {
+		return
+	}
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
+	return nil
+}
 
-		if reqItems < collected {
-			set = set[:reqItems]
+// CreateRoleMember creates one or more rows in roleMember collection
+//
+// This function is auto-generated
+func (s *Store) CreateRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+	for i := range rr {
+		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		break
+		if err = s.Exec(ctx, roleMemberInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
 	}
 
-	collected := len(set)
+	return
+}
 
-	if collected == 0 {
-		return nil, nil, nil, nil
+// UpdateRoleMember updates one or more existing entries in roleMember collection
+//
+// This function is auto-generated
+func (s *Store) UpdateRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+	for i := range rr {
+		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, roleMemberUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+			return
+		}
 	}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
+	return
+}
+
+// UpsertRoleMember updates one or more existing entries in roleMember collection
+//
+// This function is auto-generated
+func (s *Store) UpsertRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+	for i := range rr {
+		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
+		// @todo this solution is ok for now but could be problematic when we start
+		// batching together DB operations.
+		if s.Dialect.Nuances().TwoStepUpsert {
+			var rsp sql.Result
+			rsp, err = s.ExecR(ctx, roleMemberUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+			if c, err := rsp.RowsAffected(); err != nil {
+				return err
+			} else if c > 0 {
+				continue
+			}
+
+			err = s.Exec(ctx, roleMemberInsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		} else {
+			err = s.Exec(ctx, roleMemberUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			if err != nil {
+				return
+			}
+		}
 	}
 
-	if hasPrev {
-		prev = s.collectReportCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
+	return
+}
+
+// DeleteRoleMember Deletes one or more entries from roleMember collection
+//
+// This function is auto-generated
+func (s *Store) DeleteRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, roleMemberDeleteQuery(s.Dialect.GOQU(), roleMemberPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
 	}
 
-	if hasNext {
-		next = s.collectReportCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
+	return nil
+}
+
+// DeleteRoleMemberByUserIDRoleID deletes single entry from roleMember collection
+//
+// This function is auto-generated
+func (s *Store) DeleteRoleMemberByUserIDRoleID(ctx context.Context, userID uint64, roleID uint64) error {
+	return s.Exec(ctx, roleMemberDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"rel_user": userID,
+		"rel_role": roleID,
+	}))
+}
+
+// TruncateRoleMembers Deletes all rows from the roleMember collection
+func (s *Store) TruncateRoleMembers(ctx context.Context) error {
+	return s.Exec(ctx, roleMemberTruncateQuery(s.Dialect.GOQU()))
+}
+
+// SearchRoleMembers returns (filtered) set of RoleMembers
+//
+// This function is auto-generated
+func (s *Store) SearchRoleMembers(ctx context.Context, f systemType.RoleMemberFilter) (set systemType.RoleMemberSet, _ systemType.RoleMemberFilter, err error) {
+
+	set, _, err = s.QueryRoleMembers(ctx, f)
+	if err != nil {
+		return nil, f, err
 	}
 
-	return set, prev, next, nil
+	return set, f, nil
 }
 
-// QueryReports queries the database, converts and checks each row and returns collected set
+// QueryRoleMembers queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryReports(
+func (s *Store) QueryRoleMembers(
 	ctx context.Context,
-	f systemType.ReportFilter,
-) (_ []*systemType.Report, more bool, err error) {
+	f systemType.RoleMemberFilter,
+) (_ []*systemType.RoleMember, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*systemType.Report, 0, DefaultSliceCapacity)
-		res         *systemType.Report
-		aux         *auxReport
+		set         = make([]*systemType.RoleMember, 0, DefaultSliceCapacity)
+		res         *systemType.RoleMember
+		aux         *auxRoleMember
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Report != nil {
+	if s.Filters.RoleMember != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Report(s, f)
+		tExpr, f, err = s.Filters.RoleMember(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ReportFilter(s.Dialect, f)
+		tExpr, f, err = RoleMemberFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Report: %w", err)
+		err = fmt.Errorf("could generate filter expression for RoleMember: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableReportFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := reportSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableReportFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Report: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := roleMemberSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -19429,12 +23277,12 @@ func (s *Store) QueryReports(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Report: %w", err)
+		err = fmt.Errorf("could not query RoleMember: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Report: %w", err)
+		err = fmt.Errorf("could not query RoleMember: %w", err)
 		return
 	}
 
@@ -19448,144 +23296,45 @@ func (s *Store) QueryReports(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Report: %w", err)
+			err = fmt.Errorf("could not query RoleMember: %w", err)
 			return
 		}
 
-		aux = new(auxReport)
+		aux = new(auxRoleMember)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Report: %w", err)
+			err = fmt.Errorf("could not scan rows for RoleMember: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Report: %w", err)
+			err = fmt.Errorf("could not decode RoleMember: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupReportByID searches for report by ID
-//
-// It returns report even if deleted
-//
-// This function is auto-generated
-func (s *Store) LookupReportByID(ctx context.Context, id uint64) (_ *systemType.Report, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxReport)
-		lookup = reportSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupReportByHandle searches for report by handle
-//
-// It returns report if deleted
-//
-// This function is auto-generated
-func (s *Store) LookupReportByHandle(ctx context.Context, handle string) (_ *systemType.Report, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxReport)
-		lookup = reportSelectQuery(s.Dialect.GOQU()).Where(
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
+	return set, false, err
 
-	return aux.decode()
 }
 
-// sortableReportFields returns all <no value> columns flagged as sortable
+// sortableRoleMemberFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableReportFields() map[string]string {
+func (Store) sortableRoleMemberFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"role_id": "role_id",
+		"roleid":  "role_id",
+		"user_id": "user_id",
+		"userid":  "user_id",
 	}
 }
 
-// collectReportCursorValues collects values from the given resource that and sets them to the cursor
+// collectRoleMemberCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -19597,29 +23346,24 @@ func (Store) sortableReportFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectReportCursorValues(res *systemType.Report, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectRoleMemberCursorValues(res *systemType.RoleMember, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkUserID bool
+		pkRoleID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "handle":
-					hasUnique = true
-					return res.Handle
-				case "createdAt":
-					return res.CreatedAt
-				case "updatedAt":
-					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
+				case "userID":
+					pkUserID = true
+					return res.UserID
+				case "roleID":
+					pkRoleID = true
+					return res.RoleID
 				}
 				return nil
 			}
@@ -19644,62 +23388,38 @@ func (s *Store) collectReportCursorValues(res *systemType.Report, cc ...*filter.
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkUserID {
+		collect(&filter.SortExpr{Column: "userID", Descending: false})
+	}
+	if !hasUnique || !pkRoleID {
+		collect(&filter.SortExpr{Column: "roleID", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkReportConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkRoleMemberConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkReportConstraints(ctx context.Context, res *systemType.Report) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupReportByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkRoleMemberConstraints(ctx context.Context, res *systemType.RoleMember) (err error) {
 	return nil
 }
 
-// CreateResourceActivity creates one or more rows in resourceActivity collection
+// CreateSchedulerClaim creates one or more rows in schedulerClaim collection
 //
 // This function is auto-generated
-func (s *Store) CreateResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
+func (s *Store) CreateSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) (err error) {
 	for i := range rr {
-		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSchedulerClaimConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, resourceActivityInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, schedulerClaimInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -19707,16 +23427,16 @@ func (s *Store) CreateResourceActivity(ctx context.Context, rr ...*discoveryType
 	return
 }
 
-// UpdateResourceActivity updates one or more existing entries in resourceActivity collection
+// UpdateSchedulerClaim updates one or more existing entries in schedulerClaim collection
 //
 // This function is auto-generated
-func (s *Store) UpdateResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
+func (s *Store) UpdateSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) (err error) {
 	for i := range rr {
-		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSchedulerClaimConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, resourceActivityUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, schedulerClaimUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -19724,12 +23444,12 @@ func (s *Store) UpdateResourceActivity(ctx context.Context, rr ...*discoveryType
 	return
 }
 
-// UpsertResourceActivity updates one or more existing entries in resourceActivity collection
+// UpsertSchedulerClaim updates one or more existing entries in schedulerClaim collection
 //
 // This function is auto-generated
-func (s *Store) UpsertResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
+func (s *Store) UpsertSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) (err error) {
 	for i := range rr {
-		if err = s.checkResourceActivityConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSchedulerClaimConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -19737,7 +23457,7 @@ func (s *Store) UpsertResourceActivity(ctx context.Context, rr ...*discoveryType
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, resourceActivityUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, schedulerClaimUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -19747,12 +23467,12 @@ func (s *Store) UpsertResourceActivity(ctx context.Context, rr ...*discoveryType
 				continue
 			}
 
-			err = s.Exec(ctx, resourceActivityInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, schedulerClaimInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, resourceActivityUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, schedulerClaimUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -19762,12 +23482,12 @@ func (s *Store) UpsertResourceActivity(ctx context.Context, rr ...*discoveryType
 	return
 }
 
-// DeleteResourceActivity Deletes one or more entries from resourceActivity collection
+// DeleteSchedulerClaim Deletes one or more entries from schedulerClaim collection
 //
 // This function is auto-generated
-func (s *Store) DeleteResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) (err error) {
+func (s *Store) DeleteSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, resourceActivityDeleteQuery(s.Dialect.GOQU(), resourceActivityPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, schedulerClaimDeleteQuery(s.Dialect.GOQU(), schedulerClaimPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -19775,26 +23495,26 @@ func (s *Store) DeleteResourceActivity(ctx context.Context, rr ...*discoveryType
 	return nil
 }
 
-// DeleteResourceActivityByID deletes single entry from resourceActivity collection
+// DeleteSchedulerClaimByJob deletes single entry from schedulerClaim collection
 //
 // This function is auto-generated
-func (s *Store) DeleteResourceActivityByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, resourceActivityDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
+func (s *Store) DeleteSchedulerClaimByJob(ctx context.Context, job string) error {
+	return s.Exec(ctx, schedulerClaimDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"job": job,
 	}))
 }
 
-// TruncateResourceActivitys Deletes all rows from the resourceActivity collection
-func (s *Store) TruncateResourceActivitys(ctx context.Context) error {
-	return s.Exec(ctx, resourceActivityTruncateQuery(s.Dialect.GOQU()))
+// TruncateSchedulerClaims Deletes all rows from the schedulerClaim collection
+func (s *Store) TruncateSchedulerClaims(ctx context.Context) error {
+	return s.Exec(ctx, schedulerClaimTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchResourceActivitys returns (filtered) set of ResourceActivitys
+// SearchSchedulerClaims returns (filtered) set of SchedulerClaims
 //
 // This function is auto-generated
-func (s *Store) SearchResourceActivitys(ctx context.Context, f discoveryType.ResourceActivityFilter) (set discoveryType.ResourceActivitySet, _ discoveryType.ResourceActivityFilter, err error) {
+func (s *Store) SearchSchedulerClaims(ctx context.Context, f schedulerType.ClaimFilter) (set schedulerType.ClaimSet, _ schedulerType.ClaimFilter, err error) {
 
-	set, _, err = s.QueryResourceActivitys(ctx, f)
+	set, _, err = s.QuerySchedulerClaims(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -19802,41 +23522,41 @@ func (s *Store) SearchResourceActivitys(ctx context.Context, f discoveryType.Res
 	return set, f, nil
 }
 
-// QueryResourceActivitys queries the database, converts and checks each row and returns collected set
+// QuerySchedulerClaims queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryResourceActivitys(
+func (s *Store) QuerySchedulerClaims(
 	ctx context.Context,
-	f discoveryType.ResourceActivityFilter,
-) (_ []*discoveryType.ResourceActivity, more bool, err error) {
+	f schedulerType.ClaimFilter,
+) (_ []*schedulerType.Claim, more bool, err error) {
 	var (
-		set         = make([]*discoveryType.ResourceActivity, 0, DefaultSliceCapacity)
-		res         *discoveryType.ResourceActivity
-		aux         *auxResourceActivity
+		set         = make([]*schedulerType.Claim, 0, DefaultSliceCapacity)
+		res         *schedulerType.Claim
+		aux         *auxSchedulerClaim
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
 	)
 
-	if s.Filters.ResourceActivity != nil {
+	if s.Filters.SchedulerClaim != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ResourceActivity(s, f)
+		tExpr, f, err = s.Filters.SchedulerClaim(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ResourceActivityFilter(s.Dialect, f)
+		tExpr, f, err = SchedulerClaimFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ResourceActivity: %w", err)
+		err = fmt.Errorf("could generate filter expression for SchedulerClaim: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := resourceActivitySelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := schedulerClaimSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -19844,12 +23564,12 @@ func (s *Store) QueryResourceActivitys(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ResourceActivity: %w", err)
+		err = fmt.Errorf("could not query SchedulerClaim: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ResourceActivity: %w", err)
+		err = fmt.Errorf("could not query SchedulerClaim: %w", err)
 		return
 	}
 
@@ -19863,43 +23583,82 @@ func (s *Store) QueryResourceActivitys(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ResourceActivity: %w", err)
+			err = fmt.Errorf("could not query SchedulerClaim: %w", err)
 			return
 		}
 
-		aux = new(auxResourceActivity)
+		aux = new(auxSchedulerClaim)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ResourceActivity: %w", err)
+			err = fmt.Errorf("could not scan rows for SchedulerClaim: %w", err)
 			return
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ResourceActivity: %w", err)
-			return
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode SchedulerClaim: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupSchedulerClaimByJob searches for scheduler claim by job
+//
+// This function is auto-generated
+func (s *Store) LookupSchedulerClaimByJob(ctx context.Context, job string) (_ *schedulerType.Claim, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxSchedulerClaim)
+		lookup = schedulerClaimSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("job").Eq(job),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		set = append(set, res)
+	if err = rows.Err(); err != nil {
+		return
 	}
 
-	return set, false, err
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
 
+	return aux.decode()
 }
 
-// sortableResourceActivityFields returns all <no value> columns flagged as sortable
+// sortableSchedulerClaimFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableResourceActivityFields() map[string]string {
+func (Store) sortableSchedulerClaimFields() map[string]string {
 	return map[string]string{
-		"id":        "id",
-		"timestamp": "timestamp",
+		"job": "job",
 	}
 }
 
-// collectResourceActivityCursorValues collects values from the given resource that and sets them to the cursor
+// collectSchedulerClaimCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -19911,22 +23670,20 @@ func (Store) sortableResourceActivityFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectResourceActivityCursorValues(res *discoveryType.ResourceActivity, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectSchedulerClaimCursorValues(res *schedulerType.Claim, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkJob bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "timestamp":
-					return res.Timestamp
+				case "job":
+					pkJob = true
+					return res.Job
 				}
 				return nil
 			}
@@ -19951,35 +23708,35 @@ func (s *Store) collectResourceActivityCursorValues(res *discoveryType.ResourceA
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkJob {
+		collect(&filter.SortExpr{Column: "job", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkResourceActivityConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkSchedulerClaimConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkResourceActivityConstraints(ctx context.Context, res *discoveryType.ResourceActivity) (err error) {
+func (s *Store) checkSchedulerClaimConstraints(ctx context.Context, res *schedulerType.Claim) (err error) {
 	return nil
 }
 
-// CreateResourceTranslation creates one or more rows in resourceTranslation collection
+// CreateSettingValue creates one or more rows in settingValue collection
 //
 // This function is auto-generated
-func (s *Store) CreateResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+func (s *Store) CreateSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
 	for i := range rr {
-		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, resourceTranslationInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, settingValueInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -19987,16 +23744,16 @@ func (s *Store) CreateResourceTranslation(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpdateResourceTranslation updates one or more existing entries in resourceTranslation collection
+// UpdateSettingValue updates one or more existing entries in settingValue collection
 //
 // This function is auto-generated
-func (s *Store) UpdateResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+func (s *Store) UpdateSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
 	for i := range rr {
-		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, resourceTranslationUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, settingValueUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -20004,12 +23761,12 @@ func (s *Store) UpdateResourceTranslation(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpsertResourceTranslation updates one or more existing entries in resourceTranslation collection
+// UpsertSettingValue updates one or more existing entries in settingValue collection
 //
 // This function is auto-generated
-func (s *Store) UpsertResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+func (s *Store) UpsertSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
 	for i := range rr {
-		if err = s.checkResourceTranslationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -20017,7 +23774,7 @@ func (s *Store) UpsertResourceTranslation(ctx context.Context, rr ...*systemType
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, resourceTranslationUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, settingValueUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -20027,12 +23784,12 @@ func (s *Store) UpsertResourceTranslation(ctx context.Context, rr ...*systemType
 				continue
 			}
 
-			err = s.Exec(ctx, resourceTranslationInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, settingValueInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, resourceTranslationUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, settingValueUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -20042,286 +23799,82 @@ func (s *Store) UpsertResourceTranslation(ctx context.Context, rr ...*systemType
 	return
 }
 
-// DeleteResourceTranslation Deletes one or more entries from resourceTranslation collection
+// DeleteSettingValue Deletes one or more entries from settingValue collection
 //
 // This function is auto-generated
-func (s *Store) DeleteResourceTranslation(ctx context.Context, rr ...*systemType.ResourceTranslation) (err error) {
+func (s *Store) DeleteSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, resourceTranslationDeleteQuery(s.Dialect.GOQU(), resourceTranslationPrimaryKeys(rr[i]))); err != nil {
-			return
-		}
-	}
-
-	return nil
-}
-
-// DeleteResourceTranslationByID deletes single entry from resourceTranslation collection
-//
-// This function is auto-generated
-func (s *Store) DeleteResourceTranslationByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, resourceTranslationDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"id": id,
-	}))
-}
-
-// TruncateResourceTranslations Deletes all rows from the resourceTranslation collection
-func (s *Store) TruncateResourceTranslations(ctx context.Context) error {
-	return s.Exec(ctx, resourceTranslationTruncateQuery(s.Dialect.GOQU()))
-}
-
-// SearchResourceTranslations returns (filtered) set of ResourceTranslations
-//
-// This function is auto-generated
-func (s *Store) SearchResourceTranslations(ctx context.Context, f systemType.ResourceTranslationFilter) (set systemType.ResourceTranslationSet, _ systemType.ResourceTranslationFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		if f.IncPageNavigation || f.IncTotal {
-			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
-		}
-
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+		if err = s.Exec(ctx, settingValueDeleteQuery(s.Dialect.GOQU(), settingValuePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
 
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfResourceTranslations fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfResourceTranslations(ctx, f, sort)
-
-	f.PageCursor = nil
-	if err != nil {
-		return nil, f, err
-	}
-
-	if f.IncTotal {
-		// Calc total from the number of items fetched
-		// even if we do build the page navigation
-		f.Total = uint(len(set))
-
-		if f.Limit > 0 && uint(len(set)) == f.Limit {
-			// there are fewer items fetched then requested limit
-			limit := f.Limit
-			f.Limit = 0
-			var navSet systemType.ResourceTranslationSet
-			if navSet, _, _, err = s.fetchFullPageOfResourceTranslations(ctx, f, sort); err != nil {
-				return
-			} else {
-				f.Total = uint(len(navSet))
-				f.Limit = limit
-			}
-		}
-	}
-
-	return set, f, nil
-}
-
-// fetchFullPageOfResourceTranslations collects all requested results.
-//
-// Function applies:
-//   - cursor conditions (where ...)
-//   - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// # Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfResourceTranslations(
-	ctx context.Context,
-	filter systemType.ResourceTranslationFilter,
-	sort filter.SortExprSet,
-) (set []*systemType.ResourceTranslation, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*systemType.ResourceTranslation
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter systemType.ResourceTranslationFilter
-	)
-
-	set = make([]*systemType.ResourceTranslation, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryResourceTranslations(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectResourceTranslationCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
+	return nil
+}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
+// DeleteSettingValueByOwnedByName deletes single entry from settingValue collection
+//
+// This function is auto-generated
+func (s *Store) DeleteSettingValueByOwnedByName(ctx context.Context, ownedBy uint64, name string) error {
+	return s.Exec(ctx, settingValueDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"rel_owner": ownedBy,
+		"name":      name,
+	}))
+}
 
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
+// TruncateSettingValues Deletes all rows from the settingValue collection
+func (s *Store) TruncateSettingValues(ctx context.Context) error {
+	return s.Exec(ctx, settingValueTruncateQuery(s.Dialect.GOQU()))
+}
 
-	if hasPrev {
-		prev = s.collectResourceTranslationCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
+// SearchSettingValues returns (filtered) set of SettingValues
+//
+// This function is auto-generated
+func (s *Store) SearchSettingValues(ctx context.Context, f systemType.SettingsFilter) (set systemType.SettingValueSet, _ systemType.SettingsFilter, err error) {
 
-	if hasNext {
-		next = s.collectResourceTranslationCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
+	set, _, err = s.QuerySettingValues(ctx, f)
+	if err != nil {
+		return nil, f, err
 	}
 
-	return set, prev, next, nil
+	return set, f, nil
 }
 
-// QueryResourceTranslations queries the database, converts and checks each row and returns collected set
+// QuerySettingValues queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryResourceTranslations(
+func (s *Store) QuerySettingValues(
 	ctx context.Context,
-	f systemType.ResourceTranslationFilter,
-) (_ []*systemType.ResourceTranslation, more bool, err error) {
+	f systemType.SettingsFilter,
+) (_ []*systemType.SettingValue, more bool, err error) {
 	var (
-		set         = make([]*systemType.ResourceTranslation, 0, DefaultSliceCapacity)
-		res         *systemType.ResourceTranslation
-		aux         *auxResourceTranslation
+		set         = make([]*systemType.SettingValue, 0, DefaultSliceCapacity)
+		res         *systemType.SettingValue
+		aux         *auxSettingValue
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ResourceTranslation != nil {
+	if s.Filters.SettingValue != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ResourceTranslation(s, f)
+		tExpr, f, err = s.Filters.SettingValue(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ResourceTranslationFilter(s.Dialect, f)
+		tExpr, f, err = SettingValueFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ResourceTranslation: %w", err)
+		err = fmt.Errorf("could generate filter expression for SettingValue: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableResourceTranslationFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := resourceTranslationSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableResourceTranslationFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ResourceTranslation: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := settingValueSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -20329,12 +23882,12 @@ func (s *Store) QueryResourceTranslations(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ResourceTranslation: %w", err)
+		err = fmt.Errorf("could not query SettingValue: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ResourceTranslation: %w", err)
+		err = fmt.Errorf("could not query SettingValue: %w", err)
 		return
 	}
 
@@ -20348,39 +23901,39 @@ func (s *Store) QueryResourceTranslations(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ResourceTranslation: %w", err)
+			err = fmt.Errorf("could not query SettingValue: %w", err)
 			return
 		}
 
-		aux = new(auxResourceTranslation)
+		aux = new(auxSettingValue)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ResourceTranslation: %w", err)
+			err = fmt.Errorf("could not scan rows for SettingValue: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ResourceTranslation: %w", err)
+			err = fmt.Errorf("could not decode SettingValue: %w", err)
 			return
 		}
 
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupResourceTranslationByID searches for resource translation by ID
-// It also returns deleted resource translations.
+// LookupSettingValueByNameOwnedBy searches for settings by name and owner
 //
 // This function is auto-generated
-func (s *Store) LookupResourceTranslationByID(ctx context.Context, id uint64) (_ *systemType.ResourceTranslation, err error) {
+func (s *Store) LookupSettingValueByNameOwnedBy(ctx context.Context, name string, ownedBy uint64) (_ *systemType.SettingValue, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxResourceTranslation)
-		lookup = resourceTranslationSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxSettingValue)
+		lookup = settingValueSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("name").Eq(name),
+			goqu.I("rel_owner").Eq(ownedBy),
 		).Limit(1)
 	)
 
@@ -20412,25 +23965,23 @@ func (s *Store) LookupResourceTranslationByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableResourceTranslationFields returns all <no value> columns flagged as sortable
+// sortableSettingValueFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableResourceTranslationFields() map[string]string {
+func (Store) sortableSettingValueFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
+		"name":       "name",
+		"owned_by":   "owned_by",
+		"ownedby":    "owned_by",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectResourceTranslationCursorValues collects values from the given resource that and sets them to the cursor
+// collectSettingValueCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -20442,26 +23993,26 @@ func (Store) sortableResourceTranslationFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectResourceTranslationCursorValues(res *systemType.ResourceTranslation, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectSettingValueCursorValues(res *systemType.SettingValue, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkOwnedBy bool
+		pkName    bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "id":
-					pkID = true
-					return res.ID
-				case "createdAt":
-					return res.CreatedAt
+				case "ownedBy":
+					pkOwnedBy = true
+					return res.OwnedBy
+				case "name":
+					pkName = true
+					return res.Name
 				case "updatedAt":
 					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
 				}
 				return nil
 			}
@@ -20486,35 +24037,38 @@ func (s *Store) collectResourceTranslationCursorValues(res *systemType.ResourceT
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkOwnedBy {
+		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
+	}
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkResourceTranslationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkSettingValueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkResourceTranslationConstraints(ctx context.Context, res *systemType.ResourceTranslation) (err error) {
+func (s *Store) checkSettingValueConstraints(ctx context.Context, res *systemType.SettingValue) (err error) {
 	return nil
 }
 
-// CreateRole creates one or more rows in role collection
+// CreateTemplate creates one or more rows in template collection
 //
 // This function is auto-generated
-func (s *Store) CreateRole(ctx context.Context, rr ...*systemType.Role) (err error) {
+func (s *Store) CreateTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
 	for i := range rr {
-		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, roleInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, templateInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -20522,16 +24076,16 @@ func (s *Store) CreateRole(ctx context.Context, rr ...*systemType.Role) (err err
 	return
 }
 
-// UpdateRole updates one or more existing entries in role collection
+// UpdateTemplate updates one or more existing entries in template collection
 //
 // This function is auto-generated
-func (s *Store) UpdateRole(ctx context.Context, rr ...*systemType.Role) (err error) {
+func (s *Store) UpdateTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
 	for i := range rr {
-		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, roleUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, templateUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -20539,12 +24093,12 @@ func (s *Store) UpdateRole(ctx context.Context, rr ...*systemType.Role) (err err
 	return
 }
 
-// UpsertRole updates one or more existing entries in role collection
+// UpsertTemplate updates one or more existing entries in template collection
 //
 // This function is auto-generated
-func (s *Store) UpsertRole(ctx context.Context, rr ...*systemType.Role) (err error) {
+func (s *Store) UpsertTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
 	for i := range rr {
-		if err = s.checkRoleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -20552,7 +24106,7 @@ func (s *Store) UpsertRole(ctx context.Context, rr ...*systemType.Role) (err err
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, roleUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, templateUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -20562,12 +24116,12 @@ func (s *Store) UpsertRole(ctx context.Context, rr ...*systemType.Role) (err err
 				continue
 			}
 
-			err = s.Exec(ctx, roleInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, templateInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, roleUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, templateUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -20577,12 +24131,12 @@ func (s *Store) UpsertRole(ctx context.Context, rr ...*systemType.Role) (err err
 	return
 }
 
-// DeleteRole Deletes one or more entries from role collection
+// DeleteTemplate Deletes one or more entries from template collection
 //
 // This function is auto-generated
-func (s *Store) DeleteRole(ctx context.Context, rr ...*systemType.Role) (err error) {
+func (s *Store) DeleteTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, roleDeleteQuery(s.Dialect.GOQU(), rolePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, templateDeleteQuery(s.Dialect.GOQU(), templatePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -20590,24 +24144,24 @@ func (s *Store) DeleteRole(ctx context.Context, rr ...*systemType.Role) (err err
 	return nil
 }
 
-// DeleteRoleByID deletes single entry from role collection
+// DeleteTemplateByID deletes single entry from template collection
 //
 // This function is auto-generated
-func (s *Store) DeleteRoleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, roleDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteTemplateByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, templateDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateRoles Deletes all rows from the role collection
-func (s *Store) TruncateRoles(ctx context.Context) error {
-	return s.Exec(ctx, roleTruncateQuery(s.Dialect.GOQU()))
+// TruncateTemplates Deletes all rows from the template collection
+func (s *Store) TruncateTemplates(ctx context.Context) error {
+	return s.Exec(ctx, templateTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchRoles returns (filtered) set of Roles
+// SearchTemplates returns (filtered) set of Templates
 //
 // This function is auto-generated
-func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set systemType.RoleSet, _ systemType.RoleFilter, err error) {
+func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter) (set systemType.TemplateSet, _ systemType.TemplateFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -20635,7 +24189,7 @@ func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set s
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfRoles fn used for cursor creation;
+	// Original are passed to the etchFullPageOfTemplates fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -20645,7 +24199,7 @@ func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set s
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfRoles(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfTemplates(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -20661,8 +24215,8 @@ func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set s
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.RoleSet
-			if navSet, _, _, err = s.fetchFullPageOfRoles(ctx, f, sort); err != nil {
+			var navSet systemType.TemplateSet
+			if navSet, _, _, err = s.fetchFullPageOfTemplates(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -20674,7 +24228,7 @@ func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set s
 	return set, f, nil
 }
 
-// fetchFullPageOfRoles collects all requested results.
+// fetchFullPageOfTemplates collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -20686,13 +24240,13 @@ func (s *Store) SearchRoles(ctx context.Context, f systemType.RoleFilter) (set s
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfRoles(
+func (s *Store) fetchFullPageOfTemplates(
 	ctx context.Context,
-	filter systemType.RoleFilter,
+	filter systemType.TemplateFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.Role, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Template, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.Role
+		aux []*systemType.Template
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -20711,10 +24265,10 @@ func (s *Store) fetchFullPageOfRoles(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.RoleFilter
+		tryFilter systemType.TemplateFilter
 	)
 
-	set = make([]*systemType.Role, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Template, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -20727,7 +24281,7 @@ func (s *Store) fetchFullPageOfRoles(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryRoles(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryTemplates(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -20757,7 +24311,7 @@ func (s *Store) fetchFullPageOfRoles(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectRoleCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectTemplateCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -20788,35 +24342,35 @@ func (s *Store) fetchFullPageOfRoles(
 	}
 
 	if hasPrev {
-		prev = s.collectRoleCursorValues(set[0], filter.Sort...)
+		prev = s.collectTemplateCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectRoleCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectTemplateCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryRoles queries the database, converts and checks each row and returns collected set
+// QueryTemplates queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryRoles(
+func (s *Store) QueryTemplates(
 	ctx context.Context,
-	f systemType.RoleFilter,
-) (_ []*systemType.Role, more bool, err error) {
+	f systemType.TemplateFilter,
+) (_ []*systemType.Template, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.Role, 0, DefaultSliceCapacity)
-		res         *systemType.Role
-		aux         *auxRole
+		set         = make([]*systemType.Template, 0, DefaultSliceCapacity)
+		res         *systemType.Template
+		aux         *auxTemplate
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -20824,16 +24378,16 @@ func (s *Store) QueryRoles(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Role != nil {
+	if s.Filters.Template != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Role(s, f)
+		tExpr, f, err = s.Filters.Template(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = RoleFilter(s.Dialect, f)
+		tExpr, f, err = TemplateFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Role: %w", err)
+		err = fmt.Errorf("could generate filter expression for Template: %w", err)
 		return
 	}
 
@@ -20841,18 +24395,18 @@ func (s *Store) QueryRoles(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableRoleFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableTemplateFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := roleSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := templateSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableRoleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Role: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableTemplateFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Template: %w", err)
 		return
 	}
 
@@ -20866,12 +24420,12 @@ func (s *Store) QueryRoles(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Role: %w", err)
+		err = fmt.Errorf("could not query Template: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Role: %w", err)
+		err = fmt.Errorf("could not query Template: %w", err)
 		return
 	}
 
@@ -20885,19 +24439,19 @@ func (s *Store) QueryRoles(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Role: %w", err)
+			err = fmt.Errorf("could not query Template: %w", err)
 			return
 		}
 
-		aux = new(auxRole)
+		aux = new(auxTemplate)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Role: %w", err)
+			err = fmt.Errorf("could not scan rows for Template: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Role: %w", err)
+			err = fmt.Errorf("could not decode Template: %w", err)
 			return
 		}
 
@@ -20918,16 +24472,16 @@ func (s *Store) QueryRoles(
 
 }
 
-// LookupRoleByID searches for role by ID
+// LookupTemplateByID searches for template by ID
 //
-// It returns role even if deleted or suspended
+// It also returns deleted templates.
 //
 // This function is auto-generated
-func (s *Store) LookupRoleByID(ctx context.Context, id uint64) (_ *systemType.Role, err error) {
+func (s *Store) LookupTemplateByID(ctx context.Context, id uint64) (_ *systemType.Template, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxRole)
-		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxTemplate)
+		lookup = templateSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -20960,16 +24514,16 @@ func (s *Store) LookupRoleByID(ctx context.Context, id uint64) (_ *systemType.Ro
 	return aux.decode()
 }
 
-// LookupRoleByHandle searches for role by handle
+// LookupTemplateByHandle searches for template by handle
 //
-// It returns only valid role (not deleted, not suspended)
+// It returns only valid templates (not deleted)
 //
 // This function is auto-generated
-func (s *Store) LookupRoleByHandle(ctx context.Context, handle string) (_ *systemType.Role, err error) {
+func (s *Store) LookupTemplateByHandle(ctx context.Context, handle string) (_ *systemType.Template, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxRole)
-		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxTemplate)
+		lookup = templateSelectQuery(s.Dialect.GOQU()).Where(
 			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
@@ -21003,72 +24557,31 @@ func (s *Store) LookupRoleByHandle(ctx context.Context, handle string) (_ *syste
 	return aux.decode()
 }
 
-// LookupRoleByName searches for role by name
-//
-// It returns only valid role (not deleted, not suspended)
-//
-// This function is auto-generated
-func (s *Store) LookupRoleByName(ctx context.Context, name string) (_ *systemType.Role, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxRole)
-		lookup = roleSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("name").Eq(name),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableRoleFields returns all <no value> columns flagged as sortable
+// sortableTemplateFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableRoleFields() map[string]string {
+func (Store) sortableTemplateFields() map[string]string {
 	return map[string]string{
-		"archived_at": "archived_at",
-		"archivedat":  "archived_at",
-		"created_at":  "created_at",
-		"createdat":   "created_at",
-		"deleted_at":  "deleted_at",
-		"deletedat":   "deleted_at",
-		"handle":      "handle",
-		"id":          "id",
-		"name":        "name",
-		"updated_at":  "updated_at",
-		"updatedat":   "updated_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"handle":       "handle",
+		"id":           "id",
+		"language":     "language",
+		"last_used_at": "last_used_at",
+		"lastusedat":   "last_used_at",
+		"template":     "template",
+		"type":         "type",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
 	}
 }
 
-// collectRoleCursorValues collects values from the given resource that and sets them to the cursor
+// collectTemplateCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -21080,7 +24593,7 @@ func (Store) sortableRoleFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectRoleCursorValues(res *systemType.Role, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectTemplateCursorValues(res *systemType.Template, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -21094,19 +24607,23 @@ func (s *Store) collectRoleCursorValues(res *systemType.Role, cc ...*filter.Sort
 				case "id":
 					pkID = true
 					return res.ID
-				case "name":
-					return res.Name
 				case "handle":
 					hasUnique = true
 					return res.Handle
-				case "archivedAt":
-					return res.ArchivedAt
+				case "language":
+					return res.Language
+				case "type":
+					return res.Type
+				case "template":
+					return res.Template
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
 					return res.UpdatedAt
 				case "deletedAt":
 					return res.DeletedAt
+				case "lastUsedAt":
+					return res.LastUsedAt
 				}
 				return nil
 			}
@@ -21139,14 +24656,14 @@ func (s *Store) collectRoleCursorValues(res *systemType.Role, cc ...*filter.Sort
 
 }
 
-// checkRoleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkTemplateConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkRoleConstraints(ctx context.Context, res *systemType.Role) (err error) {
+func (s *Store) checkTemplateConstraints(ctx context.Context, res *systemType.Template) (err error) {
 	err = func() (err error) {
 
 		// handling string type as default
@@ -21160,34 +24677,7 @@ func (s *Store) checkRoleConstraints(ctx context.Context, res *systemType.Role)
 			return nil
 		}
 
-		ex, err := s.LookupRoleByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Name) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupRoleByName(ctx, res.Name)
+		ex, err := s.LookupTemplateByHandle(ctx, res.Handle)
 		if err == nil && ex != nil && ex.ID != res.ID {
 			return store.ErrNotUnique.Stack(1)
 		} else if !errors.IsNotFound(err) {
@@ -21204,16 +24694,16 @@ func (s *Store) checkRoleConstraints(ctx context.Context, res *systemType.Role)
 	return nil
 }
 
-// CreateRoleMember creates one or more rows in roleMember collection
+// CreateUser creates one or more rows in user collection
 //
 // This function is auto-generated
-func (s *Store) CreateRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+func (s *Store) CreateUser(ctx context.Context, rr ...*systemType.User) (err error) {
 	for i := range rr {
-		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, roleMemberInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, userInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -21221,16 +24711,16 @@ func (s *Store) CreateRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 	return
 }
 
-// UpdateRoleMember updates one or more existing entries in roleMember collection
+// UpdateUser updates one or more existing entries in user collection
 //
 // This function is auto-generated
-func (s *Store) UpdateRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+func (s *Store) UpdateUser(ctx context.Context, rr ...*systemType.User) (err error) {
 	for i := range rr {
-		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, roleMemberUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, userUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -21238,12 +24728,12 @@ func (s *Store) UpdateRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 	return
 }
 
-// UpsertRoleMember updates one or more existing entries in roleMember collection
+// UpsertUser updates one or more existing entries in user collection
 //
 // This function is auto-generated
-func (s *Store) UpsertRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+func (s *Store) UpsertUser(ctx context.Context, rr ...*systemType.User) (err error) {
 	for i := range rr {
-		if err = s.checkRoleMemberConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -21251,7 +24741,7 @@ func (s *Store) UpsertRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, roleMemberUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, userUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -21261,12 +24751,12 @@ func (s *Store) UpsertRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 				continue
 			}
 
-			err = s.Exec(ctx, roleMemberInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, userInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, roleMemberUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, userUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -21276,12 +24766,12 @@ func (s *Store) UpsertRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 	return
 }
 
-// DeleteRoleMember Deletes one or more entries from roleMember collection
+// DeleteUser Deletes one or more entries from user collection
 //
 // This function is auto-generated
-func (s *Store) DeleteRoleMember(ctx context.Context, rr ...*systemType.RoleMember) (err error) {
+func (s *Store) DeleteUser(ctx context.Context, rr ...*systemType.User) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, roleMemberDeleteQuery(s.Dialect.GOQU(), roleMemberPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, userDeleteQuery(s.Dialect.GOQU(), userPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -21289,370 +24779,436 @@ func (s *Store) DeleteRoleMember(ctx context.Context, rr ...*systemType.RoleMemb
 	return nil
 }
 
-// DeleteRoleMemberByUserIDRoleID deletes single entry from roleMember collection
+// DeleteUserByID deletes single entry from user collection
 //
 // This function is auto-generated
-func (s *Store) DeleteRoleMemberByUserIDRoleID(ctx context.Context, userID uint64, roleID uint64) error {
-	return s.Exec(ctx, roleMemberDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"rel_user": userID,
-		"rel_role": roleID,
+func (s *Store) DeleteUserByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, userDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateRoleMembers Deletes all rows from the roleMember collection
-func (s *Store) TruncateRoleMembers(ctx context.Context) error {
-	return s.Exec(ctx, roleMemberTruncateQuery(s.Dialect.GOQU()))
+// TruncateUsers Deletes all rows from the user collection
+func (s *Store) TruncateUsers(ctx context.Context) error {
+	return s.Exec(ctx, userTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchRoleMembers returns (filtered) set of RoleMembers
+// SearchUsers returns (filtered) set of Users
 //
 // This function is auto-generated
-func (s *Store) SearchRoleMembers(ctx context.Context, f systemType.RoleMemberFilter) (set systemType.RoleMemberSet, _ systemType.RoleMemberFilter, err error) {
+func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set systemType.UserSet, _ systemType.UserFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		if f.IncPageNavigation || f.IncTotal {
+			return nil, f, fmt.Errorf("not allowed to fetch page navigation or total item count with page cursor")
+		}
+
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfUsers fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfUsers(ctx, f, sort)
 
-	set, _, err = s.QueryRoleMembers(ctx, f)
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
 
+	if f.IncTotal {
+		// Calc total from the number of items fetched
+		// even if we do build the page navigation
+		f.Total = uint(len(set))
+
+		if f.Limit > 0 && uint(len(set)) == f.Limit {
+			// there are fewer items fetched then requested limit
+			limit := f.Limit
+			f.Limit = 0
+			var navSet systemType.UserSet
+			if navSet, _, _, err = s.fetchFullPageOfUsers(ctx, f, sort); err != nil {
+				return
+			} else {
+				f.Total = uint(len(navSet))
+				f.Limit = limit
+			}
+		}
+	}
+
 	return set, f, nil
 }
 
-// QueryRoleMembers queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfUsers collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryRoleMembers(
+func (s *Store) fetchFullPageOfUsers(
 	ctx context.Context,
-	f systemType.RoleMemberFilter,
-) (_ []*systemType.RoleMember, more bool, err error) {
+	filter systemType.UserFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.User, prev, next *filter.PagingCursor, err error) {
 	var (
-		set         = make([]*systemType.RoleMember, 0, DefaultSliceCapacity)
-		res         *systemType.RoleMember
-		aux         *auxRoleMember
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		aux []*systemType.User
 
-	if s.Filters.RoleMember != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.RoleMember(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = RoleMemberFilter(s.Dialect, f)
-	}
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for RoleMember: %w", err)
-		return
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	expr = append(expr, tExpr...)
+		reqItems = filter.Limit
 
-	query := roleMemberSelectQuery(s.Dialect.GOQU()).Where(expr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query RoleMember: %w", err)
-		return
-	}
+		tryFilter systemType.UserFilter
+	)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query RoleMember: %w", err)
-		return
-	}
+	set = make([]*systemType.User, 0, DefaultSliceCapacity)
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
 		}
-	}()
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query RoleMember: %w", err)
-			return
+		if aux, hasNext, err = s.QueryUsers(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
 		}
 
-		aux = new(auxRoleMember)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for RoleMember: %w", err)
-			return
+		if len(aux) == 0 {
+			// nothing fetched
+			break
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode RoleMember: %w", err)
-			return
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
 		}
 
-		set = append(set, res)
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectUserCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
 	}
 
-	return set, false, err
+	collected := len(set)
 
-}
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
 
-// sortableRoleMemberFields returns all <no value> columns flagged as sortable
-//
-// # Notes
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableRoleMemberFields() map[string]string {
-	return map[string]string{
-		"role_id": "role_id",
-		"roleid":  "role_id",
-		"user_id": "user_id",
-		"userid":  "user_id",
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectUserCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
 	}
+
+	if hasNext {
+		next = s.collectUserCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
 }
 
-// collectRoleMemberCursorValues collects values from the given resource that and sets them to the cursor
-// to be used for pagination
-//
-// Values that are collected must come from sortable, unique or primary columns/fields
-// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
-//
-// # Known issues:
+// QueryUsers queries the database, converts and checks each row and returns collected set
 //
-// When collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
-// undeleted items)
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) collectRoleMemberCursorValues(res *systemType.RoleMember, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) QueryUsers(
+	ctx context.Context,
+	f systemType.UserFilter,
+) (_ []*systemType.User, more bool, err error) {
 	var (
-		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
-
-		hasUnique bool
-
-		pkUserID bool
-		pkRoleID bool
+		ok bool
 
-		collect = func(cc ...*filter.SortExpr) {
-			getVal := func(col string) interface{} {
-				switch col {
-				case "userID":
-					pkUserID = true
-					return res.UserID
-				case "roleID":
-					pkRoleID = true
-					return res.RoleID
-				}
-				return nil
-			}
+		set         = make([]*systemType.User, 0, DefaultSliceCapacity)
+		res         *systemType.User
+		aux         *auxUser
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
 
-			for _, c := range cc {
-				switch c.Modifier() {
-				case filter.COALESCE:
-					var val interface{}
-					for _, col := range c.Columns() {
-						if reflect2.IsNil(val) {
-							val = getVal(col)
-						}
-					}
-					cur.SetModifier(c.Column, val, c.Descending, c.Modifier(), c.Columns()...)
-				default:
-					cur.Set(c.Column, getVal(c.Column), c.Descending)
-				}
-			}
-		}
+		sortExpr []exp.OrderedExpression
 	)
 
-	_ = hasUnique
+	if s.Filters.User != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.User(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = UserFilter(s.Dialect, f)
+	}
 
-	collect(cc...)
-	if !hasUnique || !pkUserID {
-		collect(&filter.SortExpr{Column: "userID", Descending: false})
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for User: %w", err)
+		return
 	}
-	if !hasUnique || !pkRoleID {
-		collect(&filter.SortExpr{Column: "roleID", Descending: false})
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableUserFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
 	}
 
-	return cur
+	query := userSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
-}
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableUserFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for User: %w", err)
+		return
+	}
 
-// checkRoleMemberConstraints performs lookups (on valid) resource to check if any of the values on unique fields
-// already exists in the store
-//
-// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
-// on the full support (MySQL does not support conditional indexes)
-//
-// This function is auto-generated
-func (s *Store) checkRoleMemberConstraints(ctx context.Context, res *systemType.RoleMember) (err error) {
-	return nil
-}
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
-// CreateSettingValue creates one or more rows in settingValue collection
-//
-// This function is auto-generated
-func (s *Store) CreateSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
-	for i := range rr {
-		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
-			return
-		}
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-		if err = s.Exec(ctx, settingValueInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
-			return
-		}
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query User: %w", err)
+		return
 	}
 
-	return
-}
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query User: %w", err)
+		return
+	}
 
-// UpdateSettingValue updates one or more existing entries in settingValue collection
-//
-// This function is auto-generated
-func (s *Store) UpdateSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
-	for i := range rr {
-		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
-			return
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		if err = s.Exec(ctx, settingValueUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query User: %w", err)
 			return
 		}
-	}
 
-	return
-}
+		aux = new(auxUser)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for User: %w", err)
+			return
+		}
 
-// UpsertSettingValue updates one or more existing entries in settingValue collection
-//
-// This function is auto-generated
-func (s *Store) UpsertSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
-	for i := range rr {
-		if err = s.checkSettingValueConstraints(ctx, rr[i]); err != nil {
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode User: %w", err)
 			return
 		}
 
-		// @todo this solution is ok for now but could be problematic when we start
-		// batching together DB operations.
-		if s.Dialect.Nuances().TwoStepUpsert {
-			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, settingValueUpdateQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
 				return
-			}
-			if c, err := rsp.RowsAffected(); err != nil {
-				return err
-			} else if c > 0 {
+			} else if !ok {
 				continue
 			}
-
-			err = s.Exec(ctx, settingValueInsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
-		} else {
-			err = s.Exec(ctx, settingValueUpsertQuery(s.Dialect.GOQU(), rr[i]))
-			if err != nil {
-				return
-			}
 		}
+
+		set = append(set, res)
 	}
 
-	return
+	return set, f.Limit > 0 && count >= f.Limit, err
+
 }
 
-// DeleteSettingValue Deletes one or more entries from settingValue collection
+// LookupUserByID searches for user by ID
+//
+// It returns user even if deleted or suspended
 //
 // This function is auto-generated
-func (s *Store) DeleteSettingValue(ctx context.Context, rr ...*systemType.SettingValue) (err error) {
-	for i := range rr {
-		if err = s.Exec(ctx, settingValueDeleteQuery(s.Dialect.GOQU(), settingValuePrimaryKeys(rr[i]))); err != nil {
-			return
-		}
-	}
+func (s *Store) LookupUserByID(ctx context.Context, id uint64) (_ *systemType.User, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxUser)
+		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
 
-	return nil
-}
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
 
-// DeleteSettingValueByOwnedByName deletes single entry from settingValue collection
-//
-// This function is auto-generated
-func (s *Store) DeleteSettingValueByOwnedByName(ctx context.Context, ownedBy uint64, name string) error {
-	return s.Exec(ctx, settingValueDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
-		"rel_owner": ownedBy,
-		"name":      name,
-	}))
-}
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
 
-// TruncateSettingValues Deletes all rows from the settingValue collection
-func (s *Store) TruncateSettingValues(ctx context.Context) error {
-	return s.Exec(ctx, settingValueTruncateQuery(s.Dialect.GOQU()))
-}
+	if err = rows.Err(); err != nil {
+		return
+	}
 
-// SearchSettingValues returns (filtered) set of SettingValues
-//
-// This function is auto-generated
-func (s *Store) SearchSettingValues(ctx context.Context, f systemType.SettingsFilter) (set systemType.SettingValueSet, _ systemType.SettingsFilter, err error) {
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
 
-	set, _, err = s.QuerySettingValues(ctx, f)
-	if err != nil {
-		return nil, f, err
+	if err = aux.scan(rows); err != nil {
+		return
 	}
 
-	return set, f, nil
+	return aux.decode()
 }
 
-// QuerySettingValues queries the database, converts and checks each row and returns collected set
+// LookupUserByEmail searches for user by email
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// It returns only valid user (not deleted, not suspended)
 //
 // This function is auto-generated
-func (s *Store) QuerySettingValues(
-	ctx context.Context,
-	f systemType.SettingsFilter,
-) (_ []*systemType.SettingValue, more bool, err error) {
+func (s *Store) LookupUserByEmail(ctx context.Context, email string) (_ *systemType.User, err error) {
 	var (
-		set         = make([]*systemType.SettingValue, 0, DefaultSliceCapacity)
-		res         *systemType.SettingValue
-		aux         *auxSettingValue
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
+		rows   *sql.Rows
+		aux    = new(auxUser)
+		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("email")).Eq(strings.ToLower(email)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
 	)
 
-	if s.Filters.SettingValue != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.SettingValue(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = SettingValueFilter(s.Dialect, f)
-	}
-
+	rows, err = s.Query(ctx, lookup)
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for SettingValue: %w", err)
 		return
 	}
 
-	expr = append(expr, tExpr...)
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
 
-	query := settingValueSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	if err = rows.Err(); err != nil {
+		return
+	}
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query SettingValue: %w", err)
+	if err = aux.scan(rows); err != nil {
 		return
 	}
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query SettingValue: %w", err)
+	return aux.decode()
+}
+
+// LookupUserByHandle searches for user by handle
+//
+// It returns only valid user (not deleted, not suspended)
+//
+// This function is auto-generated
+func (s *Store) LookupUserByHandle(ctx context.Context, handle string) (_ *systemType.User, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxUser)
+		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
 		return
 	}
 
@@ -21664,41 +25220,33 @@ func (s *Store) QuerySettingValues(
 		}
 	}()
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query SettingValue: %w", err)
-			return
-		}
-
-		aux = new(auxSettingValue)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for SettingValue: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode SettingValue: %w", err)
-			return
-		}
+	if err = rows.Err(); err != nil {
+		return
+	}
 
-		set = append(set, res)
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	return set, false, err
+	if err = aux.scan(rows); err != nil {
+		return
+	}
 
+	return aux.decode()
 }
 
-// LookupSettingValueByNameOwnedBy searches for settings by name and owner
+// LookupUserByUsername searches for user by username
+//
+// It returns only valid user (not deleted, not suspended)
 //
 // This function is auto-generated
-func (s *Store) LookupSettingValueByNameOwnedBy(ctx context.Context, name string, ownedBy uint64) (_ *systemType.SettingValue, err error) {
+func (s *Store) LookupUserByUsername(ctx context.Context, username string) (_ *systemType.User, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxSettingValue)
-		lookup = settingValueSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("name").Eq(name),
-			goqu.I("rel_owner").Eq(ownedBy),
+		aux    = new(auxUser)
+		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
+			s.Functions.LOWER(goqu.I("username")).Eq(strings.ToLower(username)),
+			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
 	)
 
@@ -21730,23 +25278,32 @@ func (s *Store) LookupSettingValueByNameOwnedBy(ctx context.Context, name string
 	return aux.decode()
 }
 
-// sortableSettingValueFields returns all <no value> columns flagged as sortable
+// sortableUserFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableSettingValueFields() map[string]string {
+func (Store) sortableUserFields() map[string]string {
 	return map[string]string{
-		"name":       "name",
-		"owned_by":   "owned_by",
-		"ownedby":    "owned_by",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"email":        "email",
+		"handle":       "handle",
+		"id":           "id",
+		"kind":         "kind",
+		"name":         "name",
+		"suspended_at": "suspended_at",
+		"suspendedat":  "suspended_at",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
+		"username":     "username",
 	}
 }
 
-// collectSettingValueCursorValues collects values from the given resource that and sets them to the cursor
+// collectUserCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -21758,26 +25315,41 @@ func (Store) sortableSettingValueFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectSettingValueCursorValues(res *systemType.SettingValue, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectUserCursorValues(res *systemType.User, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkOwnedBy bool
-		pkName    bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			getVal := func(col string) interface{} {
 				switch col {
-				case "ownedBy":
-					pkOwnedBy = true
-					return res.OwnedBy
+				case "id":
+					pkID = true
+					return res.ID
+				case "email":
+					hasUnique = true
+					return res.Email
+				case "username":
+					hasUnique = true
+					return res.Username
 				case "name":
-					pkName = true
 					return res.Name
+				case "handle":
+					hasUnique = true
+					return res.Handle
+				case "kind":
+					return res.Kind
+				case "suspendedAt":
+					return res.SuspendedAt
+				case "createdAt":
+					return res.CreatedAt
 				case "updatedAt":
 					return res.UpdatedAt
+				case "deletedAt":
+					return res.DeletedAt
 				}
 				return nil
 			}
@@ -21802,38 +25374,116 @@ func (s *Store) collectSettingValueCursorValues(res *systemType.SettingValue, cc
 	_ = hasUnique
 
 	collect(cc...)
-	if !hasUnique || !pkOwnedBy {
-		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
-	}
-	if !hasUnique || !pkName {
-		collect(&filter.SortExpr{Column: "name", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkSettingValueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkUserConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkSettingValueConstraints(ctx context.Context, res *systemType.SettingValue) (err error) {
+func (s *Store) checkUserConstraints(ctx context.Context, res *systemType.User) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Email) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupUserByEmail(ctx, res.Email)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupUserByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Username) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupUserByUsername(ctx, res.Username)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateTemplate creates one or more rows in template collection
+// CreateWebhook creates one or more rows in webhook collection
 //
 // This function is auto-generated
-func (s *Store) CreateTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
+func (s *Store) CreateWebhook(ctx context.Context, rr ...*systemType.Webhook) (err error) {
 	for i := range rr {
-		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, templateInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, webhookInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -21841,16 +25491,16 @@ func (s *Store) CreateTemplate(ctx context.Context, rr ...*systemType.Template)
 	return
 }
 
-// UpdateTemplate updates one or more existing entries in template collection
+// UpdateWebhook updates one or more existing entries in webhook collection
 //
 // This function is auto-generated
-func (s *Store) UpdateTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
+func (s *Store) UpdateWebhook(ctx context.Context, rr ...*systemType.Webhook) (err error) {
 	for i := range rr {
-		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, templateUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, webhookUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -21858,12 +25508,12 @@ func (s *Store) UpdateTemplate(ctx context.Context, rr ...*systemType.Template)
 	return
 }
 
-// UpsertTemplate updates one or more existing entries in template collection
+// UpsertWebhook updates one or more existing entries in webhook collection
 //
 // This function is auto-generated
-func (s *Store) UpsertTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
+func (s *Store) UpsertWebhook(ctx context.Context, rr ...*systemType.Webhook) (err error) {
 	for i := range rr {
-		if err = s.checkTemplateConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -21871,7 +25521,7 @@ func (s *Store) UpsertTemplate(ctx context.Context, rr ...*systemType.Template)
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, templateUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, webhookUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -21881,12 +25531,12 @@ func (s *Store) UpsertTemplate(ctx context.Context, rr ...*systemType.Template)
 				continue
 			}
 
-			err = s.Exec(ctx, templateInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, webhookInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, templateUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, webhookUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -21896,12 +25546,12 @@ func (s *Store) UpsertTemplate(ctx context.Context, rr ...*systemType.Template)
 	return
 }
 
-// DeleteTemplate Deletes one or more entries from template collection
+// DeleteWebhook Deletes one or more entries from webhook collection
 //
 // This function is auto-generated
-func (s *Store) DeleteTemplate(ctx context.Context, rr ...*systemType.Template) (err error) {
+func (s *Store) DeleteWebhook(ctx context.Context, rr ...*systemType.Webhook) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, templateDeleteQuery(s.Dialect.GOQU(), templatePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, webhookDeleteQuery(s.Dialect.GOQU(), webhookPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -21909,24 +25559,24 @@ func (s *Store) DeleteTemplate(ctx context.Context, rr ...*systemType.Template)
 	return nil
 }
 
-// DeleteTemplateByID deletes single entry from template collection
+// DeleteWebhookByID deletes single entry from webhook collection
 //
 // This function is auto-generated
-func (s *Store) DeleteTemplateByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, templateDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteWebhookByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, webhookDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateTemplates Deletes all rows from the template collection
-func (s *Store) TruncateTemplates(ctx context.Context) error {
-	return s.Exec(ctx, templateTruncateQuery(s.Dialect.GOQU()))
+// TruncateWebhooks Deletes all rows from the webhook collection
+func (s *Store) TruncateWebhooks(ctx context.Context) error {
+	return s.Exec(ctx, webhookTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchTemplates returns (filtered) set of Templates
+// SearchWebhooks returns (filtered) set of Webhooks
 //
 // This function is auto-generated
-func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter) (set systemType.TemplateSet, _ systemType.TemplateFilter, err error) {
+func (s *Store) SearchWebhooks(ctx context.Context, f systemType.WebhookFilter) (set systemType.WebhookSet, _ systemType.WebhookFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -21954,7 +25604,7 @@ func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfTemplates fn used for cursor creation;
+	// Original are passed to the etchFullPageOfWebhooks fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -21964,7 +25614,7 @@ func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfTemplates(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfWebhooks(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -21980,8 +25630,8 @@ func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.TemplateSet
-			if navSet, _, _, err = s.fetchFullPageOfTemplates(ctx, f, sort); err != nil {
+			var navSet systemType.WebhookSet
+			if navSet, _, _, err = s.fetchFullPageOfWebhooks(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -21993,7 +25643,7 @@ func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter
 	return set, f, nil
 }
 
-// fetchFullPageOfTemplates collects all requested results.
+// fetchFullPageOfWebhooks collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -22005,13 +25655,13 @@ func (s *Store) SearchTemplates(ctx context.Context, f systemType.TemplateFilter
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfTemplates(
+func (s *Store) fetchFullPageOfWebhooks(
 	ctx context.Context,
-	filter systemType.TemplateFilter,
+	filter systemType.WebhookFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.Template, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Webhook, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.Template
+		aux []*systemType.Webhook
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -22030,10 +25680,10 @@ func (s *Store) fetchFullPageOfTemplates(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.TemplateFilter
+		tryFilter systemType.WebhookFilter
 	)
 
-	set = make([]*systemType.Template, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Webhook, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -22046,7 +25696,7 @@ func (s *Store) fetchFullPageOfTemplates(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryTemplates(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryWebhooks(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -22076,7 +25726,7 @@ func (s *Store) fetchFullPageOfTemplates(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectTemplateCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectWebhookCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -22107,35 +25757,35 @@ func (s *Store) fetchFullPageOfTemplates(
 	}
 
 	if hasPrev {
-		prev = s.collectTemplateCursorValues(set[0], filter.Sort...)
+		prev = s.collectWebhookCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectTemplateCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectWebhookCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryTemplates queries the database, converts and checks each row and returns collected set
+// QueryWebhooks queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryTemplates(
+func (s *Store) QueryWebhooks(
 	ctx context.Context,
-	f systemType.TemplateFilter,
-) (_ []*systemType.Template, more bool, err error) {
+	f systemType.WebhookFilter,
+) (_ []*systemType.Webhook, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.Template, 0, DefaultSliceCapacity)
-		res         *systemType.Template
-		aux         *auxTemplate
+		set         = make([]*systemType.Webhook, 0, DefaultSliceCapacity)
+		res         *systemType.Webhook
+		aux         *auxWebhook
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -22143,16 +25793,16 @@ func (s *Store) QueryTemplates(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Template != nil {
+	if s.Filters.Webhook != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Template(s, f)
+		tExpr, f, err = s.Filters.Webhook(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = TemplateFilter(s.Dialect, f)
+		tExpr, f, err = WebhookFilter(s.Dialect, f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Template: %w", err)
+		err = fmt.Errorf("could generate filter expression for Webhook: %w", err)
 		return
 	}
 
@@ -22160,18 +25810,18 @@ func (s *Store) QueryTemplates(
 
 	// paging feature is enabled
 	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableTemplateFields()); err != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableWebhookFields()); err != nil {
 			return
 		} else {
 			expr = append(expr, tExpr...)
 		}
 	}
 
-	query := templateSelectQuery(s.Dialect.GOQU()).Where(expr...)
+	query := webhookSelectQuery(s.Dialect.GOQU()).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableTemplateFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Template: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableWebhookFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Webhook: %w", err)
 		return
 	}
 
@@ -22185,12 +25835,12 @@ func (s *Store) QueryTemplates(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Template: %w", err)
+		err = fmt.Errorf("could not query Webhook: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Template: %w", err)
+		err = fmt.Errorf("could not query Webhook: %w", err)
 		return
 	}
 
@@ -22204,19 +25854,19 @@ func (s *Store) QueryTemplates(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Template: %w", err)
+			err = fmt.Errorf("could not query Webhook: %w", err)
 			return
 		}
 
-		aux = new(auxTemplate)
+		aux = new(auxWebhook)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Template: %w", err)
+			err = fmt.Errorf("could not scan rows for Webhook: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Template: %w", err)
+			err = fmt.Errorf("could not decode Webhook: %w", err)
 			return
 		}
 
@@ -22237,16 +25887,16 @@ func (s *Store) QueryTemplates(
 
 }
 
-// LookupTemplateByID searches for template by ID
+// LookupWebhookByID searches for webhook by ID
 //
-// It also returns deleted templates.
+// It returns webhook even if deleted or disabled
 //
 // This function is auto-generated
-func (s *Store) LookupTemplateByID(ctx context.Context, id uint64) (_ *systemType.Template, err error) {
+func (s *Store) LookupWebhookByID(ctx context.Context, id uint64) (_ *systemType.Webhook, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxTemplate)
-		lookup = templateSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxWebhook)
+		lookup = webhookSelectQuery(s.Dialect.GOQU()).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -22279,16 +25929,16 @@ func (s *Store) LookupTemplateByID(ctx context.Context, id uint64) (_ *systemTyp
 	return aux.decode()
 }
 
-// LookupTemplateByHandle searches for template by handle
+// LookupWebhookByHandle searches for webhook by handle
 //
-// It returns only valid templates (not deleted)
+// It returns only valid webhook (not deleted)
 //
 // This function is auto-generated
-func (s *Store) LookupTemplateByHandle(ctx context.Context, handle string) (_ *systemType.Template, err error) {
+func (s *Store) LookupWebhookByHandle(ctx context.Context, handle string) (_ *systemType.Webhook, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxTemplate)
-		lookup = templateSelectQuery(s.Dialect.GOQU()).Where(
+		aux    = new(auxWebhook)
+		lookup = webhookSelectQuery(s.Dialect.GOQU()).Where(
 			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
 			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
 		).Limit(1)
@@ -22322,31 +25972,28 @@ func (s *Store) LookupTemplateByHandle(ctx context.Context, handle string) (_ *s
 	return aux.decode()
 }
 
-// sortableTemplateFields returns all <no value> columns flagged as sortable
+// sortableWebhookFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableTemplateFields() map[string]string {
+func (Store) sortableWebhookFields() map[string]string {
 	return map[string]string{
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"handle":       "handle",
-		"id":           "id",
-		"language":     "language",
-		"last_used_at": "last_used_at",
-		"lastusedat":   "last_used_at",
-		"template":     "template",
-		"type":         "type",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"enabled":    "enabled",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+		"url":        "url",
 	}
 }
 
-// collectTemplateCursorValues collects values from the given resource that and sets them to the cursor
+// collectWebhookCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -22358,7 +26005,7 @@ func (Store) sortableTemplateFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectTemplateCursorValues(res *systemType.Template, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectWebhookCursorValues(res *systemType.Webhook, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -22375,20 +26022,16 @@ func (s *Store) collectTemplateCursorValues(res *systemType.Template, cc ...*fil
 				case "handle":
 					hasUnique = true
 					return res.Handle
-				case "language":
-					return res.Language
-				case "type":
-					return res.Type
-				case "template":
-					return res.Template
+				case "url":
+					return res.URL
+				case "enabled":
+					return res.Enabled
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
 					return res.UpdatedAt
 				case "deletedAt":
 					return res.DeletedAt
-				case "lastUsedAt":
-					return res.LastUsedAt
 				}
 				return nil
 			}
@@ -22421,14 +26064,14 @@ func (s *Store) collectTemplateCursorValues(res *systemType.Template, cc ...*fil
 
 }
 
-// checkTemplateConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkWebhookConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkTemplateConstraints(ctx context.Context, res *systemType.Template) (err error) {
+func (s *Store) checkWebhookConstraints(ctx context.Context, res *systemType.Webhook) (err error) {
 	err = func() (err error) {
 
 		// handling string type as default
@@ -22442,7 +26085,7 @@ func (s *Store) checkTemplateConstraints(ctx context.Context, res *systemType.Te
 			return nil
 		}
 
-		ex, err := s.LookupTemplateByHandle(ctx, res.Handle)
+		ex, err := s.LookupWebhookByHandle(ctx, res.Handle)
 		if err == nil && ex != nil && ex.ID != res.ID {
 			return store.ErrNotUnique.Stack(1)
 		} else if !errors.IsNotFound(err) {
@@ -22459,16 +26102,16 @@ func (s *Store) checkTemplateConstraints(ctx context.Context, res *systemType.Te
 	return nil
 }
 
-// CreateUser creates one or more rows in user collection
+// CreateWebhookDelivery creates one or more rows in webhookDelivery collection
 //
 // This function is auto-generated
-func (s *Store) CreateUser(ctx context.Context, rr ...*systemType.User) (err error) {
+func (s *Store) CreateWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, userInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, webhookDeliveryInsertQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -22476,16 +26119,16 @@ func (s *Store) CreateUser(ctx context.Context, rr ...*systemType.User) (err err
 	return
 }
 
-// UpdateUser updates one or more existing entries in user collection
+// UpdateWebhookDelivery updates one or more existing entries in webhookDelivery collection
 //
 // This function is auto-generated
-func (s *Store) UpdateUser(ctx context.Context, rr ...*systemType.User) (err error) {
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, userUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
+		if err = s.Exec(ctx, webhookDeliveryUpdateQuery(s.Dialect.GOQU(), rr[i])); err != nil {
 			return
 		}
 	}
@@ -22493,12 +26136,12 @@ func (s *Store) UpdateUser(ctx context.Context, rr ...*systemType.User) (err err
 	return
 }
 
-// UpsertUser updates one or more existing entries in user collection
+// UpsertWebhookDelivery updates one or more existing entries in webhookDelivery collection
 //
 // This function is auto-generated
-func (s *Store) UpsertUser(ctx context.Context, rr ...*systemType.User) (err error) {
+func (s *Store) UpsertWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) (err error) {
 	for i := range rr {
-		if err = s.checkUserConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkWebhookDeliveryConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
@@ -22506,7 +26149,7 @@ func (s *Store) UpsertUser(ctx context.Context, rr ...*systemType.User) (err err
 		// batching together DB operations.
 		if s.Dialect.Nuances().TwoStepUpsert {
 			var rsp sql.Result
-			rsp, err = s.ExecR(ctx, userUpdateQuery(s.Dialect.GOQU(), rr[i]))
+			rsp, err = s.ExecR(ctx, webhookDeliveryUpdateQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -22516,12 +26159,12 @@ func (s *Store) UpsertUser(ctx context.Context, rr ...*systemType.User) (err err
 				continue
 			}
 
-			err = s.Exec(ctx, userInsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, webhookDeliveryInsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
 		} else {
-			err = s.Exec(ctx, userUpsertQuery(s.Dialect.GOQU(), rr[i]))
+			err = s.Exec(ctx, webhookDeliveryUpsertQuery(s.Dialect.GOQU(), rr[i]))
 			if err != nil {
 				return
 			}
@@ -22531,12 +26174,12 @@ func (s *Store) UpsertUser(ctx context.Context, rr ...*systemType.User) (err err
 	return
 }
 
-// DeleteUser Deletes one or more entries from user collection
+// DeleteWebhookDelivery Deletes one or more entries from webhookDelivery collection
 //
 // This function is auto-generated
-func (s *Store) DeleteUser(ctx context.Context, rr ...*systemType.User) (err error) {
+func (s *Store) DeleteWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, userDeleteQuery(s.Dialect.GOQU(), userPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, webhookDeliveryDeleteQuery(s.Dialect.GOQU(), webhookDeliveryPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -22544,24 +26187,24 @@ func (s *Store) DeleteUser(ctx context.Context, rr ...*systemType.User) (err err
 	return nil
 }
 
-// DeleteUserByID deletes single entry from user collection
+// DeleteWebhookDeliveryByID deletes single entry from webhookDelivery collection
 //
 // This function is auto-generated
-func (s *Store) DeleteUserByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, userDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
+func (s *Store) DeleteWebhookDeliveryByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, webhookDeliveryDeleteQuery(s.Dialect.GOQU(), goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateUsers Deletes all rows from the user collection
-func (s *Store) TruncateUsers(ctx context.Context) error {
-	return s.Exec(ctx, userTruncateQuery(s.Dialect.GOQU()))
+// TruncateWebhookDeliverys Deletes all rows from the webhookDelivery collection
+func (s *Store) TruncateWebhookDeliverys(ctx context.Context) error {
+	return s.Exec(ctx, webhookDeliveryTruncateQuery(s.Dialect.GOQU()))
 }
 
-// SearchUsers returns (filtered) set of Users
+// SearchWebhookDeliverys returns (filtered) set of WebhookDeliverys
 //
 // This function is auto-generated
-func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set systemType.UserSet, _ systemType.UserFilter, err error) {
+func (s *Store) SearchWebhookDeliverys(ctx context.Context, f systemType.WebhookDeliveryFilter) (set systemType.WebhookDeliverySet, _ systemType.WebhookDeliveryFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -22589,7 +26232,7 @@ func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set s
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfUsers fn used for cursor creation;
+	// Original are passed to the etchFullPageOfWebhookDeliverys fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -22599,7 +26242,7 @@ func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set s
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfUsers(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfWebhookDeliverys(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -22615,8 +26258,8 @@ func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set s
 			// there are fewer items fetched then requested limit
 			limit := f.Limit
 			f.Limit = 0
-			var navSet systemType.UserSet
-			if navSet, _, _, err = s.fetchFullPageOfUsers(ctx, f, sort); err != nil {
+			var navSet systemType.WebhookDeliverySet
+			if navSet, _, _, err = s.fetchFullPageOfWebhookDeliverys(ctx, f, sort); err != nil {
 				return
 			} else {
 				f.Total = uint(len(navSet))
@@ -22628,7 +26271,7 @@ func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set s
 	return set, f, nil
 }
 
-// fetchFullPageOfUsers collects all requested results.
+// fetchFullPageOfWebhookDeliverys collects all requested results.
 //
 // Function applies:
 //   - cursor conditions (where ...)
@@ -22640,13 +26283,13 @@ func (s *Store) SearchUsers(ctx context.Context, f systemType.UserFilter) (set s
 // # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfUsers(
+func (s *Store) fetchFullPageOfWebhookDeliverys(
 	ctx context.Context,
-	filter systemType.UserFilter,
+	filter systemType.WebhookDeliveryFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.User, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.WebhookDelivery, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.User
+		aux []*systemType.WebhookDelivery
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -22665,10 +26308,10 @@ func (s *Store) fetchFullPageOfUsers(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.UserFilter
+		tryFilter systemType.WebhookDeliveryFilter
 	)
 
-	set = make([]*systemType.User, 0, DefaultSliceCapacity)
+	set = make([]*systemType.WebhookDelivery, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -22681,7 +26324,7 @@ func (s *Store) fetchFullPageOfUsers(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryUsers(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryWebhookDeliverys(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -22711,7 +26354,7 @@ func (s *Store) fetchFullPageOfUsers(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectUserCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectWebhookDeliveryCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -22727,253 +26370,103 @@ func (s *Store) fetchFullPageOfUsers(
 
 	collected := len(set)
 
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectUserCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectUserCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryUsers queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryUsers(
-	ctx context.Context,
-	f systemType.UserFilter,
-) (_ []*systemType.User, more bool, err error) {
-	var (
-		ok bool
-
-		set         = make([]*systemType.User, 0, DefaultSliceCapacity)
-		res         *systemType.User
-		aux         *auxUser
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
-	)
-
-	if s.Filters.User != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.User(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = UserFilter(s.Dialect, f)
-	}
-
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for User: %w", err)
-		return
-	}
-
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableUserFields()); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := userSelectQuery(s.Dialect.GOQU()).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableUserFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for User: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
-
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query User: %w", err)
-		return
-	}
-
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query User: %w", err)
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query User: %w", err)
-			return
-		}
-
-		aux = new(auxUser)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for User: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode User: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
-		set = append(set, res)
-	}
-
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupUserByID searches for user by ID
-//
-// It returns user even if deleted or suspended
-//
-// This function is auto-generated
-func (s *Store) LookupUserByID(ctx context.Context, id uint64) (_ *systemType.User, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxUser)
-		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
 		}
-	}()
 
-	if err = rows.Err(); err != nil {
-		return
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
 	}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
+	if hasPrev {
+		prev = s.collectWebhookDeliveryCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
 	}
 
-	if err = aux.scan(rows); err != nil {
-		return
+	if hasNext {
+		next = s.collectWebhookDeliveryCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
 	}
 
-	return aux.decode()
+	return set, prev, next, nil
 }
 
-// LookupUserByEmail searches for user by email
+// QueryWebhookDeliverys queries the database, converts and checks each row and returns collected set
 //
-// It returns only valid user (not deleted, not suspended)
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) LookupUserByEmail(ctx context.Context, email string) (_ *systemType.User, err error) {
+func (s *Store) QueryWebhookDeliverys(
+	ctx context.Context,
+	f systemType.WebhookDeliveryFilter,
+) (_ []*systemType.WebhookDelivery, more bool, err error) {
 	var (
-		rows   *sql.Rows
-		aux    = new(auxUser)
-		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
-			s.Functions.LOWER(goqu.I("email")).Eq(strings.ToLower(email)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
+		set         = make([]*systemType.WebhookDelivery, 0, DefaultSliceCapacity)
+		res         *systemType.WebhookDelivery
+		aux         *auxWebhookDelivery
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	rows, err = s.Query(ctx, lookup)
+	if s.Filters.WebhookDelivery != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.WebhookDelivery(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = WebhookDeliveryFilter(s.Dialect, f)
+	}
+
 	if err != nil {
+		err = fmt.Errorf("could generate filter expression for WebhookDelivery: %w", err)
 		return
 	}
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
+	expr = append(expr, tExpr...)
 
-	if err = rows.Err(); err != nil {
-		return
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursorWithSorting(f.PageCursor, s.sortableWebhookDeliveryFields()); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
 	}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
+	query := webhookDeliverySelectQuery(s.Dialect.GOQU()).Where(expr...)
 
-	if err = aux.scan(rows); err != nil {
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableWebhookDeliveryFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for WebhookDelivery: %w", err)
 		return
 	}
 
-	return aux.decode()
-}
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
-// LookupUserByHandle searches for user by handle
-//
-// It returns only valid user (not deleted, not suspended)
-//
-// This function is auto-generated
-func (s *Store) LookupUserByHandle(ctx context.Context, handle string) (_ *systemType.User, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxUser)
-		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
-		).Limit(1)
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	rows, err = s.Query(ctx, lookup)
+	rows, err = s.Query(ctx, query)
 	if err != nil {
+		err = fmt.Errorf("could not query WebhookDelivery: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query WebhookDelivery: %w", err)
 		return
 	}
 
@@ -22985,33 +26478,40 @@ func (s *Store) LookupUserByHandle(ctx context.Context, handle string) (_ *syste
 		}
 	}()
 
-	if err = rows.Err(); err != nil {
-		return
-	}
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query WebhookDelivery: %w", err)
+			return
+		}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
+		aux = new(auxWebhookDelivery)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for WebhookDelivery: %w", err)
+			return
+		}
 
-	if err = aux.scan(rows); err != nil {
-		return
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode WebhookDelivery: %w", err)
+			return
+		}
+
+		set = append(set, res)
 	}
 
-	return aux.decode()
+	return set, f.Limit > 0 && count >= f.Limit, err
+
 }
 
-// LookupUserByUsername searches for user by username
-//
-// It returns only valid user (not deleted, not suspended)
+// LookupWebhookDeliveryByID
 //
 // This function is auto-generated
-func (s *Store) LookupUserByUsername(ctx context.Context, username string) (_ *systemType.User, err error) {
+func (s *Store) LookupWebhookDeliveryByID(ctx context.Context, id uint64) (_ *systemType.WebhookDelivery, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxUser)
-		lookup = userSelectQuery(s.Dialect.GOQU()).Where(
-			s.Functions.LOWER(goqu.I("username")).Eq(strings.ToLower(username)),
-			stateNilComparison(s.Dialect, "deleted_at", filter.StateExcluded),
+		aux    = new(auxWebhookDelivery)
+		lookup = webhookDeliverySelectQuery(s.Dialect.GOQU()).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -23043,32 +26543,32 @@ func (s *Store) LookupUserByUsername(ctx context.Context, username string) (_ *s
 	return aux.decode()
 }
 
-// sortableUserFields returns all <no value> columns flagged as sortable
+// sortableWebhookDeliveryFields returns all <no value> columns flagged as sortable
 //
 // # Notes
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableUserFields() map[string]string {
+func (Store) sortableWebhookDeliveryFields() map[string]string {
 	return map[string]string{
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"email":        "email",
-		"handle":       "handle",
-		"id":           "id",
-		"kind":         "kind",
-		"name":         "name",
-		"suspended_at": "suspended_at",
-		"suspendedat":  "suspended_at",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
-		"username":     "username",
-	}
-}
-
-// collectUserCursorValues collects values from the given resource that and sets them to the cursor
+		"created_at":      "created_at",
+		"createdat":       "created_at",
+		"event_type":      "event_type",
+		"eventtype":       "event_type",
+		"id":              "id",
+		"last_attempt_at": "last_attempt_at",
+		"lastattemptat":   "last_attempt_at",
+		"next_attempt_at": "next_attempt_at",
+		"nextattemptat":   "next_attempt_at",
+		"resource_type":   "resource_type",
+		"resourcetype":    "resource_type",
+		"status":          "status",
+		"updated_at":      "updated_at",
+		"updatedat":       "updated_at",
+	}
+}
+
+// collectWebhookDeliveryCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -23080,7 +26580,7 @@ func (Store) sortableUserFields() map[string]string {
 // undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectUserCursorValues(res *systemType.User, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectWebhookDeliveryCursorValues(res *systemType.WebhookDelivery, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -23094,27 +26594,20 @@ func (s *Store) collectUserCursorValues(res *systemType.User, cc ...*filter.Sort
 				case "id":
 					pkID = true
 					return res.ID
-				case "email":
-					hasUnique = true
-					return res.Email
-				case "username":
-					hasUnique = true
-					return res.Username
-				case "name":
-					return res.Name
-				case "handle":
-					hasUnique = true
-					return res.Handle
-				case "kind":
-					return res.Kind
-				case "suspendedAt":
-					return res.SuspendedAt
+				case "resourceType":
+					return res.ResourceType
+				case "eventType":
+					return res.EventType
+				case "status":
+					return res.Status
+				case "nextAttemptAt":
+					return res.NextAttemptAt
+				case "lastAttemptAt":
+					return res.LastAttemptAt
 				case "createdAt":
 					return res.CreatedAt
 				case "updatedAt":
 					return res.UpdatedAt
-				case "deletedAt":
-					return res.DeletedAt
 				}
 				return nil
 			}
@@ -23147,94 +26640,13 @@ func (s *Store) collectUserCursorValues(res *systemType.User, cc ...*filter.Sort
 
 }
 
-// checkUserConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkWebhookDeliveryConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkUserConstraints(ctx context.Context, res *systemType.User) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Email) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupUserByEmail(ctx, res.Email)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupUserByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Username) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupUserByUsername(ctx, res.Username)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkWebhookDeliveryConstraints(ctx context.Context, res *systemType.WebhookDelivery) (err error) {
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	automationModel "github.com/cortezaproject/corteza/server/automation/model"
 	"github.com/cortezaproject/corteza/server/compose/model"
 	"github.com/cortezaproject/corteza/server/compose/types"
 	discovery "github.com/cortezaproject/corteza/server/discovery/types"
@@ -18,6 +19,7 @@ import (
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	"github.com/cortezaproject/corteza/server/pkg/logger"
 	"github.com/cortezaproject/corteza/server/store"
+	systemModel "github.com/cortezaproject/corteza/server/system/model"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/spf13/cast"
@@ -49,6 +51,10 @@ var (
 		fix_2022_09_07_changePostgresIdColumnsDatatype,
 		fix_2022_09_00_migrateComposeModuleDiscoveryConfigSettings,
 		fix_2023_03_00_migrateComposePageMeta,
+		fix_2023_09_00_addValidityWindowOnRbacRules,
+		fix_2023_09_00_addStateOnAutomationSessions,
+		fix_2023_09_00_addPublishedVersionOnAutomationWorkflows,
+		fix_2023_09_00_addWorkflowVersionOnAutomationSessions,
 	}
 )
 
@@ -626,6 +632,42 @@ func fix_2022_09_00_addMissingNodeIdOnFederationMapping(ctx context.Context, s *
 	)
 }
 
+func fix_2023_09_00_addValidityWindowOnRbacRules(ctx context.Context, s *Store) (err error) {
+	for _, ident := range []string{"NotBefore", "NotAfter"} {
+		err = addColumn(ctx, s,
+			"rbac_rules",
+			systemModel.Rule.Attributes.FindByIdent(ident),
+		)
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func fix_2023_09_00_addStateOnAutomationSessions(ctx context.Context, s *Store) (err error) {
+	return addColumn(ctx, s,
+		"automation_sessions",
+		automationModel.Session.Attributes.FindByIdent("State"),
+	)
+}
+
+func fix_2023_09_00_addPublishedVersionOnAutomationWorkflows(ctx context.Context, s *Store) (err error) {
+	return addColumn(ctx, s,
+		"automation_workflows",
+		automationModel.Workflow.Attributes.FindByIdent("PublishedVersion"),
+	)
+}
+
+func fix_2023_09_00_addWorkflowVersionOnAutomationSessions(ctx context.Context, s *Store) (err error) {
+	return addColumn(ctx, s,
+		"automation_sessions",
+		automationModel.Session.Attributes.FindByIdent("WorkflowVersion"),
+	)
+}
+
 func fix_2022_09_07_changePostgresIdColumnsDatatype(ctx context.Context, s *Store) (err error) {
 	var tableName string
 	if !strings.HasPrefix(s.DB.DriverName(), "postgres") {
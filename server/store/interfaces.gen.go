@@ -18,6 +18,7 @@ import (
 	labelsType "github.com/cortezaproject/corteza/server/pkg/label/types"
 	"github.com/cortezaproject/corteza/server/pkg/locale"
 	rbacType "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulerType "github.com/cortezaproject/corteza/server/pkg/scheduler"
 	systemType "github.com/cortezaproject/corteza/server/system/types"
 	"go.uber.org/zap"
 	"golang.org/x/text/language"
@@ -56,6 +57,7 @@ type (
 		AutomationSessions
 		AutomationTriggers
 		AutomationWorkflows
+		AutomationWorkflowVersions
 		ComposeAttachments
 		ComposeCharts
 		ComposeModules
@@ -79,15 +81,21 @@ type (
 		Queues
 		QueueMessages
 		RbacRules
+		RbacRuleChanges
 		Reminders
 		Reports
+		ReportDeliverys
+		ReportSubscriptions
 		ResourceActivitys
 		ResourceTranslations
 		Roles
 		RoleMembers
+		SchedulerClaims
 		SettingValues
 		Templates
 		Users
+		Webhooks
+		WebhookDeliverys
 	}
 
 	Actionlogs interface {
@@ -250,6 +258,18 @@ type (
 		LookupAutomationWorkflowByHandle(ctx context.Context, handle string) (*automationType.Workflow, error)
 	}
 
+	AutomationWorkflowVersions interface {
+		SearchAutomationWorkflowVersions(ctx context.Context, f automationType.WorkflowVersionFilter) (automationType.WorkflowVersionSet, automationType.WorkflowVersionFilter, error)
+		CreateAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) error
+		UpdateAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) error
+		UpsertAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) error
+		DeleteAutomationWorkflowVersion(ctx context.Context, rr ...*automationType.WorkflowVersion) error
+
+		DeleteAutomationWorkflowVersionByID(ctx context.Context, id uint64) error
+		TruncateAutomationWorkflowVersions(ctx context.Context) error
+		LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx context.Context, workflowID uint64, version uint) (*automationType.WorkflowVersion, error)
+	}
+
 	ComposeAttachments interface {
 		SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (composeType.AttachmentSet, composeType.AttachmentFilter, error)
 		CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) error
@@ -541,6 +561,17 @@ type (
 		TransferRbacRules(ctx context.Context, src uint64, dst uint64) error
 	}
 
+	RbacRuleChanges interface {
+		SearchRbacRuleChanges(ctx context.Context, f rbacType.RuleChangeFilter) (rbacType.RuleChangeSet, rbacType.RuleChangeFilter, error)
+		CreateRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) error
+		UpdateRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) error
+		UpsertRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) error
+		DeleteRbacRuleChange(ctx context.Context, rr ...*rbacType.RuleChange) error
+
+		DeleteRbacRuleChangeByID(ctx context.Context, id uint64) error
+		TruncateRbacRuleChanges(ctx context.Context) error
+	}
+
 	Reminders interface {
 		SearchReminders(ctx context.Context, f systemType.ReminderFilter) (systemType.ReminderSet, systemType.ReminderFilter, error)
 		CreateReminder(ctx context.Context, rr ...*systemType.Reminder) error
@@ -566,6 +597,30 @@ type (
 		LookupReportByHandle(ctx context.Context, handle string) (*systemType.Report, error)
 	}
 
+	ReportDeliverys interface {
+		SearchReportDeliverys(ctx context.Context, f systemType.ReportDeliveryFilter) (systemType.ReportDeliverySet, systemType.ReportDeliveryFilter, error)
+		CreateReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) error
+		UpdateReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) error
+		UpsertReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) error
+		DeleteReportDelivery(ctx context.Context, rr ...*systemType.ReportDelivery) error
+
+		DeleteReportDeliveryByID(ctx context.Context, id uint64) error
+		TruncateReportDeliverys(ctx context.Context) error
+		LookupReportDeliveryByID(ctx context.Context, id uint64) (*systemType.ReportDelivery, error)
+	}
+
+	ReportSubscriptions interface {
+		SearchReportSubscriptions(ctx context.Context, f systemType.ReportSubscriptionFilter) (systemType.ReportSubscriptionSet, systemType.ReportSubscriptionFilter, error)
+		CreateReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) error
+		UpdateReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) error
+		UpsertReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) error
+		DeleteReportSubscription(ctx context.Context, rr ...*systemType.ReportSubscription) error
+
+		DeleteReportSubscriptionByID(ctx context.Context, id uint64) error
+		TruncateReportSubscriptions(ctx context.Context) error
+		LookupReportSubscriptionByID(ctx context.Context, id uint64) (*systemType.ReportSubscription, error)
+	}
+
 	ResourceActivitys interface {
 		SearchResourceActivitys(ctx context.Context, f discoveryType.ResourceActivityFilter) (discoveryType.ResourceActivitySet, discoveryType.ResourceActivityFilter, error)
 		CreateResourceActivity(ctx context.Context, rr ...*discoveryType.ResourceActivity) error
@@ -617,6 +672,20 @@ type (
 		TransferRoleMembers(ctx context.Context, src uint64, dst uint64) error
 	}
 
+	SchedulerClaims interface {
+		SearchSchedulerClaims(ctx context.Context, f schedulerType.ClaimFilter) (schedulerType.ClaimSet, schedulerType.ClaimFilter, error)
+		CreateSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) error
+		UpdateSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) error
+		UpsertSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) error
+		DeleteSchedulerClaim(ctx context.Context, rr ...*schedulerType.Claim) error
+
+		DeleteSchedulerClaimByJob(ctx context.Context, job string) error
+		TruncateSchedulerClaims(ctx context.Context) error
+		LookupSchedulerClaimByJob(ctx context.Context, job string) (*schedulerType.Claim, error)
+		RenewSchedulerClaim(ctx context.Context, claim *schedulerType.Claim) (bool, error)
+		ReleaseSchedulerClaim(ctx context.Context, claim *schedulerType.Claim) (bool, error)
+	}
+
 	SettingValues interface {
 		SearchSettingValues(ctx context.Context, f systemType.SettingsFilter) (systemType.SettingValueSet, systemType.SettingsFilter, error)
 		CreateSettingValue(ctx context.Context, rr ...*systemType.SettingValue) error
@@ -658,6 +727,31 @@ type (
 		CountUsers(ctx context.Context, u systemType.UserFilter) (uint, error)
 		UserMetrics(ctx context.Context) (*systemType.UserMetrics, error)
 	}
+
+	Webhooks interface {
+		SearchWebhooks(ctx context.Context, f systemType.WebhookFilter) (systemType.WebhookSet, systemType.WebhookFilter, error)
+		CreateWebhook(ctx context.Context, rr ...*systemType.Webhook) error
+		UpdateWebhook(ctx context.Context, rr ...*systemType.Webhook) error
+		UpsertWebhook(ctx context.Context, rr ...*systemType.Webhook) error
+		DeleteWebhook(ctx context.Context, rr ...*systemType.Webhook) error
+
+		DeleteWebhookByID(ctx context.Context, id uint64) error
+		TruncateWebhooks(ctx context.Context) error
+		LookupWebhookByID(ctx context.Context, id uint64) (*systemType.Webhook, error)
+		LookupWebhookByHandle(ctx context.Context, handle string) (*systemType.Webhook, error)
+	}
+
+	WebhookDeliverys interface {
+		SearchWebhookDeliverys(ctx context.Context, f systemType.WebhookDeliveryFilter) (systemType.WebhookDeliverySet, systemType.WebhookDeliveryFilter, error)
+		CreateWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) error
+		UpdateWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) error
+		UpsertWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) error
+		DeleteWebhookDelivery(ctx context.Context, rr ...*systemType.WebhookDelivery) error
+
+		DeleteWebhookDeliveryByID(ctx context.Context, id uint64) error
+		TruncateWebhookDeliverys(ctx context.Context) error
+		LookupWebhookDeliveryByID(ctx context.Context, id uint64) (*systemType.WebhookDelivery, error)
+	}
 )
 
 // SearchActionlogs returns all matching Actionlogs from store
@@ -1462,6 +1556,62 @@ func LookupAutomationWorkflowByHandle(ctx context.Context, s AutomationWorkflows
 	return s.LookupAutomationWorkflowByHandle(ctx, handle)
 }
 
+// SearchAutomationWorkflowVersions returns all matching AutomationWorkflowVersions from store
+//
+// This function is auto-generated
+func SearchAutomationWorkflowVersions(ctx context.Context, s AutomationWorkflowVersions, f automationType.WorkflowVersionFilter) (automationType.WorkflowVersionSet, automationType.WorkflowVersionFilter, error) {
+	return s.SearchAutomationWorkflowVersions(ctx, f)
+}
+
+// CreateAutomationWorkflowVersion creates one or more AutomationWorkflowVersions in store
+//
+// This function is auto-generated
+func CreateAutomationWorkflowVersion(ctx context.Context, s AutomationWorkflowVersions, rr ...*automationType.WorkflowVersion) error {
+	return s.CreateAutomationWorkflowVersion(ctx, rr...)
+}
+
+// UpdateAutomationWorkflowVersion updates one or more (existing) AutomationWorkflowVersions in store
+//
+// This function is auto-generated
+func UpdateAutomationWorkflowVersion(ctx context.Context, s AutomationWorkflowVersions, rr ...*automationType.WorkflowVersion) error {
+	return s.UpdateAutomationWorkflowVersion(ctx, rr...)
+}
+
+// UpsertAutomationWorkflowVersion creates new or updates existing one or more AutomationWorkflowVersions in store
+//
+// This function is auto-generated
+func UpsertAutomationWorkflowVersion(ctx context.Context, s AutomationWorkflowVersions, rr ...*automationType.WorkflowVersion) error {
+	return s.UpsertAutomationWorkflowVersion(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowVersion deletes one or more AutomationWorkflowVersions from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowVersion(ctx context.Context, s AutomationWorkflowVersions, rr ...*automationType.WorkflowVersion) error {
+	return s.DeleteAutomationWorkflowVersion(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowVersionByID deletes one or more AutomationWorkflowVersions from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowVersionByID(ctx context.Context, s AutomationWorkflowVersions, id uint64) error {
+	return s.DeleteAutomationWorkflowVersionByID(ctx, id)
+}
+
+// TruncateAutomationWorkflowVersions Deletes all AutomationWorkflowVersions from store
+//
+// This function is auto-generated
+func TruncateAutomationWorkflowVersions(ctx context.Context, s AutomationWorkflowVersions) error {
+	return s.TruncateAutomationWorkflowVersions(ctx)
+}
+
+// LookupAutomationWorkflowVersionByWorkflowIDVersion searches for workflow version by workflow ID and version number
+//
+// This function is auto-generated
+func LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx context.Context, s AutomationWorkflowVersions, workflowID uint64, version uint) (*automationType.WorkflowVersion, error) {
+	return s.LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx, workflowID, version)
+}
+
 // SearchComposeAttachments returns all matching ComposeAttachments from store
 //
 // This function is auto-generated
@@ -2890,6 +3040,55 @@ func TransferRbacRules(ctx context.Context, s RbacRules, src uint64, dst uint64)
 	return s.TransferRbacRules(ctx, src, dst)
 }
 
+// SearchRbacRuleChanges returns all matching RbacRuleChanges from store
+//
+// This function is auto-generated
+func SearchRbacRuleChanges(ctx context.Context, s RbacRuleChanges, f rbacType.RuleChangeFilter) (rbacType.RuleChangeSet, rbacType.RuleChangeFilter, error) {
+	return s.SearchRbacRuleChanges(ctx, f)
+}
+
+// CreateRbacRuleChange creates one or more RbacRuleChanges in store
+//
+// This function is auto-generated
+func CreateRbacRuleChange(ctx context.Context, s RbacRuleChanges, rr ...*rbacType.RuleChange) error {
+	return s.CreateRbacRuleChange(ctx, rr...)
+}
+
+// UpdateRbacRuleChange updates one or more (existing) RbacRuleChanges in store
+//
+// This function is auto-generated
+func UpdateRbacRuleChange(ctx context.Context, s RbacRuleChanges, rr ...*rbacType.RuleChange) error {
+	return s.UpdateRbacRuleChange(ctx, rr...)
+}
+
+// UpsertRbacRuleChange creates new or updates existing one or more RbacRuleChanges in store
+//
+// This function is auto-generated
+func UpsertRbacRuleChange(ctx context.Context, s RbacRuleChanges, rr ...*rbacType.RuleChange) error {
+	return s.UpsertRbacRuleChange(ctx, rr...)
+}
+
+// DeleteRbacRuleChange deletes one or more RbacRuleChanges from store
+//
+// This function is auto-generated
+func DeleteRbacRuleChange(ctx context.Context, s RbacRuleChanges, rr ...*rbacType.RuleChange) error {
+	return s.DeleteRbacRuleChange(ctx, rr...)
+}
+
+// DeleteRbacRuleChangeByID deletes one or more RbacRuleChanges from store
+//
+// This function is auto-generated
+func DeleteRbacRuleChangeByID(ctx context.Context, s RbacRuleChanges, id uint64) error {
+	return s.DeleteRbacRuleChangeByID(ctx, id)
+}
+
+// TruncateRbacRuleChanges Deletes all RbacRuleChanges from store
+//
+// This function is auto-generated
+func TruncateRbacRuleChanges(ctx context.Context, s RbacRuleChanges) error {
+	return s.TruncateRbacRuleChanges(ctx)
+}
+
 // SearchReminders returns all matching Reminders from store
 //
 // This function is auto-generated
@@ -3013,6 +3212,120 @@ func LookupReportByHandle(ctx context.Context, s Reports, handle string) (*syste
 	return s.LookupReportByHandle(ctx, handle)
 }
 
+// SearchReportDeliverys returns all matching ReportDeliverys from store
+//
+// This function is auto-generated
+func SearchReportDeliverys(ctx context.Context, s ReportDeliverys, f systemType.ReportDeliveryFilter) (systemType.ReportDeliverySet, systemType.ReportDeliveryFilter, error) {
+	return s.SearchReportDeliverys(ctx, f)
+}
+
+// CreateReportDelivery creates one or more ReportDeliverys in store
+//
+// This function is auto-generated
+func CreateReportDelivery(ctx context.Context, s ReportDeliverys, rr ...*systemType.ReportDelivery) error {
+	return s.CreateReportDelivery(ctx, rr...)
+}
+
+// UpdateReportDelivery updates one or more (existing) ReportDeliverys in store
+//
+// This function is auto-generated
+func UpdateReportDelivery(ctx context.Context, s ReportDeliverys, rr ...*systemType.ReportDelivery) error {
+	return s.UpdateReportDelivery(ctx, rr...)
+}
+
+// UpsertReportDelivery creates new or updates existing one or more ReportDeliverys in store
+//
+// This function is auto-generated
+func UpsertReportDelivery(ctx context.Context, s ReportDeliverys, rr ...*systemType.ReportDelivery) error {
+	return s.UpsertReportDelivery(ctx, rr...)
+}
+
+// DeleteReportDelivery deletes one or more ReportDeliverys from store
+//
+// This function is auto-generated
+func DeleteReportDelivery(ctx context.Context, s ReportDeliverys, rr ...*systemType.ReportDelivery) error {
+	return s.DeleteReportDelivery(ctx, rr...)
+}
+
+// DeleteReportDeliveryByID deletes one or more ReportDeliverys from store
+//
+// This function is auto-generated
+func DeleteReportDeliveryByID(ctx context.Context, s ReportDeliverys, id uint64) error {
+	return s.DeleteReportDeliveryByID(ctx, id)
+}
+
+// TruncateReportDeliverys Deletes all ReportDeliverys from store
+//
+// This function is auto-generated
+func TruncateReportDeliverys(ctx context.Context, s ReportDeliverys) error {
+	return s.TruncateReportDeliverys(ctx)
+}
+
+// LookupReportDeliveryByID
+//
+// This function is auto-generated
+func LookupReportDeliveryByID(ctx context.Context, s ReportDeliverys, id uint64) (*systemType.ReportDelivery, error) {
+	return s.LookupReportDeliveryByID(ctx, id)
+}
+
+// SearchReportSubscriptions returns all matching ReportSubscriptions from store
+//
+// This function is auto-generated
+func SearchReportSubscriptions(ctx context.Context, s ReportSubscriptions, f systemType.ReportSubscriptionFilter) (systemType.ReportSubscriptionSet, systemType.ReportSubscriptionFilter, error) {
+	return s.SearchReportSubscriptions(ctx, f)
+}
+
+// CreateReportSubscription creates one or more ReportSubscriptions in store
+//
+// This function is auto-generated
+func CreateReportSubscription(ctx context.Context, s ReportSubscriptions, rr ...*systemType.ReportSubscription) error {
+	return s.CreateReportSubscription(ctx, rr...)
+}
+
+// UpdateReportSubscription updates one or more (existing) ReportSubscriptions in store
+//
+// This function is auto-generated
+func UpdateReportSubscription(ctx context.Context, s ReportSubscriptions, rr ...*systemType.ReportSubscription) error {
+	return s.UpdateReportSubscription(ctx, rr...)
+}
+
+// UpsertReportSubscription creates new or updates existing one or more ReportSubscriptions in store
+//
+// This function is auto-generated
+func UpsertReportSubscription(ctx context.Context, s ReportSubscriptions, rr ...*systemType.ReportSubscription) error {
+	return s.UpsertReportSubscription(ctx, rr...)
+}
+
+// DeleteReportSubscription deletes one or more ReportSubscriptions from store
+//
+// This function is auto-generated
+func DeleteReportSubscription(ctx context.Context, s ReportSubscriptions, rr ...*systemType.ReportSubscription) error {
+	return s.DeleteReportSubscription(ctx, rr...)
+}
+
+// DeleteReportSubscriptionByID deletes one or more ReportSubscriptions from store
+//
+// This function is auto-generated
+func DeleteReportSubscriptionByID(ctx context.Context, s ReportSubscriptions, id uint64) error {
+	return s.DeleteReportSubscriptionByID(ctx, id)
+}
+
+// TruncateReportSubscriptions Deletes all ReportSubscriptions from store
+//
+// This function is auto-generated
+func TruncateReportSubscriptions(ctx context.Context, s ReportSubscriptions) error {
+	return s.TruncateReportSubscriptions(ctx)
+}
+
+// LookupReportSubscriptionByID searches for report subscription by ID
+//
+// It returns report subscription even if deleted or disabled
+//
+// This function is auto-generated
+func LookupReportSubscriptionByID(ctx context.Context, s ReportSubscriptions, id uint64) (*systemType.ReportSubscription, error) {
+	return s.LookupReportSubscriptionByID(ctx, id)
+}
+
 // SearchResourceActivitys returns all matching ResourceActivitys from store
 //
 // This function is auto-generated
@@ -3265,6 +3578,76 @@ func TransferRoleMembers(ctx context.Context, s RoleMembers, src uint64, dst uin
 	return s.TransferRoleMembers(ctx, src, dst)
 }
 
+// SearchSchedulerClaims returns all matching SchedulerClaims from store
+//
+// This function is auto-generated
+func SearchSchedulerClaims(ctx context.Context, s SchedulerClaims, f schedulerType.ClaimFilter) (schedulerType.ClaimSet, schedulerType.ClaimFilter, error) {
+	return s.SearchSchedulerClaims(ctx, f)
+}
+
+// CreateSchedulerClaim creates one or more SchedulerClaims in store
+//
+// This function is auto-generated
+func CreateSchedulerClaim(ctx context.Context, s SchedulerClaims, rr ...*schedulerType.Claim) error {
+	return s.CreateSchedulerClaim(ctx, rr...)
+}
+
+// UpdateSchedulerClaim updates one or more (existing) SchedulerClaims in store
+//
+// This function is auto-generated
+func UpdateSchedulerClaim(ctx context.Context, s SchedulerClaims, rr ...*schedulerType.Claim) error {
+	return s.UpdateSchedulerClaim(ctx, rr...)
+}
+
+// UpsertSchedulerClaim creates new or updates existing one or more SchedulerClaims in store
+//
+// This function is auto-generated
+func UpsertSchedulerClaim(ctx context.Context, s SchedulerClaims, rr ...*schedulerType.Claim) error {
+	return s.UpsertSchedulerClaim(ctx, rr...)
+}
+
+// DeleteSchedulerClaim deletes one or more SchedulerClaims from store
+//
+// This function is auto-generated
+func DeleteSchedulerClaim(ctx context.Context, s SchedulerClaims, rr ...*schedulerType.Claim) error {
+	return s.DeleteSchedulerClaim(ctx, rr...)
+}
+
+// DeleteSchedulerClaimByID deletes one or more SchedulerClaims from store
+//
+// This function is auto-generated
+func DeleteSchedulerClaimByJob(ctx context.Context, s SchedulerClaims, job string) error {
+	return s.DeleteSchedulerClaimByJob(ctx, job)
+}
+
+// TruncateSchedulerClaims Deletes all SchedulerClaims from store
+//
+// This function is auto-generated
+func TruncateSchedulerClaims(ctx context.Context, s SchedulerClaims) error {
+	return s.TruncateSchedulerClaims(ctx)
+}
+
+// LookupSchedulerClaimByJob searches for scheduler claim by job
+//
+// This function is auto-generated
+func LookupSchedulerClaimByJob(ctx context.Context, s SchedulerClaims, job string) (*schedulerType.Claim, error) {
+	return s.LookupSchedulerClaimByJob(ctx, job)
+}
+
+// RenewSchedulerClaim
+//
+// This function is auto-generated
+func RenewSchedulerClaim(ctx context.Context, s SchedulerClaims, claim *schedulerType.Claim) (bool, error) {
+	return s.RenewSchedulerClaim(ctx, claim)
+}
+
+// ReleaseSchedulerClaim
+//
+// This function is auto-generated
+func ReleaseSchedulerClaim(ctx context.Context, s SchedulerClaims, claim *schedulerType.Claim) (bool, error) {
+	return s.ReleaseSchedulerClaim(ctx, claim)
+}
+
 // SearchSettingValues returns all matching SettingValues from store
 //
 // This function is auto-generated
@@ -3486,3 +3869,126 @@ func CountUsers(ctx context.Context, s Users, u systemType.UserFilter) (uint, er
 func UserMetrics(ctx context.Context, s Users) (*systemType.UserMetrics, error) {
 	return s.UserMetrics(ctx)
 }
+
+// SearchWebhooks returns all matching Webhooks from store
+//
+// This function is auto-generated
+func SearchWebhooks(ctx context.Context, s Webhooks, f systemType.WebhookFilter) (systemType.WebhookSet, systemType.WebhookFilter, error) {
+	return s.SearchWebhooks(ctx, f)
+}
+
+// CreateWebhook creates one or more Webhooks in store
+//
+// This function is auto-generated
+func CreateWebhook(ctx context.Context, s Webhooks, rr ...*systemType.Webhook) error {
+	return s.CreateWebhook(ctx, rr...)
+}
+
+// UpdateWebhook updates one or more (existing) Webhooks in store
+//
+// This function is auto-generated
+func UpdateWebhook(ctx context.Context, s Webhooks, rr ...*systemType.Webhook) error {
+	return s.UpdateWebhook(ctx, rr...)
+}
+
+// UpsertWebhook creates new or updates existing one or more Webhooks in store
+//
+// This function is auto-generated
+func UpsertWebhook(ctx context.Context, s Webhooks, rr ...*systemType.Webhook) error {
+	return s.UpsertWebhook(ctx, rr...)
+}
+
+// DeleteWebhook deletes one or more Webhooks from store
+//
+// This function is auto-generated
+func DeleteWebhook(ctx context.Context, s Webhooks, rr ...*systemType.Webhook) error {
+	return s.DeleteWebhook(ctx, rr...)
+}
+
+// DeleteWebhookByID deletes one or more Webhooks from store
+//
+// This function is auto-generated
+func DeleteWebhookByID(ctx context.Context, s Webhooks, id uint64) error {
+	return s.DeleteWebhookByID(ctx, id)
+}
+
+// TruncateWebhooks Deletes all Webhooks from store
+//
+// This function is auto-generated
+func TruncateWebhooks(ctx context.Context, s Webhooks) error {
+	return s.TruncateWebhooks(ctx)
+}
+
+// LookupWebhookByID searches for webhook by ID
+//
+// It returns webhook even if deleted or disabled
+//
+// This function is auto-generated
+func LookupWebhookByID(ctx context.Context, s Webhooks, id uint64) (*systemType.Webhook, error) {
+	return s.LookupWebhookByID(ctx, id)
+}
+
+// LookupWebhookByHandle searches for webhook by handle
+//
+// It returns only valid webhook (not deleted)
+//
+// This function is auto-generated
+func LookupWebhookByHandle(ctx context.Context, s Webhooks, handle string) (*systemType.Webhook, error) {
+	return s.LookupWebhookByHandle(ctx, handle)
+}
+
+// SearchWebhookDeliverys returns all matching WebhookDeliverys from store
+//
+// This function is auto-generated
+func SearchWebhookDeliverys(ctx context.Context, s WebhookDeliverys, f systemType.WebhookDeliveryFilter) (systemType.WebhookDeliverySet, systemType.WebhookDeliveryFilter, error) {
+	return s.SearchWebhookDeliverys(ctx, f)
+}
+
+// CreateWebhookDelivery creates one or more WebhookDeliverys in store
+//
+// This function is auto-generated
+func CreateWebhookDelivery(ctx context.Context, s WebhookDeliverys, rr ...*systemType.WebhookDelivery) error {
+	return s.CreateWebhookDelivery(ctx, rr...)
+}
+
+// UpdateWebhookDelivery updates one or more (existing) WebhookDeliverys in store
+//
+// This function is auto-generated
+func UpdateWebhookDelivery(ctx context.Context, s WebhookDeliverys, rr ...*systemType.WebhookDelivery) error {
+	return s.UpdateWebhookDelivery(ctx, rr...)
+}
+
+// UpsertWebhookDelivery creates new or updates existing one or more WebhookDeliverys in store
+//
+// This function is auto-generated
+func UpsertWebhookDelivery(ctx context.Context, s WebhookDeliverys, rr ...*systemType.WebhookDelivery) error {
+	return s.UpsertWebhookDelivery(ctx, rr...)
+}
+
+// DeleteWebhookDelivery deletes one or more WebhookDeliverys from store
+//
+// This function is auto-generated
+func DeleteWebhookDelivery(ctx context.Context, s WebhookDeliverys, rr ...*systemType.WebhookDelivery) error {
+	return s.DeleteWebhookDelivery(ctx, rr...)
+}
+
+// DeleteWebhookDeliveryByID deletes one or more WebhookDeliverys from store
+//
+// This function is auto-generated
+func DeleteWebhookDeliveryByID(ctx context.Context, s WebhookDeliverys, id uint64) error {
+	return s.DeleteWebhookDeliveryByID(ctx, id)
+}
+
+// TruncateWebhookDeliverys Deletes all WebhookDeliverys from store
+//
+// This function is auto-generated
+func TruncateWebhookDeliverys(ctx context.Context, s WebhookDeliverys) error {
+	return s.TruncateWebhookDeliverys(ctx)
+}
+
+// LookupWebhookDeliveryByID
+//
+// This function is auto-generated
+func LookupWebhookDeliveryByID(ctx context.Context, s WebhookDeliverys, id uint64) (*systemType.WebhookDelivery, error) {
+	return s.LookupWebhookDeliveryByID(ctx, id)
+}
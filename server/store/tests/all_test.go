@@ -50,6 +50,9 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("automationWorkflow", func(t *testing.T) {
 		testAutomationWorkflows(t, s)
 	})
+	t.Run("automationWorkflowVersion", func(t *testing.T) {
+		testAutomationWorkflowVersions(t, s)
+	})
 	t.Run("composeAttachment", func(t *testing.T) {
 		testComposeAttachments(t, s)
 	})
@@ -119,12 +122,21 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("rbacRule", func(t *testing.T) {
 		testRbacRules(t, s)
 	})
+	t.Run("rbacRuleChange", func(t *testing.T) {
+		testRbacRuleChanges(t, s)
+	})
 	t.Run("reminder", func(t *testing.T) {
 		testReminders(t, s)
 	})
 	t.Run("report", func(t *testing.T) {
 		testReports(t, s)
 	})
+	t.Run("reportDelivery", func(t *testing.T) {
+		testReportDeliverys(t, s)
+	})
+	t.Run("reportSubscription", func(t *testing.T) {
+		testReportSubscriptions(t, s)
+	})
 	t.Run("resourceActivity", func(t *testing.T) {
 		testResourceActivitys(t, s)
 	})
@@ -137,6 +149,9 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("roleMember", func(t *testing.T) {
 		testRoleMembers(t, s)
 	})
+	t.Run("schedulerClaim", func(t *testing.T) {
+		testSchedulerClaims(t, s)
+	})
 	t.Run("settingValue", func(t *testing.T) {
 		testSettingValues(t, s)
 	})
@@ -146,4 +161,10 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("user", func(t *testing.T) {
 		testUsers(t, s)
 	})
+	t.Run("webhook", func(t *testing.T) {
+		testWebhooks(t, s)
+	})
+	t.Run("webhookDelivery", func(t *testing.T) {
+		testWebhookDeliverys(t, s)
+	})
 }
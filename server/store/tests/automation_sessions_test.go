@@ -7,6 +7,7 @@ import (
 	"github.com/cortezaproject/corteza/server/automation/types"
 	"github.com/cortezaproject/corteza/server/pkg/filter"
 	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
 	"github.com/cortezaproject/corteza/server/store"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/stretchr/testify/require"
@@ -66,6 +67,39 @@ func testAutomationSessions(t *testing.T, s store.AutomationSessions) {
 		req.Equal(types.SessionCompleted, fetched.Status)
 	})
 
+	t.Run("update state", func(t *testing.T) {
+		req, wf := truncAndCreate(t)
+
+		fetched, err := s.LookupAutomationSessionByID(ctx, wf.ID)
+		req.NoError(err)
+		req.Nil(fetched.State)
+
+		wf.State = &types.SessionState{
+			RunnerID: 42,
+			Snapshot: &wfexec.SessionSnapshot{
+				SessionID: wf.ID,
+				CallStack: []uint64{1, wf.ID},
+				States: []*wfexec.StateSnapshot{
+					{StateID: 2, StepID: 3, Prompt: &wfexec.PromptSnapshot{OwnerID: 42, Ref: "ref"}},
+				},
+			},
+		}
+
+		req.NoError(s.UpdateAutomationSession(ctx, wf))
+		fetched, err = s.LookupAutomationSessionByID(ctx, wf.ID)
+		req.NoError(err)
+		req.NotNil(fetched.State)
+		req.Equal(uint64(42), fetched.State.RunnerID)
+		req.Len(fetched.State.Snapshot.States, 1)
+		req.Equal("ref", fetched.State.Snapshot.States[0].Prompt.Ref)
+
+		wf.State = nil
+		req.NoError(s.UpdateAutomationSession(ctx, wf))
+		fetched, err = s.LookupAutomationSessionByID(ctx, wf.ID)
+		req.NoError(err)
+		req.Nil(fetched.State)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		t.Run("by Session", func(t *testing.T) {
 			req, wf := truncAndCreate(t)
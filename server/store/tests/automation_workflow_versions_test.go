@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationWorkflowVersions(t *testing.T, s store.AutomationWorkflowVersions) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(wfID uint64, version uint) *types.WorkflowVersion {
+			return &types.WorkflowVersion{
+				ID:         id.Next(),
+				WorkflowID: wfID,
+				Version:    version,
+				Scope:      &expr.Vars{},
+				Steps:      types.WorkflowStepSet{{ID: 1, Kind: types.WorkflowStepKindExpressions}},
+				Paths:      types.WorkflowPathSet{},
+				CreatedAt:  *now(),
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.WorkflowVersion) {
+			req := require.New(t)
+			req.NoError(s.TruncateAutomationWorkflowVersions(ctx))
+			res := makeNew(1001, 1)
+			req.NoError(s.CreateAutomationWorkflowVersion(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationWorkflowVersions(ctx))
+		req.NoError(s.CreateAutomationWorkflowVersion(ctx, makeNew(1001, 1)))
+	})
+
+	t.Run("version is unique per workflow", func(t *testing.T) {
+		req, _ := truncAndCreate(t)
+		req.Error(s.CreateAutomationWorkflowVersion(ctx, makeNew(1001, 1)))
+		req.NoError(s.CreateAutomationWorkflowVersion(ctx, makeNew(1002, 1)))
+	})
+
+	t.Run("lookup by workflow ID and version", func(t *testing.T) {
+		req, v := truncAndCreate(t)
+
+		fetched, err := s.LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx, v.WorkflowID, v.Version)
+		req.NoError(err)
+		req.Equal(v.ID, fetched.ID)
+		req.Len(fetched.Steps, 1)
+		req.Equal(types.WorkflowStepKindExpressions, fetched.Steps[0].Kind)
+
+		_, err = s.LookupAutomationWorkflowVersionByWorkflowIDVersion(ctx, v.WorkflowID, 2)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationWorkflowVersions(ctx))
+		req.NoError(s.CreateAutomationWorkflowVersion(ctx,
+			makeNew(1001, 1),
+			makeNew(1001, 2),
+			makeNew(1001, 3),
+			makeNew(1002, 1),
+		))
+
+		set, _, err := s.SearchAutomationWorkflowVersions(ctx, types.WorkflowVersionFilter{
+			WorkflowID: []uint64{1001},
+			Sorting: filter.Sorting{Sort: filter.SortExprSet{
+				&filter.SortExpr{Column: "version", Descending: true},
+			}},
+		})
+		req.NoError(err)
+		req.Len(set, 3)
+		req.Equal(uint(3), set[0].Version)
+
+		set, _, err = s.SearchAutomationWorkflowVersions(ctx, types.WorkflowVersionFilter{
+			Version: []uint{1},
+		})
+		req.NoError(err)
+		req.Len(set, 2)
+	})
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+	"github.com/cortezaproject/corteza/server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testRbacRuleChanges(t *testing.T, s store.RbacRuleChanges) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(id, changeSetID, roleID uint64) *rbac.RuleChange {
+			return &rbac.RuleChange{
+				ID:          id,
+				ChangeSetID: changeSetID,
+				Timestamp:   *now(),
+				RoleID:      roleID,
+				Resource:    "res",
+				Operation:   "op",
+				Before:      rbac.RuleState{Access: rbac.Inherit},
+				After:       rbac.RuleState{Access: rbac.Allow, NotAfter: now()},
+			}
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateRbacRuleChanges(ctx))
+		req.NoError(s.CreateRbacRuleChange(ctx, makeNew(1, 1, 42)))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateRbacRuleChanges(ctx))
+		req.NoError(s.CreateRbacRuleChange(ctx,
+			makeNew(1, 1, 42),
+			makeNew(2, 2, 42),
+			makeNew(3, 2, 43),
+			makeNew(4, 3, 42),
+		))
+
+		set, _, err := s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{})
+		req.NoError(err)
+		req.Len(set, 4)
+
+		// newest first
+		req.Equal(uint64(4), set[0].ID)
+		req.Equal(rbac.Inherit, set[0].Before.Access)
+		req.Equal(rbac.Allow, set[0].After.Access)
+		req.NotNil(set[0].After.NotAfter)
+
+		set, _, err = s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{RoleID: []uint64{42}})
+		req.NoError(err)
+		req.Len(set, 3)
+
+		set, _, err = s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{ChangeSetID: []uint64{2}})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{AfterChangeSetID: 1, RoleID: []uint64{42}})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		hourAgo := now().Add(-time.Hour)
+		set, _, err = s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{ChangedAfter: &hourAgo})
+		req.NoError(err)
+		req.Len(set, 4)
+
+		set, _, err = s.SearchRbacRuleChanges(ctx, rbac.RuleChangeFilter{ChangedAfter: now()})
+		req.NoError(err)
+		req.Empty(set)
+	})
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testReportSubscriptions(t *testing.T, s store.ReportSubscriptions) {
+	var (
+		ctx = context.Background()
+		new = &types.ReportSubscription{
+			ID:        42,
+			ReportID:  4200,
+			Schedule:  "0 8 * * 1",
+			Format:    types.ReportSubscriptionFormatCSV,
+			Enabled:   true,
+			CreatedAt: *now(),
+			Recipients: types.ReportSubscriptionRecipients{
+				Users:  []string{"1", "2"},
+				Emails: []string{"someone@example.tld"},
+			},
+			Meta: &types.ReportSubscriptionMeta{Name: "Weekly sales", Sources: []string{"sales"}},
+		}
+
+		disabled = &types.ReportSubscription{
+			ID:        4242,
+			ReportID:  4200,
+			Schedule:  "* * * * *",
+			Format:    types.ReportSubscriptionFormatXLSX,
+			CreatedAt: *now(),
+			Meta:      &types.ReportSubscriptionMeta{},
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportSubscriptions(ctx))
+		req.NoError(s.CreateReportSubscription(ctx, new))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportSubscriptions(ctx))
+		req.NoError(s.CreateReportSubscription(ctx, new))
+		fetched, err := s.LookupReportSubscriptionByID(ctx, new.ID)
+		req.NoError(err)
+		req.Equal(new.ID, fetched.ID)
+		req.Equal(new.ReportID, fetched.ReportID)
+		req.Equal(new.Schedule, fetched.Schedule)
+		req.Equal(new.Format, fetched.Format)
+		req.Equal(new.Recipients, fetched.Recipients)
+		req.Equal(new.Meta.Name, fetched.Meta.Name)
+		req.Equal(new.Meta.Sources, fetched.Meta.Sources)
+		req.Nil(fetched.LastRunAt)
+		req.Nil(fetched.DeletedAt)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportSubscriptions(ctx))
+		req.NoError(s.CreateReportSubscription(ctx, new))
+
+		upd := *new
+		upd.Format = types.ReportSubscriptionFormatPDF
+		upd.LastRunAt = now()
+		req.NoError(s.UpdateReportSubscription(ctx, &upd))
+
+		fetched, err := s.LookupReportSubscriptionByID(ctx, new.ID)
+		req.NoError(err)
+		req.Equal(types.ReportSubscriptionFormatPDF, fetched.Format)
+		req.NotNil(fetched.LastRunAt)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportSubscriptions(ctx))
+		req.NoError(s.CreateReportSubscription(ctx, new, disabled))
+
+		set, _, err := s.SearchReportSubscriptions(ctx, types.ReportSubscriptionFilter{})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(new.ID, set[0].ID)
+
+		set, _, err = s.SearchReportSubscriptions(ctx, types.ReportSubscriptionFilter{
+			ReportID: []string{"4200"},
+			Disabled: filter.StateInclusive,
+		})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchReportSubscriptions(ctx, types.ReportSubscriptionFilter{ReportID: []string{"1"}})
+		req.NoError(err)
+		req.Len(set, 0)
+	})
+}
+
+func testReportDeliverys(t *testing.T, s store.ReportDeliverys) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(ID uint64, status types.ReportDeliveryStatus) *types.ReportDelivery {
+			return &types.ReportDelivery{
+				ID:             ID,
+				SubscriptionID: 42,
+				ReportID:       4200,
+				Format:         types.ReportSubscriptionFormatCSV,
+				Recipients:     types.ReportDeliveryRecipients{"someone@example.tld"},
+				Status:         status,
+				CreatedAt:      *now(),
+			}
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportDeliverys(ctx))
+		req.NoError(s.CreateReportDelivery(ctx, makeNew(1, types.ReportDeliveryRunning)))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportDeliverys(ctx))
+		req.NoError(s.CreateReportDelivery(ctx, makeNew(1, types.ReportDeliveryDelivered)))
+
+		fetched, err := s.LookupReportDeliveryByID(ctx, 1)
+		req.NoError(err)
+		req.Equal(uint64(42), fetched.SubscriptionID)
+		req.Equal(types.ReportDeliveryDelivered, fetched.Status)
+		req.Equal(types.ReportDeliveryRecipients{"someone@example.tld"}, fetched.Recipients)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateReportDeliverys(ctx))
+		req.NoError(s.CreateReportDelivery(ctx,
+			makeNew(1, types.ReportDeliveryDelivered),
+			makeNew(2, types.ReportDeliveryFailed),
+			makeNew(3, types.ReportDeliveryDelivered),
+		))
+
+		set, _, err := s.SearchReportDeliverys(ctx, types.ReportDeliveryFilter{SubscriptionID: []string{"42"}})
+		req.NoError(err)
+		req.Len(set, 3)
+
+		set, _, err = s.SearchReportDeliverys(ctx, types.ReportDeliveryFilter{
+			Status: []types.ReportDeliveryStatus{types.ReportDeliveryFailed},
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(uint64(2), set[0].ID)
+	})
+}
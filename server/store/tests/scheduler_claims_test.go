@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testSchedulerClaims(t *testing.T, s store.SchedulerClaims) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(job string, expiresAt time.Time) *scheduler.Claim {
+			return &scheduler.Claim{
+				Job:       job,
+				ClaimedBy: "node",
+				ClaimedAt: *now(),
+				ExpiresAt: expiresAt,
+			}
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+		req.NoError(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+	})
+
+	t.Run("create claimed", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+		req.NoError(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+		req.Error(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+	})
+
+	t.Run("lookup by job", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+		req.NoError(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+
+		c, err := s.LookupSchedulerClaimByJob(ctx, "job")
+		req.NoError(err)
+		req.Equal("node", c.ClaimedBy)
+	})
+
+	t.Run("search expired", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+		req.NoError(s.CreateSchedulerClaim(ctx,
+			makeNew("expired", now().Add(-time.Minute)),
+			makeNew("valid", now().Add(time.Hour)),
+		))
+
+		set, _, err := s.SearchSchedulerClaims(ctx, scheduler.ClaimFilter{ExpiredBefore: now()})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal("expired", set[0].Job)
+
+		req.NoError(s.DeleteSchedulerClaim(ctx, set...))
+
+		set, _, err = s.SearchSchedulerClaims(ctx, scheduler.ClaimFilter{})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal("valid", set[0].Job)
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+
+		req.NoError(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+
+		until := now().Add(2 * time.Hour)
+		renewed, err := s.RenewSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "node", ExpiresAt: until})
+		req.NoError(err)
+		req.True(renewed)
+
+		// renewed with the same expiration; row is not changed
+		renewed, err = s.RenewSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "node", ExpiresAt: until})
+		req.NoError(err)
+		req.True(renewed)
+
+		renewed, err = s.RenewSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "other", ExpiresAt: until})
+		req.NoError(err)
+		req.False(renewed)
+
+		renewed, err = s.RenewSchedulerClaim(ctx, &scheduler.Claim{Job: "missing", ClaimedBy: "node", ExpiresAt: until})
+		req.NoError(err)
+		req.False(renewed)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateSchedulerClaims(ctx))
+		req.NoError(s.CreateSchedulerClaim(ctx, makeNew("job", now().Add(time.Hour))))
+
+		released, err := s.ReleaseSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "other"})
+		req.NoError(err)
+		req.False(released)
+
+		_, err = s.LookupSchedulerClaimByJob(ctx, "job")
+		req.NoError(err)
+
+		released, err = s.ReleaseSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "node"})
+		req.NoError(err)
+		req.True(released)
+
+		released, err = s.ReleaseSchedulerClaim(ctx, &scheduler.Claim{Job: "job", ClaimedBy: "node"})
+		req.NoError(err)
+		req.False(released)
+	})
+}
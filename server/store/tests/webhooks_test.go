@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testWebhooks(t *testing.T, s store.Webhooks) {
+	var (
+		ctx = context.Background()
+		new = &types.Webhook{
+			ID:        42,
+			Handle:    "crm-sync",
+			URL:       "https://example.tld/hook",
+			Secret:    "secret",
+			Enabled:   true,
+			CreatedAt: *now(),
+			Events: types.WebhookEventSet{
+				{ResourceType: "compose:record", EventTypes: []string{"afterCreate"}},
+			},
+			Meta: &types.WebhookMeta{Name: "CRM sync"},
+		}
+
+		disabled = &types.Webhook{
+			ID:        4242,
+			Handle:    "disabled",
+			URL:       "https://example.tld/disabled",
+			CreatedAt: *now(),
+			Meta:      &types.WebhookMeta{},
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhooks(ctx))
+		req.NoError(s.CreateWebhook(ctx, new))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhooks(ctx))
+		req.NoError(s.CreateWebhook(ctx, new))
+		fetched, err := s.LookupWebhookByID(ctx, new.ID)
+		req.NoError(err)
+		req.Equal(new.ID, fetched.ID)
+		req.Equal(new.URL, fetched.URL)
+		req.Equal(new.Secret, fetched.Secret)
+		req.Equal(new.Meta.Name, fetched.Meta.Name)
+		req.Len(fetched.Events, 1)
+		req.Equal("compose:record", fetched.Events[0].ResourceType)
+		req.Nil(fetched.UpdatedAt)
+		req.Nil(fetched.DeletedAt)
+	})
+
+	t.Run("lookup by handle", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhooks(ctx))
+		req.NoError(s.CreateWebhook(ctx, new))
+		fetched, err := s.LookupWebhookByHandle(ctx, new.Handle)
+		req.NoError(err)
+		req.Equal(new.ID, fetched.ID)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhooks(ctx))
+		req.NoError(s.CreateWebhook(ctx, new))
+
+		upd := *new
+		upd.URL = "https://example.tld/updated"
+		upd.UpdatedAt = now()
+		req.NoError(s.UpdateWebhook(ctx, &upd))
+
+		fetched, err := s.LookupWebhookByID(ctx, new.ID)
+		req.NoError(err)
+		req.Equal(upd.URL, fetched.URL)
+		req.NotNil(fetched.UpdatedAt)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhooks(ctx))
+		req.NoError(s.CreateWebhook(ctx, new, disabled))
+
+		set, _, err := s.SearchWebhooks(ctx, types.WebhookFilter{})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(new.ID, set[0].ID)
+
+		set, _, err = s.SearchWebhooks(ctx, types.WebhookFilter{Disabled: filter.StateInclusive})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchWebhooks(ctx, types.WebhookFilter{Query: "crm", Disabled: filter.StateInclusive})
+		req.NoError(err)
+		req.Len(set, 1)
+	})
+}
+
+func testWebhookDeliverys(t *testing.T, s store.WebhookDeliverys) {
+	var (
+		ctx   = context.Background()
+		past  = now().Add(-1 * time.Minute)
+		later = now().Add(time.Hour)
+
+		makeNew = func(ID uint64, status types.WebhookDeliveryStatus, next *time.Time) *types.WebhookDelivery {
+			return &types.WebhookDelivery{
+				ID:            ID,
+				WebhookID:     42,
+				ResourceType:  "compose:record",
+				EventType:     "afterCreate",
+				Payload:       []byte(`{"foo":"bar"}`),
+				Status:        status,
+				NextAttemptAt: next,
+				CreatedAt:     *now(),
+			}
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhookDeliverys(ctx))
+		req.NoError(s.CreateWebhookDelivery(ctx, makeNew(1, types.WebhookDeliveryPending, nil)))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhookDeliverys(ctx))
+		req.NoError(s.CreateWebhookDelivery(ctx, makeNew(1, types.WebhookDeliveryPending, nil)))
+
+		fetched, err := s.LookupWebhookDeliveryByID(ctx, 1)
+		req.NoError(err)
+		req.Equal(uint64(42), fetched.WebhookID)
+		req.Equal(types.WebhookDeliveryPending, fetched.Status)
+		req.JSONEq(`{"foo":"bar"}`, fetched.Payload.String())
+	})
+
+	t.Run("search due", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateWebhookDeliverys(ctx))
+		req.NoError(s.CreateWebhookDelivery(ctx,
+			makeNew(1, types.WebhookDeliveryPending, &past),
+			makeNew(2, types.WebhookDeliveryPending, &later),
+			makeNew(3, types.WebhookDeliveryDead, nil),
+			makeNew(4, types.WebhookDeliveryDelivered, nil),
+		))
+
+		set, _, err := s.SearchWebhookDeliverys(ctx, types.WebhookDeliveryFilter{WebhookID: []string{"42"}})
+		req.NoError(err)
+		req.Len(set, 4)
+
+		set, _, err = s.SearchWebhookDeliverys(ctx, types.WebhookDeliveryFilter{
+			Status: []types.WebhookDeliveryStatus{types.WebhookDeliveryPending},
+			DueAt:  now(),
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(uint64(1), set[0].ID)
+	})
+}
@@ -54,9 +54,11 @@ attachment: {
 	filter: {
 		struct: {
 			kind: {}
+			deleted: {goType: "filter.State", storeIdent: "deleted_at"}
 		}
 
 		byValue: ["kind"]
+		byNilState: ["deleted"]
 	}
 
 	store: {
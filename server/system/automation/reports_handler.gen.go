@@ -0,0 +1,158 @@
+package automation
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// system/automation/reports_handler.yaml
+
+import (
+	"context"
+	atypes "github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/expr"
+	"github.com/cortezaproject/corteza/server/pkg/wfexec"
+)
+
+var _ wfexec.ExecResponse
+
+type (
+	reportsHandlerRegistry interface {
+		AddFunctions(ff ...*atypes.Function)
+		Type(ref string) expr.Type
+	}
+)
+
+func (h reportsHandler) register() {
+	h.reg.AddFunctions(
+		h.Export(),
+	)
+}
+
+type (
+	reportsExportArgs struct {
+		hasReport bool
+		Report    uint64
+
+		hasSource bool
+		Source    string
+
+		hasScenario bool
+		Scenario    uint64
+
+		hasFilter bool
+		Filter    string
+
+		hasSort bool
+		Sort    string
+
+		hasFormat bool
+		Format    string
+
+		hasDocumentName bool
+		DocumentName    string
+	}
+
+	reportsExportResults struct {
+		Document *renderedDocument
+	}
+)
+
+// Export function Report export
+//
+// expects implementation of export function:
+//
+//	func (h reportsHandler) export(ctx context.Context, args *reportsExportArgs) (results *reportsExportResults, err error) {
+//	   return
+//	}
+func (h reportsHandler) Export() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "reportsExport",
+		Kind:   "function",
+		Labels: map[string]string{"export": "step", "reports": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Report export",
+			Description: "Runs the report and exports a single frame as CSV, XLSX or NDJSON document",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "report",
+				Types: []string{"ID"}, Required: true,
+			},
+			{
+				Name:  "source",
+				Types: []string{"String"}, Required: true,
+			},
+			{
+				Name:  "scenario",
+				Types: []string{"ID"},
+			},
+			{
+				Name:  "filter",
+				Types: []string{"String"},
+			},
+			{
+				Name:  "sort",
+				Types: []string{"String"},
+			},
+			{
+				Name:  "format",
+				Types: []string{"String"},
+			},
+			{
+				Name:  "documentName",
+				Types: []string{"String"},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "document",
+				Types: []string{"RenderedDocument"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &reportsExportArgs{
+					hasReport:       in.Has("report"),
+					hasSource:       in.Has("source"),
+					hasScenario:     in.Has("scenario"),
+					hasFilter:       in.Has("filter"),
+					hasSort:         in.Has("sort"),
+					hasFormat:       in.Has("format"),
+					hasDocumentName: in.Has("documentName"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *reportsExportResults
+			if results, err = h.export(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Document (*renderedDocument) to RenderedDocument
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("RenderedDocument").Cast(results.Document); err != nil {
+					return
+				} else if err = expr.Assign(out, "document", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package automation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+type (
+	reportService interface {
+		Export(ctx context.Context, reportID, scenarioID uint64, def *reporting.FrameDefinition, enc reporting.FrameEncoder) error
+	}
+
+	reportsHandler struct {
+		reg  reportsHandlerRegistry
+		rSvc reportService
+	}
+)
+
+func ReportsHandler(reg reportsHandlerRegistry, rSvc reportService) *reportsHandler {
+	h := &reportsHandler{
+		reg:  reg,
+		rSvc: rSvc,
+	}
+
+	h.register()
+	return h
+}
+
+// export runs the report and encodes the frame into the document
+//
+// Document is buffered since it can be consumed
+// by the following steps (e.g. attached to an email)
+func (h reportsHandler) export(ctx context.Context, args *reportsExportArgs) (results *reportsExportResults, err error) {
+	var (
+		buf = &bytes.Buffer{}
+		enc reporting.FrameEncoder
+		def = &reporting.FrameDefinition{
+			Source: args.Source,
+		}
+	)
+
+	if !args.hasFormat {
+		args.Format = "csv"
+	}
+
+	if enc, err = reporting.NewEncoder(args.Format, buf); err != nil {
+		return
+	}
+
+	if args.hasFilter && args.Filter != "" {
+		def.Filter = &types.ReportFilterExpr{}
+		if def.Filter.ASTNode, err = ql.NewParser().Parse(args.Filter); err != nil {
+			return
+		}
+	}
+
+	if args.hasSort && args.Sort != "" {
+		if err = def.Sort.Set(args.Sort); err != nil {
+			return
+		}
+	}
+
+	if err = h.rSvc.Export(ctx, args.Report, args.Scenario, def, enc); err != nil {
+		return
+	}
+
+	if !args.hasDocumentName {
+		args.DocumentName = fmt.Sprintf("%s.%s", args.Source, args.Format)
+	}
+
+	results = &reportsExportResults{
+		Document: &renderedDocument{
+			Document: bytes.NewReader(buf.Bytes()),
+			Name:     args.DocumentName,
+			Type:     reportDocumentType(args.Format),
+		},
+	}
+
+	return
+}
+
+func reportDocumentType(format string) string {
+	switch format {
+	case "xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case "ndjson", "jsonl":
+		return "application/jsonl"
+	}
+
+	return "text/csv"
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	cmpService "github.com/cortezaproject/corteza/server/compose/service"
+	cmpTypes "github.com/cortezaproject/corteza/server/compose/types"
+	"github.com/cortezaproject/corteza/server/pkg/cli"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/objstore/encrypted"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/spf13/cobra"
+)
+
+func Attachments(ctx context.Context, app serviceInitializer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attachments",
+		Short: "Attachment management",
+	}
+
+	cmd.AddCommand(attachmentsRotateKey(ctx, app))
+
+	return cmd
+}
+
+func attachmentsRotateKey(ctx context.Context, app serviceInitializer) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-wrap stored files with the active encryption key",
+		Long: "Re-wraps data keys of all stored system and compose attachments with the active (first) " +
+			"encryption key from STORAGE_ENCRYPTION_KEYS. Files that are not encrypted yet are encrypted.\n" +
+			"Once the command completes without errors, previous keys can be removed from the configuration.",
+		PreRunE: commandPreRunInitService(app),
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				sysFiles, sysOk = service.DefaultObjectStore.(encrypted.Rewrapper)
+				cmpFiles, cmpOk = cmpService.DefaultObjectStore.(encrypted.Rewrapper)
+
+				total, changed, failed int
+
+				rewrap = func(files encrypted.Rewrapper, names ...string) {
+					for _, name := range names {
+						if name == "" {
+							continue
+						}
+
+						total++
+						if ok, err := files.Rewrap(name); err != nil {
+							failed++
+							cmd.PrintErrf("could not re-wrap %s: %v\n", name, err)
+						} else if ok {
+							changed++
+						}
+					}
+				}
+			)
+
+			if !sysOk || !cmpOk {
+				cli.HandleError(fmt.Errorf("encryption at rest is not enabled, set STORAGE_ENCRYPTION_KEYS"))
+			}
+
+			// files of the deleted attachments are still stored
+			sa, _, err := store.SearchAttachments(ctx, service.DefaultStore, types.AttachmentFilter{
+				Deleted: filter.StateInclusive,
+			})
+			cli.HandleError(err)
+
+			for _, a := range sa {
+				rewrap(sysFiles, a.Url, a.PreviewUrl)
+			}
+
+			ca, _, err := store.SearchComposeAttachments(ctx, service.DefaultStore, cmpTypes.AttachmentFilter{
+				Deleted: filter.StateInclusive,
+			})
+			cli.HandleError(err)
+
+			for _, a := range ca {
+				rewrap(cmpFiles, a.Url, a.PreviewUrl)
+			}
+
+			cmd.Printf("%d of %d files re-wrapped\n", changed, total)
+
+			if failed > 0 {
+				cli.HandleError(fmt.Errorf("could not re-wrap %d files", failed))
+			}
+		},
+	}
+}
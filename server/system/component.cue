@@ -23,6 +23,8 @@ component: schema.#component & {
     "queue-message":         				queue_message
     "reminder":              				reminder
     "report":                				report
+    "report-subscription":   				report_subscription
+    "report-delivery":       				report_delivery
     "resource-translation":  				resource_translation
     "role":                  				role
     "role-member":           				role_member
@@ -32,6 +34,8 @@ component: schema.#component & {
     "dal-connection":        				dal_connection
     "dal-sensitivity-level": 				dal_sensitivity_level
     "dal-schema-alteration": 				dal_schema_alteration
+    "webhook":               				webhook
+    "webhook-delivery":      				webhook_delivery
 	}
 
 	rbac: operations: {
@@ -78,5 +82,8 @@ component: schema.#component & {
 
 		"data-privacy-request.create": description:  "Create data privacy requests"
 		"data-privacy-requests.search": description: "List, search or filter data privacy requests"
+
+		"webhook.create": description:  "Create webhooks"
+		"webhooks.search": description: "List, search or filter webhooks"
 	}
 }
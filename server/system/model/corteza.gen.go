@@ -13,6 +13,7 @@ import (
 	flagtype "github.com/cortezaproject/corteza/server/pkg/flag/types"
 	labelstype "github.com/cortezaproject/corteza/server/pkg/label/types"
 	rbactype "github.com/cortezaproject/corteza/server/pkg/rbac"
+	schedulertype "github.com/cortezaproject/corteza/server/pkg/scheduler"
 )
 
 var Action = &dal.Model{
@@ -161,6 +162,61 @@ var Action = &dal.Model{
 	},
 }
 
+var Claim = &dal.Model{
+	Ident:        "scheduler_claims",
+	ResourceType: schedulertype.ClaimResourceType,
+
+	Attributes: dal.AttributeSet{
+		&dal.Attribute{
+			Ident: "Job",
+			Type:  &dal.TypeText{Length: 512},
+			Store: &dal.CodecAlias{Ident: "job"},
+		},
+
+		&dal.Attribute{
+			Ident: "ClaimedBy",
+			Type:  &dal.TypeText{Length: 256},
+			Store: &dal.CodecAlias{Ident: "claimed_by"},
+		},
+
+		&dal.Attribute{
+			Ident: "ClaimedAt",
+			Type:  &dal.TypeTimestamp{Timezone: true, Precision: -1},
+			Store: &dal.CodecAlias{Ident: "claimed_at"},
+		},
+
+		&dal.Attribute{
+			Ident: "ExpiresAt",
+			Type:  &dal.TypeTimestamp{Timezone: true, Precision: -1},
+			Store: &dal.CodecAlias{Ident: "expires_at"},
+		},
+	},
+
+	Indexes: dal.IndexSet{
+		&dal.Index{
+			Ident: "scheduler_claims_expiresAt",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "ExpiresAt",
+				},
+			},
+		},
+
+		&dal.Index{
+			Ident: "PRIMARY",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "Job",
+				},
+			},
+		},
+	},
+}
+
 var Flag = &dal.Model{
 	Ident:        "flags",
 	ResourceType: flagtype.FlagResourceType,
@@ -430,6 +486,18 @@ var Rule = &dal.Model{
 			Type:  &dal.TypeNumber{Precision: -1, Scale: -1, Meta: map[string]interface{}{"rdbms:type": "integer"}},
 			Store: &dal.CodecAlias{Ident: "access"},
 		},
+
+		&dal.Attribute{
+			Ident: "NotBefore",
+			Type:  &dal.TypeTimestamp{Nullable: true, Timezone: true, Precision: -1},
+			Store: &dal.CodecAlias{Ident: "not_before"},
+		},
+
+		&dal.Attribute{
+			Ident: "NotAfter",
+			Type:  &dal.TypeTimestamp{Nullable: true, Timezone: true, Precision: -1},
+			Store: &dal.CodecAlias{Ident: "not_after"},
+		},
 	},
 
 	Indexes: dal.IndexSet{
@@ -454,13 +522,125 @@ var Rule = &dal.Model{
 	},
 }
 
+var RuleChange = &dal.Model{
+	Ident:        "rbac_rule_changes",
+	ResourceType: rbactype.RuleChangeResourceType,
+
+	Attributes: dal.AttributeSet{
+		&dal.Attribute{
+			Ident: "ID",
+			Type:  &dal.TypeID{},
+			Store: &dal.CodecAlias{Ident: "id"},
+		},
+
+		&dal.Attribute{
+			Ident: "ChangeSetID",
+			Type:  &dal.TypeID{},
+			Store: &dal.CodecAlias{Ident: "rel_change_set"},
+		},
+
+		&dal.Attribute{
+			Ident: "Timestamp", Sortable: true,
+			Type:  &dal.TypeTimestamp{Timezone: true, Precision: -1},
+			Store: &dal.CodecAlias{Ident: "ts"},
+		},
+
+		&dal.Attribute{
+			Ident: "ActorID",
+			Type: &dal.TypeRef{
+				RefAttribute: "id",
+				RefModel: &dal.ModelRef{
+					ResourceType: "corteza::system:user",
+				},
+			},
+			Store: &dal.CodecAlias{Ident: "actor_id"},
+		},
+
+		&dal.Attribute{
+			Ident: "RoleID",
+			Type: &dal.TypeRef{
+				RefAttribute: "id",
+				RefModel: &dal.ModelRef{
+					ResourceType: "corteza::system:role",
+				},
+			},
+			Store: &dal.CodecAlias{Ident: "rel_role"},
+		},
+
+		&dal.Attribute{
+			Ident: "Resource",
+			Type:  &dal.TypeText{Length: 512},
+			Store: &dal.CodecAlias{Ident: "resource"},
+		},
+
+		&dal.Attribute{
+			Ident: "Operation",
+			Type:  &dal.TypeText{Length: 50},
+			Store: &dal.CodecAlias{Ident: "operation"},
+		},
+
+		&dal.Attribute{
+			Ident: "Before",
+			Type: &dal.TypeJSON{
+				DefaultValue: "{}",
+			},
+			Store: &dal.CodecAlias{Ident: "before"},
+		},
+
+		&dal.Attribute{
+			Ident: "After",
+			Type: &dal.TypeJSON{
+				DefaultValue: "{}",
+			},
+			Store: &dal.CodecAlias{Ident: "after"},
+		},
+	},
+
+	Indexes: dal.IndexSet{
+		&dal.Index{
+			Ident: "rbac_rule_changes_changeSet",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "ChangeSetID",
+				},
+			},
+		},
+
+		&dal.Index{
+			Ident: "PRIMARY",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "ID",
+				},
+			},
+		},
+
+		&dal.Index{
+			Ident: "rbac_rule_changes_role",
+			Type:  "BTREE",
+
+			Fields: []*dal.IndexField{
+				{
+					AttributeIdent: "RoleID",
+				},
+			},
+		},
+	},
+}
+
 func init() {
 	models = append(
 		models,
 		Action,
+		Claim,
 		Flag,
 		Label,
 		ResourceActivity,
 		Rule,
+		RuleChange,
 	)
 }
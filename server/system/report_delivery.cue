@@ -0,0 +1,78 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+report_delivery: {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	model: {
+		omitGetterSetter: true
+
+		attributes: {
+			id: schema.IdField
+			subscription_id: {
+				ident: "subscriptionID",
+				goType: "uint64",
+				storeIdent: "rel_subscription"
+				dal: { type: "Ref", refModelResType: "corteza::system:report-subscription" }
+			}
+			report_id: {
+				ident: "reportID",
+				goType: "uint64",
+				storeIdent: "rel_report"
+				dal: { type: "Ref", refModelResType: "corteza::system:report" }
+			}
+			format: {
+				goType: "types.ReportSubscriptionFormat"
+				dal: { type: "Text", length: 16 }
+			}
+			recipients: {
+				goType: "types.ReportDeliveryRecipients"
+				dal: { type: "JSON", defaultEmptyObject: true }
+			}
+			status: {
+				sortable: true
+				goType: "types.ReportDeliveryStatus"
+				dal: { type: "Text", length: 32 }
+			}
+			error: {
+				dal: {}
+			}
+			created_at:   schema.SortableTimestampNowField
+			completed_at: schema.SortableTimestampNilField
+		}
+
+		indexes: {
+			"primary":      { attribute: "id" }
+			"subscription": { attribute: "subscription_id" }
+		}
+	}
+
+	envoy: {
+		omit: true
+	}
+
+	filter: {
+		struct: {
+			delivery_id: {goType: "[]uint64", ident: "deliveryID", storeIdent: "id"}
+			subscription_id: {goType: "[]uint64", ident: "subscriptionID", storeIdent: "rel_subscription"}
+			report_id: {goType: "[]uint64", ident: "reportID", storeIdent: "rel_report"}
+			status: {goType: "[]types.ReportDeliveryStatus"}
+		}
+
+		byValue: ["delivery_id", "subscription_id", "report_id"]
+	}
+
+	store: {
+		api: {
+			lookups: [
+				{ fields: ["id"] }
+			]
+		}
+	}
+}
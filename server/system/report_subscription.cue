@@ -0,0 +1,100 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+report_subscription: {
+	features: {
+		labels: false
+	}
+
+	model: {
+		attributes: {
+			id: schema.IdField
+			report_id: {
+				ident: "reportID",
+				goType: "uint64",
+				storeIdent: "rel_report"
+				dal: { type: "Ref", refModelResType: "corteza::system:report" }
+			}
+			scenario_id: {
+				ident: "scenarioID",
+				goType: "uint64",
+				dal: { type: "ID" }
+			}
+			schedule: {
+				dal: { type: "Text", length: 128 }
+			}
+			format: {
+				goType: "types.ReportSubscriptionFormat"
+				dal: { type: "Text", length: 16 }
+				omitSetter: true
+				omitGetter: true
+			}
+			enabled: {
+				sortable: true,
+				goType: "bool"
+				dal: { type: "Boolean" }
+			}
+			recipients: {
+				goType: "types.ReportSubscriptionRecipients"
+				dal: { type: "JSON", defaultEmptyObject: true }
+				omitSetter: true
+				omitGetter: true
+			}
+			meta: {
+				goType: "*types.ReportSubscriptionMeta"
+				dal: { type: "JSON", defaultEmptyObject: true }
+				omitSetter: true
+				omitGetter: true
+			}
+
+			last_run_at: schema.SortableTimestampNilField
+			owned_by:    schema.AttributeUserRef
+			created_at:  schema.SortableTimestampNowField
+			updated_at:  schema.SortableTimestampNilField
+			deleted_at:  schema.SortableTimestampNilField
+			created_by:  schema.AttributeUserRef
+			updated_by:  schema.AttributeUserRef
+			deleted_by:  schema.AttributeUserRef
+		}
+
+		indexes: {
+			"primary": { attribute: "id" }
+			"report":  { attribute: "report_id" }
+		}
+	}
+
+	envoy: {
+		omit: true
+	}
+
+	filter: {
+		struct: {
+			subscription_id: {goType: "[]uint64", ident: "subscriptionID", storeIdent: "id"}
+			report_id: {goType: "[]uint64", ident: "reportID", storeIdent: "rel_report"}
+			deleted: {goType: "filter.State", storeIdent: "deleted_at"}
+			disabled: {goType: "filter.State", storeIdent: "enabled"}
+		}
+
+		byValue: ["subscription_id", "report_id"]
+		byNilState: ["deleted"]
+		byFalseState: ["disabled"]
+	}
+
+	store: {
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for report subscription by ID
+
+						It returns report subscription even if deleted or disabled
+						"""
+				},
+			]
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package reporting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type (
+	// FrameEncoder writes frames in a specific format
+	//
+	// Frame starts a new frame (and writes the header, if needed), Rows
+	// writes the rows of the current frame and Flush finishes the document.
+	// Rows can be written in batches so the entire frame does not need to
+	// be kept in memory.
+	FrameEncoder interface {
+		Frame(f *Frame) error
+		Rows(rr ...FrameRow) error
+		Flush() error
+	}
+
+	csvEncoder struct {
+		w      *csv.Writer
+		frames int
+	}
+
+	ndjsonEncoder struct {
+		enc    *json.Encoder
+		cols   FrameColumnSet
+		frames int
+	}
+
+	xlsxEncoder struct {
+		w io.Writer
+		f *excelize.File
+
+		sw     *excelize.StreamWriter
+		cols   FrameColumnSet
+		row    int
+		sheets map[string]bool
+	}
+)
+
+const (
+	// max length of the sheet name, imposed by the spreadsheet apps
+	xlsxSheetNameMaxLength = 31
+)
+
+var (
+	sheetNameCleaner = strings.NewReplacer(
+		":", " ", "\\", " ", "/", " ", "?", " ",
+		"*", " ", "[", " ", "]", " ",
+	)
+)
+
+// NewCSVEncoder initializes an encoder that writes a single frame as CSV
+//
+// The first row holds column labels (or names, when labels are not set)
+func NewCSVEncoder(w io.Writer) FrameEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+// NewNDJSONEncoder initializes an encoder that writes a single frame
+// as newline delimited JSON, one object per row
+//
+// Rows are keyed by column names; values of the Number columns are written as numbers
+func NewNDJSONEncoder(w io.Writer) FrameEncoder {
+	return &ndjsonEncoder{enc: json.NewEncoder(w)}
+}
+
+// NewEncoder initializes an encoder for the given format (csv, xlsx or ndjson)
+func NewEncoder(format string, w io.Writer) (FrameEncoder, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return NewCSVEncoder(w), nil
+	case "xlsx":
+		return NewXLSXEncoder(w), nil
+	case "ndjson", "jsonl":
+		return NewNDJSONEncoder(w), nil
+	}
+
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// NewXLSXEncoder initializes an encoder that writes frames
+// to a workbook, each frame to its own sheet
+//
+// Values of the Number columns are written as typed cells
+func NewXLSXEncoder(w io.Writer) FrameEncoder {
+	return &xlsxEncoder{
+		w:      w,
+		f:      excelize.NewFile(),
+		sheets: make(map[string]bool),
+	}
+}
+
+// Encode writes all of the frames with the given encoder
+func Encode(enc FrameEncoder, ff ...*Frame) (err error) {
+	for _, f := range ff {
+		if err = enc.Frame(f); err != nil {
+			return
+		}
+
+		if err = enc.Rows(f.Rows...); err != nil {
+			return
+		}
+	}
+
+	return enc.Flush()
+}
+
+func (enc *csvEncoder) Frame(f *Frame) error {
+	if enc.frames > 0 {
+		return fmt.Errorf("CSV can only hold a single frame")
+	}
+	enc.frames++
+
+	return enc.w.Write(f.Columns.labels())
+}
+
+func (enc *csvEncoder) Rows(rr ...FrameRow) (err error) {
+	for _, r := range rr {
+		if err = enc.w.Write(r); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (enc *csvEncoder) Flush() error {
+	enc.w.Flush()
+	return enc.w.Error()
+}
+
+func (enc *ndjsonEncoder) Frame(f *Frame) error {
+	if enc.frames > 0 {
+		return fmt.Errorf("NDJSON can only hold a single frame")
+	}
+	enc.frames++
+
+	enc.cols = f.Columns
+	return nil
+}
+
+func (enc *ndjsonEncoder) Rows(rr ...FrameRow) (err error) {
+	for _, r := range rr {
+		row := make(map[string]interface{}, len(r))
+		for i, v := range r {
+			if i >= len(enc.cols) {
+				break
+			}
+
+			row[enc.cols[i].Name] = v
+
+			if enc.cols[i].Kind != "Number" || v == "" {
+				continue
+			}
+
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				row[enc.cols[i].Name] = json.Number(v)
+			}
+		}
+
+		if err = enc.enc.Encode(row); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (enc *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+func (enc *xlsxEncoder) Frame(f *Frame) (err error) {
+	if err = enc.flushSheet(); err != nil {
+		return
+	}
+
+	sheet := enc.sheetName(f)
+
+	if len(enc.sheets) == 0 {
+		// reuse the default sheet
+		err = enc.f.SetSheetName(enc.f.GetSheetName(0), sheet)
+	} else {
+		_, err = enc.f.NewSheet(sheet)
+	}
+
+	if err != nil {
+		return
+	}
+
+	enc.sheets[sheet] = true
+
+	if enc.sw, err = enc.f.NewStreamWriter(sheet); err != nil {
+		return
+	}
+
+	enc.cols = f.Columns
+	enc.row = 1
+
+	hh := make([]interface{}, len(f.Columns))
+	for i, l := range f.Columns.labels() {
+		hh[i] = l
+	}
+
+	return enc.setRow(hh)
+}
+
+func (enc *xlsxEncoder) Rows(rr ...FrameRow) (err error) {
+	if enc.sw == nil {
+		return fmt.Errorf("frame not started")
+	}
+
+	for _, r := range rr {
+		row := make([]interface{}, len(r))
+		for i, v := range r {
+			row[i] = v
+
+			if i >= len(enc.cols) || enc.cols[i].Kind != "Number" || v == "" {
+				continue
+			}
+
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				row[i] = n
+			}
+		}
+
+		if err = enc.setRow(row); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (enc *xlsxEncoder) Flush() (err error) {
+	defer enc.f.Close()
+
+	if err = enc.flushSheet(); err != nil {
+		return
+	}
+
+	return enc.f.Write(enc.w)
+}
+
+func (enc *xlsxEncoder) setRow(row []interface{}) (err error) {
+	cell, err := excelize.CoordinatesToCellName(1, enc.row)
+	if err != nil {
+		return
+	}
+
+	enc.row++
+	return enc.sw.SetRow(cell, row)
+}
+
+func (enc *xlsxEncoder) flushSheet() (err error) {
+	if enc.sw == nil {
+		return
+	}
+
+	err = enc.sw.Flush()
+	enc.sw = nil
+	return
+}
+
+// sheetName returns a valid and unique sheet name for the frame
+func (enc *xlsxEncoder) sheetName(f *Frame) string {
+	base := f.Name
+	if base == "" {
+		base = f.Source
+	}
+
+	base = strings.TrimSpace(sheetNameCleaner.Replace(base))
+	if base == "" {
+		base = "Sheet"
+	}
+
+	name := truncate(base, xlsxSheetNameMaxLength)
+	for i := 2; enc.sheets[name]; i++ {
+		sfx := fmt.Sprintf(" (%d)", i)
+		name = truncate(base, xlsxSheetNameMaxLength-len(sfx)) + sfx
+	}
+
+	return name
+}
+
+// labels returns column labels or names when labels are not set
+func (cc FrameColumnSet) labels() []string {
+	out := make([]string, len(cc))
+	for i, c := range cc {
+		out[i] = c.Label
+		if out[i] == "" {
+			out[i] = c.Name
+		}
+	}
+
+	return out
+}
+
+func truncate(s string, l int) string {
+	if utf8.RuneCountInString(s) <= l {
+		return s
+	}
+
+	return string([]rune(s)[:l])
+}
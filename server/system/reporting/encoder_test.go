@@ -0,0 +1,102 @@
+package reporting
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestEncoders(t *testing.T) {
+	var (
+		frames = []*Frame{
+			{
+				Name: "sales",
+				Columns: FrameColumnSet{
+					{Name: "name", Label: "Name", Kind: "String"},
+					{Name: "total", Kind: "Number"},
+				},
+				Rows: []FrameRow{
+					{"Ann", "10.5"},
+					{"Bob, Jr.", ""},
+				},
+			},
+			{
+				Source: "a/b:c",
+				Columns: FrameColumnSet{
+					{Name: "id", Kind: "Number"},
+				},
+				Rows: []FrameRow{{"1"}},
+			},
+		}
+	)
+
+	t.Run("csv", func(t *testing.T) {
+		req := require.New(t)
+		buf := &bytes.Buffer{}
+
+		req.NoError(Encode(NewCSVEncoder(buf), frames[0]))
+		req.Equal("Name,total\nAnn,10.5\n\"Bob, Jr.\",\n", buf.String())
+	})
+
+	t.Run("csv multiple frames", func(t *testing.T) {
+		req := require.New(t)
+		req.Error(Encode(NewCSVEncoder(&bytes.Buffer{}), frames...))
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		req := require.New(t)
+		buf := &bytes.Buffer{}
+
+		req.NoError(Encode(NewNDJSONEncoder(buf), frames[0]))
+		req.Equal("{\"name\":\"Ann\",\"total\":10.5}\n{\"name\":\"Bob, Jr.\",\"total\":\"\"}\n", buf.String())
+	})
+
+	t.Run("ndjson in batches", func(t *testing.T) {
+		req := require.New(t)
+		buf := &bytes.Buffer{}
+		enc := NewNDJSONEncoder(buf)
+
+		req.NoError(enc.Frame(frames[1]))
+		req.NoError(enc.Rows(FrameRow{"1"}))
+		req.NoError(enc.Rows(FrameRow{"2"}, FrameRow{"3"}))
+		req.NoError(enc.Flush())
+		req.Equal("{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n", buf.String())
+	})
+
+	t.Run("by format", func(t *testing.T) {
+		req := require.New(t)
+
+		for _, f := range []string{"csv", "XLSX", "ndjson", "jsonl"} {
+			enc, err := NewEncoder(f, &bytes.Buffer{})
+			req.NoError(err)
+			req.NotNil(enc)
+		}
+
+		_, err := NewEncoder("pdf", &bytes.Buffer{})
+		req.Error(err)
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		req := require.New(t)
+		buf := &bytes.Buffer{}
+
+		req.NoError(Encode(NewXLSXEncoder(buf), frames...))
+
+		f, err := excelize.OpenReader(buf)
+		req.NoError(err)
+		defer f.Close()
+
+		req.Equal([]string{"sales", "a b c"}, f.GetSheetList())
+
+		rr, err := f.GetRows("sales")
+		req.NoError(err)
+		req.Equal([][]string{{"Name", "total"}, {"Ann", "10.5"}, {"Bob, Jr."}}, rr)
+
+		typ, err := f.GetCellType("sales", "B2")
+		req.NoError(err)
+		req.NotEqual(excelize.CellTypeSharedString, typ)
+		req.NotEqual(excelize.CellTypeInlineString, typ)
+	})
+}
@@ -353,6 +353,20 @@ func makePipeline(mf modelFinder, ss types.ReportStepSet, defs FrameDefinitionSe
 			}
 			pp = append(pp, aux)
 
+		case step.Union != nil:
+			aux, err := convStepUnion(*step.Union, defs.FilterBySource(step.Union.Name))
+			if err != nil {
+				return nil, err
+			}
+			pp = append(pp, aux)
+
+		case step.Window != nil:
+			aux, err := convStepWindow(*step.Window, defs.FilterBySource(step.Window.Name))
+			if err != nil {
+				return nil, err
+			}
+			pp = append(pp, aux)
+
 		default:
 			// this should never happen
 			panic(fmt.Errorf("unknown step type: %v", step.Kind))
@@ -559,6 +573,7 @@ func convStepJoin(step types.ReportStepJoin, defs FrameDefinitionSet) (out *dal.
 	// Make pipeline step
 	out = &dal.Join{
 		Ident:    step.Name,
+		Type:     dal.JoinType(step.Type),
 		RelLeft:  step.LocalSource,
 		RelRight: step.ForeignSource,
 
@@ -572,6 +587,78 @@ func convStepJoin(step types.ReportStepJoin, defs FrameDefinitionSet) (out *dal.
 	return
 }
 
+// convStepUnion converts ReportStepUnion to dal.Union
+func convStepUnion(step types.ReportStepUnion, defs FrameDefinitionSet) (out *dal.Union, err error) {
+	// Validation
+	if len(defs) > 1 {
+		err = fmt.Errorf("cannot convert union step: expecting at most one definition, got %d", len(defs))
+		return
+	}
+
+	// Get additional filtering
+	var extf filter.Filter
+	if len(defs) == 1 {
+		extf = filterFromDef(defs[0])
+	}
+
+	f, err := dal.FilterFromExpr(step.Filter.Node()).
+		MergeFilters(extf)
+	if err != nil {
+		return
+	}
+
+	// Make pipeline step
+	out = &dal.Union{
+		Ident:      step.Name,
+		RelSources: step.Sources,
+
+		Filter: f,
+	}
+	return
+}
+
+// convStepWindow converts ReportStepWindow to dal.Window
+func convStepWindow(step types.ReportStepWindow, defs FrameDefinitionSet) (out *dal.Window, err error) {
+	// Validation
+	if len(defs) > 1 {
+		err = fmt.Errorf("cannot convert window step: expecting at most one definition, got %d", len(defs))
+		return
+	}
+
+	// Get additional filtering
+	var extf filter.Filter
+	if len(defs) == 1 {
+		extf = filterFromDef(defs[0])
+	}
+
+	f, err := dal.FilterFromExpr(step.Filter.Node()).
+		MergeFilters(extf)
+	if err != nil {
+		return
+	}
+
+	vvs := make([]dal.WindowAttr, 0, len(step.Columns))
+	for _, c := range step.Columns {
+		vvs = append(vvs, dal.WindowAttr{
+			Identifier: c.Name,
+			Label:      c.Label,
+			Expression: c.Def.Node(),
+		})
+	}
+
+	// Make pipeline step
+	out = &dal.Window{
+		Ident:     step.Name,
+		RelSource: step.Source,
+		Filter:    f,
+
+		PartitionBy:   step.PartitionBy,
+		OrderBy:       step.Sort,
+		OutAttributes: vvs,
+	}
+	return
+}
+
 // convStepLink converts ReportStepLink to dal.Link
 func convStepLink(step types.ReportStepLink, defs FrameDefinitionSet) (out *dal.Link, err error) {
 	// Validation
@@ -163,6 +163,10 @@ func TestRuns(t *testing.T) {
 
 			// join
 			{Name: "d4", Source: "jn"},
+			{Name: "d5", Source: "jnf"},
+
+			// union
+			{Name: "d6", Source: "un"},
 		}
 
 		rr, err := Runs(
@@ -215,11 +219,34 @@ func TestRuns(t *testing.T) {
 					LocalColumn:   "lc",
 					ForeignColumn: "fc",
 				}},
+				{Join: &types.ReportStepJoin{
+					Name:          "jnf",
+					Type:          "full",
+					LocalSource:   "l1",
+					ForeignSource: "l2",
+					LocalColumn:   "lc",
+					ForeignColumn: "fc",
+				}},
+
+				// Union
+				{Union: &types.ReportStepUnion{
+					Name:    "un",
+					Sources: []string{"l1", "l2"},
+				}},
 			},
 			defs,
 		)
 		require.NoError(t, err)
-		require.Len(t, rr, 4)
+		require.Len(t, rr, 6)
+
+		un, ok := rr[5].Pipeline[0].(*dal.Union)
+		require.True(t, ok)
+		require.Len(t, rr[5].Pipeline, 3)
+		require.Equal(t, []string{"l1", "l2"}, un.Sources())
+
+		jn, ok := rr[4].Pipeline[0].(*dal.Join)
+		require.True(t, ok)
+		require.Equal(t, dal.JoinFull, jn.Type)
 	})
 }
 
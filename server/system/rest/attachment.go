@@ -107,6 +107,15 @@ func (ctrl Attachment) serve(ctx context.Context, attachmentID uint64, preview,
 			return
 		}
 
+		// Let the client download the file directly from the object store when possible
+		if u, err := ctrl.attachment.PresignedURL(att, preview, download); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		} else if u != "" {
+			http.Redirect(w, req, u, http.StatusFound)
+			return
+		}
+
 		var fh io.ReadSeekCloser
 
 		if preview {
@@ -22,6 +22,11 @@ type (
 		List(context.Context, *request.PermissionsList) (interface{}, error)
 		Effective(context.Context, *request.PermissionsEffective) (interface{}, error)
 		Trace(context.Context, *request.PermissionsTrace) (interface{}, error)
+		Simulate(context.Context, *request.PermissionsSimulate) (interface{}, error)
+		Diff(context.Context, *request.PermissionsDiff) (interface{}, error)
+		History(context.Context, *request.PermissionsHistory) (interface{}, error)
+		HistoryDiff(context.Context, *request.PermissionsHistoryDiff) (interface{}, error)
+		Rollback(context.Context, *request.PermissionsRollback) (interface{}, error)
 		Read(context.Context, *request.PermissionsRead) (interface{}, error)
 		Delete(context.Context, *request.PermissionsDelete) (interface{}, error)
 		Update(context.Context, *request.PermissionsUpdate) (interface{}, error)
@@ -29,12 +34,17 @@ type (
 
 	// HTTP API interface
 	Permissions struct {
-		List      func(http.ResponseWriter, *http.Request)
-		Effective func(http.ResponseWriter, *http.Request)
-		Trace     func(http.ResponseWriter, *http.Request)
-		Read      func(http.ResponseWriter, *http.Request)
-		Delete    func(http.ResponseWriter, *http.Request)
-		Update    func(http.ResponseWriter, *http.Request)
+		List        func(http.ResponseWriter, *http.Request)
+		Effective   func(http.ResponseWriter, *http.Request)
+		Trace       func(http.ResponseWriter, *http.Request)
+		Simulate    func(http.ResponseWriter, *http.Request)
+		Diff        func(http.ResponseWriter, *http.Request)
+		History     func(http.ResponseWriter, *http.Request)
+		HistoryDiff func(http.ResponseWriter, *http.Request)
+		Rollback    func(http.ResponseWriter, *http.Request)
+		Read        func(http.ResponseWriter, *http.Request)
+		Delete      func(http.ResponseWriter, *http.Request)
+		Update      func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -88,6 +98,86 @@ func NewPermissions(h PermissionsAPI) *Permissions {
 
 			api.Send(w, r, value)
 		},
+		Simulate: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewPermissionsSimulate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Simulate(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Diff: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewPermissionsDiff()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Diff(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		History: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewPermissionsHistory()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.History(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		HistoryDiff: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewPermissionsHistoryDiff()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.HistoryDiff(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Rollback: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewPermissionsRollback()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Rollback(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
 		Read: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewPermissionsRead()
@@ -145,6 +235,11 @@ func (h Permissions) MountRoutes(r chi.Router, middlewares ...func(http.Handler)
 		r.Get("/permissions/", h.List)
 		r.Get("/permissions/effective", h.Effective)
 		r.Get("/permissions/trace", h.Trace)
+		r.Get("/permissions/simulate", h.Simulate)
+		r.Get("/permissions/diff", h.Diff)
+		r.Get("/permissions/history", h.History)
+		r.Get("/permissions/history/diff", h.HistoryDiff)
+		r.Post("/permissions/history/{changeSetID}/rollback", h.Rollback)
 		r.Get("/permissions/{roleID}/rules", h.Read)
 		r.Delete("/permissions/{roleID}/rules", h.Delete)
 		r.Patch("/permissions/{roleID}/rules", h.Update)
@@ -27,6 +27,7 @@ type (
 		Undelete(context.Context, *request.ReportUndelete) (interface{}, error)
 		Describe(context.Context, *request.ReportDescribe) (interface{}, error)
 		Run(context.Context, *request.ReportRun) (interface{}, error)
+		Export(context.Context, *request.ReportExport) (interface{}, error)
 	}
 
 	// HTTP API interface
@@ -39,6 +40,7 @@ type (
 		Undelete func(http.ResponseWriter, *http.Request)
 		Describe func(http.ResponseWriter, *http.Request)
 		Run      func(http.ResponseWriter, *http.Request)
+		Export   func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -170,6 +172,22 @@ func NewReport(h ReportAPI) *Report {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		Export: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportExport()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Export(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -186,5 +204,6 @@ func (h Report) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http
 		r.Post("/reports/{reportID}/undelete", h.Undelete)
 		r.Post("/reports/describe", h.Describe)
 		r.Post("/reports/{reportID}/run", h.Run)
+		r.Get("/reports/{reportID}/export/{filename}.{ext}", h.Export)
 	})
 }
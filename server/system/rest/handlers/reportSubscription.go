@@ -0,0 +1,171 @@
+package handlers
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"context"
+	"github.com/cortezaproject/corteza/server/pkg/api"
+	"github.com/cortezaproject/corteza/server/system/rest/request"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+type (
+	// Internal API interface
+	ReportSubscriptionAPI interface {
+		List(context.Context, *request.ReportSubscriptionList) (interface{}, error)
+		Create(context.Context, *request.ReportSubscriptionCreate) (interface{}, error)
+		Read(context.Context, *request.ReportSubscriptionRead) (interface{}, error)
+		Update(context.Context, *request.ReportSubscriptionUpdate) (interface{}, error)
+		Delete(context.Context, *request.ReportSubscriptionDelete) (interface{}, error)
+		Deliveries(context.Context, *request.ReportSubscriptionDeliveries) (interface{}, error)
+		Deliver(context.Context, *request.ReportSubscriptionDeliver) (interface{}, error)
+	}
+
+	// HTTP API interface
+	ReportSubscription struct {
+		List       func(http.ResponseWriter, *http.Request)
+		Create     func(http.ResponseWriter, *http.Request)
+		Read       func(http.ResponseWriter, *http.Request)
+		Update     func(http.ResponseWriter, *http.Request)
+		Delete     func(http.ResponseWriter, *http.Request)
+		Deliveries func(http.ResponseWriter, *http.Request)
+		Deliver    func(http.ResponseWriter, *http.Request)
+	}
+)
+
+func NewReportSubscription(h ReportSubscriptionAPI) *ReportSubscription {
+	return &ReportSubscription{
+		List: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionList()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.List(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Create: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionCreate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Create(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Read: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionRead()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Read(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Update: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionUpdate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Update(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Delete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionDelete()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Delete(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Deliveries: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionDeliveries()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Deliveries(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Deliver: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewReportSubscriptionDeliver()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Deliver(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+	}
+}
+
+func (h ReportSubscription) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares...)
+		r.Get("/report-subscriptions/", h.List)
+		r.Post("/report-subscriptions/", h.Create)
+		r.Get("/report-subscriptions/{subscriptionID}", h.Read)
+		r.Put("/report-subscriptions/{subscriptionID}", h.Update)
+		r.Delete("/report-subscriptions/{subscriptionID}", h.Delete)
+		r.Get("/report-subscriptions/{subscriptionID}/deliveries/", h.Deliveries)
+		r.Post("/report-subscriptions/{subscriptionID}/deliver", h.Deliver)
+	})
+}
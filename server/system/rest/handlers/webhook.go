@@ -0,0 +1,190 @@
+package handlers
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"context"
+	"github.com/cortezaproject/corteza/server/pkg/api"
+	"github.com/cortezaproject/corteza/server/system/rest/request"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+type (
+	// Internal API interface
+	WebhookAPI interface {
+		List(context.Context, *request.WebhookList) (interface{}, error)
+		Create(context.Context, *request.WebhookCreate) (interface{}, error)
+		Read(context.Context, *request.WebhookRead) (interface{}, error)
+		Update(context.Context, *request.WebhookUpdate) (interface{}, error)
+		Delete(context.Context, *request.WebhookDelete) (interface{}, error)
+		Undelete(context.Context, *request.WebhookUndelete) (interface{}, error)
+		Deliveries(context.Context, *request.WebhookDeliveries) (interface{}, error)
+		Redeliver(context.Context, *request.WebhookRedeliver) (interface{}, error)
+	}
+
+	// HTTP API interface
+	Webhook struct {
+		List       func(http.ResponseWriter, *http.Request)
+		Create     func(http.ResponseWriter, *http.Request)
+		Read       func(http.ResponseWriter, *http.Request)
+		Update     func(http.ResponseWriter, *http.Request)
+		Delete     func(http.ResponseWriter, *http.Request)
+		Undelete   func(http.ResponseWriter, *http.Request)
+		Deliveries func(http.ResponseWriter, *http.Request)
+		Redeliver  func(http.ResponseWriter, *http.Request)
+	}
+)
+
+func NewWebhook(h WebhookAPI) *Webhook {
+	return &Webhook{
+		List: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookList()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.List(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Create: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookCreate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Create(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Read: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookRead()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Read(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Update: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookUpdate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Update(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Delete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookDelete()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Delete(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Undelete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookUndelete()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Undelete(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Deliveries: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookDeliveries()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Deliveries(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Redeliver: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWebhookRedeliver()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Redeliver(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+	}
+}
+
+func (h Webhook) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares...)
+		r.Get("/webhooks/", h.List)
+		r.Post("/webhooks/", h.Create)
+		r.Get("/webhooks/{webhookID}", h.Read)
+		r.Put("/webhooks/{webhookID}", h.Update)
+		r.Delete("/webhooks/{webhookID}", h.Delete)
+		r.Post("/webhooks/{webhookID}/undelete", h.Undelete)
+		r.Get("/webhooks/{webhookID}/deliveries/", h.Deliveries)
+		r.Post("/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver", h.Redeliver)
+	})
+}
@@ -17,10 +17,21 @@ type (
 	permissionsAccessController interface {
 		Effective(context.Context, ...rbac.Resource) rbac.EffectiveSet
 		Trace(context.Context, uint64, []uint64, ...string) ([]*rbac.Trace, error)
+		Simulate(context.Context, rbac.SimulationSubject, ...string) (rbac.EvaluationSet, error)
+		Diff(context.Context, rbac.SimulationSubject, rbac.SimulationSubject, ...string) (rbac.EvaluationDiffSet, error)
 		List() []map[string]string
 		FindRulesByRoleID(context.Context, uint64) (rbac.RuleSet, error)
 		FindRules(ctx context.Context, roleID uint64, rr ...string) (rbac.RuleSet, error)
 		Grant(ctx context.Context, rr ...*rbac.Rule) error
+
+		RuleChanges(context.Context, rbac.RuleChangeFilter) (rbac.RuleChangeSet, rbac.RuleChangeFilter, error)
+		RuleChangesDiff(ctx context.Context, from, to, roleID uint64) (rbac.RuleChangeSet, error)
+		Rollback(ctx context.Context, changeSetID, roleID uint64, resource string) (rbac.RuleSet, error)
+	}
+
+	ruleChangesPayload struct {
+		Filter rbac.RuleChangeFilter `json:"filter"`
+		Set    rbac.RuleChangeSet    `json:"set"`
 	}
 )
 
@@ -38,6 +49,65 @@ func (ctrl Permissions) Trace(ctx context.Context, r *request.PermissionsTrace)
 	return ctrl.ac.Trace(ctx, r.UserID, r.RoleID, r.Resource...)
 }
 
+func (ctrl Permissions) Simulate(ctx context.Context, r *request.PermissionsSimulate) (interface{}, error) {
+	sub := rbac.SimulationSubject{UserID: r.UserID, Roles: r.RoleID}
+	if r.At != nil {
+		sub.At = *r.At
+	}
+
+	return ctrl.ac.Simulate(ctx, sub, r.Resource...)
+}
+
+func (ctrl Permissions) Diff(ctx context.Context, r *request.PermissionsDiff) (interface{}, error) {
+	var (
+		a = rbac.SimulationSubject{UserID: r.UserID, Roles: r.RoleID}
+		b = a
+	)
+
+	if r.At != nil {
+		a.At = *r.At
+		b.At = *r.At
+	}
+
+	if r.CompareUserID > 0 || len(r.CompareRoleID) > 0 {
+		// compare with another user or role combo
+		b.UserID, b.Roles = r.CompareUserID, r.CompareRoleID
+	}
+
+	if r.CompareAt != nil {
+		b.At = *r.CompareAt
+	}
+
+	return ctrl.ac.Diff(ctx, a, b, r.Resource...)
+}
+
+func (ctrl Permissions) History(ctx context.Context, r *request.PermissionsHistory) (interface{}, error) {
+	f := rbac.RuleChangeFilter{
+		ChangeSetID:      r.ChangeSetID,
+		AfterChangeSetID: r.AfterChangeSetID,
+		Limit:            r.Limit,
+	}
+
+	if r.RoleID > 0 {
+		f.RoleID = []uint64{r.RoleID}
+	}
+
+	cc, f, err := ctrl.ac.RuleChanges(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleChangesPayload{Filter: f, Set: cc}, nil
+}
+
+func (ctrl Permissions) HistoryDiff(ctx context.Context, r *request.PermissionsHistoryDiff) (interface{}, error) {
+	return ctrl.ac.RuleChangesDiff(ctx, r.FromChangeSetID, r.ToChangeSetID, r.RoleID)
+}
+
+func (ctrl Permissions) Rollback(ctx context.Context, r *request.PermissionsRollback) (interface{}, error) {
+	return ctrl.ac.Rollback(ctx, r.ChangeSetID, r.RoleID, r.Resource)
+}
+
 func (ctrl Permissions) List(ctx context.Context, r *request.PermissionsList) (interface{}, error) {
 	return ctrl.ac.List(), nil
 }
@@ -2,9 +2,13 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/cortezaproject/corteza/server/pkg/api"
 	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
 	"github.com/cortezaproject/corteza/server/system/reporting"
 	"github.com/cortezaproject/corteza/server/system/rest/request"
 	"github.com/cortezaproject/corteza/server/system/service"
@@ -25,6 +29,7 @@ type (
 		Delete(ctx context.Context, ID uint64) (err error)
 		Undelete(ctx context.Context, ID uint64) (err error)
 		Run(ctx context.Context, ID uint64, dd reporting.FrameDefinitionSet) (rr []*reporting.Frame, err error)
+		StartExport(ctx context.Context, reportID, scenarioID uint64, def *reporting.FrameDefinition) (write func(reporting.FrameEncoder) error, err error)
 		Describe(ctx context.Context, src types.ReportDataSourceSet, st types.ReportStepSet, sources ...string) (out []reporting.FrameDescription, err error)
 	}
 
@@ -147,6 +152,68 @@ func (ctrl *Report) Run(ctx context.Context, r *request.ReportRun) (interface{},
 	return ctrl.makeReportFramePayload(ctx, rr, err)
 }
 
+// Export streams a single frame of the report in the requested format
+func (ctrl *Report) Export(ctx context.Context, r *request.ReportExport) (interface{}, error) {
+	var (
+		err error
+		def = &reporting.FrameDefinition{
+			Name:   r.Name,
+			Source: r.Source,
+		}
+
+		contentType string
+	)
+
+	switch strings.ToLower(r.Ext) {
+	case "csv":
+		contentType = "text/csv"
+	case "xlsx":
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case "ndjson", "jsonl":
+		contentType = "application/jsonl"
+	default:
+		return nil, fmt.Errorf("unsupported format (%s)", r.Ext)
+	}
+
+	if r.Filter != "" {
+		def.Filter = &types.ReportFilterExpr{}
+		if def.Filter.ASTNode, err = ql.NewParser().Parse(r.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Sort != "" {
+		if err = def.Sort.Set(r.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	// access checks and the first page are done
+	// before any of the headers are written
+	write, err := ctrl.report.StartExport(ctx, r.ReportID, r.ScenarioID, def)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		enc, err := reporting.NewEncoder(r.Ext, w)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Add("Content-Type", contentType)
+		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", r.Filename, r.Ext))
+
+		if err = write(enc); err != nil {
+			// headers and (some of) the rows are already sent;
+			// abort the connection so that the client
+			// does not end up with an incomplete file
+			panic(http.ErrAbortHandler)
+		}
+	}, nil
+}
+
 func (ctrl Report) makePayload(ctx context.Context, m *types.Report, err error) (*reportPayload, error) {
 	if err != nil || m == nil {
 		return nil, err
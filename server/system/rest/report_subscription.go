@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/api"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/system/rest/request"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+type (
+	ReportSubscription struct {
+		svc reportSubscriptionService
+	}
+
+	reportSubscriptionSetPayload struct {
+		Filter types.ReportSubscriptionFilter `json:"filter"`
+		Set    types.ReportSubscriptionSet    `json:"set"`
+	}
+
+	reportDeliverySetPayload struct {
+		Filter types.ReportDeliveryFilter `json:"filter"`
+		Set    types.ReportDeliverySet    `json:"set"`
+	}
+
+	reportSubscriptionService interface {
+		FindByID(ctx context.Context, ID uint64) (*types.ReportSubscription, error)
+		Create(ctx context.Context, new *types.ReportSubscription) (*types.ReportSubscription, error)
+		Update(ctx context.Context, upd *types.ReportSubscription) (*types.ReportSubscription, error)
+		DeleteByID(ctx context.Context, ID uint64) error
+		Search(ctx context.Context, filter types.ReportSubscriptionFilter) (types.ReportSubscriptionSet, types.ReportSubscriptionFilter, error)
+		SearchDeliveries(ctx context.Context, subscriptionID uint64, filter types.ReportDeliveryFilter) (types.ReportDeliverySet, types.ReportDeliveryFilter, error)
+		Deliver(ctx context.Context, subscriptionID uint64) (*types.ReportDelivery, error)
+	}
+)
+
+func (ReportSubscription) New() *ReportSubscription {
+	return &ReportSubscription{
+		svc: service.DefaultReportSubscription,
+	}
+}
+
+func (ctrl *ReportSubscription) List(ctx context.Context, r *request.ReportSubscriptionList) (interface{}, error) {
+	var (
+		err error
+		f   = types.ReportSubscriptionFilter{
+			SubscriptionID: r.SubscriptionID,
+			ReportID:       r.ReportID,
+			Deleted:        filter.State(r.Deleted),
+			Disabled:       filter.State(r.Disabled),
+		}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	f.IncTotal = r.IncTotal
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.Search(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportSubscriptionSetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl *ReportSubscription) Create(ctx context.Context, r *request.ReportSubscriptionCreate) (interface{}, error) {
+	return ctrl.svc.Create(ctx, &types.ReportSubscription{
+		ReportID:   r.ReportID,
+		ScenarioID: r.ScenarioID,
+		Schedule:   r.Schedule,
+		Format:     r.Format,
+		Enabled:    r.Enabled,
+		Recipients: r.Recipients,
+		Meta:       r.Meta,
+		OwnedBy:    r.OwnedBy,
+	})
+}
+
+func (ctrl *ReportSubscription) Read(ctx context.Context, r *request.ReportSubscriptionRead) (interface{}, error) {
+	return ctrl.svc.FindByID(ctx, r.SubscriptionID)
+}
+
+func (ctrl *ReportSubscription) Update(ctx context.Context, r *request.ReportSubscriptionUpdate) (interface{}, error) {
+	return ctrl.svc.Update(ctx, &types.ReportSubscription{
+		ID:         r.SubscriptionID,
+		ScenarioID: r.ScenarioID,
+		Schedule:   r.Schedule,
+		Format:     r.Format,
+		Enabled:    r.Enabled,
+		Recipients: r.Recipients,
+		Meta:       r.Meta,
+		OwnedBy:    r.OwnedBy,
+		UpdatedAt:  r.UpdatedAt,
+	})
+}
+
+func (ctrl *ReportSubscription) Delete(ctx context.Context, r *request.ReportSubscriptionDelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.DeleteByID(ctx, r.SubscriptionID)
+}
+
+func (ctrl *ReportSubscription) Deliveries(ctx context.Context, r *request.ReportSubscriptionDeliveries) (interface{}, error) {
+	var (
+		err error
+		f   = types.ReportDeliveryFilter{}
+	)
+
+	for _, s := range r.Status {
+		f.Status = append(f.Status, types.ReportDeliveryStatus(s))
+	}
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	f.IncTotal = r.IncTotal
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.SearchDeliveries(ctx, r.SubscriptionID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportDeliverySetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl *ReportSubscription) Deliver(ctx context.Context, r *request.ReportSubscriptionDeliver) (interface{}, error) {
+	return ctrl.svc.Deliver(ctx, r.SubscriptionID)
+}
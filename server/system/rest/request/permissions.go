@@ -18,6 +18,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // dummy vars to prevent
@@ -61,6 +62,121 @@ type (
 		RoleID []uint64
 	}
 
+	PermissionsSimulate struct {
+		// Resource GET parameter
+		//
+		// Evaluate only operations on specific resources (use wildcards for rules on the entire subtree)
+		Resource []string
+
+		// UserID GET parameter
+		//
+		//
+		UserID uint64 `json:",string"`
+
+		// RoleID GET parameter
+		//
+		//
+		RoleID []uint64
+
+		// At GET parameter
+		//
+		// Evaluate rules as they were at the given time
+		At *time.Time
+	}
+
+	PermissionsDiff struct {
+		// Resource GET parameter
+		//
+		// Compare only operations on specific resources
+		Resource []string
+
+		// UserID GET parameter
+		//
+		//
+		UserID uint64 `json:",string"`
+
+		// RoleID GET parameter
+		//
+		//
+		RoleID []uint64
+
+		// At GET parameter
+		//
+		// Evaluate rules as they were at the given time
+		At *time.Time
+
+		// CompareUserID GET parameter
+		//
+		// User to compare with (defaults to userID)
+		CompareUserID uint64 `json:",string"`
+
+		// CompareRoleID GET parameter
+		//
+		// Role combo to compare with (defaults to roleID)
+		CompareRoleID []uint64
+
+		// CompareAt GET parameter
+		//
+		// Evaluate compared rules as they were at the given time
+		CompareAt *time.Time
+	}
+
+	PermissionsHistory struct {
+		// RoleID GET parameter
+		//
+		// Show only changes of rules for a specific role
+		RoleID uint64 `json:",string"`
+
+		// ChangeSetID GET parameter
+		//
+		// Show only changes from specific change sets
+		ChangeSetID []uint64
+
+		// AfterChangeSetID GET parameter
+		//
+		// Show only changes made after the change set
+		AfterChangeSetID uint64 `json:",string"`
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+	}
+
+	PermissionsHistoryDiff struct {
+		// FromChangeSetID GET parameter
+		//
+		// Compare rules as they were right after this change set
+		FromChangeSetID uint64 `json:",string"`
+
+		// ToChangeSetID GET parameter
+		//
+		// Compare with rules as they were right after this change set (defaults to current rules)
+		ToChangeSetID uint64 `json:",string"`
+
+		// RoleID GET parameter
+		//
+		// Compare only rules for a specific role
+		RoleID uint64 `json:",string"`
+	}
+
+	PermissionsRollback struct {
+		// ChangeSetID PATH parameter
+		//
+		// Change set ID
+		ChangeSetID uint64 `json:",string"`
+
+		// RoleID POST parameter
+		//
+		// Restore only rules for a specific role
+		RoleID uint64 `json:",string"`
+
+		// Resource POST parameter
+		//
+		// Restore only rules on matching resources (wildcards can be used)
+		Resource string
+	}
+
 	PermissionsRead struct {
 		// RoleID PATH parameter
 		//
@@ -213,6 +329,447 @@ func (r *PermissionsTrace) Fill(req *http.Request) (err error) {
 	return err
 }
 
+// NewPermissionsSimulate request
+func NewPermissionsSimulate() *PermissionsSimulate {
+	return &PermissionsSimulate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsSimulate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"resource": r.Resource,
+		"userID":   r.UserID,
+		"roleID":   r.RoleID,
+		"at":       r.At,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsSimulate) GetResource() []string {
+	return r.Resource
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsSimulate) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsSimulate) GetRoleID() []uint64 {
+	return r.RoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsSimulate) GetAt() *time.Time {
+	return r.At
+}
+
+// Fill processes request and fills internal variables
+func (r *PermissionsSimulate) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["resource[]"]; ok {
+			r.Resource, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["resource"]; ok {
+			r.Resource, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["roleID[]"]; ok {
+			r.RoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["roleID"]; ok {
+			r.RoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["at"]; ok && len(val) > 0 {
+			r.At, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewPermissionsDiff request
+func NewPermissionsDiff() *PermissionsDiff {
+	return &PermissionsDiff{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"resource":      r.Resource,
+		"userID":        r.UserID,
+		"roleID":        r.RoleID,
+		"at":            r.At,
+		"compareUserID": r.CompareUserID,
+		"compareRoleID": r.CompareRoleID,
+		"compareAt":     r.CompareAt,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetResource() []string {
+	return r.Resource
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetRoleID() []uint64 {
+	return r.RoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetAt() *time.Time {
+	return r.At
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetCompareUserID() uint64 {
+	return r.CompareUserID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetCompareRoleID() []uint64 {
+	return r.CompareRoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsDiff) GetCompareAt() *time.Time {
+	return r.CompareAt
+}
+
+// Fill processes request and fills internal variables
+func (r *PermissionsDiff) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["resource[]"]; ok {
+			r.Resource, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["resource"]; ok {
+			r.Resource, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["roleID[]"]; ok {
+			r.RoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["roleID"]; ok {
+			r.RoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["at"]; ok && len(val) > 0 {
+			r.At, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["compareUserID"]; ok && len(val) > 0 {
+			r.CompareUserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["compareRoleID[]"]; ok {
+			r.CompareRoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["compareRoleID"]; ok {
+			r.CompareRoleID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["compareAt"]; ok && len(val) > 0 {
+			r.CompareAt, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewPermissionsHistory request
+func NewPermissionsHistory() *PermissionsHistory {
+	return &PermissionsHistory{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistory) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"roleID":           r.RoleID,
+		"changeSetID":      r.ChangeSetID,
+		"afterChangeSetID": r.AfterChangeSetID,
+		"limit":            r.Limit,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistory) GetRoleID() uint64 {
+	return r.RoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistory) GetChangeSetID() []uint64 {
+	return r.ChangeSetID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistory) GetAfterChangeSetID() uint64 {
+	return r.AfterChangeSetID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistory) GetLimit() uint {
+	return r.Limit
+}
+
+// Fill processes request and fills internal variables
+func (r *PermissionsHistory) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["roleID"]; ok && len(val) > 0 {
+			r.RoleID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["changeSetID[]"]; ok {
+			r.ChangeSetID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["changeSetID"]; ok {
+			r.ChangeSetID, err = payload.ParseUint64s(val), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["afterChangeSetID"]; ok && len(val) > 0 {
+			r.AfterChangeSetID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewPermissionsHistoryDiff request
+func NewPermissionsHistoryDiff() *PermissionsHistoryDiff {
+	return &PermissionsHistoryDiff{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistoryDiff) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"fromChangeSetID": r.FromChangeSetID,
+		"toChangeSetID":   r.ToChangeSetID,
+		"roleID":          r.RoleID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistoryDiff) GetFromChangeSetID() uint64 {
+	return r.FromChangeSetID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistoryDiff) GetToChangeSetID() uint64 {
+	return r.ToChangeSetID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsHistoryDiff) GetRoleID() uint64 {
+	return r.RoleID
+}
+
+// Fill processes request and fills internal variables
+func (r *PermissionsHistoryDiff) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["fromChangeSetID"]; ok && len(val) > 0 {
+			r.FromChangeSetID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["toChangeSetID"]; ok && len(val) > 0 {
+			r.ToChangeSetID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["roleID"]; ok && len(val) > 0 {
+			r.RoleID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewPermissionsRollback request
+func NewPermissionsRollback() *PermissionsRollback {
+	return &PermissionsRollback{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsRollback) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"changeSetID": r.ChangeSetID,
+		"roleID":      r.RoleID,
+		"resource":    r.Resource,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsRollback) GetChangeSetID() uint64 {
+	return r.ChangeSetID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsRollback) GetRoleID() uint64 {
+	return r.RoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r PermissionsRollback) GetResource() string {
+	return r.Resource
+}
+
+// Fill processes request and fills internal variables
+func (r *PermissionsRollback) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["roleID"]; ok && len(val) > 0 {
+				r.RoleID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["resource"]; ok && len(val) > 0 {
+				r.Resource, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["roleID"]; ok && len(val) > 0 {
+			r.RoleID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["resource"]; ok && len(val) > 0 {
+			r.Resource, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "changeSetID")
+		r.ChangeSetID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
 // NewPermissionsRead request
 func NewPermissionsRead() *PermissionsRead {
 	return &PermissionsRead{}
@@ -202,6 +202,48 @@ type (
 		// Report data frame definitions
 		Frames reporting.FrameDefinitionSet
 	}
+
+	ReportExport struct {
+		// ReportID PATH parameter
+		//
+		// Report ID
+		ReportID uint64 `json:",string"`
+
+		// Filename PATH parameter
+		//
+		// Output file name
+		Filename string
+
+		// Ext PATH parameter
+		//
+		// Output file format (csv, xlsx, ndjson)
+		Ext string
+
+		// Source GET parameter
+		//
+		// Data source of the exported frame
+		Source string
+
+		// Name GET parameter
+		//
+		// Frame name
+		Name string
+
+		// ScenarioID GET parameter
+		//
+		// Scenario which filters are applied
+		ScenarioID uint64 `json:",string"`
+
+		// Filter GET parameter
+		//
+		// Additional filter expression
+		Filter string
+
+		// Sort GET parameter
+		//
+		// Sort expression
+		Sort string
+	}
 )
 
 // NewReportList request
@@ -960,3 +1002,128 @@ func (r *ReportRun) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewReportExport request
+func NewReportExport() *ReportExport {
+	return &ReportExport{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"reportID":   r.ReportID,
+		"filename":   r.Filename,
+		"ext":        r.Ext,
+		"source":     r.Source,
+		"name":       r.Name,
+		"scenarioID": r.ScenarioID,
+		"filter":     r.Filter,
+		"sort":       r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetReportID() uint64 {
+	return r.ReportID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetFilename() string {
+	return r.Filename
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetExt() string {
+	return r.Ext
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetSource() string {
+	return r.Source
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetName() string {
+	return r.Name
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetScenarioID() uint64 {
+	return r.ScenarioID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetFilter() string {
+	return r.Filter
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportExport) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportExport) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["source"]; ok && len(val) > 0 {
+			r.Source, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["name"]; ok && len(val) > 0 {
+			r.Name, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["scenarioID"]; ok && len(val) > 0 {
+			r.ScenarioID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["filter"]; ok && len(val) > 0 {
+			r.Filter, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "reportID")
+		r.ReportID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "filename")
+		r.Filename, err = val, nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "ext")
+		r.Ext, err = val, nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -0,0 +1,1029 @@
+package request
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortezaproject/corteza/server/pkg/payload"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// dummy vars to prevent
+// unused imports complain
+var (
+	_ = chi.URLParam
+	_ = multipart.ErrMessageTooLarge
+	_ = payload.ParseUint64s
+	_ = strings.ToLower
+	_ = io.EOF
+	_ = fmt.Errorf
+	_ = json.NewEncoder
+)
+
+type (
+	// Internal API interface
+	ReportSubscriptionList struct {
+		// SubscriptionID GET parameter
+		//
+		// Filter by subscription ID
+		SubscriptionID []string
+
+		// ReportID GET parameter
+		//
+		// Filter by report ID
+		ReportID []string
+
+		// Deleted GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) deleted subscriptions
+		Deleted uint
+
+		// Disabled GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) disabled subscriptions
+		Disabled uint
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// IncTotal GET parameter
+		//
+		// Include total counter
+		IncTotal bool
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	ReportSubscriptionCreate struct {
+		// ReportID POST parameter
+		//
+		// Report ID
+		ReportID uint64 `json:",string"`
+
+		// ScenarioID POST parameter
+		//
+		// Scenario which filters are applied to the report
+		ScenarioID uint64 `json:",string"`
+
+		// Schedule POST parameter
+		//
+		// Crontab expression
+		Schedule string
+
+		// Format POST parameter
+		//
+		// Format of the delivered results (csv, xlsx, pdf)
+		Format types.ReportSubscriptionFormat
+
+		// Enabled POST parameter
+		//
+		// Is subscription enabled
+		Enabled bool
+
+		// Recipients POST parameter
+		//
+		// Users, roles and email addresses the results are delivered to
+		Recipients types.ReportSubscriptionRecipients
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta *types.ReportSubscriptionMeta
+
+		// OwnedBy POST parameter
+		//
+		// Owner of the subscription; report is ran with their permissions
+		OwnedBy uint64 `json:",string"`
+	}
+
+	ReportSubscriptionRead struct {
+		// SubscriptionID PATH parameter
+		//
+		// Subscription ID
+		SubscriptionID uint64 `json:",string"`
+	}
+
+	ReportSubscriptionUpdate struct {
+		// SubscriptionID PATH parameter
+		//
+		// Subscription ID
+		SubscriptionID uint64 `json:",string"`
+
+		// ScenarioID POST parameter
+		//
+		// Scenario which filters are applied to the report
+		ScenarioID uint64 `json:",string"`
+
+		// Schedule POST parameter
+		//
+		// Crontab expression
+		Schedule string
+
+		// Format POST parameter
+		//
+		// Format of the delivered results (csv, xlsx, pdf)
+		Format types.ReportSubscriptionFormat
+
+		// Enabled POST parameter
+		//
+		// Is subscription enabled
+		Enabled bool
+
+		// Recipients POST parameter
+		//
+		// Users, roles and email addresses the results are delivered to
+		Recipients types.ReportSubscriptionRecipients
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta *types.ReportSubscriptionMeta
+
+		// OwnedBy POST parameter
+		//
+		// Owner of the subscription; report is ran with their permissions
+		OwnedBy uint64 `json:",string"`
+
+		// UpdatedAt POST parameter
+		//
+		// Last update (or creation) date
+		UpdatedAt *time.Time
+	}
+
+	ReportSubscriptionDelete struct {
+		// SubscriptionID PATH parameter
+		//
+		// Subscription ID
+		SubscriptionID uint64 `json:",string"`
+	}
+
+	ReportSubscriptionDeliveries struct {
+		// SubscriptionID PATH parameter
+		//
+		// Subscription ID
+		SubscriptionID uint64 `json:",string"`
+
+		// Status GET parameter
+		//
+		// Filter by status (running, delivered, failed)
+		Status []string
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// IncTotal GET parameter
+		//
+		// Include total counter
+		IncTotal bool
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	ReportSubscriptionDeliver struct {
+		// SubscriptionID PATH parameter
+		//
+		// Subscription ID
+		SubscriptionID uint64 `json:",string"`
+	}
+)
+
+// NewReportSubscriptionList request
+func NewReportSubscriptionList() *ReportSubscriptionList {
+	return &ReportSubscriptionList{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+		"reportID":       r.ReportID,
+		"deleted":        r.Deleted,
+		"disabled":       r.Disabled,
+		"limit":          r.Limit,
+		"incTotal":       r.IncTotal,
+		"pageCursor":     r.PageCursor,
+		"sort":           r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetSubscriptionID() []string {
+	return r.SubscriptionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetReportID() []string {
+	return r.ReportID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetDeleted() uint {
+	return r.Deleted
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetDisabled() uint {
+	return r.Disabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetIncTotal() bool {
+	return r.IncTotal
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionList) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionList) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["subscriptionID[]"]; ok {
+			r.SubscriptionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["subscriptionID"]; ok {
+			r.SubscriptionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["reportID[]"]; ok {
+			r.ReportID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["reportID"]; ok {
+			r.ReportID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["deleted"]; ok && len(val) > 0 {
+			r.Deleted, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["disabled"]; ok && len(val) > 0 {
+			r.Disabled, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["incTotal"]; ok && len(val) > 0 {
+			r.IncTotal, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewReportSubscriptionCreate request
+func NewReportSubscriptionCreate() *ReportSubscriptionCreate {
+	return &ReportSubscriptionCreate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"reportID":   r.ReportID,
+		"scenarioID": r.ScenarioID,
+		"schedule":   r.Schedule,
+		"format":     r.Format,
+		"enabled":    r.Enabled,
+		"recipients": r.Recipients,
+		"meta":       r.Meta,
+		"ownedBy":    r.OwnedBy,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetReportID() uint64 {
+	return r.ReportID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetScenarioID() uint64 {
+	return r.ScenarioID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetSchedule() string {
+	return r.Schedule
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetFormat() types.ReportSubscriptionFormat {
+	return r.Format
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetEnabled() bool {
+	return r.Enabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetRecipients() types.ReportSubscriptionRecipients {
+	return r.Recipients
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetMeta() *types.ReportSubscriptionMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionCreate) GetOwnedBy() uint64 {
+	return r.OwnedBy
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionCreate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["reportID"]; ok && len(val) > 0 {
+				r.ReportID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["scenarioID"]; ok && len(val) > 0 {
+				r.ScenarioID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["schedule"]; ok && len(val) > 0 {
+				r.Schedule, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["format"]; ok && len(val) > 0 {
+				r.Format, err = types.ReportSubscriptionFormat(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["enabled"]; ok && len(val) > 0 {
+				r.Enabled, err = payload.ParseBool(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["recipients[]"]; ok {
+				r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["recipients"]; ok {
+				r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseReportSubscriptionMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseReportSubscriptionMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["ownedBy"]; ok && len(val) > 0 {
+				r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["reportID"]; ok && len(val) > 0 {
+			r.ReportID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["scenarioID"]; ok && len(val) > 0 {
+			r.ScenarioID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["schedule"]; ok && len(val) > 0 {
+			r.Schedule, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["format"]; ok && len(val) > 0 {
+			r.Format, err = types.ReportSubscriptionFormat(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["enabled"]; ok && len(val) > 0 {
+			r.Enabled, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["recipients[]"]; ok {
+			r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["recipients"]; ok {
+			r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseReportSubscriptionMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseReportSubscriptionMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["ownedBy"]; ok && len(val) > 0 {
+			r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewReportSubscriptionRead request
+func NewReportSubscriptionRead() *ReportSubscriptionRead {
+	return &ReportSubscriptionRead{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionRead) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionRead) GetSubscriptionID() uint64 {
+	return r.SubscriptionID
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionRead) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "subscriptionID")
+		r.SubscriptionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewReportSubscriptionUpdate request
+func NewReportSubscriptionUpdate() *ReportSubscriptionUpdate {
+	return &ReportSubscriptionUpdate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+		"scenarioID":     r.ScenarioID,
+		"schedule":       r.Schedule,
+		"format":         r.Format,
+		"enabled":        r.Enabled,
+		"recipients":     r.Recipients,
+		"meta":           r.Meta,
+		"ownedBy":        r.OwnedBy,
+		"updatedAt":      r.UpdatedAt,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetSubscriptionID() uint64 {
+	return r.SubscriptionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetScenarioID() uint64 {
+	return r.ScenarioID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetSchedule() string {
+	return r.Schedule
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetFormat() types.ReportSubscriptionFormat {
+	return r.Format
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetEnabled() bool {
+	return r.Enabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetRecipients() types.ReportSubscriptionRecipients {
+	return r.Recipients
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetMeta() *types.ReportSubscriptionMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetOwnedBy() uint64 {
+	return r.OwnedBy
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionUpdate) GetUpdatedAt() *time.Time {
+	return r.UpdatedAt
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionUpdate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["scenarioID"]; ok && len(val) > 0 {
+				r.ScenarioID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["schedule"]; ok && len(val) > 0 {
+				r.Schedule, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["format"]; ok && len(val) > 0 {
+				r.Format, err = types.ReportSubscriptionFormat(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["enabled"]; ok && len(val) > 0 {
+				r.Enabled, err = payload.ParseBool(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["recipients[]"]; ok {
+				r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["recipients"]; ok {
+				r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseReportSubscriptionMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseReportSubscriptionMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["ownedBy"]; ok && len(val) > 0 {
+				r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["updatedAt"]; ok && len(val) > 0 {
+				r.UpdatedAt, err = payload.ParseISODatePtrWithErr(val[0])
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["scenarioID"]; ok && len(val) > 0 {
+			r.ScenarioID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["schedule"]; ok && len(val) > 0 {
+			r.Schedule, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["format"]; ok && len(val) > 0 {
+			r.Format, err = types.ReportSubscriptionFormat(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["enabled"]; ok && len(val) > 0 {
+			r.Enabled, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["recipients[]"]; ok {
+			r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["recipients"]; ok {
+			r.Recipients, err = types.ParseReportSubscriptionRecipients(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseReportSubscriptionMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseReportSubscriptionMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["ownedBy"]; ok && len(val) > 0 {
+			r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["updatedAt"]; ok && len(val) > 0 {
+			r.UpdatedAt, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "subscriptionID")
+		r.SubscriptionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewReportSubscriptionDelete request
+func NewReportSubscriptionDelete() *ReportSubscriptionDelete {
+	return &ReportSubscriptionDelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDelete) GetSubscriptionID() uint64 {
+	return r.SubscriptionID
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionDelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "subscriptionID")
+		r.SubscriptionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewReportSubscriptionDeliveries request
+func NewReportSubscriptionDeliveries() *ReportSubscriptionDeliveries {
+	return &ReportSubscriptionDeliveries{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+		"status":         r.Status,
+		"limit":          r.Limit,
+		"incTotal":       r.IncTotal,
+		"pageCursor":     r.PageCursor,
+		"sort":           r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetSubscriptionID() uint64 {
+	return r.SubscriptionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetStatus() []string {
+	return r.Status
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetIncTotal() bool {
+	return r.IncTotal
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliveries) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionDeliveries) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["status[]"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["status"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["incTotal"]; ok && len(val) > 0 {
+			r.IncTotal, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "subscriptionID")
+		r.SubscriptionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewReportSubscriptionDeliver request
+func NewReportSubscriptionDeliver() *ReportSubscriptionDeliver {
+	return &ReportSubscriptionDeliver{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliver) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionID": r.SubscriptionID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ReportSubscriptionDeliver) GetSubscriptionID() uint64 {
+	return r.SubscriptionID
+}
+
+// Fill processes request and fills internal variables
+func (r *ReportSubscriptionDeliver) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "subscriptionID")
+		r.SubscriptionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -0,0 +1,1025 @@
+package request
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortezaproject/corteza/server/pkg/payload"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// dummy vars to prevent
+// unused imports complain
+var (
+	_ = chi.URLParam
+	_ = multipart.ErrMessageTooLarge
+	_ = payload.ParseUint64s
+	_ = strings.ToLower
+	_ = io.EOF
+	_ = fmt.Errorf
+	_ = json.NewEncoder
+)
+
+type (
+	// Internal API interface
+	WebhookList struct {
+		// WebhookID GET parameter
+		//
+		// Filter by webhook ID
+		WebhookID []string
+
+		// Handle GET parameter
+		//
+		// Filter by handle
+		Handle string
+
+		// Query GET parameter
+		//
+		// Filter webhooks by handle or URL
+		Query string
+
+		// Deleted GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) deleted webhooks
+		Deleted uint
+
+		// Disabled GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) disabled webhooks
+		Disabled uint
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// IncTotal GET parameter
+		//
+		// Include total counter
+		IncTotal bool
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	WebhookCreate struct {
+		// Handle POST parameter
+		//
+		// Handle
+		Handle string
+
+		// Url POST parameter
+		//
+		// Endpoint URL
+		Url string
+
+		// Secret POST parameter
+		//
+		// Secret for signing the payload, generated when omitted
+		Secret string
+
+		// Enabled POST parameter
+		//
+		// Is webhook enabled
+		Enabled bool
+
+		// Events POST parameter
+		//
+		// Events delivered to the endpoint
+		Events types.WebhookEventSet
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta *types.WebhookMeta
+	}
+
+	WebhookRead struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+	}
+
+	WebhookUpdate struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+
+		// Handle POST parameter
+		//
+		// Handle
+		Handle string
+
+		// Url POST parameter
+		//
+		// Endpoint URL
+		Url string
+
+		// Secret POST parameter
+		//
+		// New secret for signing the payload, unchanged when omitted
+		Secret string
+
+		// Enabled POST parameter
+		//
+		// Is webhook enabled
+		Enabled bool
+
+		// Events POST parameter
+		//
+		// Events delivered to the endpoint
+		Events types.WebhookEventSet
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta *types.WebhookMeta
+
+		// UpdatedAt POST parameter
+		//
+		// Last update (or creation) date
+		UpdatedAt *time.Time
+	}
+
+	WebhookDelete struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+	}
+
+	WebhookUndelete struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+	}
+
+	WebhookDeliveries struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+
+		// Status GET parameter
+		//
+		// Filter by status (pending, delivered, dead)
+		Status []string
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// IncTotal GET parameter
+		//
+		// Include total counter
+		IncTotal bool
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	WebhookRedeliver struct {
+		// WebhookID PATH parameter
+		//
+		// Webhook ID
+		WebhookID uint64 `json:",string"`
+
+		// DeliveryID PATH parameter
+		//
+		// Delivery ID
+		DeliveryID uint64 `json:",string"`
+	}
+)
+
+// NewWebhookList request
+func NewWebhookList() *WebhookList {
+	return &WebhookList{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID":  r.WebhookID,
+		"handle":     r.Handle,
+		"query":      r.Query,
+		"deleted":    r.Deleted,
+		"disabled":   r.Disabled,
+		"limit":      r.Limit,
+		"incTotal":   r.IncTotal,
+		"pageCursor": r.PageCursor,
+		"sort":       r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetWebhookID() []string {
+	return r.WebhookID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetQuery() string {
+	return r.Query
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetDeleted() uint {
+	return r.Deleted
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetDisabled() uint {
+	return r.Disabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetIncTotal() bool {
+	return r.IncTotal
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookList) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookList) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["webhookID[]"]; ok {
+			r.WebhookID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["webhookID"]; ok {
+			r.WebhookID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["query"]; ok && len(val) > 0 {
+			r.Query, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["deleted"]; ok && len(val) > 0 {
+			r.Deleted, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["disabled"]; ok && len(val) > 0 {
+			r.Disabled, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["incTotal"]; ok && len(val) > 0 {
+			r.IncTotal, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewWebhookCreate request
+func NewWebhookCreate() *WebhookCreate {
+	return &WebhookCreate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"handle":  r.Handle,
+		"url":     r.Url,
+		"secret":  r.Secret,
+		"enabled": r.Enabled,
+		"events":  r.Events,
+		"meta":    r.Meta,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetUrl() string {
+	return r.Url
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetSecret() string {
+	return r.Secret
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetEnabled() bool {
+	return r.Enabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetEvents() types.WebhookEventSet {
+	return r.Events
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookCreate) GetMeta() *types.WebhookMeta {
+	return r.Meta
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookCreate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["handle"]; ok && len(val) > 0 {
+				r.Handle, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["url"]; ok && len(val) > 0 {
+				r.Url, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["secret"]; ok && len(val) > 0 {
+				r.Secret, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["enabled"]; ok && len(val) > 0 {
+				r.Enabled, err = payload.ParseBool(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["events[]"]; ok {
+				r.Events, err = types.ParseWebhookEventSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["events"]; ok {
+				r.Events, err = types.ParseWebhookEventSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseWebhookMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseWebhookMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["url"]; ok && len(val) > 0 {
+			r.Url, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["secret"]; ok && len(val) > 0 {
+			r.Secret, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["enabled"]; ok && len(val) > 0 {
+			r.Enabled, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["events[]"]; ok {
+			r.Events, err = types.ParseWebhookEventSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["events"]; ok {
+			r.Events, err = types.ParseWebhookEventSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseWebhookMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseWebhookMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewWebhookRead request
+func NewWebhookRead() *WebhookRead {
+	return &WebhookRead{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookRead) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID": r.WebhookID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookRead) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookRead) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWebhookUpdate request
+func NewWebhookUpdate() *WebhookUpdate {
+	return &WebhookUpdate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID": r.WebhookID,
+		"handle":    r.Handle,
+		"url":       r.Url,
+		"secret":    r.Secret,
+		"enabled":   r.Enabled,
+		"events":    r.Events,
+		"meta":      r.Meta,
+		"updatedAt": r.UpdatedAt,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetUrl() string {
+	return r.Url
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetSecret() string {
+	return r.Secret
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetEnabled() bool {
+	return r.Enabled
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetEvents() types.WebhookEventSet {
+	return r.Events
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetMeta() *types.WebhookMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUpdate) GetUpdatedAt() *time.Time {
+	return r.UpdatedAt
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookUpdate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["handle"]; ok && len(val) > 0 {
+				r.Handle, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["url"]; ok && len(val) > 0 {
+				r.Url, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["secret"]; ok && len(val) > 0 {
+				r.Secret, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["enabled"]; ok && len(val) > 0 {
+				r.Enabled, err = payload.ParseBool(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["events[]"]; ok {
+				r.Events, err = types.ParseWebhookEventSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["events"]; ok {
+				r.Events, err = types.ParseWebhookEventSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseWebhookMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseWebhookMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["updatedAt"]; ok && len(val) > 0 {
+				r.UpdatedAt, err = payload.ParseISODatePtrWithErr(val[0])
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["url"]; ok && len(val) > 0 {
+			r.Url, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["secret"]; ok && len(val) > 0 {
+			r.Secret, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["enabled"]; ok && len(val) > 0 {
+			r.Enabled, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["events[]"]; ok {
+			r.Events, err = types.ParseWebhookEventSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["events"]; ok {
+			r.Events, err = types.ParseWebhookEventSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseWebhookMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseWebhookMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["updatedAt"]; ok && len(val) > 0 {
+			r.UpdatedAt, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWebhookDelete request
+func NewWebhookDelete() *WebhookDelete {
+	return &WebhookDelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID": r.WebhookID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDelete) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookDelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWebhookUndelete request
+func NewWebhookUndelete() *WebhookUndelete {
+	return &WebhookUndelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUndelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID": r.WebhookID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookUndelete) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookUndelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWebhookDeliveries request
+func NewWebhookDeliveries() *WebhookDeliveries {
+	return &WebhookDeliveries{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID":  r.WebhookID,
+		"status":     r.Status,
+		"limit":      r.Limit,
+		"incTotal":   r.IncTotal,
+		"pageCursor": r.PageCursor,
+		"sort":       r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetStatus() []string {
+	return r.Status
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetIncTotal() bool {
+	return r.IncTotal
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookDeliveries) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookDeliveries) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["status[]"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["status"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["incTotal"]; ok && len(val) > 0 {
+			r.IncTotal, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWebhookRedeliver request
+func NewWebhookRedeliver() *WebhookRedeliver {
+	return &WebhookRedeliver{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookRedeliver) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"webhookID":  r.WebhookID,
+		"deliveryID": r.DeliveryID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookRedeliver) GetWebhookID() uint64 {
+	return r.WebhookID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WebhookRedeliver) GetDeliveryID() uint64 {
+	return r.DeliveryID
+}
+
+// Fill processes request and fills internal variables
+func (r *WebhookRedeliver) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "webhookID")
+		r.WebhookID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "deliveryID")
+		r.DeliveryID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -48,6 +48,8 @@ func MountRoutes() func(r chi.Router) {
 			handlers.NewApigwRoute(ApigwRoute{}.New()).MountRoutes(r)
 			handlers.NewApigwFilter(ApigwFilter{}.New()).MountRoutes(r)
 			handlers.NewApigwProfiler(ApigwProfiler{}.New()).MountRoutes(r)
+			handlers.NewWebhook(Webhook{}.New()).MountRoutes(r)
+			handlers.NewReportSubscription(ReportSubscription{}.New()).MountRoutes(r)
 			handlers.NewDataPrivacy(DataPrivacy{}.New()).MountRoutes(r)
 			handlers.NewSmtpConfigurationChecker(SmtpConfigurationChecker{}.New()).MountRoutes(r)
 			handlers.NewExpression(Expression{}.New()).MountRoutes(r)
@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/api"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/system/rest/request"
+	"github.com/cortezaproject/corteza/server/system/service"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+type (
+	Webhook struct {
+		svc webhookService
+		ac  webhookAccessController
+	}
+
+	webhookPayload struct {
+		*types.Webhook
+
+		CanGrant         bool `json:"canGrant"`
+		CanUpdateWebhook bool `json:"canUpdateWebhook"`
+		CanDeleteWebhook bool `json:"canDeleteWebhook"`
+	}
+
+	webhookSetPayload struct {
+		Filter types.WebhookFilter `json:"filter"`
+		Set    []*webhookPayload   `json:"set"`
+	}
+
+	webhookDeliverySetPayload struct {
+		Filter types.WebhookDeliveryFilter `json:"filter"`
+		Set    types.WebhookDeliverySet    `json:"set"`
+	}
+
+	webhookService interface {
+		FindByID(ctx context.Context, ID uint64) (*types.Webhook, error)
+		Create(ctx context.Context, new *types.Webhook) (*types.Webhook, error)
+		Update(ctx context.Context, upd *types.Webhook) (*types.Webhook, error)
+		DeleteByID(ctx context.Context, ID uint64) error
+		UndeleteByID(ctx context.Context, ID uint64) error
+		Search(ctx context.Context, filter types.WebhookFilter) (types.WebhookSet, types.WebhookFilter, error)
+		SearchDeliveries(ctx context.Context, webhookID uint64, filter types.WebhookDeliveryFilter) (types.WebhookDeliverySet, types.WebhookDeliveryFilter, error)
+		Redeliver(ctx context.Context, webhookID, deliveryID uint64) (*types.WebhookDelivery, error)
+	}
+
+	webhookAccessController interface {
+		CanGrant(context.Context) bool
+
+		CanUpdateWebhook(context.Context, *types.Webhook) bool
+		CanDeleteWebhook(context.Context, *types.Webhook) bool
+	}
+)
+
+func (Webhook) New() *Webhook {
+	return &Webhook{
+		svc: service.DefaultWebhook,
+		ac:  service.DefaultAccessControl,
+	}
+}
+
+func (ctrl *Webhook) List(ctx context.Context, r *request.WebhookList) (interface{}, error) {
+	var (
+		err error
+		f   = types.WebhookFilter{
+			WebhookID: r.WebhookID,
+			Handle:    r.Handle,
+			Query:     r.Query,
+			Deleted:   filter.State(r.Deleted),
+			Disabled:  filter.State(r.Disabled),
+		}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	f.IncTotal = r.IncTotal
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.Search(ctx, f)
+	return ctrl.makeFilterPayload(ctx, set, f, err)
+}
+
+func (ctrl *Webhook) Create(ctx context.Context, r *request.WebhookCreate) (interface{}, error) {
+	wh, err := ctrl.svc.Create(ctx, &types.Webhook{
+		Handle:  r.Handle,
+		URL:     r.Url,
+		Secret:  r.Secret,
+		Enabled: r.Enabled,
+		Events:  r.Events,
+		Meta:    r.Meta,
+	})
+
+	return ctrl.makePayload(ctx, wh, err)
+}
+
+func (ctrl *Webhook) Read(ctx context.Context, r *request.WebhookRead) (interface{}, error) {
+	wh, err := ctrl.svc.FindByID(ctx, r.WebhookID)
+	return ctrl.makePayload(ctx, wh, err)
+}
+
+func (ctrl *Webhook) Update(ctx context.Context, r *request.WebhookUpdate) (interface{}, error) {
+	wh, err := ctrl.svc.Update(ctx, &types.Webhook{
+		ID:        r.WebhookID,
+		Handle:    r.Handle,
+		URL:       r.Url,
+		Secret:    r.Secret,
+		Enabled:   r.Enabled,
+		Events:    r.Events,
+		Meta:      r.Meta,
+		UpdatedAt: r.UpdatedAt,
+	})
+
+	return ctrl.makePayload(ctx, wh, err)
+}
+
+func (ctrl *Webhook) Delete(ctx context.Context, r *request.WebhookDelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.DeleteByID(ctx, r.WebhookID)
+}
+
+func (ctrl *Webhook) Undelete(ctx context.Context, r *request.WebhookUndelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.WebhookID)
+}
+
+func (ctrl *Webhook) Deliveries(ctx context.Context, r *request.WebhookDeliveries) (interface{}, error) {
+	var (
+		err error
+		f   = types.WebhookDeliveryFilter{}
+	)
+
+	for _, s := range r.Status {
+		f.Status = append(f.Status, types.WebhookDeliveryStatus(s))
+	}
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	f.IncTotal = r.IncTotal
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.SearchDeliveries(ctx, r.WebhookID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookDeliverySetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl *Webhook) Redeliver(ctx context.Context, r *request.WebhookRedeliver) (interface{}, error) {
+	return ctrl.svc.Redeliver(ctx, r.WebhookID, r.DeliveryID)
+}
+
+func (ctrl *Webhook) makePayload(ctx context.Context, wh *types.Webhook, err error) (*webhookPayload, error) {
+	if err != nil || wh == nil {
+		return nil, err
+	}
+
+	return &webhookPayload{
+		Webhook: wh,
+
+		CanGrant: ctrl.ac.CanGrant(ctx),
+
+		CanUpdateWebhook: ctrl.ac.CanUpdateWebhook(ctx, wh),
+		CanDeleteWebhook: ctrl.ac.CanDeleteWebhook(ctx, wh),
+	}, nil
+}
+
+func (ctrl *Webhook) makeFilterPayload(ctx context.Context, nn types.WebhookSet, f types.WebhookFilter, err error) (*webhookSetPayload, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	msp := &webhookSetPayload{Filter: f, Set: make([]*webhookPayload, len(nn))}
+
+	for i := range nn {
+		msp.Set[i], _ = ctrl.makePayload(ctx, nn[i], nil)
+	}
+
+	return msp, nil
+}
@@ -0,0 +1,232 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Bulk operations as described in RFC 7644, section 3.7
+//
+// Operations are processed in the order they are listed and
+// are dispatched to the same handlers as regular requests.
+
+const (
+	urnBulkRequest  = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	urnBulkResponse = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+
+	bulkMaxOperations  = 1000
+	bulkMaxPayloadSize = 1 << 20
+)
+
+type (
+	bulkHandler struct {
+		externalIdAsPrimary bool
+
+		// handles individual operations
+		router http.Handler
+	}
+
+	// bulkResource identifies resource created by one of the operations
+	bulkResource struct {
+		ID         string `json:"id"`
+		ExternalId string `json:"externalId"`
+	}
+
+	bulkRequest struct {
+		Schemas      []string               `json:"schemas"`
+		FailOnErrors int                    `json:"failOnErrors"`
+		Operations   []bulkOperationRequest `json:"Operations"`
+	}
+
+	bulkOperationRequest struct {
+		Method  string          `json:"method"`
+		BulkId  string          `json:"bulkId,omitempty"`
+		Version string          `json:"version,omitempty"`
+		Path    string          `json:"path"`
+		Data    json.RawMessage `json:"data,omitempty"`
+	}
+
+	bulkResponse struct {
+		Schemas    []string                `json:"schemas"`
+		Operations []bulkOperationResponse `json:"Operations"`
+	}
+
+	bulkOperationResponse struct {
+		Method   string          `json:"method"`
+		BulkId   string          `json:"bulkId,omitempty"`
+		Location string          `json:"location,omitempty"`
+		Status   string          `json:"status"`
+		Response json.RawMessage `json:"response,omitempty"`
+	}
+)
+
+var (
+	bulkPathCheck = regexp.MustCompile(`^/(Users|Groups)(/[^/]+)?$`)
+	bulkIdRef     = regexp.MustCompile(`bulkId:([^"/\s]+)`)
+)
+
+func (h bulkHandler) serve(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var (
+		payload = &bulkRequest{}
+		rsp     = &bulkResponse{Schemas: []string{urnBulkResponse}}
+
+		// bulkId => created resource
+		ids    = make(map[string]bulkResource)
+		errors int
+	)
+
+	r.Body = http.MaxBytesReader(w, r.Body, bulkMaxPayloadSize)
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		if strings.Contains(err.Error(), "too large") {
+			sendError(w, newErrorfResponse(http.StatusRequestEntityTooLarge, "payload exceeds %d bytes", bulkMaxPayloadSize))
+			return
+		}
+
+		sendError(w, newScimErrorf(errTypeInvalidSyntax, "could not decode bulk payload: %v", err))
+		return
+	}
+
+	if len(payload.Operations) > bulkMaxOperations {
+		sendError(w, newErrorfResponse(http.StatusRequestEntityTooLarge, "number of operations exceeds %d", bulkMaxOperations))
+		return
+	}
+
+	for _, op := range payload.Operations {
+		if payload.FailOnErrors > 0 && errors >= payload.FailOnErrors {
+			break
+		}
+
+		opRsp := h.exec(r, op, ids)
+		if status, _ := strconv.Atoi(opRsp.Status); status >= http.StatusBadRequest {
+			errors++
+		}
+
+		rsp.Operations = append(rsp.Operations, opRsp)
+	}
+
+	send(w, http.StatusOK, rsp)
+}
+
+// exec executes one bulk operation
+func (h bulkHandler) exec(r *http.Request, op bulkOperationRequest, ids map[string]bulkResource) (rsp bulkOperationResponse) {
+	var (
+		method = strings.ToUpper(op.Method)
+		path   = op.Path
+		data   = op.Data
+
+		fail = func(err *errorResponse) bulkOperationResponse {
+			rsp.Status = strconv.Itoa(err.Status)
+			rsp.Response, _ = json.Marshal(err)
+			return rsp
+		}
+	)
+
+	rsp.Method = method
+	rsp.BulkId = op.BulkId
+
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		// ok
+	default:
+		return fail(newScimErrorf(errTypeInvalidSyntax, "unsupported method: %q", op.Method))
+	}
+
+	if method == http.MethodPost && op.BulkId == "" {
+		return fail(newScimErrorf(errTypeInvalidValue, "bulkId is required for POST operations"))
+	}
+
+	// replace references to the resources created in previous operations
+	//
+	// Resources in paths are referenced by the primary ID,
+	// members (in data) are always referenced by the external ID
+	var unresolved string
+	resolve := func(s string, external bool) string {
+		return bulkIdRef.ReplaceAllStringFunc(s, func(ref string) string {
+			res, ok := ids[ref[len("bulkId:"):]]
+			switch {
+			case !ok:
+				unresolved = ref
+				return ref
+			case external && res.ExternalId != "":
+				return res.ExternalId
+			default:
+				return res.ID
+			}
+		})
+	}
+
+	path = resolve(path, h.externalIdAsPrimary)
+	data = json.RawMessage(resolve(string(data), true))
+	if unresolved != "" {
+		rsp.Status = strconv.Itoa(http.StatusConflict)
+		rsp.Response, _ = json.Marshal(newScimErrorf(errTypeInvalidValue, "could not resolve %s", unresolved))
+		return rsp
+	}
+
+	if !bulkPathCheck.MatchString(path) {
+		return fail(newScimErrorf(errTypeInvalidPath, "invalid path: %q", op.Path))
+	}
+
+	req, err := http.NewRequestWithContext(bulkContext(r.Context()), method, path, bytes.NewReader(data))
+	if err != nil {
+		return fail(newErrorResponse(http.StatusBadRequest, err))
+	}
+
+	req.Header = r.Header.Clone()
+	req.Header.Set("Content-Type", "application/scim+json")
+
+	rec := httptest.NewRecorder()
+	h.router.ServeHTTP(rec, req)
+
+	rsp.Status = strconv.Itoa(rec.Code)
+
+	if rec.Code >= http.StatusBadRequest {
+		rsp.Response = rec.Body.Bytes()
+		return
+	}
+
+	if method == http.MethodDelete || rec.Body.Len() == 0 {
+		rsp.Location = path
+		return
+	}
+
+	res := bulkResource{}
+	if err = json.Unmarshal(rec.Body.Bytes(), &res); err != nil || res.ID == "" {
+		return fail(newErrorfResponse(http.StatusInternalServerError, "could not read id of the resource"))
+	}
+
+	if op.BulkId != "" {
+		ids[op.BulkId] = res
+	}
+
+	rsp.Location = path
+	if method == http.MethodPost {
+		id := res.ID
+		if h.externalIdAsPrimary {
+			id = res.ExternalId
+		}
+
+		rsp.Location = fmt.Sprintf("%s/%s", strings.TrimSuffix(path, "/"), id)
+	}
+
+	return
+}
+
+// bulkContext prepares context for routing the operation
+//
+// Context of the bulk request holds its own routing state
+// that would otherwise be used when routing the operation.
+func bulkContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, chi.RouteCtxKey, chi.NewRouteContext())
+}
@@ -0,0 +1,285 @@
+package scim
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Service provider configuration endpoints as described in RFC 7644, section 4
+// and resource schemas as described in RFC 7643, sections 4 and 7
+
+const (
+	urnServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	urnResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	urnSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+)
+
+type (
+	supported struct {
+		Supported bool `json:"supported"`
+	}
+
+	serviceProviderConfigResponse struct {
+		Schemas        []string               `json:"schemas"`
+		Patch          supported              `json:"patch"`
+		Bulk           bulkConfig             `json:"bulk"`
+		Filter         filterConfig           `json:"filter"`
+		ChangePassword supported              `json:"changePassword"`
+		Sort           supported              `json:"sort"`
+		Etag           supported              `json:"etag"`
+		Auth           []authenticationScheme `json:"authenticationSchemes"`
+		Meta           discoveryMeta          `json:"meta"`
+	}
+
+	bulkConfig struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+
+	filterConfig struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+
+	authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	discoveryMeta struct {
+		ResourceType string `json:"resourceType"`
+		Location     string `json:"location,omitempty"`
+	}
+
+	resourceTypeResponse struct {
+		Schemas          []string                `json:"schemas"`
+		ID               string                  `json:"id"`
+		Name             string                  `json:"name"`
+		Endpoint         string                  `json:"endpoint"`
+		Description      string                  `json:"description"`
+		Schema           string                  `json:"schema"`
+		SchemaExtensions []resourceTypeExtension `json:"schemaExtensions,omitempty"`
+		Meta             discoveryMeta           `json:"meta"`
+	}
+
+	resourceTypeExtension struct {
+		Schema   string `json:"schema"`
+		Required bool   `json:"required"`
+	}
+
+	schemaResponse struct {
+		Schemas     []string          `json:"schemas"`
+		ID          string            `json:"id"`
+		Name        string            `json:"name"`
+		Description string            `json:"description"`
+		Attributes  []schemaAttribute `json:"attributes"`
+		Meta        discoveryMeta     `json:"meta"`
+	}
+
+	schemaAttribute struct {
+		Name          string            `json:"name"`
+		Type          string            `json:"type"`
+		MultiValued   bool              `json:"multiValued"`
+		Required      bool              `json:"required"`
+		CaseExact     bool              `json:"caseExact"`
+		Mutability    string            `json:"mutability"`
+		Returned      string            `json:"returned"`
+		Uniqueness    string            `json:"uniqueness"`
+		SubAttributes []schemaAttribute `json:"subAttributes,omitempty"`
+	}
+)
+
+var (
+	serviceProviderConfig = serviceProviderConfigResponse{
+		Schemas:        []string{urnServiceProviderConfig},
+		Patch:          supported{true},
+		Bulk:           bulkConfig{Supported: true, MaxOperations: bulkMaxOperations, MaxPayloadSize: bulkMaxPayloadSize},
+		Filter:         filterConfig{Supported: true, MaxResults: maxResults},
+		ChangePassword: supported{true},
+		Sort:           supported{true},
+		Etag:           supported{false},
+		Auth: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with the shared secret as a bearer token",
+		}},
+		Meta: discoveryMeta{ResourceType: "ServiceProviderConfig", Location: "/ServiceProviderConfig"},
+	}
+
+	resourceTypes = []*resourceTypeResponse{
+		{
+			Schemas:          []string{urnResourceType},
+			ID:               "User",
+			Name:             "User",
+			Endpoint:         "/Users",
+			Description:      "User Account",
+			Schema:           urnUser,
+			SchemaExtensions: []resourceTypeExtension{{Schema: urnEnterpriseUser}},
+			Meta:             discoveryMeta{ResourceType: "ResourceType", Location: "/ResourceTypes/User"},
+		},
+		{
+			Schemas:     []string{urnResourceType},
+			ID:          "Group",
+			Name:        "Group",
+			Endpoint:    "/Groups",
+			Description: "Group",
+			Schema:      urnGroup,
+			Meta:        discoveryMeta{ResourceType: "ResourceType", Location: "/ResourceTypes/Group"},
+		},
+	}
+
+	schemas = []*schemaResponse{
+		{
+			Schemas:     []string{urnSchema},
+			ID:          urnUser,
+			Name:        "User",
+			Description: "User Account",
+			Attributes: []schemaAttribute{
+				schemaAttr("userName", "string", withUniqueness("server")),
+				schemaAttr("name", "complex", withSubAttributes(
+					schemaAttr("formatted", "string"),
+					schemaAttr("givenName", "string", withReturned("never")),
+					schemaAttr("familyName", "string", withReturned("never")),
+				)),
+				schemaAttr("displayName", "string"),
+				schemaAttr("nickName", "string"),
+				schemaAttr("password", "string", withMutability("writeOnly"), withReturned("never")),
+				schemaAttr("active", "boolean"),
+				schemaAttr("emails", "complex", multiValued, withSubAttributes(
+					schemaAttr("value", "string"),
+					schemaAttr("type", "string"),
+					schemaAttr("primary", "boolean"),
+				)),
+				schemaAttr("groups", "complex", multiValued, withMutability("writeOnly"), withReturned("never"), withSubAttributes(
+					schemaAttr("value", "string"),
+				)),
+			},
+			Meta: discoveryMeta{ResourceType: "Schema", Location: "/Schemas/" + urnUser},
+		},
+		{
+			Schemas:     []string{urnSchema},
+			ID:          urnGroup,
+			Name:        "Group",
+			Description: "Group",
+			Attributes: []schemaAttribute{
+				schemaAttr("displayName", "string", required),
+				schemaAttr("members", "complex", multiValued, withReturned("never"), withSubAttributes(
+					schemaAttr("value", "string", withMutability("immutable")),
+				)),
+			},
+			Meta: discoveryMeta{ResourceType: "Schema", Location: "/Schemas/" + urnGroup},
+		},
+		{
+			Schemas:     []string{urnSchema},
+			ID:          urnEnterpriseUser,
+			Name:        "EnterpriseUser",
+			Description: "Enterprise User",
+			Attributes: []schemaAttribute{
+				schemaAttr("employeeNumber", "string"),
+				schemaAttr("costCenter", "string"),
+				schemaAttr("organization", "string"),
+				schemaAttr("division", "string"),
+				schemaAttr("department", "string"),
+				schemaAttr("manager", "complex", withSubAttributes(
+					schemaAttr("value", "string"),
+				)),
+			},
+			Meta: discoveryMeta{ResourceType: "Schema", Location: "/Schemas/" + urnEnterpriseUser},
+		},
+	}
+)
+
+func schemaAttr(name, typ string, oo ...func(*schemaAttribute)) schemaAttribute {
+	a := schemaAttribute{
+		Name:       name,
+		Type:       typ,
+		Mutability: "readWrite",
+		Returned:   "default",
+		Uniqueness: "none",
+	}
+
+	for _, o := range oo {
+		o(&a)
+	}
+
+	return a
+}
+
+func multiValued(a *schemaAttribute) { a.MultiValued = true }
+func required(a *schemaAttribute)    { a.Required = true }
+
+func withMutability(m string) func(*schemaAttribute) {
+	return func(a *schemaAttribute) { a.Mutability = m }
+}
+
+func withReturned(r string) func(*schemaAttribute) {
+	return func(a *schemaAttribute) { a.Returned = r }
+}
+
+func withUniqueness(u string) func(*schemaAttribute) {
+	return func(a *schemaAttribute) { a.Uniqueness = u }
+}
+
+func withSubAttributes(aa ...schemaAttribute) func(*schemaAttribute) {
+	return func(a *schemaAttribute) { a.SubAttributes = aa }
+}
+
+func getServiceProviderConfig(w http.ResponseWriter, _ *http.Request) {
+	send(w, http.StatusOK, serviceProviderConfig)
+}
+
+func listResourceTypes(w http.ResponseWriter, _ *http.Request) {
+	rr := make([]interface{}, len(resourceTypes))
+	for i := range resourceTypes {
+		rr[i] = resourceTypes[i]
+	}
+
+	send(w, http.StatusOK, discoveryList(rr))
+}
+
+func getResourceType(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	for _, rt := range resourceTypes {
+		if strings.EqualFold(rt.ID, id) {
+			send(w, http.StatusOK, rt)
+			return
+		}
+	}
+
+	sendError(w, newErrorfResponse(http.StatusNotFound, "resource type not found"))
+}
+
+func listSchemas(w http.ResponseWriter, _ *http.Request) {
+	rr := make([]interface{}, len(schemas))
+	for i := range schemas {
+		rr[i] = schemas[i]
+	}
+
+	send(w, http.StatusOK, discoveryList(rr))
+}
+
+func getSchema(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	for _, s := range schemas {
+		if strings.EqualFold(s.ID, id) {
+			send(w, http.StatusOK, s)
+			return
+		}
+	}
+
+	sendError(w, newErrorfResponse(http.StatusNotFound, "schema not found"))
+}
+
+func discoveryList(rr []interface{}) *listResponse {
+	return &listResponse{
+		Schemas:      []string{urnListResponse},
+		TotalResults: len(rr),
+		ItemsPerPage: len(rr),
+		StartIndex:   1,
+		Resources:    rr,
+	}
+}
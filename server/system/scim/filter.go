@@ -0,0 +1,651 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filtering as described in RFC 7644, section 3.4.2.2
+//
+// Filters are evaluated against resources encoded to JSON and decoded
+// into a generic map so that the same code works for all resource types
+// and all (including the extension) attributes.
+
+const (
+	filterOpEq = "eq"
+	filterOpNe = "ne"
+	filterOpCo = "co"
+	filterOpSw = "sw"
+	filterOpEw = "ew"
+	filterOpGt = "gt"
+	filterOpGe = "ge"
+	filterOpLt = "lt"
+	filterOpLe = "le"
+	filterOpPr = "pr"
+)
+
+type (
+	filterExpr interface {
+		match(res map[string]interface{}) bool
+	}
+
+	logicalExpr struct {
+		and         bool
+		left, right filterExpr
+	}
+
+	notExpr struct {
+		expr filterExpr
+	}
+
+	attrExpr struct {
+		path  attrPath
+		op    string
+		value interface{}
+	}
+
+	// valuePathExpr filters values of the multi-valued attribute
+	// emails[type eq "work" and value co "@example.com"]
+	valuePathExpr struct {
+		attr   attrPath
+		filter filterExpr
+	}
+
+	// attrPath points to an attribute or sub-attribute of the resource
+	//
+	// Patch operations can also use value filter: emails[type eq "work"].value
+	attrPath struct {
+		uri    string
+		name   string
+		sub    string
+		filter filterExpr
+	}
+
+	filterParser struct {
+		tokens []string
+		pos    int
+	}
+)
+
+func (e logicalExpr) match(res map[string]interface{}) bool {
+	if e.and {
+		return e.left.match(res) && e.right.match(res)
+	}
+
+	return e.left.match(res) || e.right.match(res)
+}
+
+func (e notExpr) match(res map[string]interface{}) bool {
+	return !e.expr.match(res)
+}
+
+func (e attrExpr) match(res map[string]interface{}) bool {
+	vv := e.path.values(res)
+
+	switch e.op {
+	case filterOpPr:
+		for _, v := range vv {
+			if !isEmptyValue(v) {
+				return true
+			}
+		}
+
+		return false
+
+	case filterOpNe:
+		return !attrExpr{path: e.path, op: filterOpEq, value: e.value}.match(res)
+	}
+
+	for _, v := range vv {
+		if compareValues(e.op, v, e.value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e valuePathExpr) match(res map[string]interface{}) bool {
+	for _, v := range asList(e.attr.lookup(res)) {
+		if m, ok := v.(map[string]interface{}); ok && e.filter.match(m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseFilter parses the filter expression
+func parseFilter(filter string) (filterExpr, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in filter", p.peek())
+	}
+
+	return expr, nil
+}
+
+// parseAttrPath parses path of the patch operation
+func parseAttrPath(path string) (ap attrPath, err error) {
+	tokens, err := tokenizeFilter(path)
+	if err != nil {
+		return
+	}
+
+	p := &filterParser{tokens: tokens}
+	if ap, err = p.parseAttrPath(); err != nil {
+		return
+	}
+
+	if p.peek() == "[" {
+		p.next()
+		if ap.filter, err = p.parseOr(); err != nil {
+			return
+		}
+
+		if p.next() != "]" {
+			return ap, fmt.Errorf("expecting ] in path")
+		}
+
+		if sub := p.peek(); strings.HasPrefix(sub, ".") {
+			p.next()
+			ap.sub = sub[1:]
+		}
+	}
+
+	if !p.done() {
+		return ap, fmt.Errorf("unexpected %q in path", p.peek())
+	}
+
+	return
+}
+
+func tokenizeFilter(s string) (tokens []string, err error) {
+	rr := []rune(s)
+	for i := 0; i < len(rr); {
+		switch r := rr[i]; {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(' || r == ')' || r == '[' || r == ']':
+			tokens = append(tokens, string(r))
+			i++
+
+		case r == '"':
+			j := i + 1
+			for ; j < len(rr) && rr[j] != '"'; j++ {
+				if rr[j] == '\\' {
+					j++
+				}
+			}
+
+			if j >= len(rr) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+
+			tokens = append(tokens, string(rr[i:j+1]))
+			i = j + 1
+
+		default:
+			j := i
+			for ; j < len(rr) && !unicode.IsSpace(rr[j]) && !strings.ContainsRune("()[]\"", rr[j]); j++ {
+			}
+
+			tokens = append(tokens, string(rr[i:j]))
+			i = j
+		}
+	}
+
+	return
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() string {
+	if p.done() {
+		return ""
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalExpr{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterExpr, error) {
+	if !strings.EqualFold(p.peek(), "not") {
+		return p.parsePrimary()
+	}
+
+	p.next()
+	if p.peek() != "(" {
+		return nil, fmt.Errorf("expecting ( after not")
+	}
+
+	expr, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	return notExpr{expr: expr}, nil
+}
+
+func (p *filterParser) parsePrimary() (expr filterExpr, err error) {
+	if p.peek() == "(" {
+		p.next()
+		if expr, err = p.parseOr(); err != nil {
+			return
+		}
+
+		if p.next() != ")" {
+			return nil, fmt.Errorf("expecting ) in filter")
+		}
+
+		return
+	}
+
+	path, err := p.parseAttrPath()
+	if err != nil {
+		return
+	}
+
+	if p.peek() == "[" {
+		p.next()
+
+		vp := valuePathExpr{attr: path}
+		if vp.filter, err = p.parseOr(); err != nil {
+			return
+		}
+
+		if p.next() != "]" {
+			return nil, fmt.Errorf("expecting ] in filter")
+		}
+
+		return vp, nil
+	}
+
+	ae := attrExpr{path: path, op: strings.ToLower(p.next())}
+	switch ae.op {
+	case filterOpPr:
+		return ae, nil
+	case filterOpEq, filterOpNe, filterOpCo, filterOpSw, filterOpEw, filterOpGt, filterOpGe, filterOpLt, filterOpLe:
+		// ok
+	default:
+		return nil, fmt.Errorf("invalid filter operator %q", ae.op)
+	}
+
+	if ae.value, err = parseFilterValue(p.next()); err != nil {
+		return
+	}
+
+	return ae, nil
+}
+
+func (p *filterParser) parseAttrPath() (ap attrPath, err error) {
+	t := p.next()
+	if t == "" || strings.ContainsAny(t[:1], "()[]\".") {
+		return ap, fmt.Errorf("expecting attribute path, got %q", t)
+	}
+
+	if strings.HasPrefix(strings.ToLower(t), "urn:") {
+		// attributes of the extension schemas are prefixed with its URN
+		// urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.value
+		if strings.EqualFold(t, urnEnterpriseUser) {
+			// the whole extension
+			ap.uri = t
+			return
+		}
+
+		i := strings.LastIndex(t, ":")
+		ap.uri, t = t[:i], t[i+1:]
+
+		if strings.EqualFold(ap.uri, urnUser) || strings.EqualFold(ap.uri, urnGroup) {
+			// core attributes are not nested
+			ap.uri = ""
+		}
+	}
+
+	ap.name, ap.sub = t, ""
+	if i := strings.Index(t, "."); i >= 0 {
+		ap.name, ap.sub = t[:i], t[i+1:]
+	}
+
+	return
+}
+
+func parseFilterValue(t string) (interface{}, error) {
+	switch strings.ToLower(t) {
+	case "":
+		return nil, fmt.Errorf("expecting value in filter")
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if strings.HasPrefix(t, "\"") {
+		var s string
+		if err := json.Unmarshal([]byte(t), &s); err != nil {
+			return nil, fmt.Errorf("invalid string in filter: %w", err)
+		}
+
+		return s, nil
+	}
+
+	n, err := strconv.ParseFloat(t, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q in filter", t)
+	}
+
+	return n, nil
+}
+
+// lookup returns value of the attribute (without sub-attribute)
+func (ap attrPath) lookup(res map[string]interface{}) interface{} {
+	if ap.uri != "" {
+		ext, ok := lookupKey(res, ap.uri).(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		if ap.name == "" {
+			return ext
+		}
+
+		res = ext
+	}
+
+	return lookupKey(res, ap.name)
+}
+
+// values returns all values the attribute path points to
+//
+// Values of multi-valued attributes are flattened; when sub-attribute
+// is not specified, complex multi-valued attributes are represented
+// by their "value" sub-attribute.
+func (ap attrPath) values(res map[string]interface{}) (out []interface{}) {
+	for _, v := range asList(ap.lookup(res)) {
+		m, complex := v.(map[string]interface{})
+
+		switch {
+		case ap.sub != "" && complex:
+			out = append(out, asList(lookupKey(m, ap.sub))...)
+		case ap.sub != "":
+			// simple attributes have no sub-attributes
+		case complex:
+			if sv, has := m["value"]; has {
+				out = append(out, sv)
+			}
+		default:
+			out = append(out, v)
+		}
+	}
+
+	return
+}
+
+// lookupKey returns value under the key (attribute names are case-insensitive)
+func lookupKey(m map[string]interface{}, key string) interface{} {
+	if v, ok := m[key]; ok {
+		return v
+	}
+
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// canonicalKey returns the existing key that matches the given one
+func canonicalKey(m map[string]interface{}, key string) string {
+	for k := range m {
+		if strings.EqualFold(k, key) {
+			return k
+		}
+	}
+
+	return key
+}
+
+func asList(v interface{}) []interface{} {
+	switch c := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return c
+	default:
+		return []interface{}{v}
+	}
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch c := v.(type) {
+	case nil:
+		return true
+	case string:
+		return c == ""
+	case []interface{}:
+		return len(c) == 0
+	case map[string]interface{}:
+		return len(c) == 0
+	}
+
+	return false
+}
+
+// compareValues compares attribute value with the value from the filter
+//
+// Strings are compared case-insensitive, strings that are
+// dates are compared as dates.
+func compareValues(op string, attr, value interface{}) bool {
+	switch a := attr.(type) {
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+
+		if at, err := time.Parse(time.RFC3339Nano, a); err == nil {
+			if vt, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				switch {
+				case at.Before(vt):
+					return compareOrdered(op, -1)
+				case at.After(vt):
+					return compareOrdered(op, 1)
+				default:
+					return compareOrdered(op, 0)
+				}
+			}
+		}
+
+		a, v = strings.ToLower(a), strings.ToLower(v)
+		switch op {
+		case filterOpCo:
+			return strings.Contains(a, v)
+		case filterOpSw:
+			return strings.HasPrefix(a, v)
+		case filterOpEw:
+			return strings.HasSuffix(a, v)
+		default:
+			return compareOrdered(op, strings.Compare(a, v))
+		}
+
+	case float64:
+		v, ok := value.(float64)
+		if !ok {
+			return false
+		}
+
+		switch {
+		case a < v:
+			return compareOrdered(op, -1)
+		case a > v:
+			return compareOrdered(op, 1)
+		default:
+			return compareOrdered(op, 0)
+		}
+
+	case bool:
+		v, ok := value.(bool)
+		return ok && op == filterOpEq && a == v
+
+	case nil:
+		return op == filterOpEq && value == nil
+	}
+
+	return false
+}
+
+func compareOrdered(op string, c int) bool {
+	switch op {
+	case filterOpEq:
+		return c == 0
+	case filterOpGt:
+		return c > 0
+	case filterOpGe:
+		return c >= 0
+	case filterOpLt:
+		return c < 0
+	case filterOpLe:
+		return c <= 0
+	}
+
+	return false
+}
+
+// eqConstraint returns the value the attribute must be equal to
+// for the filter to match (when the filter is that simple)
+//
+// Sub-attributes are addressed with a dot (emails.value).
+// Used to narrow down the search before the filter is applied.
+func eqConstraint(expr filterExpr, attr string) (string, bool) {
+	switch e := expr.(type) {
+	case attrExpr:
+		if e.op != filterOpEq || !e.path.is(attr) {
+			return "", false
+		}
+
+		s, ok := e.value.(string)
+		return s, ok
+
+	case logicalExpr:
+		if !e.and {
+			return "", false
+		}
+
+		if v, ok := eqConstraint(e.left, attr); ok {
+			return v, ok
+		}
+
+		return eqConstraint(e.right, attr)
+	}
+
+	return "", false
+}
+
+// onlyEqConstraints reports if the filter consists only of eq constraints
+// on the given attributes (joined with and)
+//
+// Such filters can be fully handled by the store.
+func onlyEqConstraints(expr filterExpr, attrs ...string) bool {
+	switch e := expr.(type) {
+	case nil:
+		return true
+
+	case attrExpr:
+		if _, ok := e.value.(string); !ok || e.op != filterOpEq {
+			return false
+		}
+
+		for _, attr := range attrs {
+			if e.path.is(attr) {
+				return true
+			}
+		}
+
+	case logicalExpr:
+		return e.and && onlyEqConstraints(e.left, attrs...) && onlyEqConstraints(e.right, attrs...)
+	}
+
+	return false
+}
+
+// is checks if path points to the (core) attribute
+//
+// Sub-attributes are addressed with a dot (emails.value)
+func (ap attrPath) is(attr string) bool {
+	var (
+		name = attr
+		sub  string
+	)
+
+	if p := strings.Index(attr, "."); p > 0 {
+		name, sub = attr[:p], attr[p+1:]
+	}
+
+	return ap.uri == "" && ap.filter == nil && strings.EqualFold(ap.name, name) && strings.EqualFold(ap.sub, sub)
+}
@@ -0,0 +1,200 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	var (
+		res = map[string]interface{}{
+			"id":          "42",
+			"userName":    "Bjensen",
+			"displayName": "Barbara Jensen",
+			"active":      true,
+			"meta": map[string]interface{}{
+				"created": "2011-08-01T18:29:49.793Z",
+			},
+			"emails": []interface{}{
+				map[string]interface{}{"value": "bjensen@example.com", "type": "work", "primary": true},
+				map[string]interface{}{"value": "babs@jensen.org", "type": "home"},
+			},
+			urnEnterpriseUser: map[string]interface{}{
+				"department": "Tour Operations",
+				"manager":    map[string]interface{}{"value": "26118915-6090-4610-87e4-49d8ca9f808d"},
+			},
+		}
+
+		tcc = []struct {
+			filter string
+			match  bool
+		}{
+			{`userName eq "bjensen"`, true},
+			{`UserName Eq "bjensen"`, true},
+			{`userName ne "bjensen"`, false},
+			{`userName co "jen"`, true},
+			{`userName sw "B"`, true},
+			{`userName ew "sen"`, true},
+			{`userName sw "x"`, false},
+			{`nickName pr`, false},
+			{`displayName pr`, true},
+			{`active eq true`, true},
+			{`active eq false`, false},
+			{`meta.created gt "2011-05-13T04:42:34Z"`, true},
+			{`meta.created lt "2011-05-13T04:42:34Z"`, false},
+			{`emails co "example.com"`, true},
+			{`emails.type eq "work"`, true},
+			{`emails[type eq "work" and value co "@example.com"]`, true},
+			{`emails[type eq "home" and value co "@example.com"]`, false},
+			{`userName eq "bjensen" and active eq false`, false},
+			{`userName eq "x" or active eq true`, true},
+			{`not (userName eq "x")`, true},
+			{`userName eq "x" or (active eq true and displayName sw "Barbara")`, true},
+			{`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "Tour Operations"`, true},
+			{`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.value pr`, true},
+			{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "bjensen"`, true},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := parseFilter(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.match, f.match(res))
+		})
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	for _, filter := range []string{
+		`userName`,
+		`userName xx "foo"`,
+		`userName eq`,
+		`userName eq "foo`,
+		`(userName eq "foo"`,
+		`emails[type eq "work"`,
+		`userName eq "foo" and`,
+		`not userName eq "foo"`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			_, err := parseFilter(filter)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFilterEqConstraint(t *testing.T) {
+	req := require.New(t)
+
+	f, err := parseFilter(`externalId eq "ext" and userName sw "b"`)
+	req.NoError(err)
+
+	v, ok := eqConstraint(f, "externalId")
+	req.True(ok)
+	req.Equal("ext", v)
+
+	f, err = parseFilter(`externalId eq "ext" or userName sw "b"`)
+	req.NoError(err)
+
+	_, ok = eqConstraint(f, "externalId")
+	req.False(ok)
+
+	f, err = parseFilter(`emails.value eq "a@b.c" and userName eq "a"`)
+	req.NoError(err)
+
+	v, ok = eqConstraint(f, "emails.value")
+	req.True(ok)
+	req.Equal("a@b.c", v)
+
+	_, ok = eqConstraint(f, "emails")
+	req.False(ok)
+}
+
+func TestFilterOnlyEqConstraints(t *testing.T) {
+	tcc := []struct {
+		filter string
+		only   bool
+	}{
+		{`userName eq "a"`, true},
+		{`userName eq "a" and externalId eq "b"`, true},
+		{`emails.value eq "a@b.c"`, true},
+		{`userName eq "a" or externalId eq "b"`, false},
+		{`userName sw "a"`, false},
+		{`userName eq "a" and active eq true`, false},
+		{`emails[type eq "work" and value eq "a@b.c"]`, false},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := parseFilter(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.only, onlyEqConstraints(f, "userName", "externalId", "emails.value"))
+		})
+	}
+
+	require.True(t, onlyEqConstraints(nil))
+}
+
+func TestListRequest(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		rr = []interface{}{
+			map[string]interface{}{"userName": "c", "n": 3},
+			map[string]interface{}{"userName": "a", "n": 1},
+			map[string]interface{}{"n": 0},
+			map[string]interface{}{"userName": "B", "n": 2},
+		}
+
+		intPtr = func(i int) *int { return &i }
+
+		names = func(rsp *listResponse) (out []string) {
+			for _, r := range rsp.Resources {
+				n, _ := r.(map[string]interface{})["userName"].(string)
+				out = append(out, n)
+			}
+
+			return
+		}
+	)
+
+	lr, err := (&searchRequest{SortBy: "userName"}).listRequest()
+	req.NoError(err)
+	rsp, err := lr.apply(rr)
+	req.NoError(err)
+	req.Equal(4, rsp.TotalResults)
+	req.Equal([]string{"a", "B", "c", ""}, names(rsp))
+
+	lr, err = (&searchRequest{SortBy: "userName", SortOrder: "descending", StartIndex: intPtr(2), Count: intPtr(2)}).listRequest()
+	req.NoError(err)
+	rsp, err = lr.apply(rr)
+	req.NoError(err)
+	req.Equal(4, rsp.TotalResults)
+	req.Equal(2, rsp.ItemsPerPage)
+	req.Equal(2, rsp.StartIndex)
+	req.Equal([]string{"B", "a"}, names(rsp))
+
+	lr, err = (&searchRequest{Filter: "n gt 1", Count: intPtr(0)}).listRequest()
+	req.NoError(err)
+	rsp, err = lr.apply(rr)
+	req.NoError(err)
+	req.Equal(2, rsp.TotalResults)
+	req.Empty(rsp.Resources)
+
+	// resources paged by the store
+	lr, err = (&searchRequest{StartIndex: intPtr(2), Count: intPtr(2)}).listRequest()
+	req.NoError(err)
+	req.Equal(uint(3), lr.limit())
+	rsp = lr.page(rr[:3], 4)
+	req.Equal(4, rsp.TotalResults)
+	req.Equal(2, rsp.ItemsPerPage)
+	req.Equal([]string{"a", ""}, names(rsp))
+
+	_, err = (&searchRequest{Filter: "n gt"}).listRequest()
+	req.Error(err)
+	req.Equal(errTypeInvalidFilter, err.(*errorResponse).SCIMType)
+
+	_, err = (&searchRequest{SortOrder: "random"}).listRequest()
+	req.Error(err)
+}
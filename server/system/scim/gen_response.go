@@ -24,6 +24,14 @@ type (
 
 const (
 	urnError = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// error types, RFC 7644, section 3.12
+	errTypeInvalidFilter = "invalidFilter"
+	errTypeTooMany       = "tooMany"
+	errTypeInvalidSyntax = "invalidSyntax"
+	errTypeInvalidPath   = "invalidPath"
+	errTypeNoTarget      = "noTarget"
+	errTypeInvalidValue  = "invalidValue"
 )
 
 func newUserMetaResponse(u *types.User) *metaResponse {
@@ -70,3 +78,10 @@ func newErrorResponse(httpStatus int, err error) *errorResponse {
 func (e *errorResponse) Error() string {
 	return e.Detail
 }
+
+// newScimErrorf returns bad request error with the SCIM error type
+func newScimErrorf(scimType, format string, aa ...interface{}) *errorResponse {
+	er := newErrorfResponse(http.StatusBadRequest, format, aa...)
+	er.SCIMType = scimType
+	return er
+}
@@ -37,6 +37,46 @@ func (h groupsHandler) get(w http.ResponseWriter, r *http.Request) {
 	send(w, http.StatusOK, newGroupResourceResponse(res))
 }
 
+// lists groups
+//
+// Handles GET /Groups and POST /Groups/.search requests
+func (h groupsHandler) list(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = h.sec(r)
+		f   = types.RoleFilter{}
+	)
+
+	lr, err := decodeSearchRequest(r)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	if extID, ok := eqConstraint(lr.filter, "externalId"); ok {
+		// narrow down the search
+		f.Labels = map[string]string{groupLabel_SCIM_externalId: extID}
+	}
+
+	gg, _, err := h.svc.Find(ctx, f)
+	if err != nil {
+		sendError(w, newErrorResponse(http.StatusInternalServerError, err))
+		return
+	}
+
+	rr := make([]interface{}, len(gg))
+	for i, g := range gg {
+		rr[i] = newGroupResourceResponse(g)
+	}
+
+	rsp, err := lr.apply(rr)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	send(w, http.StatusOK, rsp)
+}
+
 func (h groupsHandler) create(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
@@ -114,13 +154,13 @@ func (h groupsHandler) replace(w http.ResponseWriter, r *http.Request) {
 
 // patches group
 //
-// only supports adding and removing members
+// Members are added, removed or replaced, all other
+// operations are applied to the group resource
 func (h groupsHandler) patch(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
 	var (
 		ctx     = h.sec(r)
-		svc     = h.svc
 		res     = h.lookup(ctx, chi.URLParam(r, "id"), w)
 		payload = &operationsRequest{}
 	)
@@ -134,10 +174,55 @@ func (h groupsHandler) patch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	attrOps, memberOps, err := splitGroupOperations(payload.Operations)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	if len(attrOps.Operations) > 0 {
+		doc, err := toResourceMap(newGroupResourceResponse(res))
+		if err != nil {
+			sendError(w, err)
+			return
+		}
+
+		if err = attrOps.applyTo(doc); err != nil {
+			sendError(w, err)
+			return
+		}
+
+		req := &groupResourceRequest{}
+		if err = fromResourceMap(doc, req); err != nil {
+			sendError(w, err)
+			return
+		}
+
+		if res, err = h.save(ctx, req, res); err != nil {
+			sendError(w, err)
+			return
+		}
+	}
+
+	if err = h.applyMemberOps(ctx, res, memberOps); err != nil {
+		sendError(w, err)
+		return
+	}
+
+	send(w, http.StatusNoContent, nil)
+}
+
+// applyMemberOps validates and applies member operations
+func (h groupsHandler) applyMemberOps(ctx context.Context, res *types.Role, memberOps []groupMemberOperation) error {
+	if len(memberOps) == 0 {
+		return nil
+	}
+
 	var (
+		svc         = h.svc
 		u           *types.User
-		ops         = make([]func() error, 0, len(payload.Operations))
 		err         error
+		ops         = make([]func() error, 0, len(memberOps))
 		memberships = make(map[uint64]bool)
 	)
 
@@ -148,8 +233,7 @@ func (h groupsHandler) patch(w http.ResponseWriter, r *http.Request) {
 		// this is not 100% bulletproof for concurrent modifications
 		mm, _, err := store.SearchRoleMembers(ctx, service.DefaultStore, types.RoleMemberFilter{RoleID: res.ID})
 		if err != nil {
-			sendError(w, err)
-			return
+			return err
 		}
 
 		for _, m := range mm {
@@ -158,32 +242,35 @@ func (h groupsHandler) patch(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// validate and collect operations
-	for _, op := range payload.Operations {
-		// iterate through operation's values, load user and schedule op
-		for path, userExternalId := range op.Value {
-			if path != "members" {
-				// allow only "members" path
-				sendError(w, newErrorfResponse(http.StatusBadRequest, "unsupported path: %q", op.Path))
-				return
-			}
+	for _, op := range memberOps {
+		var (
+			// members listed in the replace operation
+			keep = make(map[uint64]bool)
+		)
+
+		if op.Operation == patchOpRemove && len(op.values) == 0 {
+			// remove all members
+			op.Operation = patchOpReplace
+		}
 
-			u, err = lookupUserByExternalId(ctx, h.userSvc, h.externalIdValidator, userExternalId)
+		// iterate through operation's values, load user and schedule op
+		for _, value := range op.values {
+			u, err = h.lookupMember(ctx, value)
 			if err != nil {
-				sendError(w, err)
-				return
+				return err
 			}
 
 			if u == nil {
-				sendError(w, newErrorfResponse(http.StatusBadRequest, "no such user: %q", userExternalId))
-				return
+				return newErrorfResponse(http.StatusBadRequest, "no such user: %q", value)
 			}
 
 			// making sure u is not overwritten
 			// in the next iteration
 			memberId := u.ID
+			keep[memberId] = true
 
 			switch op.Operation {
-			case patchOpAdd:
+			case patchOpAdd, patchOpReplace:
 				// support for add operation,
 				// check if there members already exist
 				ops = append(ops, func() error {
@@ -208,23 +295,58 @@ func (h groupsHandler) patch(w http.ResponseWriter, r *http.Request) {
 					delete(memberships, memberId)
 					return svc.MemberRemove(ctx, res.ID, memberId)
 				})
-
-			default:
-				sendError(w, newErrorfResponse(http.StatusBadRequest, "unsupported operation: %q", op.Operation))
-				return
 			}
 		}
+
+		if op.Operation == patchOpReplace {
+			// remove all members that are not listed
+			ops = append(ops, func() error {
+				for memberId := range memberships {
+					if keep[memberId] {
+						continue
+					}
+
+					delete(memberships, memberId)
+					if err := svc.MemberRemove(ctx, res.ID, memberId); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		}
 	}
 
 	// run all scheduled ops
 	for _, op := range ops {
 		if err = op(); err != nil {
-			sendError(w, err)
-			return
+			return err
 		}
 	}
 
-	send(w, http.StatusNoContent, nil)
+	return nil
+}
+
+// lookupMember loads user referenced by the members value
+//
+// Value is user's externalId; when externalId is not used as
+// primary identifier, user ID is accepted as well
+func (h groupsHandler) lookupMember(ctx context.Context, value string) (u *types.User, err error) {
+	u, err = lookupUserByExternalId(ctx, h.userSvc, h.externalIdValidator, value)
+	if err != nil || u != nil || h.externalIdAsPrimary {
+		return
+	}
+
+	id, _ := strconv.ParseUint(value, 10, 64)
+	if id == 0 {
+		return nil, nil
+	}
+
+	if u, err = h.userSvc.FindByID(ctx, id); errors.Is(err, service.UserErrNotFound()) {
+		return nil, nil
+	}
+
+	return
 }
 
 func (h groupsHandler) save(ctx context.Context, req *groupResourceRequest, existing *types.Role) (res *types.Role, err error) {
@@ -251,6 +373,18 @@ func (h groupsHandler) save(ctx context.Context, req *groupResourceRequest, exis
 		return nil, newErrorResponse(http.StatusInternalServerError, err)
 	}
 
+	if req.Members != nil {
+		// members are listed, replace existing memberships
+		mop := groupMemberOperation{Operation: patchOpReplace}
+		for _, m := range req.Members {
+			mop.values = append(mop.values, m.Value)
+		}
+
+		if err = h.applyMemberOps(ctx, res, []groupMemberOperation{mop}); err != nil {
+			return nil, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/cortezaproject/corteza/server/system/types"
 	"io"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -22,11 +23,23 @@ type (
 		Name       string        `json:"displayName"`
 	}
 
+	groupMemberOperation struct {
+		Operation string
+		values    []string
+	}
+
 	groupResourceRequest struct {
 		Schemas    []string      `json:"schemas"`
 		Meta       *metaResponse `json:"meta,omitempty"`
 		ExternalId *string       `json:"externalId,omitempty"`
 		Name       *string       `json:"displayName"`
+
+		// when set, group members are replaced
+		Members []groupMemberRequest `json:"members,omitempty"`
+	}
+
+	groupMemberRequest struct {
+		Value string `json:"value"`
 	}
 )
 
@@ -59,3 +72,102 @@ func (req *groupResourceRequest) applyTo(u *types.Role) {
 		u.SetLabel("SCIM_externalId", *req.ExternalId)
 	}
 }
+
+// splitGroupOperations separates member operations from the operations
+// on the group attributes
+//
+// Members can be referenced through path with value filter
+// (members[value eq "42"]) or listed in the value of the operation
+// ([{"value": "42"}]).
+func splitGroupOperations(oo []operationRequest) (attrOps *operationsRequest, memberOps []groupMemberOperation, err error) {
+	attrOps = &operationsRequest{}
+
+	for _, op := range oo {
+		var (
+			value interface{}
+			mop   = groupMemberOperation{Operation: op.Operation}
+		)
+
+		switch op.Operation {
+		case patchOpAdd, patchOpRemove, patchOpReplace:
+			// ok
+		default:
+			return nil, nil, newScimErrorf(errTypeInvalidSyntax, "unsupported operation: %q", op.Operation)
+		}
+
+		if value, err = op.value(); err != nil {
+			return nil, nil, newScimErrorf(errTypeInvalidValue, "%v", err)
+		}
+
+		if op.Path == "" {
+			vm, ok := value.(map[string]interface{})
+			if !ok {
+				// let the attribute patching deal with it
+				attrOps.Operations = append(attrOps.Operations, op)
+				continue
+			}
+
+			key := canonicalKey(vm, "members")
+			if members, has := vm[key]; has {
+				if mop.values, err = memberValues(members); err != nil {
+					return
+				}
+
+				memberOps = append(memberOps, mop)
+				delete(vm, key)
+			}
+
+			if len(vm) > 0 {
+				op.RawValue, _ = json.Marshal(vm)
+				attrOps.Operations = append(attrOps.Operations, op)
+			}
+
+			continue
+		}
+
+		ap, err := parseAttrPath(op.Path)
+		if err != nil {
+			return nil, nil, newScimErrorf(errTypeInvalidPath, "%v", err)
+		}
+
+		if ap.uri != "" || !strings.EqualFold(ap.name, "members") {
+			attrOps.Operations = append(attrOps.Operations, op)
+			continue
+		}
+
+		if ap.filter != nil {
+			ae, ok := ap.filter.(attrExpr)
+			v, isString := ae.value.(string)
+			if !ok || ae.op != filterOpEq || !strings.EqualFold(ae.path.name, "value") || !isString {
+				return nil, nil, newScimErrorf(errTypeInvalidFilter, "unsupported members filter: %q", op.Path)
+			}
+
+			mop.values = []string{v}
+		} else if mop.values, err = memberValues(value); err != nil {
+			return nil, nil, err
+		}
+
+		memberOps = append(memberOps, mop)
+	}
+
+	return
+}
+
+// memberValues returns values (user IDs) from the list of members
+func memberValues(members interface{}) (out []string, err error) {
+	for _, m := range asList(members) {
+		v, ok := lookupKey(asMap(m), "value").(string)
+		if !ok {
+			return nil, newScimErrorf(errTypeInvalidValue, "invalid members value")
+		}
+
+		out = append(out, v)
+	}
+
+	return
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
@@ -0,0 +1,288 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	urnListResponse  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	urnSearchRequest = "urn:ietf:params:scim:api:messages:2.0:SearchRequest"
+
+	// max number of resources returned in one response
+	maxResults = 1000
+)
+
+type (
+	// searchRequest holds filtering, sorting and paging parameters
+	// from the query string or from the body of the .search request
+	searchRequest struct {
+		Schemas    []string `json:"schemas"`
+		Filter     string   `json:"filter"`
+		SortBy     string   `json:"sortBy"`
+		SortOrder  string   `json:"sortOrder"`
+		StartIndex *int     `json:"startIndex"`
+		Count      *int     `json:"count"`
+	}
+
+	listRequest struct {
+		filter     filterExpr
+		sortBy     *attrPath
+		descending bool
+
+		// 1-based index of the first resource
+		startIndex int
+		count      int
+	}
+
+	listResponse struct {
+		Schemas      []string      `json:"schemas"`
+		TotalResults int           `json:"totalResults"`
+		ItemsPerPage int           `json:"itemsPerPage"`
+		StartIndex   int           `json:"startIndex"`
+		Resources    []interface{} `json:"Resources"`
+	}
+
+	listItem struct {
+		res interface{}
+		doc map[string]interface{}
+	}
+)
+
+func (req *searchRequest) decodeQuery(q url.Values) error {
+	req.Filter = q.Get("filter")
+	req.SortBy = q.Get("sortBy")
+	req.SortOrder = q.Get("sortOrder")
+
+	for param, dst := range map[string]**int{"startIndex": &req.StartIndex, "count": &req.Count} {
+		if v := q.Get(param); v != "" {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return newScimErrorf(errTypeInvalidValue, "invalid %s: %q", param, v)
+			}
+
+			*dst = &i
+		}
+	}
+
+	return nil
+}
+
+func (req *searchRequest) decodeJSON(r io.Reader) error {
+	if err := json.NewDecoder(r).Decode(req); err != nil {
+		return newScimErrorf(errTypeInvalidSyntax, "could not decode search payload: %v", err)
+	}
+
+	return nil
+}
+
+// listRequest validates and parses the search request
+func (req *searchRequest) listRequest() (lr *listRequest, err error) {
+	lr = &listRequest{
+		startIndex: 1,
+		count:      maxResults,
+	}
+
+	if req.Filter != "" {
+		if lr.filter, err = parseFilter(req.Filter); err != nil {
+			return nil, newScimErrorf(errTypeInvalidFilter, "%v", err)
+		}
+	}
+
+	if req.SortBy != "" {
+		ap, err := parseAttrPath(req.SortBy)
+		if err != nil || ap.filter != nil {
+			return nil, newScimErrorf(errTypeInvalidPath, "invalid sortBy: %q", req.SortBy)
+		}
+
+		lr.sortBy = &ap
+	}
+
+	switch strings.ToLower(req.SortOrder) {
+	case "", "ascending":
+	case "descending":
+		lr.descending = true
+	default:
+		return nil, newScimErrorf(errTypeInvalidValue, "invalid sortOrder: %q", req.SortOrder)
+	}
+
+	if req.StartIndex != nil && *req.StartIndex > 1 {
+		lr.startIndex = *req.StartIndex
+	}
+
+	if req.Count != nil {
+		switch {
+		case *req.Count < 0:
+			lr.count = 0
+		case *req.Count < maxResults:
+			lr.count = *req.Count
+		}
+	}
+
+	return
+}
+
+// apply filters, sorts and pages the resources
+func (lr *listRequest) apply(rr []interface{}) (*listResponse, error) {
+	var (
+		items = make([]listItem, 0, len(rr))
+		rsp   = &listResponse{
+			Schemas:    []string{urnListResponse},
+			StartIndex: lr.startIndex,
+			Resources:  []interface{}{},
+		}
+	)
+
+	for _, res := range rr {
+		doc, err := toResourceMap(res)
+		if err != nil {
+			return nil, err
+		}
+
+		if lr.filter != nil && !lr.filter.match(doc) {
+			continue
+		}
+
+		items = append(items, listItem{res: res, doc: doc})
+	}
+
+	if lr.sortBy != nil {
+		sort.SliceStable(items, func(i, j int) bool {
+			return lr.less(items[i].doc, items[j].doc)
+		})
+	}
+
+	rsp.TotalResults = len(items)
+
+	from := lr.startIndex - 1
+	if from > len(items) {
+		from = len(items)
+	}
+
+	to := from + lr.count
+	if to > len(items) {
+		to = len(items)
+	}
+
+	for _, i := range items[from:to] {
+		rsp.Resources = append(rsp.Resources, i.res)
+	}
+
+	rsp.ItemsPerPage = len(rsp.Resources)
+	return rsp, nil
+}
+
+// limit returns number of resources that need to be
+// fetched (including the skipped ones) for the requested page
+func (lr *listRequest) limit() uint {
+	return uint(lr.startIndex - 1 + lr.count)
+}
+
+// page builds response from resources that were already
+// filtered, sorted and limited (see limit) by the store
+func (lr *listRequest) page(rr []interface{}, total uint) *listResponse {
+	var (
+		rsp = &listResponse{
+			Schemas:      []string{urnListResponse},
+			StartIndex:   lr.startIndex,
+			TotalResults: int(total),
+			Resources:    []interface{}{},
+		}
+
+		from = lr.startIndex - 1
+	)
+
+	if from < len(rr) {
+		rsp.Resources = append(rsp.Resources, rr[from:]...)
+	}
+
+	rsp.ItemsPerPage = len(rsp.Resources)
+	return rsp
+}
+
+// less compares resources by the sortBy attribute
+//
+// Resources without value are always sorted last.
+func (lr *listRequest) less(a, b map[string]interface{}) bool {
+	var (
+		av = sortValue(lr.sortBy.values(a))
+		bv = sortValue(lr.sortBy.values(b))
+	)
+
+	switch {
+	case av == nil:
+		return false
+	case bv == nil:
+		return true
+	case lr.descending:
+		return compareValues(filterOpGt, av, bv)
+	default:
+		return compareValues(filterOpLt, av, bv)
+	}
+}
+
+// sortValue returns the value a multi-valued attribute is sorted by
+func sortValue(vv []interface{}) interface{} {
+	if len(vv) == 0 {
+		return nil
+	}
+
+	return vv[0]
+}
+
+// decodeSearchRequest reads search parameters from the query string
+// or from the body of the POST .search request
+func decodeSearchRequest(r *http.Request) (*listRequest, error) {
+	var (
+		req = &searchRequest{}
+		err error
+	)
+
+	if r.Method == http.MethodPost {
+		defer r.Body.Close()
+		err = req.decodeJSON(r.Body)
+	} else {
+		err = req.decodeQuery(r.URL.Query())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return req.listRequest()
+}
+
+// toResourceMap converts resource to a generic map
+// used for filtering, sorting and patching
+func toResourceMap(res interface{}) (out map[string]interface{}, err error) {
+	buf, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode resource: %w", err)
+	}
+
+	if err = json.Unmarshal(buf, &out); err != nil {
+		return nil, fmt.Errorf("could not decode resource: %w", err)
+	}
+
+	return
+}
+
+// fromResourceMap converts generic map back to the resource request
+func fromResourceMap(doc map[string]interface{}, dst interface{}) error {
+	buf, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("could not encode resource: %w", err)
+	}
+
+	if err = json.Unmarshal(buf, dst); err != nil {
+		return newScimErrorf(errTypeInvalidValue, "%v", err)
+	}
+
+	return nil
+}
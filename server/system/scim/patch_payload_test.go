@@ -36,3 +36,116 @@ func TestOperationsRequestDecodeJSON(t *testing.T) {
 	req.NoError(err)
 	req.Equal(expectedPayload, payload)
 }
+
+func TestOperationsRequestApplyTo(t *testing.T) {
+	var (
+		res = func() map[string]interface{} {
+			return map[string]interface{}{
+				"userName": "bjensen",
+				"active":   true,
+				"name":     map[string]interface{}{"formatted": "Barbara Jensen"},
+				"emails": []interface{}{
+					map[string]interface{}{"value": "bjensen@example.com", "type": "work"},
+				},
+			}
+		}
+
+		tcc = []struct {
+			name    string
+			payload string
+			check   func(*require.Assertions, map[string]interface{})
+		}{
+			{
+				name:    "replace simple attribute",
+				payload: `{"op":"Replace","path":"active","value":false}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Equal(false, res["active"])
+				},
+			},
+			{
+				name:    "replace sub-attribute",
+				payload: `{"op":"replace","path":"name.formatted","value":"Babs Jensen"}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Equal("Babs Jensen", res["name"].(map[string]interface{})["formatted"])
+				},
+			},
+			{
+				name:    "replace without path",
+				payload: `{"op":"replace","value":{"userName":"babs","name.formatted":"Babs"}}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Equal("babs", res["userName"])
+					req.Equal("Babs", res["name"].(map[string]interface{})["formatted"])
+				},
+			},
+			{
+				name:    "replace filtered value",
+				payload: `{"op":"replace","path":"emails[type eq \"work\"].value","value":"babs@example.com"}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Len(res["emails"], 1)
+					req.Equal("babs@example.com", res["emails"].([]interface{})[0].(map[string]interface{})["value"])
+				},
+			},
+			{
+				name:    "add to multi-valued attribute",
+				payload: `{"op":"add","path":"emails","value":[{"value":"babs@jensen.org","type":"home"}]}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Len(res["emails"], 2)
+				},
+			},
+			{
+				name:    "add extension attribute",
+				payload: `{"op":"add","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department","value":"Sales"}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Equal("Sales", res[urnEnterpriseUser].(map[string]interface{})["department"])
+				},
+			},
+			{
+				name:    "remove attribute",
+				payload: `{"op":"remove","path":"name"}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.NotContains(res, "name")
+				},
+			},
+			{
+				name:    "remove filtered value",
+				payload: `{"op":"remove","path":"emails[type eq \"work\"]"}`,
+				check: func(req *require.Assertions, res map[string]interface{}) {
+					req.Empty(res["emails"])
+				},
+			},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				req     = require.New(t)
+				payload operationsRequest
+				doc     = res()
+			)
+
+			req.NoError(payload.decodeJSON(strings.NewReader(`{"Operations":[` + tc.payload + `]}`)))
+			req.NoError(payload.applyTo(doc))
+			tc.check(req, doc)
+		})
+	}
+}
+
+func TestOperationsRequestApplyToErrors(t *testing.T) {
+	for _, payload := range []string{
+		`{"op":"move","path":"active","value":false}`,
+		`{"op":"remove"}`,
+		`{"op":"replace","value":"foo"}`,
+		`{"op":"replace","path":"emails[type eq","value":"foo"}`,
+	} {
+		t.Run(payload, func(t *testing.T) {
+			var (
+				req = require.New(t)
+				op  operationsRequest
+			)
+
+			req.NoError(op.decodeJSON(strings.NewReader(`{"Operations":[` + payload + `]}`)))
+			req.Error(op.applyTo(map[string]interface{}{}))
+		})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
 )
 
 const (
-	urnPatchOp    = "urn:ietf:params:scim:schemas:core:2.0:PatchOp"
-	patchOpAdd    = "add"
-	patchOpRemove = "remove"
+	urnPatchOp     = "urn:ietf:params:scim:schemas:core:2.0:PatchOp"
+	patchOpAdd     = "add"
+	patchOpRemove  = "remove"
+	patchOpReplace = "replace"
 )
 
 type (
@@ -24,6 +26,9 @@ type (
 		Operation string `json:"op"`
 		Path      string `json:"path"`
 		Value     map[string]string
+
+		// RawValue holds value of any type (string, bool, list, object...)
+		RawValue json.RawMessage `json:"-"`
 	}
 )
 
@@ -48,3 +53,263 @@ func (req *operationsRequest) decodeJSON(r io.Reader) error {
 
 	return nil
 }
+
+// UnmarshalJSON decodes the operation
+//
+// Operation names are case-insensitive (Azure AD sends "Replace").
+// Values that are objects with string values only are
+// also decoded into Value.
+func (op *operationRequest) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		Operation string          `json:"op"`
+		Path      string          `json:"path"`
+		Value     json.RawMessage `json:"value"`
+	}{}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	op.Operation = strings.ToLower(aux.Operation)
+	op.Path = aux.Path
+
+	if len(aux.Value) > 0 && string(aux.Value) != "null" {
+		op.RawValue = aux.Value
+
+		var m map[string]string
+		if json.Unmarshal(aux.Value, &m) == nil {
+			op.Value = m
+		}
+	}
+
+	return nil
+}
+
+// value returns decoded raw value
+func (op operationRequest) value() (v interface{}, err error) {
+	if len(op.RawValue) == 0 {
+		return
+	}
+
+	if err = json.Unmarshal(op.RawValue, &v); err != nil {
+		return nil, fmt.Errorf("could not decode operation value: %w", err)
+	}
+
+	return
+}
+
+// applyTo applies all operations to the resource
+func (req *operationsRequest) applyTo(res map[string]interface{}) error {
+	for _, op := range req.Operations {
+		if err := op.applyTo(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyTo applies the operation to the resource
+// as described in RFC 7644, section 3.5.2
+func (op operationRequest) applyTo(res map[string]interface{}) error {
+	switch op.Operation {
+	case patchOpAdd, patchOpRemove, patchOpReplace:
+		// ok
+	default:
+		return newScimErrorf(errTypeInvalidSyntax, "unsupported operation: %q", op.Operation)
+	}
+
+	value, err := op.value()
+	if err != nil {
+		return newScimErrorf(errTypeInvalidValue, "%v", err)
+	}
+
+	if op.Path != "" {
+		ap, err := parseAttrPath(op.Path)
+		if err != nil {
+			return newScimErrorf(errTypeInvalidPath, "%v", err)
+		}
+
+		return patchAttr(res, op.Operation, ap, value)
+	}
+
+	if op.Operation == patchOpRemove {
+		return newScimErrorf(errTypeNoTarget, "path is required for remove operation")
+	}
+
+	// without path, value holds attributes (or paths) with values
+	vm, ok := value.(map[string]interface{})
+	if !ok {
+		return newScimErrorf(errTypeInvalidValue, "value must be an object when path is not set")
+	}
+
+	for k, v := range vm {
+		ap, err := parseAttrPath(k)
+		if err != nil {
+			return newScimErrorf(errTypeInvalidPath, "%v", err)
+		}
+
+		if err = patchAttr(res, op.Operation, ap, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func patchAttr(res map[string]interface{}, op string, ap attrPath, value interface{}) error {
+	container := res
+
+	if ap.uri != "" {
+		key := canonicalKey(res, ap.uri)
+		ext, ok := res[key].(map[string]interface{})
+		if !ok {
+			if op == patchOpRemove {
+				return nil
+			}
+
+			ext = make(map[string]interface{})
+			res[key] = ext
+		}
+
+		if ap.name == "" {
+			// operation on the whole extension
+			if op == patchOpRemove {
+				delete(res, key)
+				return nil
+			}
+
+			vm, ok := value.(map[string]interface{})
+			if !ok {
+				return newScimErrorf(errTypeInvalidValue, "value of %s must be an object", ap.uri)
+			}
+
+			for k, v := range vm {
+				if err := patchAttr(ext, op, attrPath{name: k}, v); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		container = ext
+	}
+
+	key := canonicalKey(container, ap.name)
+
+	if ap.filter != nil {
+		return patchFiltered(container, key, op, ap, value)
+	}
+
+	if ap.sub != "" {
+		cur, ok := container[key].(map[string]interface{})
+		if !ok {
+			if op == patchOpRemove {
+				return nil
+			}
+
+			cur = make(map[string]interface{})
+			container[key] = cur
+		}
+
+		if op == patchOpRemove {
+			delete(cur, canonicalKey(cur, ap.sub))
+		} else {
+			cur[canonicalKey(cur, ap.sub)] = value
+		}
+
+		return nil
+	}
+
+	if op == patchOpRemove {
+		delete(container, key)
+		return nil
+	}
+
+	switch cur := container[key].(type) {
+	case []interface{}:
+		if op == patchOpAdd {
+			// values are added to the multi-valued attributes
+			container[key] = append(cur, asList(value)...)
+			return nil
+		}
+
+	case map[string]interface{}:
+		if vm, ok := value.(map[string]interface{}); ok {
+			// sub-attributes of complex attributes are merged
+			for k, v := range vm {
+				cur[canonicalKey(cur, k)] = v
+			}
+
+			return nil
+		}
+	}
+
+	container[key] = value
+	return nil
+}
+
+// patchFiltered applies the operation to the values of the
+// multi-valued attribute that match the filter
+//
+// When nothing matches a simple equality filter (emails[type eq "work"].value),
+// add and replace operations add a new value.
+func patchFiltered(container map[string]interface{}, key, op string, ap attrPath, value interface{}) error {
+	var (
+		list, _ = container[key].([]interface{})
+		out     = make([]interface{}, 0, len(list))
+		matched bool
+	)
+
+	for _, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok || !ap.filter.match(m) {
+			out = append(out, v)
+			continue
+		}
+
+		matched = true
+		switch {
+		case op == patchOpRemove && ap.sub == "":
+			// value is removed
+			continue
+		case op == patchOpRemove:
+			delete(m, canonicalKey(m, ap.sub))
+		case ap.sub != "":
+			m[canonicalKey(m, ap.sub)] = value
+		default:
+			vm, ok := value.(map[string]interface{})
+			if !ok {
+				return newScimErrorf(errTypeInvalidValue, "value of %s must be an object", ap.name)
+			}
+
+			for k, v := range vm {
+				m[canonicalKey(m, k)] = v
+			}
+		}
+
+		out = append(out, m)
+	}
+
+	if !matched && op != patchOpRemove {
+		ae, ok := ap.filter.(attrExpr)
+		if !ok || ae.op != filterOpEq || ae.path.sub != "" {
+			return newScimErrorf(errTypeNoTarget, "no values of %s match the filter", ap.name)
+		}
+
+		m := map[string]interface{}{ae.path.name: ae.value}
+		if ap.sub != "" {
+			m[ap.sub] = value
+		} else if vm, ok := value.(map[string]interface{}); ok {
+			for k, v := range vm {
+				m[k] = v
+			}
+		}
+
+		out = append(out, m)
+	}
+
+	container[key] = out
+	return nil
+}
@@ -41,6 +41,17 @@ func Guard(opt options.SCIMOpt) func(next http.Handler) http.Handler {
 }
 
 func Routes(r chi.Router, cfg Config) {
+	r.Get("/ServiceProviderConfig", getServiceProviderConfig)
+	r.Get("/ResourceTypes", listResourceTypes)
+	r.Get("/ResourceTypes/{id}", getResourceType)
+	r.Get("/Schemas", listSchemas)
+	r.Get("/Schemas/{id}", getSchema)
+
+	r.Post("/Bulk", bulkHandler{
+		externalIdAsPrimary: cfg.ExternalIdAsPrimary,
+		router:              r,
+	}.serve)
+
 	r.Route("/Users", func(r chi.Router) {
 		uh := &usersHandler{
 			externalIdAsPrimary: cfg.ExternalIdAsPrimary,
@@ -51,9 +62,12 @@ func Routes(r chi.Router, cfg Config) {
 			sec:     getSecurityContext,
 		}
 
+		r.Get("/", uh.list)
+		r.Post("/.search", uh.list)
 		r.Get("/{id}", uh.get)
 		r.Post("/", uh.create)
 		r.Put("/{id}", uh.replace)
+		r.Patch("/{id}", uh.patch)
 		r.Delete("/{id}", uh.delete)
 	})
 
@@ -67,6 +81,8 @@ func Routes(r chi.Router, cfg Config) {
 			sec:     getSecurityContext,
 		}
 
+		r.Get("/", gh.list)
+		r.Post("/.search", gh.list)
 		r.Get("/{id}", gh.get)
 		r.Post("/", gh.create)
 		r.Put("/{id}", gh.replace)
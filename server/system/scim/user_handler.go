@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
 	"github.com/cortezaproject/corteza/server/system/service"
 	"github.com/cortezaproject/corteza/server/system/types"
 	"github.com/go-chi/chi/v5"
@@ -40,6 +41,71 @@ func (h usersHandler) get(w http.ResponseWriter, r *http.Request) {
 	send(w, http.StatusOK, newUserResourceResponse(res))
 }
 
+// lists users
+//
+// Handles GET /Users and POST /Users/.search requests
+//
+// Equality constraint on externalId is passed to the store;
+// when filter has no other constraints and no sorting is requested,
+// store also takes care of paging
+//
+// userName and emails are case-insensitive (RFC 7644) and are always
+// matched by the filter; store compares them case-sensitively
+func (h usersHandler) list(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx = h.sec(r)
+		f   = types.UserFilter{Suspended: filter.StateInclusive}
+	)
+
+	lr, err := decodeSearchRequest(r)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	if extID, ok := eqConstraint(lr.filter, "externalId"); ok {
+		// narrow down the search
+		f.Labels = map[string]string{userLabel_SCIM_externalId: extID}
+	}
+
+	paged := lr.sortBy == nil && onlyEqConstraints(lr.filter, "externalId")
+	if paged {
+		f.IncTotal = true
+		if f.Limit = lr.limit(); f.Limit == 0 {
+			// only total is requested (count=0)
+			f.Limit = 1
+		}
+	}
+
+	uu, f, err := h.svc.Find(ctx, f)
+	if err != nil {
+		sendError(w, newErrorResponse(http.StatusInternalServerError, err))
+		return
+	}
+
+	if paged && uint(len(uu)) > lr.limit() {
+		uu = uu[:lr.limit()]
+	}
+
+	rr := make([]interface{}, len(uu))
+	for i, u := range uu {
+		rr[i] = newUserResourceResponse(u)
+	}
+
+	if paged {
+		send(w, http.StatusOK, lr.page(rr, f.Total))
+		return
+	}
+
+	rsp, err := lr.apply(rr)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	send(w, http.StatusOK, rsp)
+}
+
 func (h usersHandler) create(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
@@ -116,14 +182,66 @@ func (h usersHandler) replace(w http.ResponseWriter, r *http.Request) {
 	send(w, status, newUserResourceResponse(res))
 }
 
+// patches user
+//
+// Operations are applied to the user resource and
+// modified resource is then stored as on replace
+func (h usersHandler) patch(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var (
+		ctx      = h.sec(r)
+		existing = h.lookup(ctx, chi.URLParam(r, "id"), w)
+		payload  = &operationsRequest{}
+	)
+
+	if existing == nil {
+		return
+	}
+
+	if err := payload.decodeJSON(r.Body); err != nil {
+		sendError(w, newErrorResponse(http.StatusBadRequest, err))
+		return
+	}
+
+	before, err := toResourceMap(newUserResourceResponse(existing))
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	after, _ := toResourceMap(newUserResourceResponse(existing))
+	if err = payload.applyTo(after); err != nil {
+		sendError(w, err)
+		return
+	}
+
+	req, err := userPatchRequest(before, after)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	res, err := h.save(ctx, req, existing)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	send(w, http.StatusOK, newUserResourceResponse(res))
+}
+
 func (h usersHandler) save(ctx context.Context, req *userResourceRequest, existing *types.User) (res *types.User, err error) {
 	var (
 		svc = h.svc
 	)
 
-	if existing == nil || !existing.Valid() {
+	if existing == nil || existing.ID == 0 || existing.DeletedAt != nil {
 		// in case when we did not find a valid user,
 		// start from blank
+		//
+		// suspended users are still updated since
+		// they are deactivated through SCIM
 		existing = &types.User{}
 	}
 
@@ -140,6 +258,22 @@ func (h usersHandler) save(ctx context.Context, req *userResourceRequest, existi
 		return nil, err
 	}
 
+	if req.Active != nil && bool(*req.Active) == (res.SuspendedAt != nil) {
+		if *req.Active {
+			err = svc.Unsuspend(ctx, res.ID)
+		} else {
+			err = svc.Suspend(ctx, res.ID)
+		}
+
+		if err != nil {
+			return
+		}
+
+		if res, err = svc.FindByID(ctx, res.ID); err != nil {
+			return
+		}
+	}
+
 	if req.Password != nil && *req.Password != "" {
 		err = h.passSvc.SetPassword(ctx, res.ID, *req.Password)
 		if err != nil {
@@ -214,7 +348,12 @@ func lookupUserByExternalId(ctx context.Context, svc service.UserService, v *reg
 		return nil, newErrorfResponse(http.StatusBadRequest, "invalid external ID")
 	}
 
-	rr, _, err := svc.Find(ctx, types.UserFilter{Labels: map[string]string{userLabel_SCIM_externalId: id}})
+	rr, _, err := svc.Find(ctx, types.UserFilter{
+		Labels: map[string]string{userLabel_SCIM_externalId: id},
+
+		// deactivated users are suspended
+		Suspended: filter.StateInclusive,
+	})
 	if err != nil {
 		return nil, newErrorResponse(http.StatusInternalServerError, err)
 	}
@@ -6,50 +6,85 @@ import (
 	"github.com/cortezaproject/corteza/server/pkg/handle"
 	"github.com/cortezaproject/corteza/server/system/types"
 	"io"
+	"reflect"
 	"strconv"
+	"strings"
 )
 
 const (
 	urnUser                   = "urn:ietf:params:scim:schemas:core:2.0:User"
+	urnEnterpriseUser         = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
 	userLabel_SCIM_externalId = "SCIM_externalId"
+
+	// enterprise user extension attributes are stored
+	// as user labels with this prefix
+	userLabelPrefix_SCIM_enterprise = "SCIM_enterprise_"
 )
 
 type (
 	emailResponse struct {
 		Value   string `json:"value"`
+		Type    string `json:"type,omitempty"`
 		Primary bool   `json:"primary,omitempty"`
 	}
 
 	emailsResponse []*emailResponse
 
 	userNameResponse struct {
-		Formatted string `json:"formatted"`
+		Formatted  string `json:"formatted"`
+		GivenName  string `json:"givenName,omitempty"`
+		FamilyName string `json:"familyName,omitempty"`
+	}
+
+	// enterpriseUser holds attributes of the enterprise user extension
+	enterpriseUser struct {
+		EmployeeNumber string             `json:"employeeNumber,omitempty"`
+		CostCenter     string             `json:"costCenter,omitempty"`
+		Organization   string             `json:"organization,omitempty"`
+		Division       string             `json:"division,omitempty"`
+		Department     string             `json:"department,omitempty"`
+		Manager        *enterpriseManager `json:"manager,omitempty"`
 	}
 
+	enterpriseManager struct {
+		Value string `json:"value"`
+	}
+
+	// boolValue decodes booleans that are sent as strings ("False")
+	boolValue bool
+
 	userGroupMembershipRequest struct {
 		Value string `json:"value"`
 	}
 
 	userResourceResponse struct {
-		Schemas    []string          `json:"schemas"`
-		Meta       *metaResponse     `json:"meta,omitempty"`
-		ID         string            `json:"id,omitempty"`
-		ExternalId string            `json:"externalId,omitempty"`
-		UserName   string            `json:"userName,omitempty"`
-		NickName   string            `json:"nickName,omitempty"`
-		Name       *userNameResponse `json:"displayName"`
-		Emails     emailsResponse    `json:"emails,omitempty"`
+		Schemas     []string          `json:"schemas"`
+		Meta        *metaResponse     `json:"meta,omitempty"`
+		ID          string            `json:"id,omitempty"`
+		ExternalId  string            `json:"externalId,omitempty"`
+		UserName    string            `json:"userName,omitempty"`
+		NickName    string            `json:"nickName,omitempty"`
+		Name        *userNameResponse `json:"name,omitempty"`
+		DisplayName string            `json:"displayName,omitempty"`
+		Emails      emailsResponse    `json:"emails,omitempty"`
+		Active      bool              `json:"active"`
+
+		Enterprise *enterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
 	}
 
 	userResourceRequest struct {
-		Schemas    []string          `json:"schemas"`
-		Meta       *metaResponse     `json:"meta,omitempty"`
-		ExternalId *string           `json:"externalId,omitempty"`
-		UserName   *string           `json:"userName,omitempty"`
-		NickName   *string           `json:"nickName,omitempty"`
-		Password   *string           `json:"password,omitempty"`
-		Name       *userNameResponse `json:"name"`
-		Emails     emailsResponse    `json:"emails,omitempty"`
+		Schemas     []string          `json:"schemas"`
+		Meta        *metaResponse     `json:"meta,omitempty"`
+		ExternalId  *string           `json:"externalId,omitempty"`
+		UserName    *string           `json:"userName,omitempty"`
+		NickName    *string           `json:"nickName,omitempty"`
+		Password    *string           `json:"password,omitempty"`
+		Name        *userNameResponse `json:"name"`
+		DisplayName *string           `json:"displayName,omitempty"`
+		Emails      emailsResponse    `json:"emails,omitempty"`
+		Active      *boolValue        `json:"active,omitempty"`
+
+		Enterprise *enterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
 
 		Groups []*userGroupMembershipRequest `json:"groups,omitempty"`
 	}
@@ -63,16 +98,123 @@ func newUserResourceResponse(u *types.User) *userResourceResponse {
 		ExternalId: u.Labels[userLabel_SCIM_externalId],
 		UserName:   u.Username,
 		NickName:   u.Handle,
-		Emails:     emailsResponse{{u.Email, true}},
+		Emails:     emailsResponse{{Value: u.Email, Type: "work", Primary: true}},
+		Active:     u.SuspendedAt == nil,
+		Enterprise: newEnterpriseUser(u),
 	}
 
 	if u.Name != "" {
 		rsp.Name = &userNameResponse{Formatted: u.Name}
+		rsp.DisplayName = u.Name
+	}
+
+	if rsp.Enterprise != nil {
+		rsp.Schemas = append(rsp.Schemas, urnEnterpriseUser)
 	}
 
 	return rsp
 }
 
+// newEnterpriseUser loads enterprise extension attributes from user labels
+func newEnterpriseUser(u *types.User) *enterpriseUser {
+	var (
+		e   = &enterpriseUser{}
+		set bool
+	)
+
+	for name, v := range e.attributes() {
+		if *v = u.Labels[userLabelPrefix_SCIM_enterprise+name]; *v != "" {
+			set = true
+		}
+	}
+
+	if m := u.Labels[userLabelPrefix_SCIM_enterprise+"manager"]; m != "" {
+		e.Manager = &enterpriseManager{Value: m}
+		set = true
+	}
+
+	if !set {
+		return nil
+	}
+
+	return e
+}
+
+// attributes returns pointers to all simple attributes of the extension
+func (e *enterpriseUser) attributes() map[string]*string {
+	return map[string]*string{
+		"employeeNumber": &e.EmployeeNumber,
+		"costCenter":     &e.CostCenter,
+		"organization":   &e.Organization,
+		"division":       &e.Division,
+		"department":     &e.Department,
+	}
+}
+
+// applyTo replaces user's extension attributes
+//
+// Attributes without value are removed.
+func (e *enterpriseUser) applyTo(u *types.User) {
+	set := func(name, value string) {
+		if value != "" {
+			u.SetLabel(userLabelPrefix_SCIM_enterprise+name, value)
+		} else if u.Labels != nil {
+			delete(u.Labels, userLabelPrefix_SCIM_enterprise+name)
+		}
+	}
+
+	for name, v := range e.attributes() {
+		set(name, *v)
+	}
+
+	if e.Manager != nil {
+		set("manager", e.Manager.Value)
+	} else {
+		set("manager", "")
+	}
+}
+
+// UnmarshalJSON accepts manager ID as a string as well (Azure AD)
+func (m *enterpriseManager) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		m.Value = s
+		return nil
+	}
+
+	aux := struct {
+		Value string `json:"value"`
+	}{}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	m.Value = aux.Value
+	return nil
+}
+
+func (b *boolValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		v, err := strconv.ParseBool(strings.ToLower(s))
+		if err != nil {
+			return fmt.Errorf("invalid boolean value %q", s)
+		}
+
+		*b = boolValue(v)
+		return nil
+	}
+
+	var v bool
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*b = boolValue(v)
+	return nil
+}
+
 // returns first (primary) email
 func (ee emailsResponse) getFirst() string {
 	if len(ee) == 0 {
@@ -103,8 +245,13 @@ func (req *userResourceRequest) applyTo(u *types.User) {
 		u.Email = v
 	}
 
-	if req.Name != nil {
+	switch {
+	case req.Name != nil && req.Name.Formatted != "":
 		u.Name = req.Name.Formatted
+	case req.DisplayName != nil:
+		u.Name = *req.DisplayName
+	case req.Name != nil:
+		u.Name = strings.TrimSpace(req.Name.GivenName + " " + req.Name.FamilyName)
 	}
 
 	if req.UserName != nil {
@@ -118,4 +265,52 @@ func (req *userResourceRequest) applyTo(u *types.User) {
 	if req.ExternalId != nil {
 		u.SetLabel("SCIM_externalId", *req.ExternalId)
 	}
+
+	if req.Enterprise != nil {
+		req.Enterprise.applyTo(u)
+	}
+}
+
+// userPatchRequest prepares request from the patched user resource
+//
+// Name of the user can be patched through the displayName and the name
+// attributes; only the one that was changed is used.
+func userPatchRequest(before, after map[string]interface{}) (req *userResourceRequest, err error) {
+	req = &userResourceRequest{}
+	if err = fromResourceMap(after, req); err != nil {
+		return
+	}
+
+	changed := func(key string) bool {
+		return !reflect.DeepEqual(lookupKey(before, key), lookupKey(after, key))
+	}
+
+	switch {
+	case changed("displayName"):
+		req.Name = nil
+		if req.DisplayName == nil {
+			// removed
+			req.DisplayName = new(string)
+		}
+
+	case changed("name"):
+		req.DisplayName = nil
+		if req.Name == nil {
+			// removed
+			req.Name = &userNameResponse{}
+		} else if bn, ok := lookupKey(before, "name").(map[string]interface{}); ok && req.Name.Formatted == bn["formatted"] {
+			// only name parts were changed
+			req.Name.Formatted = ""
+		}
+
+	default:
+		req.Name, req.DisplayName = nil, nil
+	}
+
+	if req.Enterprise == nil {
+		// all extension attributes were removed
+		req.Enterprise = &enterpriseUser{}
+	}
+
+	return
 }
@@ -17,12 +17,14 @@ import (
 	systemTypes "github.com/cortezaproject/corteza/server/system/types"
 	"github.com/spf13/cast"
 	"strings"
+	"time"
 )
 
 type (
 	rbacService interface {
 		Can(rbac.Session, string, rbac.Resource) bool
 		Trace(rbac.Session, string, rbac.Resource) *rbac.Trace
+		At(context.Context, time.Time) (*rbac.Snapshot, error)
 		Grant(context.Context, ...*rbac.Rule) error
 		FindRulesByRoleID(roleID uint64) (rr rbac.RuleSet)
 	}
@@ -71,7 +73,54 @@ func (svc accessControl) Trace(ctx context.Context, userID uint64, roles []uint6
 		return nil, AccessControlErrNotAllowedToSetPermissions()
 	}
 
+	return svc.trace(ctx, rbac.SimulationSubject{UserID: userID, Roles: roles}, rr...)
+}
+
+// Simulate evaluates all operations on the given resources for the subject
+//
+// # Returns access matrix with the rule that decided each of the entries
+//
+// This function is auto-generated
+func (svc accessControl) Simulate(ctx context.Context, sub rbac.SimulationSubject, rr ...string) (rbac.EvaluationSet, error) {
+	// Reusing the grant permission since this is who the feature is for
+	if !svc.CanGrant(ctx) {
+		return nil, AccessControlErrNotAllowedToSetPermissions()
+	}
+
+	tt, err := svc.trace(ctx, sub, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.Evaluate(tt...), nil
+}
+
+// Diff evaluates all operations on the given resources for both subjects
+// and returns the ones with different access
+//
+// This function is auto-generated
+func (svc accessControl) Diff(ctx context.Context, a, b rbac.SimulationSubject, rr ...string) (rbac.EvaluationDiffSet, error) {
+	ea, err := svc.Simulate(ctx, a, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	eb, err := svc.Simulate(ctx, b, rr...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.Diff(ea, eb), nil
+}
+
+// trace evaluates all operations on the given resources for the subject
+//
+// This function is auto-generated
+func (svc accessControl) trace(ctx context.Context, sub rbac.SimulationSubject, rr ...string) (ee []*rbac.Trace, err error) {
 	var (
+		userID = sub.UserID
+		roles  = sub.Roles
+
 		resource  rbac.Resource
 		resources []rbac.Resource
 		members   systemTypes.RoleMemberSet
@@ -120,11 +169,24 @@ func (svc accessControl) Trace(ctx context.Context, userID uint64, roles []uint6
 		return nil, fmt.Errorf("no roles specified")
 	}
 
+	var (
+		tracer interface {
+			Trace(rbac.Session, string, rbac.Resource) *rbac.Trace
+		} = svc.rbac
+	)
+
+	if !sub.At.IsZero() {
+		// rules as they were at the given time
+		if tracer, err = svc.rbac.At(ctx, sub.At); err != nil {
+			return nil, err
+		}
+	}
+
 	session := rbac.ParamsToSession(ctx, userID, roles...)
 	for _, res := range resources {
 		r := res.RbacResource()
 		for op := range rbacResourceOperations(r) {
-			ee = append(ee, svc.rbac.Trace(session, op, res))
+			ee = append(ee, tracer.Trace(session, op, res))
 		}
 	}
 
@@ -146,6 +208,7 @@ func (svc accessControl) Resources() []rbac.Resource {
 		rbac.NewResource(types.TemplateRbacResource(0)),
 		rbac.NewResource(types.UserRbacResource(0)),
 		rbac.NewResource(types.DalConnectionRbacResource(0)),
+		rbac.NewResource(types.WebhookRbacResource(0)),
 		rbac.NewResource(types.ComponentRbacResource()),
 	}
 }
@@ -365,6 +428,21 @@ func (svc accessControl) List() (out []map[string]string) {
 			"any":  types.DalConnectionRbacResource(0),
 			"op":   "dal-config.manage",
 		},
+		{
+			"type": types.WebhookResourceType,
+			"any":  types.WebhookRbacResource(0),
+			"op":   "read",
+		},
+		{
+			"type": types.WebhookResourceType,
+			"any":  types.WebhookRbacResource(0),
+			"op":   "update",
+		},
+		{
+			"type": types.WebhookResourceType,
+			"any":  types.WebhookRbacResource(0),
+			"op":   "delete",
+		},
 		{
 			"type": types.ComponentResourceType,
 			"any":  types.ComponentRbacResource(),
@@ -515,6 +593,16 @@ func (svc accessControl) List() (out []map[string]string) {
 			"any":  types.ComponentRbacResource(),
 			"op":   "data-privacy-requests.search",
 		},
+		{
+			"type": types.ComponentResourceType,
+			"any":  types.ComponentRbacResource(),
+			"op":   "webhook.create",
+		},
+		{
+			"type": types.ComponentResourceType,
+			"any":  types.ComponentRbacResource(),
+			"op":   "webhooks.search",
+		},
 	}
 
 	func(svc interface{}) {
@@ -901,6 +989,27 @@ func (svc accessControl) CanManageDalConfigOnDalConnection(ctx context.Context,
 	return svc.can(ctx, "dal-config.manage", r)
 }
 
+// CanReadWebhook checks if current user can read webhook and its deliveries
+//
+// This function is auto-generated
+func (svc accessControl) CanReadWebhook(ctx context.Context, r *types.Webhook) bool {
+	return svc.can(ctx, "read", r)
+}
+
+// CanUpdateWebhook checks if current user can update webhook and redeliver events
+//
+// This function is auto-generated
+func (svc accessControl) CanUpdateWebhook(ctx context.Context, r *types.Webhook) bool {
+	return svc.can(ctx, "update", r)
+}
+
+// CanDeleteWebhook checks if current user can delete webhook
+//
+// This function is auto-generated
+func (svc accessControl) CanDeleteWebhook(ctx context.Context, r *types.Webhook) bool {
+	return svc.can(ctx, "delete", r)
+}
+
 // CanGrant checks if current user can manage system permissions
 //
 // This function is auto-generated
@@ -1141,6 +1250,22 @@ func (svc accessControl) CanSearchDataPrivacyRequests(ctx context.Context) bool
 	return svc.can(ctx, "data-privacy-requests.search", r)
 }
 
+// CanCreateWebhook checks if current user can create webhooks
+//
+// This function is auto-generated
+func (svc accessControl) CanCreateWebhook(ctx context.Context) bool {
+	r := &types.Component{}
+	return svc.can(ctx, "webhook.create", r)
+}
+
+// CanSearchWebhooks checks if current user can list, search or filter webhooks
+//
+// This function is auto-generated
+func (svc accessControl) CanSearchWebhooks(ctx context.Context) bool {
+	r := &types.Component{}
+	return svc.can(ctx, "webhooks.search", r)
+}
+
 // rbacResourceValidator validates known component's resource by routing it to the appropriate validator
 //
 // This function is auto-generated
@@ -1166,6 +1291,8 @@ func rbacResourceValidator(r string, oo ...string) error {
 		return rbacUserResourceValidator(r, oo...)
 	case types.DalConnectionResourceType:
 		return rbacDalConnectionResourceValidator(r, oo...)
+	case types.WebhookResourceType:
+		return rbacWebhookResourceValidator(r, oo...)
 	case types.ComponentResourceType:
 		return rbacComponentResourceValidator(r, oo...)
 	}
@@ -1253,6 +1380,12 @@ func (svc accessControl) resourceLoader(ctx context.Context, resource string) (r
 		}
 
 		return loadDalConnection(ctx, svc.store, ids[0])
+	case types.WebhookResourceType:
+		if hasWildcard {
+			return rbac.NewResource(types.WebhookRbacResource(ids[0])), nil
+		}
+
+		return loadWebhook(ctx, svc.store, ids[0])
 	case types.ComponentResourceType:
 		return &types.Component{}, nil
 	}
@@ -1338,6 +1471,12 @@ func rbacResourceOperations(r string) map[string]bool {
 			"delete":            true,
 			"dal-config.manage": true,
 		}
+	case types.WebhookResourceType:
+		return map[string]bool{
+			"read":   true,
+			"update": true,
+			"delete": true,
+		}
 	case types.ComponentResourceType:
 		return map[string]bool{
 			"grant":                         true,
@@ -1370,6 +1509,8 @@ func rbacResourceOperations(r string) map[string]bool {
 			"dal-schema-alterations.manage": true,
 			"data-privacy-request.create":   true,
 			"data-privacy-requests.search":  true,
+			"webhook.create":                true,
+			"webhooks.search":               true,
 		}
 	}
 
@@ -1826,6 +1967,51 @@ func rbacDalConnectionResourceValidator(r string, oo ...string) error {
 	return nil
 }
 
+// rbacWebhookResourceValidator checks validity of RBAC resource and operations
+//
+// # Notes
+// Can be called without operations to check for validity of resource string only
+//
+// This function is auto-generated
+func rbacWebhookResourceValidator(r string, oo ...string) error {
+	if !strings.HasPrefix(r, types.WebhookResourceType) {
+		// expecting resource to always include path
+		return fmt.Errorf("invalid resource type")
+	}
+
+	defOps := rbacResourceOperations(r)
+	for _, o := range oo {
+		if !defOps[o] {
+			return fmt.Errorf("invalid operation '%s' for webhook resource", o)
+		}
+	}
+
+	const sep = "/"
+	var (
+		pp  = strings.Split(strings.Trim(r[len(types.WebhookResourceType):], sep), sep)
+		prc = []string{
+			"ID",
+		}
+	)
+
+	if len(pp) != len(prc) {
+		return fmt.Errorf("invalid resource path structure")
+	}
+
+	for i := 0; i < len(pp); i++ {
+		if pp[i] != "*" {
+			if i > 0 && pp[i-1] == "*" {
+				return fmt.Errorf("invalid path wildcard level (%d) for webhook resource", i)
+			}
+
+			if _, err := cast.ToUint64E(pp[i]); err != nil {
+				return fmt.Errorf("invalid reference for %s: '%s'", prc[i], pp[i])
+			}
+		}
+	}
+	return nil
+}
+
 // rbacComponentResourceValidator checks validity of RBAC resource and operations
 //
 // # Notes
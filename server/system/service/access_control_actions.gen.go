@@ -174,6 +174,46 @@ func AccessControlActionGrant(props ...*accessControlActionProps) *accessControl
 	return a
 }
 
+// AccessControlActionExpire returns "system:access_control.expire" action
+//
+// This function is auto-generated.
+//
+func AccessControlActionExpire(props ...*accessControlActionProps) *accessControlAction {
+	a := &accessControlAction{
+		timestamp: time.Now(),
+		resource:  "system:access_control",
+		action:    "expire",
+		log:       "expired {{rule}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// AccessControlActionRollback returns "system:access_control.rollback" action
+//
+// This function is auto-generated.
+//
+func AccessControlActionRollback(props ...*accessControlActionProps) *accessControlAction {
+	a := &accessControlAction{
+		timestamp: time.Now(),
+		resource:  "system:access_control",
+		action:    "rollback",
+		log:       "rolled back {{rule}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+)
+
+// LogExpired records rules removed after their validity window ended
+//
+// Registered with RBAC service (see rbac.OnExpire)
+func (svc accessControl) LogExpired(ctx context.Context, rr rbac.RuleSet) {
+	if svc.actionlog == nil {
+		return
+	}
+
+	for _, r := range rr {
+		a := AccessControlActionExpire(&accessControlActionProps{r})
+		a.resource = r.Resource
+
+		svc.actionlog.Record(ctx, a.ToAction())
+	}
+}
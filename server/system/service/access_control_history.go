@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+)
+
+type (
+	rbacRuleHistory interface {
+		RuleChanges(context.Context, rbac.RuleChangeFilter) (rbac.RuleChangeSet, rbac.RuleChangeFilter, error)
+		Rollback(ctx context.Context, changeSetID, roleID uint64, resource string) (rbac.RuleSet, error)
+	}
+)
+
+// RuleChanges returns recorded changes of the RBAC rules
+func (svc accessControl) RuleChanges(ctx context.Context, f rbac.RuleChangeFilter) (rbac.RuleChangeSet, rbac.RuleChangeFilter, error) {
+	if !svc.CanGrant(ctx) {
+		return nil, f, AccessControlErrNotAllowedToSetPermissions()
+	}
+
+	h, ok := svc.rbac.(rbacRuleHistory)
+	if !ok {
+		return nil, f, AccessControlErrGeneric()
+	}
+
+	return h.RuleChanges(ctx, f)
+}
+
+// RuleChangesDiff returns changes made after the from change set up to
+// (and including) the to change set, combined into one change per rule
+//
+// When to is 0, all changes made after from are combined.
+func (svc accessControl) RuleChangesDiff(ctx context.Context, from, to, roleID uint64) (rbac.RuleChangeSet, error) {
+	f := rbac.RuleChangeFilter{AfterChangeSetID: from}
+	if roleID > 0 {
+		f.RoleID = []uint64{roleID}
+	}
+
+	cc, _, err := svc.RuleChanges(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return cc.Squash(from, to), nil
+}
+
+// Rollback restores rules to the state right after the given change set
+//
+// Rollback can be limited to rules of one role and/or resources matching the given resource.
+func (svc accessControl) Rollback(ctx context.Context, changeSetID, roleID uint64, resource string) (rbac.RuleSet, error) {
+	if !svc.CanGrant(ctx) {
+		return nil, AccessControlErrNotAllowedToSetPermissions()
+	}
+
+	h, ok := svc.rbac.(rbacRuleHistory)
+	if !ok {
+		return nil, AccessControlErrGeneric()
+	}
+
+	rr, err := h.Rollback(ctx, changeSetID, roleID, resource)
+	if err != nil {
+		return nil, AccessControlErrGeneric().Wrap(err)
+	}
+
+	if svc.actionlog != nil {
+		for _, r := range rr {
+			a := AccessControlActionRollback(&accessControlActionProps{r})
+			a.resource = r.Resource
+
+			svc.actionlog.Record(ctx, a.ToAction())
+		}
+	}
+
+	return rr, nil
+}
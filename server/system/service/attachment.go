@@ -7,6 +7,7 @@ import (
 	"github.com/cortezaproject/corteza/server/assets"
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
 	intAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
 	files "github.com/cortezaproject/corteza/server/pkg/objstore"
 	"github.com/cortezaproject/corteza/server/pkg/options"
 	"github.com/cortezaproject/corteza/server/store"
@@ -57,6 +58,7 @@ type (
 		CreateAvatarInitialsAttachment(ctx context.Context, initials string, bgColor string, textColor string) (att *types.Attachment, err error)
 		OpenOriginal(att *types.Attachment) (io.ReadSeekCloser, error)
 		OpenPreview(att *types.Attachment) (io.ReadSeekCloser, error)
+		PresignedURL(att *types.Attachment, preview, download bool) (string, error)
 		DeleteByID(ctx context.Context, ID uint64) error
 	}
 )
@@ -146,6 +148,33 @@ func (svc attachment) OpenPreview(att *types.Attachment) (io.ReadSeekCloser, err
 	return svc.files.Open(att.PreviewUrl)
 }
 
+// PresignedURL returns time-limited URL for downloading the attachment directly from the object store
+//
+// Empty string is returned when the object store does not support presigned URLs
+// (or is not configured for them) and attachment needs to be served by us.
+func (svc attachment) PresignedURL(att *types.Attachment, preview, download bool) (string, error) {
+	ps, ok := svc.files.(files.Presigner)
+	if !ok {
+		return "", nil
+	}
+
+	name := att.Url
+	if preview {
+		name = att.PreviewUrl
+	}
+
+	if len(name) == 0 {
+		return "", nil
+	}
+
+	url, err := ps.Presign(name, files.PresignOptions{Name: att.Name, Download: download})
+	if errors.Is(err, files.ErrPresignNotSupported) {
+		return "", nil
+	}
+
+	return url, err
+}
+
 func (svc attachment) CreateSettingsAttachment(ctx context.Context, name string, size int64, fh io.ReadSeeker, labels map[string]string) (att *types.Attachment, err error) {
 	var (
 		aaProps       = &attachmentActionProps{}
@@ -324,7 +353,7 @@ func (svc attachment) CreateAvatarInitialsAttachment(ctx context.Context, initia
 			return err
 		}
 
-		if err = svc.files.Save(att.Url, buf); err != nil {
+		if err = svc.files.SaveStream(att.Url, buf, files.Meta{Size: int64(buf.Len()), ContentType: "image/png"}); err != nil {
 			return AttachmentErrFailedToStoreFile(aaProps).Wrap(err)
 		}
 
@@ -382,7 +411,7 @@ func (svc attachment) create(ctx context.Context, name string, size int64, fh io
 			return
 		}
 
-		if err = svc.files.Save(att.Url, buf); err != nil {
+		if err = svc.files.SaveStream(att.Url, buf, files.Meta{Size: int64(buf.Len()), ContentType: "image/jpeg"}); err != nil {
 			return AttachmentErrFailedToStoreFile(aaProps).Wrap(err)
 		}
 
@@ -393,7 +422,7 @@ func (svc attachment) create(ctx context.Context, name string, size int64, fh io
 		return nil
 	}
 
-	if err = svc.files.Save(att.Url, fh); err != nil {
+	if err = svc.files.SaveStream(att.Url, fh, files.Meta{Size: size, ContentType: att.Meta.Original.Mimetype}); err != nil {
 		return AttachmentErrFailedToStoreFile(aaProps).Wrap(err)
 	}
 
@@ -522,7 +551,7 @@ func (svc attachment) processImage(original io.ReadSeeker, att *types.Attachment
 	// Can and how we make a preview of this attachment?
 	att.PreviewUrl = svc.files.Preview(att.ID, meta.Extension)
 
-	return svc.files.Save(att.PreviewUrl, buf)
+	return svc.files.SaveStream(att.PreviewUrl, buf, files.Meta{Size: meta.Size, ContentType: meta.Mimetype})
 }
 
 // processFontsFile checks if the file exists and has the correct file extension,
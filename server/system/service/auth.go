@@ -40,6 +40,10 @@ type (
 		// reused?
 		// @todo make this configurable someday
 		PasswordReuseTimeWindow time.Duration
+
+		// base URL of the auth server, used as relying
+		// party identity for security keys (WebAuthn)
+		BaseURL string
 	}
 
 	authAccessController interface {
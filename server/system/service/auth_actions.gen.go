@@ -666,6 +666,106 @@ func AuthActionSendInviteEMail(props ...*authActionProps) *authAction {
 	return a
 }
 
+// AuthActionWebauthnConfigure returns "system:auth.webauthnConfigure" action
+//
+// This function is auto-generated.
+//
+func AuthActionWebauthnConfigure(props ...*authActionProps) *authAction {
+	a := &authAction{
+		timestamp: time.Now(),
+		resource:  "system:auth",
+		action:    "webauthnConfigure",
+		log:       "security key {{credentials.label}} for {{user}} registered",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// AuthActionWebauthnRemove returns "system:auth.webauthnRemove" action
+//
+// This function is auto-generated.
+//
+func AuthActionWebauthnRemove(props ...*authActionProps) *authAction {
+	a := &authAction{
+		timestamp: time.Now(),
+		resource:  "system:auth",
+		action:    "webauthnRemove",
+		log:       "security key {{credentials.label}} for {{user}} removed",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// AuthActionWebauthnValidate returns "system:auth.webauthnValidate" action
+//
+// This function is auto-generated.
+//
+func AuthActionWebauthnValidate(props ...*authActionProps) *authAction {
+	a := &authAction{
+		timestamp: time.Now(),
+		resource:  "system:auth",
+		action:    "webauthnValidate",
+		log:       "security key {{credentials.label}} for {{user}} validated",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// AuthActionRecoveryCodesGenerate returns "system:auth.recoveryCodesGenerate" action
+//
+// This function is auto-generated.
+//
+func AuthActionRecoveryCodesGenerate(props ...*authActionProps) *authAction {
+	a := &authAction{
+		timestamp: time.Now(),
+		resource:  "system:auth",
+		action:    "recoveryCodesGenerate",
+		log:       "MFA recovery codes for {{user}} generated",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// AuthActionRecoveryCodeUse returns "system:auth.recoveryCodeUse" action
+//
+// This function is auto-generated.
+//
+func AuthActionRecoveryCodeUse(props ...*authActionProps) *authAction {
+	a := &authAction{
+		timestamp: time.Now(),
+		resource:  "system:auth",
+		action:    "recoveryCodeUse",
+		log:       "MFA recovery code used by {{user}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -1896,6 +1996,244 @@ func AuthErrDisabledSendUserInviteEmail(mm ...*authActionProps) *errors.Error {
 	return e
 }
 
+// AuthErrDisabledMFAWithWebAuthn returns "system:auth.disabledMFAWithWebAuthn" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrDisabledMFAWithWebAuthn(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("multi factor authentication with security keys is disabled", nil),
+
+		errors.Meta("type", "disabledMFAWithWebAuthn"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.disabledMFAWithWebAuthn"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrDisabledPasswordless returns "system:auth.disabledPasswordless" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrDisabledPasswordless(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("passwordless login with security keys is disabled", nil),
+
+		errors.Meta("type", "disabledPasswordless"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.disabledPasswordless"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrUnconfiguredWebAuthn returns "system:auth.unconfiguredWebAuthn" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrUnconfiguredWebAuthn(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("security key not configured", nil),
+
+		errors.Meta("type", "unconfiguredWebAuthn"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.unconfiguredWebAuthn"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrInvalidWebAuthn returns "system:auth.invalidWebAuthn" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrInvalidWebAuthn(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("security key verification failed", nil),
+
+		errors.Meta("type", "invalidWebAuthn"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.invalidWebAuthn"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrNotAllowedToRemoveWebAuthn returns "system:auth.notAllowedToRemoveWebAuthn" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrNotAllowedToRemoveWebAuthn(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to remove security key", nil),
+
+		errors.Meta("type", "notAllowedToRemoveWebAuthn"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.notAllowedToRemoveWebAuthn"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrWebAuthnUnavailable returns "system:auth.webAuthnUnavailable" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrWebAuthnUnavailable(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("security keys are not available, auth base URL is not configured", nil),
+
+		errors.Meta("type", "webAuthnUnavailable"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.webAuthnUnavailable"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// AuthErrInvalidRecoveryCode returns "system:auth.invalidRecoveryCode" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func AuthErrInvalidRecoveryCode(mm ...*authActionProps) *errors.Error {
+	var p = &authActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid recovery code", nil),
+
+		errors.Meta("type", "invalidRecoveryCode"),
+		errors.Meta("resource", "system:auth"),
+
+		errors.Meta(authPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "auth.errors.invalidRecoveryCode"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 
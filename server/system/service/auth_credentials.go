@@ -857,6 +857,11 @@ func (svc *auth) RemoveTOTP(ctx context.Context, userID uint64, code string) (u
 			return err
 		}
 
+		// recovery codes are useless without TOTP
+		if err = svc.revokeAllRecoveryCodes(ctx, s, u.ID); err != nil {
+			return err
+		}
+
 		u.Meta.SecurityPolicy.MFA.EnforcedTOTP = false
 		return store.UpdateUser(ctx, s, u)
 
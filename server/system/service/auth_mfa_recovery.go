@@ -0,0 +1,228 @@
+package service
+
+// part of auth service
+// collection of functions that handle single-use MFA recovery codes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"regexp"
+	"strings"
+
+	internalAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+const (
+	credentialsTypeMFARecoveryCode = "mfa-recovery-code"
+
+	// number of codes issued at once
+	recoveryCodeCount = 10
+
+	// number of random bytes per code (8 chars of base32)
+	recoveryCodeLength = 5
+)
+
+var (
+	// recovery codes are formatted as XXXX-XXXX (base32 alphabet)
+	reRecoveryCode = regexp.MustCompile(`^[A-Z2-7]{4}-[A-Z2-7]{4}$`)
+)
+
+// GenerateMFARecoveryCodes revokes all existing recovery codes of
+// the current user and issues a new set
+//
+// Codes are returned only here, only their hashes are stored
+func (svc *auth) GenerateMFARecoveryCodes(ctx context.Context) (codes []string, err error) {
+	var (
+		u    *types.User
+		kind = credentialsTypeMFARecoveryCode
+		aam  = &authActionProps{credentials: &types.Credential{Kind: kind}}
+		i    = internalAuth.GetIdentityFromContext(ctx)
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		if !svc.settings.Auth.MultiFactor.TOTP.Enabled {
+			return AuthErrDisabledMFAWithTOTP()
+		}
+
+		u, err = store.LookupUserByID(ctx, s, i.Identity())
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		// recovery codes are alternative to TOTP codes
+		if !u.Meta.SecurityPolicy.MFA.EnforcedTOTP {
+			return AuthErrUnconfiguredTOTP()
+		}
+
+		if err = svc.revokeAllRecoveryCodes(ctx, s, u.ID); err != nil {
+			return
+		}
+
+		var (
+			hash []byte
+		)
+
+		codes = make([]string, recoveryCodeCount)
+		for c := range codes {
+			if codes[c], err = makeRecoveryCode(); err != nil {
+				return
+			}
+
+			if hash, err = hashRecoveryCode(codes[c]); err != nil {
+				return
+			}
+
+			err = store.CreateCredential(ctx, s, &types.Credential{
+				ID:          nextID(),
+				CreatedAt:   *now(),
+				OwnerID:     u.ID,
+				Kind:        kind,
+				Credentials: string(hash),
+			})
+
+			if err != nil {
+				return
+			}
+		}
+
+		return
+	})
+
+	if err != nil {
+		codes = nil
+	}
+
+	return codes, svc.recordAction(ctx, aam, AuthActionRecoveryCodesGenerate, err)
+}
+
+// CountMFARecoveryCodes returns number of unused recovery codes of the user
+func (svc *auth) CountMFARecoveryCodes(ctx context.Context, userID uint64) (int, error) {
+	cc, _, err := store.SearchCredentials(ctx, svc.store, types.CredentialFilter{
+		OwnerID: userID,
+		Kind:    credentialsTypeMFARecoveryCode,
+		Deleted: filter.StateExcluded,
+	})
+
+	return len(cc), err
+}
+
+// IsMFARecoveryCode returns true if string is formatted as a recovery code
+func IsMFARecoveryCode(code string) bool {
+	return reRecoveryCode.MatchString(formatRecoveryCode(code))
+}
+
+// ValidateMFARecoveryCode checks given code against current user's recovery codes
+//
+// Valid codes can be used in place of TOTP code; each code can be used only once
+func (svc *auth) ValidateMFARecoveryCode(ctx context.Context, code string) (err error) {
+	var (
+		u    *types.User
+		cc   types.CredentialSet
+		kind = credentialsTypeMFARecoveryCode
+		aam  = &authActionProps{credentials: &types.Credential{Kind: kind}}
+		i    = internalAuth.GetIdentityFromContext(ctx)
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		if !svc.settings.Auth.MultiFactor.TOTP.Enabled {
+			return AuthErrDisabledMFAWithTOTP()
+		}
+
+		u, err = store.LookupUserByID(ctx, s, i.Identity())
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		if !u.Meta.SecurityPolicy.MFA.EnforcedTOTP {
+			return AuthErrUnconfiguredTOTP()
+		}
+
+		if !IsMFARecoveryCode(code) {
+			return AuthErrInvalidRecoveryCode(aam)
+		}
+
+		// codes are hashed with salt and can not be looked up by hash;
+		// there are only a few unused codes per user to compare with
+		cc, _, err = store.SearchCredentials(ctx, s, types.CredentialFilter{
+			OwnerID: u.ID,
+			Kind:    kind,
+			Deleted: filter.StateExcluded,
+		})
+
+		if err != nil {
+			return
+		}
+
+		cc = credentialsFilter(cc, 1, compareHashedCredentials(formatRecoveryCode(code)))
+		if len(cc) != 1 {
+			return AuthErrInvalidRecoveryCode(aam)
+		}
+
+		// recovery codes are single-use
+		cc[0].LastUsedAt = now()
+		cc[0].DeletedAt = now()
+		return store.UpdateCredential(ctx, s, cc[0])
+	})
+
+	return svc.recordAction(ctx, aam, AuthActionRecoveryCodeUse, err)
+}
+
+// Revokes all existing user's recovery codes
+func (auth) revokeAllRecoveryCodes(ctx context.Context, s store.Credentials, userID uint64) error {
+	cc, _, err := store.SearchCredentials(ctx, s, types.CredentialFilter{
+		OwnerID: userID,
+		Kind:    credentialsTypeMFARecoveryCode,
+		Deleted: filter.StateExcluded,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return cc.Walk(func(c *types.Credential) error {
+		c.DeletedAt = now()
+		return store.UpdateCredential(ctx, s, c)
+	})
+}
+
+// generates random recovery code in XXXX-XXXX format
+func makeRecoveryCode() (string, error) {
+	var raw [recoveryCodeLength]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return "", err
+	}
+
+	return formatRecoveryCode(base32.StdEncoding.EncodeToString(raw[:])), nil
+}
+
+// formats code as XXXX-XXXX
+//
+// Separators and whitespace are removed first so that codes
+// can be entered without the dash; codes are case-insensitive
+func formatRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 8 {
+		return code
+	}
+
+	return code[:4] + "-" + code[4:]
+}
+
+// codes are hashed the same way as passwords (bcrypt with salt)
+// so that leaked hashes can not be cheaply brute-forced
+func hashRecoveryCode(code string) ([]byte, error) {
+	return hashPassword(formatRecoveryCode(code))
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	internalAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestIsMFARecoveryCode(t *testing.T) {
+	req := require.New(t)
+
+	req.True(IsMFARecoveryCode("ABCD-EFGH"))
+	req.True(IsMFARecoveryCode(" abcd efgh "))
+	req.True(IsMFARecoveryCode("ABCDEFGH"))
+	req.False(IsMFARecoveryCode("123456"))
+	req.False(IsMFARecoveryCode("123 456"))
+	req.False(IsMFARecoveryCode("ABCD-EFG1"))
+	req.False(IsMFARecoveryCode("token_TOO_LONG"))
+}
+
+func TestAuth_MFARecoveryCodes(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		u = &types.User{Email: "recovery@test.cortezaproject.org", ID: nextID(), CreatedAt: *now(), Meta: &types.UserMeta{}}
+	)
+
+	svc := makeMockAuthService()
+	req.NoError(store.TruncateUsers(ctx, svc.store))
+	req.NoError(store.TruncateCredentials(ctx, svc.store))
+	req.NoError(store.CreateUser(ctx, svc.store, u))
+
+	ctx = internalAuth.SetIdentityToContext(ctx, u)
+
+	_, err := svc.GenerateMFARecoveryCodes(ctx)
+	req.True(AuthErrDisabledMFAWithTOTP().Is(err))
+
+	svc.settings.Auth.MultiFactor.TOTP.Enabled = true
+
+	_, err = svc.GenerateMFARecoveryCodes(ctx)
+	req.True(AuthErrUnconfiguredTOTP().Is(err))
+
+	u.Meta.SecurityPolicy.MFA.EnforcedTOTP = true
+	req.NoError(store.UpdateUser(ctx, svc.store, u))
+
+	codes, err := svc.GenerateMFARecoveryCodes(ctx)
+	req.NoError(err)
+	req.Len(codes, recoveryCodeCount)
+	for _, c := range codes {
+		req.Regexp(reRecoveryCode, c)
+	}
+
+	count, err := svc.CountMFARecoveryCodes(ctx, u.ID)
+	req.NoError(err)
+	req.Equal(recoveryCodeCount, count)
+
+	// codes are stored hashed with salt
+	cc, _, err := store.SearchCredentials(ctx, svc.store, types.CredentialFilter{OwnerID: u.ID, Kind: credentialsTypeMFARecoveryCode})
+	req.NoError(err)
+	for _, c := range cc {
+		req.NotContains(codes, c.Credentials)
+		_, err = bcrypt.Cost([]byte(c.Credentials))
+		req.NoError(err)
+	}
+
+	// same code is hashed differently each time
+	again, err := hashRecoveryCode(codes[0])
+	req.NoError(err)
+	for _, c := range cc {
+		req.NotEqual(string(again), c.Credentials)
+	}
+
+	req.True(AuthErrInvalidRecoveryCode().Is(svc.ValidateMFARecoveryCode(ctx, "AAAA-AAAA")))
+	req.True(AuthErrInvalidRecoveryCode().Is(svc.ValidateMFARecoveryCode(ctx, "123456")))
+
+	// case and separators do not matter
+	req.NoError(svc.ValidateMFARecoveryCode(ctx, " "+strings.ToLower(strings.Replace(codes[0], "-", "", 1))+" "))
+
+	// single use
+	req.True(AuthErrInvalidRecoveryCode().Is(svc.ValidateMFARecoveryCode(ctx, codes[0])))
+
+	count, err = svc.CountMFARecoveryCodes(ctx, u.ID)
+	req.NoError(err)
+	req.Equal(recoveryCodeCount-1, count)
+
+	// regenerating invalidates old codes
+	fresh, err := svc.GenerateMFARecoveryCodes(ctx)
+	req.NoError(err)
+	req.True(AuthErrInvalidRecoveryCode().Is(svc.ValidateMFARecoveryCode(ctx, codes[1])))
+
+	count, err = svc.CountMFARecoveryCodes(ctx, u.ID)
+	req.NoError(err)
+	req.Equal(recoveryCodeCount, count)
+
+	// removing TOTP revokes recovery codes
+	req.NoError(store.CreateCredential(ctx, svc.store, &types.Credential{ID: nextID(), OwnerID: u.ID, Kind: credentialsTypeMfaTotpSecret, Credentials: "secret", CreatedAt: *now()}))
+	svc.ac = &denyAllUserAccess{}
+	_, err = svc.RemoveTOTP(internalAuth.SetIdentityToContext(ctx, &types.User{ID: nextID()}), u.ID, "")
+	req.True(AuthErrNotAllowedToRemoveTOTP().Is(err))
+
+	svc.ac = &allowAllUserAccess{}
+	_, err = svc.RemoveTOTP(internalAuth.SetIdentityToContext(ctx, &types.User{ID: nextID()}), u.ID, "")
+	req.NoError(err)
+
+	count, err = svc.CountMFARecoveryCodes(ctx, u.ID)
+	req.NoError(err)
+	req.Zero(count)
+	req.True(AuthErrUnconfiguredTOTP().Is(svc.ValidateMFARecoveryCode(ctx, fresh[0])))
+}
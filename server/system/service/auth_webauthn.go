@@ -0,0 +1,506 @@
+package service
+
+// part of auth service
+// collection of functions that handle security keys (WebAuthn/FIDO2)
+// for multi-factor and passwordless authentication
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	internalAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+const (
+	credentialsTypeWebAuthn = "webauthn"
+
+	webAuthnLabelMaxLength = 64
+)
+
+// WebAuthnCredentials returns all registered security keys of the user
+func (svc *auth) WebAuthnCredentials(ctx context.Context, userID uint64) (cc types.CredentialSet, err error) {
+	return svc.webAuthnCredentials(ctx, svc.store, userID)
+}
+
+func (auth) webAuthnCredentials(ctx context.Context, s store.Credentials, userID uint64) (cc types.CredentialSet, err error) {
+	cc, _, err = store.SearchCredentials(ctx, s, types.CredentialFilter{
+		OwnerID: userID,
+		Kind:    credentialsTypeWebAuthn,
+		Deleted: filter.StateExcluded,
+	})
+
+	return
+}
+
+// WebAuthnRegistrationOptions prepares options for registering
+// a new security key for the current user
+//
+// Challenge from the returned options needs to be stored (in the session)
+// and passed to ConfigureWebAuthn together with the authenticator's response
+func (svc *auth) WebAuthnRegistrationOptions(ctx context.Context) (opt *webauthn.CreationOptions, err error) {
+	var (
+		u         *types.User
+		cc        types.CredentialSet
+		exclude   []*webauthn.Credential
+		challenge []byte
+		rp        webauthn.RelyingParty
+		i         = internalAuth.GetIdentityFromContext(ctx)
+		aam       = &authActionProps{credentials: &types.Credential{Kind: credentialsTypeWebAuthn}}
+	)
+
+	if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled {
+		return nil, AuthErrDisabledMFAWithWebAuthn()
+	}
+
+	if rp, err = svc.webAuthnRelyingParty(); err != nil {
+		return
+	}
+
+	if u, err = store.LookupUserByID(ctx, svc.store, i.Identity()); errors.IsNotFound(err) {
+		return nil, AuthErrFailedForUnknownUser(aam)
+	} else if err != nil {
+		return
+	}
+
+	if cc, err = svc.WebAuthnCredentials(ctx, u.ID); err != nil {
+		return
+	}
+
+	// prevent registering the same authenticator twice
+	if exclude, err = decodeWebAuthnCredentials(cc); err != nil {
+		return
+	}
+
+	if challenge, err = webauthn.NewChallenge(); err != nil {
+		return
+	}
+
+	return rp.CreationOptions(
+		challenge,
+		webAuthnUser(u),
+		exclude,
+		svc.settings.Auth.MultiFactor.WebAuthn.Passwordless,
+	), nil
+}
+
+// ConfigureWebAuthn verifies authenticator's response and
+// stores new security key in user's credentials
+//
+// It returns the user with security policy changes
+func (svc *auth) ConfigureWebAuthn(ctx context.Context, challenge []byte, label string, rsp *webauthn.RegistrationResponse) (u *types.User, err error) {
+	var (
+		kind = credentialsTypeWebAuthn
+		aam  = &authActionProps{credentials: &types.Credential{Kind: kind}}
+		i    = internalAuth.GetIdentityFromContext(ctx)
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var (
+			rp   webauthn.RelyingParty
+			wc   *webauthn.Credential
+			meta []byte
+		)
+
+		if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled {
+			return AuthErrDisabledMFAWithWebAuthn()
+		}
+
+		if rp, err = svc.webAuthnRelyingParty(); err != nil {
+			return
+		}
+
+		if i == nil || i.Identity() == 0 {
+			return AuthErrFailedForUnknownUser(aam)
+		}
+
+		u, err = store.LookupUserByID(ctx, s, i.Identity())
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		if wc, err = rp.VerifyRegistration(rsp, challenge); err != nil {
+			return AuthErrInvalidWebAuthn(aam).Wrap(err)
+		}
+
+		// credential IDs are globally unique, same
+		// authenticator can not be registered twice
+		if existing, _ := svc.lookupWebAuthnCredential(ctx, s, wc.ID); existing != nil {
+			return AuthErrInvalidWebAuthn(aam)
+		}
+
+		if meta, err = json.Marshal(wc); err != nil {
+			return
+		}
+
+		label = strings.TrimSpace(label)
+		if len(label) > webAuthnLabelMaxLength {
+			label = label[:webAuthnLabelMaxLength]
+		}
+
+		if label == "" {
+			label = "Security key"
+		}
+
+		cred := &types.Credential{
+			ID:          nextID(),
+			CreatedAt:   *now(),
+			OwnerID:     u.ID,
+			Kind:        kind,
+			Label:       label,
+			Credentials: encodeWebAuthnCredentialID(wc.ID),
+			Meta:        meta,
+		}
+
+		aam.setCredentials(cred)
+
+		if err = store.CreateCredential(ctx, s, cred); err != nil {
+			return
+		}
+
+		if u.Meta.SecurityPolicy.MFA.EnforcedWebAuthn {
+			return
+		}
+
+		u.Meta.SecurityPolicy.MFA.EnforcedWebAuthn = true
+		return store.UpdateUser(ctx, s, u)
+	})
+
+	return u, svc.recordAction(ctx, aam, AuthActionWebauthnConfigure, err)
+}
+
+// WebAuthnAssertionOptions prepares options for verifying
+// one of current user's security keys (second factor)
+func (svc *auth) WebAuthnAssertionOptions(ctx context.Context) (opt *webauthn.RequestOptions, err error) {
+	var (
+		cc        types.CredentialSet
+		allow     []*webauthn.Credential
+		challenge []byte
+		rp        webauthn.RelyingParty
+		i         = internalAuth.GetIdentityFromContext(ctx)
+	)
+
+	if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled {
+		return nil, AuthErrDisabledMFAWithWebAuthn()
+	}
+
+	if rp, err = svc.webAuthnRelyingParty(); err != nil {
+		return
+	}
+
+	if cc, err = svc.WebAuthnCredentials(ctx, i.Identity()); err != nil {
+		return
+	}
+
+	if len(cc) == 0 {
+		return nil, AuthErrUnconfiguredWebAuthn()
+	}
+
+	if allow, err = decodeWebAuthnCredentials(cc); err != nil {
+		return
+	}
+
+	if challenge, err = webauthn.NewChallenge(); err != nil {
+		return
+	}
+
+	return rp.RequestOptions(challenge, allow, false), nil
+}
+
+// ValidateWebAuthn verifies assertion, signed with one of current user's security keys
+func (svc *auth) ValidateWebAuthn(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (err error) {
+	var (
+		u    *types.User
+		kind = credentialsTypeWebAuthn
+		aam  = &authActionProps{credentials: &types.Credential{Kind: kind}}
+		i    = internalAuth.GetIdentityFromContext(ctx)
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled {
+			return AuthErrDisabledMFAWithWebAuthn()
+		}
+
+		u, err = store.LookupUserByID(ctx, s, i.Identity())
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		if !u.Meta.SecurityPolicy.MFA.EnforcedWebAuthn {
+			return AuthErrUnconfiguredWebAuthn()
+		}
+
+		_, err = svc.verifyWebAuthnAssertion(ctx, s, aam, u.ID, challenge, rsp, false)
+		return
+	})
+
+	return svc.recordAction(ctx, aam, AuthActionWebauthnValidate, err)
+}
+
+// PasswordlessOptions prepares options for logging-in with a passkey
+//
+// Credentials are not listed; authenticator offers
+// discoverable credentials it holds for this relying party
+func (svc *auth) PasswordlessOptions(_ context.Context) (opt *webauthn.RequestOptions, err error) {
+	var (
+		challenge []byte
+		rp        webauthn.RelyingParty
+	)
+
+	if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled || !svc.settings.Auth.MultiFactor.WebAuthn.Passwordless {
+		return nil, AuthErrDisabledPasswordless()
+	}
+
+	if rp, err = svc.webAuthnRelyingParty(); err != nil {
+		return
+	}
+
+	if challenge, err = webauthn.NewChallenge(); err != nil {
+		return
+	}
+
+	return rp.RequestOptions(challenge, nil, true), nil
+}
+
+// PasswordlessLogin verifies passkey assertion and logs-in the owner of the credential
+//
+// User verification (PIN, biometrics) on the authenticator is required
+// and replaces both, password and second factor
+func (svc *auth) PasswordlessLogin(ctx context.Context, challenge []byte, rsp *webauthn.AssertionResponse) (u *types.User, err error) {
+	var (
+		kind         = credentialsTypeWebAuthn
+		authProvider = &types.AuthProvider{Provider: kind}
+		aam          = &authActionProps{
+			provider:    kind,
+			credentials: &types.Credential{Kind: kind},
+		}
+	)
+
+	err = func() (err error) {
+		var (
+			c *types.Credential
+		)
+
+		if !svc.settings.Auth.MultiFactor.WebAuthn.Enabled || !svc.settings.Auth.MultiFactor.WebAuthn.Passwordless {
+			return AuthErrDisabledPasswordless()
+		}
+
+		if rsp == nil {
+			return AuthErrInvalidWebAuthn(aam)
+		}
+
+		if c, err = svc.lookupWebAuthnCredential(ctx, svc.store, rsp.CredentialID()); err != nil {
+			return AuthErrInvalidWebAuthn(aam).Wrap(err)
+		}
+
+		u, err = store.LookupUserByID(ctx, svc.store, c.OwnerID)
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		// when authenticator returns user handle it must match the owner
+		if len(rsp.UserHandle()) > 0 && !bytes.Equal(rsp.UserHandle(), webAuthnUser(u).ID) {
+			return AuthErrInvalidWebAuthn(aam)
+		}
+
+		if c, err = svc.verifyWebAuthnAssertion(ctx, svc.store, aam, u.ID, challenge, rsp, true); err != nil {
+			return
+		}
+
+		// credentials were already updated
+		// when assertion was verified
+		return svc.procLogin(ctx, svc.store, u, nil, authProvider)
+	}()
+
+	return u, svc.recordAction(ctx, aam, AuthActionAuthenticate, err)
+}
+
+// RemoveWebAuthn removes security key from user's credentials
+//
+// Users can remove their own keys, removing keys of another
+// user requires permission to update that user
+//
+// It returns the user with security policy changes
+func (svc *auth) RemoveWebAuthn(ctx context.Context, userID, credentialID uint64) (u *types.User, err error) {
+	var (
+		kind = credentialsTypeWebAuthn
+		aam  = &authActionProps{credentials: &types.Credential{Kind: kind}}
+		i    = internalAuth.GetIdentityFromContext(ctx)
+		self = i != nil && i.Identity() == userID
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		var (
+			cc types.CredentialSet
+			c  *types.Credential
+		)
+
+		u, err = store.LookupUserByID(ctx, s, userID)
+		if errors.IsNotFound(err) {
+			return AuthErrFailedForUnknownUser(aam)
+		} else if err != nil {
+			return
+		}
+
+		aam.setUser(u)
+
+		if !self && !svc.ac.CanUpdateUser(ctx, u) {
+			return AuthErrNotAllowedToRemoveWebAuthn()
+		}
+
+		if cc, err = svc.webAuthnCredentials(ctx, s, u.ID); err != nil {
+			return
+		}
+
+		if c = cc.FindByID(credentialID); c == nil {
+			return AuthErrUnconfiguredWebAuthn()
+		}
+
+		aam.setCredentials(c)
+
+		c.DeletedAt = now()
+		if err = store.UpdateCredential(ctx, s, c); err != nil {
+			return
+		}
+
+		if len(cc) > 1 {
+			// user still has other security keys
+			return
+		}
+
+		u.Meta.SecurityPolicy.MFA.EnforcedWebAuthn = false
+		return store.UpdateUser(ctx, s, u)
+	})
+
+	return u, svc.recordAction(ctx, aam, AuthActionWebauthnRemove, err)
+}
+
+// verifies assertion against one of user's security keys
+// and stores updated signature counter
+func (svc *auth) verifyWebAuthnAssertion(ctx context.Context, s store.Credentials, aam *authActionProps, userID uint64, challenge []byte, rsp *webauthn.AssertionResponse, requireUV bool) (c *types.Credential, err error) {
+	var (
+		rp webauthn.RelyingParty
+		wc = &webauthn.Credential{}
+	)
+
+	if rp, err = svc.webAuthnRelyingParty(); err != nil {
+		return
+	}
+
+	if rsp == nil {
+		return nil, AuthErrInvalidWebAuthn(aam)
+	}
+
+	if c, err = svc.lookupWebAuthnCredential(ctx, s, rsp.CredentialID()); err != nil || c.OwnerID != userID {
+		return nil, AuthErrInvalidWebAuthn(aam)
+	}
+
+	aam.setCredentials(c)
+
+	if err = json.Unmarshal(c.Meta, wc); err != nil {
+		return nil, AuthErrInvalidWebAuthn(aam).Wrap(err)
+	}
+
+	if err = rp.VerifyAssertion(rsp, challenge, wc, requireUV); err != nil {
+		return nil, AuthErrInvalidWebAuthn(aam).Wrap(err)
+	}
+
+	if c.Meta, err = json.Marshal(wc); err != nil {
+		return
+	}
+
+	c.LastUsedAt = now()
+	return c, store.UpdateCredential(ctx, s, c)
+}
+
+// finds valid security key by its (raw) credential ID
+func (svc *auth) lookupWebAuthnCredential(ctx context.Context, s store.Credentials, credentialID []byte) (*types.Credential, error) {
+	if len(credentialID) == 0 {
+		return nil, AuthErrInvalidWebAuthn()
+	}
+
+	cc, _, err := store.SearchCredentials(ctx, s, types.CredentialFilter{
+		Kind:        credentialsTypeWebAuthn,
+		Credentials: encodeWebAuthnCredentialID(credentialID),
+		Deleted:     filter.StateExcluded,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cc) != 1 {
+		return nil, AuthErrInvalidWebAuthn()
+	}
+
+	return cc[0], nil
+}
+
+// relying party is derived from the auth base URL;
+// its host is used as RP ID and scheme+host as the only allowed origin
+func (svc *auth) webAuthnRelyingParty() (rp webauthn.RelyingParty, err error) {
+	base, err := url.Parse(svc.opt.BaseURL)
+	if err != nil || base.Hostname() == "" {
+		return rp, AuthErrWebAuthnUnavailable()
+	}
+
+	return webauthn.RelyingParty{
+		ID:      base.Hostname(),
+		Name:    svc.settings.Auth.MultiFactor.WebAuthn.RPName,
+		Origins: []string{base.Scheme + "://" + base.Host},
+	}, nil
+}
+
+func webAuthnUser(u *types.User) webauthn.User {
+	wu := webauthn.User{
+		// user handle must not contain personally identifying information
+		ID:          []byte(strconv.FormatUint(u.ID, 10)),
+		Name:        u.Email,
+		DisplayName: u.Name,
+	}
+
+	if wu.DisplayName == "" {
+		wu.DisplayName = u.Email
+	}
+
+	return wu
+}
+
+func decodeWebAuthnCredentials(cc types.CredentialSet) (out []*webauthn.Credential, err error) {
+	out = make([]*webauthn.Credential, 0, len(cc))
+	for _, c := range cc {
+		wc := &webauthn.Credential{}
+		if err = json.Unmarshal(c.Meta, wc); err != nil {
+			return nil, err
+		}
+
+		out = append(out, wc)
+	}
+
+	return
+}
+
+func encodeWebAuthnCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	internalAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/webauthn"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	denyAllUserAccess  struct{}
+	allowAllUserAccess struct{}
+)
+
+func (denyAllUserAccess) CanImpersonateUser(context.Context, *types.User) bool { return false }
+func (denyAllUserAccess) CanUpdateUser(context.Context, *types.User) bool      { return false }
+
+func (allowAllUserAccess) CanImpersonateUser(context.Context, *types.User) bool { return true }
+func (allowAllUserAccess) CanUpdateUser(context.Context, *types.User) bool      { return true }
+
+func TestAuth_webAuthnRelyingParty(t *testing.T) {
+	var (
+		req = require.New(t)
+		svc = &auth{settings: &types.AppSettings{}}
+	)
+
+	_, err := svc.webAuthnRelyingParty()
+	req.True(AuthErrWebAuthnUnavailable().Is(err))
+
+	svc.opt.BaseURL = "https://corteza.example.tld:8443/auth"
+	svc.settings.Auth.MultiFactor.WebAuthn.RPName = "Example"
+
+	rp, err := svc.webAuthnRelyingParty()
+	req.NoError(err)
+	req.Equal("corteza.example.tld", rp.ID)
+	req.Equal("Example", rp.Name)
+	req.Equal([]string{"https://corteza.example.tld:8443"}, rp.Origins)
+}
+
+func TestAuth_WebAuthnOptions(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		u = &types.User{Email: "webauthn@test.cortezaproject.org", ID: nextID(), CreatedAt: *now(), Meta: &types.UserMeta{}}
+	)
+
+	svc := makeMockAuthService()
+	svc.opt.BaseURL = "https://corteza.example.tld/auth"
+	req.NoError(store.TruncateUsers(ctx, svc.store))
+	req.NoError(store.TruncateCredentials(ctx, svc.store))
+	req.NoError(store.CreateUser(ctx, svc.store, u))
+
+	ctx = internalAuth.SetIdentityToContext(ctx, u)
+
+	_, err := svc.WebAuthnRegistrationOptions(ctx)
+	req.True(AuthErrDisabledMFAWithWebAuthn().Is(err))
+
+	_, err = svc.PasswordlessOptions(ctx)
+	req.True(AuthErrDisabledPasswordless().Is(err))
+
+	svc.settings.Auth.MultiFactor.WebAuthn.Enabled = true
+
+	cOpt, err := svc.WebAuthnRegistrationOptions(ctx)
+	req.NoError(err)
+	req.Len(cOpt.Challenge, webauthn.ChallengeLength)
+	req.Equal("corteza.example.tld", cOpt.RP.ID)
+	req.Equal(u.Email, cOpt.User.Name)
+	req.Empty(cOpt.ExcludeCredentials)
+
+	// no keys registered yet
+	_, err = svc.WebAuthnAssertionOptions(ctx)
+	req.True(AuthErrUnconfiguredWebAuthn().Is(err))
+
+	wc := &webauthn.Credential{ID: []byte("credential-id"), PublicKey: []byte{}}
+	req.NoError(store.CreateCredential(ctx, svc.store, &types.Credential{
+		ID:          nextID(),
+		CreatedAt:   *now(),
+		OwnerID:     u.ID,
+		Kind:        credentialsTypeWebAuthn,
+		Credentials: encodeWebAuthnCredentialID(wc.ID),
+		Meta:        []byte(`{"id":"Y3JlZGVudGlhbC1pZA==","publicKey":""}`),
+	}))
+
+	cOpt, err = svc.WebAuthnRegistrationOptions(ctx)
+	req.NoError(err)
+	req.Len(cOpt.ExcludeCredentials, 1)
+	req.Equal(wc.ID, []byte(cOpt.ExcludeCredentials[0].ID))
+
+	rOpt, err := svc.WebAuthnAssertionOptions(ctx)
+	req.NoError(err)
+	req.Len(rOpt.AllowCredentials, 1)
+
+	svc.settings.Auth.MultiFactor.WebAuthn.Passwordless = true
+	rOpt, err = svc.PasswordlessOptions(ctx)
+	req.NoError(err)
+	req.Empty(rOpt.AllowCredentials)
+	req.Equal("required", rOpt.UserVerification)
+}
+
+func TestAuth_RemoveWebAuthn(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		u     = &types.User{Email: "webauthn-remove@test.cortezaproject.org", ID: nextID(), CreatedAt: *now(), Meta: &types.UserMeta{}}
+		other = &types.User{Email: "webauthn-other@test.cortezaproject.org", ID: nextID(), CreatedAt: *now(), Meta: &types.UserMeta{}}
+
+		c1 = &types.Credential{ID: nextID(), CreatedAt: *now(), OwnerID: u.ID, Kind: credentialsTypeWebAuthn, Credentials: "c1", Meta: []byte(`{}`)}
+		c2 = &types.Credential{ID: nextID(), CreatedAt: *now(), OwnerID: u.ID, Kind: credentialsTypeWebAuthn, Credentials: "c2", Meta: []byte(`{}`)}
+	)
+
+	u.Meta.SecurityPolicy.MFA.EnforcedWebAuthn = true
+
+	svc := makeMockAuthService()
+	svc.ac = &denyAllUserAccess{}
+	req.NoError(store.TruncateUsers(ctx, svc.store))
+	req.NoError(store.TruncateCredentials(ctx, svc.store))
+	req.NoError(store.CreateUser(ctx, svc.store, u, other))
+	req.NoError(store.CreateCredential(ctx, svc.store, c1, c2))
+
+	// not allowed to remove keys of another user
+	_, err := svc.RemoveWebAuthn(internalAuth.SetIdentityToContext(ctx, other), u.ID, c1.ID)
+	req.True(AuthErrNotAllowedToRemoveWebAuthn().Is(err))
+
+	ctx = internalAuth.SetIdentityToContext(ctx, u)
+
+	// unknown key
+	_, err = svc.RemoveWebAuthn(ctx, u.ID, nextID())
+	req.True(AuthErrUnconfiguredWebAuthn().Is(err))
+
+	usr, err := svc.RemoveWebAuthn(ctx, u.ID, c1.ID)
+	req.NoError(err)
+	req.True(usr.Meta.SecurityPolicy.MFA.EnforcedWebAuthn)
+
+	// removing the last key disables the MFA with security keys
+	usr, err = svc.RemoveWebAuthn(ctx, u.ID, c2.ID)
+	req.NoError(err)
+	req.False(usr.Meta.SecurityPolicy.MFA.EnforcedWebAuthn)
+
+	cc, err := svc.WebAuthnCredentials(ctx, u.ID)
+	req.NoError(err)
+	req.Empty(cc)
+}
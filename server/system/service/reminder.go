@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/cortezaproject/corteza/server/pkg/actionlog"
 	intAuth "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
 	"github.com/cortezaproject/corteza/server/store"
 	"github.com/cortezaproject/corteza/server/system/types"
 	"github.com/getsentry/sentry-go"
@@ -48,6 +50,12 @@ type (
 	}
 )
 
+const (
+	// how long claims for reminder dispatches are kept;
+	// reminders that are still not dismissed are sent again after that
+	reminderClaimTTL = 24 * time.Hour
+)
+
 func Reminder(ctx context.Context, log *zap.Logger, rs reminderSender) ReminderService {
 	return &reminder{
 		ac:             DefaultAccessControl,
@@ -340,6 +348,10 @@ func (svc reminder) Delete(ctx context.Context, ID uint64) (err error) {
 	return svc.recordAction(ctx, raProps, ReminderActionDelete, err)
 }
 
+// Watch periodically sends scheduled reminders to the users
+//
+// Each dispatch is claimed so that only one instance in the cluster sends
+// the reminder; it is sent over websocket connections to that instance.
 func (svc reminder) Watch(ctx context.Context) {
 	if svc.reminderSender != nil {
 		var (
@@ -370,9 +382,7 @@ func (svc reminder) Watch(ctx context.Context) {
 					// Send scheduled reminders to users
 					_ = rr.Walk(func(r *types.Reminder) error {
 						if r.RemindAt != nil && r.DismissedAt == nil && now().Add(interval).After(*r.RemindAt) {
-							if err := svc.reminderSender.Send("reminder", r, r.AssignedTo); err != nil {
-								svc.log.Error("failed to send reminder to user", zap.Error(err))
-							}
+							svc.send(ctx, r)
 						}
 						return nil
 					})
@@ -381,3 +391,27 @@ func (svc reminder) Watch(ctx context.Context) {
 		}()
 	}
 }
+
+// send sends the reminder unless another instance already claimed it
+func (svc reminder) send(ctx context.Context, r *types.Reminder) {
+	claimed, err := scheduler.Claims().Claim(ctx, reminderJob(r), reminderClaimTTL)
+	if err != nil {
+		svc.log.Warn("failed to claim reminder", zap.Uint64("reminderID", r.ID), zap.Error(err))
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	if err = svc.reminderSender.Send("reminder", r, r.AssignedTo); err != nil {
+		svc.log.Error("failed to send reminder to user", zap.Error(err))
+	}
+}
+
+// reminderJob identifies the reminder dispatch
+//
+// Snoozed reminders get a new remind-at time and are sent again
+func reminderJob(r *types.Reminder) string {
+	return fmt.Sprintf("reminder:%d@%d", r.ID, r.RemindAt.Unix())
+}
@@ -375,6 +375,26 @@ func ReportActionRun(props ...*reportActionProps) *reportAction {
 	return a
 }
 
+// ReportActionExport returns "system:report.export" action
+//
+// This function is auto-generated.
+//
+func ReportActionExport(props ...*reportActionProps) *reportAction {
+	a := &reportAction{
+		timestamp: time.Now(),
+		resource:  "system:report",
+		action:    "export",
+		log:       "exported {{report}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -805,6 +825,74 @@ func ReportErrNotAllowedToRun(mm ...*reportActionProps) *errors.Error {
 	return e
 }
 
+// ReportErrInvalidScenario returns "system:report.invalidScenario" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func ReportErrInvalidScenario(mm ...*reportActionProps) *errors.Error {
+	var p = &reportActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("scenario not found", nil),
+
+		errors.Meta("type", "invalidScenario"),
+		errors.Meta("resource", "system:report"),
+
+		errors.Meta(reportPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report.errors.invalidScenario"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportErrInvalidFrame returns "system:report.invalidFrame" as *errors.Error
+//
+//
+// This function is auto-generated.
+//
+func ReportErrInvalidFrame(mm ...*reportActionProps) *errors.Error {
+	var p = &reportActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid frame definition", nil),
+
+		errors.Meta("type", "invalidFrame"),
+		errors.Meta("resource", "system:report"),
+
+		errors.Meta(reportPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report.errors.invalidFrame"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // ReportErrInvalidConfiguration returns "system:report.invalidConfiguration" as *errors.Error
 //
 //
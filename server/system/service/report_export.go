@@ -0,0 +1,183 @@
+package service
+
+// part of report service
+// runs the report and streams a single frame to the encoder
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza/server/pkg/dal"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+)
+
+const (
+	// number of rows fetched from the DAL with each export page
+	reportExportPageSize uint = 1000
+)
+
+// Export runs the report and writes the frame with the given encoder
+//
+// See StartExport for details
+func (svc *report) Export(ctx context.Context, reportID, scenarioID uint64, def *reporting.FrameDefinition, enc reporting.FrameEncoder) (err error) {
+	write, err := svc.StartExport(ctx, reportID, scenarioID, def)
+	if err != nil {
+		return err
+	}
+
+	return write(enc)
+}
+
+// StartExport runs the report and reads the first page of the frame
+//
+// Returned function writes the frame with the given encoder. Access checks
+// and the first page are done before anything is written so that errors
+// can still be reported to the client in the usual way.
+//
+// Frame is read from the DAL in pages (cursor is passed from one page to the next)
+// so the results of the report never need to be held in the memory in its entirety.
+//
+// When scenario is set, its filter for the frame's source is applied
+// together with the filter of the definition.
+func (svc *report) StartExport(ctx context.Context, reportID, scenarioID uint64, def *reporting.FrameDefinition) (write func(reporting.FrameEncoder) error, err error) {
+	var (
+		aaProps = &reportActionProps{}
+	)
+
+	write, err = func() (_ func(reporting.FrameEncoder) error, err error) {
+		r, err := loadReport(ctx, svc.store, reportID)
+		if err != nil {
+			return nil, err
+		}
+
+		aaProps.setReport(r)
+
+		if !svc.ac.CanRunReport(ctx, r) {
+			return nil, ReportErrNotAllowedToRun(aaProps)
+		}
+
+		if def == nil || def.Source == "" {
+			return nil, ReportErrInvalidFrame(aaProps)
+		}
+
+		// work on a copy; paging is modified with each of the pages
+		aux := *def
+		def = &aux
+
+		if def.Name == "" {
+			def.Name = def.Source
+		}
+
+		if scenarioID > 0 {
+			s := reportScenario(r, scenarioID)
+			if s == nil {
+				return nil, ReportErrInvalidScenario(aaProps)
+			}
+
+			if f, ok := s.Filters[def.Source]; ok {
+				def.Filter = mergeReportFilters(def.Filter, &f)
+			}
+		}
+
+		ss := r.Sources.ReportSteps()
+		ss = append(ss, r.Blocks.ReportSteps()...)
+
+		def.Paging = &filter.Paging{Limit: reportExportPageSize}
+
+		f, err := svc.exportPage(ctx, ss, def)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(enc reporting.FrameEncoder) error {
+			return svc.recordAction(ctx, aaProps, ReportActionExport, svc.exportFrame(ctx, ss, def, f, enc))
+		}, nil
+	}()
+
+	if err != nil {
+		return nil, svc.recordAction(ctx, aaProps, ReportActionExport, err)
+	}
+
+	return write, nil
+}
+
+// exportFrame writes the first page of the frame and then reads
+// and writes all the following pages
+func (svc *report) exportFrame(ctx context.Context, ss types.ReportStepSet, def *reporting.FrameDefinition, f *reporting.Frame, enc reporting.FrameEncoder) (err error) {
+	if err = enc.Frame(f); err != nil {
+		return
+	}
+
+	for {
+		if err = enc.Rows(f.Rows...); err != nil {
+			return
+		}
+
+		if f.Paging == nil || f.Paging.NextPage == nil {
+			break
+		}
+
+		def.Paging = &filter.Paging{Limit: reportExportPageSize, PageCursor: f.Paging.NextPage}
+		if f, err = svc.exportPage(ctx, ss, def); err != nil {
+			return
+		}
+	}
+
+	return enc.Flush()
+}
+
+// exportPage runs the report for a single page of the frame
+func (svc *report) exportPage(ctx context.Context, ss types.ReportStepSet, def *reporting.FrameDefinition) (f *reporting.Frame, err error) {
+	var (
+		iter dal.Iterator
+		ff   []*reporting.Frame
+	)
+
+	runs, err := reporting.Runs(svc.pipelineRunner, ss, reporting.FrameDefinitionSet{def})
+	if err != nil {
+		return
+	}
+
+	if len(runs) != 1 {
+		return nil, ReportErrInvalidFrame()
+	}
+
+	if iter, err = svc.pipelineRunner.Run(ctx, runs[0].Pipeline); err != nil {
+		return
+	}
+	defer iter.Close()
+
+	if ff, err = reporting.Frames(ctx, iter, runs[0]); err != nil {
+		return
+	}
+
+	if err = svc.enhance(ctx, ff); err != nil {
+		return
+	}
+
+	if len(ff) == 0 {
+		// no rows; frame is still needed for the header
+		return &reporting.Frame{Name: def.Name, Source: def.Source, Columns: def.Columns}, nil
+	}
+
+	return ff[0], nil
+}
+
+// mergeReportFilters combines both filters with the AND operator
+func mergeReportFilters(a, b *types.ReportFilterExpr) *types.ReportFilterExpr {
+	switch {
+	case a.Node() == nil:
+		return b
+	case b.Node() == nil:
+		return a
+	}
+
+	return &types.ReportFilterExpr{
+		ASTNode: &ql.ASTNode{
+			Ref:  "and",
+			Args: ql.ASTNodeSet{a.Node(), b.Node()},
+		},
+	}
+}
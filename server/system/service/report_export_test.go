@@ -0,0 +1,240 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/dal"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	reportExportTestAC struct {
+		reportAccessController
+		canRun bool
+	}
+
+	reportExportTestUsers struct {
+		UserService
+	}
+
+	// reportExportTestRunner serves the rows of the data source
+	// starting after the row the cursor points to
+	reportExportTestRunner struct {
+		rows    int
+		filters []filter.Filter
+
+		// run (starting with 1) that fails
+		failAt int
+	}
+
+	reportExportTestIterator struct {
+		rows, ptr int
+	}
+)
+
+func (ac reportExportTestAC) CanRunReport(context.Context, *types.Report) bool { return ac.canRun }
+
+func (reportExportTestUsers) Find(context.Context, types.UserFilter) (types.UserSet, types.UserFilter, error) {
+	return nil, types.UserFilter{}, nil
+}
+
+func (reportExportTestRunner) FindModel(dal.ModelRef) *dal.Model {
+	return &dal.Model{
+		Attributes: dal.AttributeSet{
+			{Ident: "id", Label: "ID", Type: dal.TypeNumber{}},
+		},
+	}
+}
+
+func (reportExportTestRunner) Dryrun(context.Context, dal.Pipeline) error { return nil }
+
+func (r *reportExportTestRunner) Run(_ context.Context, pp dal.Pipeline) (dal.Iterator, error) {
+	f := pp[0].(*dal.Datasource).Filter
+	r.filters = append(r.filters, f)
+
+	if len(r.filters) == r.failAt {
+		return nil, fmt.Errorf("failed to read page %d", r.failAt)
+	}
+
+	i := &reportExportTestIterator{rows: r.rows, ptr: -1}
+	if c := f.Cursor(); c != nil {
+		i.ptr = cast.ToInt(c.Values()[0])
+	}
+
+	return i, nil
+}
+
+func (i *reportExportTestIterator) Next(context.Context) bool {
+	i.ptr++
+	return i.ptr < i.rows
+}
+
+func (i *reportExportTestIterator) Scan(dst dal.ValueSetter) error {
+	return dst.SetValue("id", 0, i.ptr)
+}
+
+func (i *reportExportTestIterator) ForwardCursor(v dal.ValueGetter) (*filter.PagingCursor, error) {
+	val, err := v.GetValue("id", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &filter.PagingCursor{}
+	c.Set("id", val, false)
+	return c, nil
+}
+
+func (i *reportExportTestIterator) More(uint, dal.ValueGetter) error { return nil }
+func (i *reportExportTestIterator) Err() error                       { return nil }
+func (i *reportExportTestIterator) Close() error                     { return nil }
+func (i *reportExportTestIterator) BackCursor(dal.ValueGetter) (*filter.PagingCursor, error) {
+	return nil, nil
+}
+
+func TestReportExport(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		s   store.Storer
+		err error
+
+		r = &types.Report{
+			ID:        42,
+			CreatedAt: time.Now(),
+			Sources: types.ReportDataSourceSet{
+				{Step: &types.ReportStep{Load: &types.ReportStepLoad{
+					Name:   "users",
+					Source: "composeRecords",
+					Definition: map[string]interface{}{
+						"module":    "mod",
+						"namespace": "ns",
+					},
+				}}},
+			},
+			Scenarios: types.ReportScenarioSet{
+				{
+					ScenarioID: 10,
+					Filters: types.ScenarioFilterMap{
+						"users": types.ReportFilterExpr{ASTNode: &ql.ASTNode{
+							Ref:  "gt",
+							Args: ql.ASTNodeSet{{Symbol: "id"}, {Symbol: "0"}},
+						}},
+					},
+				},
+			},
+		}
+
+		makeSvc = func(runner pipelineRunner, canRun bool) *report {
+			return &report{
+				store:          s,
+				ac:             reportExportTestAC{canRun: canRun},
+				users:          reportExportTestUsers{},
+				pipelineRunner: runner,
+			}
+		}
+	)
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.CreateReport(ctx, s, r))
+
+	t.Run("paged", func(t *testing.T) {
+		var (
+			req    = require.New(t)
+			buf    = &bytes.Buffer{}
+			runner = &reportExportTestRunner{rows: int(reportExportPageSize)*2 + 5}
+		)
+
+		err := makeSvc(runner, true).Export(ctx, r.ID, 0, &reporting.FrameDefinition{Source: "users"}, reporting.NewCSVEncoder(buf))
+		req.NoError(err)
+
+		// three pages; each page starts where the previous one ended
+		req.Len(runner.filters, 3)
+		req.Nil(runner.filters[0].Cursor())
+		req.Equal(int(reportExportPageSize)-1, cast.ToInt(runner.filters[1].Cursor().Values()[0]))
+		req.Equal(int(reportExportPageSize)*2-1, cast.ToInt(runner.filters[2].Cursor().Values()[0]))
+
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		req.Len(lines, runner.rows+1)
+		req.Equal("ID", string(lines[0]))
+		req.Equal("0", string(lines[1]))
+		req.Equal(cast.ToString(runner.rows-1), string(lines[len(lines)-1]))
+	})
+
+	t.Run("scenario", func(t *testing.T) {
+		var (
+			req    = require.New(t)
+			runner = &reportExportTestRunner{rows: 1}
+		)
+
+		err := makeSvc(runner, true).Export(ctx, r.ID, 10, &reporting.FrameDefinition{Source: "users"}, reporting.NewNDJSONEncoder(&bytes.Buffer{}))
+		req.NoError(err)
+		req.Len(runner.filters, 1)
+
+		exp := runner.filters[0].(interface{ ExpressionParsed() *ql.ASTNode }).ExpressionParsed()
+		req.NotNil(exp)
+		req.Contains(exp.String(), "id")
+
+		err = makeSvc(runner, true).Export(ctx, r.ID, 11, &reporting.FrameDefinition{Source: "users"}, reporting.NewNDJSONEncoder(&bytes.Buffer{}))
+		req.Error(err)
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		err := makeSvc(&reportExportTestRunner{}, false).Export(ctx, r.ID, 0, &reporting.FrameDefinition{Source: "users"}, reporting.NewCSVEncoder(&bytes.Buffer{}))
+		require.Error(t, err)
+	})
+
+	t.Run("first page fails before writing", func(t *testing.T) {
+		req := require.New(t)
+
+		write, err := makeSvc(&reportExportTestRunner{rows: 1, failAt: 1}, true).StartExport(ctx, r.ID, 0, &reporting.FrameDefinition{Source: "users"})
+		req.Error(err)
+		req.Nil(write)
+	})
+
+	t.Run("next page fails while writing", func(t *testing.T) {
+		var (
+			req = require.New(t)
+			buf = &bytes.Buffer{}
+		)
+
+		write, err := makeSvc(&reportExportTestRunner{rows: int(reportExportPageSize) + 1, failAt: 2}, true).StartExport(ctx, r.ID, 0, &reporting.FrameDefinition{Source: "users"})
+		req.NoError(err)
+		req.Zero(buf.Len())
+
+		req.Error(write(reporting.NewCSVEncoder(buf)))
+	})
+}
+
+func TestMergeReportFilters(t *testing.T) {
+	var (
+		req = require.New(t)
+		a   = &types.ReportFilterExpr{ASTNode: &ql.ASTNode{Symbol: "a"}}
+		b   = &types.ReportFilterExpr{ASTNode: &ql.ASTNode{Symbol: "b"}}
+	)
+
+	req.Equal(a, mergeReportFilters(a, nil))
+	req.Equal(b, mergeReportFilters(nil, b))
+
+	m := mergeReportFilters(a, b)
+	req.Equal("and", m.Ref)
+	req.Equal("a", m.Args[0].Symbol)
+	req.Equal("b", m.Args[1].Symbol)
+}
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/actionlog"
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/mail"
+	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/renderer"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"go.uber.org/zap"
+	gomail "gopkg.in/mail.v2"
+)
+
+type (
+	reportSubscription struct {
+		actionlog actionlog.Recorder
+		store     store.Storer
+		ac        reportSubscriptionAccessController
+		log       *zap.Logger
+
+		reports   reportRunner
+		templates reportTemplateRenderer
+		renderer  rendererService
+
+		// sends the message; replaceable for testing
+		send func(*gomail.Message) error
+	}
+
+	reportSubscriptionAccessController interface {
+		reportAccessController
+		CanImpersonateUser(context.Context, *types.User) bool
+	}
+
+	reportRunner interface {
+		Run(context.Context, uint64, reporting.FrameDefinitionSet) ([]*reporting.Frame, error)
+	}
+
+	reportTemplateRenderer interface {
+		Render(ctx context.Context, templateID uint64, dstType string, variables map[string]interface{}, options map[string]string) (io.ReadSeeker, error)
+	}
+)
+
+func ReportSubscription(log *zap.Logger, tpl options.TemplateOpt) *reportSubscription {
+	return &reportSubscription{
+		ac:        DefaultAccessControl,
+		actionlog: DefaultActionlog,
+		store:     DefaultStore,
+		log:       log,
+
+		reports:   DefaultReport,
+		templates: DefaultRenderer,
+		renderer:  renderer.Renderer(tpl),
+
+		send: func(m *gomail.Message) error { return mail.Send(m) },
+	}
+}
+
+func (svc *reportSubscription) FindByID(ctx context.Context, ID uint64) (sub *types.ReportSubscription, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{}
+	)
+
+	err = func() (err error) {
+		if sub, err = loadReportSubscription(ctx, svc.store, ID); err != nil {
+			return
+		}
+
+		rsProps.setSubscription(sub)
+
+		r, err := loadReport(ctx, svc.store, sub.ReportID)
+		if err != nil {
+			return
+		}
+
+		if !svc.ac.CanReadReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToRead(rsProps)
+		}
+
+		svc.hideRecipients(ctx, r, sub)
+		return nil
+	}()
+
+	return sub, svc.recordAction(ctx, rsProps, ReportSubscriptionActionLookup, err)
+}
+
+// Search returns subscriptions of the reports current user can read
+//
+// Recipients are only returned to the owners of the subscriptions
+// and to users that can update the report (see hideRecipients)
+func (svc *reportSubscription) Search(ctx context.Context, filter types.ReportSubscriptionFilter) (set types.ReportSubscriptionSet, f types.ReportSubscriptionFilter, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{filter: &filter}
+
+		// access to the report is checked only once
+		readable = make(map[uint64]bool)
+		reports  = make(map[uint64]*types.Report)
+	)
+
+	// For each fetched item, store backend will check if it is valid or not
+	filter.Check = func(res *types.ReportSubscription) (bool, error) {
+		if can, checked := readable[res.ReportID]; checked {
+			return can, nil
+		}
+
+		r, err := store.LookupReportByID(ctx, svc.store, res.ReportID)
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		reports[res.ReportID] = r
+		readable[res.ReportID] = r != nil && svc.ac.CanReadReport(ctx, r)
+		return readable[res.ReportID], nil
+	}
+
+	err = func() error {
+		set, f, err = store.SearchReportSubscriptions(ctx, svc.store, filter)
+		if err != nil {
+			return err
+		}
+
+		return set.Walk(func(sub *types.ReportSubscription) error {
+			svc.hideRecipients(ctx, reports[sub.ReportID], sub)
+			return nil
+		})
+	}()
+
+	return set, f, svc.recordAction(ctx, rsProps, ReportSubscriptionActionSearch, err)
+}
+
+// hideRecipients removes recipients of the subscription unless current user
+// owns the subscription or can update (and with that manage subscriptions of) the report
+func (svc *reportSubscription) hideRecipients(ctx context.Context, r *types.Report, sub *types.ReportSubscription) {
+	if sub.OwnedBy == a.GetIdentityFromContext(ctx).Identity() {
+		return
+	}
+
+	if r != nil && svc.ac.CanUpdateReport(ctx, r) {
+		return
+	}
+
+	sub.Recipients = types.ReportSubscriptionRecipients{}
+}
+
+// Create stores new report subscription
+//
+// Subscriptions can be managed by users that can update the report;
+// report is ran with the permissions of the owner. Current user becomes
+// the owner unless they are allowed to impersonate the requested one.
+func (svc *reportSubscription) Create(ctx context.Context, new *types.ReportSubscription) (sub *types.ReportSubscription, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{new: new}
+	)
+
+	err = func() (err error) {
+		r, err := loadReport(ctx, svc.store, new.ReportID)
+		if err != nil {
+			return
+		}
+
+		if !svc.ac.CanUpdateReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToManage(rsProps)
+		}
+
+		if err = svc.validate(r, new); err != nil {
+			return
+		}
+
+		if new.Meta == nil {
+			new.Meta = &types.ReportSubscriptionMeta{}
+		}
+
+		new.ID = nextID()
+		new.CreatedAt = *now()
+		new.CreatedBy = a.GetIdentityFromContext(ctx).Identity()
+		new.LastRunAt = nil
+
+		if new.OwnedBy, err = svc.owner(ctx, rsProps, new.OwnedBy); err != nil {
+			return
+		}
+
+		if err = store.CreateReportSubscription(ctx, svc.store, new); err != nil {
+			return
+		}
+
+		sub = new
+		rsProps.setSubscription(sub)
+		return nil
+	}()
+
+	return sub, svc.recordAction(ctx, rsProps, ReportSubscriptionActionCreate, err)
+}
+
+func (svc *reportSubscription) Update(ctx context.Context, upd *types.ReportSubscription) (sub *types.ReportSubscription, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{update: upd}
+	)
+
+	err = func() (err error) {
+		if sub, err = loadReportSubscription(ctx, svc.store, upd.ID); err != nil {
+			return
+		}
+
+		rsProps.setSubscription(sub)
+
+		r, err := loadReport(ctx, svc.store, sub.ReportID)
+		if err != nil {
+			return
+		}
+
+		if !svc.ac.CanUpdateReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToManage(rsProps)
+		}
+
+		// Test if stale (update has an older version of data)
+		if isStale(upd.UpdatedAt, sub.UpdatedAt, sub.CreatedAt) {
+			return ReportSubscriptionErrStaleData(rsProps)
+		}
+
+		if err = svc.validate(r, upd); err != nil {
+			return
+		}
+
+		sub.ScenarioID = upd.ScenarioID
+		sub.Schedule = upd.Schedule
+		sub.Format = upd.Format
+		sub.Enabled = upd.Enabled
+		sub.Recipients = upd.Recipients
+
+		if upd.Meta != nil {
+			sub.Meta = upd.Meta
+		}
+
+		// subscription is always re-owned by whoever changes it;
+		// otherwise recipients could be changed to receive the
+		// data that only the previous owner can read
+		if sub.OwnedBy, err = svc.owner(ctx, rsProps, upd.OwnedBy); err != nil {
+			return
+		}
+
+		sub.UpdatedAt = now()
+		sub.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		return store.UpdateReportSubscription(ctx, svc.store, sub)
+	}()
+
+	return sub, svc.recordAction(ctx, rsProps, ReportSubscriptionActionUpdate, err)
+}
+
+func (svc *reportSubscription) DeleteByID(ctx context.Context, ID uint64) (err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{}
+		sub     *types.ReportSubscription
+	)
+
+	err = func() (err error) {
+		if sub, err = loadReportSubscription(ctx, svc.store, ID); err != nil {
+			return
+		}
+
+		rsProps.setSubscription(sub)
+
+		// report (even a deleted one) is needed to check the access;
+		// subscriptions of reports that no longer exist can be removed by anyone
+		r, err := store.LookupReportByID(ctx, svc.store, sub.ReportID)
+		if errors.IsNotFound(err) {
+			r, err = nil, nil
+		}
+
+		if err != nil {
+			return
+		}
+
+		if r != nil && !svc.ac.CanUpdateReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToManage(rsProps)
+		}
+
+		sub.DeletedAt = now()
+		sub.DeletedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		return store.UpdateReportSubscription(ctx, svc.store, sub)
+	}()
+
+	return svc.recordAction(ctx, rsProps, ReportSubscriptionActionDelete, err)
+}
+
+// SearchDeliveries returns delivery history of the subscription
+func (svc *reportSubscription) SearchDeliveries(ctx context.Context, subscriptionID uint64, filter types.ReportDeliveryFilter) (set types.ReportDeliverySet, f types.ReportDeliveryFilter, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{}
+		sub     *types.ReportSubscription
+	)
+
+	err = func() (err error) {
+		if sub, err = loadReportSubscription(ctx, svc.store, subscriptionID); err != nil {
+			return
+		}
+
+		rsProps.setSubscription(sub)
+
+		r, err := loadReport(ctx, svc.store, sub.ReportID)
+		if err != nil {
+			return
+		}
+
+		if !svc.ac.CanReadReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToRead(rsProps)
+		}
+
+		filter.SubscriptionID = []string{strconv.FormatUint(sub.ID, 10)}
+
+		if len(filter.Sort) == 0 {
+			if err = filter.Sort.Set("createdAt DESC"); err != nil {
+				return
+			}
+		}
+
+		set, f, err = store.SearchReportDeliverys(ctx, svc.store, filter)
+		return
+	}()
+
+	return set, f, svc.recordAction(ctx, rsProps, ReportSubscriptionActionSearchDeliveries, err)
+}
+
+// Deliver runs the report and delivers the results right away,
+// regardless of the schedule
+//
+// Delivery is recorded and returned, even when it fails
+func (svc *reportSubscription) Deliver(ctx context.Context, subscriptionID uint64) (d *types.ReportDelivery, err error) {
+	var (
+		rsProps = &reportSubscriptionActionProps{}
+		sub     *types.ReportSubscription
+	)
+
+	err = func() (err error) {
+		if sub, err = loadReportSubscription(ctx, svc.store, subscriptionID); err != nil {
+			return
+		}
+
+		rsProps.setSubscription(sub)
+
+		r, err := loadReport(ctx, svc.store, sub.ReportID)
+		if err != nil {
+			return
+		}
+
+		if !svc.ac.CanUpdateReport(ctx, r) {
+			return ReportSubscriptionErrNotAllowedToManage(rsProps)
+		}
+
+		if d, err = svc.deliver(ctx, sub); err != nil {
+			return
+		}
+
+		rsProps.setDelivery(d)
+		return nil
+	}()
+
+	return d, svc.recordAction(ctx, rsProps, ReportSubscriptionActionDeliver, err)
+}
+
+// owner resolves the owner of the subscription
+//
+// Current user is the owner unless another user is requested
+// and current user is allowed to impersonate them
+func (svc *reportSubscription) owner(ctx context.Context, rsProps *reportSubscriptionActionProps, ownerID uint64) (uint64, error) {
+	invokerID := a.GetIdentityFromContext(ctx).Identity()
+	if ownerID == 0 || ownerID == invokerID {
+		return invokerID, nil
+	}
+
+	u, err := store.LookupUserByID(ctx, svc.store, ownerID)
+	if errors.IsNotFound(err) || (u != nil && !u.Valid()) {
+		return 0, ReportSubscriptionErrOwnerNotFound(rsProps)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !svc.ac.CanImpersonateUser(ctx, u) {
+		return 0, ReportSubscriptionErrNotAllowedToSetOwner(rsProps)
+	}
+
+	return ownerID, nil
+}
+
+func (svc *reportSubscription) validate(r *types.Report, sub *types.ReportSubscription) error {
+	if _, err := scheduler.NextInterval(time.Now(), sub.Schedule); err != nil {
+		return ReportSubscriptionErrInvalidSchedule().Wrap(err)
+	}
+
+	if !sub.Format.IsValid() {
+		return ReportSubscriptionErrInvalidFormat()
+	}
+
+	if sub.ScenarioID > 0 && reportScenario(r, sub.ScenarioID) == nil {
+		return ReportSubscriptionErrInvalidScenario()
+	}
+
+	if sub.Meta != nil && sub.Meta.Timezone != "" {
+		if _, err := time.LoadLocation(sub.Meta.Timezone); err != nil {
+			return ReportSubscriptionErrInvalidTimezone().Wrap(err)
+		}
+	}
+
+	if sub.Recipients.Empty() {
+		return ReportSubscriptionErrNoRecipients()
+	}
+
+	for _, e := range sub.Recipients.Emails {
+		if !mail.IsValidAddress(e) {
+			return ReportSubscriptionErrInvalidRecipient()
+		}
+	}
+
+	return nil
+}
+
+func loadReportSubscription(ctx context.Context, s store.ReportSubscriptions, ID uint64) (res *types.ReportSubscription, err error) {
+	if ID == 0 {
+		return nil, ReportSubscriptionErrInvalidID()
+	}
+
+	if res, err = store.LookupReportSubscriptionByID(ctx, s, ID); errors.IsNotFound(err) {
+		return nil, ReportSubscriptionErrNotFound()
+	}
+
+	return
+}
+
+func reportScenario(r *types.Report, ID uint64) *types.ReportScenario {
+	for _, s := range r.Scenarios {
+		if s.ScenarioID == ID {
+			return s
+		}
+	}
+
+	return nil
+}
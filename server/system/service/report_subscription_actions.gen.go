@@ -0,0 +1,964 @@
+package service
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// system/service/report_subscription_actions.yaml
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortezaproject/corteza/server/pkg/actionlog"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/locale"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"strings"
+	"time"
+)
+
+type (
+	reportSubscriptionActionProps struct {
+		subscription *types.ReportSubscription
+		new          *types.ReportSubscription
+		update       *types.ReportSubscription
+		delivery     *types.ReportDelivery
+		filter       *types.ReportSubscriptionFilter
+	}
+
+	reportSubscriptionAction struct {
+		timestamp time.Time
+		resource  string
+		action    string
+		log       string
+		severity  actionlog.Severity
+
+		// prefix for error when action fails
+		errorMessage string
+
+		props *reportSubscriptionActionProps
+	}
+
+	reportSubscriptionLogMetaKey   struct{}
+	reportSubscriptionPropsMetaKey struct{}
+)
+
+var (
+	// just a placeholder to cover template cases w/o fmt package use
+	_ = fmt.Println
+)
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Props methods
+// setSubscription updates reportSubscriptionActionProps's subscription
+//
+// This function is auto-generated.
+func (p *reportSubscriptionActionProps) setSubscription(subscription *types.ReportSubscription) *reportSubscriptionActionProps {
+	p.subscription = subscription
+	return p
+}
+
+// setNew updates reportSubscriptionActionProps's new
+//
+// This function is auto-generated.
+func (p *reportSubscriptionActionProps) setNew(new *types.ReportSubscription) *reportSubscriptionActionProps {
+	p.new = new
+	return p
+}
+
+// setUpdate updates reportSubscriptionActionProps's update
+//
+// This function is auto-generated.
+func (p *reportSubscriptionActionProps) setUpdate(update *types.ReportSubscription) *reportSubscriptionActionProps {
+	p.update = update
+	return p
+}
+
+// setDelivery updates reportSubscriptionActionProps's delivery
+//
+// This function is auto-generated.
+func (p *reportSubscriptionActionProps) setDelivery(delivery *types.ReportDelivery) *reportSubscriptionActionProps {
+	p.delivery = delivery
+	return p
+}
+
+// setFilter updates reportSubscriptionActionProps's filter
+//
+// This function is auto-generated.
+func (p *reportSubscriptionActionProps) setFilter(filter *types.ReportSubscriptionFilter) *reportSubscriptionActionProps {
+	p.filter = filter
+	return p
+}
+
+// Serialize converts reportSubscriptionActionProps to actionlog.Meta
+//
+// This function is auto-generated.
+func (p reportSubscriptionActionProps) Serialize() actionlog.Meta {
+	var (
+		m = make(actionlog.Meta)
+	)
+
+	if p.subscription != nil {
+		m.Set("subscription.ID", p.subscription.ID, true)
+		m.Set("subscription.reportID", p.subscription.ReportID, true)
+		m.Set("subscription.format", p.subscription.Format, true)
+		m.Set("subscription.schedule", p.subscription.Schedule, true)
+	}
+	if p.new != nil {
+		m.Set("new.reportID", p.new.ReportID, true)
+		m.Set("new.format", p.new.Format, true)
+		m.Set("new.schedule", p.new.Schedule, true)
+	}
+	if p.update != nil {
+		m.Set("update.ID", p.update.ID, true)
+		m.Set("update.reportID", p.update.ReportID, true)
+		m.Set("update.format", p.update.Format, true)
+		m.Set("update.schedule", p.update.Schedule, true)
+	}
+	if p.delivery != nil {
+		m.Set("delivery.ID", p.delivery.ID, true)
+		m.Set("delivery.status", p.delivery.Status, true)
+	}
+	if p.filter != nil {
+	}
+
+	return m
+}
+
+// tr translates string and replaces meta value placeholder with values
+//
+// This function is auto-generated.
+func (p reportSubscriptionActionProps) Format(in string, err error) string {
+	var (
+		pairs = []string{"{{err}}"}
+		// first non-empty string
+		fns = func(ii ...interface{}) string {
+			for _, i := range ii {
+				if s := fmt.Sprintf("%v", i); len(s) > 0 {
+					return s
+				}
+			}
+
+			return ""
+		}
+	)
+
+	if err != nil {
+		pairs = append(pairs, err.Error())
+	} else {
+		pairs = append(pairs, "nil")
+	}
+
+	if p.subscription != nil {
+		// replacement for "{{subscription}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{subscription}}",
+			fns(
+				p.subscription.ID,
+				p.subscription.ReportID,
+				p.subscription.Format,
+				p.subscription.Schedule,
+			),
+		)
+		pairs = append(pairs, "{{subscription.ID}}", fns(p.subscription.ID))
+		pairs = append(pairs, "{{subscription.reportID}}", fns(p.subscription.ReportID))
+		pairs = append(pairs, "{{subscription.format}}", fns(p.subscription.Format))
+		pairs = append(pairs, "{{subscription.schedule}}", fns(p.subscription.Schedule))
+	}
+
+	if p.new != nil {
+		// replacement for "{{new}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{new}}",
+			fns(
+				p.new.ReportID,
+				p.new.Format,
+				p.new.Schedule,
+			),
+		)
+		pairs = append(pairs, "{{new.reportID}}", fns(p.new.ReportID))
+		pairs = append(pairs, "{{new.format}}", fns(p.new.Format))
+		pairs = append(pairs, "{{new.schedule}}", fns(p.new.Schedule))
+	}
+
+	if p.update != nil {
+		// replacement for "{{update}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{update}}",
+			fns(
+				p.update.ID,
+				p.update.ReportID,
+				p.update.Format,
+				p.update.Schedule,
+			),
+		)
+		pairs = append(pairs, "{{update.ID}}", fns(p.update.ID))
+		pairs = append(pairs, "{{update.reportID}}", fns(p.update.ReportID))
+		pairs = append(pairs, "{{update.format}}", fns(p.update.Format))
+		pairs = append(pairs, "{{update.schedule}}", fns(p.update.Schedule))
+	}
+
+	if p.delivery != nil {
+		// replacement for "{{delivery}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{delivery}}",
+			fns(
+				p.delivery.ID,
+				p.delivery.Status,
+			),
+		)
+		pairs = append(pairs, "{{delivery.ID}}", fns(p.delivery.ID))
+		pairs = append(pairs, "{{delivery.status}}", fns(p.delivery.Status))
+	}
+
+	if p.filter != nil {
+		// replacement for "{{filter}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{filter}}",
+			fns(),
+		)
+	}
+	return strings.NewReplacer(pairs...).Replace(in)
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action methods
+
+// String returns loggable description as string
+//
+// This function is auto-generated.
+func (a *reportSubscriptionAction) String() string {
+	var props = &reportSubscriptionActionProps{}
+
+	if a.props != nil {
+		props = a.props
+	}
+
+	return props.Format(a.log, nil)
+}
+
+func (e *reportSubscriptionAction) ToAction() *actionlog.Action {
+	return &actionlog.Action{
+		Resource:    e.resource,
+		Action:      e.action,
+		Severity:    e.severity,
+		Description: e.String(),
+		Meta:        e.props.Serialize(),
+	}
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action constructors
+
+// ReportSubscriptionActionSearch returns "system:report-subscription.search" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionSearch(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "search",
+		log:       "searched for report subscriptions",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionLookup returns "system:report-subscription.lookup" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionLookup(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "lookup",
+		log:       "looked-up for a {{subscription}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionCreate returns "system:report-subscription.create" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionCreate(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "create",
+		log:       "created {{subscription}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionUpdate returns "system:report-subscription.update" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionUpdate(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "update",
+		log:       "updated {{subscription}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionDelete returns "system:report-subscription.delete" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionDelete(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "delete",
+		log:       "deleted {{subscription}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionSearchDeliveries returns "system:report-subscription.searchDeliveries" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionSearchDeliveries(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "searchDeliveries",
+		log:       "searched for deliveries of {{subscription}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ReportSubscriptionActionDeliver returns "system:report-subscription.deliver" action
+//
+// This function is auto-generated.
+func ReportSubscriptionActionDeliver(props ...*reportSubscriptionActionProps) *reportSubscriptionAction {
+	a := &reportSubscriptionAction{
+		timestamp: time.Now(),
+		resource:  "system:report-subscription",
+		action:    "deliver",
+		log:       "delivered {{subscription}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Error constructors
+
+// ReportSubscriptionErrGeneric returns "system:report-subscription.generic" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrGeneric(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("failed to complete request due to internal error", nil),
+
+		errors.Meta("type", "generic"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(reportSubscriptionLogMetaKey{}, "{err}"),
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.generic"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNotFound returns "system:report-subscription.notFound" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNotFound(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("report subscription not found", nil),
+
+		errors.Meta("type", "notFound"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.notFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidID returns "system:report-subscription.invalidID" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidID(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid ID", nil),
+
+		errors.Meta("type", "invalidID"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidID"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidSchedule returns "system:report-subscription.invalidSchedule" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidSchedule(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid schedule, must be a valid crontab expression", nil),
+
+		errors.Meta("type", "invalidSchedule"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidSchedule"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidFormat returns "system:report-subscription.invalidFormat" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidFormat(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid format, must be one of csv, xlsx or pdf", nil),
+
+		errors.Meta("type", "invalidFormat"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidFormat"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidScenario returns "system:report-subscription.invalidScenario" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidScenario(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("scenario does not exist on the report", nil),
+
+		errors.Meta("type", "invalidScenario"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidScenario"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidTimezone returns "system:report-subscription.invalidTimezone" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidTimezone(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid timezone", nil),
+
+		errors.Meta("type", "invalidTimezone"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidTimezone"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNoRecipients returns "system:report-subscription.noRecipients" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNoRecipients(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("no recipients specified", nil),
+
+		errors.Meta("type", "noRecipients"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.noRecipients"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrInvalidRecipient returns "system:report-subscription.invalidRecipient" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrInvalidRecipient(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid recipient email address", nil),
+
+		errors.Meta("type", "invalidRecipient"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.invalidRecipient"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrOwnerNotFound returns "system:report-subscription.ownerNotFound" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrOwnerNotFound(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("report subscription owner not found", nil),
+
+		errors.Meta("type", "ownerNotFound"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.ownerNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNoFrames returns "system:report-subscription.noFrames" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNoFrames(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("report produced no frames to deliver", nil),
+
+		errors.Meta("type", "noFrames"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.noFrames"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrStaleData returns "system:report-subscription.staleData" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrStaleData(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("stale data", nil),
+
+		errors.Meta("type", "staleData"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.staleData"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNotAllowedToRead returns "system:report-subscription.notAllowedToRead" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNotAllowedToRead(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to read this report subscription", nil),
+
+		errors.Meta("type", "notAllowedToRead"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(reportSubscriptionLogMetaKey{}, "failed to read {{subscription}}; insufficient permissions"),
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.notAllowedToRead"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNotAllowedToManage returns "system:report-subscription.notAllowedToManage" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNotAllowedToManage(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to manage subscriptions of this report", nil),
+
+		errors.Meta("type", "notAllowedToManage"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(reportSubscriptionLogMetaKey{}, "failed to manage {{subscription}}; insufficient permissions"),
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.notAllowedToManage"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNotAllowedToRun returns "system:report-subscription.notAllowedToRun" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNotAllowedToRun(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to run this report", nil),
+
+		errors.Meta("type", "notAllowedToRun"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(reportSubscriptionLogMetaKey{}, "failed to run {{subscription}}; insufficient permissions"),
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.notAllowedToRun"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ReportSubscriptionErrNotAllowedToSetOwner returns "system:report-subscription.notAllowedToSetOwner" as *errors.Error
+//
+// This function is auto-generated.
+func ReportSubscriptionErrNotAllowedToSetOwner(mm ...*reportSubscriptionActionProps) *errors.Error {
+	var p = &reportSubscriptionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to set another user as report subscription owner", nil),
+
+		errors.Meta("type", "notAllowedToSetOwner"),
+		errors.Meta("resource", "system:report-subscription"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(reportSubscriptionLogMetaKey{}, "failed to set owner of {{subscription}}; insufficient permissions"),
+		errors.Meta(reportSubscriptionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "report-subscription.errors.notAllowedToSetOwner"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+
+// recordAction is a service helper function wraps function that can return error
+//
+// It will wrap unrecognized/internal errors with generic errors.
+//
+// This function is auto-generated.
+func (svc reportSubscription) recordAction(ctx context.Context, props *reportSubscriptionActionProps, actionFn func(...*reportSubscriptionActionProps) *reportSubscriptionAction, err error) error {
+	if svc.actionlog == nil || actionFn == nil {
+		// action log disabled or no action fn passed, return error as-is
+		return err
+	} else if err == nil {
+		// action completed w/o error, record it
+		svc.actionlog.Record(ctx, actionFn(props).ToAction())
+		return nil
+	}
+
+	a := actionFn(props).ToAction()
+
+	// Extracting error information and recording it as action
+	a.Error = err.Error()
+
+	switch c := err.(type) {
+	case *errors.Error:
+		m := c.Meta()
+
+		a.Error = err.Error()
+		a.Severity = actionlog.Severity(m.AsInt("severity"))
+		a.Description = props.Format(m.AsString(reportSubscriptionLogMetaKey{}), err)
+
+		if p, has := m[reportSubscriptionPropsMetaKey{}]; has {
+			a.Meta = p.(*reportSubscriptionActionProps).Serialize()
+		}
+
+		svc.actionlog.Record(ctx, a)
+	default:
+		svc.actionlog.Record(ctx, a)
+	}
+
+	// Original error is passed on
+	return err
+}
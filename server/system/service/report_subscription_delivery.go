@@ -0,0 +1,523 @@
+package service
+
+// part of report subscription service
+// collection of functions that run the subscribed reports,
+// render the results and send them to the recipients
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/handle"
+	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/cortezaproject/corteza/server/pkg/mail"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/pkg/sentry"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/renderer"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"go.uber.org/zap"
+)
+
+type (
+	// reportAttachment is a rendered file, attached to the delivery email
+	reportAttachment struct {
+		name string
+		body []byte
+	}
+)
+
+const (
+	// how often subscriptions are checked if they are due
+	reportSubscriptionInterval = time.Minute
+
+	// how long claims for subscription deliveries are kept
+	reportSubscriptionClaimTTL = time.Hour
+
+	// max length of the error stored on the delivery
+	reportDeliveryErrorMaxLength = 1024
+
+	// used to render PDF when subscription does not specify the template
+	reportSubscriptionDefaultTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<style>
+body { font-family: sans-serif; font-size: 10px; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 20px; }
+th, td { border: 1px solid #ccc; padding: 4px; text-align: left; }
+th { background: #eee; }
+</style>
+</head>
+<body>
+<h1>{{ .name }}</h1>
+{{ range .frames }}
+<h2>{{ if .Name }}{{ .Name }}{{ else }}{{ .Source }}{{ end }}</h2>
+<table>
+<thead><tr>{{ range .Columns }}<th>{{ if .Label }}{{ .Label }}{{ else }}{{ .Name }}{{ end }}</th>{{ end }}</tr></thead>
+<tbody>{{ range .Rows }}<tr>{{ range . }}<td>{{ . }}</td>{{ end }}</tr>{{ end }}</tbody>
+</table>
+{{ end }}
+</body>
+</html>`
+)
+
+// Watch periodically delivers subscriptions that are due
+func (svc *reportSubscription) Watch(ctx context.Context) {
+	ticker := time.NewTicker(reportSubscriptionInterval)
+
+	go func() {
+		defer sentry.Recover()
+		defer ticker.Stop()
+		defer svc.log.Info("stopped")
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := svc.deliverDue(ctx, *now()); err != nil {
+					svc.log.Error("failed to deliver report subscriptions", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	svc.log.Debug("watcher initialized")
+}
+
+// deliverDue delivers all enabled subscriptions that are due at the given time
+func (svc *reportSubscription) deliverDue(ctx context.Context, at time.Time) error {
+	set, _, err := store.SearchReportSubscriptions(ctx, svc.store, types.ReportSubscriptionFilter{
+		Deleted:  filter.StateExcluded,
+		Disabled: filter.StateExcluded,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return set.Walk(func(sub *types.ReportSubscription) error {
+		if !isReportSubscriptionDue(sub, at) {
+			return nil
+		}
+
+		// other instances might be delivering the same subscription
+		claimed, err := scheduler.Claims().Claim(ctx, reportSubscriptionJob(sub), reportSubscriptionClaimTTL)
+		if err != nil {
+			svc.log.Warn("failed to claim report subscription delivery",
+				logger.Uint64("subscriptionID", sub.ID),
+				zap.Error(err),
+			)
+			return nil
+		}
+
+		if !claimed {
+			return nil
+		}
+
+		d, err := svc.deliver(ctx, sub)
+		if err != nil {
+			// failing to record delivery is the only error
+			// that stops the rest of the subscriptions
+			return err
+		}
+
+		svc.log.Debug("report subscription delivered",
+			logger.Uint64("subscriptionID", sub.ID),
+			logger.Uint64("deliveryID", d.ID),
+			zap.String("status", string(d.Status)),
+		)
+
+		return nil
+	})
+}
+
+// deliver runs the report with owner's permissions, renders the frames
+// and sends them to the recipients
+//
+// Failures to run, render or send are recorded on the delivery and do not
+// result in an error
+func (svc *reportSubscription) deliver(ctx context.Context, sub *types.ReportSubscription) (d *types.ReportDelivery, err error) {
+	d = &types.ReportDelivery{
+		ID:             nextID(),
+		SubscriptionID: sub.ID,
+		ReportID:       sub.ReportID,
+		Format:         sub.Format,
+		Status:         types.ReportDeliveryRunning,
+		CreatedAt:      *now(),
+	}
+
+	if err = store.CreateReportDelivery(ctx, svc.store, d); err != nil {
+		return
+	}
+
+	// mark subscription as ran before the report is ran
+	// so that failures are not retried on the next tick
+	sub.LastRunAt = &d.CreatedAt
+	if err = store.UpdateReportSubscription(ctx, svc.store, sub); err != nil {
+		return
+	}
+
+	dErr := func() (err error) {
+		var (
+			r  *types.Report
+			ff []*reporting.Frame
+			aa []reportAttachment
+		)
+
+		if r, err = loadReport(ctx, svc.store, sub.ReportID); err != nil {
+			return
+		}
+
+		if d.Recipients, err = svc.recipients(ctx, sub.Recipients); err != nil {
+			return
+		}
+
+		if len(d.Recipients) == 0 {
+			return ReportSubscriptionErrNoRecipients()
+		}
+
+		ownerCtx, err := svc.ownerContext(ctx, sub)
+		if err != nil {
+			return
+		}
+
+		if ff, err = svc.reports.Run(ownerCtx, r.ID, reportSubscriptionFrameDefinitions(r, sub)); err != nil {
+			return
+		}
+
+		if len(ff) == 0 {
+			return ReportSubscriptionErrNoFrames()
+		}
+
+		if aa, err = svc.render(ownerCtx, r, sub, ff); err != nil {
+			return
+		}
+
+		return svc.sendAll(r, sub, d.Recipients, aa)
+	}()
+
+	d.Status = types.ReportDeliveryDelivered
+	if dErr != nil {
+		d.Status = types.ReportDeliveryFailed
+		d.Error = dErr.Error()
+		if len(d.Error) > reportDeliveryErrorMaxLength {
+			d.Error = d.Error[:reportDeliveryErrorMaxLength]
+		}
+	}
+
+	d.CompletedAt = now()
+	return d, store.UpdateReportDelivery(ctx, svc.store, d)
+}
+
+// ownerContext returns context with the owner of the subscription (and their roles)
+// as the identity so that the report is ran with their permissions
+func (svc *reportSubscription) ownerContext(ctx context.Context, sub *types.ReportSubscription) (context.Context, error) {
+	ownerID := sub.OwnedBy
+	if ownerID == 0 {
+		ownerID = sub.CreatedBy
+	}
+
+	u, err := store.LookupUserByID(ctx, svc.store, ownerID)
+	if errors.IsNotFound(err) || (u != nil && !u.Valid()) {
+		return nil, ReportSubscriptionErrOwnerNotFound()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rr, _, err := store.SearchRoles(ctx, svc.store, types.RoleFilter{MemberID: u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	u.SetRoles(rr.IDs()...)
+	return a.SetIdentityToContext(ctx, u), nil
+}
+
+// recipients resolves users and members of the roles to their emails
+//
+// Suspended and deleted users are skipped, duplicates are removed
+func (svc *reportSubscription) recipients(ctx context.Context, rr types.ReportSubscriptionRecipients) (out types.ReportDeliveryRecipients, err error) {
+	var (
+		uu    types.UserSet
+		aux   types.UserSet
+		index = make(map[string]bool)
+
+		add = func(email string) {
+			if email == "" || index[strings.ToLower(email)] {
+				return
+			}
+
+			index[strings.ToLower(email)] = true
+			out = append(out, email)
+		}
+	)
+
+	if len(rr.Users) > 0 {
+		if aux, _, err = store.SearchUsers(ctx, svc.store, types.UserFilter{UserID: rr.Users}); err != nil {
+			return
+		}
+
+		uu = append(uu, aux...)
+	}
+
+	if len(rr.Roles) > 0 {
+		if aux, _, err = store.SearchUsers(ctx, svc.store, types.UserFilter{RoleID: rr.Roles}); err != nil {
+			return
+		}
+
+		uu = append(uu, aux...)
+	}
+
+	for _, u := range uu {
+		if u.Valid() {
+			add(u.Email)
+		}
+	}
+
+	for _, e := range rr.Emails {
+		add(e)
+	}
+
+	return
+}
+
+// render encodes the frames in the format of the subscription
+//
+// CSV produces one file per frame, XLSX and PDF produce one file with all the frames
+func (svc *reportSubscription) render(ctx context.Context, r *types.Report, sub *types.ReportSubscription, ff []*reporting.Frame) (out []reportAttachment, err error) {
+	var (
+		name = reportFileName(r)
+		buf  = &bytes.Buffer{}
+	)
+
+	switch sub.Format {
+	case types.ReportSubscriptionFormatCSV:
+		for _, f := range ff {
+			buf = &bytes.Buffer{}
+			if err = reporting.Encode(reporting.NewCSVEncoder(buf), f); err != nil {
+				return
+			}
+
+			fName := f.Name
+			if fName == "" {
+				fName = f.Source
+			}
+
+			out = append(out, reportAttachment{
+				name: fmt.Sprintf("%s-%s.csv", name, fName),
+				body: buf.Bytes(),
+			})
+		}
+
+	case types.ReportSubscriptionFormatXLSX:
+		if err = reporting.Encode(reporting.NewXLSXEncoder(buf), ff...); err != nil {
+			return
+		}
+
+		out = append(out, reportAttachment{name: name + ".xlsx", body: buf.Bytes()})
+
+	case types.ReportSubscriptionFormatPDF:
+		var (
+			doc  io.ReadSeeker
+			vars = map[string]interface{}{
+				"name":         reportName(r),
+				"report":       r,
+				"subscription": sub,
+				"frames":       ff,
+			}
+		)
+
+		if sub.Meta != nil && sub.Meta.TemplateID > 0 {
+			doc, err = svc.templates.Render(ctx, sub.Meta.TemplateID, string(types.DocumentTypePDF), vars, nil)
+		} else {
+			doc, err = svc.renderer.Render(ctx, &renderer.RendererPayload{
+				Template:     strings.NewReader(reportSubscriptionDefaultTemplate),
+				TemplateType: types.DocumentTypeHTML,
+				TargetType:   types.DocumentTypePDF,
+				Variables:    vars,
+			})
+		}
+
+		if err != nil {
+			return
+		}
+
+		if _, err = io.Copy(buf, doc); err != nil {
+			return
+		}
+
+		out = append(out, reportAttachment{name: name + ".pdf", body: buf.Bytes()})
+
+	default:
+		return nil, ReportSubscriptionErrInvalidFormat()
+	}
+
+	return
+}
+
+// sendAll sends the attachments to each of the recipients separately
+// so the addresses are not disclosed to the other recipients
+func (svc *reportSubscription) sendAll(r *types.Report, sub *types.ReportSubscription, rr []string, aa []reportAttachment) error {
+	var (
+		failed = make([]string, 0, len(rr))
+		lErr   error
+	)
+
+	subject, body := reportSubscriptionMessage(r, sub)
+
+	for _, rcpt := range rr {
+		msg := mail.New()
+		msg.SetHeader("To", rcpt)
+		msg.SetHeader("Subject", subject)
+		msg.SetBody("text/plain", body)
+
+		for _, att := range aa {
+			msg.AttachReader(att.name, bytes.NewReader(att.body))
+		}
+
+		if err := svc.send(msg); err != nil {
+			failed = append(failed, rcpt)
+			lErr = err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to deliver to %s: %w", strings.Join(failed, ", "), lErr)
+	}
+
+	return nil
+}
+
+// isReportSubscriptionDue checks if the schedule matched since the last run
+// (or creation, when subscription was never ran)
+func isReportSubscriptionDue(sub *types.ReportSubscription, at time.Time) bool {
+	var (
+		ref = sub.CreatedAt
+		loc = time.UTC
+	)
+
+	if sub.LastRunAt != nil {
+		ref = *sub.LastRunAt
+	}
+
+	if sub.Meta != nil && sub.Meta.Timezone != "" {
+		if l, err := time.LoadLocation(sub.Meta.Timezone); err == nil {
+			loc = l
+		}
+	}
+
+	next, err := scheduler.NextInterval(ref.In(loc), sub.Schedule)
+	if err != nil {
+		return false
+	}
+
+	return !next.After(at)
+}
+
+// reportSubscriptionJob identifies next delivery of the subscription
+//
+// Delivery updates last run of the subscription so
+// the next one is claimed under a different job
+func reportSubscriptionJob(sub *types.ReportSubscription) string {
+	ref := sub.CreatedAt
+	if sub.LastRunAt != nil {
+		ref = *sub.LastRunAt
+	}
+
+	return fmt.Sprintf("report-subscription:%d@%d", sub.ID, ref.UnixNano())
+}
+
+// reportSubscriptionFrameDefinitions prepares definitions of the frames
+// included in the delivery, with the scenario filters applied
+//
+// Scenario filters are keyed by the data source name
+func reportSubscriptionFrameDefinitions(r *types.Report, sub *types.ReportSubscription) (out reporting.FrameDefinitionSet) {
+	var (
+		sources  []string
+		scenario *types.ReportScenario
+	)
+
+	if sub.Meta != nil {
+		sources = sub.Meta.Sources
+	}
+
+	if len(sources) == 0 {
+		for _, s := range r.Sources.ReportSteps() {
+			if n := s.Name(); n != "" {
+				sources = append(sources, n)
+			}
+		}
+	}
+
+	if sub.ScenarioID > 0 {
+		scenario = reportScenario(r, sub.ScenarioID)
+	}
+
+	for _, src := range sources {
+		def := &reporting.FrameDefinition{
+			Name:   src,
+			Source: src,
+		}
+
+		if scenario != nil {
+			if f, ok := scenario.Filters[src]; ok {
+				def.Filter = &f
+			}
+		}
+
+		out = append(out, def)
+	}
+
+	return
+}
+
+func reportSubscriptionMessage(r *types.Report, sub *types.ReportSubscription) (subject, body string) {
+	name := reportName(r)
+
+	subject, body = name, fmt.Sprintf("Results of the %q report are attached.", name)
+	if sub.Meta == nil {
+		return
+	}
+
+	if sub.Meta.Subject != "" {
+		subject = sub.Meta.Subject
+	}
+
+	if sub.Meta.Body != "" {
+		body = sub.Meta.Body
+	}
+
+	return
+}
+
+func reportName(r *types.Report) string {
+	switch {
+	case r.Meta != nil && r.Meta.Name != "":
+		return r.Meta.Name
+	case r.Handle != "":
+		return r.Handle
+	}
+
+	return strconv.FormatUint(r.ID, 10)
+}
+
+func reportFileName(r *types.Report) string {
+	if r.Handle != "" && handle.IsValid(r.Handle) {
+		return r.Handle
+	}
+
+	return "report-" + strconv.FormatUint(r.ID, 10)
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/ql"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/cortezaproject/corteza/server/system/reporting"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	gomail "gopkg.in/mail.v2"
+)
+
+type (
+	reportSubscriptionTestAC struct {
+		reportAccessController
+		canImpersonate bool
+	}
+
+	reportSubscriptionReaderTestAC struct {
+		reportSubscriptionAccessController
+		canUpdate bool
+	}
+)
+
+func (reportSubscriptionTestAC) CanUpdateReport(context.Context, *types.Report) bool { return true }
+
+func (ac reportSubscriptionTestAC) CanImpersonateUser(context.Context, *types.User) bool {
+	return ac.canImpersonate
+}
+
+func (reportSubscriptionReaderTestAC) CanReadReport(context.Context, *types.Report) bool { return true }
+
+func (ac reportSubscriptionReaderTestAC) CanUpdateReport(context.Context, *types.Report) bool {
+	return ac.canUpdate
+}
+
+func TestIsReportSubscriptionDue(t *testing.T) {
+	var (
+		created = time.Date(2022, 1, 3, 7, 0, 0, 0, time.UTC)
+		lastRun = time.Date(2022, 1, 3, 8, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name string
+		sub  *types.ReportSubscription
+		at   time.Time
+		due  bool
+	}{
+		{
+			name: "never ran, before the schedule",
+			sub:  &types.ReportSubscription{Schedule: "0 8 * * *", CreatedAt: created},
+			at:   created.Add(30 * time.Minute),
+			due:  false,
+		},
+		{
+			name: "never ran, after the schedule",
+			sub:  &types.ReportSubscription{Schedule: "0 8 * * *", CreatedAt: created},
+			at:   created.Add(time.Hour),
+			due:  true,
+		},
+		{
+			name: "ran today",
+			sub:  &types.ReportSubscription{Schedule: "0 8 * * *", CreatedAt: created, LastRunAt: &lastRun},
+			at:   lastRun.Add(12 * time.Hour),
+			due:  false,
+		},
+		{
+			name: "ran yesterday",
+			sub:  &types.ReportSubscription{Schedule: "0 8 * * *", CreatedAt: created, LastRunAt: &lastRun},
+			at:   lastRun.Add(24 * time.Hour),
+			due:  true,
+		},
+		{
+			name: "schedule in a different timezone",
+			sub: &types.ReportSubscription{
+				Schedule:  "0 8 * * *",
+				CreatedAt: created,
+				Meta:      &types.ReportSubscriptionMeta{Timezone: "America/New_York"},
+			},
+			// 8:00 UTC is 3:00 in New York
+			at:  created.Add(time.Hour),
+			due: false,
+		},
+		{
+			name: "invalid schedule",
+			sub:  &types.ReportSubscription{Schedule: "foo", CreatedAt: created},
+			at:   created.Add(24 * time.Hour),
+			due:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.due, isReportSubscriptionDue(tc.sub, tc.at))
+		})
+	}
+}
+
+func TestReportSubscriptionFrameDefinitions(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		r = &types.Report{
+			Sources: types.ReportDataSourceSet{
+				{Step: &types.ReportStep{Load: &types.ReportStepLoad{Name: "users"}}},
+				{Step: &types.ReportStep{Aggregate: &types.ReportStepAggregate{Name: "per_role"}}},
+			},
+			Scenarios: types.ReportScenarioSet{
+				{
+					ScenarioID: 10,
+					Filters: types.ScenarioFilterMap{
+						"users": types.ReportFilterExpr{ASTNode: &ql.ASTNode{Symbol: "active"}},
+					},
+				},
+			},
+		}
+	)
+
+	dd := reportSubscriptionFrameDefinitions(r, &types.ReportSubscription{})
+	req.Len(dd, 2)
+	req.Equal("users", dd[0].Source)
+	req.Equal("per_role", dd[1].Source)
+	req.Nil(dd[0].Filter)
+
+	dd = reportSubscriptionFrameDefinitions(r, &types.ReportSubscription{
+		ScenarioID: 10,
+		Meta:       &types.ReportSubscriptionMeta{Sources: []string{"users"}},
+	})
+	req.Len(dd, 1)
+	req.Equal("users", dd[0].Name)
+	req.NotNil(dd[0].Filter)
+	req.Equal("active", dd[0].Filter.Symbol)
+}
+
+func TestReportSubscriptionRenderAndSend(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		sent []*gomail.Message
+		svc  = &reportSubscription{
+			send: func(m *gomail.Message) error {
+				sent = append(sent, m)
+				return nil
+			},
+		}
+
+		r  = &types.Report{ID: 42, Handle: "sales", Meta: &types.ReportMeta{Name: "Sales"}}
+		ff = []*reporting.Frame{
+			{
+				Name:    "users",
+				Source:  "users",
+				Columns: reporting.FrameColumnSet{{Name: "name", Kind: "String"}},
+				Rows:    []reporting.FrameRow{{"Ana"}, {"Bob"}},
+			},
+			{
+				Name:    "roles",
+				Source:  "roles",
+				Columns: reporting.FrameColumnSet{{Name: "name", Kind: "String"}},
+				Rows:    []reporting.FrameRow{{"admins"}},
+			},
+		}
+	)
+
+	aa, err := svc.render(ctx, r, &types.ReportSubscription{Format: types.ReportSubscriptionFormatCSV}, ff)
+	req.NoError(err)
+	req.Len(aa, 2)
+	req.Equal("sales-users.csv", aa[0].name)
+	req.Equal("name\nAna\nBob\n", string(aa[0].body))
+
+	aa, err = svc.render(ctx, r, &types.ReportSubscription{Format: types.ReportSubscriptionFormatXLSX}, ff)
+	req.NoError(err)
+	req.Len(aa, 1)
+	req.Equal("sales.xlsx", aa[0].name)
+	req.NotEmpty(aa[0].body)
+
+	_, err = svc.render(ctx, r, &types.ReportSubscription{Format: "foo"}, ff)
+	req.Error(err)
+
+	sub := &types.ReportSubscription{Meta: &types.ReportSubscriptionMeta{Subject: "Weekly sales"}}
+	req.NoError(svc.sendAll(r, sub, []string{"a@example.tld", "b@example.tld"}, aa))
+	req.Len(sent, 2)
+	req.Equal([]string{"a@example.tld"}, sent[0].GetHeader("To"))
+	req.Equal([]string{"Weekly sales"}, sent[0].GetHeader("Subject"))
+
+	svc.send = func(m *gomail.Message) error { return errors.New("unavailable") }
+	err = svc.sendAll(r, sub, []string{"a@example.tld"}, aa)
+	req.Error(err)
+	req.Contains(err.Error(), "a@example.tld")
+}
+
+func TestReportSubscriptionOwner(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   store.Storer
+		err error
+
+		r       = &types.Report{ID: nextID(), Handle: "sales", CreatedAt: *now()}
+		invoker = &types.User{ID: nextID(), Email: "invoker@example.tld", CreatedAt: *now()}
+		admin   = &types.User{ID: nextID(), Email: "admin@example.tld", CreatedAt: *now()}
+
+		makeSvc = func(canImpersonate bool) *reportSubscription {
+			return &reportSubscription{
+				store: s,
+				ac:    reportSubscriptionTestAC{canImpersonate: canImpersonate},
+			}
+		}
+
+		makeSub = func(ownedBy uint64) *types.ReportSubscription {
+			return &types.ReportSubscription{
+				ReportID:   r.ID,
+				Schedule:   "0 8 * * *",
+				Format:     types.ReportSubscriptionFormatCSV,
+				Enabled:    true,
+				OwnedBy:    ownedBy,
+				Recipients: types.ReportSubscriptionRecipients{Emails: []string{"invoker@example.tld"}},
+			}
+		}
+	)
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.CreateReport(ctx, s, r))
+	req.NoError(store.CreateUser(ctx, s, invoker, admin))
+
+	ctx = a.SetIdentityToContext(ctx, invoker)
+
+	t.Run("current user is the owner by default", func(t *testing.T) {
+		req := require.New(t)
+		sub, err := makeSvc(false).Create(ctx, makeSub(0))
+		req.NoError(err)
+		req.Equal(invoker.ID, sub.OwnedBy)
+	})
+
+	t.Run("foreign owner is rejected", func(t *testing.T) {
+		req := require.New(t)
+		_, err := makeSvc(false).Create(ctx, makeSub(admin.ID))
+		req.Error(err)
+		req.True(errors.Is(err, ReportSubscriptionErrNotAllowedToSetOwner()))
+	})
+
+	t.Run("foreign owner is set on update", func(t *testing.T) {
+		req := require.New(t)
+		sub, err := makeSvc(false).Create(ctx, makeSub(invoker.ID))
+		req.NoError(err)
+
+		upd := makeSub(admin.ID)
+		upd.ID = sub.ID
+
+		_, err = makeSvc(false).Update(ctx, upd)
+		req.True(errors.Is(err, ReportSubscriptionErrNotAllowedToSetOwner()))
+
+		stored, err := store.LookupReportSubscriptionByID(ctx, s, sub.ID)
+		req.NoError(err)
+		req.Equal(invoker.ID, stored.OwnedBy)
+	})
+
+	t.Run("update re-owns the subscription", func(t *testing.T) {
+		req := require.New(t)
+		sub, err := makeSvc(true).Create(ctx, makeSub(admin.ID))
+		req.NoError(err)
+		req.Equal(admin.ID, sub.OwnedBy)
+
+		upd := makeSub(0)
+		upd.ID = sub.ID
+
+		sub, err = makeSvc(false).Update(ctx, upd)
+		req.NoError(err)
+		req.Equal(invoker.ID, sub.OwnedBy)
+	})
+
+	t.Run("foreign owner with impersonation", func(t *testing.T) {
+		req := require.New(t)
+		sub, err := makeSvc(true).Create(ctx, makeSub(admin.ID))
+		req.NoError(err)
+		req.Equal(admin.ID, sub.OwnedBy)
+	})
+}
+
+func TestReportSubscriptionRecipients(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   store.Storer
+		err error
+
+		r      = &types.Report{ID: nextID(), Handle: "recipients", CreatedAt: *now()}
+		owner  = &types.User{ID: nextID()}
+		reader = &types.User{ID: nextID()}
+
+		sub = &types.ReportSubscription{
+			ID:         nextID(),
+			ReportID:   r.ID,
+			Schedule:   "0 8 * * *",
+			Format:     types.ReportSubscriptionFormatCSV,
+			Enabled:    true,
+			OwnedBy:    owner.ID,
+			Recipients: types.ReportSubscriptionRecipients{Emails: []string{"recipient@example.tld"}},
+			CreatedAt:  *now(),
+		}
+
+		makeSvc = func(canUpdate bool) *reportSubscription {
+			return &reportSubscription{
+				store: s,
+				ac:    reportSubscriptionReaderTestAC{canUpdate: canUpdate},
+			}
+		}
+
+		recipients = func(ctx context.Context, svc *reportSubscription) [][]string {
+			var out [][]string
+
+			found, err := svc.FindByID(ctx, sub.ID)
+			req.NoError(err)
+			out = append(out, found.Recipients.Emails)
+
+			set, _, err := svc.Search(ctx, types.ReportSubscriptionFilter{ReportID: []string{strconv.FormatUint(r.ID, 10)}})
+			req.NoError(err)
+			req.Len(set, 1)
+			out = append(out, set[0].Recipients.Emails)
+
+			return out
+		}
+	)
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.CreateReport(ctx, s, r))
+	req.NoError(store.CreateReportSubscription(ctx, s, sub))
+
+	t.Run("report reader", func(t *testing.T) {
+		for _, ee := range recipients(a.SetIdentityToContext(ctx, reader), makeSvc(false)) {
+			require.Empty(t, ee)
+		}
+	})
+
+	t.Run("report editor", func(t *testing.T) {
+		for _, ee := range recipients(a.SetIdentityToContext(ctx, reader), makeSvc(true)) {
+			require.Equal(t, []string{"recipient@example.tld"}, ee)
+		}
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		for _, ee := range recipients(a.SetIdentityToContext(ctx, owner), makeSvc(false)) {
+			require.Equal(t, []string{"recipient@example.tld"}, ee)
+		}
+	})
+}
@@ -15,8 +15,10 @@ import (
     "github.com/cortezaproject/corteza/server/pkg/id"
     "github.com/cortezaproject/corteza/server/pkg/logger"
     "github.com/cortezaproject/corteza/server/pkg/objstore"
+    "github.com/cortezaproject/corteza/server/pkg/objstore/encrypted"
     "github.com/cortezaproject/corteza/server/pkg/objstore/minio"
     "github.com/cortezaproject/corteza/server/pkg/objstore/plain"
+    "github.com/cortezaproject/corteza/server/pkg/objstore/s3"
     "github.com/cortezaproject/corteza/server/pkg/options"
     "github.com/cortezaproject/corteza/server/pkg/rbac"
     "github.com/cortezaproject/corteza/server/pkg/valuestore"
@@ -42,6 +44,7 @@ type (
 		Limit      options.LimitOpt
 		Attachment options.AttachmentOpt
 		Webapps    options.WebappOpt
+		Webhook    options.WebhookOpt
 	}
 
 	eventDispatcher interface {
@@ -95,9 +98,11 @@ var (
 	DefaultApigwFilter         *apigwFilter
 	DefaultApigwProfiler       *apigwProfiler
 	DefaultReport              *report
+	DefaultReportSubscription  *reportSubscription
 	DefaultDataPrivacy         *dataPrivacy
 	DefaultSMTPChecker         *smtpConfigurationChecker
 	DefaultExpression          *expression
+	DefaultWebhook             *webhook
 
 	DefaultStatistics *statistics
 
@@ -159,6 +164,11 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 
 	DefaultAccessControl = AccessControl(s)
 
+	if rbac.Global() != nil {
+		// log rules removed after their validity window ended
+		rbac.Global().OnExpire(DefaultAccessControl.LogExpired)
+	}
+
 	DefaultSettings = Settings(ctx, DefaultStore, DefaultLogger, DefaultAccessControl, DefaultActionlog, CurrentSettings, c.Webapps)
     DefaultStylesheet = Stylesheet(sassTranspiler, log)
 
@@ -174,7 +184,27 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 			bucket string
 		)
 		const svcPath = "system"
-		if opt.MinioEndpoint != "" {
+		if opt.S3Endpoint != "" {
+			bucket = s3.GetBucket(opt.S3Bucket, svcPath)
+
+			DefaultObjectStore, err = s3.New(bucket, opt.S3PathPrefix, svcPath, s3.Options{
+				Endpoint:        opt.S3Endpoint,
+				Region:          opt.S3Region,
+				Secure:          opt.S3Secure,
+				Strict:          opt.S3Strict,
+				PathStyle:       opt.S3PathStyle,
+				AccessKeyID:     opt.S3AccessKey,
+				SecretAccessKey: opt.S3SecretKey,
+
+				PartSize:   int64(opt.S3PartSize) << 20,
+				PresignTTL: opt.PresignTtl,
+			})
+
+			log.Info("initializing s3",
+				zap.String("bucket", bucket),
+				zap.String("endpoint", opt.S3Endpoint),
+				zap.Error(err))
+		} else if opt.MinioEndpoint != "" {
 			bucket = minio.GetBucket(opt.MinioBucket, svcPath)
 
 			DefaultObjectStore, err = minio.New(bucket, opt.MinioPathPrefix, svcPath, minio.Options{
@@ -185,6 +215,8 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 				SecretAccessKey: opt.MinioSecretKey,
 
 				ServerSideEncryptKey: []byte(opt.MinioSSECKey),
+
+				PresignTTL: opt.PresignTtl,
 			})
 
 			log.Info("initializing minio",
@@ -199,6 +231,17 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 				zap.Error(err))
 		}
 
+		if err == nil && opt.EncryptionKeys != "" {
+			var keys [][]byte
+			if keys, err = encrypted.ParseKeys(opt.EncryptionKeys); err == nil {
+				DefaultObjectStore, err = encrypted.New(DefaultObjectStore, keys...)
+			}
+
+			log.Info("initializing encryption at rest",
+				zap.Int("keys", len(keys)),
+				zap.Error(err))
+		}
+
 		hcd.Add(objstore.Healthcheck(DefaultObjectStore), "ObjectStore/System")
 
 		if err != nil {
@@ -210,7 +253,7 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 	DefaultRenderer = Renderer(c.Template)
 	DefaultResourceTranslation = ResourceTranslation()
 	DefaultAuthNotification = AuthNotification(CurrentSettings, DefaultRenderer, c.Auth)
-	DefaultAuth = Auth(AuthOptions{LimitUsers: c.Limit.SystemUsers})
+	DefaultAuth = Auth(AuthOptions{LimitUsers: c.Limit.SystemUsers, BaseURL: c.Auth.BaseURL})
 	DefaultAuthClient = AuthClient(DefaultStore, DefaultAccessControl, DefaultActionlog, eventbus.Service(), c.Auth)
 	DefaultAttachment = Attachment(DefaultObjectStore, c.Attachment, DefaultLogger)
 	DefaultUser = User(UserOptions{LimitUsers: c.Limit.SystemUsers})
@@ -228,6 +271,9 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 	DefaultDataPrivacy = DataPrivacy(DefaultStore, DefaultAccessControl, DefaultActionlog, eventbus.Service())
 	DefaultSMTPChecker = SmtpConfigurationChecker(CurrentSettings, DefaultRenderer, DefaultAccessControl, c.Auth)
 	DefaultExpression = Expression()
+	DefaultWebhook = Webhook(DefaultLogger.Named("webhook"), c.Webhook)
+
+	DefaultReportSubscription = ReportSubscription(DefaultLogger.Named("report-subscription"), c.Template)
 
 	if err = initRoles(ctx, log.Named("rbac.roles"), c.RBAC, eventbus.Service(), rbac.Global()); err != nil {
 		return err
@@ -255,6 +301,11 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 		DefaultRenderer,
 	)
 
+	automation.ReportsHandler(
+		automationService.Registry(),
+		DefaultReport,
+	)
+
 	automation.RolesHandler(
 		automationService.Registry(),
 		DefaultRole,
@@ -304,6 +355,8 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 
 func Watchers(ctx context.Context) {
 	DefaultReminder.Watch(ctx)
+	DefaultWebhook.Watch(ctx)
+	DefaultReportSubscription.Watch(ctx)
 	return
 }
 
@@ -314,6 +367,12 @@ func Activate(ctx context.Context) (err error) {
 		return
 	}
 
+	// Subscribe webhooks to the events
+	err = DefaultWebhook.Reload(ctx)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	cmpTypes "github.com/cortezaproject/corteza/server/compose/types"
+	"github.com/cortezaproject/corteza/server/pkg/actionlog"
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/handle"
+	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"go.uber.org/zap"
+)
+
+type (
+	webhook struct {
+		actionlog actionlog.Recorder
+		store     store.Storer
+		ac        webhookAccessController
+		rbac      webhookRbacEvaluator
+		recordAC  webhookRecordAccessController
+		eventbus  webhookEventRegistry
+		log       *zap.Logger
+		opt       options.WebhookOpt
+		client    *http.Client
+
+		mux *sync.Mutex
+
+		// registered eventbus handlers for each webhook
+		reg map[uint64][]uintptr
+	}
+
+	webhookAccessController interface {
+		CanSearchWebhooks(context.Context) bool
+		CanCreateWebhook(context.Context) bool
+		CanReadWebhook(context.Context, *types.Webhook) bool
+		CanUpdateWebhook(context.Context, *types.Webhook) bool
+		CanDeleteWebhook(context.Context, *types.Webhook) bool
+	}
+
+	// evaluates access of the webhook owner to the event resources
+	webhookRbacEvaluator interface {
+		Can(rbac.Session, string, rbac.Resource) bool
+	}
+
+	// evaluates access of the webhook owner to the record values
+	//
+	// Set by compose service; identity is taken from the context
+	webhookRecordAccessController interface {
+		CanReadRecordValueOnModuleField(context.Context, *cmpTypes.ModuleField) bool
+	}
+
+	webhookEventRegistry interface {
+		Register(eventbus.HandlerFn, ...eventbus.HandlerRegOp) uintptr
+		Unregister(...uintptr)
+	}
+)
+
+const (
+	// length of the generated webhook secret (random bytes, hex encoded)
+	webhookSecretLength = 32
+)
+
+func Webhook(log *zap.Logger, opt options.WebhookOpt) *webhook {
+	return &webhook{
+		ac:        DefaultAccessControl,
+		rbac:      rbac.Global(),
+		actionlog: DefaultActionlog,
+		store:     DefaultStore,
+		eventbus:  eventbus.Service(),
+		log:       log,
+		opt:       opt,
+		client:    &http.Client{Timeout: opt.Timeout},
+		mux:       &sync.Mutex{},
+		reg:       make(map[uint64][]uintptr),
+	}
+}
+
+// SetRecordAccessController sets access controller used to
+// remove record values the webhook owner can not read
+func (svc *webhook) SetRecordAccessController(ac webhookRecordAccessController) {
+	svc.recordAC = ac
+}
+
+func (svc *webhook) FindByID(ctx context.Context, ID uint64) (wh *types.Webhook, err error) {
+	var (
+		whProps = &webhookActionProps{}
+	)
+
+	err = func() error {
+		if wh, err = loadWebhook(ctx, svc.store, ID); err != nil {
+			return err
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanReadWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToRead(whProps)
+		}
+
+		return nil
+	}()
+
+	return redactWebhook(wh), svc.recordAction(ctx, whProps, WebhookActionLookup, err)
+}
+
+func (svc *webhook) Search(ctx context.Context, filter types.WebhookFilter) (set types.WebhookSet, f types.WebhookFilter, err error) {
+	var (
+		whProps = &webhookActionProps{search: &filter}
+	)
+
+	// For each fetched item, store backend will check if it is valid or not
+	filter.Check = func(res *types.Webhook) (bool, error) {
+		if !svc.ac.CanReadWebhook(ctx, res) {
+			return false, nil
+		}
+
+		return true, nil
+	}
+
+	err = func() error {
+		if !svc.ac.CanSearchWebhooks(ctx) {
+			return WebhookErrNotAllowedToSearch()
+		}
+
+		if set, f, err = store.SearchWebhooks(ctx, svc.store, filter); err != nil {
+			return err
+		}
+
+		return set.Walk(func(wh *types.Webhook) error {
+			redactWebhook(wh)
+			return nil
+		})
+	}()
+
+	return set, f, svc.recordAction(ctx, whProps, WebhookActionSearch, err)
+}
+
+// Create stores new webhook and subscribes it to the events
+//
+// When secret is not set, a random one is generated; this is
+// the only time secret is returned
+func (svc *webhook) Create(ctx context.Context, new *types.Webhook) (wh *types.Webhook, err error) {
+	var (
+		whProps = &webhookActionProps{new: new}
+	)
+
+	err = func() (err error) {
+		if !svc.ac.CanCreateWebhook(ctx) {
+			return WebhookErrNotAllowedToCreate(whProps)
+		}
+
+		if err = svc.validate(ctx, new); err != nil {
+			return
+		}
+
+		if new.Secret == "" {
+			if new.Secret, err = makeWebhookSecret(); err != nil {
+				return
+			}
+		}
+
+		if new.Meta == nil {
+			new.Meta = &types.WebhookMeta{}
+		}
+
+		new.ID = nextID()
+		new.CreatedAt = *now()
+		new.CreatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.CreateWebhook(ctx, svc.store, new); err != nil {
+			return
+		}
+
+		wh = new
+		whProps.setWebhook(wh)
+
+		svc.register(wh)
+		return nil
+	}()
+
+	return wh, svc.recordAction(ctx, whProps, WebhookActionCreate, err)
+}
+
+// Update webhook and re-subscribe it to the events
+//
+// Secret is changed only when set
+func (svc *webhook) Update(ctx context.Context, upd *types.Webhook) (wh *types.Webhook, err error) {
+	var (
+		whProps = &webhookActionProps{update: upd}
+	)
+
+	err = func() (err error) {
+		if wh, err = loadWebhook(ctx, svc.store, upd.ID); err != nil {
+			return
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanUpdateWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToUpdate(whProps)
+		}
+
+		// Test if stale (update has an older version of data)
+		if isStale(upd.UpdatedAt, wh.UpdatedAt, wh.CreatedAt) {
+			return WebhookErrStaleData(whProps)
+		}
+
+		if err = svc.validate(ctx, upd); err != nil {
+			return
+		}
+
+		wh.Handle = upd.Handle
+		wh.URL = upd.URL
+		wh.Enabled = upd.Enabled
+		wh.Events = upd.Events
+
+		if upd.Meta != nil {
+			wh.Meta = upd.Meta
+		}
+
+		if upd.Secret != "" {
+			wh.Secret = upd.Secret
+		}
+
+		wh.UpdatedAt = now()
+		wh.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateWebhook(ctx, svc.store, wh); err != nil {
+			return
+		}
+
+		svc.register(wh)
+		return nil
+	}()
+
+	return redactWebhook(wh), svc.recordAction(ctx, whProps, WebhookActionUpdate, err)
+}
+
+func (svc *webhook) DeleteByID(ctx context.Context, ID uint64) (err error) {
+	var (
+		whProps = &webhookActionProps{}
+		wh      *types.Webhook
+	)
+
+	err = func() (err error) {
+		if wh, err = loadWebhook(ctx, svc.store, ID); err != nil {
+			return
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanDeleteWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToDelete(whProps)
+		}
+
+		wh.DeletedAt = now()
+		wh.DeletedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateWebhook(ctx, svc.store, wh); err != nil {
+			return
+		}
+
+		svc.unregister(wh.ID)
+		return nil
+	}()
+
+	return svc.recordAction(ctx, whProps, WebhookActionDelete, err)
+}
+
+func (svc *webhook) UndeleteByID(ctx context.Context, ID uint64) (err error) {
+	var (
+		whProps = &webhookActionProps{}
+		wh      *types.Webhook
+	)
+
+	err = func() (err error) {
+		if wh, err = loadWebhook(ctx, svc.store, ID); err != nil {
+			return
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanDeleteWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToUndelete(whProps)
+		}
+
+		if err = svc.uniqueCheck(ctx, wh); err != nil {
+			return
+		}
+
+		wh.DeletedAt = nil
+		wh.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateWebhook(ctx, svc.store, wh); err != nil {
+			return
+		}
+
+		svc.register(wh)
+		return nil
+	}()
+
+	return svc.recordAction(ctx, whProps, WebhookActionUndelete, err)
+}
+
+// SearchDeliveries returns delivery log of the webhook
+func (svc *webhook) SearchDeliveries(ctx context.Context, webhookID uint64, filter types.WebhookDeliveryFilter) (set types.WebhookDeliverySet, f types.WebhookDeliveryFilter, err error) {
+	var (
+		whProps = &webhookActionProps{}
+		wh      *types.Webhook
+	)
+
+	err = func() (err error) {
+		if wh, err = loadWebhook(ctx, svc.store, webhookID); err != nil {
+			return
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanReadWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToRead(whProps)
+		}
+
+		filter.WebhookID = []string{strconv.FormatUint(wh.ID, 10)}
+
+		if len(filter.Sort) == 0 {
+			if err = filter.Sort.Set("createdAt DESC"); err != nil {
+				return
+			}
+		}
+
+		set, f, err = store.SearchWebhookDeliverys(ctx, svc.store, filter)
+		return
+	}()
+
+	return set, f, svc.recordAction(ctx, whProps, WebhookActionSearchDeliveries, err)
+}
+
+// Redeliver resets delivery and sends it again
+//
+// Works for deliveries in any state, dead deliveries get
+// full set of attempts again
+func (svc *webhook) Redeliver(ctx context.Context, webhookID, deliveryID uint64) (d *types.WebhookDelivery, err error) {
+	var (
+		whProps = &webhookActionProps{}
+		wh      *types.Webhook
+	)
+
+	err = func() (err error) {
+		if wh, err = loadWebhook(ctx, svc.store, webhookID); err != nil {
+			return
+		}
+
+		whProps.setWebhook(wh)
+
+		if !svc.ac.CanUpdateWebhook(ctx, wh) {
+			return WebhookErrNotAllowedToUpdate(whProps)
+		}
+
+		if d, err = store.LookupWebhookDeliveryByID(ctx, svc.store, deliveryID); errors.IsNotFound(err) || (err == nil && d.WebhookID != wh.ID) {
+			return WebhookErrDeliveryNotFound(whProps)
+		} else if err != nil {
+			return
+		}
+
+		whProps.setDelivery(d)
+
+		d.Status = types.WebhookDeliveryPending
+		d.Attempts = 0
+
+		// request context might get canceled
+		// before the delivery log is updated
+		return svc.attempt(context.Background(), wh, d)
+	}()
+
+	return d, svc.recordAction(ctx, whProps, WebhookActionRedeliver, err)
+}
+
+func (svc *webhook) validate(ctx context.Context, wh *types.Webhook) error {
+	if !handle.IsValid(wh.Handle) {
+		return WebhookErrInvalidHandle()
+	}
+
+	if u, err := url.Parse(wh.URL); err != nil || !u.IsAbs() || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return WebhookErrInvalidURL()
+	}
+
+	for _, e := range wh.Events {
+		if e == nil || e.ResourceType == "" || len(e.EventTypes) == 0 {
+			return WebhookErrInvalidEvent()
+		}
+
+		for _, et := range e.EventTypes {
+			// only after-events are dispatched asynchronously
+			// and can not be affected by the delivery
+			if !strings.HasPrefix(et, "after") {
+				return WebhookErrInvalidEvent()
+			}
+		}
+
+		for _, c := range e.Constraints {
+			if _, err := eventbus.ConstraintMaker(c.Name, c.Op, c.Values...); err != nil {
+				return WebhookErrInvalidEvent().Wrap(err)
+			}
+		}
+	}
+
+	return svc.uniqueCheck(ctx, wh)
+}
+
+func (svc *webhook) uniqueCheck(ctx context.Context, wh *types.Webhook) error {
+	if wh.Handle != "" {
+		if ex, _ := store.LookupWebhookByHandle(ctx, svc.store, wh.Handle); ex != nil && ex.ID > 0 && ex.ID != wh.ID {
+			return WebhookErrHandleNotUnique()
+		}
+	}
+
+	return nil
+}
+
+func loadWebhook(ctx context.Context, s store.Webhooks, ID uint64) (res *types.Webhook, err error) {
+	if ID == 0 {
+		return nil, WebhookErrInvalidID()
+	}
+
+	if res, err = store.LookupWebhookByID(ctx, s, ID); errors.IsNotFound(err) {
+		return nil, WebhookErrNotFound()
+	}
+
+	return
+}
+
+// removes secret from the webhook before it is returned
+func redactWebhook(wh *types.Webhook) *types.Webhook {
+	if wh != nil {
+		wh.Secret = ""
+	}
+
+	return wh
+}
+
+func makeWebhookSecret() (string, error) {
+	var raw [webhookSecretLength]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw[:]), nil
+}
@@ -0,0 +1,952 @@
+package service
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// system/service/webhook_actions.yaml
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortezaproject/corteza/server/pkg/actionlog"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/locale"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"strings"
+	"time"
+)
+
+type (
+	webhookActionProps struct {
+		webhook  *types.Webhook
+		new      *types.Webhook
+		update   *types.Webhook
+		delivery *types.WebhookDelivery
+		search   *types.WebhookFilter
+	}
+
+	webhookAction struct {
+		timestamp time.Time
+		resource  string
+		action    string
+		log       string
+		severity  actionlog.Severity
+
+		// prefix for error when action fails
+		errorMessage string
+
+		props *webhookActionProps
+	}
+
+	webhookLogMetaKey   struct{}
+	webhookPropsMetaKey struct{}
+)
+
+var (
+	// just a placeholder to cover template cases w/o fmt package use
+	_ = fmt.Println
+)
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Props methods
+// setWebhook updates webhookActionProps's webhook
+//
+// This function is auto-generated.
+func (p *webhookActionProps) setWebhook(webhook *types.Webhook) *webhookActionProps {
+	p.webhook = webhook
+	return p
+}
+
+// setNew updates webhookActionProps's new
+//
+// This function is auto-generated.
+func (p *webhookActionProps) setNew(new *types.Webhook) *webhookActionProps {
+	p.new = new
+	return p
+}
+
+// setUpdate updates webhookActionProps's update
+//
+// This function is auto-generated.
+func (p *webhookActionProps) setUpdate(update *types.Webhook) *webhookActionProps {
+	p.update = update
+	return p
+}
+
+// setDelivery updates webhookActionProps's delivery
+//
+// This function is auto-generated.
+func (p *webhookActionProps) setDelivery(delivery *types.WebhookDelivery) *webhookActionProps {
+	p.delivery = delivery
+	return p
+}
+
+// setSearch updates webhookActionProps's search
+//
+// This function is auto-generated.
+func (p *webhookActionProps) setSearch(search *types.WebhookFilter) *webhookActionProps {
+	p.search = search
+	return p
+}
+
+// Serialize converts webhookActionProps to actionlog.Meta
+//
+// This function is auto-generated.
+func (p webhookActionProps) Serialize() actionlog.Meta {
+	var (
+		m = make(actionlog.Meta)
+	)
+
+	if p.webhook != nil {
+		m.Set("webhook.handle", p.webhook.Handle, true)
+		m.Set("webhook.URL", p.webhook.URL, true)
+		m.Set("webhook.ID", p.webhook.ID, true)
+	}
+	if p.new != nil {
+		m.Set("new.handle", p.new.Handle, true)
+		m.Set("new.URL", p.new.URL, true)
+	}
+	if p.update != nil {
+		m.Set("update.handle", p.update.Handle, true)
+		m.Set("update.URL", p.update.URL, true)
+		m.Set("update.ID", p.update.ID, true)
+	}
+	if p.delivery != nil {
+		m.Set("delivery.ID", p.delivery.ID, true)
+		m.Set("delivery.resourceType", p.delivery.ResourceType, true)
+		m.Set("delivery.eventType", p.delivery.EventType, true)
+		m.Set("delivery.status", p.delivery.Status, true)
+	}
+	if p.search != nil {
+	}
+
+	return m
+}
+
+// tr translates string and replaces meta value placeholder with values
+//
+// This function is auto-generated.
+func (p webhookActionProps) Format(in string, err error) string {
+	var (
+		pairs = []string{"{{err}}"}
+		// first non-empty string
+		fns = func(ii ...interface{}) string {
+			for _, i := range ii {
+				if s := fmt.Sprintf("%v", i); len(s) > 0 {
+					return s
+				}
+			}
+
+			return ""
+		}
+	)
+
+	if err != nil {
+		pairs = append(pairs, err.Error())
+	} else {
+		pairs = append(pairs, "nil")
+	}
+
+	if p.webhook != nil {
+		// replacement for "{{webhook}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{webhook}}",
+			fns(
+				p.webhook.Handle,
+				p.webhook.URL,
+				p.webhook.ID,
+			),
+		)
+		pairs = append(pairs, "{{webhook.handle}}", fns(p.webhook.Handle))
+		pairs = append(pairs, "{{webhook.URL}}", fns(p.webhook.URL))
+		pairs = append(pairs, "{{webhook.ID}}", fns(p.webhook.ID))
+	}
+
+	if p.new != nil {
+		// replacement for "{{new}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{new}}",
+			fns(
+				p.new.Handle,
+				p.new.URL,
+			),
+		)
+		pairs = append(pairs, "{{new.handle}}", fns(p.new.Handle))
+		pairs = append(pairs, "{{new.URL}}", fns(p.new.URL))
+	}
+
+	if p.update != nil {
+		// replacement for "{{update}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{update}}",
+			fns(
+				p.update.Handle,
+				p.update.URL,
+				p.update.ID,
+			),
+		)
+		pairs = append(pairs, "{{update.handle}}", fns(p.update.Handle))
+		pairs = append(pairs, "{{update.URL}}", fns(p.update.URL))
+		pairs = append(pairs, "{{update.ID}}", fns(p.update.ID))
+	}
+
+	if p.delivery != nil {
+		// replacement for "{{delivery}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{delivery}}",
+			fns(
+				p.delivery.ID,
+				p.delivery.ResourceType,
+				p.delivery.EventType,
+				p.delivery.Status,
+			),
+		)
+		pairs = append(pairs, "{{delivery.ID}}", fns(p.delivery.ID))
+		pairs = append(pairs, "{{delivery.resourceType}}", fns(p.delivery.ResourceType))
+		pairs = append(pairs, "{{delivery.eventType}}", fns(p.delivery.EventType))
+		pairs = append(pairs, "{{delivery.status}}", fns(p.delivery.Status))
+	}
+
+	if p.search != nil {
+		// replacement for "{{search}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{search}}",
+			fns(),
+		)
+	}
+	return strings.NewReplacer(pairs...).Replace(in)
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action methods
+
+// String returns loggable description as string
+//
+// This function is auto-generated.
+func (a *webhookAction) String() string {
+	var props = &webhookActionProps{}
+
+	if a.props != nil {
+		props = a.props
+	}
+
+	return props.Format(a.log, nil)
+}
+
+func (e *webhookAction) ToAction() *actionlog.Action {
+	return &actionlog.Action{
+		Resource:    e.resource,
+		Action:      e.action,
+		Severity:    e.severity,
+		Description: e.String(),
+		Meta:        e.props.Serialize(),
+	}
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action constructors
+
+// WebhookActionSearch returns "system:webhook.search" action
+//
+// This function is auto-generated.
+func WebhookActionSearch(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "search",
+		log:       "searched for webhooks",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionLookup returns "system:webhook.lookup" action
+//
+// This function is auto-generated.
+func WebhookActionLookup(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "lookup",
+		log:       "looked-up for a {{webhook}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionCreate returns "system:webhook.create" action
+//
+// This function is auto-generated.
+func WebhookActionCreate(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "create",
+		log:       "created {{webhook}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionUpdate returns "system:webhook.update" action
+//
+// This function is auto-generated.
+func WebhookActionUpdate(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "update",
+		log:       "updated {{webhook}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionDelete returns "system:webhook.delete" action
+//
+// This function is auto-generated.
+func WebhookActionDelete(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "delete",
+		log:       "deleted {{webhook}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionUndelete returns "system:webhook.undelete" action
+//
+// This function is auto-generated.
+func WebhookActionUndelete(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "undelete",
+		log:       "undeleted {{webhook}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionSearchDeliveries returns "system:webhook.searchDeliveries" action
+//
+// This function is auto-generated.
+func WebhookActionSearchDeliveries(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "searchDeliveries",
+		log:       "searched for deliveries of {{webhook}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WebhookActionRedeliver returns "system:webhook.redeliver" action
+//
+// This function is auto-generated.
+func WebhookActionRedeliver(props ...*webhookActionProps) *webhookAction {
+	a := &webhookAction{
+		timestamp: time.Now(),
+		resource:  "system:webhook",
+		action:    "redeliver",
+		log:       "redelivered {{delivery}} to {{webhook}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Error constructors
+
+// WebhookErrGeneric returns "system:webhook.generic" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrGeneric(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("failed to complete request due to internal error", nil),
+
+		errors.Meta("type", "generic"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "{err}"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.generic"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotFound returns "system:webhook.notFound" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotFound(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("webhook not found", nil),
+
+		errors.Meta("type", "notFound"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrInvalidID returns "system:webhook.invalidID" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrInvalidID(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid ID", nil),
+
+		errors.Meta("type", "invalidID"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.invalidID"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrInvalidHandle returns "system:webhook.invalidHandle" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrInvalidHandle(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid handle", nil),
+
+		errors.Meta("type", "invalidHandle"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.invalidHandle"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrInvalidURL returns "system:webhook.invalidURL" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrInvalidURL(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid URL, must be an absolute http or https URL", nil),
+
+		errors.Meta("type", "invalidURL"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.invalidURL"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrInvalidEvent returns "system:webhook.invalidEvent" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrInvalidEvent(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid event, only after events with resource and event type can be delivered", nil),
+
+		errors.Meta("type", "invalidEvent"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.invalidEvent"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrHandleNotUnique returns "system:webhook.handleNotUnique" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrHandleNotUnique(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("webhook handle not unique", nil),
+
+		errors.Meta("type", "handleNotUnique"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.handleNotUnique"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrStaleData returns "system:webhook.staleData" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrStaleData(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("stale data", nil),
+
+		errors.Meta("type", "staleData"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.staleData"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrDeliveryNotFound returns "system:webhook.deliveryNotFound" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrDeliveryNotFound(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("delivery not found", nil),
+
+		errors.Meta("type", "deliveryNotFound"),
+		errors.Meta("resource", "system:webhook"),
+
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.deliveryNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToCreate returns "system:webhook.notAllowedToCreate" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToCreate(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to create a webhook", nil),
+
+		errors.Meta("type", "notAllowedToCreate"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to create a webhook; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToCreate"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToRead returns "system:webhook.notAllowedToRead" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToRead(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to read this webhook", nil),
+
+		errors.Meta("type", "notAllowedToRead"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to read {{webhook.handle}}; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToRead"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToSearch returns "system:webhook.notAllowedToSearch" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToSearch(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to list or search webhooks", nil),
+
+		errors.Meta("type", "notAllowedToSearch"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to search for webhooks; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToSearch"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToUpdate returns "system:webhook.notAllowedToUpdate" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToUpdate(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to update this webhook", nil),
+
+		errors.Meta("type", "notAllowedToUpdate"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to update {{webhook.handle}}; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToUpdate"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToDelete returns "system:webhook.notAllowedToDelete" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToDelete(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to delete this webhook", nil),
+
+		errors.Meta("type", "notAllowedToDelete"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to delete {{webhook.handle}}; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToDelete"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WebhookErrNotAllowedToUndelete returns "system:webhook.notAllowedToUndelete" as *errors.Error
+//
+// This function is auto-generated.
+func WebhookErrNotAllowedToUndelete(mm ...*webhookActionProps) *errors.Error {
+	var p = &webhookActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to undelete this webhook", nil),
+
+		errors.Meta("type", "notAllowedToUndelete"),
+		errors.Meta("resource", "system:webhook"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(webhookLogMetaKey{}, "failed to undelete {{webhook.handle}}; insufficient permissions"),
+		errors.Meta(webhookPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "webhook.errors.notAllowedToUndelete"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+
+// recordAction is a service helper function wraps function that can return error
+//
+// It will wrap unrecognized/internal errors with generic errors.
+//
+// This function is auto-generated.
+func (svc webhook) recordAction(ctx context.Context, props *webhookActionProps, actionFn func(...*webhookActionProps) *webhookAction, err error) error {
+	if svc.actionlog == nil || actionFn == nil {
+		// action log disabled or no action fn passed, return error as-is
+		return err
+	} else if err == nil {
+		// action completed w/o error, record it
+		svc.actionlog.Record(ctx, actionFn(props).ToAction())
+		return nil
+	}
+
+	a := actionFn(props).ToAction()
+
+	// Extracting error information and recording it as action
+	a.Error = err.Error()
+
+	switch c := err.(type) {
+	case *errors.Error:
+		m := c.Meta()
+
+		a.Error = err.Error()
+		a.Severity = actionlog.Severity(m.AsInt("severity"))
+		a.Description = props.Format(m.AsString(webhookLogMetaKey{}), err)
+
+		if p, has := m[webhookPropsMetaKey{}]; has {
+			a.Meta = p.(*webhookActionProps).Serialize()
+		}
+
+		svc.actionlog.Record(ctx, a)
+	default:
+		svc.actionlog.Record(ctx, a)
+	}
+
+	// Original error is passed on
+	return err
+}
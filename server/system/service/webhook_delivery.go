@@ -0,0 +1,549 @@
+package service
+
+// part of webhook service
+// collection of functions that subscribe webhooks to the events,
+// deliver them and retry failed deliveries
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	cmpTypes "github.com/cortezaproject/corteza/server/compose/types"
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/errors"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/logger"
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+	"github.com/cortezaproject/corteza/server/pkg/scheduler"
+	"github.com/cortezaproject/corteza/server/pkg/sentry"
+	"github.com/cortezaproject/corteza/server/pkg/version"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"go.uber.org/zap"
+)
+
+type (
+	// webhookPayload is sent as JSON body of each delivery
+	webhookPayload struct {
+		DeliveryID   uint64                     `json:"deliveryID,string"`
+		WebhookID    uint64                     `json:"webhookID,string"`
+		ResourceType string                     `json:"resourceType"`
+		EventType    string                     `json:"eventType"`
+		Timestamp    time.Time                  `json:"timestamp"`
+		Data         map[string]json.RawMessage `json:"data"`
+	}
+
+	// events that can encode their arguments (record, module, ...)
+	webhookEventEncoder interface {
+		Encode() (map[string][]byte, error)
+	}
+)
+
+const (
+	WebhookHeaderID        = "X-Corteza-Webhook-ID"
+	WebhookHeaderDelivery  = "X-Corteza-Delivery-ID"
+	WebhookHeaderEvent     = "X-Corteza-Event"
+	WebhookHeaderTimestamp = "X-Corteza-Timestamp"
+	WebhookHeaderSignature = "X-Corteza-Signature"
+
+	// max number of pending deliveries retried at once
+	webhookRetryBatch = 100
+
+	// how long claims for retried deliveries are kept
+	webhookRetryClaimTTL = time.Hour
+
+	// max length of the error stored on the delivery
+	webhookErrorMaxLength = 1024
+)
+
+// Reload subscribes all enabled webhooks to their events
+func (svc *webhook) Reload(ctx context.Context) error {
+	if !svc.opt.Enabled {
+		return nil
+	}
+
+	set, _, err := store.SearchWebhooks(ctx, svc.store, types.WebhookFilter{
+		Deleted:  filter.StateExcluded,
+		Disabled: filter.StateExcluded,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return set.Walk(func(wh *types.Webhook) error {
+		svc.register(wh)
+		return nil
+	})
+}
+
+// Watch periodically retries pending deliveries
+func (svc *webhook) Watch(ctx context.Context) {
+	if !svc.opt.Enabled {
+		return
+	}
+
+	ticker := time.NewTicker(svc.opt.RetryInterval)
+
+	go func() {
+		defer sentry.Recover()
+		defer ticker.Stop()
+		defer svc.log.Info("stopped")
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := svc.retry(ctx); err != nil {
+					svc.log.Error("failed to retry webhook deliveries", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	svc.log.Debug("watcher initialized")
+}
+
+// register (or re-register) eventbus handlers for the webhook
+//
+// Disabled and deleted webhooks are only unregistered
+func (svc *webhook) register(wh *types.Webhook) {
+	svc.unregister(wh.ID)
+
+	if !svc.opt.Enabled || !wh.Enabled || wh.DeletedAt != nil {
+		return
+	}
+
+	defer svc.mux.Unlock()
+	svc.mux.Lock()
+
+	// handler keeps its own copy (with the secret)
+	var (
+		c   = *wh
+		log = svc.log.With(logger.Uint64("webhookID", wh.ID))
+	)
+
+	for _, e := range wh.Events {
+		var (
+			cnstr eventbus.ConstraintMatcher
+			err   error
+			ops   = []eventbus.HandlerRegOp{
+				eventbus.For(e.ResourceType),
+				eventbus.On(e.EventTypes...),
+			}
+		)
+
+		for _, ec := range e.Constraints {
+			if cnstr, err = eventbus.ConstraintMaker(ec.Name, ec.Op, ec.Values...); err != nil {
+				log.Debug("failed to make constraint for webhook", zap.Any("constraint", ec), zap.Error(err))
+				continue
+			}
+
+			ops = append(ops, eventbus.Constraint(cnstr))
+		}
+
+		svc.reg[wh.ID] = append(svc.reg[wh.ID], svc.eventbus.Register(svc.handler(&c), ops...))
+
+		log.Debug("webhook registered",
+			zap.String("resourceType", e.ResourceType),
+			zap.Strings("eventTypes", e.EventTypes),
+		)
+	}
+}
+
+func (svc *webhook) unregister(webhookID uint64) {
+	defer svc.mux.Unlock()
+	svc.mux.Lock()
+
+	if ptrs, has := svc.reg[webhookID]; has {
+		svc.eventbus.Unregister(ptrs...)
+		delete(svc.reg, webhookID)
+	}
+}
+
+// handler creates delivery for the event and makes the first attempt
+//
+// Delivery is evaluated with permissions of the webhook owner; events
+// for resources the owner can not read are not delivered
+func (svc *webhook) handler(wh *types.Webhook) eventbus.HandlerFn {
+	return func(_ context.Context, ev eventbus.Event) (err error) {
+		var (
+			d   *types.WebhookDelivery
+			ses rbac.Session
+
+			// events are dispatched asynchronously, request
+			// context can be canceled before the delivery is done
+			ctx = context.Background()
+
+			log = svc.log.With(
+				logger.Uint64("webhookID", wh.ID),
+				zap.String("resourceType", ev.ResourceType()),
+				zap.String("eventType", ev.EventType()),
+			)
+		)
+
+		if ses, err = svc.ownerSession(ctx, wh); err != nil {
+			log.Error("failed to load webhook owner", zap.Error(err))
+			return
+		}
+
+		if ses == nil {
+			log.Debug("webhook owner is missing, suspended or deleted, skipping delivery")
+			return
+		}
+
+		if d, err = svc.enqueue(ctx, wh, ev, ses); err != nil {
+			log.Error("failed to create webhook delivery", zap.Error(err))
+			return
+		}
+
+		if d == nil {
+			log.Debug("webhook owner is not allowed to read the resource, skipping delivery")
+			return
+		}
+
+		return svc.attempt(ctx, wh, d)
+	}
+}
+
+// ownerSession returns RBAC session of the webhook owner (the user who created it)
+//
+// Session context holds owner's identity so that it can be used
+// with access controllers that read the identity from the context
+//
+// Nil session is returned when the owner does not exist or is not valid
+func (svc *webhook) ownerSession(ctx context.Context, wh *types.Webhook) (rbac.Session, error) {
+	u, err := store.LookupUserByID(ctx, svc.store, wh.CreatedBy)
+	if errors.IsNotFound(err) || (u != nil && !u.Valid()) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rr, _, err := store.SearchRoles(ctx, svc.store, types.RoleFilter{MemberID: u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	u.SetRoles(rr.IDs()...)
+	return rbac.NewSession(a.SetIdentityToContext(ctx, u), u), nil
+}
+
+// enqueue stores a new pending delivery for the event
+//
+// Nil delivery is returned when the owner can not read the event resource
+func (svc *webhook) enqueue(ctx context.Context, wh *types.Webhook, ev eventbus.Event, ses rbac.Session) (d *types.WebhookDelivery, err error) {
+	d = &types.WebhookDelivery{
+		ID:           nextID(),
+		WebhookID:    wh.ID,
+		ResourceType: ev.ResourceType(),
+		EventType:    ev.EventType(),
+		Status:       types.WebhookDeliveryPending,
+		CreatedAt:    *now(),
+	}
+
+	canRead := func(res rbac.Resource) bool {
+		return svc.rbac.Can(ses, "read", res)
+	}
+
+	// same check as when records are read; without
+	// access controller no record values are delivered
+	canReadValue := func(f *cmpTypes.ModuleField) bool {
+		return svc.recordAC != nil && svc.recordAC.CanReadRecordValueOnModuleField(ses.Context(), f)
+	}
+
+	if d.Payload, err = makeWebhookPayload(d, ev, canRead, canReadValue); err != nil || d.Payload == nil {
+		return nil, err
+	}
+
+	// first attempt is made right away; retry watcher
+	// should not pick the delivery before the attempt times out
+	next := d.CreatedAt.Add(svc.opt.Timeout)
+	d.NextAttemptAt = &next
+
+	return d, store.CreateWebhookDelivery(ctx, svc.store, d)
+}
+
+// retry attempts all pending deliveries that are due
+func (svc *webhook) retry(ctx context.Context) error {
+	set, _, err := store.SearchWebhookDeliverys(ctx, svc.store, types.WebhookDeliveryFilter{
+		Status: []types.WebhookDeliveryStatus{types.WebhookDeliveryPending},
+		DueAt:  now(),
+		Sorting: filter.Sorting{Sort: filter.SortExprSet{
+			&filter.SortExpr{Column: "next_attempt_at"},
+		}},
+		Paging: filter.Paging{Limit: webhookRetryBatch},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	var (
+		// webhooks are loaded once per batch
+		hooks = make(map[uint64]*types.Webhook)
+	)
+
+	return set.Walk(func(d *types.WebhookDelivery) error {
+		wh, has := hooks[d.WebhookID]
+		if !has {
+			if wh, err = store.LookupWebhookByID(ctx, svc.store, d.WebhookID); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+
+			hooks[d.WebhookID] = wh
+		}
+
+		if wh == nil || !wh.Enabled || wh.DeletedAt != nil {
+			// can be redelivered manually when webhook is enabled again
+			d.Status = types.WebhookDeliveryDead
+			d.Error = "webhook is disabled or deleted"
+			d.NextAttemptAt = nil
+			d.UpdatedAt = now()
+			return store.UpdateWebhookDelivery(ctx, svc.store, d)
+		}
+
+		// other instances might be retrying the same delivery
+		job := fmt.Sprintf("webhook-delivery:%d@%d", d.ID, d.Attempts)
+		claimed, err := scheduler.Claims().Claim(ctx, job, webhookRetryClaimTTL)
+		if err != nil || !claimed {
+			return err
+		}
+
+		return svc.attempt(ctx, wh, d)
+	})
+}
+
+// attempt sends the delivery and updates its state
+//
+// Failed deliveries are rescheduled with exponential backoff
+// until they run out of attempts
+func (svc *webhook) attempt(ctx context.Context, wh *types.Webhook, d *types.WebhookDelivery) error {
+	var (
+		status, err = svc.send(ctx, wh, d)
+		attemptedAt = now()
+	)
+
+	d.Attempts++
+	d.LastAttemptAt = attemptedAt
+	d.UpdatedAt = attemptedAt
+	d.ResponseStatus = status
+	d.NextAttemptAt = nil
+	d.Error = ""
+
+	switch {
+	case err == nil:
+		d.Status = types.WebhookDeliveryDelivered
+
+	case int(d.Attempts) >= svc.opt.MaxAttempts:
+		d.Status = types.WebhookDeliveryDead
+		d.Error = truncateWebhookError(err)
+
+	default:
+		next := attemptedAt.Add(webhookBackoff(svc.opt.RetryBackoff, svc.opt.RetryBackoffMax, d.Attempts))
+		d.Status = types.WebhookDeliveryPending
+		d.Error = truncateWebhookError(err)
+		d.NextAttemptAt = &next
+	}
+
+	if err != nil {
+		svc.log.Debug("webhook delivery failed",
+			logger.Uint64("webhookID", wh.ID),
+			logger.Uint64("deliveryID", d.ID),
+			zap.Uint("attempts", d.Attempts),
+			zap.String("status", string(d.Status)),
+			zap.Error(err),
+		)
+	}
+
+	return store.UpdateWebhookDelivery(ctx, svc.store, d)
+}
+
+// send makes HTTP request with signed payload
+//
+// Any 2xx response is considered a successful delivery
+func (svc *webhook) send(ctx context.Context, wh *types.Webhook, d *types.WebhookDelivery) (int, error) {
+	var (
+		ts = strconv.FormatInt(now().Unix(), 10)
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Corteza-Webhook/"+version.Version)
+	req.Header.Set(WebhookHeaderID, strconv.FormatUint(wh.ID, 10))
+	req.Header.Set(WebhookHeaderDelivery, strconv.FormatUint(d.ID, 10))
+	req.Header.Set(WebhookHeaderEvent, d.ResourceType+"."+d.EventType)
+	req.Header.Set(WebhookHeaderTimestamp, ts)
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(wh.Secret, ts, d.Payload))
+
+	rsp, err := svc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer rsp.Body.Close()
+
+	// response body is not used but reading it
+	// allows connection to be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 1<<16))
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return rsp.StatusCode, fmt.Errorf("unexpected response status %d", rsp.StatusCode)
+	}
+
+	return rsp.StatusCode, nil
+}
+
+// SignWebhookPayload returns signature of the payload
+//
+// Signature is HMAC-SHA256 of the timestamp and the payload, joined
+// with a dot; receivers should compare it in constant time and
+// reject deliveries with old timestamps
+func SignWebhookPayload(secret, ts string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// makeWebhookPayload encodes event arguments into the delivery payload
+//
+// Arguments that are RBAC resources (record, module, user...) are included
+// only when they can be read; when the resource of the event itself
+// can not be read, nil payload is returned and nothing should be delivered
+//
+// Records are included without values of the fields that can not be read
+func makeWebhookPayload(d *types.WebhookDelivery, ev eventbus.Event, canRead func(rbac.Resource) bool, canReadValue func(*cmpTypes.ModuleField) bool) (_ []byte, err error) {
+	p := webhookPayload{
+		DeliveryID:   d.ID,
+		WebhookID:    d.WebhookID,
+		ResourceType: d.ResourceType,
+		EventType:    d.EventType,
+		Timestamp:    d.CreatedAt,
+		Data:         make(map[string]json.RawMessage),
+	}
+
+	if enc, ok := ev.(webhookEventEncoder); ok {
+		var args map[string][]byte
+		if args, err = enc.Encode(); err != nil {
+			return
+		}
+
+		for k, v := range args {
+			if len(v) == 0 || string(v) == "null" {
+				continue
+			}
+
+			res := webhookEventResource(ev, k)
+			if res != nil && !canRead(res) {
+				if rbac.ResourceType(res.RbacResource()) == "corteza::"+ev.ResourceType() {
+					return nil, nil
+				}
+
+				// redact resources that can not be read
+				continue
+			}
+
+			if rec, ok := res.(*cmpTypes.Record); ok {
+				mod, _ := webhookEventResource(ev, "module").(*cmpTypes.Module)
+				if v, err = json.Marshal(webhookReadableRecord(rec, mod, canReadValue)); err != nil {
+					return
+				}
+			}
+
+			p.Data[k] = v
+		}
+	}
+
+	return json.Marshal(p)
+}
+
+// webhookEventResource returns event argument as RBAC resource
+//
+// Events expose their arguments with getters (record => Record())
+func webhookEventResource(ev eventbus.Event, arg string) rbac.Resource {
+	m := reflect.ValueOf(ev).MethodByName(strings.ToUpper(arg[:1]) + arg[1:])
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+
+	out := m.Call(nil)[0]
+	switch out.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if out.IsNil() {
+			return nil
+		}
+	}
+
+	res, _ := out.Interface().(rbac.Resource)
+	return res
+}
+
+// webhookReadableRecord returns copy of the record without
+// values of the module fields that can not be read
+//
+// When module is not known, all values are removed
+func webhookReadableRecord(rec *cmpTypes.Record, mod *cmpTypes.Module, canReadValue func(*cmpTypes.ModuleField) bool) *cmpTypes.Record {
+	var (
+		readable = make(map[string]bool)
+		c        = *rec
+	)
+
+	if mod != nil {
+		for _, f := range mod.Fields {
+			readable[f.Name] = canReadValue(f)
+		}
+	}
+
+	c.Values, _ = rec.Values.Filter(func(v *cmpTypes.RecordValue) (bool, error) {
+		return readable[v.Name], nil
+	})
+
+	return &c
+}
+
+// webhookBackoff returns delay before the next attempt;
+// it doubles with each attempt up to the max
+func webhookBackoff(base, max time.Duration, attempts uint) time.Duration {
+	delay := base
+	for i := uint(1); i < attempts; i++ {
+		if delay *= 2; delay >= max {
+			return max
+		}
+	}
+
+	if delay > max {
+		return max
+	}
+
+	return delay
+}
+
+func truncateWebhookError(err error) string {
+	msg := err.Error()
+	if len(msg) > webhookErrorMaxLength {
+		return msg[:webhookErrorMaxLength]
+	}
+
+	return msg
+}
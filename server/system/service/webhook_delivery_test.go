@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	cmpTypes "github.com/cortezaproject/corteza/server/compose/types"
+	a "github.com/cortezaproject/corteza/server/pkg/auth"
+	"github.com/cortezaproject/corteza/server/pkg/eventbus"
+	"github.com/cortezaproject/corteza/server/pkg/options"
+	"github.com/cortezaproject/corteza/server/pkg/rbac"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/cortezaproject/corteza/server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/cortezaproject/corteza/server/system/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	webhookTestEvent struct {
+		args map[string][]byte
+		user *types.User
+		role *types.Role
+	}
+
+	webhookTestRecordEvent struct {
+		record *cmpTypes.Record
+		module *cmpTypes.Module
+	}
+
+	// allows reading of the listed resources only
+	webhookTestRbac map[string]bool
+
+	// allows reading values of the listed fields to the listed user
+	webhookTestRecordAC struct {
+		userID uint64
+		fields map[string]bool
+	}
+)
+
+func (ev webhookTestEvent) User() *types.User { return ev.user }
+func (ev webhookTestEvent) Role() *types.Role { return ev.role }
+
+func (r webhookTestRbac) Can(_ rbac.Session, op string, res rbac.Resource) bool {
+	return op == "read" && r[res.RbacResource()]
+}
+
+func (webhookTestEvent) ResourceType() string { return "system:user" }
+func (webhookTestEvent) EventType() string    { return "afterCreate" }
+func (webhookTestEvent) Match(eventbus.ConstraintMatcher) bool {
+	return true
+}
+func (ev webhookTestEvent) Encode() (map[string][]byte, error) {
+	return ev.args, nil
+}
+
+func (ev webhookTestRecordEvent) Record() *cmpTypes.Record { return ev.record }
+func (ev webhookTestRecordEvent) Module() *cmpTypes.Module { return ev.module }
+
+func (webhookTestRecordEvent) ResourceType() string { return "compose:record" }
+func (webhookTestRecordEvent) EventType() string    { return "afterUpdate" }
+func (webhookTestRecordEvent) Match(eventbus.ConstraintMatcher) bool {
+	return true
+}
+func (ev webhookTestRecordEvent) Encode() (map[string][]byte, error) {
+	rec, err := json.Marshal(ev.record)
+	return map[string][]byte{"record": rec}, err
+}
+
+func (ac webhookTestRecordAC) CanReadRecordValueOnModuleField(ctx context.Context, f *cmpTypes.ModuleField) bool {
+	return a.GetIdentityFromContext(ctx).Identity() == ac.userID && ac.fields[f.Name]
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	req := require.New(t)
+
+	sig := SignWebhookPayload("secret", "1600000000", []byte(`{}`))
+	req.Regexp(`^sha256=[0-9a-f]{64}$`, sig)
+	req.Equal(sig, SignWebhookPayload("secret", "1600000000", []byte(`{}`)))
+	req.NotEqual(sig, SignWebhookPayload("other", "1600000000", []byte(`{}`)))
+	req.NotEqual(sig, SignWebhookPayload("secret", "1600000001", []byte(`{}`)))
+	req.NotEqual(sig, SignWebhookPayload("secret", "1600000000", []byte(`[]`)))
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	var (
+		base = 30 * time.Second
+		max  = 5 * time.Minute
+	)
+
+	tests := []struct {
+		attempts uint
+		want     time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{50, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempts), func(t *testing.T) {
+			require.Equal(t, tt.want, webhookBackoff(base, max, tt.attempts))
+		})
+	}
+}
+
+func TestMakeWebhookPayload(t *testing.T) {
+	var (
+		req = require.New(t)
+		d   = &types.WebhookDelivery{
+			ID:           42,
+			WebhookID:    1,
+			ResourceType: "system:user",
+			EventType:    "afterCreate",
+			CreatedAt:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		user = &types.User{ID: 3}
+		role = &types.Role{ID: 4}
+
+		ev = webhookTestEvent{
+			user: user,
+			role: role,
+			args: map[string][]byte{
+				"user":    []byte(`{"userID":"3"}`),
+				"role":    []byte(`{"roleID":"4"}`),
+				"invoker": []byte(`null`),
+			},
+		}
+
+		canRead = func(rr ...rbac.Resource) func(rbac.Resource) bool {
+			return func(res rbac.Resource) bool {
+				for _, r := range rr {
+					if r.RbacResource() == res.RbacResource() {
+						return true
+					}
+				}
+
+				return false
+			}
+		}
+	)
+
+	raw, err := makeWebhookPayload(d, ev, canRead(user, role), nil)
+	req.NoError(err)
+
+	p := make(map[string]json.RawMessage)
+	req.NoError(json.Unmarshal(raw, &p))
+	req.JSONEq(`"42"`, string(p["deliveryID"]))
+	req.JSONEq(`"system:user"`, string(p["resourceType"]))
+	req.JSONEq(`"afterCreate"`, string(p["eventType"]))
+	req.JSONEq(`{"user":{"userID":"3"},"role":{"roleID":"4"}}`, string(p["data"]))
+
+	// resources that can not be read are redacted
+	raw, err = makeWebhookPayload(d, ev, canRead(user), nil)
+	req.NoError(err)
+	req.NoError(json.Unmarshal(raw, &p))
+	req.JSONEq(`{"user":{"userID":"3"}}`, string(p["data"]))
+
+	// nothing is delivered when resource of the event can not be read
+	raw, err = makeWebhookPayload(d, ev, canRead(role), nil)
+	req.NoError(err)
+	req.Nil(raw)
+}
+
+func TestWebhookHandlerReadAccess(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   store.Storer
+		err error
+
+		hits  int
+		owner = &types.User{ID: nextID(), Email: "owner@example.tld", CreatedAt: *now()}
+		user  = &types.User{ID: nextID(), Email: "user@example.tld", CreatedAt: *now()}
+
+		ev = webhookTestEvent{
+			user: user,
+			args: map[string][]byte{"user": []byte(`{"userID":"1"}`)},
+		}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.CreateUser(ctx, s, owner, user))
+
+	var (
+		wh = &types.Webhook{ID: nextID(), URL: srv.URL, Secret: "secret", Enabled: true, CreatedBy: owner.ID}
+
+		makeSvc = func(ac webhookTestRbac) *webhook {
+			return &webhook{
+				store:  s,
+				rbac:   ac,
+				log:    zap.NewNop(),
+				opt:    options.WebhookOpt{Timeout: time.Second, MaxAttempts: 1},
+				client: &http.Client{Timeout: time.Second},
+			}
+		}
+
+		deliveries = func() types.WebhookDeliverySet {
+			set, _, err := store.SearchWebhookDeliverys(ctx, s, types.WebhookDeliveryFilter{WebhookID: []string{strconv.FormatUint(wh.ID, 10)}})
+			req.NoError(err)
+			return set
+		}
+	)
+
+	t.Run("owner without read access gets nothing", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(makeSvc(webhookTestRbac{}).handler(wh)(ctx, ev))
+		req.Zero(hits)
+		req.Empty(deliveries())
+	})
+
+	t.Run("owner with read access", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(makeSvc(webhookTestRbac{user.RbacResource(): true}).handler(wh)(ctx, ev))
+		req.Equal(1, hits)
+		req.Len(deliveries(), 1)
+		req.Equal(types.WebhookDeliveryDelivered, deliveries()[0].Status)
+	})
+}
+
+func TestWebhookHandlerRecordValueReadAccess(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s   store.Storer
+		err error
+
+		body  []byte
+		owner = &types.User{ID: nextID(), Email: "record-owner@example.tld", CreatedAt: *now()}
+
+		mod = &cmpTypes.Module{ID: 2, NamespaceID: 1, Fields: cmpTypes.ModuleFieldSet{
+			{Name: "public"},
+			{Name: "secret"},
+		}}
+
+		rec = &cmpTypes.Record{ID: 3, ModuleID: mod.ID, NamespaceID: mod.NamespaceID, Values: cmpTypes.RecordValueSet{
+			{Name: "public", Value: "foo"},
+			{Name: "secret", Value: "bar"},
+		}}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	if s, err = sqlite.ConnectInMemory(ctx); err != nil {
+		req.NoError(err)
+	} else if err = store.Upgrade(ctx, zap.NewNop(), s); err != nil {
+		req.NoError(err)
+	}
+
+	req.NoError(store.CreateUser(ctx, s, owner))
+
+	svc := &webhook{
+		store:    s,
+		rbac:     webhookTestRbac{rec.RbacResource(): true},
+		recordAC: webhookTestRecordAC{userID: owner.ID, fields: map[string]bool{"public": true}},
+		log:      zap.NewNop(),
+		opt:      options.WebhookOpt{Timeout: time.Second, MaxAttempts: 1},
+		client:   &http.Client{Timeout: time.Second},
+	}
+
+	wh := &types.Webhook{ID: nextID(), URL: srv.URL, Secret: "secret", Enabled: true, CreatedBy: owner.ID}
+	req.NoError(svc.handler(wh)(ctx, webhookTestRecordEvent{record: rec, module: mod}))
+
+	p := struct {
+		Data struct {
+			Record *cmpTypes.Record `json:"record"`
+		} `json:"data"`
+	}{}
+
+	req.NoError(json.Unmarshal(body, &p))
+	req.NotNil(p.Data.Record)
+	req.Equal(rec.ID, p.Data.Record.ID)
+	req.Len(p.Data.Record.Values, 1)
+	req.Equal("public", p.Data.Record.Values[0].Name)
+
+	// record of the event is left intact for other handlers
+	req.Len(rec.Values, 2)
+}
+
+func TestWebhookSend(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		wh = &types.Webhook{ID: 1, Secret: "secret"}
+		d  = &types.WebhookDelivery{
+			ID:           2,
+			ResourceType: "system:user",
+			EventType:    "afterCreate",
+			Payload:      []byte(`{"foo":"bar"}`),
+		}
+
+		svc = &webhook{client: &http.Client{Timeout: time.Second}}
+	)
+
+	t.Run("success", func(t *testing.T) {
+		req := require.New(t)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+
+			req.Equal(http.MethodPost, r.Method)
+			req.Equal("1", r.Header.Get(WebhookHeaderID))
+			req.Equal("2", r.Header.Get(WebhookHeaderDelivery))
+			req.Equal("system:user.afterCreate", r.Header.Get(WebhookHeaderEvent))
+			req.Equal(
+				SignWebhookPayload("secret", r.Header.Get(WebhookHeaderTimestamp), body),
+				r.Header.Get(WebhookHeaderSignature),
+			)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		wh.URL = srv.URL
+		status, err := svc.send(ctx, wh, d)
+		req.NoError(err)
+		req.Equal(http.StatusNoContent, status)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		req := require.New(t)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		wh.URL = srv.URL
+		status, err := svc.send(ctx, wh, d)
+		req.Error(err)
+		req.Equal(http.StatusBadGateway, status)
+	})
+}
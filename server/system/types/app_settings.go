@@ -154,6 +154,17 @@ type (
 					// TOTP issuer, defaults to "Corteza"
 					Issuer string
 				} `kv:"totp"`
+
+				WebAuthn struct {
+					// Can users register security keys (FIDO2/WebAuthn) for MFA
+					Enabled bool
+
+					// Can users log-in with a passkey, without a password
+					Passwordless bool
+
+					// Relying party name shown by the browser, defaults to "Corteza"
+					RPName string `kv:"rp-name"`
+				} `kv:"webauthn"`
 			} `json:"-" kv:"multi-factor"`
 
 			Mail struct {
@@ -26,8 +26,9 @@ type (
 
 	// AttachmentFilter is used for filtering and as a return value from Find
 	AttachmentFilter struct {
-		Kind   string `json:"kind,omitempty"`
-		Filter string `json:"filter"`
+		Kind    string       `json:"kind,omitempty"`
+		Filter  string       `json:"filter"`
+		Deleted filter.State `json:"deleted"`
 
 		// Check fn is called by store backend for each resource found function can
 		// modify the resource and return false if store should not return it
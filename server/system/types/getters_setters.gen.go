@@ -538,6 +538,82 @@ func (r *Report) SetValue(name string, pos uint, value any) (err error) {
 	return nil
 }
 
+func (r ReportSubscription) GetID() uint64 { return r.ID }
+
+func (r *ReportSubscription) GetValue(name string, pos uint) (any, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	switch name {
+	case "createdAt", "CreatedAt":
+		return r.CreatedAt, nil
+	case "createdBy", "CreatedBy":
+		return r.CreatedBy, nil
+	case "deletedAt", "DeletedAt":
+		return r.DeletedAt, nil
+	case "deletedBy", "DeletedBy":
+		return r.DeletedBy, nil
+	case "enabled", "Enabled":
+		return r.Enabled, nil
+	case "id", "ID":
+		return r.ID, nil
+	case "lastRunAt", "LastRunAt":
+		return r.LastRunAt, nil
+	case "ownedBy", "OwnedBy":
+		return r.OwnedBy, nil
+	case "reportID", "ReportID":
+		return r.ReportID, nil
+	case "scenarioID", "ScenarioID":
+		return r.ScenarioID, nil
+	case "schedule", "Schedule":
+		return r.Schedule, nil
+	case "updatedAt", "UpdatedAt":
+		return r.UpdatedAt, nil
+	case "updatedBy", "UpdatedBy":
+		return r.UpdatedBy, nil
+
+	}
+	return nil, nil
+}
+
+func (r *ReportSubscription) SetValue(name string, pos uint, value any) (err error) {
+	if r == nil {
+		r = &ReportSubscription{}
+	}
+
+	switch name {
+	case "createdAt", "CreatedAt":
+		return cast2.Time(value, &r.CreatedAt)
+	case "createdBy", "CreatedBy":
+		return cast2.Uint64(value, &r.CreatedBy)
+	case "deletedAt", "DeletedAt":
+		return cast2.TimePtr(value, &r.DeletedAt)
+	case "deletedBy", "DeletedBy":
+		return cast2.Uint64(value, &r.DeletedBy)
+	case "enabled", "Enabled":
+		return cast2.Bool(value, &r.Enabled)
+	case "id", "ID":
+		return cast2.Uint64(value, &r.ID)
+	case "lastRunAt", "LastRunAt":
+		return cast2.TimePtr(value, &r.LastRunAt)
+	case "ownedBy", "OwnedBy":
+		return cast2.Uint64(value, &r.OwnedBy)
+	case "reportID", "ReportID":
+		return cast2.Uint64(value, &r.ReportID)
+	case "scenarioID", "ScenarioID":
+		return cast2.Uint64(value, &r.ScenarioID)
+	case "schedule", "Schedule":
+		return cast2.String(value, &r.Schedule)
+	case "updatedAt", "UpdatedAt":
+		return cast2.TimePtr(value, &r.UpdatedAt)
+	case "updatedBy", "UpdatedBy":
+		return cast2.Uint64(value, &r.UpdatedBy)
+
+	}
+	return nil
+}
+
 func (r ResourceTranslation) GetID() uint64 { return r.ID }
 
 func (r *ResourceTranslation) GetValue(name string, pos uint) (any, error) {
@@ -1039,3 +1115,67 @@ func (r *DalSchemaAlteration) SetValue(name string, pos uint, value any) (err er
 	}
 	return nil
 }
+
+func (r Webhook) GetID() uint64 { return r.ID }
+
+func (r *Webhook) GetValue(name string, pos uint) (any, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	switch name {
+	case "createdAt", "CreatedAt":
+		return r.CreatedAt, nil
+	case "createdBy", "CreatedBy":
+		return r.CreatedBy, nil
+	case "deletedAt", "DeletedAt":
+		return r.DeletedAt, nil
+	case "deletedBy", "DeletedBy":
+		return r.DeletedBy, nil
+	case "enabled", "Enabled":
+		return r.Enabled, nil
+	case "handle", "Handle":
+		return r.Handle, nil
+	case "id", "ID":
+		return r.ID, nil
+	case "updatedAt", "UpdatedAt":
+		return r.UpdatedAt, nil
+	case "updatedBy", "UpdatedBy":
+		return r.UpdatedBy, nil
+	case "url", "URL":
+		return r.URL, nil
+
+	}
+	return nil, nil
+}
+
+func (r *Webhook) SetValue(name string, pos uint, value any) (err error) {
+	if r == nil {
+		r = &Webhook{}
+	}
+
+	switch name {
+	case "createdAt", "CreatedAt":
+		return cast2.Time(value, &r.CreatedAt)
+	case "createdBy", "CreatedBy":
+		return cast2.Uint64(value, &r.CreatedBy)
+	case "deletedAt", "DeletedAt":
+		return cast2.TimePtr(value, &r.DeletedAt)
+	case "deletedBy", "DeletedBy":
+		return cast2.Uint64(value, &r.DeletedBy)
+	case "enabled", "Enabled":
+		return cast2.Bool(value, &r.Enabled)
+	case "handle", "Handle":
+		return cast2.String(value, &r.Handle)
+	case "id", "ID":
+		return cast2.Uint64(value, &r.ID)
+	case "updatedAt", "UpdatedAt":
+		return cast2.TimePtr(value, &r.UpdatedAt)
+	case "updatedBy", "UpdatedBy":
+		return cast2.Uint64(value, &r.UpdatedBy)
+	case "url", "URL":
+		return cast2.String(value, &r.URL)
+
+	}
+	return nil
+}
@@ -46,3 +46,23 @@ func ParseRoleMeta(ss []string) (p *RoleMeta, err error) {
 	p = &RoleMeta{}
 	return p, parseStringsInput(ss, &p)
 }
+
+func ParseWebhookEventSet(ss []string) (p WebhookEventSet, err error) {
+	p = WebhookEventSet{}
+	return p, parseStringsInput(ss, &p)
+}
+
+func ParseWebhookMeta(ss []string) (p *WebhookMeta, err error) {
+	p = &WebhookMeta{}
+	return p, parseStringsInput(ss, &p)
+}
+
+func ParseReportSubscriptionRecipients(ss []string) (p ReportSubscriptionRecipients, err error) {
+	p = ReportSubscriptionRecipients{}
+	return p, parseStringsInput(ss, &p)
+}
+
+func ParseReportSubscriptionMeta(ss []string) (p *ReportSubscriptionMeta, err error) {
+	p = &ReportSubscriptionMeta{}
+	return p, parseStringsInput(ss, &p)
+}
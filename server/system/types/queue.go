@@ -61,6 +61,13 @@ type (
 	QueueMeta struct {
 		PollDelay      *time.Duration `json:"poll_delay"`
 		DispatchEvents bool           `json:"dispatch_events"`
+
+		// Redis stream settings; stream key defaults to queue name
+		// and consumer group defaults to "corteza"
+		Stream         string         `json:"stream"`
+		Group          string         `json:"group"`
+		MaxLen         int64          `json:"max_len"`
+		RedeliverAfter *time.Duration `json:"redeliver_after"`
 	}
 )
 
@@ -68,7 +75,8 @@ func (h *QueueMeta) UnmarshalJSON(s []byte) error {
 	type Alias QueueMeta
 
 	aux := &struct {
-		PollDelay string `json:"poll_delay"`
+		PollDelay      string `json:"poll_delay"`
+		RedeliverAfter string `json:"redeliver_after"`
 		*Alias
 	}{
 		Alias: (*Alias)(h),
@@ -85,6 +93,12 @@ func (h *QueueMeta) UnmarshalJSON(s []byte) error {
 		h.PollDelay = &d
 	}
 
+	if aux.RedeliverAfter != "" {
+		if d, err := cast.ToDurationE(aux.RedeliverAfter); err == nil {
+			h.RedeliverAfter = &d
+		}
+	}
+
 	return nil
 }
 
@@ -98,12 +112,25 @@ func (m QueueMeta) MarshalJSON() ([]byte, error) {
 		pollDelay = m.PollDelay.String()
 	}
 
+	redeliverAfter := ""
+	if m.RedeliverAfter != nil {
+		redeliverAfter = m.RedeliverAfter.String()
+	}
+
 	return json.Marshal(struct {
 		PollDelay      string `json:"poll_delay"`
 		DispatchEvents bool   `json:"dispatch_events"`
+		Stream         string `json:"stream,omitempty"`
+		Group          string `json:"group,omitempty"`
+		MaxLen         int64  `json:"max_len,omitempty"`
+		RedeliverAfter string `json:"redeliver_after,omitempty"`
 	}{
 		PollDelay:      pollDelay,
 		DispatchEvents: m.DispatchEvents,
+		Stream:         m.Stream,
+		Group:          m.Group,
+		MaxLen:         m.MaxLen,
+		RedeliverAfter: redeliverAfter,
 	})
 }
 
@@ -323,6 +323,36 @@ func DalConnectionRbacResourceTpl() string {
 	return "%s/%s"
 }
 
+// RbacResource returns string representation of RBAC resource for Webhook by calling WebhookRbacResource fn
+//
+// RBAC resource is in the corteza::system:webhook/... format
+//
+// This function is auto-generated
+func (r Webhook) RbacResource() string {
+	return WebhookRbacResource(r.ID)
+}
+
+// WebhookRbacResource returns string representation of RBAC resource for Webhook
+//
+// RBAC resource is in the corteza::system:webhook/... format
+//
+// This function is auto-generated
+func WebhookRbacResource(id uint64) string {
+	cpts := []interface{}{WebhookResourceType}
+	if id != 0 {
+		cpts = append(cpts, strconv.FormatUint(id, 10))
+	} else {
+		cpts = append(cpts, "*")
+	}
+
+	return fmt.Sprintf(WebhookRbacResourceTpl(), cpts...)
+
+}
+
+func WebhookRbacResourceTpl() string {
+	return "%s/%s"
+}
+
 // RbacResource returns string representation of RBAC resource for Component by calling ComponentRbacResource fn
 //
 // RBAC resource is in the corteza::system/... format
@@ -74,6 +74,8 @@ type (
 		Join      *ReportStepJoin      `json:"join,omitempty"`
 		Link      *ReportStepLink      `json:"link,omitempty"`
 		Aggregate *ReportStepAggregate `json:"aggregate,omitempty"`
+		Union     *ReportStepUnion     `json:"union,omitempty"`
+		Window    *ReportStepWindow    `json:"window,omitempty"`
 
 		// @todo remove for the next set of patch/major releases.
 		//       it exists just for the migration as we need to rename this one.
@@ -88,7 +90,10 @@ type (
 	}
 
 	ReportStepJoin struct {
-		Name          string            `json:"name"`
+		Name string `json:"name"`
+		// Type of the join; one of inner, left, right or full.
+		// When omitted, only rows with a match on both sides are produced.
+		Type          string            `json:"type,omitempty"`
 		LocalSource   string            `json:"localSource"`
 		LocalColumn   string            `json:"localColumn"`
 		ForeignSource string            `json:"foreignSource"`
@@ -105,6 +110,23 @@ type (
 		Filter        *ReportFilterExpr `json:"filter,omitempty"`
 	}
 
+	ReportStepUnion struct {
+		Name    string            `json:"name"`
+		Sources []string          `json:"sources"`
+		Filter  *ReportFilterExpr `json:"filter,omitempty"`
+	}
+
+	ReportStepWindow struct {
+		Name   string `json:"name"`
+		Source string `json:"source"`
+		// PartitionBy lists the columns the rows are partitioned by
+		PartitionBy []string `json:"partitionBy,omitempty"`
+		// Sort defines the order of the rows inside the partition
+		Sort    filter.SortExprSet       `json:"sort,omitempty"`
+		Columns ReportAggregateColumnSet `json:"columns"`
+		Filter  *ReportFilterExpr        `json:"filter,omitempty"`
+	}
+
 	ReportLegacyStepGroup struct {
 		Name    string                   `json:"name"`
 		Source  string                   `json:"source"`
@@ -170,6 +192,28 @@ func (ss ReportDataSourceSet) ReportSteps() ReportStepSet {
 	return out
 }
 
+// Name returns the name of the step, regardless of its kind
+func (s *ReportStep) Name() string {
+	switch {
+	case s == nil:
+		return ""
+	case s.Load != nil:
+		return s.Load.Name
+	case s.Join != nil:
+		return s.Join.Name
+	case s.Link != nil:
+		return s.Link.Name
+	case s.Aggregate != nil:
+		return s.Aggregate.Name
+	case s.Union != nil:
+		return s.Union.Name
+	case s.Window != nil:
+		return s.Window.Name
+	}
+
+	return ""
+}
+
 // ReportSteps returns a ReportStepSet collected from the ReportBlockSet
 func (pp ReportBlockSet) ReportSteps() ReportStepSet {
 	out := make(ReportStepSet, 0, 124)
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/sql"
+)
+
+type (
+	// ReportSubscription periodically runs the report and
+	// delivers the results to the recipients by email
+	ReportSubscription struct {
+		ID       uint64 `json:"subscriptionID,string"`
+		ReportID uint64 `json:"reportID,string"`
+
+		// scenario which filters are applied to the report;
+		// when not set, report is ran without any scenario
+		ScenarioID uint64 `json:"scenarioID,string,omitempty"`
+
+		// crontab expression
+		Schedule string                   `json:"schedule"`
+		Format   ReportSubscriptionFormat `json:"format"`
+		Enabled  bool                     `json:"enabled"`
+
+		Recipients ReportSubscriptionRecipients `json:"recipients"`
+		Meta       *ReportSubscriptionMeta      `json:"meta"`
+
+		LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+
+		// report is ran with the permissions of the owner
+		OwnedBy   uint64     `json:"ownedBy,string"`
+		CreatedAt time.Time  `json:"createdAt,omitempty"`
+		CreatedBy uint64     `json:"createdBy,string" `
+		UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+		UpdatedBy uint64     `json:"updatedBy,string,omitempty" `
+		DeletedAt *time.Time `json:"deletedAt,omitempty"`
+		DeletedBy uint64     `json:"deletedBy,string,omitempty" `
+	}
+
+	// ReportSubscriptionRecipients lists users, members of roles
+	// and arbitrary email addresses the results are delivered to
+	//
+	// Users and roles are referenced by their IDs
+	ReportSubscriptionRecipients struct {
+		Users  []string `json:"users,omitempty"`
+		Roles  []string `json:"roles,omitempty"`
+		Emails []string `json:"emails,omitempty"`
+	}
+
+	ReportSubscriptionMeta struct {
+		Name string `json:"name"`
+
+		// email subject and body; when not set, report name is used
+		Subject string `json:"subject,omitempty"`
+		Body    string `json:"body,omitempty"`
+
+		// data sources (frames) included in the delivery;
+		// when not set, all of the report's data sources are included
+		Sources []string `json:"sources,omitempty"`
+
+		// template used to render the PDF; it is rendered with the report name,
+		// report, subscription and frames as variables.
+		// When not set, a simple table layout is used
+		TemplateID uint64 `json:"templateID,string,omitempty"`
+
+		// timezone of the schedule; when not set, UTC is used
+		Timezone string `json:"timezone,omitempty"`
+	}
+
+	ReportSubscriptionFormat string
+
+	ReportSubscriptionFilter struct {
+		SubscriptionID []string `json:"subscriptionID"`
+		ReportID       []string `json:"reportID"`
+
+		Deleted  filter.State `json:"deleted"`
+		Disabled filter.State `json:"disabled"`
+
+		// Check fn is called by store backend for each resource found function can
+		// modify the resource and return false if store should not return it
+		//
+		// Store then loads additional resources to satisfy the paging parameters
+		Check func(*ReportSubscription) (bool, error) `json:"-"`
+
+		filter.Sorting
+		filter.Paging
+	}
+
+	// ReportDelivery records one (scheduled or manual) run of the subscription
+	ReportDelivery struct {
+		ID             uint64 `json:"deliveryID,string"`
+		SubscriptionID uint64 `json:"subscriptionID,string"`
+		ReportID       uint64 `json:"reportID,string"`
+
+		Format     ReportSubscriptionFormat `json:"format"`
+		Recipients ReportDeliveryRecipients `json:"recipients"`
+
+		Status ReportDeliveryStatus `json:"status"`
+		Error  string               `json:"error,omitempty"`
+
+		CreatedAt   time.Time  `json:"createdAt,omitempty"`
+		CompletedAt *time.Time `json:"completedAt,omitempty"`
+	}
+
+	// ReportDeliveryRecipients holds email addresses
+	// the delivery was sent to
+	ReportDeliveryRecipients []string
+
+	ReportDeliveryStatus string
+
+	ReportDeliveryFilter struct {
+		DeliveryID     []string               `json:"deliveryID"`
+		SubscriptionID []string               `json:"subscriptionID"`
+		ReportID       []string               `json:"reportID"`
+		Status         []ReportDeliveryStatus `json:"status"`
+
+		filter.Sorting
+		filter.Paging
+	}
+)
+
+const (
+	ReportSubscriptionFormatCSV  ReportSubscriptionFormat = "csv"
+	ReportSubscriptionFormatXLSX ReportSubscriptionFormat = "xlsx"
+	ReportSubscriptionFormatPDF  ReportSubscriptionFormat = "pdf"
+
+	// ReportDeliveryRunning report is being ran and delivered
+	ReportDeliveryRunning ReportDeliveryStatus = "running"
+
+	// ReportDeliveryDelivered results were sent to all recipients
+	ReportDeliveryDelivered ReportDeliveryStatus = "delivered"
+
+	// ReportDeliveryFailed report could not be ran, rendered or sent
+	ReportDeliveryFailed ReportDeliveryStatus = "failed"
+)
+
+// IsValid checks if the format is supported
+func (f ReportSubscriptionFormat) IsValid() bool {
+	switch f {
+	case ReportSubscriptionFormatCSV, ReportSubscriptionFormatXLSX, ReportSubscriptionFormatPDF:
+		return true
+	}
+
+	return false
+}
+
+// Empty returns true when there are no recipients
+func (rr ReportSubscriptionRecipients) Empty() bool {
+	return len(rr.Users) == 0 && len(rr.Roles) == 0 && len(rr.Emails) == 0
+}
+
+func (rr *ReportSubscriptionRecipients) Scan(src any) error          { return sql.ParseJSON(src, rr) }
+func (rr ReportSubscriptionRecipients) Value() (driver.Value, error) { return json.Marshal(rr) }
+
+func (m *ReportSubscriptionMeta) Scan(src any) error           { return sql.ParseJSON(src, m) }
+func (m *ReportSubscriptionMeta) Value() (driver.Value, error) { return json.Marshal(m) }
+
+func (rr *ReportDeliveryRecipients) Scan(src any) error          { return sql.ParseJSON(src, rr) }
+func (rr ReportDeliveryRecipients) Value() (driver.Value, error) { return json.Marshal(rr) }
@@ -22,6 +22,8 @@ const (
 	QueueMessageResourceType              = "corteza::system:queue-message"
 	ReminderResourceType                  = "corteza::system:reminder"
 	ReportResourceType                    = "corteza::system:report"
+	ReportSubscriptionResourceType        = "corteza::system:report-subscription"
+	ReportDeliveryResourceType            = "corteza::system:report-delivery"
 	ResourceTranslationResourceType       = "corteza::system:resource-translation"
 	RoleResourceType                      = "corteza::system:role"
 	RoleMemberResourceType                = "corteza::system:role-member"
@@ -31,5 +33,7 @@ const (
 	DalConnectionResourceType             = "corteza::system:dal-connection"
 	DalSensitivityLevelResourceType       = "corteza::system:dal-sensitivity-level"
 	DalSchemaAlterationResourceType       = "corteza::system:dal-schema-alteration"
+	WebhookResourceType                   = "corteza::system:webhook"
+	WebhookDeliveryResourceType           = "corteza::system:webhook-delivery"
 	ComponentResourceType                 = "corteza::system"
 )
@@ -115,6 +115,16 @@ type (
 	// This type is auto-generated.
 	ReportSet []*Report
 
+	// ReportDeliverySet slice of ReportDelivery
+	//
+	// This type is auto-generated.
+	ReportDeliverySet []*ReportDelivery
+
+	// ReportSubscriptionSet slice of ReportSubscription
+	//
+	// This type is auto-generated.
+	ReportSubscriptionSet []*ReportSubscription
+
 	// ResourceTranslationSet slice of ResourceTranslation
 	//
 	// This type is auto-generated.
@@ -144,6 +154,16 @@ type (
 	//
 	// This type is auto-generated.
 	UserSet []*User
+
+	// WebhookSet slice of Webhook
+	//
+	// This type is auto-generated.
+	WebhookSet []*Webhook
+
+	// WebhookDeliverySet slice of WebhookDelivery
+	//
+	// This type is auto-generated.
+	WebhookDeliverySet []*WebhookDelivery
 )
 
 // Walk iterates through every slice item and calls w(ApigwFilter) err
@@ -1192,6 +1212,118 @@ func (set ReportSet) IDs() (IDs []uint64) {
 	return
 }
 
+// Walk iterates through every slice item and calls w(ReportDelivery) err
+//
+// This function is auto-generated.
+func (set ReportDeliverySet) Walk(w func(*ReportDelivery) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(ReportDelivery) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ReportDeliverySet) Filter(f func(*ReportDelivery) (bool, error)) (out ReportDeliverySet, err error) {
+	var ok bool
+	out = ReportDeliverySet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set ReportDeliverySet) FindByID(ID uint64) *ReportDelivery {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set ReportDeliverySet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(ReportSubscription) err
+//
+// This function is auto-generated.
+func (set ReportSubscriptionSet) Walk(w func(*ReportSubscription) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(ReportSubscription) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ReportSubscriptionSet) Filter(f func(*ReportSubscription) (bool, error)) (out ReportSubscriptionSet, err error) {
+	var ok bool
+	out = ReportSubscriptionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set ReportSubscriptionSet) FindByID(ID uint64) *ReportSubscription {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set ReportSubscriptionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(ResourceTranslation) err
 //
 // This function is auto-generated.
@@ -1475,3 +1607,115 @@ func (set UserSet) IDs() (IDs []uint64) {
 
 	return
 }
+
+// Walk iterates through every slice item and calls w(Webhook) err
+//
+// This function is auto-generated.
+func (set WebhookSet) Walk(w func(*Webhook) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(Webhook) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WebhookSet) Filter(f func(*Webhook) (bool, error)) (out WebhookSet, err error) {
+	var ok bool
+	out = WebhookSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set WebhookSet) FindByID(ID uint64) *Webhook {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set WebhookSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(WebhookDelivery) err
+//
+// This function is auto-generated.
+func (set WebhookDeliverySet) Walk(w func(*WebhookDelivery) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(WebhookDelivery) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WebhookDeliverySet) Filter(f func(*WebhookDelivery) (bool, error)) (out WebhookDeliverySet, err error) {
+	var ok bool
+	out = WebhookDeliverySet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set WebhookDeliverySet) FindByID(ID uint64) *WebhookDelivery {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set WebhookDeliverySet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
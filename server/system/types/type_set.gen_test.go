@@ -1734,6 +1734,186 @@ func TestReportSetIDs(t *testing.T) {
 	}
 }
 
+func TestReportDeliverySetWalk(t *testing.T) {
+	var (
+		value = make(ReportDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*ReportDelivery) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*ReportDelivery) error { return fmt.Errorf("walk error") }))
+}
+
+func TestReportDeliverySetFilter(t *testing.T) {
+	var (
+		value = make(ReportDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*ReportDelivery) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*ReportDelivery) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*ReportDelivery) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestReportDeliverySetIDs(t *testing.T) {
+	var (
+		value = make(ReportDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(ReportDelivery)
+	value[1] = new(ReportDelivery)
+	value[2] = new(ReportDelivery)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
+func TestReportSubscriptionSetWalk(t *testing.T) {
+	var (
+		value = make(ReportSubscriptionSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*ReportSubscription) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*ReportSubscription) error { return fmt.Errorf("walk error") }))
+}
+
+func TestReportSubscriptionSetFilter(t *testing.T) {
+	var (
+		value = make(ReportSubscriptionSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*ReportSubscription) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*ReportSubscription) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*ReportSubscription) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestReportSubscriptionSetIDs(t *testing.T) {
+	var (
+		value = make(ReportSubscriptionSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(ReportSubscription)
+	value[1] = new(ReportSubscription)
+	value[2] = new(ReportSubscription)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
 func TestResourceTranslationSetWalk(t *testing.T) {
 	var (
 		value = make(ResourceTranslationSet, 3)
@@ -2205,3 +2385,183 @@ func TestUserSetIDs(t *testing.T) {
 		req.Equal(len(val), len(value))
 	}
 }
+
+func TestWebhookSetWalk(t *testing.T) {
+	var (
+		value = make(WebhookSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*Webhook) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*Webhook) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWebhookSetFilter(t *testing.T) {
+	var (
+		value = make(WebhookSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*Webhook) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*Webhook) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*Webhook) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestWebhookSetIDs(t *testing.T) {
+	var (
+		value = make(WebhookSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(Webhook)
+	value[1] = new(Webhook)
+	value[2] = new(Webhook)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
+func TestWebhookDeliverySetWalk(t *testing.T) {
+	var (
+		value = make(WebhookDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*WebhookDelivery) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*WebhookDelivery) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWebhookDeliverySetFilter(t *testing.T) {
+	var (
+		value = make(WebhookDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*WebhookDelivery) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*WebhookDelivery) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*WebhookDelivery) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestWebhookDeliverySetIDs(t *testing.T) {
+	var (
+		value = make(WebhookDeliverySet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(WebhookDelivery)
+	value[1] = new(WebhookDelivery)
+	value[2] = new(WebhookDelivery)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
@@ -64,6 +64,10 @@ type (
 
 				// Require OTP to be entered every time client is authorized
 				//StrictTOTP bool `json:"strictTOTP"`
+
+				// Has at least one security key (WebAuthn) registered
+				// and is required to use it on login?
+				EnforcedWebAuthn bool `json:"enforcedWebAuthn"`
 			} `json:"mfa"`
 		} `json:"securityPolicy"`
 	}
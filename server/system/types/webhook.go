@@ -0,0 +1,137 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/sql"
+	"github.com/jmoiron/sqlx/types"
+)
+
+type (
+	Webhook struct {
+		ID     uint64 `json:"webhookID,string"`
+		Handle string `json:"handle"`
+
+		// endpoint where events are delivered to
+		URL string `json:"url"`
+
+		// used for signing the payload,
+		// service keeps it out of the responses
+		Secret string `json:"secret,omitempty"`
+
+		Enabled bool            `json:"enabled"`
+		Events  WebhookEventSet `json:"events"`
+		Meta    *WebhookMeta    `json:"meta"`
+
+		CreatedAt time.Time  `json:"createdAt,omitempty"`
+		CreatedBy uint64     `json:"createdBy,string" `
+		UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+		UpdatedBy uint64     `json:"updatedBy,string,omitempty" `
+		DeletedAt *time.Time `json:"deletedAt,omitempty"`
+		DeletedBy uint64     `json:"deletedBy,string,omitempty" `
+	}
+
+	WebhookMeta struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	// WebhookEvent describes which events are delivered
+	//
+	// Works the same way as workflow triggers; constraints
+	// can narrow down the events (e.g. to specific modules)
+	WebhookEvent struct {
+		ResourceType string                    `json:"resourceType"`
+		EventTypes   []string                  `json:"eventTypes"`
+		Constraints  WebhookEventConstraintSet `json:"constraints,omitempty"`
+	}
+
+	WebhookEventSet []*WebhookEvent
+
+	WebhookEventConstraint struct {
+		Name   string   `json:"name"`
+		Op     string   `json:"op,omitempty"`
+		Values []string `json:"values"`
+	}
+
+	WebhookEventConstraintSet []*WebhookEventConstraint
+
+	WebhookFilter struct {
+		WebhookID []string `json:"webhookID"`
+		Handle    string   `json:"handle"`
+		Query     string   `json:"query"`
+
+		Deleted  filter.State `json:"deleted"`
+		Disabled filter.State `json:"disabled"`
+
+		// Check fn is called by store backend for each resource found function can
+		// modify the resource and return false if store should not return it
+		//
+		// Store then loads additional resources to satisfy the paging parameters
+		Check func(*Webhook) (bool, error) `json:"-"`
+
+		filter.Sorting
+		filter.Paging
+	}
+
+	// WebhookDelivery is one event, delivered (or not) to the webhook
+	//
+	// Deliveries are kept as a log; failed ones are retried
+	// until they succeed or run out of attempts
+	WebhookDelivery struct {
+		ID           uint64 `json:"deliveryID,string"`
+		WebhookID    uint64 `json:"webhookID,string"`
+		ResourceType string `json:"resourceType"`
+		EventType    string `json:"eventType"`
+
+		// payload, exactly as it is sent
+		Payload types.JSONText `json:"payload"`
+
+		Status   WebhookDeliveryStatus `json:"status"`
+		Attempts uint                  `json:"attempts"`
+
+		// result of the last attempt
+		ResponseStatus int    `json:"responseStatus,omitempty"`
+		Error          string `json:"error,omitempty"`
+
+		NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+		LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty"`
+		CreatedAt     time.Time  `json:"createdAt,omitempty"`
+		UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+	}
+
+	WebhookDeliveryStatus string
+
+	WebhookDeliveryFilter struct {
+		DeliveryID []string                `json:"deliveryID"`
+		WebhookID  []string                `json:"webhookID"`
+		Status     []WebhookDeliveryStatus `json:"status"`
+
+		// deliveries that are due for (re)delivery at the given time
+		DueAt *time.Time `json:"dueAt"`
+
+		filter.Sorting
+		filter.Paging
+	}
+)
+
+const (
+	// WebhookDeliveryPending delivery is waiting for the (next) attempt
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+
+	// WebhookDeliveryDelivered endpoint accepted the delivery
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+
+	// WebhookDeliveryDead all attempts failed, delivery
+	// can only be redelivered manually
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+func (vv *WebhookEventSet) Scan(src any) error          { return sql.ParseJSON(src, vv) }
+func (vv WebhookEventSet) Value() (driver.Value, error) { return json.Marshal(vv) }
+
+func (m *WebhookMeta) Scan(src any) error           { return sql.ParseJSON(src, m) }
+func (m *WebhookMeta) Value() (driver.Value, error) { return json.Marshal(m) }
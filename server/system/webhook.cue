@@ -0,0 +1,107 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+webhook: {
+	features: {
+		labels: false
+	}
+
+	model: {
+		attributes: {
+			id:     schema.IdField
+			handle: schema.HandleField
+			url: {
+				expIdent: "URL"
+				sortable: true
+				dal: { type: "Text", length: 2048 }
+			}
+			secret: {
+				dal: {}
+				omitSetter: true
+				omitGetter: true
+			}
+			enabled: {
+				sortable: true,
+				goType: "bool"
+				dal: { type: "Boolean" }
+			}
+			events: {
+				goType: "types.WebhookEventSet"
+				dal: { type: "JSON", defaultEmptyObject: true }
+				omitSetter: true
+				omitGetter: true
+			}
+			meta: {
+				goType: "*types.WebhookMeta"
+				dal: { type: "JSON", defaultEmptyObject: true }
+				omitSetter: true
+				omitGetter: true
+			}
+
+			created_at: schema.SortableTimestampNowField
+			updated_at: schema.SortableTimestampNilField
+			deleted_at: schema.SortableTimestampNilField
+			created_by: schema.AttributeUserRef
+			updated_by: schema.AttributeUserRef
+			deleted_by: schema.AttributeUserRef
+		}
+
+		indexes: {
+			"primary": { attribute: "id" }
+		}
+	}
+
+	envoy: {
+		omit: true
+	}
+
+	filter: {
+		struct: {
+			webhook_id: {goType: "[]uint64", ident: "webhookID", storeIdent: "id" }
+			handle: {goType: "string"}
+			query: {goType: "string"}
+			deleted: {goType: "filter.State", storeIdent: "deleted_at"}
+			disabled: {goType: "filter.State", storeIdent: "enabled"}
+		}
+
+		query: ["handle", "url"]
+		byValue: ["handle", "webhook_id"]
+		byNilState: ["deleted"]
+		byFalseState: ["disabled"]
+	}
+
+	rbac: {
+		operations: {
+			read: description:   "Read webhook and its deliveries"
+			update: description: "Update webhook and redeliver events"
+			delete: description: "Delete webhook"
+		}
+	}
+
+	store: {
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for webhook by ID
+
+						It returns webhook even if deleted or disabled
+						"""
+				}, {
+					fields: ["handle"]
+					nullConstraint: ["deleted_at"]
+					constraintCheck: true
+					description: """
+						searches for webhook by handle
+
+						It returns only valid webhook (not deleted)
+						"""
+				},
+			]
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza/server/codegen/schema"
+)
+
+webhook_delivery: {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	model: {
+		omitGetterSetter: true
+
+		attributes: {
+			id: schema.IdField
+			webhook_id: {
+				ident: "webhookID",
+				goType: "uint64",
+				storeIdent: "rel_webhook"
+				dal: { type: "Ref", refModelResType: "corteza::system:webhook" }
+			}
+			resource_type: {
+				sortable: true
+				dal: {}
+			}
+			event_type: {
+				sortable: true
+				dal: {}
+			}
+			payload: {
+				goType: "rawJson"
+				dal: { type: "JSON", defaultEmptyObject: true }
+			}
+			status: {
+				sortable: true
+				goType: "types.WebhookDeliveryStatus"
+				dal: { type: "Text", length: 32 }
+			}
+			attempts: {
+				goType: "uint"
+				dal: { type: "Number", meta: { "rdbms:type": "integer" } }
+			}
+			response_status: {
+				goType: "int"
+				dal: { type: "Number", meta: { "rdbms:type": "integer" } }
+			}
+			error: {
+				dal: {}
+			}
+			next_attempt_at: schema.SortableTimestampNilField
+			last_attempt_at: schema.SortableTimestampNilField
+			created_at:      schema.SortableTimestampNowField
+			updated_at:      schema.SortableTimestampNilField
+		}
+
+		indexes: {
+			"primary": { attribute: "id" }
+			"webhook": { attribute: "webhook_id" }
+			"status":  { attribute: "status" }
+		}
+	}
+
+	envoy: {
+		omit: true
+	}
+
+	filter: {
+		struct: {
+			delivery_id: {goType: "[]uint64", ident: "deliveryID", storeIdent: "id"}
+			webhook_id: {goType: "[]uint64", ident: "webhookID", storeIdent: "rel_webhook"}
+			status: {goType: "[]types.WebhookDeliveryStatus"}
+			due_at: {goType: "*time.Time"}
+		}
+
+		byValue: ["delivery_id", "webhook_id"]
+	}
+
+	store: {
+		api: {
+			lookups: [
+				{ fields: ["id"] }
+			]
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package automation
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/tests/helpers"
+	"github.com/steinfletcher/apitest-jsonpath"
+)
+
+func (h helper) createVersionedWorkflow(value string) *types.Workflow {
+	var (
+		wf = &types.Workflow{}
+	)
+
+	h.apiInit().
+		Post("/workflows/").
+		Header("Accept", "application/json").
+		JSON(fmt.Sprintf(`{
+			"handle": "wf_%s",
+			"meta": { "name": "versioned" },
+			"enabled": true,
+			"steps": [{ "stepID": "1", "kind": "expressions", "arguments": [{ "target": "out", "type": "String", "expr": %q }] }]
+		}`, rs(), fmt.Sprintf("%q", value))).
+		Expect(h.t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End().
+		JSON(&struct{ Response *types.Workflow }{wf})
+
+	return wf
+}
+
+func (h helper) updateVersionedWorkflow(wf *types.Workflow, value string) {
+	h.apiInit().
+		Put(fmt.Sprintf("/workflows/%d", wf.ID)).
+		Header("Accept", "application/json").
+		JSON(fmt.Sprintf(`{
+			"handle": %q,
+			"meta": { "name": "versioned" },
+			"enabled": true,
+			"steps": [{ "stepID": "1", "kind": "expressions", "arguments": [{ "target": "out", "type": "String", "expr": %q }] }]
+		}`, wf.Handle, fmt.Sprintf("%q", value))).
+		Expect(h.t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+}
+
+func (h helper) publishWorkflow(wf *types.Workflow) {
+	h.apiInit().
+		Post(fmt.Sprintf("/workflows/%d/publish", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(h.t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+}
+
+func TestWorkflowPublishForbidden(t *testing.T) {
+	h := newHelper(t)
+	h.clearWorkflows()
+
+	wf := h.repoMakeWorkflow()
+
+	h.apiInit().
+		Post(fmt.Sprintf("/workflows/%d/publish", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("workflow.errors.notAllowedToPublish")).
+		End()
+}
+
+func TestWorkflowPublish(t *testing.T) {
+	h := newHelper(t)
+	h.clearWorkflows()
+
+	helpers.AllowMe(h, types.ComponentRbacResource(), "workflow.create")
+	helpers.AllowMe(h, types.WorkflowRbacResource(0), "read", "update", "execute")
+
+	wf := h.createVersionedWorkflow("v1")
+
+	h.apiInit().
+		Post(fmt.Sprintf("/workflows/%d/publish", wf.ID)).
+		Header("Accept", "application/json").
+		FormData("description", "first").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.version`, float64(1))).
+		Assert(jsonpath.Equal(`$.response.description`, "first")).
+		End()
+
+	// editing the draft does not change the published version
+	h.updateVersionedWorkflow(wf, "v2")
+	h.a.Equal(uint(1), h.lookupWorkflowByID(wf.ID).PublishedVersion)
+
+	h.publishWorkflow(wf)
+	h.a.Equal(uint(2), h.lookupWorkflowByID(wf.ID).PublishedVersion)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/workflows/%d/diff", wf.ID)).
+		Query("from", "1").
+		Query("to", "2").
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.changes`, 1)).
+		Assert(jsonpath.Equal(`$.response.changes[0].kind`, types.WorkflowChangeStep)).
+		Assert(jsonpath.Equal(`$.response.changes[0].change`, types.WorkflowChangeModified)).
+		End()
+
+	// rollback publishes old definition as a new version
+	h.apiInit().
+		Post(fmt.Sprintf("/workflows/%d/versions/1/rollback", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.version`, float64(3))).
+		End()
+
+	h.a.Equal(uint(3), h.lookupWorkflowByID(wf.ID).PublishedVersion)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/workflows/%d/versions", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 3)).
+		Assert(jsonpath.Equal(`$.response.set[0].version`, float64(3))).
+		End()
+
+	h.apiInit().
+		Get(fmt.Sprintf("/workflows/%d/versions/2", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.version`, float64(2))).
+		End()
+
+	h.apiInit().
+		Get(fmt.Sprintf("/workflows/%d/versions/42", wf.ID)).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("workflow.errors.versionNotFound")).
+		End()
+}
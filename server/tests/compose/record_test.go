@@ -559,6 +559,63 @@ func TestRecordUpdate(t *testing.T) {
 	h.a.NotNil(r)
 }
 
+func TestRecordUpdate_revision(t *testing.T) {
+	h := newHelper(t)
+	h.clearRecords()
+
+	module := h.repoMakeRecordModuleWithFields("record testing module")
+	record := h.makeRecord(module)
+	helpers.AllowMe(h, types.RecordRbacResource(0, 0, 0), "update")
+
+	path := fmt.Sprintf("/namespace/%d/module/%d/record/%d", module.NamespaceID, module.ID, record.ID)
+
+	// first update moves record to the 1st revision
+	h.apiInit().
+		Post(path).
+		JSON(`{"values": [{"name": "name", "value": "v1"}]}`).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusOK).
+		Header("ETag", `"1"`).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	// update of the stale revision is rejected
+	h.apiInit().
+		Post(path).
+		JSON(`{"values": [{"name": "name", "value": "stale"}]}`).
+		Header("Accept", "application/json").
+		Header("If-Match", `"5"`).
+		Expect(t).
+		Status(http.StatusPreconditionFailed).
+		End()
+
+	h.apiInit().
+		Post(path).
+		JSON(`{"revision": 5, "values": [{"name": "name", "value": "stale"}]}`).
+		Header("Accept", "application/json").
+		Expect(t).
+		Status(http.StatusPreconditionFailed).
+		End()
+
+	// update of the current revision returns the next one
+	h.apiInit().
+		Post(path).
+		JSON(`{"values": [{"name": "name", "value": "v2"}]}`).
+		Header("Accept", "application/json").
+		Header("If-Match", `"1"`).
+		Expect(t).
+		Status(http.StatusOK).
+		Header("ETag", `"2"`).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	r := h.lookupRecordByID(module, record.ID)
+	h.a.NotNil(r)
+	h.a.Equal(2, r.Revision)
+	h.a.Equal("v2", r.Values.Get("name", 0).Value)
+}
+
 func TestRecordUpdate_missingField(t *testing.T) {
 	h := newHelper(t)
 	h.clearRecords()
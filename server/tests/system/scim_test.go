@@ -383,3 +383,239 @@ func scimSetWithExternalId(c *scim.Config) {
 func scimSetWithUUIDValidator(c *scim.Config) {
 	c.ExternalIdValidator = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$`)
 }
+
+func TestScimUserList(t *testing.T) {
+	h := newHelper(t)
+	h.clearUsers()
+
+	h.createUserWithEmail("scim-list-a@example.com")
+	b := h.createUser(&types.User{Email: "scim-list-b@example.com", Username: "jdoe"})
+	u := h.createUserWithEmail("scim-list-c@example.com")
+	h.setLabel(u, "SCIM_externalId", "ext-c")
+
+	h.scimApiInit().
+		Get("/Users").
+		Query("filter", `emails.value sw "scim-list-"`).
+		Query("sortBy", "userName").
+		Query("sortOrder", "descending").
+		Query("count", "2").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Contains(`$.schemas`, "urn:ietf:params:scim:api:messages:2.0:ListResponse")).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(3))).
+		Assert(jsonpath.Equal(`$.itemsPerPage`, float64(2))).
+		Assert(jsonpath.Len(`$.Resources`, 2)).
+		End()
+
+	h.scimApiInit().
+		Post("/Users/.search").
+		JSON(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:SearchRequest"],"filter":"externalId eq \"ext-c\""}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(1))).
+		Assert(jsonpath.Equal(`$.Resources[0].id`, fmt.Sprintf("%d", u.ID))).
+		End()
+
+	// paged by the store
+	h.scimApiInit().
+		Get("/Users").
+		Query("startIndex", "2").
+		Query("count", "1").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(3))).
+		Assert(jsonpath.Equal(`$.startIndex`, float64(2))).
+		Assert(jsonpath.Len(`$.Resources`, 1)).
+		End()
+
+	h.scimApiInit().
+		Get("/Users").
+		Query("filter", `emails.value eq "scim-list-b@example.com"`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(1))).
+		Assert(jsonpath.Equal(`$.Resources[0].emails[0].value`, "scim-list-b@example.com")).
+		End()
+
+	// userName and emails are case-insensitive
+	h.scimApiInit().
+		Get("/Users").
+		Query("filter", `userName eq "JDoe"`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(1))).
+		Assert(jsonpath.Equal(`$.Resources[0].id`, fmt.Sprintf("%d", b.ID))).
+		End()
+
+	h.scimApiInit().
+		Get("/Users").
+		Query("filter", `emails.value eq "SCIM-LIST-B@example.com"`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(1))).
+		Assert(jsonpath.Equal(`$.Resources[0].id`, fmt.Sprintf("%d", b.ID))).
+		End()
+
+	h.scimApiInit().
+		Get("/Users").
+		Query("filter", `userName eq`).
+		Expect(t).
+		Status(http.StatusBadRequest).
+		Assert(jsonpath.Equal(`$.scimType`, "invalidFilter")).
+		End()
+}
+
+func TestScimUserPatch(t *testing.T) {
+	h := newHelper(t)
+	h.clearUsers()
+
+	u := h.createUserWithEmail(h.randEmail())
+
+	h.scimApiInit().
+		Patch(fmt.Sprintf("/Users/%d", u.ID)).
+		JSON(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+  {"op":"Replace","path":"active","value":"False"},
+  {"op":"replace","path":"name.formatted","value":"Patched Name"},
+  {"op":"add","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department","value":"Sales"}
+]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.active`, false)).
+		Assert(jsonpath.Equal(`$.name.formatted`, "Patched Name")).
+		Assert(jsonpath.Equal(`$["urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"].department`, "Sales")).
+		End()
+
+	u, err := store.LookupUserByID(context.Background(), service.DefaultStore, u.ID)
+	h.a.NoError(err)
+	h.a.NotNil(u.SuspendedAt)
+	h.a.Equal("Patched Name", u.Name)
+
+	h.scimApiInit().
+		Patch(fmt.Sprintf("/Users/%d", u.ID)).
+		JSON(`{"Operations":[{"op":"replace","value":{"active":true}}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.active`, true)).
+		End()
+
+	u, err = store.LookupUserByID(context.Background(), service.DefaultStore, u.ID)
+	h.a.NoError(err)
+	h.a.Nil(u.SuspendedAt)
+}
+
+func TestScimGroupList(t *testing.T) {
+	h := newHelper(t)
+	h.clearRoles()
+
+	h.createRole(&types.Role{Name: "scim list role"})
+
+	h.scimApiInit().
+		Get("/Groups").
+		Query("filter", `displayName eq "SCIM LIST ROLE"`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(1))).
+		Assert(jsonpath.Equal(`$.Resources[0].displayName`, "scim list role")).
+		End()
+}
+
+func TestScimDiscovery(t *testing.T) {
+	h := newHelper(t)
+
+	h.scimApiInit().
+		Get("/ServiceProviderConfig").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.patch.supported`, true)).
+		Assert(jsonpath.Equal(`$.bulk.supported`, true)).
+		Assert(jsonpath.Equal(`$.filter.supported`, true)).
+		End()
+
+	h.scimApiInit().
+		Get("/ResourceTypes").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.totalResults`, float64(2))).
+		End()
+
+	h.scimApiInit().
+		Get("/ResourceTypes/User").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.schemaExtensions[0].schema`, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User")).
+		End()
+
+	h.scimApiInit().
+		Get("/Schemas/urn:ietf:params:scim:schemas:core:2.0:Group").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Equal(`$.name`, "Group")).
+		End()
+
+	h.scimApiInit().
+		Get("/Schemas/foo").
+		Expect(t).
+		Status(http.StatusNotFound).
+		End()
+}
+
+func TestScimBulk(t *testing.T) {
+	h := newHelper(t)
+	h.clearUsers()
+	h.clearRoles()
+	h.clearRoleMembers()
+
+	h.scimApiInit().
+		Post("/Bulk").
+		JSON(`{
+  "schemas": ["urn:ietf:params:scim:api:messages:2.0:BulkRequest"],
+  "Operations": [
+    {
+      "method": "POST",
+      "path": "/Users",
+      "bulkId": "u1",
+      "data": {"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"bulk","emails":[{"value":"bulk@example.com"}]}
+    },
+    {
+      "method": "POST",
+      "path": "/Groups",
+      "bulkId": "g1",
+      "data": {"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"displayName":"bulk group","members":[{"value":"bulkId:u1"}]}
+    },
+    {
+      "method": "DELETE",
+      "path": "/Users/42"
+    }
+  ]
+}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Contains(`$.schemas`, "urn:ietf:params:scim:api:messages:2.0:BulkResponse")).
+		Assert(jsonpath.Len(`$.Operations`, 3)).
+		Assert(jsonpath.Equal(`$.Operations[0].status`, "201")).
+		Assert(jsonpath.Equal(`$.Operations[1].status`, "201")).
+		Assert(jsonpath.Equal(`$.Operations[2].status`, "400")).
+		End()
+
+	u, err := store.LookupUserByEmail(context.Background(), service.DefaultStore, "bulk@example.com")
+	h.a.NoError(err)
+
+	rr, _, err := store.SearchRoles(context.Background(), service.DefaultStore, types.RoleFilter{Query: "bulk group"})
+	h.a.NoError(err)
+	h.a.Len(rr, 1)
+
+	mm, _, err := store.SearchRoleMembers(h.secCtx(), service.DefaultStore, types.RoleMemberFilter{RoleID: rr[0].ID, UserID: u.ID})
+	h.a.NoError(err)
+	h.a.Len(mm, 1)
+
+	h.scimApiInit().
+		Post("/Bulk").
+		JSON(`{"failOnErrors":1,"Operations":[
+  {"method":"DELETE","path":"/Users/42"},
+  {"method":"DELETE","path":"/Users/43"}
+]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(jsonpath.Len(`$.Operations`, 1)).
+		End()
+}
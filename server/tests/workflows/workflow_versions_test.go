@@ -0,0 +1,100 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza/server/automation/service"
+	"github.com/cortezaproject/corteza/server/automation/types"
+	"github.com/cortezaproject/corteza/server/pkg/filter"
+	"github.com/cortezaproject/corteza/server/pkg/id"
+	"github.com/cortezaproject/corteza/server/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_workflow_versions(t *testing.T) {
+	var (
+		ctx = bypassRBAC(context.Background())
+		req = require.New(t)
+
+		aux = struct{ Foo int64 }{}
+
+		exec = func(p types.WorkflowExecParams) int64 {
+			vars, _ := mustExecWorkflow(ctx, t, "workflow_versions", p)
+			req.NoError(vars.Decode(&aux))
+			return aux.Foo
+		}
+
+		setFoo = func(wf *types.Workflow, foo string) {
+			wf.Steps = types.WorkflowStepSet{{
+				ID:        1,
+				Kind:      types.WorkflowStepKindExpressions,
+				Arguments: []*types.Expr{{Target: "foo", Type: "Integer", Expr: foo}},
+			}}
+
+			_, err := service.DefaultWorkflow.Update(ctx, wf)
+			req.NoError(err)
+		}
+	)
+
+	req.NoError(defStore.TruncateAutomationWorkflowVersions(ctx))
+	req.NoError(defStore.TruncateAutomationSessions(ctx))
+
+	loadNewScenario(ctx, t)
+
+	wf, err := defStore.LookupAutomationWorkflowByHandle(ctx, "workflow_versions")
+	req.NoError(err)
+
+	// never published, draft is executed
+	req.Equal(int64(1), exec(types.WorkflowExecParams{}))
+
+	v, err := service.DefaultWorkflow.Publish(ctx, wf.ID, "")
+	req.NoError(err)
+	req.Equal(uint(1), v.Version)
+
+	wf, err = service.DefaultWorkflow.LookupByID(ctx, wf.ID)
+	req.NoError(err)
+
+	setFoo(wf, "2")
+
+	// draft changes do not affect the published version
+	req.Equal(int64(1), exec(types.WorkflowExecParams{}))
+
+	// tracing runs the draft
+	req.Equal(int64(2), exec(types.WorkflowExecParams{Trace: true}))
+
+	// reloading keeps the published version live
+	req.NoError(service.DefaultWorkflow.Load(ctx))
+	req.Equal(int64(1), exec(types.WorkflowExecParams{}))
+
+	v, err = service.DefaultWorkflow.Publish(ctx, wf.ID, "")
+	req.NoError(err)
+	req.Equal(uint(2), v.Version)
+	req.Equal(int64(2), exec(types.WorkflowExecParams{}))
+
+	// sessions record the version they were started with
+	ss, _, err := store.SearchAutomationSessions(ctx, defStore, types.SessionFilter{
+		WorkflowID: id.Strings(wf.ID),
+		Completed:  filter.StateInclusive,
+	})
+	req.NoError(err)
+
+	versions := make(map[uint]int)
+	for _, s := range ss {
+		versions[s.WorkflowVersion]++
+	}
+
+	// 2 sessions of the draft (before publishing and trace) and
+	// 2 sessions of the first version and one of the second
+	req.Equal(map[uint]int{0: 2, 1: 2, 2: 1}, versions)
+
+	v, err = service.DefaultWorkflow.Rollback(ctx, wf.ID, 1)
+	req.NoError(err)
+	req.Equal(uint(3), v.Version)
+	req.Equal(int64(1), exec(types.WorkflowExecParams{}))
+
+	d, err := service.DefaultWorkflow.Diff(ctx, wf.ID, 2, 3)
+	req.NoError(err)
+	req.Len(d.Changes, 1)
+	req.Equal(types.WorkflowChangeModified, d.Changes[0].Change)
+}